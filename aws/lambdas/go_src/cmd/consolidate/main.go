@@ -8,8 +8,8 @@ import (
 	"os"
 
 	"github.com/aws/aws-lambda-go/lambda"
-	consolidateservice "github.com/projeto-toq/toq_server/aws/lambdas/go_src/internal/core/service/consolidate"
 	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	consolidateservice "github.com/projeto-toq/toq_server/internal/core/service/media_pipeline/consolidate"
 )
 
 var logger *slog.Logger
@@ -4,6 +4,8 @@ import (
 	"context"
 	"log/slog"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/aws/aws-lambda-go/lambda"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -11,7 +13,7 @@ import (
 
 	"github.com/projeto-toq/toq_server/aws/lambdas/go_src/internal/adapter/left/lambda/thumbnails"
 	s3adapter "github.com/projeto-toq/toq_server/aws/lambdas/go_src/internal/adapter/right/s3"
-	imageprocessing "github.com/projeto-toq/toq_server/aws/lambdas/go_src/internal/core/service/image_processing"
+	imageprocessing "github.com/projeto-toq/toq_server/internal/core/service/media_pipeline/image_processing"
 )
 
 func main() {
@@ -29,8 +31,17 @@ func main() {
 	s3Client := s3.NewFromConfig(cfg)
 	storageAdapter := s3adapter.NewS3Adapter(s3Client)
 
-	// 4. Init Service
-	svc := imageprocessing.NewThumbnailService(storageAdapter)
+	// 4. Init Service (WebP/AVIF require the ffmpeg layer; unsupported formats are skipped)
+	extraEncoders, skipped := imageprocessing.BuildExtraEncoders(
+		context.Background(),
+		resolveFFmpegPath(os.Getenv("FFMPEG_PATH")),
+		splitList(os.Getenv("IMAGE_VARIANT_FORMATS")),
+		resolveEnvInt("IMAGE_VARIANT_QUALITY", 80),
+	)
+	for _, reason := range skipped {
+		logger.Warn("Image variant format disabled", "reason", reason)
+	}
+	svc := imageprocessing.NewThumbnailService(storageAdapter, extraEncoders...)
 
 	// 5. Init Handler
 	h := thumbnails.NewHandler(svc, logger)
@@ -38,3 +49,37 @@ func main() {
 	// 6. Start Lambda
 	lambda.Start(h.HandleRequest)
 }
+
+func splitList(raw string) []string {
+	values := make([]string, 0)
+	for _, part := range strings.Split(raw, ",") {
+		if trimmed := strings.TrimSpace(part); trimmed != "" {
+			values = append(values, trimmed)
+		}
+	}
+	return values
+}
+
+func resolveEnvInt(key string, fallback int) int {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return fallback
+	}
+	if v, err := strconv.Atoi(raw); err == nil && v > 0 {
+		return v
+	}
+	return fallback
+}
+
+// resolveFFmpegPath mirrors the lookup used by the video thumbnails Lambda layer.
+func resolveFFmpegPath(fromEnv string) string {
+	for _, candidate := range []string{strings.TrimSpace(fromEnv), "/opt/bin/ffmpeg", "/opt/ffmpeg/ffmpeg"} {
+		if candidate == "" {
+			continue
+		}
+		if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+			return candidate
+		}
+	}
+	return ""
+}
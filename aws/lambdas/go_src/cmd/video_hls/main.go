@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	videohls "github.com/projeto-toq/toq_server/aws/lambdas/go_src/internal/adapter/left/lambda/video_hls"
+	s3adapter "github.com/projeto-toq/toq_server/aws/lambdas/go_src/internal/adapter/right/s3"
+	videoprocessing "github.com/projeto-toq/toq_server/internal/core/service/media_pipeline/video_processing"
+)
+
+func main() {
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+
+	cfg, err := config.LoadDefaultConfig(context.Background())
+	if err != nil {
+		logger.Error("Failed to load AWS config", "error", err)
+		os.Exit(1)
+	}
+
+	s3Client := s3.NewFromConfig(cfg)
+	storageAdapter := s3adapter.NewS3Adapter(s3Client)
+
+	ffmpegPath := os.Getenv("FFMPEG_PATH")
+	segmentSeconds := resolveEnvInt("HLS_SEGMENT_SECONDS", 6)
+	posterSecond := resolveEnvInt("HLS_POSTER_SECOND", 1)
+	renditions := videoprocessing.ParseHLSRenditions(splitList(os.Getenv("HLS_RENDITIONS")))
+
+	svc := videoprocessing.NewHLSService(storageAdapter, ffmpegPath, segmentSeconds, posterSecond, renditions)
+	handler := videohls.NewHandler(svc, logger)
+
+	lambda.Start(handler.HandleRequest)
+}
+
+func resolveEnvInt(key string, fallback int) int {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return fallback
+	}
+	if v, err := strconv.Atoi(raw); err == nil && v > 0 {
+		return v
+	}
+	return fallback
+}
+
+func splitList(raw string) []string {
+	values := make([]string, 0)
+	for _, part := range strings.Split(raw, ",") {
+		if trimmed := strings.TrimSpace(part); trimmed != "" {
+			values = append(values, trimmed)
+		}
+	}
+	return values
+}
@@ -12,7 +12,7 @@ import (
 
 	videothumbnails "github.com/projeto-toq/toq_server/aws/lambdas/go_src/internal/adapter/left/lambda/video_thumbnails"
 	s3adapter "github.com/projeto-toq/toq_server/aws/lambdas/go_src/internal/adapter/right/s3"
-	videoprocessing "github.com/projeto-toq/toq_server/aws/lambdas/go_src/internal/core/service/video_processing"
+	videoprocessing "github.com/projeto-toq/toq_server/internal/core/service/media_pipeline/video_processing"
 )
 
 func main() {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"log/slog"
 	"os"
+	"strconv"
 
 	"github.com/aws/aws-lambda-go/lambda"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -11,7 +12,7 @@ import (
 
 	"github.com/projeto-toq/toq_server/aws/lambdas/go_src/internal/adapter/left/lambda/zip"
 	s3adapter "github.com/projeto-toq/toq_server/aws/lambdas/go_src/internal/adapter/right/s3"
-	zipservice "github.com/projeto-toq/toq_server/aws/lambdas/go_src/internal/core/service/zip"
+	zipservice "github.com/projeto-toq/toq_server/internal/core/service/media_pipeline/zip"
 )
 
 func main() {
@@ -30,7 +31,9 @@ func main() {
 	storageAdapter := s3adapter.NewS3Adapter(s3Client)
 
 	// 4. Init Service
-	svc := zipservice.NewZipService(storageAdapter)
+	prefetch := resolveEnvInt("ZIP_PREFETCH", 4)
+	readAheadMB := resolveEnvInt("ZIP_READ_AHEAD_MB", 8)
+	svc := zipservice.NewZipService(storageAdapter, prefetch, int64(readAheadMB)<<20)
 
 	// 5. Init Handler
 	h := zip.NewHandler(svc, logger)
@@ -38,3 +41,14 @@ func main() {
 	// 6. Start Lambda
 	lambda.Start(h.HandleRequest)
 }
+
+func resolveEnvInt(key string, fallback int) int {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return fallback
+	}
+	if v, err := strconv.Atoi(raw); err == nil && v > 0 {
+		return v
+	}
+	return fallback
+}
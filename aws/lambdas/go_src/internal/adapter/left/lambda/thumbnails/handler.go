@@ -6,8 +6,8 @@ import (
 	"os"
 	"strings"
 
-	imageprocessing "github.com/projeto-toq/toq_server/aws/lambdas/go_src/internal/core/service/image_processing"
 	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	imageprocessing "github.com/projeto-toq/toq_server/internal/core/service/media_pipeline/image_processing"
 )
 
 // Handler manages the Lambda execution flow
@@ -65,7 +65,26 @@ func (h *Handler) HandleRequest(ctx context.Context, event mediaprocessingmodel.
 			continue
 		}
 
-		generatedKeys, err := h.service.ProcessImage(ctx, bucket, asset.Key)
+		if asset.Type == string(mediaprocessingmodel.MediaAssetTypePanorama360) {
+			panorama, err := h.service.ProcessPanorama(ctx, bucket, asset.Key)
+			if err != nil {
+				h.logger.Error("Failed to process panorama", "key", asset.Key, "error", err)
+				collectedErrors = append(collectedErrors, ThumbnailError{
+					SourceKey:    asset.Key,
+					ErrorCode:    mediaprocessingmodel.PanoramaErrorCode(err),
+					ErrorMessage: err.Error(),
+				})
+				continue
+			}
+			h.logger.Info("Panorama tiled", "key", asset.Key, "width", panorama.Tiles.Width, "height", panorama.Tiles.Height, "levels", len(panorama.Tiles.Levels))
+			allGeneratedAssets = append(allGeneratedAssets, panorama.GeneratedAssets(asset.Type, asset.Key)...)
+			continue
+		}
+
+		result, err := h.service.ProcessImage(ctx, bucket, asset.Key, mediaprocessingmodel.ImageOptionsFor(event.ImageOptions, asset.Type))
+		for _, formatErr := range result.FormatErrs {
+			h.logger.Warn("Optional image format skipped", "key", asset.Key, "error", formatErr)
+		}
 		if err != nil {
 			h.logger.Error("Failed to process asset", "key", asset.Key, "error", err)
 			collectedErrors = append(collectedErrors, ThumbnailError{
@@ -76,13 +95,8 @@ func (h *Handler) HandleRequest(ctx context.Context, event mediaprocessingmodel.
 			continue
 		}
 
-		for _, key := range generatedKeys {
-			allGeneratedAssets = append(allGeneratedAssets, mediaprocessingmodel.JobAsset{
-				Key:       key,
-				Type:      asset.Type, // Or derived type?
-				SourceKey: asset.Key,
-			})
-		}
+		h.logger.Info("Photo quality analyzed", "key", asset.Key, "width", result.Quality.Width, "height", result.Quality.Height, "blur", result.Quality.BlurScore, "brightness", result.Quality.Brightness)
+		allGeneratedAssets = append(allGeneratedAssets, result.GeneratedAssets(asset.Type, asset.Key)...)
 	}
 
 	h.logger.Info("Thumbnails Lambda finished", "generated_count", len(allGeneratedAssets))
@@ -0,0 +1,100 @@
+package videohls
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strings"
+
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	videoprocessing "github.com/projeto-toq/toq_server/internal/core/service/media_pipeline/video_processing"
+)
+
+// Handler orchestrates the HLS transcoding Lambda execution flow.
+type Handler struct {
+	service *videoprocessing.HLSService
+	logger  *slog.Logger
+}
+
+// NewHandler builds the handler with its dependencies.
+func NewHandler(service *videoprocessing.HLSService, logger *slog.Logger) *Handler {
+	return &Handler{service: service, logger: logger}
+}
+
+type Output struct {
+	Status          string                          `json:"status"`
+	GeneratedAssets []mediaprocessingmodel.JobAsset `json:"generatedAssets"`
+	Errors          []TranscodeError                `json:"errors"`
+}
+
+// TranscodeError surfaces failures to the orchestrator for telemetry.
+type TranscodeError struct {
+	SourceKey    string `json:"sourceKey"`
+	ErrorCode    string `json:"errorCode"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+func (h *Handler) HandleRequest(ctx context.Context, event mediaprocessingmodel.StepFunctionPayload) (mediaprocessingmodel.LambdaResponse, error) {
+	assets := event.VideoAssets
+	if len(assets) == 0 {
+		for _, asset := range event.Assets {
+			if strings.Contains(strings.ToUpper(asset.Type), "VIDEO") {
+				assets = append(assets, asset)
+			}
+		}
+	}
+
+	h.logger.Info("Video HLS Lambda started",
+		"job_id", event.JobID,
+		"listing_identity_id", event.ListingIdentityID,
+		"assets_to_process", len(assets),
+	)
+
+	bucket := resolveBucket()
+	generated := make([]mediaprocessingmodel.JobAsset, 0, len(assets)*4)
+	errs := make([]TranscodeError, 0)
+
+	for i, asset := range assets {
+		h.logger.Info("Transcoding video asset", "index", i, "key", asset.Key, "type", asset.Type)
+
+		if asset.Error != "" {
+			h.logger.Warn("Skipping asset with previous error", "key", asset.Key, "error", asset.Error)
+			continue
+		}
+
+		output, err := h.service.Transcode(ctx, bucket, asset.Key)
+		if err != nil {
+			h.logger.Error("Video HLS transcoding failed", "key", asset.Key, "error", err)
+			errs = append(errs, TranscodeError{
+				SourceKey:    asset.Key,
+				ErrorCode:    "VIDEO_HLS_FAILED",
+				ErrorMessage: err.Error(),
+			})
+			continue
+		}
+
+		sourceKey := asset.SourceKey
+		if sourceKey == "" {
+			sourceKey = asset.Key
+		}
+
+		h.logger.Info("Video HLS generated", "key", asset.Key, "master_key", output.MasterKey, "renditions", len(output.Playlists), "objects", len(output.ObjectKeys))
+		generated = append(generated, output.GeneratedAssets(sourceKey)...)
+	}
+
+	h.logger.Info("Video HLS completed", "generated_count", len(generated), "error_count", len(errs))
+
+	return mediaprocessingmodel.LambdaResponse{Body: Output{
+		Status:          "SUCCESS",
+		GeneratedAssets: generated,
+		Errors:          errs,
+	}}, nil
+}
+
+func resolveBucket() string {
+	bucket := os.Getenv("MEDIA_BUCKET")
+	if bucket == "" {
+		bucket = "toq-listing-medias"
+	}
+	return bucket
+}
@@ -6,8 +6,8 @@ import (
 	"os"
 	"strings"
 
-	videoprocessing "github.com/projeto-toq/toq_server/aws/lambdas/go_src/internal/core/service/video_processing"
 	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	videoprocessing "github.com/projeto-toq/toq_server/internal/core/service/media_pipeline/video_processing"
 )
 
 // Handler orchestrates the video thumbnail Lambda execution flow.
@@ -7,8 +7,8 @@ import (
 	"log/slog"
 	"os"
 
-	"github.com/projeto-toq/toq_server/aws/lambdas/go_src/internal/core/service/zip"
 	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	"github.com/projeto-toq/toq_server/internal/core/service/media_pipeline/zip"
 )
 
 type Handler struct {
@@ -26,6 +26,8 @@ type ZipOutput struct {
 	ZipBundles        []string `json:"zipBundles"`
 	ZipSizeBytes      int64    `json:"zipSizeBytes"`
 	UnzippedSizeBytes int64    `json:"unzippedSizeBytes"`
+	ZipManifestKey    string   `json:"zipManifestKey"`
+	ZipSHA256         string   `json:"zipSha256"`
 }
 
 const listingMediaZipObject = "listing-media.zip"
@@ -49,7 +51,7 @@ func (h *Handler) HandleRequest(ctx context.Context, event mediaprocessingmodel.
 	bucket := h.resolveBucket()
 	destinationKey := buildZipKey(event.ListingIdentityID, event.JobID)
 
-	unzippedBytes, zipBytes, err := h.service.CreateZip(ctx, bucket, sourceKeys, destinationKey)
+	result, err := h.service.CreateZip(ctx, bucket, sourceKeys, destinationKey)
 	if err != nil {
 		h.logger.Error("lambda.zip.create_zip_error", "error", err, "bucket", bucket, "destination", destinationKey)
 		return mediaprocessingmodel.LambdaResponse{}, err
@@ -59,8 +61,10 @@ func (h *Handler) HandleRequest(ctx context.Context, event mediaprocessingmodel.
 		"job_id", event.JobID,
 		"listing_identity_id", event.ListingIdentityID,
 		"zip_key", destinationKey,
-		"zip_size_bytes", zipBytes,
-		"unzipped_size_bytes", unzippedBytes,
+		"zip_size_bytes", result.ZipBytes,
+		"unzipped_size_bytes", result.UncompressedBytes,
+		"manifest_key", result.ManifestKey,
+		"zip_sha256", result.SHA256,
 	)
 
 	return mediaprocessingmodel.LambdaResponse{
@@ -68,8 +72,10 @@ func (h *Handler) HandleRequest(ctx context.Context, event mediaprocessingmodel.
 			ZipKey:            destinationKey,
 			AssetsZipped:      len(sourceKeys),
 			ZipBundles:        []string{destinationKey},
-			ZipSizeBytes:      zipBytes,
-			UnzippedSizeBytes: unzippedBytes,
+			ZipSizeBytes:      result.ZipBytes,
+			UnzippedSizeBytes: result.UncompressedBytes,
+			ZipManifestKey:    result.ManifestKey,
+			ZipSHA256:         result.SHA256,
 		},
 	}, nil
 }
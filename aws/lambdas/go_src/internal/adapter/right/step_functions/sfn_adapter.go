@@ -2,10 +2,12 @@ package stepfunctions
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/aws/aws-sdk-go-v2/service/sfn/types"
 	"github.com/projeto-toq/toq_server/aws/lambdas/go_src/internal/core/port"
 )
 
@@ -19,12 +21,17 @@ func NewSfnAdapter(client *sfn.Client) port.WorkflowPort {
 	}
 }
 
-func (a *SfnAdapter) StartExecution(ctx context.Context, stateMachineArn string, input string) (string, error) {
+func (a *SfnAdapter) StartExecution(ctx context.Context, stateMachineArn string, name string, input string) (string, error) {
 	out, err := a.client.StartExecution(ctx, &sfn.StartExecutionInput{
 		StateMachineArn: aws.String(stateMachineArn),
+		Name:            aws.String(name),
 		Input:           aws.String(input),
 	})
 	if err != nil {
+		var alreadyExists *types.ExecutionAlreadyExists
+		if errors.As(err, &alreadyExists) {
+			return "", port.ErrExecutionAlreadyExists
+		}
 		return "", fmt.Errorf("failed to start execution: %w", err)
 	}
 	return aws.ToString(out.ExecutionArn), nil
@@ -1,18 +1,9 @@
 package port
 
 import (
-	"context"
-	"io"
+	storageport "github.com/projeto-toq/toq_server/internal/core/port/right/storage"
 )
 
-// StoragePort defines the interface for object storage operations (e.g., S3)
-type StoragePort interface {
-	// Download retrieves an object from storage
-	Download(ctx context.Context, bucket, key string) (io.ReadCloser, error)
-
-	// Upload stores an object and returns its location/error
-	Upload(ctx context.Context, bucket, key string, body io.Reader, contentType string) error
-
-	// GetMetadata retrieves object metadata (size, etag)
-	GetMetadata(ctx context.Context, bucket, key string) (int64, string, error)
-}
+// StoragePort defines the interface for object storage operations (e.g., S3).
+// It aliases the server contract so the shared media pipeline services accept the Lambda adapter.
+type StoragePort = storageport.MediaObjectStoragePort
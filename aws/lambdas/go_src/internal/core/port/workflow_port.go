@@ -1,9 +1,16 @@
 package port
 
-import "context"
+import (
+	"context"
+	"errors"
+)
+
+// ErrExecutionAlreadyExists is returned when an execution with the same name was already started.
+var ErrExecutionAlreadyExists = errors.New("workflow execution already exists")
 
 // WorkflowPort defines the interface for workflow orchestration (e.g., Step Functions)
 type WorkflowPort interface {
-	// StartExecution starts a new execution of the state machine and returns the execution ARN.
-	StartExecution(ctx context.Context, stateMachineArn string, input string) (executionArn string, err error)
+	// StartExecution starts a named execution of the state machine and returns the execution ARN;
+	// ErrExecutionAlreadyExists when the name was already used.
+	StartExecution(ctx context.Context, stateMachineArn string, name string, input string) (executionArn string, err error)
 }
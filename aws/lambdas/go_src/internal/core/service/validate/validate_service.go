@@ -3,6 +3,7 @@ package validate
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"path"
@@ -43,10 +44,11 @@ func (s *ValidateService) ProcessSQSEvent(ctx context.Context, records []events.
 		s.logger.Info("Processing SQS record", "message_id", record.MessageId)
 
 		var rawPayload struct {
-			JobID             uint64          `json:"jobId"`
-			ListingIdentityID uint64          `json:"listingIdentityId"`
-			Assets            json.RawMessage `json:"assets"`
-			Retry             uint16          `json:"retry"`
+			JobID             uint64                                                 `json:"jobId"`
+			ListingIdentityID uint64                                                 `json:"listingIdentityId"`
+			Assets            json.RawMessage                                        `json:"assets"`
+			Retry             uint16                                                 `json:"retry"`
+			ImageOptions      map[string]mediaprocessingmodel.ImageProcessingOptions `json:"imageOptions"`
 		}
 
 		if err := json.Unmarshal([]byte(record.Body), &rawPayload); err != nil {
@@ -81,13 +83,21 @@ func (s *ValidateService) ProcessSQSEvent(ctx context.Context, records []events.
 			JobID:             rawPayload.JobID,
 			ListingIdentityID: rawPayload.ListingIdentityID,
 			Assets:            assets,
+			ImageOptions:      rawPayload.ImageOptions,
 		}
 
 		// Start Step Function
 		inputBytes, _ := json.Marshal(payload)
 		inputStr := string(inputBytes)
 
-		executionArn, err := s.workflow.StartExecution(ctx, s.smArn, inputStr)
+		// The execution name comes from the job, so a redelivered message does not start a second
+		// execution and the backend can stop it before the callback reports the ARN.
+		executionName := mediaprocessingmodel.ProcessingExecutionName(payload.ListingIdentityID, payload.JobID)
+		executionArn, err := s.workflow.StartExecution(ctx, s.smArn, executionName, inputStr)
+		if errors.Is(err, port.ErrExecutionAlreadyExists) {
+			s.logger.Warn("Step Function execution already started", "job_id", payload.JobID, "execution_name", executionName)
+			continue
+		}
 		if err != nil {
 			s.logger.Error("Failed to start Step Function", "error", err, "job_id", payload.JobID)
 			return err // Fail the lambda so SQS retries
@@ -1,98 +0,0 @@
-package zip
-
-import (
-	"archive/zip"
-	"bytes"
-	"context"
-	"fmt"
-	"io"
-	"regexp"
-	"strings"
-
-	"github.com/projeto-toq/toq_server/aws/lambdas/go_src/internal/core/port"
-)
-
-type ZipService struct {
-	storage port.StoragePort
-}
-
-func NewZipService(storage port.StoragePort) *ZipService {
-	return &ZipService{
-		storage: storage,
-	}
-}
-
-// CreateZip creates a zip file from the given keys and uploads it to the destination key.
-// It returns the total bytes read from the source objects (unzipped) and the final archive size.
-func (s *ZipService) CreateZip(ctx context.Context, bucket string, sourceKeys []string, destinationKey string) (int64, int64, error) {
-	buf := new(bytes.Buffer)
-	zipWriter := zip.NewWriter(buf)
-	var uncompressedBytes int64
-
-	for _, key := range sourceKeys {
-		// 1. Download file
-		reader, err := s.storage.Download(ctx, bucket, key)
-		if err != nil {
-			// Log error but maybe continue? For now, fail.
-			return 0, 0, fmt.Errorf("failed to download %s: %w", key, err)
-		}
-
-		// 2. Determine internal path in zip
-		internalPath := s.cleanPath(key)
-
-		// 3. Create entry in zip
-		header := &zip.FileHeader{
-			Name:   internalPath,
-			Method: zip.Deflate,
-		}
-		writer, err := zipWriter.CreateHeader(header)
-		if err != nil {
-			reader.Close()
-			return 0, 0, fmt.Errorf("failed to create zip header for %s: %w", key, err)
-		}
-
-		// 4. Copy content
-		written, err := io.Copy(writer, reader)
-		reader.Close()
-		if err != nil {
-			return 0, 0, fmt.Errorf("failed to write %s to zip: %w", key, err)
-		}
-		uncompressedBytes += written
-	}
-
-	if err := zipWriter.Close(); err != nil {
-		return 0, 0, fmt.Errorf("failed to close zip writer: %w", err)
-	}
-
-	zipBytes := int64(buf.Len())
-
-	// 5. Upload zip
-	if err := s.storage.Upload(ctx, bucket, destinationKey, buf, "application/zip"); err != nil {
-		return 0, 0, fmt.Errorf("failed to upload zip to %s: %w", destinationKey, err)
-	}
-
-	return uncompressedBytes, zipBytes, nil
-}
-
-// cleanPath removes 'processed/' prefix and date segments to create a clean internal zip path
-func (s *ZipService) cleanPath(key string) string {
-	original := key
-	path := key
-
-	if parts := strings.SplitN(path, "/", 2); len(parts) == 2 {
-		path = parts[1]
-	}
-
-	path = strings.TrimPrefix(path, "processed/")
-	path = strings.TrimPrefix(path, "raw/")
-
-	// Remove date segment (YYYY-MM-DD/)
-	dateRegex := regexp.MustCompile(`\d{4}-\d{2}-\d{2}/`)
-	path = dateRegex.ReplaceAllString(path, "")
-
-	if path == "" {
-		return original
-	}
-
-	return path
-}
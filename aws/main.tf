@@ -141,8 +141,9 @@ module "lambda_thumbnails" {
   handler       = "bootstrap"
   runtime       = "provided.al2023"
   filename      = "${path.module}/lambdas/bin/thumbnails.zip"
-  memory_size   = 2048
-  timeout       = 300
+  # 360° panoramas are decoded and resized up to 8192x4096 and cut into up to 168 tiles.
+  memory_size   = 3008
+  timeout       = 900
   environment   = {
     MEDIA_BUCKET    = local.media_bucket
     ENV             = "staging"
@@ -167,6 +168,26 @@ module "lambda_video_thumbnails" {
   layers        = [var.ffmpeg_layer_arn]
 }
 
+module "lambda_video_hls" {
+  source       = "./modules/lambda_function"
+  function_name = "listing-media-video_hls-staging"
+  role_arn      = module.iam_lambda.arn
+  handler       = "bootstrap"
+  runtime       = "provided.al2"
+  filename      = "${path.module}/lambdas/bin/video_hls.zip"
+  memory_size   = 3008
+  timeout       = 900
+  ephemeral_storage_size = 4096
+  environment   = {
+    MEDIA_BUCKET        = local.media_bucket
+    FFMPEG_PATH         = "/opt/bin/ffmpeg"
+    HLS_SEGMENT_SECONDS = "6"
+    HLS_RENDITIONS      = "360p,540p,720p,1080p"
+  }
+  tracing_mode  = "PassThrough"
+  layers        = [var.ffmpeg_layer_arn]
+}
+
 module "lambda_consolidate" {
   source       = "./modules/lambda_function"
   function_name = "listing-media-consolidate-staging"
@@ -369,6 +390,7 @@ data "aws_iam_policy_document" "sfn_policy" {
       module.lambda_validate.arn,
       module.lambda_thumbnails.arn,
       module.lambda_video_thumbnails.arn,
+      module.lambda_video_hls.arn,
       module.lambda_consolidate.arn,
       module.lambda_callback.arn,
       module.lambda_callback_dispatch.arn,
@@ -509,6 +531,7 @@ data "aws_iam_policy_document" "backend_policy_extended" {
       module.lambda_validate.arn,
       module.lambda_thumbnails.arn,
       module.lambda_video_thumbnails.arn,
+      module.lambda_video_hls.arn,
       module.lambda_zip.arn,
       module.lambda_callback_dispatch.arn,
       module.lambda_consolidate.arn
@@ -544,6 +567,15 @@ data "aws_iam_policy_document" "backend_policy_extended" {
     resources = ["arn:aws:states:${var.aws_region}:${local.account_id}:stateMachine:listing-media-finalization-sm-staging"]
   }
 
+  statement {
+    sid     = "StopMediaExecutions"
+    actions = ["states:StopExecution"]
+    resources = [
+      "arn:aws:states:${var.aws_region}:${local.account_id}:execution:listing-media-processing-sm-staging:*",
+      "arn:aws:states:${var.aws_region}:${local.account_id}:execution:listing-media-finalization-sm-staging:*"
+    ]
+  }
+
   statement {
     sid     = "CloudWatchLogs"
     actions = [
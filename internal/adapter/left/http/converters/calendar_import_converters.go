@@ -0,0 +1,106 @@
+package converters
+
+import (
+	dto "github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	photosessionservices "github.com/projeto-toq/toq_server/internal/core/service/photo_session_service"
+	scheduleservices "github.com/projeto-toq/toq_server/internal/core/service/schedule_service"
+)
+
+// ScheduleImportResultToDTO converts a listing calendar import result into the HTTP response payload.
+func ScheduleImportResultToDTO(result scheduleservices.ImportBlocksResult) dto.ScheduleImportResponse {
+	items := make([]dto.ScheduleImportItemResponse, 0, len(result.Items))
+	for _, item := range result.Items {
+		resp := dto.ScheduleImportItemResponse{
+			UID:      item.UID,
+			Summary:  item.Summary,
+			StartsAt: formatScheduleTime(item.StartsAt),
+			EndsAt:   formatScheduleTime(item.EndsAt),
+			AllDay:   item.AllDay,
+			Partial:  item.Partial,
+			Status:   string(item.Status),
+			EntryID:  item.EntryID,
+		}
+		for _, conflict := range item.Conflicts {
+			conflictResp := dto.ScheduleImportConflictResponse{
+				EntryID:   conflict.EntryID,
+				EntryType: string(conflict.EntryType),
+				StartsAt:  formatScheduleTime(conflict.StartsAt),
+				EndsAt:    formatScheduleTime(conflict.EndsAt),
+			}
+			if conflict.VisitID != nil {
+				conflictResp.VisitID = *conflict.VisitID
+			}
+			if conflict.PhotoBookingID != nil {
+				conflictResp.PhotoBookingID = *conflict.PhotoBookingID
+			}
+			resp.Conflicts = append(resp.Conflicts, conflictResp)
+		}
+		items = append(items, resp)
+	}
+
+	unsupported := make([]dto.CalendarImportUnsupportedResponse, 0, len(result.Unsupported))
+	for _, event := range result.Unsupported {
+		unsupported = append(unsupported, dto.CalendarImportUnsupportedResponse{UID: event.UID, Summary: event.Summary, Reason: event.Reason})
+	}
+
+	return dto.ScheduleImportResponse{
+		ListingIdentityID: result.ListingIdentityID,
+		EntryType:         string(result.EntryType),
+		Timezone:          result.Timezone,
+		WindowStart:       formatScheduleTime(result.WindowStart),
+		WindowEnd:         formatScheduleTime(result.WindowEnd),
+		Items:             items,
+		Unsupported:       unsupported,
+		Ready:             result.Ready,
+		Conflicts:         result.Conflicts,
+		Created:           result.Created,
+	}
+}
+
+// TimeOffImportResultToDTO converts a photographer calendar import result into the HTTP response payload.
+func TimeOffImportResultToDTO(result photosessionservices.ImportTimeOffResult) dto.TimeOffImportResponse {
+	items := make([]dto.TimeOffImportItemResponse, 0, len(result.Items))
+	for _, item := range result.Items {
+		resp := dto.TimeOffImportItemResponse{
+			UID:       item.UID,
+			Summary:   item.Summary,
+			StartDate: formatTimeOff(item.StartsAt),
+			EndDate:   formatTimeOff(item.EndsAt),
+			AllDay:    item.AllDay,
+			Partial:   item.Partial,
+			Status:    string(item.Status),
+			TimeOffID: item.TimeOffID,
+		}
+		for _, conflict := range item.Conflicts {
+			conflictResp := dto.TimeOffImportConflictResponse{
+				EntryID:   conflict.EntryID,
+				StartDate: formatTimeOff(conflict.StartsAt),
+				EndDate:   formatTimeOff(conflict.EndsAt),
+			}
+			if conflict.BookingID != nil {
+				conflictResp.BookingID = *conflict.BookingID
+			}
+			if conflict.ListingIdentityID != nil {
+				conflictResp.ListingIdentityID = *conflict.ListingIdentityID
+			}
+			resp.Conflicts = append(resp.Conflicts, conflictResp)
+		}
+		items = append(items, resp)
+	}
+
+	unsupported := make([]dto.CalendarImportUnsupportedResponse, 0, len(result.Unsupported))
+	for _, event := range result.Unsupported {
+		unsupported = append(unsupported, dto.CalendarImportUnsupportedResponse{UID: event.UID, Summary: event.Summary, Reason: event.Reason})
+	}
+
+	return dto.TimeOffImportResponse{
+		Timezone:    result.Timezone,
+		WindowStart: formatTimeOff(result.WindowStart),
+		WindowEnd:   formatTimeOff(result.WindowEnd),
+		Items:       items,
+		Unsupported: unsupported,
+		Ready:       result.Ready,
+		Conflicts:   result.Conflicts,
+		Created:     result.Created,
+	}
+}
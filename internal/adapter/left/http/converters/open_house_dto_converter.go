@@ -0,0 +1,175 @@
+package converters
+
+import (
+	"strings"
+	"time"
+
+	dto "github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	visitservice "github.com/projeto-toq/toq_server/internal/core/service/visit_service"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// CreateOpenHouseDTOToInput converts the incoming DTO into a service input with parsed values.
+func CreateOpenHouseDTOToInput(req dto.CreateOpenHouseRequest) (visitservice.CreateOpenHouseInput, error) {
+	start, err := time.Parse(time.RFC3339, strings.TrimSpace(req.StartsAt))
+	if err != nil {
+		return visitservice.CreateOpenHouseInput{}, coreutils.ValidationError("startsAt", "must be a valid RFC3339 timestamp")
+	}
+
+	end, err := time.Parse(time.RFC3339, strings.TrimSpace(req.EndsAt))
+	if err != nil {
+		return visitservice.CreateOpenHouseInput{}, coreutils.ValidationError("endsAt", "must be a valid RFC3339 timestamp")
+	}
+
+	return visitservice.CreateOpenHouseInput{
+		ListingIdentityID: req.ListingIdentityID,
+		StartsAt:          start.UTC(),
+		EndsAt:            end.UTC(),
+		Capacity:          req.Capacity,
+		SlotMinutes:       req.SlotMinutes,
+		Notes:             strings.TrimSpace(req.Notes),
+	}, nil
+}
+
+// RegisterOpenHouseClientDTOToInput converts the registration DTO into a service input.
+func RegisterOpenHouseClientDTOToInput(req dto.RegisterOpenHouseClientRequest) (visitservice.RegisterOpenHouseClientInput, error) {
+	input := visitservice.RegisterOpenHouseClientInput{
+		OpenHouseID: req.OpenHouseID,
+		ClientName:  strings.TrimSpace(req.ClientName),
+		ClientPhone: strings.TrimSpace(req.ClientPhone),
+	}
+
+	if raw := strings.TrimSpace(req.SlotStart); raw != "" {
+		slotStart, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return visitservice.RegisterOpenHouseClientInput{}, coreutils.ValidationError("slotStart", "must be a valid RFC3339 timestamp")
+		}
+		slotStart = slotStart.UTC()
+		input.SlotStart = &slotStart
+	}
+
+	return input, nil
+}
+
+// OpenHouseDomainToResponse maps an open house (without counters) to a response DTO.
+func OpenHouseDomainToResponse(openHouse listingmodel.OpenHouseInterface) dto.OpenHouseResponse {
+	return OpenHouseWithCountsToResponse(listingmodel.OpenHouseWithCounts{OpenHouse: openHouse})
+}
+
+// OpenHouseWithCountsToResponse maps an open house and its counters to a response DTO.
+func OpenHouseWithCountsToResponse(item listingmodel.OpenHouseWithCounts) dto.OpenHouseResponse {
+	openHouse := item.OpenHouse
+	if openHouse == nil {
+		return dto.OpenHouseResponse{}
+	}
+
+	response := dto.OpenHouseResponse{
+		ID:                openHouse.ID(),
+		ListingIdentityID: openHouse.ListingIdentityID(),
+		OwnerUserID:       openHouse.OwnerUserID(),
+		StartsAt:          openHouse.StartsAt().UTC().Format(time.RFC3339),
+		EndsAt:            openHouse.EndsAt().UTC().Format(time.RFC3339),
+		Capacity:          openHouse.Capacity(),
+		SlotMinutes:       openHouse.SlotMinutes(),
+		Status:            string(openHouse.Status()),
+		RegisteredCount:   item.RegisteredCount,
+		WaitlistCount:     item.WaitlistCount,
+		CheckedInCount:    item.CheckedInCount,
+	}
+
+	if notes, ok := openHouse.Notes(); ok {
+		response.Notes = notes
+	}
+	if reason, ok := openHouse.CancellationReason(); ok {
+		response.CancellationReason = reason
+	}
+
+	return response
+}
+
+// OpenHouseListToResponse maps paginated open houses to the list response.
+func OpenHouseListToResponse(output visitservice.OpenHouseListOutput) dto.OpenHouseListResponse {
+	page := output.Page
+	limit := output.Limit
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+
+	items := make([]dto.OpenHouseResponse, 0, len(output.Items))
+	for _, item := range output.Items {
+		items = append(items, OpenHouseWithCountsToResponse(item))
+	}
+
+	return dto.OpenHouseListResponse{
+		Items: items,
+		Pagination: dto.PaginationResponse{
+			Page:       page,
+			Limit:      limit,
+			Total:      output.Total,
+			TotalPages: visitTotalPages(output.Total, limit),
+		},
+	}
+}
+
+// OpenHouseRegistrationToResponse maps a registration and its waitlist position to a response DTO.
+func OpenHouseRegistrationToResponse(output visitservice.OpenHouseRegistrationOutput) dto.OpenHouseRegistrationResponse {
+	registration := output.Registration
+	if registration == nil {
+		return dto.OpenHouseRegistrationResponse{}
+	}
+
+	response := dto.OpenHouseRegistrationResponse{
+		ID:               registration.ID(),
+		OpenHouseID:      registration.OpenHouseID(),
+		RealtorUserID:    registration.RealtorUserID(),
+		ClientName:       registration.ClientName(),
+		SlotStart:        registration.SlotStart().UTC().Format(time.RFC3339),
+		Status:           string(registration.Status()),
+		WaitlistPosition: output.WaitlistPosition,
+		CreatedAt:        registration.CreatedAt().UTC().Format(time.RFC3339),
+	}
+
+	if phone, ok := registration.ClientPhone(); ok {
+		response.ClientPhone = phone
+	}
+	if checkedInAt, ok := registration.CheckedInAt(); ok {
+		formatted := checkedInAt.UTC().Format(time.RFC3339)
+		response.CheckedInAt = &formatted
+	}
+
+	return response
+}
+
+// OpenHouseDetailToResponse maps the detail output (slots and visible registrations) to a response DTO.
+func OpenHouseDetailToResponse(output visitservice.OpenHouseDetailOutput) dto.OpenHouseDetailResponse {
+	item := listingmodel.OpenHouseWithCounts{OpenHouse: output.OpenHouse}
+	slots := make([]dto.OpenHouseSlotResponse, 0, len(output.Slots))
+	for _, slot := range output.Slots {
+		item.RegisteredCount += int64(slot.Registered)
+		item.WaitlistCount += int64(slot.Waitlisted)
+		item.CheckedInCount += int64(slot.CheckedIn)
+		slots = append(slots, dto.OpenHouseSlotResponse{
+			SlotStart:  slot.SlotStart.UTC().Format(time.RFC3339),
+			SlotEnd:    slot.SlotEnd.UTC().Format(time.RFC3339),
+			Registered: slot.Registered,
+			Waitlisted: slot.Waitlisted,
+			CheckedIn:  slot.CheckedIn,
+			Available:  slot.Available,
+		})
+	}
+
+	registrations := make([]dto.OpenHouseRegistrationResponse, 0, len(output.Registrations))
+	for _, registration := range output.Registrations {
+		registrations = append(registrations, OpenHouseRegistrationToResponse(registration))
+	}
+
+	return dto.OpenHouseDetailResponse{
+		OpenHouse:     OpenHouseWithCountsToResponse(item),
+		Slots:         slots,
+		Registrations: registrations,
+	}
+}
@@ -130,9 +130,62 @@ func ScheduleRulesMutationToDTO(result scheduleservices.RuleMutationResult) dto.
 // ScheduleRuleListToDTO converts a rule list domain result into a response payload.
 func ScheduleRuleListToDTO(result schedulemodel.RuleListResult) dto.ScheduleRulesResponse {
 	return dto.ScheduleRulesResponse{
-		ListingIdentityID: result.ListingIdentityID,
-		Timezone:          result.Timezone,
-		Rules:             scheduleRulesToDTO(result.Rules),
+		ListingIdentityID:  result.ListingIdentityID,
+		Timezone:           result.Timezone,
+		Rules:              scheduleRulesToDTO(result.Rules),
+		TemplateID:         result.TemplateID,
+		TemplateOverridden: result.TemplateOverridden,
+	}
+}
+
+// ScheduleTemplatesToDTO converts the owner's templates and their linked agendas into a response payload.
+func ScheduleTemplatesToDTO(items []scheduleservices.TemplateListItem) dto.ScheduleTemplatesResponse {
+	templates := make([]dto.ScheduleTemplateResponse, 0, len(items))
+	for _, item := range items {
+		response := ScheduleTemplateToDTO(item.Template)
+		for _, agenda := range item.LinkedAgendas {
+			response.LinkedListings = append(response.LinkedListings, dto.ScheduleTemplateLinkResponse{
+				ListingIdentityID: agenda.ListingIdentityID(),
+				Overridden:        agenda.TemplateOverridden(),
+			})
+		}
+		templates = append(templates, response)
+	}
+	return dto.ScheduleTemplatesResponse{Templates: templates}
+}
+
+// ScheduleTemplateMutationToDTO converts a template mutation result into a response payload.
+func ScheduleTemplateMutationToDTO(result scheduleservices.TemplateMutationResult) dto.ScheduleTemplateMutationResponse {
+	response := dto.ScheduleTemplateMutationResponse{
+		Template:                     ScheduleTemplateToDTO(result.Template),
+		SyncedListingIdentityIDs:     result.SyncedListingIdentityIDs,
+		OverriddenListingIdentityIDs: result.OverriddenListingIdentityIDs,
+	}
+	if response.SyncedListingIdentityIDs == nil {
+		response.SyncedListingIdentityIDs = []int64{}
+	}
+	if response.OverriddenListingIdentityIDs == nil {
+		response.OverriddenListingIdentityIDs = []int64{}
+	}
+	return response
+}
+
+// ScheduleTemplateToDTO converts a single availability template into a response representation.
+func ScheduleTemplateToDTO(template schedulemodel.AvailabilityTemplateInterface) dto.ScheduleTemplateResponse {
+	if template == nil {
+		return dto.ScheduleTemplateResponse{}
+	}
+	rules := make([]dto.ScheduleRuleResponse, 0, len(template.Rules()))
+	for _, rule := range template.Rules() {
+		rules = append(rules, ScheduleRuleToDTO(rule))
+	}
+	return dto.ScheduleTemplateResponse{
+		TemplateID: template.ID(),
+		Name:       template.Name(),
+		IsDefault:  template.IsDefault(),
+		CreatedAt:  formatScheduleTime(template.CreatedAt()),
+		UpdatedAt:  formatScheduleTime(template.UpdatedAt()),
+		Rules:      rules,
 	}
 }
 
@@ -141,13 +194,30 @@ func ScheduleRuleToDTO(rule schedulemodel.AgendaRuleInterface) dto.ScheduleRuleR
 	if rule == nil {
 		return dto.ScheduleRuleResponse{}
 	}
-	return dto.ScheduleRuleResponse{
-		RuleID:    rule.ID(),
-		Weekday:   formatWeekday(rule.DayOfWeek()),
-		StartTime: formatMinutesAsTime(rule.StartMinutes()),
-		EndTime:   formatMinutesAsTime(rule.EndMinutes()),
-		Active:    rule.IsActive(),
+	resp := dto.ScheduleRuleResponse{
+		RuleID:      rule.ID(),
+		Weekday:     formatWeekday(rule.DayOfWeek()),
+		StartTime:   formatMinutesAsTime(rule.StartMinutes()),
+		EndTime:     formatMinutesAsTime(rule.EndMinutes()),
+		Active:      rule.IsActive(),
+		Recurrence:  string(rule.Recurrence()),
+		RuleType:    string(rule.RuleType()),
+		DayOfMonth:  rule.DayOfMonth(),
+		WeekOfMonth: rule.WeekOfMonth(),
+	}
+	if rule.Recurrence() == schedulemodel.RuleRecurrenceMonthlyDay {
+		resp.Weekday = ""
+	}
+	if date, ok := rule.RuleDate(); ok {
+		resp.Date = date.Format(ruleDateLayout)
 	}
+	if from, ok := rule.ValidFrom(); ok {
+		resp.ValidFrom = from.Format(ruleDateLayout)
+	}
+	if until, ok := rule.ValidUntil(); ok {
+		resp.ValidUntil = until.Format(ruleDateLayout)
+	}
+	return resp
 }
 
 func scheduleRulesToDTO(rules []schedulemodel.AgendaRuleInterface) []dto.ScheduleRuleResponse {
@@ -161,6 +231,8 @@ func scheduleRulesToDTO(rules []schedulemodel.AgendaRuleInterface) []dto.Schedul
 	return responses
 }
 
+const ruleDateLayout = "2006-01-02"
+
 func formatMinutesAsTime(minutes uint16) string {
 	hour := int(minutes) / 60
 	minute := int(minutes) % 60
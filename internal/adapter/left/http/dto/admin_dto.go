@@ -31,6 +31,34 @@ type AdminPendingRealtor struct {
 	CreciState    string `json:"creciState"`
 }
 
+// AdminGetExpiringRolesRequest captures filters for GET /admin/users/roles/expiring
+type AdminGetExpiringRolesRequest struct {
+	WithinDays int `form:"withinDays,default=7" binding:"min=1,max=90"`
+	Page       int `form:"page,default=1" binding:"min=1"`
+	Limit      int `form:"limit,default=20" binding:"min=1,max=100"`
+}
+
+// AdminGetExpiringRolesResponse represents GET /admin/users/roles/expiring response
+type AdminGetExpiringRolesResponse struct {
+	Grants     []AdminExpiringRole `json:"grants"`
+	Pagination PaginationResponse  `json:"pagination"`
+}
+
+// AdminExpiringRole describes a time-bound role grant close to expiration
+type AdminExpiringRole struct {
+	UserRoleID       int64   `json:"userRoleId"`
+	UserID           int64   `json:"userId"`
+	FullName         string  `json:"fullName"`
+	Email            string  `json:"email"`
+	RoleID           int64   `json:"roleId"`
+	RoleSlug         string  `json:"roleSlug"`
+	RoleName         string  `json:"roleName"`
+	GrantState       string  `json:"grantState" example:"EFFECTIVE"`
+	ValidFrom        *string `json:"validFrom,omitempty"`
+	ValidUntil       string  `json:"validUntil"`
+	ExpiryNotifiedAt *string `json:"expiryNotifiedAt,omitempty"`
+}
+
 // AdminGetUserRequest represents POST /admin/users/detail request
 type AdminGetUserRequest struct {
 	ID int64 `json:"id" binding:"required,min=1"`
@@ -144,6 +172,8 @@ type AdminCreateSystemUserRequest struct {
 	BornAt      string `json:"bornAt" binding:"required"`
 	ZipCode     string `json:"zipCode,omitempty" example:"06543001" description:"Optional zip code without separators (8 digits). When provided, address number must also be present."`
 	Number      string `json:"number,omitempty" example:"123" description:"Optional address number. Required when zipCode is provided."`
+	ValidFrom   string `json:"validFrom,omitempty" example:"2025-07-01T08:00:00-03:00" description:"Optional RFC3339 start of the role grant. Future dates schedule the grant."`
+	ValidUntil  string `json:"validUntil,omitempty" example:"2025-09-30T23:59:59-03:00" description:"Optional RFC3339 end of the role grant. Must be in the future and after validFrom."`
 }
 
 // AdminUpdateSystemUserRequest represents PUT /admin/users/system request body
@@ -152,6 +182,8 @@ type AdminUpdateSystemUserRequest struct {
 	FullName    string `json:"fullName" binding:"required,min=2,max=150"`
 	Email       string `json:"email" binding:"required,email"`
 	PhoneNumber string `json:"phoneNumber" binding:"required"`
+	ValidFrom   string `json:"validFrom,omitempty" example:"2025-07-01T08:00:00-03:00" description:"Optional RFC3339 new start of the role grant. Future dates schedule the grant; omitted keeps the current start."`
+	ValidUntil  string `json:"validUntil,omitempty" example:"2025-12-31T23:59:59-03:00" description:"Optional RFC3339 new end of the role grant, to extend or shorten it. Must be in the future and after validFrom; omitted keeps the current end."`
 }
 
 // AdminSystemUserResponse basic response for create/update actions
@@ -0,0 +1,87 @@
+package dto
+
+// AdminMediaJobsListRequest captures filters for GET /admin/media/jobs.
+// status and provider accept comma separated values.
+type AdminMediaJobsListRequest struct {
+	Status            string `form:"status" example:"FAILED,PARTIAL_SUCCESS"`
+	Provider          string `form:"provider" example:"STEP_FUNCTIONS"`
+	ListingIdentityID uint64 `form:"listingIdentityId" example:"1024"`
+	OlderThanMinutes  int    `form:"olderThanMinutes" binding:"omitempty,min=0" example:"30"`
+	Page              int    `form:"page,default=1" binding:"omitempty,min=1"`
+	Limit             int    `form:"limit,default=20" binding:"omitempty,min=1,max=100"`
+}
+
+// AdminMediaJobResponse is a media processing job as shown on the dashboard.
+// Each job is the batch of assets sent to the pipeline by one process/retry call.
+type AdminMediaJobResponse struct {
+	ID                uint64  `json:"id" example:"512"`
+	ListingIdentityID uint64  `json:"listingIdentityId" example:"1024"`
+	Status            string  `json:"status" enums:"PENDING,RUNNING,SUCCEEDED,PARTIAL_SUCCESS,FAILED,CANCELLED" example:"PARTIAL_SUCCESS"`
+	Provider          string  `json:"provider" enums:"STEP_FUNCTIONS,LOCAL,STEP_FUNCTIONS_FINALIZATION,MEDIACONVERT" example:"STEP_FUNCTIONS"`
+	ExecutionARN      string  `json:"executionArn,omitempty"`
+	RetryCount        uint16  `json:"retryCount" example:"0"`
+	CreatedAt         string  `json:"createdAt" example:"2026-10-19T12:00:00Z"`
+	StartedAt         *string `json:"startedAt,omitempty"`
+	CompletedAt       *string `json:"completedAt,omitempty"`
+	LastError         string  `json:"lastError,omitempty"`
+	TotalAssets       int     `json:"totalAssets" example:"12"`
+	FailedAssets      int     `json:"failedAssets" example:"2"`
+}
+
+// AdminMediaJobsListResponse bundles jobs and pagination metadata.
+type AdminMediaJobsListResponse struct {
+	Jobs       []AdminMediaJobResponse `json:"jobs"`
+	Pagination PaginationResponse      `json:"pagination"`
+}
+
+// AdminMediaJobDetailRequest identifies a job for POST /admin/media/jobs/detail.
+type AdminMediaJobDetailRequest struct {
+	JobID uint64 `json:"jobId" binding:"required" example:"512"`
+}
+
+// AdminMediaJobAssetResponse is the outcome of one asset reported by the job callback.
+// assetId is omitted when the asset was deleted since; status is the current asset status.
+type AdminMediaJobAssetResponse struct {
+	AssetID      uint64 `json:"assetId,omitempty" example:"2048"`
+	AssetType    string `json:"assetType,omitempty" example:"PHOTO_HORIZONTAL"`
+	Sequence     uint8  `json:"sequence,omitempty" example:"3"`
+	RawKey       string `json:"rawKey"`
+	Status       string `json:"status,omitempty" example:"FAILED"`
+	Failed       bool   `json:"failed" example:"true"`
+	ErrorCode    string `json:"errorCode,omitempty" example:"IMAGE_DECODE_FAILED"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+// AdminMediaJobDetailResponse returns a job with its per-asset outcome.
+type AdminMediaJobDetailResponse struct {
+	Job    AdminMediaJobResponse        `json:"job"`
+	Assets []AdminMediaJobAssetResponse `json:"assets"`
+}
+
+// AdminMediaJobRetryRequest retries the failed assets of a job, or only assetId when informed.
+type AdminMediaJobRetryRequest struct {
+	JobID   uint64 `json:"jobId" binding:"required" example:"512"`
+	AssetID uint64 `json:"assetId,omitempty" example:"2048"`
+}
+
+// AdminMediaJobRetryResponse identifies the job created by the retry.
+type AdminMediaJobRetryResponse struct {
+	JobID    uint64   `json:"jobId" example:"513"`
+	AssetIDs []uint64 `json:"assetIds"`
+}
+
+// AdminMediaJobCancelRequest cancels an unfinished job.
+type AdminMediaJobCancelRequest struct {
+	JobID  uint64 `json:"jobId" binding:"required" example:"512"`
+	Reason string `json:"reason,omitempty" binding:"omitempty,max=500" example:"Pipeline travado em vídeo corrompido"`
+}
+
+// AdminMediaJobForceCompleteRequest force-completes a PARTIAL_SUCCESS job.
+type AdminMediaJobForceCompleteRequest struct {
+	JobID uint64 `json:"jobId" binding:"required" example:"512"`
+}
+
+// AdminMediaJobForceCompleteResponse lists the failed assets marked DISCARDED.
+type AdminMediaJobForceCompleteResponse struct {
+	DiscardedAssetIDs []uint64 `json:"discardedAssetIds"`
+}
@@ -0,0 +1,86 @@
+package dto
+
+// AdminRescheduleReportRequest captures filters for GET /admin/photo-sessions/reschedules.
+// from/to filter by the moment the reschedule was requested (RFC3339).
+type AdminRescheduleReportRequest struct {
+	GroupBy string `form:"groupBy" binding:"omitempty,oneof=LISTING PHOTOGRAPHER listing photographer" example:"PHOTOGRAPHER"`
+	From    string `form:"from" example:"2026-10-01T00:00:00Z"`
+	To      string `form:"to" example:"2026-11-01T00:00:00Z"`
+	Page    int    `form:"page,default=1" binding:"omitempty,min=1"`
+	Limit   int    `form:"limit,default=20" binding:"omitempty,min=1,max=100"`
+}
+
+// AdminRescheduleCountResponse aggregates reschedules of one listing identity or photographer.
+// subjectId is the listingIdentityId or the photographer user id (the one who held the original slot).
+type AdminRescheduleCountResponse struct {
+	SubjectID           uint64  `json:"subjectId" example:"1024"`
+	Total               int64   `json:"total" example:"3"`
+	PhotographerChanged int64   `json:"photographerChanged" example:"1"`
+	AvgNoticeHours      float64 `json:"avgNoticeHours" example:"52.5"`
+	MinNoticeHours      float64 `json:"minNoticeHours" example:"26"`
+	LastRescheduledAt   string  `json:"lastRescheduledAt" example:"2026-10-18T14:03:00Z"`
+}
+
+// AdminRescheduleReportResponse bundles the aggregated rows and pagination metadata.
+type AdminRescheduleReportResponse struct {
+	GroupBy    string                         `json:"groupBy" enums:"LISTING,PHOTOGRAPHER" example:"PHOTOGRAPHER"`
+	Items      []AdminRescheduleCountResponse `json:"items"`
+	Pagination PaginationResponse             `json:"pagination"`
+}
+
+// AdminClosePayoutPeriodRequest identifies the past month (YYYY-MM) to settle.
+type AdminClosePayoutPeriodRequest struct {
+	Period string `json:"period" binding:"required" example:"2026-09"`
+}
+
+// AdminPayoutRuleResponse describes a payout pricing rule; absent criteria match any session.
+type AdminPayoutRuleResponse struct {
+	ID                  uint64   `json:"id" example:"4"`
+	PropertyType        *uint16  `json:"propertyType,omitempty" example:"16"`
+	SessionType         string   `json:"sessionType,omitempty" enums:"STANDARD,PANORAMA" example:"PANORAMA"`
+	MinSizeM2           *float64 `json:"minSizeM2,omitempty" example:"300"`
+	MaxSizeM2           *float64 `json:"maxSizeM2,omitempty"`
+	BaseAmountCents     int64    `json:"baseAmountCents" example:"30000"`
+	WeekendSurchargePct float64  `json:"weekendSurchargePct" example:"20"`
+	HolidaySurchargePct float64  `json:"holidaySurchargePct" example:"50"`
+	Active              bool     `json:"active" example:"true"`
+}
+
+// AdminPayoutRulesResponse lists every payout rule ordered by id.
+type AdminPayoutRulesResponse struct {
+	Rules []AdminPayoutRuleResponse `json:"rules"`
+}
+
+// AdminPhotographerKPIRequest captures filters for GET /admin/photo-sessions/photographer-kpis.
+// from/to filter by the session start (RFC3339).
+type AdminPhotographerKPIRequest struct {
+	PhotographerID uint64 `form:"photographerId" example:"77"`
+	From           string `form:"from" example:"2026-07-01T00:00:00Z"`
+	To             string `form:"to" example:"2026-10-01T00:00:00Z"`
+	Page           int    `form:"page,default=1" binding:"omitempty,min=1"`
+	Limit          int    `form:"limit,default=20" binding:"omitempty,min=1,max=100"`
+}
+
+// AdminPhotographerKPIResponse aggregates the delivery KPIs of one photographer.
+// Rates and averages are omitted when there is no data to compute them.
+type AdminPhotographerKPIResponse struct {
+	PhotographerID    uint64   `json:"photographerId" example:"77"`
+	Sessions          int64    `json:"sessions" example:"18"`
+	ArrivalsConfirmed int64    `json:"arrivalsConfirmed" example:"16"`
+	ArrivalsOnTime    int64    `json:"arrivalsOnTime" example:"15"`
+	OnTimeRate        *float64 `json:"onTimeRate,omitempty" example:"0.94"`
+	Uploads           int64    `json:"uploads" example:"17"`
+	AvgUploadHours    *float64 `json:"avgUploadHours,omitempty" example:"20.5"`
+	OwnerApprovals    int64    `json:"ownerApprovals" example:"16"`
+	OwnerRejections   int64    `json:"ownerRejections" example:"2"`
+	RejectionRate     *float64 `json:"rejectionRate,omitempty" example:"0.11"`
+	Ratings           int64    `json:"ratings" example:"9"`
+	AvgRating         *float64 `json:"avgRating,omitempty" example:"4.7"`
+	Score             float64  `json:"score" example:"0.82"`
+}
+
+// AdminPhotographerKPIReportResponse bundles the per-photographer KPIs and pagination metadata.
+type AdminPhotographerKPIReportResponse struct {
+	Items      []AdminPhotographerKPIResponse `json:"items"`
+	Pagination PaginationResponse             `json:"pagination"`
+}
@@ -439,6 +439,7 @@ type ListingDetailResponse struct {
 	TenantPhone                string                            `json:"tenantPhone"`
 	Accompanying               *CatalogItemResponse              `json:"accompanying,omitempty"`
 	PhotoSessionID             *uint64                           `json:"photoSessionId,omitempty"`
+	Covers                     []MediaGalleryCoverResponse       `json:"covers,omitempty"`
 	Deleted                    bool                              `json:"deleted"`
 	PerformanceMetrics         ListingPerformanceMetricsResponse `json:"performanceMetrics"`
 	FavoritesCount             int64                             `json:"favoritesCount"`
@@ -900,6 +901,8 @@ type ListingResponse struct {
 	ComplexID         string                       `json:"complexId,omitempty"`
 	FavoritesCount    int64                        `json:"favoritesCount"`
 	IsFavorite        bool                         `json:"isFavorite"`
+	// Covers traz as capas da galeria (uma por orientação de foto).
+	Covers []MediaGalleryCoverResponse `json:"covers,omitempty"`
 }
 
 // AddListingPhotosRequest represents request for adding photos to a listing
@@ -1034,13 +1037,14 @@ type ListingCatalogRestoreRequest struct {
 
 // ListPhotographerSlotsRequest define filtros e paginação para consulta de slots.
 // Period filter was removed; slots follow the configured business window and duration.
+// Sort "rank" orders each day by photographer KPI score and is only accepted with manual photographer assignment.
 type ListPhotographerSlotsRequest struct {
 	From              string `form:"from" binding:"omitempty" example:"2025-10-20"`
 	To                string `form:"to" binding:"omitempty" example:"2025-10-31"`
 	DurationMinutes   int    `form:"durationMinutes" binding:"omitempty,min=30,max=240" example:"120"`
 	Page              int    `form:"page,default=1" binding:"min=1"`
 	Size              int    `form:"size,default=20" binding:"min=1,max=100"`
-	Sort              string `form:"sort,default=start_asc" binding:"omitempty,oneof=start_asc start_desc photographer_asc photographer_desc date_asc date_desc"`
+	Sort              string `form:"sort,default=start_asc" binding:"omitempty,oneof=start_asc start_desc photographer_asc photographer_desc date_asc date_desc rank" example:"start_asc" enums:"start_asc,start_desc,photographer_asc,photographer_desc,date_asc,date_desc,rank"`
 	ListingIdentityID int64  `form:"listingIdentityId" binding:"required,min=1" example:"1024"`
 	Timezone          string `form:"timezone" binding:"required" example:"America/Sao_Paulo"`
 }
@@ -1066,6 +1070,8 @@ type ListPhotographerSlotsResponse struct {
 type ReservePhotoSessionRequest struct {
 	ListingIdentityID int64  `json:"listingIdentityId" binding:"required" example:"1024"`
 	SlotID            uint64 `json:"slotId" binding:"required" example:"2002"`
+	// SessionType selects what the photographer delivers; defaults to STANDARD.
+	SessionType string `json:"sessionType,omitempty" binding:"omitempty,oneof=STANDARD PANORAMA" enums:"STANDARD,PANORAMA" example:"STANDARD"`
 }
 
 // PhotographerResponse representa dados básicos do fotógrafo retornados em reservas.
@@ -1083,6 +1089,7 @@ type ReservePhotoSessionResponse struct {
 	SlotEnd        string               `json:"slotEnd" example:"2025-10-24T10:00:00Z"`
 	PhotoSessionID uint64               `json:"photoSessionId" example:"3003"`
 	Photographer   PhotographerResponse `json:"photographer"`
+	AutoAssigned   bool                 `json:"autoAssigned" example:"false"`
 }
 
 // ConfirmPhotoSessionRequest representa o payload para confirmar a sessão de fotos.
@@ -1104,6 +1111,38 @@ type CancelPhotoSessionRequest struct {
 	PhotoSessionID uint64 `json:"photoSessionId" binding:"required" example:"3003"`
 }
 
+// ReschedulePhotoSessionRequest representa o corpo para mover uma sessão de fotos para outro slot.
+type ReschedulePhotoSessionRequest struct {
+	PhotoSessionID uint64  `json:"photoSessionId" binding:"required" example:"3003"`
+	SlotID         uint64  `json:"slotId" binding:"required" example:"2010"`
+	Reason         *string `json:"reason,omitempty" binding:"omitempty,max=255" example:"Proprietário viajando"`
+}
+
+// ReschedulePhotoSessionResponse retorna a sessão após a troca de slot.
+type ReschedulePhotoSessionResponse struct {
+	PhotoSessionID  uint64               `json:"photoSessionId" example:"3003"`
+	SlotID          uint64               `json:"slotId" example:"2010"`
+	SlotStart       string               `json:"slotStart" example:"2025-10-27T09:00:00Z"`
+	SlotEnd         string               `json:"slotEnd" example:"2025-10-27T11:00:00Z"`
+	Status          string               `json:"status" example:"ACCEPTED"`
+	RescheduleCount int64                `json:"rescheduleCount" example:"1"`
+	Photographer    PhotographerResponse `json:"photographer"`
+}
+
+// RatePhotoSessionRequest representa a avaliação do proprietário para o fotógrafo da última sessão concluída.
+type RatePhotoSessionRequest struct {
+	ListingIdentityID int64   `json:"listingIdentityId" binding:"required" example:"1024"`
+	Rating            int     `json:"rating" binding:"required,min=1,max=5" example:"5"`
+	Comment           *string `json:"comment,omitempty" binding:"omitempty,max=500" example:"Fotos excelentes e entrega rápida"`
+}
+
+// RatePhotoSessionResponse identifica a sessão e o fotógrafo avaliados.
+type RatePhotoSessionResponse struct {
+	PhotoSessionID uint64 `json:"photoSessionId" example:"3003"`
+	PhotographerID uint64 `json:"photographerId" example:"77"`
+	Rating         int    `json:"rating" example:"5"`
+}
+
 // ====================================================================================================
 // Media Processing DTOs
 // ====================================================================================================
@@ -1130,7 +1169,7 @@ type RequestUploadURLsRequest struct {
 type RequestUploadFileRequest struct {
 	// AssetType categorizes the media for processing and display
 	// Allowed values: PHOTO_VERTICAL, PHOTO_HORIZONTAL, VIDEO_VERTICAL, VIDEO_HORIZONTAL,
-	//                 THUMBNAIL, ZIP, PROJECT_DOC, PROJECT_RENDER
+	//                 THUMBNAIL, ZIP, PROJECT_DOC, PROJECT_RENDER, PANORAMA_360, LAND_PARCEL
 	// Example: "PHOTO_VERTICAL"
 	AssetType string `json:"assetType" binding:"required" example:"PHOTO_VERTICAL"`
 
@@ -1147,6 +1186,7 @@ type RequestUploadFileRequest struct {
 	// ContentType is the MIME type of the file
 	// Must be in the allowed content types list (configured in env.yaml)
 	// Common values: image/jpeg, image/png, image/heic, video/mp4, video/quicktime
+	// LAND_PARCEL only accepts application/vnd.google-earth.kmz or application/vnd.google-earth.kml+xml
 	// Example: "image/jpeg"
 	ContentType string `json:"contentType" binding:"required" example:"image/jpeg"`
 
@@ -1434,7 +1474,7 @@ type RetryMediaBatchResponse struct {
 // ListMediaRequest define filtros e paginação para consulta de mídias.
 type ListMediaRequest struct {
 	ListingIdentityID uint64 `form:"listingIdentityId" binding:"required,min=1"`
-	AssetType         string `form:"assetType,omitempty" binding:"omitempty,oneof=PHOTO_VERTICAL PHOTO_HORIZONTAL VIDEO_VERTICAL VIDEO_HORIZONTAL THUMBNAIL ZIP PROJECT_DOC PROJECT_RENDER"`
+	AssetType         string `form:"assetType,omitempty" binding:"omitempty,oneof=PHOTO_VERTICAL PHOTO_HORIZONTAL VIDEO_VERTICAL VIDEO_HORIZONTAL THUMBNAIL ZIP PROJECT_DOC PROJECT_RENDER PANORAMA_360 LAND_PARCEL"`
 	Sequence          *uint8 `form:"sequence,omitempty"`
 
 	// Paginação e Ordenação
@@ -1449,6 +1489,82 @@ type ListMediaResponse struct {
 	Data       []MediaAssetResponse    `json:"data"`
 	Pagination PaginationResponse      `json:"pagination"`
 	ZipBundle  *MediaZipBundleResponse `json:"zipBundle,omitempty"`
+	// GalleryRevision identifica o arranjo atual da galeria e deve ser enviado em /media/gallery.
+	GalleryRevision string `json:"galleryRevision" example:"9f1c2a7b4d3e8f60"`
+	// Tour é o tour virtual que liga os panoramas 360° do imóvel; ausente quando não configurado.
+	Tour *MediaTourResponse `json:"tour,omitempty"`
+	// Parcel é o polígono do terreno lido do documento LAND_PARCEL (KMZ/KML).
+	Parcel *MediaParcelResponse `json:"parcel,omitempty"`
+}
+
+// MediaParcelResponse traz o polígono do terreno, as medidas calculadas e as divergências com o anúncio.
+type MediaParcelResponse struct {
+	AssetID     uint64                        `json:"assetId" example:"1201"`
+	AreaM2      float64                       `json:"areaM2" example:"412.37"`
+	PerimeterM  float64                       `json:"perimeterM" example:"84.9"`
+	Centroid    MediaParcelCoordinateResponse `json:"centroid"`
+	Polygon     MediaParcelPolygonResponse    `json:"polygon"`
+	Divergences []MediaParcelDivergence       `json:"divergences"`
+	UpdatedAt   string                        `json:"updatedAt,omitempty" example:"2025-01-01T12:00:00Z"`
+}
+
+// MediaParcelPolygonResponse descreve o contorno (anel aberto, sem repetir o primeiro vértice) e os recortes internos.
+type MediaParcelPolygonResponse struct {
+	Outer []MediaParcelCoordinateResponse   `json:"outer"`
+	Holes [][]MediaParcelCoordinateResponse `json:"holes,omitempty"`
+}
+
+// MediaParcelCoordinateResponse é um vértice WGS84 em graus decimais.
+type MediaParcelCoordinateResponse struct {
+	Lat float64 `json:"lat" example:"-23.5505"`
+	Lng float64 `json:"lng" example:"-46.6333"`
+}
+
+// MediaParcelDivergence sinaliza uma medida declarada que difere do polígono acima da tolerância.
+type MediaParcelDivergence struct {
+	// Field é landSize (área) ou perimeter (landFront + landBack + 2 x landSide).
+	Field      string  `json:"field" example:"landSize"`
+	Declared   float64 `json:"declared" example:"500"`
+	Computed   float64 `json:"computed" example:"412.37"`
+	Difference float64 `json:"difference" example:"0.212"`
+}
+
+// ArrangeMediaGalleryRequest reordena a galeria, define as capas e as tags de cômodo em uma chamada.
+type ArrangeMediaGalleryRequest struct {
+	ListingIdentityID uint64                           `json:"listingIdentityId" binding:"required,min=1" example:"42"`
+	Revision          string                           `json:"revision" binding:"required" example:"9f1c2a7b4d3e8f60"`
+	Items             []ArrangeMediaGalleryItemRequest `json:"items" binding:"required,min=1,dive"`
+}
+
+// ArrangeMediaGalleryItemRequest posiciona um asset; a ordem dos itens de cada tipo define a sequência.
+type ArrangeMediaGalleryItemRequest struct {
+	AssetID       uint64 `json:"assetId" binding:"required,min=1" example:"1001"`
+	Cover         bool   `json:"cover,omitempty" example:"true"`
+	RoomTag       string `json:"roomTag,omitempty" binding:"omitempty,oneof=FEATURE FACADE COMMON_AREA" example:"FEATURE"`
+	RoomFeatureID int64  `json:"roomFeatureId,omitempty" example:"4"`
+}
+
+// ArrangeMediaGalleryResponse devolve a nova revisão da galeria.
+type ArrangeMediaGalleryResponse struct {
+	ListingIdentityID uint64 `json:"listingIdentityId" example:"42"`
+	Revision          string `json:"revision" example:"0b7d55e1c9a4f312"`
+}
+
+// MediaRoomTagResponse identifica o cômodo mostrado em uma foto.
+type MediaRoomTagResponse struct {
+	Tag       string `json:"tag" example:"FEATURE"`
+	FeatureID int64  `json:"featureId,omitempty" example:"4"`
+	Label     string `json:"label,omitempty" example:"Cozinha"`
+}
+
+// MediaGalleryCoverResponse é a capa de uma orientação, com URLs assinadas.
+type MediaGalleryCoverResponse struct {
+	AssetID   uint64                `json:"assetId" example:"1001"`
+	AssetType string                `json:"assetType" example:"PHOTO_HORIZONTAL"`
+	Title     string                `json:"title,omitempty"`
+	URL       string                `json:"url,omitempty"`
+	RoomTag   *MediaRoomTagResponse `json:"roomTag,omitempty"`
+	SrcSets   []MediaSrcSetResponse `json:"srcSets,omitempty"`
 }
 
 // ListingMediaApprovalRequest represents owner approval/rejection payload.
@@ -1475,6 +1591,119 @@ type MediaAssetResponse struct {
 	Metadata          map[string]string `json:"metadata,omitempty"`
 	S3KeyRaw          string            `json:"s3KeyRaw,omitempty"`
 	S3KeyProcessed    string            `json:"s3KeyProcessed,omitempty"`
+	// SrcSets lists signed responsive variants per format (avif, webp, jpeg), only for processed photos.
+	SrcSets []MediaSrcSetResponse `json:"srcSets,omitempty"`
+	// Quality traz a análise de qualidade das fotos processadas e o resultado do gate.
+	Quality *MediaQualityResponse `json:"quality,omitempty"`
+	// Cover indica a capa da orientação; RoomTag o cômodo definido em /media/gallery.
+	Cover   bool                  `json:"cover,omitempty"`
+	RoomTag *MediaRoomTagResponse `json:"roomTag,omitempty"`
+	// Panorama descreve a pirâmide de tiles dos panoramas 360° processados.
+	Panorama *MediaPanoramaResponse `json:"panorama,omitempty"`
+}
+
+// MediaPanoramaResponse traz o layout dos tiles e o template de URL tokenizado do panorama.
+type MediaPanoramaResponse struct {
+	Width    int                          `json:"width" example:"8192"`
+	Height   int                          `json:"height" example:"4096"`
+	TileSize int                          `json:"tileSize" example:"512"`
+	Levels   []MediaPanoramaLevelResponse `json:"levels"`
+	// TileURLTemplate contém os placeholders {level}, {row} e {col}.
+	TileURLTemplate  string `json:"tileUrlTemplate" example:"/api/v2/listings/media/panorama/abc.def/{level}/{row}_{col}.jpg"`
+	TileURLExpiresAt string `json:"tileUrlExpiresAt" example:"2025-01-01T12:00:00Z"`
+	// PreviewURL é a versão plana equiretangular, para visualizadores sem suporte a tiles.
+	PreviewURL string `json:"previewUrl,omitempty"`
+}
+
+// MediaPanoramaLevelResponse é um nível de resolução da pirâmide (índice = posição na lista).
+type MediaPanoramaLevelResponse struct {
+	Width int `json:"width" example:"4096"`
+	Cols  int `json:"cols" example:"8"`
+	Rows  int `json:"rows" example:"4"`
+}
+
+// MediaTourResponse é o tour virtual salvo em /media/tour.
+type MediaTourResponse struct {
+	StartAssetID uint64                  `json:"startAssetId" example:"1001"`
+	Nodes        []MediaTourNodeResponse `json:"nodes"`
+	UpdatedAt    string                  `json:"updatedAt,omitempty" example:"2025-01-01T12:00:00Z"`
+}
+
+// MediaTourNodeResponse é um panorama do tour com a visão inicial e seus hotspots.
+type MediaTourNodeResponse struct {
+	AssetID      uint64                     `json:"assetId" example:"1001"`
+	Label        string                     `json:"label,omitempty" example:"Sala"`
+	InitialYaw   float64                    `json:"initialYaw" example:"90"`
+	InitialPitch float64                    `json:"initialPitch" example:"0"`
+	Hotspots     []MediaTourHotspotResponse `json:"hotspots,omitempty"`
+}
+
+// MediaTourHotspotResponse leva o visitante ao panorama TargetAssetID.
+type MediaTourHotspotResponse struct {
+	TargetAssetID uint64  `json:"targetAssetId" example:"1002"`
+	Yaw           float64 `json:"yaw" example:"-45"`
+	Pitch         float64 `json:"pitch" example:"-10"`
+	Label         string  `json:"label,omitempty" example:"Cozinha"`
+}
+
+// SaveMediaTourRequest substitui o tour virtual do imóvel; nodes vazio remove o tour.
+type SaveMediaTourRequest struct {
+	ListingIdentityID uint64                     `json:"listingIdentityId" binding:"required,min=1" example:"42"`
+	StartAssetID      uint64                     `json:"startAssetId,omitempty" example:"1001"`
+	Nodes             []SaveMediaTourNodeRequest `json:"nodes" binding:"omitempty,max=100,dive"`
+}
+
+// SaveMediaTourNodeRequest posiciona um panorama no tour. Ângulos em graus: yaw em [-180,180], pitch em [-90,90].
+type SaveMediaTourNodeRequest struct {
+	AssetID      uint64                        `json:"assetId" binding:"required,min=1" example:"1001"`
+	Label        string                        `json:"label,omitempty" example:"Sala"`
+	InitialYaw   float64                       `json:"initialYaw" example:"90"`
+	InitialPitch float64                       `json:"initialPitch" example:"0"`
+	Hotspots     []SaveMediaTourHotspotRequest `json:"hotspots,omitempty" binding:"omitempty,max=50,dive"`
+}
+
+// SaveMediaTourHotspotRequest liga o panorama atual a outro nó do tour.
+type SaveMediaTourHotspotRequest struct {
+	TargetAssetID uint64  `json:"targetAssetId" binding:"required,min=1" example:"1002"`
+	Yaw           float64 `json:"yaw" example:"-45"`
+	Pitch         float64 `json:"pitch" example:"-10"`
+	Label         string  `json:"label,omitempty" example:"Cozinha"`
+}
+
+// SaveMediaTourResponse devolve o tour salvo; tour ausente indica que foi removido.
+type SaveMediaTourResponse struct {
+	ListingIdentityID uint64             `json:"listingIdentityId" example:"42"`
+	Tour              *MediaTourResponse `json:"tour,omitempty"`
+}
+
+// MediaQualityResponse expõe as métricas de qualidade de uma foto e o resultado do gate.
+type MediaQualityResponse struct {
+	Status            string   `json:"status,omitempty" example:"FLAGGED"`
+	Issues            []string `json:"issues,omitempty" example:"blurry,duplicate"`
+	DuplicateOf       uint64   `json:"duplicateOf,omitempty" example:"42"`
+	Width             int      `json:"width" example:"4032"`
+	Height            int      `json:"height" example:"3024"`
+	BlurScore         float64  `json:"blurScore" example:"143.2"`
+	Brightness        float64  `json:"brightness" example:"0.47"`
+	ShadowsClipped    float64  `json:"shadowsClipped" example:"0.01"`
+	HighlightsClipped float64  `json:"highlightsClipped" example:"0.02"`
+	ExposureScore     float64  `json:"exposureScore" example:"0.91"`
+	PHash             string   `json:"phash" example:"c3a1f0e07c3e1f0f"`
+}
+
+// MediaSrcSetResponse agrupa as variantes de uma foto em um formato, pronta para <source srcset>.
+type MediaSrcSetResponse struct {
+	Format  string               `json:"format" example:"webp"`
+	Type    string               `json:"type" example:"image/webp"`
+	SrcSet  string               `json:"srcset" example:"https://.../small.webp 400w, https://.../medium.webp 800w"`
+	Sources []MediaSrcSetVariant `json:"sources"`
+}
+
+// MediaSrcSetVariant descreve uma largura assinada de um srcset.
+type MediaSrcSetVariant struct {
+	Resolution string `json:"resolution" example:"medium"`
+	Width      int    `json:"width" example:"800"`
+	URL        string `json:"url"`
 }
 
 // MediaZipBundleResponse expõe os metadados do bundle zipado.
@@ -1483,6 +1712,7 @@ type MediaZipBundleResponse struct {
 	AssetsCount             int    `json:"assetsCount"`
 	ZipSizeBytes            int64  `json:"zipSizeBytes"`
 	EstimatedExtractedBytes int64  `json:"estimatedExtractedBytes"`
+	SHA256                  string `json:"sha256,omitempty"`
 	CompletedAt             string `json:"completedAt,omitempty"`
 }
 
@@ -1496,10 +1726,26 @@ type GenerateDownloadURLsRequest struct {
 
 // DownloadRequestItem combina a chave do asset com a resolução desejada.
 type DownloadRequestItem struct {
-	AssetType string `json:"assetType" binding:"required,oneof=PHOTO_VERTICAL PHOTO_HORIZONTAL VIDEO_VERTICAL VIDEO_HORIZONTAL THUMBNAIL ZIP PROJECT_DOC PROJECT_RENDER" enums:"PHOTO_VERTICAL,PHOTO_HORIZONTAL,VIDEO_VERTICAL,VIDEO_HORIZONTAL,THUMBNAIL,ZIP,PROJECT_DOC,PROJECT_RENDER" example:"PHOTO_VERTICAL"`
+	AssetType string `json:"assetType" binding:"required,oneof=PHOTO_VERTICAL PHOTO_HORIZONTAL VIDEO_VERTICAL VIDEO_HORIZONTAL THUMBNAIL ZIP PROJECT_DOC PROJECT_RENDER PANORAMA_360 LAND_PARCEL" enums:"PHOTO_VERTICAL,PHOTO_HORIZONTAL,VIDEO_VERTICAL,VIDEO_HORIZONTAL,THUMBNAIL,ZIP,PROJECT_DOC,PROJECT_RENDER,PANORAMA_360,LAND_PARCEL" example:"PHOTO_VERTICAL"`
 	Sequence  uint8  `json:"sequence" binding:"required" example:"1"`
-	// Resolution options: thumbnail, small, medium, large, original, zip (zip is only valid when assetType=ZIP and ignores sequence)
-	Resolution string `json:"resolution" binding:"required,oneof=thumbnail small medium large original zip" enums:"thumbnail,small,medium,large,original,zip" example:"medium"`
+	// Resolution options: thumbnail, small, medium, large, original, zip (zip is only valid when assetType=ZIP and ignores sequence),
+	// hls (signed HLS master playlist) and poster (HLS poster frame) for processed videos
+	Resolution string `json:"resolution" binding:"required,oneof=thumbnail small medium large original zip hls poster" enums:"thumbnail,small,medium,large,original,zip,hls,poster" example:"medium"`
+	// Format selects an alternative photo encoding; falls back to jpeg when the variant was not generated
+	Format string `json:"format,omitempty" binding:"omitempty,oneof=jpeg webp avif" enums:"jpeg,webp,avif" example:"webp"`
+}
+
+// HLSPlaylistRequest identifica a playlist HLS pedida no endpoint público assinado.
+type HLSPlaylistRequest struct {
+	Token    string `uri:"token" binding:"required"`
+	Playlist string `uri:"playlist" binding:"required,endswith=.m3u8" example:"master.m3u8"`
+}
+
+// PanoramaTileRequest identifica um tile pedido no endpoint público assinado de panoramas.
+type PanoramaTileRequest struct {
+	Token string `uri:"token" binding:"required"`
+	Level int    `uri:"level" binding:"min=0" example:"1"`
+	Tile  string `uri:"tile" binding:"required,endswith=.jpg" example:"2_5.jpg"`
 }
 
 // GenerateDownloadURLsResponse retorna as URLs geradas.
@@ -1512,6 +1758,7 @@ type DownloadURLResponse struct {
 	AssetType  string `json:"assetType"`
 	Sequence   uint8  `json:"sequence"`
 	Resolution string `json:"resolution"`
+	Format     string `json:"format,omitempty"`
 	Url        string `json:"url"`
 	ExpiresIn  int    `json:"expiresIn"`
 }
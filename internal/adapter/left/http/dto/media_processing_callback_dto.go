@@ -25,6 +25,8 @@ type MediaProcessingCallbackRequest struct {
 	ZipBundles        []string                                         `json:"zipBundles,omitempty"`
 	ZipSizeBytes      int64                                            `json:"zipSizeBytes,omitempty"`
 	UnzippedSizeBytes int64                                            `json:"unzippedSizeBytes,omitempty"`
+	ZipManifestKey    string                                           `json:"zipManifestKey,omitempty"`
+	ZipSHA256         string                                           `json:"zipSha256,omitempty"`
 	FailureReason     string                                           `json:"failureReason"`
 	Error             *MediaProcessingCallbackError                    `json:"error"`
 	RawBody           []byte                                           `json:"-"`
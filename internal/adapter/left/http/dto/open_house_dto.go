@@ -0,0 +1,119 @@
+package dto
+
+// CreateOpenHouseRequest represents the payload to open a group visit window on a listing agenda.
+// SlotMinutes is optional: zero keeps a single slot spanning the whole window.
+type CreateOpenHouseRequest struct {
+	ListingIdentityID int64  `json:"listingIdentityId" binding:"required,gt=0" example:"123"`
+	StartsAt          string `json:"startsAt" binding:"required" example:"2025-01-11T10:00:00Z"`
+	EndsAt            string `json:"endsAt" binding:"required" example:"2025-01-11T13:00:00Z"`
+	Capacity          uint16 `json:"capacity" binding:"required,gt=0" example:"10"`
+	SlotMinutes       uint16 `json:"slotMinutes,omitempty" example:"30"`
+	Notes             string `json:"notes,omitempty" binding:"max=500" example:"Estacionamento disponível na rua lateral"`
+}
+
+// CancelOpenHouseRequest cancels a scheduled open house.
+type CancelOpenHouseRequest struct {
+	OpenHouseID int64  `json:"openHouseId" binding:"required,gt=0" example:"15"`
+	Reason      string `json:"reason" binding:"required,max=255" example:"Imóvel em manutenção"`
+}
+
+// GetOpenHouseDetailRequest carries the identifier to fetch a single open house.
+type GetOpenHouseDetailRequest struct {
+	OpenHouseID int64 `json:"openHouseId" binding:"required,gt=0" example:"15"`
+}
+
+// OpenHouseListQuery captures query parameters for open house listings (RFC3339 range, pagination capped at 50).
+type OpenHouseListQuery struct {
+	ListingIdentityID int64    `form:"listingIdentityId"`
+	Statuses          []string `form:"status"`
+	From              string   `form:"from"`
+	To                string   `form:"to"`
+	Page              int      `form:"page,default=1"`
+	Limit             int      `form:"limit,default=20"`
+}
+
+// RegisterOpenHouseClientRequest registers a realtor's client into an open house.
+// SlotStart is required when the open house is split into slots.
+type RegisterOpenHouseClientRequest struct {
+	OpenHouseID int64  `json:"openHouseId" binding:"required,gt=0" example:"15"`
+	ClientName  string `json:"clientName" binding:"required,max=120" example:"Ana Lima"`
+	ClientPhone string `json:"clientPhone,omitempty" binding:"max=25" example:"+5511999999999"`
+	SlotStart   string `json:"slotStart,omitempty" example:"2025-01-11T10:30:00Z"`
+}
+
+// OpenHouseRegistrationActionRequest targets a single registration.
+type OpenHouseRegistrationActionRequest struct {
+	RegistrationID int64 `json:"registrationId" binding:"required,gt=0" example:"88"`
+}
+
+// CheckInOpenHouseRegistrationRequest records attendance of a registered client.
+type CheckInOpenHouseRegistrationRequest struct {
+	RegistrationID int64 `json:"registrationId" binding:"required,gt=0" example:"88"`
+	Attended       *bool `json:"attended" binding:"required" example:"true"`
+}
+
+// NotifyOpenHouseRequest broadcasts an owner message to the realtors registered in an open house.
+type NotifyOpenHouseRequest struct {
+	OpenHouseID     int64  `json:"openHouseId" binding:"required,gt=0" example:"15"`
+	Message         string `json:"message" binding:"required,max=500" example:"Portão de entrada pela rua lateral."`
+	IncludeWaitlist bool   `json:"includeWaitlist,omitempty" example:"false"`
+}
+
+// OpenHouseResponse represents an open house with registration counters.
+type OpenHouseResponse struct {
+	ID                 int64  `json:"id" example:"15"`
+	ListingIdentityID  int64  `json:"listingIdentityId" example:"123"`
+	OwnerUserID        int64  `json:"ownerUserId" example:"10"`
+	StartsAt           string `json:"startsAt" example:"2025-01-11T10:00:00Z"`
+	EndsAt             string `json:"endsAt" example:"2025-01-11T13:00:00Z"`
+	Capacity           uint16 `json:"capacity" example:"10"`
+	SlotMinutes        uint16 `json:"slotMinutes" example:"30"`
+	Status             string `json:"status" example:"SCHEDULED"`
+	Notes              string `json:"notes,omitempty"`
+	CancellationReason string `json:"cancellationReason,omitempty"`
+	RegisteredCount    int64  `json:"registeredCount" example:"8"`
+	WaitlistCount      int64  `json:"waitlistCount" example:"2"`
+	CheckedInCount     int64  `json:"checkedInCount" example:"0"`
+}
+
+// OpenHouseListResponse wraps paginated open houses.
+type OpenHouseListResponse struct {
+	Items      []OpenHouseResponse `json:"items"`
+	Pagination PaginationResponse  `json:"pagination"`
+}
+
+// OpenHouseSlotResponse summarizes seat usage of a slot.
+type OpenHouseSlotResponse struct {
+	SlotStart  string `json:"slotStart" example:"2025-01-11T10:00:00Z"`
+	SlotEnd    string `json:"slotEnd" example:"2025-01-11T10:30:00Z"`
+	Registered int    `json:"registered" example:"10"`
+	Waitlisted int    `json:"waitlisted" example:"1"`
+	CheckedIn  int    `json:"checkedIn" example:"0"`
+	Available  int    `json:"available" example:"0"`
+}
+
+// OpenHouseRegistrationResponse represents a client registration.
+type OpenHouseRegistrationResponse struct {
+	ID               int64   `json:"id" example:"88"`
+	OpenHouseID      int64   `json:"openHouseId" example:"15"`
+	RealtorUserID    int64   `json:"realtorUserId" example:"5"`
+	ClientName       string  `json:"clientName" example:"Ana Lima"`
+	ClientPhone      string  `json:"clientPhone,omitempty" example:"+5511999999999"`
+	SlotStart        string  `json:"slotStart" example:"2025-01-11T10:30:00Z"`
+	Status           string  `json:"status" example:"REGISTERED"`
+	WaitlistPosition int     `json:"waitlistPosition,omitempty" example:"0"`
+	CheckedInAt      *string `json:"checkedInAt,omitempty" example:"2025-01-11T10:34:00Z"`
+	CreatedAt        string  `json:"createdAt" example:"2025-01-05T12:00:00Z"`
+}
+
+// OpenHouseDetailResponse aggregates the open house, slot occupation and visible registrations.
+type OpenHouseDetailResponse struct {
+	OpenHouse     OpenHouseResponse               `json:"openHouse"`
+	Slots         []OpenHouseSlotResponse         `json:"slots"`
+	Registrations []OpenHouseRegistrationResponse `json:"registrations"`
+}
+
+// NotifyOpenHouseResponse reports how many realtors were notified.
+type NotifyOpenHouseResponse struct {
+	Recipients int `json:"recipients" example:"4"`
+}
@@ -69,6 +69,48 @@ type ListPhotographerTimeOffResponse struct {
 	Timezone   string                        `json:"timezone"`
 }
 
+// TimeOffImportRequest carries an iCalendar (.ics) document to be converted into time-off entries.
+// Timezone applies to floating and all-day values; defaults to America/Sao_Paulo.
+type TimeOffImportRequest struct {
+	Timezone      string `json:"timezone,omitempty" example:"America/Sao_Paulo"`
+	Calendar      string `json:"calendar" binding:"required" example:"BEGIN:VCALENDAR\r\nVERSION:2.0\r\n...\r\nEND:VCALENDAR"`
+	SkipConflicts bool   `json:"skipConflicts" example:"false"`
+}
+
+// TimeOffImportConflictResponse describes a booked photo session overlapping an imported occurrence.
+type TimeOffImportConflictResponse struct {
+	EntryID           uint64 `json:"entryId"`
+	BookingID         uint64 `json:"bookingId,omitempty"`
+	ListingIdentityID int64  `json:"listingIdentityId,omitempty"`
+	StartDate         string `json:"startDate"`
+	EndDate           string `json:"endDate"`
+}
+
+// TimeOffImportItemResponse is one expanded occurrence of the imported calendar, or one part of it when partial.
+type TimeOffImportItemResponse struct {
+	UID       string                          `json:"uid"`
+	Summary   string                          `json:"summary,omitempty"`
+	StartDate string                          `json:"startDate"`
+	EndDate   string                          `json:"endDate"`
+	AllDay    bool                            `json:"allDay"`
+	Partial   bool                            `json:"partial,omitempty"`
+	Status    string                          `json:"status" example:"READY"`
+	TimeOffID uint64                          `json:"timeOffId,omitempty"`
+	Conflicts []TimeOffImportConflictResponse `json:"conflicts,omitempty"`
+}
+
+// TimeOffImportResponse is the preview or outcome of a time-off calendar import.
+type TimeOffImportResponse struct {
+	Timezone    string                              `json:"timezone"`
+	WindowStart string                              `json:"windowStart"`
+	WindowEnd   string                              `json:"windowEnd"`
+	Items       []TimeOffImportItemResponse         `json:"items"`
+	Unsupported []CalendarImportUnsupportedResponse `json:"unsupported"`
+	Ready       int                                 `json:"ready"`
+	Conflicts   int                                 `json:"conflicts"`
+	Created     int                                 `json:"created"`
+}
+
 // PhotographerServiceAreaListQuery captures filters for listing service areas.
 type PhotographerServiceAreaListQuery struct {
 	Page int `form:"page" binding:"omitempty,min=1" example:"1"`
@@ -110,3 +152,48 @@ type PhotographerServiceAreaListResponse struct {
 	ServiceAreas []PhotographerServiceAreaResponse `json:"serviceAreas"`
 	Pagination   PaginationResponse                `json:"pagination"`
 }
+
+// PhotographerStatementQuery selects the statement month (YYYY-MM); empty means the current month.
+type PhotographerStatementQuery struct {
+	Period string `form:"period" example:"2026-10"`
+}
+
+// PayoutLineResponse is one ledger line: what is owed for a completed session and how it was priced.
+type PayoutLineResponse struct {
+	ID                uint64  `json:"id" example:"310"`
+	PhotoSessionID    uint64  `json:"photoSessionId" example:"5521"`
+	ListingIdentityID int64   `json:"listingIdentityId" example:"1024"`
+	RuleID            *uint64 `json:"ruleId,omitempty" example:"4"`
+	SessionType       string  `json:"sessionType" enums:"STANDARD,PANORAMA" example:"STANDARD"`
+	PropertyType      uint16  `json:"propertyType" example:"16"`
+	SizeM2            float64 `json:"sizeM2" example:"320"`
+	SessionStartsAt   string  `json:"sessionStartsAt" example:"2026-10-11T12:00:00Z"`
+	BaseAmountCents   int64   `json:"baseAmountCents" example:"30000"`
+	SurchargePct      float64 `json:"surchargePct" example:"20"`
+	SurchargeReason   string  `json:"surchargeReason,omitempty" example:"weekend"`
+	AmountCents       int64   `json:"amountCents" example:"36000"`
+	Status            string  `json:"status" enums:"PENDING,PAID" example:"PENDING"`
+	PayoutReference   string  `json:"payoutReference,omitempty" example:"PAYOUT-2026-09-1759287600"`
+	PaidAt            string  `json:"paidAt,omitempty" example:"2026-10-01T03:00:00Z"`
+}
+
+// PhotographerStatementResponse lists the month's ledger lines with totals in cents.
+type PhotographerStatementResponse struct {
+	Period       string               `json:"period" example:"2026-10"`
+	Lines        []PayoutLineResponse `json:"lines"`
+	TotalCents   int64                `json:"totalCents" example:"96000"`
+	PendingCents int64                `json:"pendingCents" example:"96000"`
+	PaidCents    int64                `json:"paidCents" example:"0"`
+}
+
+// ConfirmArrivalRequest identifies the session the photographer has just arrived at.
+type ConfirmArrivalRequest struct {
+	PhotoSessionID uint64 `json:"photoSessionId" binding:"required" example:"12345"`
+}
+
+// ConfirmArrivalResponse reports the recorded arrival and whether it was within the grace period.
+type ConfirmArrivalResponse struct {
+	PhotoSessionID uint64 `json:"photoSessionId" example:"12345"`
+	ArrivedAt      string `json:"arrivedAt" example:"2026-10-11T11:58:00Z"`
+	OnTime         bool   `json:"onTime" example:"true"`
+}
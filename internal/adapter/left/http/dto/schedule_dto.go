@@ -12,25 +12,39 @@ type SchedulePaginationRequest struct {
 	Limit int `json:"limit,omitempty" example:"20"`
 }
 
+// ScheduleRuleRecurrenceRequest holds the optional recurrence fields of a rule. Omitted fields keep the weekly
+// BLOCK behaviour. weekDays is required for WEEKLY and MONTHLY_WEEKDAY and ignored otherwise.
+type ScheduleRuleRecurrenceRequest struct {
+	Recurrence  string `json:"recurrence,omitempty" binding:"omitempty,oneof=WEEKLY MONTHLY_DAY MONTHLY_WEEKDAY DATE" example:"MONTHLY_WEEKDAY"`
+	RuleType    string `json:"ruleType,omitempty" binding:"omitempty,oneof=BLOCK FREE" example:"BLOCK"`
+	DayOfMonth  uint8  `json:"dayOfMonth,omitempty" example:"15"`
+	WeekOfMonth int8   `json:"weekOfMonth,omitempty" example:"1"`
+	Date        string `json:"date,omitempty" example:"2025-12-20"`
+	ValidFrom   string `json:"validFrom,omitempty" example:"2025-11-01"`
+	ValidUntil  string `json:"validUntil,omitempty" example:"2025-12-15"`
+}
+
 // ScheduleRuleRequest represents the payload to create recurring unavailability rules.
 type ScheduleRuleRequest struct {
 	ListingIdentityID int64    `json:"listingIdentityId" binding:"required" example:"3241"`
-	WeekDays          []string `json:"weekDays" binding:"required" example:"[\"MONDAY\",\"TUESDAY\"]"`
+	WeekDays          []string `json:"weekDays" example:"[\"MONDAY\",\"TUESDAY\"]"`
 	RangeStart        string   `json:"rangeStart" binding:"required" example:"09:00"`
 	RangeEnd          string   `json:"rangeEnd" binding:"required" example:"18:00"`
 	Active            bool     `json:"active"`
 	Timezone          string   `json:"timezone" binding:"required" example:"America/Sao_Paulo"`
+	ScheduleRuleRecurrenceRequest
 }
 
 // ScheduleRuleUpdateRequest represents the payload to update an existing rule.
 type ScheduleRuleUpdateRequest struct {
 	RuleID            uint64   `json:"ruleId" binding:"required" example:"9801"`
 	ListingIdentityID int64    `json:"listingIdentityId" binding:"required" example:"3241"`
-	WeekDays          []string `json:"weekDays" binding:"required" example:"[\"MONDAY\"]"`
+	WeekDays          []string `json:"weekDays" example:"[\"MONDAY\"]"`
 	RangeStart        string   `json:"rangeStart" binding:"required" example:"10:00"`
 	RangeEnd          string   `json:"rangeEnd" binding:"required" example:"22:00"`
 	Active            bool     `json:"active"`
 	Timezone          string   `json:"timezone" binding:"required" example:"America/Sao_Paulo"`
+	ScheduleRuleRecurrenceRequest
 }
 
 // ScheduleRuleDeleteRequest represents the payload to delete a recurring rule.
@@ -41,18 +55,91 @@ type ScheduleRuleDeleteRequest struct {
 
 // ScheduleRuleResponse exposes a recurring rule definition.
 type ScheduleRuleResponse struct {
-	RuleID    uint64 `json:"ruleId"`
-	Weekday   string `json:"weekday"`
-	StartTime string `json:"startTime"`
-	EndTime   string `json:"endTime"`
-	Active    bool   `json:"active"`
+	RuleID      uint64 `json:"ruleId"`
+	Weekday     string `json:"weekday,omitempty"`
+	StartTime   string `json:"startTime"`
+	EndTime     string `json:"endTime"`
+	Active      bool   `json:"active"`
+	Recurrence  string `json:"recurrence"`
+	RuleType    string `json:"ruleType"`
+	DayOfMonth  uint8  `json:"dayOfMonth,omitempty"`
+	WeekOfMonth int8   `json:"weekOfMonth,omitempty"`
+	Date        string `json:"date,omitempty"`
+	ValidFrom   string `json:"validFrom,omitempty"`
+	ValidUntil  string `json:"validUntil,omitempty"`
 }
 
 // ScheduleRulesResponse wraps a listing rule collection.
 type ScheduleRulesResponse struct {
-	ListingIdentityID int64                  `json:"listingIdentityId"`
-	Rules             []ScheduleRuleResponse `json:"rules"`
-	Timezone          string                 `json:"timezone"`
+	ListingIdentityID  int64                  `json:"listingIdentityId"`
+	Rules              []ScheduleRuleResponse `json:"rules"`
+	Timezone           string                 `json:"timezone"`
+	TemplateID         *uint64                `json:"templateId,omitempty"`
+	TemplateOverridden bool                   `json:"templateOverridden,omitempty"`
+}
+
+// ScheduleTemplateRuleRequest describes one availability template rule, with the same fields as a listing rule.
+type ScheduleTemplateRuleRequest struct {
+	WeekDays   []string `json:"weekDays" example:"[\"SATURDAY\",\"SUNDAY\"]"`
+	RangeStart string   `json:"rangeStart" binding:"required" example:"00:00"`
+	RangeEnd   string   `json:"rangeEnd" binding:"required" example:"23:59"`
+	Active     bool     `json:"active"`
+	ScheduleRuleRecurrenceRequest
+}
+
+// ScheduleTemplateRequest represents the payload to create an availability template.
+type ScheduleTemplateRequest struct {
+	Name      string                        `json:"name" binding:"required" example:"Fins de semana bloqueados"`
+	IsDefault bool                          `json:"isDefault"`
+	Rules     []ScheduleTemplateRuleRequest `json:"rules" binding:"dive"`
+}
+
+// ScheduleTemplateUpdateRequest represents the payload to replace an availability template.
+type ScheduleTemplateUpdateRequest struct {
+	TemplateID uint64                        `json:"templateId" binding:"required" example:"12"`
+	Name       string                        `json:"name" binding:"required" example:"Fins de semana bloqueados"`
+	IsDefault  bool                          `json:"isDefault"`
+	Rules      []ScheduleTemplateRuleRequest `json:"rules" binding:"dive"`
+}
+
+// ScheduleTemplateDeleteRequest represents the payload to delete an availability template.
+type ScheduleTemplateDeleteRequest struct {
+	TemplateID uint64 `json:"templateId" binding:"required" example:"12"`
+}
+
+// ScheduleTemplateApplyRequest represents the payload to apply a template to listing agendas.
+type ScheduleTemplateApplyRequest struct {
+	TemplateID         uint64  `json:"templateId" binding:"required" example:"12"`
+	ListingIdentityIDs []int64 `json:"listingIdentityIds" binding:"required,min=1" example:"3241,3242"`
+}
+
+// ScheduleTemplateLinkResponse describes a listing agenda linked to a template.
+type ScheduleTemplateLinkResponse struct {
+	ListingIdentityID int64 `json:"listingIdentityId"`
+	Overridden        bool  `json:"overridden"`
+}
+
+// ScheduleTemplateResponse exposes an availability template.
+type ScheduleTemplateResponse struct {
+	TemplateID     uint64                         `json:"templateId"`
+	Name           string                         `json:"name"`
+	IsDefault      bool                           `json:"isDefault"`
+	CreatedAt      string                         `json:"createdAt"`
+	UpdatedAt      string                         `json:"updatedAt"`
+	Rules          []ScheduleRuleResponse         `json:"rules"`
+	LinkedListings []ScheduleTemplateLinkResponse `json:"linkedListings,omitempty"`
+}
+
+// ScheduleTemplatesResponse wraps the availability templates of an owner.
+type ScheduleTemplatesResponse struct {
+	Templates []ScheduleTemplateResponse `json:"templates"`
+}
+
+// ScheduleTemplateMutationResponse reports a saved or applied template and the agendas that received its rules.
+type ScheduleTemplateMutationResponse struct {
+	Template                     ScheduleTemplateResponse `json:"template"`
+	SyncedListingIdentityIDs     []int64                  `json:"syncedListingIdentityIds"`
+	OverriddenListingIdentityIDs []int64                  `json:"overriddenListingIdentityIds"`
 }
 
 // ScheduleFinishAgendaRequest represents the payload to finish agenda creation.
@@ -150,3 +237,55 @@ type ScheduleAvailabilityResponse struct {
 	Pagination PaginationResponse                 `json:"pagination"`
 	Timezone   string                             `json:"timezone"`
 }
+
+// ScheduleImportRequest carries an iCalendar (.ics) document to be converted into listing blocks.
+type ScheduleImportRequest struct {
+	ListingIdentityID int64  `json:"listingIdentityId" binding:"required" example:"3241"`
+	EntryType         string `json:"entryType" binding:"required,oneof=BLOCK TEMP_BLOCK" example:"TEMP_BLOCK"`
+	Calendar          string `json:"calendar" binding:"required" example:"BEGIN:VCALENDAR\r\nVERSION:2.0\r\n...\r\nEND:VCALENDAR"`
+	SkipConflicts     bool   `json:"skipConflicts" example:"false"`
+}
+
+// CalendarImportUnsupportedResponse describes an imported event that was left out.
+type CalendarImportUnsupportedResponse struct {
+	UID     string `json:"uid"`
+	Summary string `json:"summary,omitempty"`
+	Reason  string `json:"reason"`
+}
+
+// ScheduleImportConflictResponse describes an agenda entry overlapping an imported occurrence.
+type ScheduleImportConflictResponse struct {
+	EntryID        uint64 `json:"entryId"`
+	EntryType      string `json:"entryType"`
+	StartsAt       string `json:"startsAt"`
+	EndsAt         string `json:"endsAt"`
+	VisitID        uint64 `json:"visitId,omitempty"`
+	PhotoBookingID uint64 `json:"photoBookingId,omitempty"`
+}
+
+// ScheduleImportItemResponse is one expanded occurrence of the imported calendar, or one part of it when partial.
+type ScheduleImportItemResponse struct {
+	UID       string                           `json:"uid"`
+	Summary   string                           `json:"summary,omitempty"`
+	StartsAt  string                           `json:"startsAt"`
+	EndsAt    string                           `json:"endsAt"`
+	AllDay    bool                             `json:"allDay"`
+	Partial   bool                             `json:"partial,omitempty"`
+	Status    string                           `json:"status" example:"READY"`
+	EntryID   uint64                           `json:"entryId,omitempty"`
+	Conflicts []ScheduleImportConflictResponse `json:"conflicts,omitempty"`
+}
+
+// ScheduleImportResponse is the preview or outcome of a listing calendar import.
+type ScheduleImportResponse struct {
+	ListingIdentityID int64                               `json:"listingIdentityId"`
+	EntryType         string                              `json:"entryType"`
+	Timezone          string                              `json:"timezone"`
+	WindowStart       string                              `json:"windowStart"`
+	WindowEnd         string                              `json:"windowEnd"`
+	Items             []ScheduleImportItemResponse        `json:"items"`
+	Unsupported       []CalendarImportUnsupportedResponse `json:"unsupported"`
+	Ready             int                                 `json:"ready"`
+	Conflicts         int                                 `json:"conflicts"`
+	Created           int                                 `json:"created"`
+}
@@ -403,3 +403,108 @@ type UserStatusResponse struct {
 type UserStatusData struct {
 	Status int `json:"status" example:"0"`
 }
+
+// DataExportResponse represents the status of an LGPD personal data export
+// for POST/GET /user/data-export
+type DataExportResponse struct {
+	ID           int64  `json:"id" example:"42"`
+	Status       string `json:"status" example:"COMPLETED" enums:"PENDING,PROCESSING,COMPLETED,FAILED"`
+	RequestedAt  string `json:"requestedAt" example:"2025-01-10T12:00:00Z"`
+	CompletedAt  string `json:"completedAt,omitempty" example:"2025-01-10T12:05:00Z"`
+	ExpiresAt    string `json:"expiresAt,omitempty" example:"2025-01-13T12:05:00Z"`
+	DownloadURL  string `json:"downloadUrl,omitempty"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+// NotificationPreferenceResponse is one channel/category entry of the consent matrix
+type NotificationPreferenceResponse struct {
+	Channel       string `json:"channel" example:"push" enums:"email,sms,push"`
+	Category      string `json:"category" example:"marketing" enums:"transactional,visit_updates,proposal_updates,saved_search_alerts,marketing"`
+	Granted       bool   `json:"granted" example:"false"`
+	Mandatory     bool   `json:"mandatory" example:"false"`
+	Recorded      bool   `json:"recorded" example:"true"`
+	PolicyVersion string `json:"policyVersion,omitempty" example:"1.0"`
+	UpdatedAt     string `json:"updatedAt,omitempty" example:"2025-01-10T12:00:00Z"`
+}
+
+// NotificationPreferencesResponse represents GET/PUT /user/notification-preferences response
+type NotificationPreferencesResponse struct {
+	CurrentPolicyVersion string                           `json:"currentPolicyVersion" example:"1.0"`
+	Preferences          []NotificationPreferenceResponse `json:"preferences"`
+}
+
+// NotificationPreferenceChangeRequest is one consent change requested by the user
+type NotificationPreferenceChangeRequest struct {
+	Channel  string `json:"channel" binding:"required" example:"email" enums:"email,sms,push"`
+	Category string `json:"category" binding:"required" example:"marketing" enums:"transactional,visit_updates,proposal_updates,saved_search_alerts,marketing"`
+	Granted  bool   `json:"granted" example:"true"`
+}
+
+// UpdateNotificationPreferencesRequest represents PUT /user/notification-preferences request
+type UpdateNotificationPreferencesRequest struct {
+	// PolicyVersion is the privacy policy version the user accepted (defaults to the current one)
+	PolicyVersion string                                `json:"policyVersion,omitempty" example:"1.0"`
+	Preferences   []NotificationPreferenceChangeRequest `json:"preferences" binding:"required,min=1,dive"`
+}
+
+// ListNotificationsQuery represents GET /user/notifications query parameters
+type ListNotificationsQuery struct {
+	Page       int  `form:"page" binding:"omitempty,min=1" example:"1"`
+	Limit      int  `form:"limit" binding:"omitempty,min=1,max=100" example:"20"`
+	UnreadOnly bool `form:"unreadOnly" example:"false"`
+}
+
+// NotificationResponse is one entry of the in-app notification inbox
+type NotificationResponse struct {
+	ID        int64             `json:"id" example:"123"`
+	Channel   string            `json:"channel" example:"push" enums:"email,sms,push"`
+	Category  string            `json:"category" example:"visit_updates" enums:"transactional,visit_updates,proposal_updates,saved_search_alerts,marketing"`
+	Title     string            `json:"title" example:"Nova visita solicitada"`
+	Body      string            `json:"body" example:"Você recebeu um pedido de visita para o seu imóvel."`
+	DeepLink  string            `json:"deepLink,omitempty" example:"toq://visits/42"`
+	Data      map[string]string `json:"data,omitempty"`
+	Read      bool              `json:"read" example:"false"`
+	ReadAt    string            `json:"readAt,omitempty" example:"2025-01-10T12:00:00Z"`
+	CreatedAt string            `json:"createdAt" example:"2025-01-10T11:58:00Z"`
+}
+
+// ListNotificationsResponse represents GET /user/notifications response
+type ListNotificationsResponse struct {
+	Notifications []NotificationResponse `json:"notifications"`
+	UnreadCount   int64                  `json:"unreadCount" example:"3"`
+	Pagination    PaginationResponse     `json:"pagination"`
+}
+
+// MarkNotificationReadRequest represents POST /user/notifications/read request
+type MarkNotificationReadRequest struct {
+	NotificationID int64 `json:"notificationId" binding:"required,min=1" example:"123"`
+}
+
+// MarkAllNotificationsReadResponse represents POST /user/notifications/read-all response
+type MarkAllNotificationsReadResponse struct {
+	Updated int64 `json:"updated" example:"5"`
+}
+
+// UnreadNotificationCountResponse represents GET /user/notifications/unread-count response
+type UnreadNotificationCountResponse struct {
+	UnreadCount int64 `json:"unreadCount" example:"3"`
+}
+
+// CalendarFeedStatusResponse describes the user's iCalendar subscription without exposing the secret URL.
+type CalendarFeedStatusResponse struct {
+	Active         bool    `json:"active" example:"true"`
+	CreatedAt      *string `json:"createdAt,omitempty" example:"2026-10-19T12:00:00Z"`
+	LastAccessedAt *string `json:"lastAccessedAt,omitempty" example:"2026-10-19T13:05:00Z"`
+}
+
+// CalendarFeedTokenResponse carries a freshly generated feed URL; it is shown only once.
+type CalendarFeedTokenResponse struct {
+	URL       string `json:"url" example:"https://api.toq.com.br/api/v2/calendar/feeds/3q2-7wAbCdEf.ics"`
+	Token     string `json:"token" example:"3q2-7wAbCdEf"`
+	CreatedAt string `json:"createdAt" example:"2026-10-19T12:00:00Z"`
+}
+
+// CalendarFeedRequest identifies the feed token in the public subscription URL.
+type CalendarFeedRequest struct {
+	Token string `uri:"token" binding:"required" example:"3q2-7wAbCdEf.ics"`
+}
@@ -13,6 +13,7 @@ import (
 // DeleteAdminSystemUser handles DELETE /admin/users/system
 //
 //	@Summary      Deactivate a system user
+//	@Description	Deactivates all roles of a System User and anonymizes their personal data (LGPD). Stored files are purged.
 //	@Tags         Admin Users
 //	@Accept       json
 //	@Produce      json
@@ -0,0 +1,82 @@
+package adminhandlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	dto "github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	userservices "github.com/projeto-toq/toq_server/internal/core/service/user_service"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// GetAdminExpiringRoles handles GET /admin/users/roles/expiring
+//
+//	@Summary      List role grants expiring soon
+//	@Description  Returns time-bound role grants whose validUntil falls within the next withinDays days, soonest first
+//	@Tags         Admin Users
+//	@Produce      json
+//	@Param        withinDays  query  int  false  "Look-ahead window in days (max 90)" default(7) Extensions(x-example=7)
+//	@Param        page        query  int  false  "Page number" default(1) Extensions(x-example=1)
+//	@Param        limit       query  int  false  "Page size" default(20) Extensions(x-example=20)
+//	@Success      200  {object}  dto.AdminGetExpiringRolesResponse
+//	@Failure      400  {object}  map[string]any
+//	@Failure      401  {object}  map[string]any
+//	@Failure      403  {object}  map[string]any
+//	@Failure      500  {object}  map[string]any
+//	@Router       /admin/users/roles/expiring [get]
+func (h *AdminHandler) GetAdminExpiringRoles(c *gin.Context) {
+	ctx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+	var req dto.AdminGetExpiringRolesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		httperrors.SendHTTPErrorObj(c, httperrors.ConvertBindError(err))
+		return
+	}
+
+	result, err := h.userService.ListExpiringUserRoles(ctx, userservices.ListExpiringUserRolesInput{
+		WithinDays: req.WithinDays,
+		Page:       req.Page,
+		Limit:      req.Limit,
+	})
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	resp := dto.AdminGetExpiringRolesResponse{
+		Grants: make([]dto.AdminExpiringRole, 0, len(result.Items)),
+		Pagination: dto.PaginationResponse{
+			Page:       result.Page,
+			Limit:      result.Limit,
+			Total:      result.Total,
+			TotalPages: computeTotalPages(result.Total, result.Limit),
+		},
+	}
+
+	for _, item := range result.Items {
+		resp.Grants = append(resp.Grants, dto.AdminExpiringRole{
+			UserRoleID:       item.UserRoleID,
+			UserID:           item.UserID,
+			FullName:         item.FullName,
+			Email:            item.Email,
+			RoleID:           item.RoleID,
+			RoleSlug:         item.RoleSlug,
+			RoleName:         item.RoleName,
+			GrantState:       item.GrantState.String(),
+			ValidFrom:        formatOptionalTimestamp(item.ValidFrom),
+			ValidUntil:       item.ExpiresAt.UTC().Format(time.RFC3339),
+			ExpiryNotifiedAt: formatOptionalTimestamp(item.ExpiryNotifiedAt),
+		})
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+func formatOptionalTimestamp(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.UTC().Format(time.RFC3339)
+	return &formatted
+}
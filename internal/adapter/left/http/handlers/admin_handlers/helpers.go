@@ -3,6 +3,7 @@ package adminhandlers
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	dto "github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
@@ -229,3 +230,15 @@ func parseOptionalCoverageKind(raw string) (*propertycoveragemodel.CoverageKind,
 	}
 	return &kind, nil
 }
+
+func parseOptionalRFC3339(field, raw string) (*time.Time, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+	parsed, err := coreutils.ParseRFC3339Relaxed(field, raw)
+	if err != nil {
+		return nil, err
+	}
+	parsed = parsed.UTC()
+	return &parsed, nil
+}
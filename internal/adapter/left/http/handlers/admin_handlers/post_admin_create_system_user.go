@@ -20,7 +20,7 @@ import (
 //	@Accept       json
 //	@Produce      json
 //	@Param        request  body  dto.AdminCreateSystemUserRequest  true  "System user payload"
-//	@Description	Create a System User with roleSlug: (photographer, attendantRealtor, attendantOwner, attendant, manager) and details. Not for Owner/Realtor user creation. Email with instruction will be sent to the new user.
+//	@Description	Create a System User with roleSlug: (photographer, attendantRealtor, attendantOwner, attendant, manager) and details. Optional validFrom/validUntil make the role grant time-bound. Not for Owner/Realtor user creation. Email with instruction will be sent to the new user.
 //	@Success      201  {object}  dto.AdminSystemUserResponse
 //	@Failure      400  {object}  map[string]any
 //	@Failure      401  {object}  map[string]any
@@ -53,6 +53,17 @@ func (h *AdminHandler) PostAdminCreateSystemUser(c *gin.Context) {
 		return
 	}
 
+	validFrom, err := parseOptionalRFC3339("validFrom", req.ValidFrom)
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+	validUntil, err := parseOptionalRFC3339("validUntil", req.ValidUntil)
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
 	input := userservices.CreateSystemUserInput{
 		NickName:    strings.TrimSpace(req.NickName),
 		Email:       strings.TrimSpace(req.Email),
@@ -62,6 +73,8 @@ func (h *AdminHandler) PostAdminCreateSystemUser(c *gin.Context) {
 		RoleSlug:    permissionmodel.RoleSlug(strings.TrimSpace(req.RoleSlug)),
 		ZipCode:     zipCode,
 		Number:      number,
+		ValidFrom:   validFrom,
+		ValidUntil:  validUntil,
 	}
 
 	result, svcErr := h.userService.CreateSystemUser(ctx, input)
@@ -14,6 +14,7 @@ import (
 // PutAdminUpdateSystemUser handles PUT /admin/users/system
 //
 //	@Summary      Update system user data
+//	@Description	Update a System User's name, email and phone. Optional validFrom/validUntil grant, extend or shorten the role validity; an omitted field keeps its current value.
 //	@Tags         Admin Users
 //	@Accept       json
 //	@Produce      json
@@ -34,11 +35,24 @@ func (h *AdminHandler) PutAdminUpdateSystemUser(c *gin.Context) {
 		return
 	}
 
+	validFrom, err := parseOptionalRFC3339("validFrom", req.ValidFrom)
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+	validUntil, err := parseOptionalRFC3339("validUntil", req.ValidUntil)
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
 	input := userservices.UpdateSystemUserInput{
 		UserID:      req.UserID,
 		FullName:    strings.TrimSpace(req.FullName),
 		Email:       strings.TrimSpace(req.Email),
 		PhoneNumber: strings.TrimSpace(req.PhoneNumber),
+		ValidFrom:   validFrom,
+		ValidUntil:  validUntil,
 	}
 
 	result, svcErr := h.userService.UpdateSystemUser(ctx, input)
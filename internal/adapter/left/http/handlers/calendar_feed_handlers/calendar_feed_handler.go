@@ -0,0 +1,20 @@
+package calendarfeedhandlers
+
+import (
+	calendarfeedservice "github.com/projeto-toq/toq_server/internal/core/service/calendar_feed_service"
+	globalservice "github.com/projeto-toq/toq_server/internal/core/service/global_service"
+)
+
+// CalendarFeedHandler handles iCalendar subscription management and the public feed endpoint.
+type CalendarFeedHandler struct {
+	service       calendarfeedservice.Service
+	globalService globalservice.GlobalServiceInterface
+}
+
+// NewCalendarFeedHandler creates a new handler with its dependencies.
+func NewCalendarFeedHandler(service calendarfeedservice.Service, globalService globalservice.GlobalServiceInterface) *CalendarFeedHandler {
+	return &CalendarFeedHandler{
+		service:       service,
+		globalService: globalService,
+	}
+}
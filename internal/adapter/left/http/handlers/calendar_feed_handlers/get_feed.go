@@ -0,0 +1,45 @@
+package calendarfeedhandlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	httputils "github.com/projeto-toq/toq_server/internal/adapter/left/http/utils"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+const calendarContentType = "text/calendar; charset=utf-8"
+
+// GetFeed serves the iCalendar document of a subscription.
+// The endpoint is public: access is granted by the secret token in the path.
+//
+//	@Summary		Get iCalendar feed
+//	@Description	Returns the RFC 5545 calendar with the photo sessions, time-off, visits and agenda blocks of the feed owner. Subscribe to the URL returned by POST /user/calendar-feed; the ".ics" suffix is optional.
+//	@Tags			Calendar
+//	@Produce		text/calendar
+//	@Param			token	path		string	true	"Secret feed token"	example(3q2-7wAbCdEf.ics)
+//	@Success		200		{string}	string	"iCalendar document"
+//	@Failure		404		{object}	dto.ErrorResponse	"Unknown, rotated or revoked feed"
+//	@Failure		500		{object}	dto.ErrorResponse	"Internal server error"
+//	@Router			/calendar/feeds/{token} [get]
+func (h *CalendarFeedHandler) GetFeed(c *gin.Context) {
+	ctx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	var request dto.CalendarFeedRequest
+	if err := c.ShouldBindUri(&request); err != nil {
+		httperrors.SendHTTPErrorObj(c, httputils.MapBindingError(err))
+		return
+	}
+
+	content, err := h.service.RenderFeed(ctx, strings.TrimSuffix(request.Token, ".ics"))
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", "private, no-cache")
+	c.Data(http.StatusOK, calendarContentType, content)
+}
@@ -0,0 +1,95 @@
+package calendarfeedhandlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+)
+
+// GetCalendarFeed reports whether the user has an active calendar subscription.
+//
+//	@Summary      Get calendar feed status
+//	@Description  Reports whether the authenticated user has an active iCalendar feed, when it was created and last fetched. The secret URL is only returned on regeneration.
+//	@Tags         User
+//	@Produce      json
+//	@Success      200  {object}  dto.CalendarFeedStatusResponse
+//	@Failure      401  {object}  dto.ErrorResponse  "Unauthorized"
+//	@Failure      403  {object}  dto.ErrorResponse  "Forbidden"
+//	@Failure      500  {object}  dto.ErrorResponse  "Internal server error"
+//	@Router       /user/calendar-feed [get]
+//	@Security     BearerAuth
+func (h *CalendarFeedHandler) GetCalendarFeed(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	status, err := h.service.GetFeed(ctx)
+	if err != nil {
+		http_errors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	response := dto.CalendarFeedStatusResponse{Active: status.Active}
+	if status.CreatedAt != nil {
+		createdAt := status.CreatedAt.UTC().Format(time.RFC3339)
+		response.CreatedAt = &createdAt
+	}
+	if status.LastAccessedAt != nil {
+		accessedAt := status.LastAccessedAt.UTC().Format(time.RFC3339)
+		response.LastAccessedAt = &accessedAt
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// RegenerateCalendarFeed issues a new secret feed URL.
+//
+//	@Summary      Regenerate calendar feed URL
+//	@Description  Creates (or rotates) the secret iCalendar URL of the authenticated user. The previous URL stops working immediately. The URL is returned only in this response.
+//	@Tags         User
+//	@Produce      json
+//	@Success      200  {object}  dto.CalendarFeedTokenResponse
+//	@Failure      401  {object}  dto.ErrorResponse  "Unauthorized"
+//	@Failure      403  {object}  dto.ErrorResponse  "Forbidden"
+//	@Failure      500  {object}  dto.ErrorResponse  "Internal server error"
+//	@Router       /user/calendar-feed [post]
+//	@Security     BearerAuth
+func (h *CalendarFeedHandler) RegenerateCalendarFeed(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	token, err := h.service.RegenerateFeedToken(ctx)
+	if err != nil {
+		http_errors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.CalendarFeedTokenResponse{
+		URL:       token.URL,
+		Token:     token.Token,
+		CreatedAt: token.CreatedAt.UTC().Format(time.RFC3339),
+	})
+}
+
+// RevokeCalendarFeed disables the secret feed URL.
+//
+//	@Summary      Revoke calendar feed URL
+//	@Description  Disables the iCalendar feed of the authenticated user; subscribed calendar apps receive 404 from then on.
+//	@Tags         User
+//	@Success      204  "Feed revoked"
+//	@Failure      401  {object}  dto.ErrorResponse  "Unauthorized"
+//	@Failure      403  {object}  dto.ErrorResponse  "Forbidden"
+//	@Failure      404  {object}  dto.ErrorResponse  "No active feed"
+//	@Failure      500  {object}  dto.ErrorResponse  "Internal server error"
+//	@Router       /user/calendar-feed [delete]
+//	@Security     BearerAuth
+func (h *CalendarFeedHandler) RevokeCalendarFeed(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	if err := h.service.RevokeFeedToken(ctx); err != nil {
+		http_errors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -85,6 +85,11 @@ func ListMediaOutputToDTO(output domaindto.ListMediaOutput) dto.ListMediaRespons
 			Metadata:          metaMap,
 			S3KeyRaw:          a.S3KeyRaw(),
 			S3KeyProcessed:    a.S3KeyProcessed(),
+			SrcSets:           srcSetsToDTO(output.SrcSets[a.ID()]),
+			Quality:           qualityToDTO(output.Quality, a.ID()),
+			Cover:             output.Gallery[a.ID()].Cover,
+			RoomTag:           roomTagToDTO(output.Gallery[a.ID()]),
+			Panorama:          panoramaToDTO(output.Panoramas, a.ID()),
 		})
 	}
 
@@ -99,6 +104,7 @@ func ListMediaOutputToDTO(output domaindto.ListMediaOutput) dto.ListMediaRespons
 			AssetsCount:             output.ZipBundle.AssetsCount,
 			ZipSizeBytes:            output.ZipBundle.ZipSizeBytes,
 			EstimatedExtractedBytes: output.ZipBundle.EstimatedExtractedBytes,
+			SHA256:                  output.ZipBundle.SHA256,
 			CompletedAt:             completedAt,
 		}
 	}
@@ -110,7 +116,58 @@ func ListMediaOutputToDTO(output domaindto.ListMediaOutput) dto.ListMediaRespons
 			Limit: output.Limit,
 			Total: output.TotalCount,
 		},
-		ZipBundle: zipBundle,
+		ZipBundle:       zipBundle,
+		GalleryRevision: output.GalleryRevision,
+		Tour:            MediaTourToDTO(output.Tour),
+		Parcel:          parcelToDTO(output.Parcel),
+	}
+}
+
+func srcSetsToDTO(srcSets []domaindto.MediaImageSrcSet) []dto.MediaSrcSetResponse {
+	if len(srcSets) == 0 {
+		return nil
+	}
+	result := make([]dto.MediaSrcSetResponse, 0, len(srcSets))
+	for _, set := range srcSets {
+		sources := make([]dto.MediaSrcSetVariant, 0, len(set.Sources))
+		for _, source := range set.Sources {
+			sources = append(sources, dto.MediaSrcSetVariant{
+				Resolution: source.Resolution,
+				Width:      source.Width,
+				URL:        source.URL,
+			})
+		}
+		result = append(result, dto.MediaSrcSetResponse{
+			Format:  string(set.Format),
+			Type:    set.ContentType,
+			SrcSet:  set.SrcSet,
+			Sources: sources,
+		})
+	}
+	return result
+}
+
+func qualityToDTO(quality map[uint64]domaindto.MediaPhotoQuality, assetID uint64) *dto.MediaQualityResponse {
+	q, ok := quality[assetID]
+	if !ok {
+		return nil
+	}
+	issues := make([]string, 0, len(q.Issues))
+	for _, issue := range q.Issues {
+		issues = append(issues, string(issue))
+	}
+	return &dto.MediaQualityResponse{
+		Status:            string(q.Status),
+		Issues:            issues,
+		DuplicateOf:       q.DuplicateOf,
+		Width:             q.Report.Width,
+		Height:            q.Report.Height,
+		BlurScore:         q.Report.BlurScore,
+		Brightness:        q.Report.Brightness,
+		ShadowsClipped:    q.Report.ShadowsClipped,
+		HighlightsClipped: q.Report.HighlightsClipped,
+		ExposureScore:     q.Report.ExposureScore,
+		PHash:             q.Report.PHash,
 	}
 }
 
@@ -122,6 +179,7 @@ func DTOToGenerateDownloadURLsInput(req dto.GenerateDownloadURLsRequest) domaind
 			AssetType:  mediaprocessingmodel.MediaAssetType(r.AssetType),
 			Sequence:   r.Sequence,
 			Resolution: r.Resolution,
+			Format:     mediaprocessingmodel.ImageVariantFormat(r.Format),
 		})
 	}
 
@@ -139,6 +197,7 @@ func GenerateDownloadURLsOutputToDTO(output domaindto.GenerateDownloadURLsOutput
 			AssetType:  string(u.AssetType),
 			Sequence:   u.Sequence,
 			Resolution: u.Resolution,
+			Format:     string(u.Format),
 			Url:        u.Url,
 			ExpiresIn:  u.ExpiresIn,
 		})
@@ -149,3 +208,259 @@ func GenerateDownloadURLsOutputToDTO(output domaindto.GenerateDownloadURLsOutput
 		Urls:              urls,
 	}
 }
+
+// DTOToGetHLSPlaylistInput converts the HLS playlist path params to service input
+func DTOToGetHLSPlaylistInput(req dto.HLSPlaylistRequest) domaindto.GetHLSPlaylistInput {
+	return domaindto.GetHLSPlaylistInput{
+		Token:    req.Token,
+		Playlist: req.Playlist,
+	}
+}
+
+// DTOToGetPanoramaTileInput converts the panorama tile path params to service input
+func DTOToGetPanoramaTileInput(req dto.PanoramaTileRequest) domaindto.GetPanoramaTileInput {
+	return domaindto.GetPanoramaTileInput{
+		Token: req.Token,
+		Level: req.Level,
+		Tile:  req.Tile,
+	}
+}
+
+// DTOToArrangeGalleryInput converts the HTTP request to the service input.
+func DTOToArrangeGalleryInput(req dto.ArrangeMediaGalleryRequest) domaindto.ArrangeGalleryInput {
+	items := make([]domaindto.ArrangeGalleryItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		items = append(items, domaindto.ArrangeGalleryItem{
+			AssetID:       item.AssetID,
+			Cover:         item.Cover,
+			RoomTag:       mediaprocessingmodel.MediaRoomTag(item.RoomTag),
+			RoomFeatureID: item.RoomFeatureID,
+		})
+	}
+	return domaindto.ArrangeGalleryInput{
+		ListingIdentityID: int64(req.ListingIdentityID),
+		Revision:          req.Revision,
+		Items:             items,
+	}
+}
+
+// GalleryCoversToDTO converts the cover photos of a listing.
+func GalleryCoversToDTO(covers []domaindto.MediaGalleryCover) []dto.MediaGalleryCoverResponse {
+	if len(covers) == 0 {
+		return nil
+	}
+	result := make([]dto.MediaGalleryCoverResponse, 0, len(covers))
+	for _, cover := range covers {
+		result = append(result, dto.MediaGalleryCoverResponse{
+			AssetID:   cover.AssetID,
+			AssetType: string(cover.AssetType),
+			Title:     cover.Title,
+			URL:       cover.URL,
+			RoomTag:   roomTagToDTO(cover.Placement),
+			SrcSets:   srcSetsToDTO(cover.SrcSets),
+		})
+	}
+	return result
+}
+
+func roomTagToDTO(placement mediaprocessingmodel.GalleryPlacement) *dto.MediaRoomTagResponse {
+	if placement.RoomTag == "" {
+		return nil
+	}
+	return &dto.MediaRoomTagResponse{
+		Tag:       string(placement.RoomTag),
+		FeatureID: placement.RoomFeatureID,
+		Label:     placement.RoomLabel,
+	}
+}
+
+func panoramaToDTO(panoramas map[uint64]domaindto.MediaPanorama, assetID uint64) *dto.MediaPanoramaResponse {
+	panorama, ok := panoramas[assetID]
+	if !ok {
+		return nil
+	}
+	levels := make([]dto.MediaPanoramaLevelResponse, 0, len(panorama.Tiles.Levels))
+	for _, level := range panorama.Tiles.Levels {
+		levels = append(levels, dto.MediaPanoramaLevelResponse{Width: level.Width, Cols: level.Cols, Rows: level.Rows})
+	}
+	return &dto.MediaPanoramaResponse{
+		Width:            panorama.Tiles.Width,
+		Height:           panorama.Tiles.Height,
+		TileSize:         panorama.Tiles.TileSize,
+		Levels:           levels,
+		TileURLTemplate:  panorama.TileURLTemplate,
+		TileURLExpiresAt: panorama.TileURLExpiresAt.UTC().Format(time.RFC3339),
+		PreviewURL:       panorama.PreviewURL,
+	}
+}
+
+// DTOToSaveMediaTourInput converts the tour request to the service input.
+func DTOToSaveMediaTourInput(req dto.SaveMediaTourRequest) domaindto.SaveMediaTourInput {
+	nodes := make([]mediaprocessingmodel.MediaTourNode, 0, len(req.Nodes))
+	for _, node := range req.Nodes {
+		hotspots := make([]mediaprocessingmodel.MediaTourHotspot, 0, len(node.Hotspots))
+		for _, hotspot := range node.Hotspots {
+			hotspots = append(hotspots, mediaprocessingmodel.MediaTourHotspot{
+				TargetAssetID: hotspot.TargetAssetID,
+				Yaw:           hotspot.Yaw,
+				Pitch:         hotspot.Pitch,
+				Label:         hotspot.Label,
+			})
+		}
+		nodes = append(nodes, mediaprocessingmodel.MediaTourNode{
+			AssetID:      node.AssetID,
+			Label:        node.Label,
+			InitialYaw:   node.InitialYaw,
+			InitialPitch: node.InitialPitch,
+			Hotspots:     hotspots,
+		})
+	}
+	return domaindto.SaveMediaTourInput{
+		ListingIdentityID: int64(req.ListingIdentityID),
+		StartAssetID:      req.StartAssetID,
+		Nodes:             nodes,
+	}
+}
+
+// MediaTourToDTO converts a saved tour; nil when the listing has no tour.
+func MediaTourToDTO(tour *mediaprocessingmodel.MediaTour) *dto.MediaTourResponse {
+	if tour == nil || len(tour.Nodes) == 0 {
+		return nil
+	}
+	nodes := make([]dto.MediaTourNodeResponse, 0, len(tour.Nodes))
+	for _, node := range tour.Nodes {
+		var hotspots []dto.MediaTourHotspotResponse
+		for _, hotspot := range node.Hotspots {
+			hotspots = append(hotspots, dto.MediaTourHotspotResponse{
+				TargetAssetID: hotspot.TargetAssetID,
+				Yaw:           hotspot.Yaw,
+				Pitch:         hotspot.Pitch,
+				Label:         hotspot.Label,
+			})
+		}
+		nodes = append(nodes, dto.MediaTourNodeResponse{
+			AssetID:      node.AssetID,
+			Label:        node.Label,
+			InitialYaw:   node.InitialYaw,
+			InitialPitch: node.InitialPitch,
+			Hotspots:     hotspots,
+		})
+	}
+	updatedAt := ""
+	if !tour.UpdatedAt.IsZero() {
+		updatedAt = tour.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+	return &dto.MediaTourResponse{
+		StartAssetID: tour.StartAssetID,
+		Nodes:        nodes,
+		UpdatedAt:    updatedAt,
+	}
+}
+
+func parcelToDTO(parcel *mediaprocessingmodel.LandParcel) *dto.MediaParcelResponse {
+	if parcel == nil {
+		return nil
+	}
+	toCoordinates := func(ring []mediaprocessingmodel.ParcelCoordinate) []dto.MediaParcelCoordinateResponse {
+		coordinates := make([]dto.MediaParcelCoordinateResponse, 0, len(ring))
+		for _, point := range ring {
+			coordinates = append(coordinates, dto.MediaParcelCoordinateResponse{Lat: point.Lat, Lng: point.Lng})
+		}
+		return coordinates
+	}
+
+	response := &dto.MediaParcelResponse{
+		AssetID:     parcel.AssetID,
+		AreaM2:      parcel.AreaM2,
+		PerimeterM:  parcel.PerimeterM,
+		Centroid:    dto.MediaParcelCoordinateResponse{Lat: parcel.Centroid.Lat, Lng: parcel.Centroid.Lng},
+		Polygon:     dto.MediaParcelPolygonResponse{Outer: toCoordinates(parcel.Polygon.Outer)},
+		Divergences: make([]dto.MediaParcelDivergence, 0, len(parcel.Divergences)),
+	}
+	for _, hole := range parcel.Polygon.Holes {
+		response.Polygon.Holes = append(response.Polygon.Holes, toCoordinates(hole))
+	}
+	for _, divergence := range parcel.Divergences {
+		response.Divergences = append(response.Divergences, dto.MediaParcelDivergence{
+			Field:      string(divergence.Field),
+			Declared:   divergence.Declared,
+			Computed:   divergence.Computed,
+			Difference: divergence.Difference,
+		})
+	}
+	if !parcel.UpdatedAt.IsZero() {
+		response.UpdatedAt = parcel.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+	return response
+}
+
+// ProcessingJobsToDTO converts a page of the admin job dashboard.
+func ProcessingJobsToDTO(output domaindto.ListProcessingJobsOutput) dto.AdminMediaJobsListResponse {
+	response := dto.AdminMediaJobsListResponse{
+		Jobs: make([]dto.AdminMediaJobResponse, 0, len(output.Jobs)),
+		Pagination: dto.PaginationResponse{
+			Page:  output.Page,
+			Limit: output.Limit,
+			Total: output.Total,
+		},
+	}
+	if output.Limit > 0 {
+		response.Pagination.TotalPages = int((output.Total + int64(output.Limit) - 1) / int64(output.Limit))
+	}
+	for _, summary := range output.Jobs {
+		item := processingJobToDTO(summary.Job)
+		item.TotalAssets = summary.TotalAssets
+		item.FailedAssets = summary.FailedAssets
+		response.Jobs = append(response.Jobs, item)
+	}
+	return response
+}
+
+// ProcessingJobDetailToDTO converts a job with the outcome of each asset.
+func ProcessingJobDetailToDTO(output domaindto.ProcessingJobDetailOutput) dto.AdminMediaJobDetailResponse {
+	response := dto.AdminMediaJobDetailResponse{
+		Job:    processingJobToDTO(output.Job),
+		Assets: make([]dto.AdminMediaJobAssetResponse, 0, len(output.Assets)),
+	}
+	for _, asset := range output.Assets {
+		response.Job.TotalAssets++
+		if asset.Failed {
+			response.Job.FailedAssets++
+		}
+		response.Assets = append(response.Assets, dto.AdminMediaJobAssetResponse{
+			AssetID:      asset.AssetID,
+			AssetType:    string(asset.AssetType),
+			Sequence:     asset.Sequence,
+			RawKey:       asset.RawKey,
+			Status:       string(asset.Status),
+			Failed:       asset.Failed,
+			ErrorCode:    asset.ErrorCode,
+			ErrorMessage: asset.ErrorMessage,
+		})
+	}
+	return response
+}
+
+func processingJobToDTO(job mediaprocessingmodel.MediaProcessingJob) dto.AdminMediaJobResponse {
+	response := dto.AdminMediaJobResponse{
+		ID:                job.ID(),
+		ListingIdentityID: job.ListingIdentityID(),
+		Status:            string(job.Status()),
+		Provider:          string(job.Provider()),
+		ExecutionARN:      job.ExternalID(),
+		RetryCount:        job.RetryCount(),
+		LastError:         job.LastError(),
+	}
+	if !job.CreatedAt().IsZero() {
+		response.CreatedAt = job.CreatedAt().UTC().Format(time.RFC3339)
+	}
+	if startedAt := job.StartedAt(); startedAt != nil {
+		formatted := startedAt.UTC().Format(time.RFC3339)
+		response.StartedAt = &formatted
+	}
+	if completedAt := job.CompletedAt(); completedAt != nil {
+		formatted := completedAt.UTC().Format(time.RFC3339)
+		response.CompletedAt = &formatted
+	}
+	return response
+}
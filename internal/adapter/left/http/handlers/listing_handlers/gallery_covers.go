@@ -0,0 +1,49 @@
+package listinghandlers
+
+import (
+	"context"
+
+	dto "github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	mediaconverters "github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/listing_handlers/converters"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// attachGalleryCovers enriches listing summaries with the cover photos chosen in the media gallery.
+// Covers are optional decoration: failures are logged and the listings are returned without them.
+func (lh *ListingHandler) attachGalleryCovers(ctx context.Context, listings []dto.ListingResponse) {
+	if lh.mediaProcessingService == nil || len(listings) == 0 {
+		return
+	}
+
+	ids := make([]int64, 0, len(listings))
+	for _, listing := range listings {
+		ids = append(ids, listing.ListingIdentityID)
+	}
+
+	covers, err := lh.mediaProcessingService.ListGalleryCovers(ctx, ids)
+	if err != nil {
+		logger := coreutils.LoggerFromContext(ctx)
+		logger.Warn("listing.list.gallery_covers_error", "listings", len(ids), "err", err)
+		return
+	}
+
+	for i := range listings {
+		listings[i].Covers = mediaconverters.GalleryCoversToDTO(covers[listings[i].ListingIdentityID])
+	}
+}
+
+// attachDetailGalleryCovers sets the gallery covers of a single listing detail response.
+func (lh *ListingHandler) attachDetailGalleryCovers(ctx context.Context, detail *dto.ListingDetailResponse) {
+	if lh.mediaProcessingService == nil || detail == nil || detail.ListingIdentityID <= 0 {
+		return
+	}
+
+	covers, err := lh.mediaProcessingService.ListGalleryCovers(ctx, []int64{detail.ListingIdentityID})
+	if err != nil {
+		logger := coreutils.LoggerFromContext(ctx)
+		logger.Warn("listing.detail.gallery_covers_error", "listing_identity_id", detail.ListingIdentityID, "err", err)
+		return
+	}
+
+	detail.Covers = mediaconverters.GalleryCoversToDTO(covers[detail.ListingIdentityID])
+}
@@ -46,6 +46,7 @@ func (lh *ListingHandler) GetFavoriteListings(c *gin.Context) {
 	for _, item := range result.Items {
 		data = append(data, toListingResponse(item))
 	}
+	lh.attachGalleryCovers(ctx, data)
 
 	resp := dto.ListListingsResponse{
 		Data: data,
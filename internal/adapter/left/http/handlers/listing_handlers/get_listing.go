@@ -101,6 +101,7 @@ func (lh *ListingHandler) GetListing(c *gin.Context) {
 
 	// Convert service output to DTO response
 	response := converters.ListingDetailToDTO(detail)
+	lh.attachDetailGalleryCovers(ctx, &response)
 	c.JSON(http.StatusOK, response)
 }
 
@@ -247,6 +247,7 @@ func (lh *ListingHandler) ListListings(c *gin.Context) {
 	for _, item := range result.Items {
 		data = append(data, toListingResponse(item))
 	}
+	lh.attachGalleryCovers(ctx, data)
 
 	// Build response with pagination metadata
 	resp := dto.ListListingsResponse{
@@ -29,11 +29,11 @@ const (
 //	@Param     durationMinutes query int    false "Slot duration in minutes (defaults to configured duration)" minimum(30) maximum(240) Extensions(x-example=120)
 //	@Param     page      query    int    false "Page number" default(1)
 //	@Param     size      query    int    false "Page size" default(20)
-//	@Param     sort      query    string false "Sort order" Enums(start_asc,start_desc,photographer_asc,photographer_desc,date_asc,date_desc) default(start_asc)
+//	@Param     sort      query    string false "Sort order; rank orders each day by photographer KPI score and is rejected when photographers are assigned automatically" Enums(start_asc,start_desc,photographer_asc,photographer_desc,date_asc,date_desc,rank) default(start_asc)
 //	@Param     listingIdentityId query    int    true  "Listing identifier" Extensions(x-example=1024)
 //	@Param     timezone  query    string true  "Listing timezone" Extensions(x-example=America/Sao_Paulo)
 //	@Success   200 {object} dto.ListPhotographerSlotsResponse
-//	@Failure   400 {object} dto.ErrorResponse "Invalid filters"
+//	@Failure   400 {object} dto.ErrorResponse "Invalid filters or sort rank with automatic assignment"
 //	@Failure   401 {object} dto.ErrorResponse "Unauthorized"
 //	@Failure   403 {object} dto.ErrorResponse "Forbidden"
 //	@Failure   500 {object} dto.ErrorResponse "Internal error"
@@ -4,6 +4,7 @@ import (
 	listinghandlerport "github.com/projeto-toq/toq_server/internal/core/port/left/http/listinghandler"
 	globalservice "github.com/projeto-toq/toq_server/internal/core/service/global_service"
 	listingservice "github.com/projeto-toq/toq_server/internal/core/service/listing_service"
+	mediaprocessingservice "github.com/projeto-toq/toq_server/internal/core/service/media_processing_service"
 	propertycoverageservice "github.com/projeto-toq/toq_server/internal/core/service/property_coverage_service"
 	userservices "github.com/projeto-toq/toq_server/internal/core/service/user_service"
 )
@@ -14,6 +15,7 @@ type ListingHandler struct {
 	globalService           globalservice.GlobalServiceInterface
 	userService             userservices.UserServiceInterface
 	propertyCoverageService propertycoverageservice.PropertyCoverageServiceInterface
+	mediaProcessingService  mediaprocessingservice.MediaProcessingServiceInterface
 	config                  ListingHandlerConfig
 }
 
@@ -30,6 +32,7 @@ func NewListingHandlerAdapter(
 	globalService globalservice.GlobalServiceInterface,
 	userService userservices.UserServiceInterface,
 	propertyCoverageService propertycoverageservice.PropertyCoverageServiceInterface,
+	mediaProcessingService mediaprocessingservice.MediaProcessingServiceInterface,
 	config ListingHandlerConfig,
 ) listinghandlerport.ListingHandlerPort {
 	return &ListingHandler{
@@ -37,6 +40,7 @@ func NewListingHandlerAdapter(
 		globalService:           globalService,
 		userService:             userService,
 		propertyCoverageService: propertyCoverageService,
+		mediaProcessingService:  mediaProcessingService,
 		config:                  config,
 	}
 }
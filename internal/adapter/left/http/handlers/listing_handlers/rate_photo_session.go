@@ -0,0 +1,67 @@
+package listinghandlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/middlewares"
+	listingservices "github.com/projeto-toq/toq_server/internal/core/service/listing_service"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// RatePhotoSession registra a avaliação do proprietário para o fotógrafo da última sessão concluída do imóvel.
+//
+//	@Summary     Rate the photographer of the latest photo session
+//	@Description Stores a 1-5 star rating (and optional comment) for the photographer of the listing's latest completed session. Allowed once, after the owner approved the media. Ratings feed the photographer KPIs used to rank slots.
+//	@Tags        Listing Photo Sessions
+//	@Accept      json
+//	@Produce     json
+//	@Param       request body      dto.RatePhotoSessionRequest true "Rating payload" Extensions(x-example={"listingIdentityId":1024,"rating":5,"comment":"Fotos excelentes e entrega rápida"})
+//	@Success     200     {object} dto.RatePhotoSessionResponse "Rating recorded"
+//	@Failure     400     {object} dto.ErrorResponse "Invalid payload"
+//	@Failure     401     {object} dto.ErrorResponse "Unauthorized"
+//	@Failure     403     {object} dto.ErrorResponse "Listing does not belong to user"
+//	@Failure     404     {object} dto.ErrorResponse "Listing or completed photo session not found"
+//	@Failure     409     {object} dto.ErrorResponse "Media not approved yet or session already rated"
+//	@Failure     422     {object} dto.ErrorResponse "Validation error"
+//	@Failure     500     {object} dto.ErrorResponse "Internal error"
+//	@Router      /listings/photo-session/rating [post]
+//	@Security    BearerAuth
+func (lh *ListingHandler) RatePhotoSession(c *gin.Context) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+	ctx, spanEnd, err := coreutils.GenerateTracer(baseCtx)
+	if err != nil {
+		httperrors.SendHTTPError(c, http.StatusInternalServerError, "TRACER_ERROR", "Failed to generate tracer")
+		return
+	}
+	defer spanEnd()
+
+	if _, ok := middlewares.GetUserInfoFromContext(c); !ok {
+		httperrors.SendHTTPError(c, http.StatusInternalServerError, "INTERNAL_CONTEXT_MISSING", "User context not found")
+		return
+	}
+
+	var request dto.RatePhotoSessionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		httperrors.SendHTTPErrorObj(c, httperrors.ConvertBindError(err))
+		return
+	}
+
+	output, err := lh.listingService.RatePhotoSession(ctx, listingservices.RatePhotoSessionInput{
+		ListingIdentityID: request.ListingIdentityID,
+		Rating:            request.Rating,
+		Comment:           request.Comment,
+	})
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.RatePhotoSessionResponse{
+		PhotoSessionID: output.PhotoSessionID,
+		PhotographerID: output.PhotographerID,
+		Rating:         output.Rating,
+	})
+}
@@ -0,0 +1,82 @@
+package listinghandlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/middlewares"
+	listingservices "github.com/projeto-toq/toq_server/internal/core/service/listing_service"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ReschedulePhotoSession move uma sessão de fotos para outro slot sem liberar a reserva atual antes da troca.
+//
+//	@Summary     Reschedule a booked photo session
+//	@Description Atomically swaps the session to another slot (possibly another photographer). Keeps the same photoSessionId, enforces the configured cut-off before the current start and the per-booking reschedule limit.
+//	@Tags        Listing Photo Sessions
+//	@Accept      json
+//	@Produce     json
+//	@Param       request body      dto.ReschedulePhotoSessionRequest true "Reschedule payload" Extensions(x-example={"photoSessionId":3003,"slotId":2010,"reason":"Proprietário viajando"})
+//	@Success     200     {object} dto.ReschedulePhotoSessionResponse "Session rescheduled"
+//	@Failure     400     {object} dto.ErrorResponse "Invalid payload"
+//	@Failure     401     {object} dto.ErrorResponse "Unauthorized"
+//	@Failure     403     {object} dto.ErrorResponse "Forbidden"
+//	@Failure     404     {object} dto.ErrorResponse "Photo session not found"
+//	@Failure     409     {object} dto.ErrorResponse "Slot unavailable, session not reschedulable, cut-off passed or limit reached"
+//	@Failure     422     {object} dto.ErrorResponse "Validation error"
+//	@Failure     500     {object} dto.ErrorResponse "Internal error"
+//	@Router      /listings/photo-session/reschedule [post]
+//	@Security    BearerAuth
+func (lh *ListingHandler) ReschedulePhotoSession(c *gin.Context) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+	ctx, spanEnd, err := coreutils.GenerateTracer(baseCtx)
+	if err != nil {
+		httperrors.SendHTTPError(c, http.StatusInternalServerError, "TRACER_ERROR", "Failed to generate tracer")
+		return
+	}
+	defer spanEnd()
+
+	if _, ok := middlewares.GetUserInfoFromContext(c); !ok {
+		httperrors.SendHTTPError(c, http.StatusInternalServerError, "INTERNAL_CONTEXT_MISSING", "User context not found")
+		return
+	}
+
+	var request dto.ReschedulePhotoSessionRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		httperrors.SendHTTPError(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid reschedule payload")
+		return
+	}
+
+	if request.PhotoSessionID == 0 || request.SlotID == 0 {
+		httperrors.SendHTTPError(c, http.StatusBadRequest, "INVALID_REQUEST", "photoSessionId and slotId are required")
+		return
+	}
+
+	output, err := lh.listingService.ReschedulePhotoSession(ctx, listingservices.ReschedulePhotoSessionInput{
+		PhotoSessionID: request.PhotoSessionID,
+		SlotID:         request.SlotID,
+		Reason:         request.Reason,
+	})
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ReschedulePhotoSessionResponse{
+		PhotoSessionID:  output.PhotoSessionID,
+		SlotID:          output.SlotID,
+		SlotStart:       output.SlotStart.UTC().Format(time.RFC3339),
+		SlotEnd:         output.SlotEnd.UTC().Format(time.RFC3339),
+		Status:          string(output.Status),
+		RescheduleCount: output.RescheduleCount,
+		Photographer: dto.PhotographerResponse{
+			ID:          output.Photographer.ID,
+			FullName:    output.Photographer.FullName,
+			PhoneNumber: output.Photographer.PhoneNumber,
+			PhotoURL:    output.Photographer.PhotoURL,
+		},
+	})
+}
@@ -56,6 +56,7 @@ func (lh *ListingHandler) ReservePhotoSession(c *gin.Context) {
 	input := listingservices.ReservePhotoSessionInput{
 		ListingIdentityID: request.ListingIdentityID,
 		SlotID:            request.SlotID,
+		SessionType:       request.SessionType,
 	}
 
 	output, err := lh.listingService.ReservePhotoSession(ctx, input)
@@ -75,5 +76,6 @@ func (lh *ListingHandler) ReservePhotoSession(c *gin.Context) {
 			PhoneNumber: output.Photographer.PhoneNumber,
 			PhotoURL:    output.Photographer.PhotoURL,
 		},
+		AutoAssigned: output.AutoAssigned,
 	})
 }
@@ -0,0 +1,66 @@
+package mediaprocessinghandlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/listing_handlers/converters"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	httputils "github.com/projeto-toq/toq_server/internal/adapter/left/http/utils"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ArrangeGallery reorders the listing gallery and sets covers and room tags in one call.
+//
+// @Summary     Arrange listing media gallery
+// @Description Reorders assets (the order of the items of each asset type becomes their sequence), picks one cover per photo orientation and tags photos by room (listing feature, FACADE or COMMON_AREA). Every asset of an arranged type must be listed. The request must carry the galleryRevision returned by GET /listings/media; a stale revision returns 409 with the current one.
+// @Tags        Listings Media
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       request body dto.ArrangeMediaGalleryRequest true "Gallery arrangement"
+// @Success     200 {object} dto.ArrangeMediaGalleryResponse "Gallery arranged"
+// @Failure     400 {object} dto.ErrorResponse "Invalid arrangement"
+// @Failure     401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure     403 {object} dto.ErrorResponse "Not the listing owner"
+// @Failure     404 {object} dto.ErrorResponse "Listing not found"
+// @Failure     409 {object} dto.ErrorResponse "Gallery changed since it was loaded"
+// @Failure     500 {object} dto.ErrorResponse "Internal server error"
+// @Router      /listings/media/gallery [post]
+func (h *MediaProcessingHandler) ArrangeGallery(c *gin.Context) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+	ctx, spanEnd, err := coreutils.GenerateTracer(baseCtx)
+	if err != nil {
+		httperrors.SendHTTPError(c, http.StatusInternalServerError, "TRACER_ERROR", "Failed to generate tracer")
+		return
+	}
+	defer spanEnd()
+
+	userInfo, err := coreutils.GetUserInfoFromGinContext(c)
+	if err != nil {
+		httperrors.SendHTTPError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User info not found in context")
+		return
+	}
+
+	var request dto.ArrangeMediaGalleryRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		httperrors.SendHTTPErrorObj(c, httputils.MapBindingError(err))
+		return
+	}
+
+	input := converters.DTOToArrangeGalleryInput(request)
+	input.RequestedBy = uint64(userInfo.ID)
+	input.RequesterRole = userInfo.RoleSlug
+
+	output, err := h.service.ArrangeGallery(ctx, input)
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ArrangeMediaGalleryResponse{
+		ListingIdentityID: uint64(output.ListingIdentityID),
+		Revision:          output.Revision,
+	})
+}
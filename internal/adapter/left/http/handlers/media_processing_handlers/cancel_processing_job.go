@@ -0,0 +1,62 @@
+package mediaprocessinghandlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	httputils "github.com/projeto-toq/toq_server/internal/adapter/left/http/utils"
+	domaindto "github.com/projeto-toq/toq_server/internal/core/domain/dto"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// CancelProcessingJob stops an unfinished media processing job.
+//
+// @Summary     Cancel media processing job
+// @Description Stops the workflow execution of a PENDING/RUNNING job when its ARN is known and closes the job as CANCELLED; a callback arriving later is ignored. Assets still PROCESSING on the listing become FAILED and can be retried.
+// @Tags        Admin Media
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       request body dto.AdminMediaJobCancelRequest true "Job and optional reason"
+// @Success     200 {object} dto.APIResponse "Job cancelled"
+// @Failure     400 {object} dto.ErrorResponse "Invalid request"
+// @Failure     401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure     403 {object} dto.ErrorResponse "Forbidden"
+// @Failure     404 {object} dto.ErrorResponse "Job not found"
+// @Failure     409 {object} dto.ErrorResponse "Job already finished"
+// @Failure     500 {object} dto.ErrorResponse "Internal server error"
+// @Router      /admin/media/jobs/cancel [post]
+func (h *MediaProcessingHandler) CancelProcessingJob(c *gin.Context) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+	ctx, spanEnd, err := coreutils.GenerateTracer(baseCtx)
+	if err != nil {
+		httperrors.SendHTTPError(c, http.StatusInternalServerError, "TRACER_ERROR", "Failed to generate tracer")
+		return
+	}
+	defer spanEnd()
+
+	userInfo, err := coreutils.GetUserInfoFromGinContext(c)
+	if err != nil {
+		httperrors.SendHTTPError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User info not found in context")
+		return
+	}
+
+	var request dto.AdminMediaJobCancelRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		httperrors.SendHTTPErrorObj(c, httputils.MapBindingError(err))
+		return
+	}
+
+	if err := h.service.CancelProcessingJob(ctx, domaindto.CancelProcessingJobInput{
+		JobID:       request.JobID,
+		Reason:      request.Reason,
+		RequestedBy: uint64(userInfo.ID),
+	}); err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse(gin.H{"status": "cancelled"}))
+}
@@ -0,0 +1,62 @@
+package mediaprocessinghandlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	httputils "github.com/projeto-toq/toq_server/internal/adapter/left/http/utils"
+	domaindto "github.com/projeto-toq/toq_server/internal/core/domain/dto"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ForceCompleteProcessingJob accepts the processed assets of a partially successful job.
+//
+// @Summary     Force-complete media processing job
+// @Description Marks the assets of a PARTIAL_SUCCESS processing job that are FAILED now as DISCARDED (hidden from listing and gallery, raw uploads kept) and closes the job as SUCCEEDED, so the listing media can be completed with the processed assets.
+// @Tags        Admin Media
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       request body dto.AdminMediaJobForceCompleteRequest true "Job identifier"
+// @Success     200 {object} dto.AdminMediaJobForceCompleteResponse
+// @Failure     400 {object} dto.ErrorResponse "Invalid request"
+// @Failure     401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure     403 {object} dto.ErrorResponse "Forbidden"
+// @Failure     404 {object} dto.ErrorResponse "Job not found"
+// @Failure     409 {object} dto.ErrorResponse "Job is not a PARTIAL_SUCCESS processing job"
+// @Failure     500 {object} dto.ErrorResponse "Internal server error"
+// @Router      /admin/media/jobs/force-complete [post]
+func (h *MediaProcessingHandler) ForceCompleteProcessingJob(c *gin.Context) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+	ctx, spanEnd, err := coreutils.GenerateTracer(baseCtx)
+	if err != nil {
+		httperrors.SendHTTPError(c, http.StatusInternalServerError, "TRACER_ERROR", "Failed to generate tracer")
+		return
+	}
+	defer spanEnd()
+
+	userInfo, err := coreutils.GetUserInfoFromGinContext(c)
+	if err != nil {
+		httperrors.SendHTTPError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User info not found in context")
+		return
+	}
+
+	var request dto.AdminMediaJobForceCompleteRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		httperrors.SendHTTPErrorObj(c, httputils.MapBindingError(err))
+		return
+	}
+
+	output, err := h.service.ForceCompleteProcessingJob(ctx, domaindto.ForceCompleteProcessingJobInput{
+		JobID:       request.JobID,
+		RequestedBy: uint64(userInfo.ID),
+	})
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.AdminMediaJobForceCompleteResponse{DiscardedAssetIDs: output.DiscardedAssetIDs})
+}
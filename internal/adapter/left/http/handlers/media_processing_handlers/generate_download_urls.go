@@ -14,7 +14,7 @@ import (
 // GenerateDownloadURLs generates signed download URLs for specific assets
 //
 //	@Summary		Generate signed download URLs
-//	@Description	Generates time-limited signed S3 URLs for specific assets. Supports multiple assets/resolutions, including the final ZIP bundle (assetType=ZIP, resolution="zip" ignored). Valid resolutions: thumbnail (200px), small (400px), medium (800px), large (1200px), original, zip.
+//	@Description	Generates time-limited signed S3 URLs for specific assets. Supports multiple assets/resolutions, including the final ZIP bundle (assetType=ZIP, resolution="zip" ignored). Valid resolutions: thumbnail (200px), small (400px), medium (800px), large (1200px), original, zip, hls (signed HLS master playlist of processed videos) and poster (HLS poster frame). Photos accept an optional format (jpeg, webp, avif).
 //	@Tags			Listings Media
 //	@Accept			json
 //	@Produce		json
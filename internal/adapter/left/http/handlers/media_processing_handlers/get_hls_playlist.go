@@ -0,0 +1,48 @@
+package mediaprocessinghandlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/listing_handlers/converters"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	httputils "github.com/projeto-toq/toq_server/internal/adapter/left/http/utils"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+const hlsPlaylistContentType = "application/vnd.apple.mpegurl"
+
+// GetHLSPlaylist serves the HLS playlists of a listing video with signed segment URLs.
+// The endpoint is public: access is granted by the signed token returned by the download endpoint.
+//
+//	@Summary		Get signed HLS playlist
+//	@Description	Returns the master playlist (master.m3u8) or a rendition playlist ({rendition}.m3u8) of a processed video. Rendition entries of the master are relative to the same token; segment entries are pre-signed storage URLs. Obtain the master URL via POST /listings/media/download with resolution "hls".
+//	@Tags			Listings Media
+//	@Produce		application/vnd.apple.mpegurl
+//	@Param			token		path		string	true	"Signed stream token"
+//	@Param			playlist	path		string	true	"master.m3u8 or {rendition}.m3u8"	example(master.m3u8)
+//	@Success		200			{string}	string	"HLS playlist"
+//	@Failure		403			{object}	dto.ErrorResponse	"Invalid or expired token"
+//	@Failure		404			{object}	dto.ErrorResponse	"Stream not found"
+//	@Failure		500			{object}	dto.ErrorResponse	"Internal server error"
+//	@Router			/listings/media/hls/{token}/{playlist} [get]
+func (h *MediaProcessingHandler) GetHLSPlaylist(c *gin.Context) {
+	ctx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	var request dto.HLSPlaylistRequest
+	if err := c.ShouldBindUri(&request); err != nil {
+		httperrors.SendHTTPErrorObj(c, httputils.MapBindingError(err))
+		return
+	}
+
+	output, err := h.service.GetHLSPlaylist(ctx, converters.DTOToGetHLSPlaylistInput(request))
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", fmt.Sprintf("private, max-age=%d", int(output.MaxAge.Seconds())))
+	c.Data(http.StatusOK, hlsPlaylistContentType, []byte(output.Content))
+}
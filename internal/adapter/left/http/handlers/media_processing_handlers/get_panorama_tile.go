@@ -0,0 +1,46 @@
+package mediaprocessinghandlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/listing_handlers/converters"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	httputils "github.com/projeto-toq/toq_server/internal/adapter/left/http/utils"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// GetPanoramaTile redirects to the pre-signed storage URL of one tile of a 360° panorama.
+// The endpoint is public: access is granted by the signed token embedded in the tile URL template.
+//
+//	@Summary		Get 360° panorama tile
+//	@Description	Redirects (302) to a pre-signed URL of the tile {row}_{col}.jpg of the given pyramid level. Build the path from panorama.tileUrlTemplate returned by GET /listings/media; levels, rows and columns are described in panorama.levels.
+//	@Tags			Listings Media
+//	@Param			token	path	string	true	"Signed panorama token"
+//	@Param			level	path	int		true	"Pyramid level index (0 = lowest resolution)"	example(1)
+//	@Param			tile	path	string	true	"{row}_{col}.jpg"								example(2_5.jpg)
+//	@Success		302		"Redirect to the tile"
+//	@Failure		403		{object}	dto.ErrorResponse	"Invalid or expired token"
+//	@Failure		404		{object}	dto.ErrorResponse	"Tile not found"
+//	@Failure		500		{object}	dto.ErrorResponse	"Internal server error"
+//	@Router			/listings/media/panorama/{token}/{level}/{tile} [get]
+func (h *MediaProcessingHandler) GetPanoramaTile(c *gin.Context) {
+	ctx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	var request dto.PanoramaTileRequest
+	if err := c.ShouldBindUri(&request); err != nil {
+		httperrors.SendHTTPErrorObj(c, httputils.MapBindingError(err))
+		return
+	}
+
+	output, err := h.service.GetPanoramaTile(ctx, converters.DTOToGetPanoramaTileInput(request))
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.Header("Cache-Control", fmt.Sprintf("private, max-age=%d", int(output.MaxAge.Seconds())))
+	c.Redirect(http.StatusFound, output.URL)
+}
@@ -0,0 +1,53 @@
+package mediaprocessinghandlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/listing_handlers/converters"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	httputils "github.com/projeto-toq/toq_server/internal/adapter/left/http/utils"
+	domaindto "github.com/projeto-toq/toq_server/internal/core/domain/dto"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// GetProcessingJob returns a media processing job with the outcome of each asset.
+//
+// @Summary     Get media processing job detail
+// @Description Returns the job and, for each asset reported by its callback, the error code and message next to the current asset status.
+// @Tags        Admin Media
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       request body dto.AdminMediaJobDetailRequest true "Job identifier"
+// @Success     200 {object} dto.AdminMediaJobDetailResponse
+// @Failure     400 {object} dto.ErrorResponse "Invalid request"
+// @Failure     401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure     403 {object} dto.ErrorResponse "Forbidden"
+// @Failure     404 {object} dto.ErrorResponse "Job not found"
+// @Failure     500 {object} dto.ErrorResponse "Internal server error"
+// @Router      /admin/media/jobs/detail [post]
+func (h *MediaProcessingHandler) GetProcessingJob(c *gin.Context) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+	ctx, spanEnd, err := coreutils.GenerateTracer(baseCtx)
+	if err != nil {
+		httperrors.SendHTTPError(c, http.StatusInternalServerError, "TRACER_ERROR", "Failed to generate tracer")
+		return
+	}
+	defer spanEnd()
+
+	var request dto.AdminMediaJobDetailRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		httperrors.SendHTTPErrorObj(c, httputils.MapBindingError(err))
+		return
+	}
+
+	output, err := h.service.GetProcessingJob(ctx, domaindto.GetProcessingJobInput{JobID: request.JobID})
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, converters.ProcessingJobDetailToDTO(output))
+}
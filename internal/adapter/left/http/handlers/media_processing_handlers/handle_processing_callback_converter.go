@@ -7,7 +7,6 @@ import (
 	httpdto "github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
 	"github.com/projeto-toq/toq_server/internal/core/derrors"
 	"github.com/projeto-toq/toq_server/internal/core/domain/dto"
-	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
 	"github.com/projeto-toq/toq_server/internal/core/utils"
 )
 
@@ -58,11 +57,13 @@ func toHandleProcessingCallbackInput(request httpdto.MediaProcessingCallbackRequ
 		Provider:          strings.ToUpper(strings.TrimSpace(request.Provider)),
 		Status:            strings.ToUpper(strings.TrimSpace(request.Status)),
 		ExecutionARN:      executionArn,
-		Results:           mapOutputsToResults(request.Outputs),
+		Results:           dto.ProcessingResultsFromPayloads(request.Outputs),
 		AssetsZipped:      request.AssetsZipped,
 		ZipBundles:        cloneStringSlice(request.ZipBundles),
 		ZipSizeBytes:      request.ZipSizeBytes,
 		UnzippedSizeBytes: request.UnzippedSizeBytes,
+		ZipManifestKey:    strings.TrimSpace(request.ZipManifestKey),
+		ZipSHA256:         strings.TrimSpace(request.ZipSHA256),
 		Error:             payloadError,
 		ErrorCode:         errorCode,
 		ErrorMetadata:     errorMetadata,
@@ -79,43 +80,6 @@ func toHandleProcessingCallbackInput(request httpdto.MediaProcessingCallbackRequ
 	return input, nil
 }
 
-func mapOutputsToResults(outputs []mediaprocessingmodel.MediaProcessingJobPayload) []dto.ProcessingResult {
-	if len(outputs) == 0 {
-		return nil
-	}
-	results := make([]dto.ProcessingResult, 0, len(outputs))
-	for _, output := range outputs {
-		status := "PROCESSED"
-		if output.ErrorCode != "" || output.ErrorMessage != "" {
-			status = "FAILED"
-		}
-
-		var metadata map[string]string
-		if len(output.Outputs) > 0 {
-			metadata = make(map[string]string, len(output.Outputs))
-			for k, v := range output.Outputs {
-				trimmedKey := strings.TrimSpace(k)
-				trimmedValue := strings.TrimSpace(v)
-				if trimmedKey == "" || trimmedValue == "" {
-					continue
-				}
-				metadata[trimmedKey] = trimmedValue
-			}
-		}
-
-		results = append(results, dto.ProcessingResult{
-			RawKey:       output.RawKey,
-			Status:       status,
-			ProcessedKey: output.ProcessedKey,
-			ThumbnailKey: output.ThumbnailKey,
-			Metadata:     metadata,
-			Error:        output.ErrorMessage,
-			ErrorCode:    output.ErrorCode,
-		})
-	}
-	return results
-}
-
 func cloneStringSlice(values []string) []string {
 	if len(values) == 0 {
 		return nil
@@ -0,0 +1,83 @@
+package mediaprocessinghandlers
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/listing_handlers/converters"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	httputils "github.com/projeto-toq/toq_server/internal/adapter/left/http/utils"
+	domaindto "github.com/projeto-toq/toq_server/internal/core/domain/dto"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ListProcessingJobs lists media processing jobs for the admin dashboard.
+//
+// @Summary     List media processing jobs
+// @Description Returns processing and finalization jobs, newest first, filtered by status, provider, listing and age. Each job is the batch of assets sent by one process or retry call; failedAssets counts the failed outputs of its callback.
+// @Tags        Admin Media
+// @Produce     json
+// @Security    BearerAuth
+// @Param       status            query string false "Comma separated statuses (PENDING, RUNNING, SUCCEEDED, PARTIAL_SUCCESS, FAILED, CANCELLED)" Extensions(x-example="FAILED,PARTIAL_SUCCESS")
+// @Param       provider          query string false "Comma separated providers (STEP_FUNCTIONS, LOCAL, STEP_FUNCTIONS_FINALIZATION)" Extensions(x-example="STEP_FUNCTIONS")
+// @Param       listingIdentityId query int    false "Listing identity ID" Extensions(x-example=1024)
+// @Param       olderThanMinutes  query int    false "Only jobs created at least this many minutes ago" Extensions(x-example=30)
+// @Param       page              query int    false "Page number" default(1) Extensions(x-example=1)
+// @Param       limit             query int    false "Page size (max 100)" default(20) Extensions(x-example=20)
+// @Success     200 {object} dto.AdminMediaJobsListResponse
+// @Failure     400 {object} dto.ErrorResponse "Invalid filters"
+// @Failure     401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure     403 {object} dto.ErrorResponse "Forbidden"
+// @Failure     500 {object} dto.ErrorResponse "Internal server error"
+// @Router      /admin/media/jobs [get]
+func (h *MediaProcessingHandler) ListProcessingJobs(c *gin.Context) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+	ctx, spanEnd, err := coreutils.GenerateTracer(baseCtx)
+	if err != nil {
+		httperrors.SendHTTPError(c, http.StatusInternalServerError, "TRACER_ERROR", "Failed to generate tracer")
+		return
+	}
+	defer spanEnd()
+
+	var request dto.AdminMediaJobsListRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		httperrors.SendHTTPErrorObj(c, httputils.MapBindingError(err))
+		return
+	}
+
+	input := domaindto.ListProcessingJobsInput{
+		ListingIdentityID: request.ListingIdentityID,
+		OlderThan:         time.Duration(request.OlderThanMinutes) * time.Minute,
+		Page:              request.Page,
+		Limit:             request.Limit,
+	}
+	for _, status := range splitFilterValues(request.Status) {
+		input.Statuses = append(input.Statuses, mediaprocessingmodel.MediaProcessingJobStatus(status))
+	}
+	for _, provider := range splitFilterValues(request.Provider) {
+		input.Providers = append(input.Providers, mediaprocessingmodel.MediaProcessingProvider(provider))
+	}
+
+	output, err := h.service.ListProcessingJobs(ctx, input)
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, converters.ProcessingJobsToDTO(output))
+}
+
+// splitFilterValues reads a comma separated query filter as upper-case values.
+func splitFilterValues(raw string) []string {
+	var values []string
+	for _, part := range strings.Split(raw, ",") {
+		if value := strings.ToUpper(strings.TrimSpace(part)); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
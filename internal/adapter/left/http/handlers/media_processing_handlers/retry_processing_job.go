@@ -0,0 +1,63 @@
+package mediaprocessinghandlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	httputils "github.com/projeto-toq/toq_server/internal/adapter/left/http/utils"
+	domaindto "github.com/projeto-toq/toq_server/internal/core/domain/dto"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// RetryProcessingJob sends failed assets of a job back to the pipeline.
+//
+// @Summary     Retry media processing job
+// @Description Creates a new job with the assets of a finished processing job that are FAILED now, or only assetId when informed (a FAILED asset of the same listing). The job is published on the retry queue with retryCount incremented.
+// @Tags        Admin Media
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       request body dto.AdminMediaJobRetryRequest true "Job and optional asset"
+// @Success     202 {object} dto.AdminMediaJobRetryResponse "Retry enqueued"
+// @Failure     400 {object} dto.ErrorResponse "Invalid request"
+// @Failure     401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure     403 {object} dto.ErrorResponse "Forbidden"
+// @Failure     404 {object} dto.ErrorResponse "Job or asset not found"
+// @Failure     409 {object} dto.ErrorResponse "Job still running, finalization job or nothing to retry"
+// @Failure     500 {object} dto.ErrorResponse "Internal server error"
+// @Router      /admin/media/jobs/retry [post]
+func (h *MediaProcessingHandler) RetryProcessingJob(c *gin.Context) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+	ctx, spanEnd, err := coreutils.GenerateTracer(baseCtx)
+	if err != nil {
+		httperrors.SendHTTPError(c, http.StatusInternalServerError, "TRACER_ERROR", "Failed to generate tracer")
+		return
+	}
+	defer spanEnd()
+
+	userInfo, err := coreutils.GetUserInfoFromGinContext(c)
+	if err != nil {
+		httperrors.SendHTTPError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User info not found in context")
+		return
+	}
+
+	var request dto.AdminMediaJobRetryRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		httperrors.SendHTTPErrorObj(c, httputils.MapBindingError(err))
+		return
+	}
+
+	output, err := h.service.RetryProcessingJob(ctx, domaindto.RetryProcessingJobInput{
+		JobID:       request.JobID,
+		AssetID:     request.AssetID,
+		RequestedBy: uint64(userInfo.ID),
+	})
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dto.AdminMediaJobRetryResponse{JobID: output.JobID, AssetIDs: output.AssetIDs})
+}
@@ -0,0 +1,65 @@
+package mediaprocessinghandlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/listing_handlers/converters"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	httputils "github.com/projeto-toq/toq_server/internal/adapter/left/http/utils"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// SaveTour replaces the virtual tour linking the 360° panoramas of a listing.
+//
+// @Summary     Save listing virtual tour
+// @Description Replaces the tour graph: each node is a processed PANORAMA_360 asset of the listing with its initial view, and hotspots (yaw/pitch in degrees) link to other nodes of the tour. startAssetId defaults to the first node. Sending an empty node list removes the tour. Deleting a panorama removes it from the tour.
+// @Tags        Listings Media
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       request body dto.SaveMediaTourRequest true "Tour graph"
+// @Success     200 {object} dto.SaveMediaTourResponse "Tour saved"
+// @Failure     400 {object} dto.ErrorResponse "Invalid tour"
+// @Failure     401 {object} dto.ErrorResponse "Unauthorized"
+// @Failure     403 {object} dto.ErrorResponse "Not the listing owner"
+// @Failure     404 {object} dto.ErrorResponse "Listing not found"
+// @Failure     500 {object} dto.ErrorResponse "Internal server error"
+// @Router      /listings/media/tour [post]
+func (h *MediaProcessingHandler) SaveTour(c *gin.Context) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+	ctx, spanEnd, err := coreutils.GenerateTracer(baseCtx)
+	if err != nil {
+		httperrors.SendHTTPError(c, http.StatusInternalServerError, "TRACER_ERROR", "Failed to generate tracer")
+		return
+	}
+	defer spanEnd()
+
+	userInfo, err := coreutils.GetUserInfoFromGinContext(c)
+	if err != nil {
+		httperrors.SendHTTPError(c, http.StatusUnauthorized, "UNAUTHORIZED", "User info not found in context")
+		return
+	}
+
+	var request dto.SaveMediaTourRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		httperrors.SendHTTPErrorObj(c, httputils.MapBindingError(err))
+		return
+	}
+
+	input := converters.DTOToSaveMediaTourInput(request)
+	input.RequestedBy = uint64(userInfo.ID)
+	input.RequesterRole = userInfo.RoleSlug
+
+	tour, err := h.service.SaveTour(ctx, input)
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SaveMediaTourResponse{
+		ListingIdentityID: request.ListingIdentityID,
+		Tour:              converters.MediaTourToDTO(&tour),
+	})
+}
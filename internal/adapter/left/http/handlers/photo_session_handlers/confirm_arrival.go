@@ -0,0 +1,60 @@
+package photosessionhandlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	photosessionservices "github.com/projeto-toq/toq_server/internal/core/service/photo_session_service"
+)
+
+// ConfirmArrival records that the photographer arrived at the session location.
+//
+//	@Summary   Confirm arrival at a photo session
+//	@Description Records the photographer's arrival once per session, from 2 hours before the booked start until its end.
+//	             The arrival counts as on time when confirmed up to the configured grace period after the start; it feeds the photographer KPIs.
+//	@Tags      Photographer
+//	@Accept    json
+//	@Produce   json
+//	@Param     request   body      dto.ConfirmArrivalRequest true "Session the photographer arrived at"
+//	@Success   200       {object}  dto.ConfirmArrivalResponse "Arrival recorded"
+//	@Failure   400       {object}  dto.ErrorResponse  "Invalid payload"
+//	@Failure   401       {object}  dto.ErrorResponse  "Unauthorized"
+//	@Failure   403       {object}  dto.ErrorResponse  "Forbidden - session does not belong to photographer"
+//	@Failure   404       {object}  dto.ErrorResponse  "Session not found"
+//	@Failure   409       {object}  dto.ErrorResponse  "Session not accepted, outside the arrival window or arrival already confirmed"
+//	@Failure   500       {object}  dto.ErrorResponse  "Internal error"
+//	@Router    /photographer/sessions/arrival [post]
+//	@Security  BearerAuth
+func (h *PhotoSessionHandler) ConfirmArrival(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := h.globalService.GetUserIDFromContext(ctx)
+	if err != nil {
+		http_errors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	var req dto.ConfirmArrivalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		http_errors.SendHTTPErrorObj(c, http_errors.ConvertBindError(err))
+		return
+	}
+
+	output, err := h.service.ConfirmArrival(ctx, photosessionservices.ConfirmArrivalInput{
+		SessionID:      req.PhotoSessionID,
+		PhotographerID: uint64(userID),
+	})
+	if err != nil {
+		http_errors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ConfirmArrivalResponse{
+		PhotoSessionID: output.SessionID,
+		ArrivedAt:      output.ArrivedAt.UTC().Format(time.RFC3339),
+		OnTime:         output.OnTime,
+	})
+}
@@ -0,0 +1,120 @@
+package photosessionhandlers
+
+import (
+	"math"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	dto "github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	photosessionservices "github.com/projeto-toq/toq_server/internal/core/service/photo_session_service"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// GetPhotographerKPIs aggregates delivery KPIs per photographer.
+// @Summary      Photographer delivery KPIs
+// @Description  Per photographer: on-time arrival confirmations, average time from session start to media upload, owner rejection rate and average owner rating, plus the score used by the `rank` slot sort.
+// @Tags         Admin Photo Sessions
+// @Produce      json
+// @Param        photographerId query int    false "Restrict to one photographer"
+// @Param        from           query string false "Sessions starting at or after (RFC3339)"
+// @Param        to             query string false "Sessions starting before (RFC3339)"
+// @Param        page           query int    false "Page number" default(1)
+// @Param        limit          query int    false "Page size (max 100)" default(20)
+// @Success      200 {object} dto.AdminPhotographerKPIReportResponse
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      401 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      422 {object} dto.ErrorResponse
+// @Failure      500 {object} dto.ErrorResponse
+// @Router       /admin/photo-sessions/photographer-kpis [get]
+// @Security     BearerAuth
+func (h *PhotoSessionHandler) GetPhotographerKPIs(c *gin.Context) {
+	var query dto.AdminPhotographerKPIRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		http_errors.SendHTTPError(c, http.StatusBadRequest, "invalid_query", "Invalid query parameters: "+err.Error())
+		return
+	}
+
+	input := photosessionservices.PhotographerKPIReportInput{
+		PhotographerID: query.PhotographerID,
+		Page:           query.Page,
+		Size:           query.Limit,
+	}
+
+	if strings.TrimSpace(query.From) != "" {
+		from, err := coreutils.ParseRFC3339Relaxed("from", query.From)
+		if err != nil {
+			http_errors.SendHTTPErrorObj(c, err)
+			return
+		}
+		input.From = &from
+	}
+	if strings.TrimSpace(query.To) != "" {
+		to, err := coreutils.ParseRFC3339Relaxed("to", query.To)
+		if err != nil {
+			http_errors.SendHTTPErrorObj(c, err)
+			return
+		}
+		input.To = &to
+	}
+
+	output, svcErr := h.service.GetPhotographerKPIReport(c.Request.Context(), input)
+	if svcErr != nil {
+		http_errors.SendHTTPErrorObj(c, svcErr)
+		return
+	}
+
+	items := make([]dto.AdminPhotographerKPIResponse, 0, len(output.Items))
+	for _, item := range output.Items {
+		resp := dto.AdminPhotographerKPIResponse{
+			PhotographerID:    item.PhotographerID,
+			Sessions:          item.Sessions,
+			ArrivalsConfirmed: item.ArrivalsConfirmed,
+			ArrivalsOnTime:    item.ArrivalsOnTime,
+			Uploads:           item.Uploads,
+			OwnerApprovals:    item.OwnerApprovals,
+			OwnerRejections:   item.OwnerRejections,
+			Ratings:           item.Ratings,
+			Score:             roundKPI(item.Score),
+		}
+		if item.ArrivalsConfirmed > 0 {
+			rate := roundKPI(item.OnTimeRate())
+			resp.OnTimeRate = &rate
+		}
+		if item.AvgUploadMinutes != nil {
+			hours := math.Round(*item.AvgUploadMinutes/60*10) / 10
+			resp.AvgUploadHours = &hours
+		}
+		if item.OwnerApprovals+item.OwnerRejections > 0 {
+			rate := roundKPI(item.RejectionRate())
+			resp.RejectionRate = &rate
+		}
+		if item.AvgRating != nil {
+			avg := math.Round(*item.AvgRating*10) / 10
+			resp.AvgRating = &avg
+		}
+		items = append(items, resp)
+	}
+
+	totalPages := 0
+	if output.Size > 0 {
+		totalPages = int(math.Ceil(float64(output.Total) / float64(output.Size)))
+	}
+
+	c.JSON(http.StatusOK, dto.AdminPhotographerKPIReportResponse{
+		Items: items,
+		Pagination: dto.PaginationResponse{
+			Page:       output.Page,
+			Limit:      output.Size,
+			Total:      output.Total,
+			TotalPages: totalPages,
+		},
+	})
+}
+
+// roundKPI keeps rates and scores at two decimals.
+func roundKPI(value float64) float64 {
+	return math.Round(value*100) / 100
+}
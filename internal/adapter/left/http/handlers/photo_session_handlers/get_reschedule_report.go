@@ -0,0 +1,97 @@
+package photosessionhandlers
+
+import (
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	dto "github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	photosessionservices "github.com/projeto-toq/toq_server/internal/core/service/photo_session_service"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// GetRescheduleReport aggregates photo session reschedules per listing or per photographer.
+// @Summary      Photo session reschedule report
+// @Description  Counts reschedules per listing identity or per photographer (the one who held the original slot), with the notice given before the original start.
+// @Tags         Admin Photo Sessions
+// @Produce      json
+// @Param        groupBy query string false "Aggregation dimension" Enums(LISTING, PHOTOGRAPHER) default(LISTING)
+// @Param        from    query string false "Requested at or after (RFC3339)"
+// @Param        to      query string false "Requested before (RFC3339)"
+// @Param        page    query int    false "Page number" default(1)
+// @Param        limit   query int    false "Page size (max 100)" default(20)
+// @Success      200 {object} dto.AdminRescheduleReportResponse
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      401 {object} dto.ErrorResponse
+// @Failure      403 {object} dto.ErrorResponse
+// @Failure      422 {object} dto.ErrorResponse
+// @Failure      500 {object} dto.ErrorResponse
+// @Router       /admin/photo-sessions/reschedules [get]
+// @Security     BearerAuth
+func (h *PhotoSessionHandler) GetRescheduleReport(c *gin.Context) {
+	var query dto.AdminRescheduleReportRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		http_errors.SendHTTPError(c, http.StatusBadRequest, "invalid_query", "Invalid query parameters: "+err.Error())
+		return
+	}
+
+	input := photosessionservices.RescheduleReportInput{
+		GroupBy: query.GroupBy,
+		Page:    query.Page,
+		Size:    query.Limit,
+	}
+
+	if strings.TrimSpace(query.From) != "" {
+		from, err := coreutils.ParseRFC3339Relaxed("from", query.From)
+		if err != nil {
+			http_errors.SendHTTPErrorObj(c, err)
+			return
+		}
+		input.From = &from
+	}
+	if strings.TrimSpace(query.To) != "" {
+		to, err := coreutils.ParseRFC3339Relaxed("to", query.To)
+		if err != nil {
+			http_errors.SendHTTPErrorObj(c, err)
+			return
+		}
+		input.To = &to
+	}
+
+	output, svcErr := h.service.GetRescheduleReport(c.Request.Context(), input)
+	if svcErr != nil {
+		http_errors.SendHTTPErrorObj(c, svcErr)
+		return
+	}
+
+	items := make([]dto.AdminRescheduleCountResponse, 0, len(output.Items))
+	for _, item := range output.Items {
+		items = append(items, dto.AdminRescheduleCountResponse{
+			SubjectID:           item.SubjectID,
+			Total:               item.Total,
+			PhotographerChanged: item.PhotographerChanged,
+			AvgNoticeHours:      math.Round(item.AvgNoticeMinutes/60*10) / 10,
+			MinNoticeHours:      math.Round(float64(item.MinNoticeMinutes)/60*10) / 10,
+			LastRescheduledAt:   item.LastRescheduledAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	totalPages := 0
+	if output.Size > 0 {
+		totalPages = int(math.Ceil(float64(output.Total) / float64(output.Size)))
+	}
+
+	c.JSON(http.StatusOK, dto.AdminRescheduleReportResponse{
+		GroupBy: string(output.GroupBy),
+		Items:   items,
+		Pagination: dto.PaginationResponse{
+			Page:       output.Page,
+			Limit:      output.Size,
+			Total:      output.Total,
+			TotalPages: totalPages,
+		},
+	})
+}
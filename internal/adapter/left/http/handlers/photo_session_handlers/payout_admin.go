@@ -0,0 +1,147 @@
+package photosessionhandlers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	photosessionservices "github.com/projeto-toq/toq_server/internal/core/service/photo_session_service"
+)
+
+var payoutCSVHeader = []string{
+	"line_id", "photo_session_id", "photographer_user_id", "listing_identity_id", "session_starts_at",
+	"session_type", "property_type", "size_m2", "rule_id", "base_amount_cents", "surcharge_pct",
+	"surcharge_reason", "amount_cents", "payout_reference", "paid_at",
+}
+
+// ClosePayoutPeriod settles a past month and exports its payout lines as CSV for finance.
+//
+//	@Summary     Close photographer payout period
+//	@Description Marks every priced pending payout line of the month as PAID under a new payout reference and returns the lines of that reference as CSV. Lines without a matching payout rule stay PENDING. Closing the period again settles lines recorded since the last close; with nothing pending it re-exports the lines of the latest reference.
+//	@Tags        Admin Photo Sessions
+//	@Accept      json
+//	@Produce     text/csv
+//	@Param       request body dto.AdminClosePayoutPeriodRequest true "Period to close (YYYY-MM, must be a past month)"
+//	@Success     200 {string} string "CSV export"
+//	@Failure     400 {object} dto.ErrorResponse
+//	@Failure     401 {object} dto.ErrorResponse
+//	@Failure     403 {object} dto.ErrorResponse
+//	@Failure     422 {object} dto.ErrorResponse "Invalid or current/future period"
+//	@Failure     500 {object} dto.ErrorResponse
+//	@Router      /admin/photo-sessions/payouts/close [post]
+//	@Security    BearerAuth
+func (h *PhotoSessionHandler) ClosePayoutPeriod(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := h.globalService.GetUserIDFromContext(ctx)
+	if err != nil {
+		http_errors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	var req dto.AdminClosePayoutPeriodRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		http_errors.SendHTTPErrorObj(c, http_errors.ConvertBindError(err))
+		return
+	}
+
+	output, svcErr := h.service.ClosePayoutPeriod(ctx, photosessionservices.ClosePayoutPeriodInput{
+		Period:   req.Period,
+		ClosedBy: userID,
+	})
+	if svcErr != nil {
+		http_errors.SendHTTPErrorObj(c, svcErr)
+		return
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	_ = writer.Write(payoutCSVHeader)
+	for _, line := range output.Lines {
+		ruleID, reason, reference, paidAt := "", "", "", ""
+		if line.RuleID() != nil {
+			ruleID = strconv.FormatUint(*line.RuleID(), 10)
+		}
+		if line.SurchargeReason() != nil {
+			reason = *line.SurchargeReason()
+		}
+		if line.PayoutReference() != nil {
+			reference = *line.PayoutReference()
+		}
+		if line.PaidAt() != nil {
+			paidAt = line.PaidAt().UTC().Format(time.RFC3339)
+		}
+		_ = writer.Write([]string{
+			strconv.FormatUint(line.ID(), 10),
+			strconv.FormatUint(line.BookingID(), 10),
+			strconv.FormatUint(line.PhotographerUserID(), 10),
+			strconv.FormatInt(line.ListingIdentityID(), 10),
+			line.SessionStartsAt().UTC().Format(time.RFC3339),
+			string(line.SessionType()),
+			strconv.FormatUint(uint64(line.PropertyType()), 10),
+			strconv.FormatFloat(line.SizeM2(), 'f', 2, 64),
+			ruleID,
+			strconv.FormatInt(line.BaseAmountCents(), 10),
+			strconv.FormatFloat(line.SurchargePct(), 'f', 2, 64),
+			reason,
+			strconv.FormatInt(line.AmountCents(), 10),
+			reference,
+			paidAt,
+		})
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		http_errors.SendHTTPErrorObj(c, derrors.Infra("failed to build payout export", err))
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"photographer-payouts-%s.csv\"", output.Period))
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+}
+
+// ListPayoutRules returns the photographer payout pricing rules.
+//
+//	@Summary     List photographer payout rules
+//	@Description Lists every payout rule, including inactive ones. The most specific active rule matching property type, session type and land size prices a completed session; weekend and holiday surcharges do not stack.
+//	@Tags        Admin Photo Sessions
+//	@Produce     json
+//	@Success     200 {object} dto.AdminPayoutRulesResponse
+//	@Failure     401 {object} dto.ErrorResponse
+//	@Failure     403 {object} dto.ErrorResponse
+//	@Failure     500 {object} dto.ErrorResponse
+//	@Router      /admin/photo-sessions/payout-rules [get]
+//	@Security    BearerAuth
+func (h *PhotoSessionHandler) ListPayoutRules(c *gin.Context) {
+	rules, err := h.service.ListPayoutRules(c.Request.Context())
+	if err != nil {
+		http_errors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	out := make([]dto.AdminPayoutRuleResponse, 0, len(rules))
+	for _, rule := range rules {
+		resp := dto.AdminPayoutRuleResponse{
+			ID:                  rule.ID,
+			PropertyType:        rule.PropertyType,
+			MinSizeM2:           rule.MinSizeM2,
+			MaxSizeM2:           rule.MaxSizeM2,
+			BaseAmountCents:     rule.BaseAmountCents,
+			WeekendSurchargePct: rule.WeekendSurchargePct,
+			HolidaySurchargePct: rule.HolidaySurchargePct,
+			Active:              rule.Active,
+		}
+		if rule.SessionType != nil {
+			resp.SessionType = string(*rule.SessionType)
+		}
+		out = append(out, resp)
+	}
+
+	c.JSON(http.StatusOK, dto.AdminPayoutRulesResponse{Rules: out})
+}
@@ -0,0 +1,90 @@
+package photosessionhandlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+	photosessionservices "github.com/projeto-toq/toq_server/internal/core/service/photo_session_service"
+)
+
+// GetPayoutStatement returns the authenticated photographer's payout statement for a month.
+//
+//	@Summary     Photographer payout statement
+//	@Description Lists the payout ledger lines of completed sessions in the month (session start, America/Sao_Paulo) with pending and paid totals in cents.
+//	@Tags        Photographer
+//	@Produce     json
+//	@Param       period query string false "Month formatted as YYYY-MM (defaults to the current month)"
+//	@Success     200 {object} dto.PhotographerStatementResponse
+//	@Failure     400 {object} dto.ErrorResponse
+//	@Failure     401 {object} dto.ErrorResponse
+//	@Failure     422 {object} dto.ErrorResponse "Invalid period"
+//	@Failure     500 {object} dto.ErrorResponse
+//	@Router      /photographer/payouts/statement [get]
+//	@Security    BearerAuth
+func (h *PhotoSessionHandler) GetPayoutStatement(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := h.globalService.GetUserIDFromContext(ctx)
+	if err != nil {
+		http_errors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	var query dto.PhotographerStatementQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		http_errors.SendHTTPErrorObj(c, http_errors.ConvertBindError(err))
+		return
+	}
+
+	output, svcErr := h.service.GetPhotographerStatement(ctx, photosessionservices.PhotographerStatementInput{
+		PhotographerID: uint64(userID),
+		Period:         query.Period,
+	})
+	if svcErr != nil {
+		http_errors.SendHTTPErrorObj(c, svcErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.PhotographerStatementResponse{
+		Period:       output.Period,
+		Lines:        toPayoutLineResponses(output.Lines),
+		TotalCents:   output.TotalCents,
+		PendingCents: output.PendingCents,
+		PaidCents:    output.PaidCents,
+	})
+}
+
+func toPayoutLineResponses(lines []photosessionmodel.PayoutLedgerLineInterface) []dto.PayoutLineResponse {
+	out := make([]dto.PayoutLineResponse, 0, len(lines))
+	for _, line := range lines {
+		resp := dto.PayoutLineResponse{
+			ID:                line.ID(),
+			PhotoSessionID:    line.BookingID(),
+			ListingIdentityID: line.ListingIdentityID(),
+			RuleID:            line.RuleID(),
+			SessionType:       string(line.SessionType()),
+			PropertyType:      line.PropertyType(),
+			SizeM2:            line.SizeM2(),
+			SessionStartsAt:   line.SessionStartsAt().UTC().Format(time.RFC3339),
+			BaseAmountCents:   line.BaseAmountCents(),
+			SurchargePct:      line.SurchargePct(),
+			AmountCents:       line.AmountCents(),
+			Status:            string(line.Status()),
+		}
+		if reason := line.SurchargeReason(); reason != nil {
+			resp.SurchargeReason = *reason
+		}
+		if reference := line.PayoutReference(); reference != nil {
+			resp.PayoutReference = *reference
+		}
+		if paidAt := line.PaidAt(); paidAt != nil {
+			resp.PaidAt = paidAt.UTC().Format(time.RFC3339)
+		}
+		out = append(out, resp)
+	}
+	return out
+}
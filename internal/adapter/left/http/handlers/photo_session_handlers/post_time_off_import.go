@@ -0,0 +1,83 @@
+package photosessionhandlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/converters"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	photosessionservices "github.com/projeto-toq/toq_server/internal/core/service/photo_session_service"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// PreviewTimeOffImport expands an iCalendar document into time-off candidates without persisting them.
+// @Summary      Preview Photographer Time-Off Import
+// @Description  Expands the events of an iCalendar document (including RRULE recurrences) over the next 365 days and classifies each occurrence as READY, CONFLICT (booked photo session), ALREADY_BLOCKED or DUPLICATE. Occurrences only partly covered are split into partial items, and only their free parts are READY.
+// @Tags         Photographer
+// @Accept       json
+// @Produce      json
+// @Param        input body dto.TimeOffImportRequest true "Import payload"
+// @Success      200 {object} dto.TimeOffImportResponse
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      500 {object} dto.ErrorResponse
+// @Router       /photographer/agenda/time-off/import/preview [post]
+func (h *PhotoSessionHandler) PreviewTimeOffImport(c *gin.Context) {
+	h.handleTimeOffImport(c, true)
+}
+
+// ImportTimeOff creates time-off entries from an iCalendar document.
+// @Summary      Import Photographer Time-Off
+// @Description  Creates one time-off entry per READY occurrence of the iCalendar document. Fails with 409 when occurrences overlap booked photo sessions, unless skipConflicts is true.
+// @Tags         Photographer
+// @Accept       json
+// @Produce      json
+// @Param        input body dto.TimeOffImportRequest true "Import payload"
+// @Success      200 {object} dto.TimeOffImportResponse
+// @Failure      400 {object} dto.ErrorResponse
+// @Failure      409 {object} dto.ErrorResponse
+// @Failure      500 {object} dto.ErrorResponse
+// @Router       /photographer/agenda/time-off/import [post]
+func (h *PhotoSessionHandler) ImportTimeOff(c *gin.Context) {
+	h.handleTimeOffImport(c, false)
+}
+
+func (h *PhotoSessionHandler) handleTimeOffImport(c *gin.Context, preview bool) {
+	var req dto.TimeOffImportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		http_errors.SendHTTPError(c, http.StatusBadRequest, "invalid_json", "Invalid JSON body")
+		return
+	}
+
+	loc, tzErr := coreutils.ResolveLocation("timezone", req.Timezone)
+	if tzErr != nil {
+		http_errors.SendHTTPErrorObj(c, tzErr)
+		return
+	}
+
+	userID, err := h.globalService.GetUserIDFromContext(c.Request.Context())
+	if err != nil {
+		http_errors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	input := photosessionservices.ImportTimeOffInput{
+		PhotographerID: uint64(userID),
+		Calendar:       req.Calendar,
+		Location:       loc,
+		SkipConflicts:  req.SkipConflicts,
+	}
+
+	var result photosessionservices.ImportTimeOffResult
+	if preview {
+		result, err = h.service.PreviewTimeOffImport(c.Request.Context(), input)
+	} else {
+		result, err = h.service.ImportTimeOff(c.Request.Context(), input)
+	}
+	if err != nil {
+		http_errors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, converters.TimeOffImportResultToDTO(result))
+}
@@ -0,0 +1,57 @@
+package schedulehandlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	dto "github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/middlewares"
+	scheduleservices "github.com/projeto-toq/toq_server/internal/core/service/schedule_service"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// DeleteTemplate handles DELETE /schedules/templates.
+//
+// @Summary     Delete an availability template
+// @Description Removes a template. Linked listing agendas keep their current rules and are unlinked.
+// @Tags        Listing Schedules
+// @Accept      json
+// @Produce     json
+// @Param       request body dto.ScheduleTemplateDeleteRequest true "Template deletion payload" Extensions(x-example={"templateId":12})
+// @Success     204 "Template deleted successfully"
+// @Failure     400 {object} dto.ErrorResponse
+// @Failure     401 {object} dto.ErrorResponse
+// @Failure     403 {object} dto.ErrorResponse
+// @Failure     404 {object} dto.ErrorResponse
+// @Failure     500 {object} dto.ErrorResponse
+// @Router      /schedules/templates [delete]
+// @Security    BearerAuth
+func (h *ScheduleHandler) DeleteTemplate(c *gin.Context) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	userInfo, ok := middlewares.GetUserInfoFromContext(c)
+	if !ok {
+		httperrors.SendHTTPError(c, http.StatusUnauthorized, "UNAUTHENTICATED", "User context not found")
+		return
+	}
+
+	var req dto.ScheduleTemplateDeleteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperrors.SendHTTPError(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request payload")
+		return
+	}
+
+	input := scheduleservices.DeleteTemplateInput{
+		TemplateID: req.TemplateID,
+		OwnerID:    userInfo.ID,
+	}
+
+	ctx := coreutils.ContextWithLogger(baseCtx)
+	if err := h.scheduleService.DeleteTemplate(ctx, input); err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
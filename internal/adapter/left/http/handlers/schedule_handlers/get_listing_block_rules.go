@@ -15,7 +15,7 @@ import (
 // GetListingBlockRules handles GET /schedules/listing/block.
 //
 // @Summary     List recurring block rules for a listing agenda
-// @Description Returns the recurring blocking rules configured for a listing owned by the authenticated user.
+// @Description Returns the blocking rules configured for a listing owned by the authenticated user, together with monthly and date FREE exceptions.
 // @Tags        Listing Schedules
 // @Produce     json
 // @Param       listingIdentityId query int64  true  "Listing identity identifier" Extensions(x-example=3241)
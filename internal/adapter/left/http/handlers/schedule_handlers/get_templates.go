@@ -0,0 +1,44 @@
+package schedulehandlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/converters"
+	dto "github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/middlewares"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// GetTemplates handles GET /schedules/templates.
+//
+// @Summary     List availability templates
+// @Description Lists the availability templates of the authenticated owner, with their rules and the listings linked to each one. Linked listings flagged as overridden had their rules edited locally and no longer receive template updates.
+// @Tags        Listing Schedules
+// @Produce     json
+// @Success     200 {object} dto.ScheduleTemplatesResponse
+// @Failure     401 {object} dto.ErrorResponse
+// @Failure     403 {object} dto.ErrorResponse
+// @Failure     500 {object} dto.ErrorResponse
+// @Router      /schedules/templates [get]
+// @Security    BearerAuth
+func (h *ScheduleHandler) GetTemplates(c *gin.Context) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	userInfo, ok := middlewares.GetUserInfoFromContext(c)
+	if !ok {
+		httperrors.SendHTTPError(c, http.StatusUnauthorized, "UNAUTHENTICATED", "User context not found")
+		return
+	}
+
+	ctx := coreutils.ContextWithLogger(baseCtx)
+	items, err := h.scheduleService.ListTemplates(ctx, userInfo.ID)
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	var response dto.ScheduleTemplatesResponse = converters.ScheduleTemplatesToDTO(items)
+	c.JSON(http.StatusOK, response)
+}
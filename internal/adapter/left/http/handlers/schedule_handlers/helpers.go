@@ -7,12 +7,14 @@ import (
 
 	dto "github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
 	schedulemodel "github.com/projeto-toq/toq_server/internal/core/model/schedule_model"
+	scheduleservices "github.com/projeto-toq/toq_server/internal/core/service/schedule_service"
 	"github.com/projeto-toq/toq_server/internal/core/utils"
 )
 
 const (
 	defaultScheduleLimit = 20
 	minutesPerDay        = 24 * 60
+	ruleDateLayout       = "2006-01-02"
 )
 
 var weekdayLookup = map[string]time.Weekday{
@@ -148,3 +150,89 @@ func schedulePaginationValues(config schedulemodel.PaginationConfig) (page, limi
 	}
 	return
 }
+
+// parseScheduleRuleSchedule converts the optional recurrence fields; value validation happens in the service.
+func parseScheduleRuleSchedule(req dto.ScheduleRuleRecurrenceRequest) (scheduleservices.RuleSchedule, error) {
+	schedule := scheduleservices.RuleSchedule{
+		Recurrence:  schedulemodel.RuleRecurrence(strings.ToUpper(strings.TrimSpace(req.Recurrence))),
+		RuleType:    schedulemodel.RuleType(strings.ToUpper(strings.TrimSpace(req.RuleType))),
+		DayOfMonth:  req.DayOfMonth,
+		WeekOfMonth: req.WeekOfMonth,
+	}
+
+	var err error
+	if schedule.Date, err = parseOptionalRuleDate("date", req.Date); err != nil {
+		return scheduleservices.RuleSchedule{}, err
+	}
+	if schedule.ValidFrom, err = parseOptionalRuleDate("validFrom", req.ValidFrom); err != nil {
+		return scheduleservices.RuleSchedule{}, err
+	}
+	if schedule.ValidUntil, err = parseOptionalRuleDate("validUntil", req.ValidUntil); err != nil {
+		return scheduleservices.RuleSchedule{}, err
+	}
+
+	return schedule, nil
+}
+
+// ruleUsesWeekdays reports whether the requested recurrence selects days by weekday (WEEKLY is the default).
+func ruleUsesWeekdays(req dto.ScheduleRuleRecurrenceRequest) bool {
+	switch schedulemodel.RuleRecurrence(strings.ToUpper(strings.TrimSpace(req.Recurrence))) {
+	case "", schedulemodel.RuleRecurrenceWeekly, schedulemodel.RuleRecurrenceMonthlyWeekday:
+		return true
+	default:
+		return false
+	}
+}
+
+func parseOptionalRuleDate(field, value string) (*time.Time, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(ruleDateLayout, trimmed)
+	if err != nil {
+		return nil, utils.ValidationError(field, field+" must be formatted as YYYY-MM-DD")
+	}
+	return &parsed, nil
+}
+
+// parseScheduleTemplateRules converts template rule payloads with the same parsing used by listing rules.
+func parseScheduleTemplateRules(requests []dto.ScheduleTemplateRuleRequest) ([]scheduleservices.TemplateRuleInput, error) {
+	rules := make([]scheduleservices.TemplateRuleInput, 0, len(requests))
+	for _, req := range requests {
+		var weekdays []time.Weekday
+		if ruleUsesWeekdays(req.ScheduleRuleRecurrenceRequest) {
+			parsed, err := parseScheduleWeekdays(req.WeekDays)
+			if err != nil {
+				return nil, err
+			}
+			weekdays = parsed
+		}
+
+		schedule, err := parseScheduleRuleSchedule(req.ScheduleRuleRecurrenceRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		startMinute, err := parseScheduleRuleMinutes("rangeStart", req.RangeStart)
+		if err != nil {
+			return nil, err
+		}
+
+		endMinute, err := parseScheduleRuleMinutes("rangeEnd", req.RangeEnd)
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, scheduleservices.TemplateRuleInput{
+			Weekdays: weekdays,
+			Range: scheduleservices.RuleTimeRange{
+				StartMinute: startMinute,
+				EndMinute:   endMinute,
+			},
+			Schedule: schedule,
+			Active:   req.Active,
+		})
+	}
+	return rules, nil
+}
@@ -0,0 +1,60 @@
+package schedulehandlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/converters"
+	dto "github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/middlewares"
+	scheduleservices "github.com/projeto-toq/toq_server/internal/core/service/schedule_service"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// PostApplyTemplate handles POST /schedules/templates/apply.
+//
+// @Summary     Apply an availability template to listings
+// @Description Replaces the rules of each listing agenda (up to 100) with the template rules and links the agendas to the template, clearing previous local overrides. All listings must belong to the owner; otherwise nothing is changed. Later template updates propagate to linked agendas until their rules are edited through /schedules/listing/block.
+// @Tags        Listing Schedules
+// @Accept      json
+// @Produce     json
+// @Param       request body dto.ScheduleTemplateApplyRequest true "Apply payload" Extensions(x-example={"templateId":12,"listingIdentityIds":[3241,3242]})
+// @Success     200 {object} dto.ScheduleTemplateMutationResponse
+// @Failure     400 {object} dto.ErrorResponse
+// @Failure     401 {object} dto.ErrorResponse
+// @Failure     403 {object} dto.ErrorResponse
+// @Failure     404 {object} dto.ErrorResponse
+// @Failure     500 {object} dto.ErrorResponse
+// @Router      /schedules/templates/apply [post]
+// @Security    BearerAuth
+func (h *ScheduleHandler) PostApplyTemplate(c *gin.Context) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	userInfo, ok := middlewares.GetUserInfoFromContext(c)
+	if !ok {
+		httperrors.SendHTTPError(c, http.StatusUnauthorized, "UNAUTHENTICATED", "User context not found")
+		return
+	}
+
+	var req dto.ScheduleTemplateApplyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperrors.SendHTTPError(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request payload")
+		return
+	}
+
+	input := scheduleservices.ApplyTemplateInput{
+		TemplateID:         req.TemplateID,
+		OwnerID:            userInfo.ID,
+		ListingIdentityIDs: req.ListingIdentityIDs,
+	}
+
+	ctx := coreutils.ContextWithLogger(baseCtx)
+	result, err := h.scheduleService.ApplyTemplate(ctx, input)
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, converters.ScheduleTemplateMutationToDTO(result))
+}
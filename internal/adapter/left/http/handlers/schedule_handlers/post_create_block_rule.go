@@ -2,6 +2,7 @@ package schedulehandlers
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/projeto-toq/toq_server/internal/adapter/left/http/converters"
@@ -15,7 +16,7 @@ import (
 // PostCreateBlockRule handles POST /schedules/listing/block.
 //
 // @Summary     Create recurring block rules
-// @Description Creates recurring blocking rules for a listing agenda.
+// @Description Creates blocking rules for a listing agenda. Rules are weekly by default; recurrence MONTHLY_DAY (dayOfMonth), MONTHLY_WEEKDAY (weekOfMonth of each weekDay, -1 = last) and DATE (date) create exceptions, and validFrom/validUntil bound recurring rules. ruleType FREE reopens time blocked by broader rules (weekly < monthly < date); it is not accepted for weekly rules.
 // @Tags        Listing Schedules
 // @Accept      json
 // @Produce     json
@@ -44,7 +45,17 @@ func (h *ScheduleHandler) PostCreateBlockRule(c *gin.Context) {
 		return
 	}
 
-	weekdays, err := parseScheduleWeekdays(req.WeekDays)
+	var weekdays []time.Weekday
+	if ruleUsesWeekdays(req.ScheduleRuleRecurrenceRequest) {
+		parsed, err := parseScheduleWeekdays(req.WeekDays)
+		if err != nil {
+			httperrors.SendHTTPErrorObj(c, err)
+			return
+		}
+		weekdays = parsed
+	}
+
+	schedule, err := parseScheduleRuleSchedule(req.ScheduleRuleRecurrenceRequest)
 	if err != nil {
 		httperrors.SendHTTPErrorObj(c, err)
 		return
@@ -70,6 +81,7 @@ func (h *ScheduleHandler) PostCreateBlockRule(c *gin.Context) {
 			StartMinute: startMinute,
 			EndMinute:   endMinute,
 		},
+		Schedule: schedule,
 		Active:   req.Active,
 		Timezone: req.Timezone,
 		ActorID:  userInfo.ID,
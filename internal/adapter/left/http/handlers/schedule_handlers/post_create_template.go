@@ -0,0 +1,67 @@
+package schedulehandlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/converters"
+	dto "github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/middlewares"
+	scheduleservices "github.com/projeto-toq/toq_server/internal/core/service/schedule_service"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// PostCreateTemplate handles POST /schedules/templates.
+//
+// @Summary     Create an availability template
+// @Description Creates a named set of agenda rules that can be applied to many listings. Rules accept the same fields as /schedules/listing/block. A template marked isDefault replaces the global default rules for agendas of the owner's new listings; only one template per owner is the default.
+// @Tags        Listing Schedules
+// @Accept      json
+// @Produce     json
+// @Param       request body dto.ScheduleTemplateRequest true "Template payload" Extensions(x-example={"name":"Fins de semana bloqueados","isDefault":false,"rules":[{"weekDays":["SATURDAY","SUNDAY"],"rangeStart":"00:00","rangeEnd":"23:59","active":true}]})
+// @Success     201 {object} dto.ScheduleTemplateMutationResponse
+// @Failure     400 {object} dto.ErrorResponse
+// @Failure     401 {object} dto.ErrorResponse
+// @Failure     403 {object} dto.ErrorResponse
+// @Failure     409 {object} dto.ErrorResponse
+// @Failure     500 {object} dto.ErrorResponse
+// @Router      /schedules/templates [post]
+// @Security    BearerAuth
+func (h *ScheduleHandler) PostCreateTemplate(c *gin.Context) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	userInfo, ok := middlewares.GetUserInfoFromContext(c)
+	if !ok {
+		httperrors.SendHTTPError(c, http.StatusUnauthorized, "UNAUTHENTICATED", "User context not found")
+		return
+	}
+
+	var req dto.ScheduleTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperrors.SendHTTPError(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request payload")
+		return
+	}
+
+	rules, err := parseScheduleTemplateRules(req.Rules)
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	input := scheduleservices.SaveTemplateInput{
+		OwnerID:   userInfo.ID,
+		Name:      req.Name,
+		IsDefault: req.IsDefault,
+		Rules:     rules,
+	}
+
+	ctx := coreutils.ContextWithLogger(baseCtx)
+	result, serviceErr := h.scheduleService.CreateTemplate(ctx, input)
+	if serviceErr != nil {
+		httperrors.SendHTTPErrorObj(c, serviceErr)
+		return
+	}
+
+	c.JSON(http.StatusCreated, converters.ScheduleTemplateMutationToDTO(result))
+}
@@ -0,0 +1,97 @@
+package schedulehandlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/converters"
+	dto "github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/middlewares"
+	schedulemodel "github.com/projeto-toq/toq_server/internal/core/model/schedule_model"
+	scheduleservices "github.com/projeto-toq/toq_server/internal/core/service/schedule_service"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// PostPreviewListingImport handles POST /schedules/listing/import/preview.
+//
+// @Summary     Preview iCalendar import of listing blocks
+// @Description Expands the events of an iCalendar document (including RRULE recurrences) over the next 365 days in the agenda timezone and classifies each occurrence as READY, CONFLICT (confirmed visit or photo session), ALREADY_BLOCKED or DUPLICATE. Occurrences only partly covered are split into partial items, and only their free parts are READY. Nothing is persisted.
+// @Tags        Listing Schedules
+// @Accept      json
+// @Produce     json
+// @Param       request body dto.ScheduleImportRequest true "Import payload"
+// @Success     200 {object} dto.ScheduleImportResponse
+// @Failure     400 {object} dto.ErrorResponse
+// @Failure     401 {object} dto.ErrorResponse
+// @Failure     403 {object} dto.ErrorResponse
+// @Failure     404 {object} dto.ErrorResponse
+// @Failure     500 {object} dto.ErrorResponse
+// @Router      /schedules/listing/import/preview [post]
+// @Security    BearerAuth
+func (h *ScheduleHandler) PostPreviewListingImport(c *gin.Context) {
+	h.handleListingImport(c, true)
+}
+
+// PostListingImport handles POST /schedules/listing/import.
+//
+// @Summary     Import listing blocks from iCalendar
+// @Description Creates one BLOCK or TEMP_BLOCK entry per READY occurrence of the iCalendar document. Fails with 409 when occurrences conflict with confirmed visits or photo sessions, unless skipConflicts is true.
+// @Tags        Listing Schedules
+// @Accept      json
+// @Produce     json
+// @Param       request body dto.ScheduleImportRequest true "Import payload"
+// @Success     200 {object} dto.ScheduleImportResponse
+// @Failure     400 {object} dto.ErrorResponse
+// @Failure     401 {object} dto.ErrorResponse
+// @Failure     403 {object} dto.ErrorResponse
+// @Failure     404 {object} dto.ErrorResponse
+// @Failure     409 {object} dto.ErrorResponse
+// @Failure     500 {object} dto.ErrorResponse
+// @Router      /schedules/listing/import [post]
+// @Security    BearerAuth
+func (h *ScheduleHandler) PostListingImport(c *gin.Context) {
+	h.handleListingImport(c, false)
+}
+
+func (h *ScheduleHandler) handleListingImport(c *gin.Context, preview bool) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	userInfo, ok := middlewares.GetUserInfoFromContext(c)
+	if !ok {
+		httperrors.SendHTTPError(c, http.StatusUnauthorized, "UNAUTHENTICATED", "User context not found")
+		return
+	}
+
+	var req dto.ScheduleImportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperrors.SendHTTPError(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request payload")
+		return
+	}
+
+	input := scheduleservices.ImportBlocksInput{
+		ListingIdentityID: req.ListingIdentityID,
+		OwnerID:           userInfo.ID,
+		ActorID:           userInfo.ID,
+		EntryType:         schedulemodel.EntryType(req.EntryType),
+		Calendar:          req.Calendar,
+		SkipConflicts:     req.SkipConflicts,
+	}
+
+	ctx := coreutils.ContextWithLogger(baseCtx)
+	var (
+		result     scheduleservices.ImportBlocksResult
+		serviceErr error
+	)
+	if preview {
+		result, serviceErr = h.scheduleService.PreviewBlockImport(ctx, input)
+	} else {
+		result, serviceErr = h.scheduleService.ImportBlocks(ctx, input)
+	}
+	if serviceErr != nil {
+		httperrors.SendHTTPErrorObj(c, serviceErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, converters.ScheduleImportResultToDTO(result))
+}
@@ -2,6 +2,7 @@ package schedulehandlers
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/projeto-toq/toq_server/internal/adapter/left/http/converters"
@@ -15,7 +16,7 @@ import (
 // PutUpdateBlockRule handles PUT /schedules/listing/block.
 //
 // @Summary     Update a recurring block rule
-// @Description Updates an existing recurring blocking rule for a listing agenda.
+// @Description Updates an existing rule for a listing agenda, including its recurrence, rule type and validity range. weekDays must hold exactly one value for WEEKLY and MONTHLY_WEEKDAY rules.
 // @Tags        Listing Schedules
 // @Accept      json
 // @Produce     json
@@ -44,7 +45,17 @@ func (h *ScheduleHandler) PutUpdateBlockRule(c *gin.Context) {
 		return
 	}
 
-	weekday, err := parseSingleScheduleWeekday(req.WeekDays)
+	var weekday time.Weekday
+	if ruleUsesWeekdays(req.ScheduleRuleRecurrenceRequest) {
+		parsed, err := parseSingleScheduleWeekday(req.WeekDays)
+		if err != nil {
+			httperrors.SendHTTPErrorObj(c, err)
+			return
+		}
+		weekday = parsed
+	}
+
+	schedule, err := parseScheduleRuleSchedule(req.ScheduleRuleRecurrenceRequest)
 	if err != nil {
 		httperrors.SendHTTPErrorObj(c, err)
 		return
@@ -71,6 +82,7 @@ func (h *ScheduleHandler) PutUpdateBlockRule(c *gin.Context) {
 			StartMinute: startMinute,
 			EndMinute:   endMinute,
 		},
+		Schedule: schedule,
 		Active:   req.Active,
 		Timezone: req.Timezone,
 		ActorID:  userInfo.ID,
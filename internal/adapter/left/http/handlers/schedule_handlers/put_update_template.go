@@ -0,0 +1,69 @@
+package schedulehandlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/converters"
+	dto "github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/middlewares"
+	scheduleservices "github.com/projeto-toq/toq_server/internal/core/service/schedule_service"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// PutUpdateTemplate handles PUT /schedules/templates.
+//
+// @Summary     Update an availability template
+// @Description Replaces the name, default flag and rules of a template. The new rules are copied to every linked listing agenda that was not overridden locally; syncedListingIdentityIds and overriddenListingIdentityIds report which agendas were updated and which were skipped.
+// @Tags        Listing Schedules
+// @Accept      json
+// @Produce     json
+// @Param       request body dto.ScheduleTemplateUpdateRequest true "Template payload" Extensions(x-example={"templateId":12,"name":"Fins de semana bloqueados","isDefault":true,"rules":[{"weekDays":["SATURDAY","SUNDAY"],"rangeStart":"00:00","rangeEnd":"23:59","active":true}]})
+// @Success     200 {object} dto.ScheduleTemplateMutationResponse
+// @Failure     400 {object} dto.ErrorResponse
+// @Failure     401 {object} dto.ErrorResponse
+// @Failure     403 {object} dto.ErrorResponse
+// @Failure     404 {object} dto.ErrorResponse
+// @Failure     409 {object} dto.ErrorResponse
+// @Failure     500 {object} dto.ErrorResponse
+// @Router      /schedules/templates [put]
+// @Security    BearerAuth
+func (h *ScheduleHandler) PutUpdateTemplate(c *gin.Context) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	userInfo, ok := middlewares.GetUserInfoFromContext(c)
+	if !ok {
+		httperrors.SendHTTPError(c, http.StatusUnauthorized, "UNAUTHENTICATED", "User context not found")
+		return
+	}
+
+	var req dto.ScheduleTemplateUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperrors.SendHTTPError(c, http.StatusBadRequest, "INVALID_REQUEST", "Invalid request payload")
+		return
+	}
+
+	rules, err := parseScheduleTemplateRules(req.Rules)
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	input := scheduleservices.SaveTemplateInput{
+		TemplateID: req.TemplateID,
+		OwnerID:    userInfo.ID,
+		Name:       req.Name,
+		IsDefault:  req.IsDefault,
+		Rules:      rules,
+	}
+
+	ctx := coreutils.ContextWithLogger(baseCtx)
+	result, serviceErr := h.scheduleService.UpdateTemplate(ctx, input)
+	if serviceErr != nil {
+		httperrors.SendHTTPErrorObj(c, serviceErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, converters.ScheduleTemplateMutationToDTO(result))
+}
@@ -0,0 +1,79 @@
+package userhandlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	userservices "github.com/projeto-toq/toq_server/internal/core/service/user_service"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// RequestDataExport enqueues an LGPD personal data export for the authenticated user
+//
+//	@Summary      Request personal data export
+//	@Description  Enqueue an asynchronous export of all personal data held for the current user (profile, roles, sessions, device tokens, listings and versions, visits, proposals, favorites and audit events). The archive is delivered by email as an expiring download link. Only one export may be in progress at a time.
+//	@Tags         User
+//	@Produce      json
+//	@Success      202  {object}  dto.DataExportResponse
+//	@Failure      401  {object}  dto.ErrorResponse  "Unauthorized"
+//	@Failure      403  {object}  dto.ErrorResponse  "Forbidden"
+//	@Failure      409  {object}  dto.ErrorResponse  "Export already in progress"
+//	@Failure      500  {object}  dto.ErrorResponse  "Internal server error"
+//	@Router       /user/data-export [post]
+//	@Security     BearerAuth
+func (uh *UserHandler) RequestDataExport(c *gin.Context) {
+	ctx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	output, err := uh.userService.RequestDataExport(ctx)
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, toDataExportResponse(output))
+}
+
+// GetDataExportStatus returns the latest personal data export of the authenticated user
+//
+//	@Summary      Get personal data export status
+//	@Description  Return the status of the latest personal data export. When completed and not expired, a fresh signed download URL is included.
+//	@Tags         User
+//	@Produce      json
+//	@Success      200  {object}  dto.DataExportResponse
+//	@Failure      401  {object}  dto.ErrorResponse  "Unauthorized"
+//	@Failure      403  {object}  dto.ErrorResponse  "Forbidden"
+//	@Failure      404  {object}  dto.ErrorResponse  "No export requested"
+//	@Failure      500  {object}  dto.ErrorResponse  "Internal server error"
+//	@Router       /user/data-export [get]
+//	@Security     BearerAuth
+func (uh *UserHandler) GetDataExportStatus(c *gin.Context) {
+	ctx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	output, err := uh.userService.GetDataExportStatus(ctx)
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toDataExportResponse(output))
+}
+
+func toDataExportResponse(output userservices.DataExportStatusOutput) dto.DataExportResponse {
+	response := dto.DataExportResponse{
+		ID:           output.ID,
+		Status:       string(output.Status),
+		RequestedAt:  output.RequestedAt.UTC().Format(time.RFC3339),
+		DownloadURL:  output.DownloadURL,
+		ErrorMessage: output.ErrorMessage,
+	}
+	if output.CompletedAt != nil {
+		response.CompletedAt = output.CompletedAt.UTC().Format(time.RFC3339)
+	}
+	if output.ExpiresAt != nil {
+		response.ExpiresAt = output.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+	return response
+}
@@ -12,7 +12,7 @@ import (
 // DeleteAccount deletes the authenticated user's account
 //
 //	@Summary      Delete account
-//	@Description  Logically delete the current user's account. Revokes all sessions and removes device tokens. User data and role history are preserved for audit purposes and regulatory compliance (LGPD). The account cannot be restored after deletion, but the user may create a new account with the same credentials (email, phone, CPF).
+//	@Description  Logically delete the current user's account. Revokes all sessions, removes device tokens and anonymizes personal data (name, CPF, email, phone, address, photos and documents) with irreversible tokens, as required by LGPD. Listings, visits, proposals and audit events keep their references to the anonymized account and a deletion certificate is recorded in the audit trail. The account cannot be restored after deletion, but the user may create a new account with the same credentials (email, phone, CPF).
 //	@Tags         User
 //	@Accept       json
 //	@Produce      json
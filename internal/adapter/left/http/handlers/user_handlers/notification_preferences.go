@@ -0,0 +1,106 @@
+package userhandlers
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
+	userservices "github.com/projeto-toq/toq_server/internal/core/service/user_service"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// GetNotificationPreferences returns the authenticated user's consent matrix
+//
+//	@Summary      Get notification preferences
+//	@Description  Return the consent for every channel (email, sms, push) and category (transactional, visit_updates, proposal_updates, saved_search_alerts, marketing). Transactional notifications are mandatory; categories without a recorded choice report their default (marketing defaults to not granted).
+//	@Tags         User
+//	@Produce      json
+//	@Success      200  {object}  dto.NotificationPreferencesResponse
+//	@Failure      401  {object}  dto.ErrorResponse  "Unauthorized"
+//	@Failure      403  {object}  dto.ErrorResponse  "Forbidden"
+//	@Failure      500  {object}  dto.ErrorResponse  "Internal server error"
+//	@Router       /user/notification-preferences [get]
+//	@Security     BearerAuth
+func (uh *UserHandler) GetNotificationPreferences(c *gin.Context) {
+	ctx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	output, err := uh.userService.GetNotificationPreferences(ctx)
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toNotificationPreferencesResponse(output))
+}
+
+// UpdateNotificationPreferences records consent changes for the authenticated user
+//
+//	@Summary      Update notification preferences
+//	@Description  Grant or revoke consent per channel and category. Each change is stored with timestamp and the accepted privacy policy version, which must be the current one. Transactional notifications cannot be disabled. Granting a push category re-enables the legacy push opt-in.
+//	@Tags         User
+//	@Accept       json
+//	@Produce      json
+//	@Param        request  body      dto.UpdateNotificationPreferencesRequest  true  "Consent changes"
+//	@Success      200      {object}  dto.NotificationPreferencesResponse
+//	@Failure      400      {object}  dto.ErrorResponse  "Invalid request"
+//	@Failure      401      {object}  dto.ErrorResponse  "Unauthorized"
+//	@Failure      403      {object}  dto.ErrorResponse  "Forbidden"
+//	@Failure      422      {object}  dto.ErrorResponse  "Validation error"
+//	@Failure      500      {object}  dto.ErrorResponse  "Internal server error"
+//	@Router       /user/notification-preferences [put]
+//	@Security     BearerAuth
+func (uh *UserHandler) UpdateNotificationPreferences(c *gin.Context) {
+	ctx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	var request dto.UpdateNotificationPreferencesRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		httperrors.SendHTTPErrorObj(c, httperrors.ConvertBindError(err))
+		return
+	}
+
+	input := userservices.UpdateNotificationPreferencesInput{
+		PolicyVersion: request.PolicyVersion,
+		Changes:       make([]userservices.NotificationPreferenceChange, 0, len(request.Preferences)),
+	}
+	for _, pref := range request.Preferences {
+		input.Changes = append(input.Changes, userservices.NotificationPreferenceChange{
+			Channel:  globalmodel.NotificationChannel(strings.ToLower(strings.TrimSpace(pref.Channel))),
+			Category: globalmodel.NotificationCategory(strings.ToLower(strings.TrimSpace(pref.Category))),
+			Granted:  pref.Granted,
+		})
+	}
+
+	output, err := uh.userService.UpdateNotificationPreferences(ctx, input)
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toNotificationPreferencesResponse(output))
+}
+
+func toNotificationPreferencesResponse(output userservices.NotificationPreferencesOutput) dto.NotificationPreferencesResponse {
+	response := dto.NotificationPreferencesResponse{
+		CurrentPolicyVersion: output.CurrentPolicyVersion,
+		Preferences:          make([]dto.NotificationPreferenceResponse, 0, len(output.Preferences)),
+	}
+	for _, pref := range output.Preferences {
+		item := dto.NotificationPreferenceResponse{
+			Channel:       string(pref.Channel),
+			Category:      string(pref.Category),
+			Granted:       pref.Granted,
+			Mandatory:     pref.Mandatory,
+			Recorded:      pref.Recorded,
+			PolicyVersion: pref.PolicyVersion,
+		}
+		if pref.UpdatedAt != nil {
+			item.UpdatedAt = pref.UpdatedAt.UTC().Format(time.RFC3339)
+		}
+		response.Preferences = append(response.Preferences, item)
+	}
+	return response
+}
@@ -0,0 +1,168 @@
+package userhandlers
+
+import (
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	userservices "github.com/projeto-toq/toq_server/internal/core/service/user_service"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ListNotifications returns the authenticated user's in-app notification inbox
+//
+//	@Summary      List notifications
+//	@Description  Return the in-app inbox newest first. Every notification sent to the user (email, SMS or push) is stored here with its category, deep link and data payload, so it stays available even when the delivery channel was missed.
+//	@Tags         User
+//	@Produce      json
+//	@Param        page        query     int   false  "Page number (default 1)"
+//	@Param        limit       query     int   false  "Items per page (default 20, max 100)"
+//	@Param        unreadOnly  query     bool  false  "Return only unread notifications"
+//	@Success      200         {object}  dto.ListNotificationsResponse
+//	@Failure      400         {object}  dto.ErrorResponse  "Invalid request"
+//	@Failure      401         {object}  dto.ErrorResponse  "Unauthorized"
+//	@Failure      403         {object}  dto.ErrorResponse  "Forbidden"
+//	@Failure      500         {object}  dto.ErrorResponse  "Internal server error"
+//	@Router       /user/notifications [get]
+//	@Security     BearerAuth
+func (uh *UserHandler) ListNotifications(c *gin.Context) {
+	ctx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	var query dto.ListNotificationsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httperrors.SendHTTPErrorObj(c, httperrors.ConvertBindError(err))
+		return
+	}
+
+	output, err := uh.userService.ListNotifications(ctx, userservices.ListNotificationsInput{
+		OnlyUnread: query.UnreadOnly,
+		Page:       query.Page,
+		Limit:      query.Limit,
+	})
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	response := dto.ListNotificationsResponse{
+		Notifications: make([]dto.NotificationResponse, 0, len(output.Items)),
+		UnreadCount:   output.UnreadCount,
+		Pagination: dto.PaginationResponse{
+			Page:  output.Page,
+			Limit: output.Limit,
+			Total: output.Total,
+		},
+	}
+	if output.Limit > 0 {
+		response.Pagination.TotalPages = int(math.Ceil(float64(output.Total) / float64(output.Limit)))
+	}
+	for _, item := range output.Items {
+		notification := dto.NotificationResponse{
+			ID:        item.ID,
+			Channel:   string(item.Channel),
+			Category:  string(item.Category),
+			Title:     item.Title,
+			Body:      item.Body,
+			DeepLink:  item.DeepLink,
+			Data:      item.Data,
+			Read:      item.IsRead(),
+			CreatedAt: item.CreatedAt.UTC().Format(time.RFC3339),
+		}
+		if item.ReadAt != nil {
+			notification.ReadAt = item.ReadAt.UTC().Format(time.RFC3339)
+		}
+		response.Notifications = append(response.Notifications, notification)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// MarkNotificationRead marks one inbox entry as read
+//
+//	@Summary      Mark notification as read
+//	@Description  Mark a single inbox entry of the authenticated user as read and return the updated unread count. Marking an already read entry has no effect.
+//	@Tags         User
+//	@Accept       json
+//	@Produce      json
+//	@Param        request  body      dto.MarkNotificationReadRequest  true  "Notification to mark"
+//	@Success      200      {object}  dto.UnreadNotificationCountResponse
+//	@Failure      400      {object}  dto.ErrorResponse  "Invalid request"
+//	@Failure      401      {object}  dto.ErrorResponse  "Unauthorized"
+//	@Failure      403      {object}  dto.ErrorResponse  "Forbidden"
+//	@Failure      404      {object}  dto.ErrorResponse  "Notification not found"
+//	@Failure      500      {object}  dto.ErrorResponse  "Internal server error"
+//	@Router       /user/notifications/read [post]
+//	@Security     BearerAuth
+func (uh *UserHandler) MarkNotificationRead(c *gin.Context) {
+	ctx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	var request dto.MarkNotificationReadRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		httperrors.SendHTTPErrorObj(c, httperrors.ConvertBindError(err))
+		return
+	}
+
+	if err := uh.userService.MarkNotificationRead(ctx, request.NotificationID); err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	count, err := uh.userService.GetUnreadNotificationCount(ctx)
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.UnreadNotificationCountResponse{UnreadCount: count})
+}
+
+// MarkAllNotificationsRead marks every inbox entry as read
+//
+//	@Summary      Mark all notifications as read
+//	@Description  Mark every unread inbox entry of the authenticated user as read.
+//	@Tags         User
+//	@Produce      json
+//	@Success      200  {object}  dto.MarkAllNotificationsReadResponse
+//	@Failure      401  {object}  dto.ErrorResponse  "Unauthorized"
+//	@Failure      403  {object}  dto.ErrorResponse  "Forbidden"
+//	@Failure      500  {object}  dto.ErrorResponse  "Internal server error"
+//	@Router       /user/notifications/read-all [post]
+//	@Security     BearerAuth
+func (uh *UserHandler) MarkAllNotificationsRead(c *gin.Context) {
+	ctx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	updated, err := uh.userService.MarkAllNotificationsRead(ctx)
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MarkAllNotificationsReadResponse{Updated: updated})
+}
+
+// GetUnreadNotificationCount returns the unread inbox count for app badges
+//
+//	@Summary      Get unread notification count
+//	@Description  Return how many inbox entries the authenticated user has not read yet. Lightweight endpoint intended for app badges.
+//	@Tags         User
+//	@Produce      json
+//	@Success      200  {object}  dto.UnreadNotificationCountResponse
+//	@Failure      401  {object}  dto.ErrorResponse  "Unauthorized"
+//	@Failure      403  {object}  dto.ErrorResponse  "Forbidden"
+//	@Failure      500  {object}  dto.ErrorResponse  "Internal server error"
+//	@Router       /user/notifications/unread-count [get]
+//	@Security     BearerAuth
+func (uh *UserHandler) GetUnreadNotificationCount(c *gin.Context) {
+	ctx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	count, err := uh.userService.GetUnreadNotificationCount(ctx)
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.UnreadNotificationCountResponse{UnreadCount: count})
+}
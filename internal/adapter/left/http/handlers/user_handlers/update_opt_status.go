@@ -12,7 +12,7 @@ import (
 // UpdateOptStatus updates the user's messaging opt-in status
 //
 //	@Summary      Update opt-in status
-//	@Description  Update user's consent to receive notifications (opt-in/opt-out)
+//	@Description  Update user's consent to receive notifications (opt-in/opt-out). The choice is mirrored on the push consents of the preference center: opt-out revokes every optional push category, opt-in grants them except marketing.
 //	@Tags         User
 //	@Accept       json
 //	@Produce      json
@@ -0,0 +1,48 @@
+package visithandlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/converters"
+	dto "github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// CancelOpenHouse handles POST /visits/open-houses/cancel.
+//
+// @Summary     Cancel an open house
+// @Description Cancels a scheduled open house, releases its agenda window and cancels active registrations, notifying their realtors.
+// @Tags        Visits
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       Authorization header string true "Bearer token"
+// @Param       request body dto.CancelOpenHouseRequest true "Cancellation payload"
+// @Success     200 {object} dto.OpenHouseResponse
+// @Failure     400 {object} dto.ErrorResponse
+// @Failure     401 {object} dto.ErrorResponse
+// @Failure     403 {object} dto.ErrorResponse
+// @Failure     404 {object} dto.ErrorResponse
+// @Failure     409 {object} dto.ErrorResponse
+// @Failure     500 {object} dto.ErrorResponse
+// @Router      /visits/open-houses/cancel [post]
+func (h *VisitHandler) CancelOpenHouse(c *gin.Context) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	var req dto.CancelOpenHouseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperrors.SendHTTPErrorObj(c, httperrors.ConvertBindError(err))
+		return
+	}
+
+	ctx := coreutils.ContextWithLogger(baseCtx)
+	openHouse, svcErr := h.visitService.CancelOpenHouse(ctx, req.OpenHouseID, req.Reason)
+	if svcErr != nil {
+		httperrors.SendHTTPErrorObj(c, svcErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, converters.OpenHouseDomainToResponse(openHouse))
+}
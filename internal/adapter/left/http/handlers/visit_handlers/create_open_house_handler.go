@@ -0,0 +1,54 @@
+package visithandlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/converters"
+	dto "github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// CreateOpenHouse handles POST /visits/open-houses.
+//
+// @Summary     Create an open house
+// @Description Opens a group visit window on the listing agenda with a per-slot capacity. The window must respect the visit lead time/horizon and must not overlap blocking agenda entries; it then blocks private visits.
+// @Tags        Visits
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       Authorization header string true "Bearer token"
+// @Param       request body dto.CreateOpenHouseRequest true "Open house payload"
+// @Success     201 {object} dto.OpenHouseResponse
+// @Failure     400 {object} dto.ErrorResponse
+// @Failure     401 {object} dto.ErrorResponse
+// @Failure     403 {object} dto.ErrorResponse
+// @Failure     404 {object} dto.ErrorResponse
+// @Failure     409 {object} dto.ErrorResponse
+// @Failure     500 {object} dto.ErrorResponse
+// @Router      /visits/open-houses [post]
+func (h *VisitHandler) CreateOpenHouse(c *gin.Context) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	var req dto.CreateOpenHouseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperrors.SendHTTPErrorObj(c, httperrors.ConvertBindError(err))
+		return
+	}
+
+	input, err := converters.CreateOpenHouseDTOToInput(req)
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	ctx := coreutils.ContextWithLogger(baseCtx)
+	openHouse, svcErr := h.visitService.CreateOpenHouse(ctx, input)
+	if svcErr != nil {
+		httperrors.SendHTTPErrorObj(c, svcErr)
+		return
+	}
+
+	c.JSON(http.StatusCreated, converters.OpenHouseDomainToResponse(openHouse))
+}
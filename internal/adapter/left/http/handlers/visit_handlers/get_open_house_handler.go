@@ -0,0 +1,47 @@
+package visithandlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/converters"
+	dto "github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// GetOpenHouse handles POST /visits/open-houses/detail.
+//
+// @Summary     Get open house detail
+// @Description Returns the open house with seat usage per slot. Owners see every registration; realtors only see the clients they registered.
+// @Tags        Visits
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       Authorization header string true "Bearer token"
+// @Param       request body dto.GetOpenHouseDetailRequest true "Open house identifier"
+// @Success     200 {object} dto.OpenHouseDetailResponse
+// @Failure     400 {object} dto.ErrorResponse
+// @Failure     401 {object} dto.ErrorResponse
+// @Failure     403 {object} dto.ErrorResponse
+// @Failure     404 {object} dto.ErrorResponse
+// @Failure     500 {object} dto.ErrorResponse
+// @Router      /visits/open-houses/detail [post]
+func (h *VisitHandler) GetOpenHouse(c *gin.Context) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	var req dto.GetOpenHouseDetailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperrors.SendHTTPErrorObj(c, httperrors.ConvertBindError(err))
+		return
+	}
+
+	ctx := coreutils.ContextWithLogger(baseCtx)
+	detail, svcErr := h.visitService.GetOpenHouse(ctx, req.OpenHouseID)
+	if svcErr != nil {
+		httperrors.SendHTTPErrorObj(c, svcErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, converters.OpenHouseDetailToResponse(detail))
+}
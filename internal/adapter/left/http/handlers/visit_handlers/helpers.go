@@ -102,3 +102,54 @@ func normalizeMulti(raw []string) []string {
 	}
 	return result
 }
+
+func buildOpenHouseFilter(query dto.OpenHouseListQuery) (listingmodel.OpenHouseListFilter, error) {
+	statuses := make([]listingmodel.OpenHouseStatus, 0)
+	for _, item := range normalizeMulti(query.Statuses) {
+		status, err := listingmodel.ParseOpenHouseStatus(item)
+		if err != nil {
+			return listingmodel.OpenHouseListFilter{}, coreutils.ValidationError("status", err.Error())
+		}
+		statuses = append(statuses, status)
+	}
+
+	from, err := parseOptionalTime("from", query.From)
+	if err != nil {
+		return listingmodel.OpenHouseListFilter{}, err
+	}
+
+	to, err := parseOptionalTime("to", query.To)
+	if err != nil {
+		return listingmodel.OpenHouseListFilter{}, err
+	}
+
+	if from != nil && to != nil && from.After(*to) {
+		return listingmodel.OpenHouseListFilter{}, coreutils.ValidationError("from", "must be before or equal to 'to'")
+	}
+
+	page := query.Page
+	limit := query.Limit
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > maxVisitPageSize {
+		limit = maxVisitPageSize
+	}
+
+	filter := listingmodel.OpenHouseListFilter{
+		Statuses: statuses,
+		From:     from,
+		To:       to,
+		Page:     page,
+		Limit:    limit,
+	}
+
+	if query.ListingIdentityID > 0 {
+		filter.ListingIdentityID = &query.ListingIdentityID
+	}
+
+	return filter, nil
+}
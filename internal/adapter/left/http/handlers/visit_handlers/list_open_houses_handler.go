@@ -0,0 +1,116 @@
+package visithandlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/converters"
+	dto "github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ListOpenHousesOwner handles GET /visits/open-houses/owner.
+//
+// @Summary     List open houses for owners
+// @Description Lists the authenticated owner's open houses with registration counters, status/time filters (RFC3339) and pagination (max 50 per page).
+// @Tags        Visits
+// @Produce     json
+// @Security    BearerAuth
+// @Param       listingIdentityId query int false "Listing identity filter" Extensions(x-example=123)
+// @Param       status            query []string false "Statuses (SCHEDULED, CANCELLED)" collectionFormat(multi)
+// @Param       from              query string false "Start date/time (RFC3339)" Extensions(x-example="2025-01-01T00:00:00Z")
+// @Param       to                query string false "End date/time (RFC3339)" Extensions(x-example="2025-01-31T23:59:59Z")
+// @Param       page              query int false "Page" default(1)
+// @Param       limit             query int false "Page size" default(20)
+// @Success     200 {object} dto.OpenHouseListResponse
+// @Failure     400 {object} dto.ErrorResponse
+// @Failure     401 {object} dto.ErrorResponse
+// @Failure     403 {object} dto.ErrorResponse
+// @Failure     500 {object} dto.ErrorResponse
+// @Router      /visits/open-houses/owner [get]
+func (h *VisitHandler) ListOpenHousesOwner(c *gin.Context) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	var query dto.OpenHouseListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httperrors.SendHTTPErrorObj(c, httperrors.ConvertBindError(err))
+		return
+	}
+
+	userInfo, infoErr := coreutils.GetUserInfoFromGinContext(c)
+	if infoErr != nil {
+		httperrors.SendHTTPErrorObj(c, infoErr)
+		return
+	}
+
+	filter, err := buildOpenHouseFilter(query)
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+	filter.OwnerUserID = &userInfo.ID
+
+	ctx := coreutils.ContextWithLogger(baseCtx)
+	result, svcErr := h.visitService.ListOpenHouses(ctx, filter)
+	if svcErr != nil {
+		httperrors.SendHTTPErrorObj(c, svcErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, converters.OpenHouseListToResponse(result))
+}
+
+// ListOpenHousesListing handles GET /visits/open-houses.
+//
+// @Summary     List upcoming open houses of a listing
+// @Description Lists scheduled open houses of a listing that have not ended yet, so realtors can register clients. listingIdentityId is required.
+// @Tags        Visits
+// @Produce     json
+// @Security    BearerAuth
+// @Param       listingIdentityId query int true "Listing identity" Extensions(x-example=123)
+// @Param       to                query string false "End date/time (RFC3339)" Extensions(x-example="2025-01-31T23:59:59Z")
+// @Param       page              query int false "Page" default(1)
+// @Param       limit             query int false "Page size" default(20)
+// @Success     200 {object} dto.OpenHouseListResponse
+// @Failure     400 {object} dto.ErrorResponse
+// @Failure     401 {object} dto.ErrorResponse
+// @Failure     403 {object} dto.ErrorResponse
+// @Failure     500 {object} dto.ErrorResponse
+// @Router      /visits/open-houses [get]
+func (h *VisitHandler) ListOpenHousesListing(c *gin.Context) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	var query dto.OpenHouseListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httperrors.SendHTTPErrorObj(c, httperrors.ConvertBindError(err))
+		return
+	}
+	if query.ListingIdentityID <= 0 {
+		httperrors.SendHTTPErrorObj(c, coreutils.ValidationError("listingIdentityId", "is required"))
+		return
+	}
+
+	// Realtors only see upcoming scheduled windows; status/from filters are fixed.
+	query.Statuses = nil
+	query.From = ""
+	filter, err := buildOpenHouseFilter(query)
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+	now := time.Now().UTC()
+	filter.From = &now
+	filter.Statuses = []listingmodel.OpenHouseStatus{listingmodel.OpenHouseStatusScheduled}
+
+	ctx := coreutils.ContextWithLogger(baseCtx)
+	result, svcErr := h.visitService.ListOpenHouses(ctx, filter)
+	if svcErr != nil {
+		httperrors.SendHTTPErrorObj(c, svcErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, converters.OpenHouseListToResponse(result))
+}
@@ -0,0 +1,52 @@
+package visithandlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	dto "github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	visitservice "github.com/projeto-toq/toq_server/internal/core/service/visit_service"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// NotifyOpenHouse handles POST /visits/open-houses/notify.
+//
+// @Summary     Notify open house participants
+// @Description Sends an owner message as push notification to every realtor with registered clients (optionally including waitlisted ones).
+// @Tags        Visits
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       Authorization header string true "Bearer token"
+// @Param       request body dto.NotifyOpenHouseRequest true "Message payload"
+// @Success     200 {object} dto.NotifyOpenHouseResponse
+// @Failure     400 {object} dto.ErrorResponse
+// @Failure     401 {object} dto.ErrorResponse
+// @Failure     403 {object} dto.ErrorResponse
+// @Failure     404 {object} dto.ErrorResponse
+// @Failure     409 {object} dto.ErrorResponse
+// @Failure     500 {object} dto.ErrorResponse
+// @Router      /visits/open-houses/notify [post]
+func (h *VisitHandler) NotifyOpenHouse(c *gin.Context) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	var req dto.NotifyOpenHouseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperrors.SendHTTPErrorObj(c, httperrors.ConvertBindError(err))
+		return
+	}
+
+	ctx := coreutils.ContextWithLogger(baseCtx)
+	output, svcErr := h.visitService.NotifyOpenHouse(ctx, visitservice.NotifyOpenHouseInput{
+		OpenHouseID:     req.OpenHouseID,
+		Message:         req.Message,
+		IncludeWaitlist: req.IncludeWaitlist,
+	})
+	if svcErr != nil {
+		httperrors.SendHTTPErrorObj(c, svcErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NotifyOpenHouseResponse{Recipients: output.Recipients})
+}
@@ -0,0 +1,54 @@
+package visithandlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/converters"
+	dto "github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// RegisterOpenHouseClient handles POST /visits/open-houses/registrations.
+//
+// @Summary     Register a client into an open house
+// @Description Registers a realtor's client into an open house slot. When the slot is full the client is waitlisted (status WAITLISTED with waitlistPosition) and promoted automatically when a seat is released.
+// @Tags        Visits
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       Authorization header string true "Bearer token"
+// @Param       request body dto.RegisterOpenHouseClientRequest true "Registration payload"
+// @Success     201 {object} dto.OpenHouseRegistrationResponse
+// @Failure     400 {object} dto.ErrorResponse
+// @Failure     401 {object} dto.ErrorResponse
+// @Failure     403 {object} dto.ErrorResponse
+// @Failure     404 {object} dto.ErrorResponse
+// @Failure     409 {object} dto.ErrorResponse
+// @Failure     500 {object} dto.ErrorResponse
+// @Router      /visits/open-houses/registrations [post]
+func (h *VisitHandler) RegisterOpenHouseClient(c *gin.Context) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	var req dto.RegisterOpenHouseClientRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperrors.SendHTTPErrorObj(c, httperrors.ConvertBindError(err))
+		return
+	}
+
+	input, err := converters.RegisterOpenHouseClientDTOToInput(req)
+	if err != nil {
+		httperrors.SendHTTPErrorObj(c, err)
+		return
+	}
+
+	ctx := coreutils.ContextWithLogger(baseCtx)
+	output, svcErr := h.visitService.RegisterOpenHouseClient(ctx, input)
+	if svcErr != nil {
+		httperrors.SendHTTPErrorObj(c, svcErr)
+		return
+	}
+
+	c.JSON(http.StatusCreated, converters.OpenHouseRegistrationToResponse(output))
+}
@@ -0,0 +1,86 @@
+package visithandlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/projeto-toq/toq_server/internal/adapter/left/http/converters"
+	dto "github.com/projeto-toq/toq_server/internal/adapter/left/http/dto"
+	httperrors "github.com/projeto-toq/toq_server/internal/adapter/left/http/http_errors"
+	visitservice "github.com/projeto-toq/toq_server/internal/core/service/visit_service"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// CancelOpenHouseRegistration handles POST /visits/open-houses/registrations/cancel.
+//
+// @Summary     Cancel an open house registration
+// @Description Cancels a registered or waitlisted client (registering realtor or listing owner). A released seat promotes the oldest waitlisted client of the same slot.
+// @Tags        Visits
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       Authorization header string true "Bearer token"
+// @Param       request body dto.OpenHouseRegistrationActionRequest true "Registration identifier"
+// @Success     200 {object} dto.OpenHouseRegistrationResponse
+// @Failure     400 {object} dto.ErrorResponse
+// @Failure     401 {object} dto.ErrorResponse
+// @Failure     403 {object} dto.ErrorResponse
+// @Failure     404 {object} dto.ErrorResponse
+// @Failure     409 {object} dto.ErrorResponse
+// @Failure     500 {object} dto.ErrorResponse
+// @Router      /visits/open-houses/registrations/cancel [post]
+func (h *VisitHandler) CancelOpenHouseRegistration(c *gin.Context) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	var req dto.OpenHouseRegistrationActionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperrors.SendHTTPErrorObj(c, httperrors.ConvertBindError(err))
+		return
+	}
+
+	ctx := coreutils.ContextWithLogger(baseCtx)
+	registration, svcErr := h.visitService.CancelOpenHouseRegistration(ctx, req.RegistrationID)
+	if svcErr != nil {
+		httperrors.SendHTTPErrorObj(c, svcErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, converters.OpenHouseRegistrationToResponse(visitservice.OpenHouseRegistrationOutput{Registration: registration}))
+}
+
+// CheckInOpenHouseRegistration handles POST /visits/open-houses/registrations/check-in.
+//
+// @Summary     Check a client in
+// @Description Marks a registered client as CHECKED_IN (attended=true) or NO_SHOW (attended=false). Available to the owner from one hour before the open house starts; previous marks can be corrected.
+// @Tags        Visits
+// @Accept      json
+// @Produce     json
+// @Security    BearerAuth
+// @Param       Authorization header string true "Bearer token"
+// @Param       request body dto.CheckInOpenHouseRegistrationRequest true "Check-in payload"
+// @Success     200 {object} dto.OpenHouseRegistrationResponse
+// @Failure     400 {object} dto.ErrorResponse
+// @Failure     401 {object} dto.ErrorResponse
+// @Failure     403 {object} dto.ErrorResponse
+// @Failure     404 {object} dto.ErrorResponse
+// @Failure     409 {object} dto.ErrorResponse
+// @Failure     500 {object} dto.ErrorResponse
+// @Router      /visits/open-houses/registrations/check-in [post]
+func (h *VisitHandler) CheckInOpenHouseRegistration(c *gin.Context) {
+	baseCtx := coreutils.EnrichContextWithRequestInfo(c.Request.Context(), c)
+
+	var req dto.CheckInOpenHouseRegistrationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperrors.SendHTTPErrorObj(c, httperrors.ConvertBindError(err))
+		return
+	}
+
+	ctx := coreutils.ContextWithLogger(baseCtx)
+	registration, svcErr := h.visitService.CheckInOpenHouseRegistration(ctx, req.RegistrationID, *req.Attended)
+	if svcErr != nil {
+		httperrors.SendHTTPErrorObj(c, svcErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, converters.OpenHouseRegistrationToResponse(visitservice.OpenHouseRegistrationOutput{Registration: registration}))
+}
@@ -83,6 +83,12 @@ func mapErrorToHTTP(err error) (status int, message string, details any) {
 		return http.StatusConflict, "Photo session awaiting photographer decision", nil
 	case errorsIs(err, derrors.ErrPhotoSessionAlreadyFinal):
 		return http.StatusConflict, "Photo session already finalized", nil
+	case errorsIs(err, derrors.ErrPhotoSessionNotReschedulable):
+		return http.StatusConflict, "Photo session cannot be rescheduled", nil
+	case errorsIs(err, derrors.ErrRescheduleWindowClosed):
+		return http.StatusConflict, "Photo session reschedule window closed", nil
+	case errorsIs(err, derrors.ErrRescheduleLimitReached):
+		return http.StatusConflict, "Photo session reschedule limit reached", nil
 	case errorsIs(err, derrors.ErrRoleNotSystem):
 		return http.StatusBadRequest, "Role must be a system role", nil
 	case errorsIs(err, derrors.ErrAdminRoleProtected):
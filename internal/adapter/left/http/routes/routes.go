@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 	adminhandlers "github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/admin_handlers"
 	authhandlers "github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/auth_handlers"
+	calendarfeedhandlers "github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/calendar_feed_handlers"
 	holidayhandlers "github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/holiday_handlers"
 	listinghandlers "github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/listing_handlers"
 	mediaprocessinghandlers "github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/media_processing_handlers"
@@ -72,6 +73,7 @@ func SetupRoutes(
 	photoSessionHandler := handlers.PhotoSessionHandler
 	visitHandler := handlers.VisitHandler
 	proposalHandler := handlers.ProposalHandler
+	calendarFeedHandler := handlers.CalendarFeedHandler
 
 	// API base routes (v2)
 	base := "/api/v2"
@@ -83,6 +85,15 @@ func SetupRoutes(
 	// Public callback (Step Functions webhook) - bypass auth but honors version provider
 	router.POST(base+"/listings/media/callback", mediaProcessingHandler.HandleProcessingCallback)
 
+	// Public HLS playlists - access granted by the signed token in the path
+	router.GET(base+"/listings/media/hls/:token/:playlist", mediaProcessingHandler.GetHLSPlaylist)
+
+	// Public 360° panorama tiles - access granted by the signed token in the path
+	router.GET(base+"/listings/media/panorama/:token/:level/:tile", mediaProcessingHandler.GetPanoramaTile)
+
+	// Public iCalendar feeds - access granted by the secret token in the path
+	router.GET(base+"/calendar/feeds/:token", calendarFeedHandler.GetFeed)
+
 	// Register user routes with dependencies
 	RegisterUserRoutes(v1, authHandler, userHandler, activityTracker, permissionService, tokenBlocklist)
 
@@ -93,7 +104,7 @@ func SetupRoutes(
 	RegisterProposalRoutes(v1, proposalHandler, activityTracker, permissionService, tokenBlocklist)
 
 	// Register admin routes with dependencies
-	RegisterAdminRoutes(v1, adminHandler, holidayHandler, activityTracker, permissionService, tokenBlocklist)
+	RegisterAdminRoutes(v1, adminHandler, holidayHandler, mediaProcessingHandler, photoSessionHandler, activityTracker, permissionService, tokenBlocklist)
 
 	// Register schedule routes (authenticated)
 	RegisterScheduleRoutes(v1, scheduleHandler, activityTracker, permissionService, tokenBlocklist)
@@ -103,6 +114,9 @@ func SetupRoutes(
 
 	// Register photographer routes (authenticated)
 	RegisterPhotographerRoutes(v1, photoSessionHandler, activityTracker, permissionService, tokenBlocklist)
+
+	// Register calendar feed management routes (authenticated)
+	RegisterCalendarFeedRoutes(v1, calendarFeedHandler, activityTracker, permissionService, tokenBlocklist)
 }
 
 // setupGlobalMiddlewares configura middlewares aplicados a todas as rotas
@@ -212,6 +226,20 @@ func RegisterUserRoutes(
 
 		// Account management
 		user.DELETE("/account", userHandler.DeleteAccount)
+
+		// LGPD personal data export
+		user.POST("/data-export", userHandler.RequestDataExport)  // RequestDataExport
+		user.GET("/data-export", userHandler.GetDataExportStatus) // GetDataExportStatus
+
+		// Consent and notification preference center
+		user.GET("/notification-preferences", userHandler.GetNotificationPreferences)    // GetNotificationPreferences
+		user.PUT("/notification-preferences", userHandler.UpdateNotificationPreferences) // UpdateNotificationPreferences
+
+		// In-app notification inbox
+		user.GET("/notifications", userHandler.ListNotifications)                       // ListNotifications
+		user.POST("/notifications/read", userHandler.MarkNotificationRead)              // MarkNotificationRead
+		user.POST("/notifications/read-all", userHandler.MarkAllNotificationsRead)      // MarkAllNotificationsRead
+		user.GET("/notifications/unread-count", userHandler.GetUnreadNotificationCount) // GetUnreadNotificationCount
 	}
 
 	// Realtor routes (Realtor only)
@@ -245,6 +273,13 @@ func RegisterScheduleRoutes(
 		schedules.DELETE("/listing/block", scheduleHandler.DeleteBlockRule)
 		schedules.GET("/listing/availability", scheduleHandler.GetListingAvailability)
 		schedules.POST("/listing/finish", scheduleHandler.PostFinishListingAgenda)
+		schedules.POST("/listing/import/preview", scheduleHandler.PostPreviewListingImport)
+		schedules.POST("/listing/import", scheduleHandler.PostListingImport)
+		schedules.GET("/templates", scheduleHandler.GetTemplates)
+		schedules.POST("/templates", scheduleHandler.PostCreateTemplate)
+		schedules.PUT("/templates", scheduleHandler.PutUpdateTemplate)
+		schedules.DELETE("/templates", scheduleHandler.DeleteTemplate)
+		schedules.POST("/templates/apply", scheduleHandler.PostApplyTemplate)
 	}
 }
 
@@ -265,6 +300,35 @@ func RegisterVisitRoutes(
 		visits.GET("/owner", visitHandler.ListVisitsOwner)
 		visits.GET("/realtor", visitHandler.ListVisitsRealtor)
 		visits.POST("/detail", visitHandler.GetVisit)
+
+		// Open houses (group visits)
+		visits.POST("/open-houses", visitHandler.CreateOpenHouse)
+		visits.GET("/open-houses", visitHandler.ListOpenHousesListing)
+		visits.GET("/open-houses/owner", visitHandler.ListOpenHousesOwner)
+		visits.POST("/open-houses/detail", visitHandler.GetOpenHouse)
+		visits.POST("/open-houses/cancel", visitHandler.CancelOpenHouse)
+		visits.POST("/open-houses/notify", visitHandler.NotifyOpenHouse)
+		visits.POST("/open-houses/registrations", visitHandler.RegisterOpenHouseClient)
+		visits.POST("/open-houses/registrations/cancel", visitHandler.CancelOpenHouseRegistration)
+		visits.POST("/open-houses/registrations/check-in", visitHandler.CheckInOpenHouseRegistration)
+	}
+}
+
+// RegisterCalendarFeedRoutes registers the management of the user's iCalendar subscription
+func RegisterCalendarFeedRoutes(
+	router *gin.RouterGroup,
+	calendarFeedHandler *calendarfeedhandlers.CalendarFeedHandler,
+	activityTracker *goroutines.ActivityTracker,
+	permissionService permissionservice.PermissionServiceInterface,
+	tokenBlocklist cacheport.TokenBlocklistPort,
+) {
+	feed := router.Group("/user/calendar-feed")
+	feed.Use(middlewares.AuthMiddleware(activityTracker, tokenBlocklist))
+	feed.Use(middlewares.PermissionMiddleware(permissionService))
+	{
+		feed.GET("", calendarFeedHandler.GetCalendarFeed)         // GetCalendarFeed
+		feed.POST("", calendarFeedHandler.RegenerateCalendarFeed) // RegenerateCalendarFeed
+		feed.DELETE("", calendarFeedHandler.RevokeCalendarFeed)   // RevokeCalendarFeed
 	}
 }
 
@@ -302,6 +366,8 @@ func RegisterListingRoutes(
 		listings.GET("/photo-session/slots", listingHandler.ListPhotographerSlots)
 		listings.POST("/photo-session/reserve", listingHandler.ReservePhotoSession)
 		listings.POST("/photo-session/cancel", listingHandler.CancelPhotoSession)
+		listings.POST("/photo-session/reschedule", listingHandler.ReschedulePhotoSession)
+		listings.POST("/photo-session/rating", listingHandler.RatePhotoSession)
 
 		// Media processing routes
 		media := listings.Group("/media")
@@ -311,6 +377,8 @@ func RegisterListingRoutes(
 			media.POST("/uploads/process", mediaProcessingHandler.ProcessMedia)   // ProcessMedia - trigger async processing
 			media.POST("/uploads/complete", mediaProcessingHandler.CompleteMedia) // CompleteMedia - finalize and zip
 			media.POST("/update", mediaProcessingHandler.UpdateMedia)             // UpdateMedia - update metadata
+			media.POST("/gallery", mediaProcessingHandler.ArrangeGallery)         // ArrangeGallery - order, covers and room tags
+			media.POST("/tour", mediaProcessingHandler.SaveTour)                  // SaveTour - 360° virtual tour graph
 			media.DELETE("/delete", mediaProcessingHandler.DeleteMedia)           // DeleteMedia - remove asset
 			media.POST("/approve", mediaProcessingHandler.ApproveListingMedia)    // ApproveListingMedia - owner approval
 
@@ -384,11 +452,18 @@ func RegisterPhotographerRoutes(
 
 			// DELETE /api/v2/photographer/agenda/time-off
 			agenda.DELETE("/time-off", photoSessionHandler.DeleteTimeOff)
+
+			// POST /api/v2/photographer/agenda/time-off/import/preview
+			agenda.POST("/time-off/import/preview", photoSessionHandler.PreviewTimeOffImport)
+
+			// POST /api/v2/photographer/agenda/time-off/import
+			agenda.POST("/time-off/import", photoSessionHandler.ImportTimeOff)
 		}
 
 		sessions := photographer.Group("/sessions")
 		{
 			sessions.POST("/status", photoSessionHandler.UpdateSessionStatus)
+			sessions.POST("/arrival", photoSessionHandler.ConfirmArrival)
 		}
 
 		serviceAreas := photographer.Group("/service-area")
@@ -399,6 +474,12 @@ func RegisterPhotographerRoutes(
 			serviceAreas.PUT("", photoSessionHandler.UpdateServiceArea)
 			serviceAreas.DELETE("", photoSessionHandler.DeleteServiceArea)
 		}
+
+		payouts := photographer.Group("/payouts")
+		{
+			// GET /api/v2/photographer/payouts/statement
+			payouts.GET("/statement", photoSessionHandler.GetPayoutStatement)
+		}
 	}
 }
 
@@ -407,6 +488,8 @@ func RegisterAdminRoutes(
 	router *gin.RouterGroup,
 	adminHandler *adminhandlers.AdminHandler,
 	holidayHandler *holidayhandlers.HolidayHandler,
+	mediaProcessingHandler *mediaprocessinghandlers.MediaProcessingHandler,
+	photoSessionHandler *photosessionhandlers.PhotoSessionHandler,
 	activityTracker *goroutines.ActivityTracker,
 	permissionService permissionservice.PermissionServiceInterface,
 	tokenBlocklist cacheport.TokenBlocklistPort,
@@ -431,6 +514,7 @@ func RegisterAdminRoutes(
 			usersGroup.POST("/system", adminHandler.PostAdminCreateSystemUser)
 			usersGroup.PUT("/system", adminHandler.PutAdminUpdateSystemUser)
 			usersGroup.DELETE("/system", adminHandler.DeleteAdminSystemUser)
+			usersGroup.GET("/roles/expiring", adminHandler.GetAdminExpiringRoles)
 
 			creciGroup := usersGroup.Group("/creci")
 			{
@@ -531,4 +615,21 @@ func RegisterAdminRoutes(
 		holidayGroup.GET("/dates", holidayHandler.ListCalendarDates)
 		holidayGroup.DELETE("/dates", holidayHandler.DeleteCalendarDate)
 	}
+
+	mediaJobsGroup := admin.Group("/media/jobs")
+	{
+		mediaJobsGroup.GET("", mediaProcessingHandler.ListProcessingJobs)
+		mediaJobsGroup.POST("/detail", mediaProcessingHandler.GetProcessingJob)
+		mediaJobsGroup.POST("/retry", mediaProcessingHandler.RetryProcessingJob)
+		mediaJobsGroup.POST("/cancel", mediaProcessingHandler.CancelProcessingJob)
+		mediaJobsGroup.POST("/force-complete", mediaProcessingHandler.ForceCompleteProcessingJob)
+	}
+
+	photoSessionsGroup := admin.Group("/photo-sessions")
+	{
+		photoSessionsGroup.GET("/reschedules", photoSessionHandler.GetRescheduleReport)
+		photoSessionsGroup.GET("/payout-rules", photoSessionHandler.ListPayoutRules)
+		photoSessionsGroup.POST("/payouts/close", photoSessionHandler.ClosePayoutPeriod)
+		photoSessionsGroup.GET("/photographer-kpis", photoSessionHandler.GetPhotographerKPIs)
+	}
 }
@@ -0,0 +1,54 @@
+package stepfunctions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	derrors "github.com/projeto-toq/toq_server/internal/core/derrors"
+	"github.com/projeto-toq/toq_server/internal/core/port/right/workflow"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// maxStopCauseLength is the limit Step Functions applies to the cause of a stopped execution.
+const maxStopCauseLength = 32768
+
+// StopExecution aborts a processing or finalization execution. Executions that do not exist are
+// reported as workflow.ErrExecutionNotFound so the caller can still close its own job record.
+func (a *StepFunctionsAdapter) StopExecution(ctx context.Context, executionARN string, cause string) error {
+	ctx = utils.ContextWithLogger(ctx)
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return derrors.Infra("failed to create tracer", err)
+	}
+	defer spanEnd()
+
+	logger := utils.LoggerFromContext(ctx)
+
+	if len(cause) > maxStopCauseLength {
+		cause = cause[:maxStopCauseLength]
+	}
+
+	_, err = a.client.StopExecution(ctx, &sfn.StopExecutionInput{
+		ExecutionArn: aws.String(executionARN),
+		Error:        aws.String("CANCELLED"),
+		Cause:        aws.String(cause),
+	})
+	if err != nil {
+		var notFound *types.ExecutionDoesNotExist
+		if errors.As(err, &notFound) {
+			logger.Warn("adapter.stepfunctions.stop_execution_not_found", "execution_arn", executionARN)
+			return workflow.ErrExecutionNotFound
+		}
+
+		utils.SetSpanError(ctx, err)
+		logger.Error("adapter.stepfunctions.stop_execution_error", "err", err, "execution_arn", executionARN)
+		return fmt.Errorf("stop workflow execution: %w", err)
+	}
+
+	logger.Info("adapter.stepfunctions.stop_execution_success", "execution_arn", executionARN)
+	return nil
+}
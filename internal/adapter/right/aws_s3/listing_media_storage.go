@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"path"
 	"regexp"
 	"strings"
@@ -410,6 +411,10 @@ func mediaTypePathSegment(assetType mediaprocessingmodel.MediaAssetType) string
 		return "project/doc"
 	case mediaprocessingmodel.MediaAssetTypeProjectRender:
 		return "project/render"
+	case mediaprocessingmodel.MediaAssetTypePanorama360:
+		return "panorama/360"
+	case mediaprocessingmodel.MediaAssetTypeLandParcel:
+		return "land/parcel"
 	case mediaprocessingmodel.MediaAssetTypeThumbnail:
 		return "thumb"
 	case mediaprocessingmodel.MediaAssetTypeZip:
@@ -460,6 +465,10 @@ func defaultExtension(contentType string) string {
 		return ".mov"
 	case "application/pdf":
 		return ".pdf"
+	case mediaprocessingmodel.ParcelContentTypeKMZ:
+		return ".kmz"
+	case mediaprocessingmodel.ParcelContentTypeKML:
+		return ".kml"
 	default:
 		return ".bin"
 	}
@@ -542,6 +551,32 @@ func (a *ListingMediaStorageAdapter) DownloadFile(ctx context.Context, key strin
 	return buf.Bytes(), nil
 }
 
+// OpenFile streams an object from the listing bucket without buffering it in memory.
+func (a *ListingMediaStorageAdapter) OpenFile(ctx context.Context, key string) (io.ReadCloser, error) {
+	ctx = utils.ContextWithLogger(ctx)
+	ctx, spanEnd, err := utils.GenerateBusinessTracer(ctx, "ListingMediaStorage.OpenFile")
+	if err != nil {
+		return nil, derrors.Infra("failed to create tracer", err)
+	}
+	defer spanEnd()
+
+	if err := a.ensureClients(); err != nil {
+		utils.SetSpanError(ctx, err)
+		return nil, err
+	}
+
+	output, err := a.readerClient.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(a.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		return nil, derrors.Infra("failed to open file", err)
+	}
+
+	return output.Body, nil
+}
+
 // UploadFile uploads content from memory to the listing bucket.
 func (a *ListingMediaStorageAdapter) UploadFile(ctx context.Context, key string, content []byte, contentType string) error {
 	ctx = utils.ContextWithLogger(ctx)
@@ -0,0 +1,80 @@
+package s3adapter
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	storageport "github.com/projeto-toq/toq_server/internal/core/port/right/storage"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// Download streams an object body; the caller must close the returned reader.
+func (s *S3Adapter) Download(ctx context.Context, bucket, key string) (io.ReadCloser, error) {
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	// Usa o readerClient por princípio de menor privilégio
+	resp, err := s.readerClient.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("adapter.s3.download_error", "bucket", bucket, "key", key, "error", err)
+		return nil, fmt.Errorf("failed to download from s3: %w", err)
+	}
+	return resp.Body, nil
+}
+
+// Upload stores the body using the multipart uploader so large bundles are streamed.
+func (s *S3Adapter) Upload(ctx context.Context, bucket, key string, body io.Reader, contentType string) error {
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	_, err := s.uploader.Upload(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(bucket),
+		Key:         aws.String(key),
+		Body:        body,
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("adapter.s3.upload_error", "bucket", bucket, "key", key, "error", err)
+		return fmt.Errorf("failed to upload to s3: %w", err)
+	}
+	return nil
+}
+
+// GetMetadata returns the object size and ETag through a HEAD request.
+func (s *S3Adapter) GetMetadata(ctx context.Context, bucket, key string) (int64, string, error) {
+	resp, err := s.readerClient.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to head object: %w", err)
+	}
+
+	var size int64
+	if resp.ContentLength != nil {
+		size = *resp.ContentLength
+	}
+
+	var etag string
+	if resp.ETag != nil {
+		etag = *resp.ETag
+	}
+
+	return size, etag, nil
+}
+
+// ListingBucketName exposes the bucket that stores raw and processed listing media.
+func (s *S3Adapter) ListingBucketName() string {
+	return s.listingBucketName
+}
+
+var _ storageport.MediaObjectStoragePort = (*S3Adapter)(nil)
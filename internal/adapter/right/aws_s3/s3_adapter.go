@@ -32,7 +32,8 @@ func NewS3Adapter(ctx context.Context, env *globalmodel.Environment) (s3Adapter
 	logger.Info("adapter.s3.creating",
 		"region", env.S3.Region,
 		"user_bucket", env.S3.UserBucketName,
-		"listing_bucket", env.S3.ListingBucketName)
+		"listing_bucket", env.S3.ListingBucketName,
+		"endpoint", env.S3.Endpoint)
 
 	// Configuração básica da AWS
 	cfg, err := config.LoadDefaultConfig(ctx,
@@ -50,7 +51,7 @@ func NewS3Adapter(ctx context.Context, env *globalmodel.Environment) (s3Adapter
 		logger.Info("adapter.s3.credentials.override", "client", "admin", "mode", mode)
 		adminCfg.Credentials = provider
 	}
-	adminClient := s3.NewFromConfig(adminCfg)
+	adminClient := s3.NewFromConfig(adminCfg, clientOptions(env))
 
 	// Cliente Reader com credenciais específicas
 	readerCfg := cfg.Copy()
@@ -58,7 +59,7 @@ func NewS3Adapter(ctx context.Context, env *globalmodel.Environment) (s3Adapter
 		logger.Info("adapter.s3.credentials.override", "client", "reader", "mode", mode)
 		readerCfg.Credentials = provider
 	}
-	readerClient := s3.NewFromConfig(readerCfg)
+	readerClient := s3.NewFromConfig(readerCfg, clientOptions(env))
 
 	// Uploader e Downloader usando admin/reader clients
 	uploader := manager.NewUploader(adminClient)
@@ -87,6 +88,17 @@ func NewS3Adapter(ctx context.Context, env *globalmodel.Environment) (s3Adapter
 	return s3Adapter, CloseFunc, nil
 }
 
+// clientOptions points the clients to a custom endpoint (MinIO and other S3-compatible stores)
+// when configured; with the defaults the SDK resolves the regional AWS endpoint.
+func clientOptions(env *globalmodel.Environment) func(*s3.Options) {
+	return func(o *s3.Options) {
+		if env.S3.Endpoint != "" {
+			o.BaseEndpoint = aws.String(env.S3.Endpoint)
+		}
+		o.UsePathStyle = env.S3.UsePathStyle
+	}
+}
+
 func resolveStaticCredentials(cfgAccessKey, cfgSecretKey, envAccessKey, envSecretKey string) (aws.CredentialsProvider, string) {
 	switch {
 	case cfgAccessKey != "" && cfgSecretKey != "":
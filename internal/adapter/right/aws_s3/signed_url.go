@@ -54,6 +54,31 @@ func (s *S3Adapter) GenerateV4GetObjectSignedURL(bucketName, objectName string)
 	return request.URL, nil
 }
 
+// GenerateUserObjectDownloadURL gera uma URL assinada (GET) com validade customizada para objetos do bucket de usuários
+func (s *S3Adapter) GenerateUserObjectDownloadURL(objectKey string, ttl time.Duration) (string, error) {
+	if s.readerClient == nil {
+		return "", fmt.Errorf("reader client is not initialized")
+	}
+	if ttl <= 0 {
+		ttl = 60 * time.Minute
+	}
+
+	presignClient := s3.NewPresignClient(s.readerClient)
+
+	request, err := presignClient.PresignGetObject(context.TODO(), &s3.GetObjectInput{
+		Bucket: aws.String(s.userBucketName),
+		Key:    aws.String(objectKey),
+	}, func(opts *s3.PresignOptions) {
+		opts.Expires = ttl
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("failed to generate S3 signed URL for GET: %w", err)
+	}
+
+	return request.URL, nil
+}
+
 // GeneratePhotoSignedURL gera uma URL para upload de foto específica do usuário
 func (s *S3Adapter) GeneratePhotoSignedURL(bucketName string, userID int64, photoType, contentType string) (string, error) {
 	objectPath := fmt.Sprintf("%d/%s", userID, photoType)
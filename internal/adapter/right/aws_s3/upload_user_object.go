@@ -0,0 +1,46 @@
+package s3adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// UploadUserObject stores content under the user's prefix in the user bucket.
+//
+// The object key is "{userID}/{objectName}", so objects are removed together with
+// the rest of the user folder by DeleteUserFolder. content is streamed with multipart
+// uploads, so it does not need to fit in memory.
+//
+// Returns the full object key, usable with GenerateUserObjectDownloadURL.
+func (s *S3Adapter) UploadUserObject(ctx context.Context, userID int64, objectName string, content io.Reader, contentType string) (string, error) {
+	if s.uploader == nil {
+		return "", errors.New("s3 uploader is nil")
+	}
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	objectKey := fmt.Sprintf("%d/%s", userID, strings.TrimPrefix(objectName, "/"))
+
+	_, err := s.uploader.Upload(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.userBucketName),
+		Key:         aws.String(objectKey),
+		Body:        content,
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("adapter.s3.upload_user_object.error", "user_id", userID, "key", objectKey, "error", err)
+		return "", fmt.Errorf("upload user object %s: %w", objectKey, err)
+	}
+
+	logger.Info("adapter.s3.upload_user_object.success", "user_id", userID, "key", objectKey)
+	return objectKey, nil
+}
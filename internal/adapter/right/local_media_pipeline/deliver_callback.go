@@ -0,0 +1,107 @@
+package localmediapipelineadapter
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/projeto-toq/toq_server/internal/core/domain/dto"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+const callbackRetryDelay = time.Second
+
+// callbackPayload mirrors the body the callback Lambda posts to /listings/media/callback.
+type callbackPayload struct {
+	JobID             uint64                                           `json:"jobId"`
+	ListingIdentityID uint64                                           `json:"listingIdentityId"`
+	ExecutionARN      string                                           `json:"executionArn,omitempty"`
+	StartedAt         string                                           `json:"startedAt,omitempty"`
+	Provider          string                                           `json:"provider,omitempty"`
+	Status            string                                           `json:"status"`
+	FailureReason     string                                           `json:"failureReason,omitempty"`
+	Error             *callbackError                                   `json:"error,omitempty"`
+	Outputs           []mediaprocessingmodel.MediaProcessingJobPayload `json:"outputs,omitempty"`
+	AssetsZipped      int                                              `json:"assetsZipped,omitempty"`
+	ZipBundles        []string                                         `json:"zipBundles,omitempty"`
+	ZipSizeBytes      int64                                            `json:"zipSizeBytes,omitempty"`
+	UnzippedSizeBytes int64                                            `json:"unzippedSizeBytes,omitempty"`
+	ZipManifestKey    string                                           `json:"zipManifestKey,omitempty"`
+	ZipSHA256         string                                           `json:"zipSha256,omitempty"`
+	Traceparent       string                                           `json:"traceparent,omitempty"`
+}
+
+type callbackError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// deliver hands the result to the media service, retrying with exponential backoff. Retries also
+// cover the window in which the job row is still uncommitted: services enqueue before committing.
+func (a *LocalMediaPipelineAdapter) deliver(ctx context.Context, payload callbackPayload) {
+	logger := utils.LoggerFromContext(ctx)
+
+	handler := a.callbackHandler()
+	if handler == nil {
+		logger.Error("adapter.local_media_pipeline.callback_handler_missing", "job_id", payload.JobID)
+		return
+	}
+
+	input := toCallbackInput(payload)
+
+	delay := callbackRetryDelay
+	for attempt := 1; attempt <= a.callbackMaxAttempts; attempt++ {
+		_, err := handler(ctx, input)
+		if err == nil {
+			logger.Info("adapter.local_media_pipeline.callback_delivered", "job_id", payload.JobID, "status", payload.Status, "attempt", attempt)
+			return
+		}
+
+		logger.Warn("adapter.local_media_pipeline.callback_attempt_failed", "job_id", payload.JobID, "attempt", attempt, "error", err)
+		if attempt == a.callbackMaxAttempts || !a.wait(delay) {
+			utils.SetSpanError(ctx, err)
+			logger.Error("adapter.local_media_pipeline.callback_failed", "job_id", payload.JobID, "status", payload.Status, "error", err)
+			return
+		}
+		delay *= 2
+	}
+}
+
+// toCallbackInput applies the normalization done by the HTTP callback converter.
+func toCallbackInput(payload callbackPayload) dto.HandleProcessingCallbackInput {
+	rawPayload, _ := json.Marshal(payload)
+
+	input := dto.HandleProcessingCallbackInput{
+		JobID:             payload.JobID,
+		ListingIdentityID: payload.ListingIdentityID,
+		Provider:          strings.ToUpper(payload.Provider),
+		Status:            strings.ToUpper(payload.Status),
+		ExecutionARN:      payload.ExecutionARN,
+		Results:           dto.ProcessingResultsFromPayloads(payload.Outputs),
+		AssetsZipped:      payload.AssetsZipped,
+		ZipBundles:        payload.ZipBundles,
+		ZipSizeBytes:      payload.ZipSizeBytes,
+		UnzippedSizeBytes: payload.UnzippedSizeBytes,
+		ZipManifestKey:    payload.ZipManifestKey,
+		ZipSHA256:         payload.ZipSHA256,
+		FailureReason:     payload.FailureReason,
+		Traceparent:       payload.Traceparent,
+		RawPayload:        string(rawPayload),
+	}
+	if len(input.ZipBundles) == 0 {
+		input.ZipBundles = nil
+	}
+	if payload.Error != nil {
+		input.Error = payload.Error.Message
+		input.ErrorCode = strings.ToUpper(payload.Error.Code)
+	}
+	if payload.StartedAt != "" {
+		if startedAt, err := time.Parse(time.RFC3339, payload.StartedAt); err == nil {
+			startedAt = startedAt.UTC()
+			input.StartedAt = &startedAt
+		}
+	}
+	return input
+}
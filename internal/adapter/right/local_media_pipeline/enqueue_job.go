@@ -0,0 +1,103 @@
+package localmediapipelineadapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	derrors "github.com/projeto-toq/toq_server/internal/core/derrors"
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// EnqueueJob schedules the processing pipeline for the job and returns its execution ARN, which
+// the media service records on the job so StopExecution works before the first callback.
+func (a *LocalMediaPipelineAdapter) EnqueueJob(ctx context.Context, payload mediaprocessingmodel.MediaProcessingJobMessage) (string, error) {
+	return a.enqueueProcessing(ctx, payload, "LocalMediaPipeline.EnqueueJob")
+}
+
+// EnqueueRetry schedules the job again; locally there is no separate retry queue.
+func (a *LocalMediaPipelineAdapter) EnqueueRetry(ctx context.Context, payload mediaprocessingmodel.MediaProcessingJobMessage) (string, error) {
+	return a.enqueueProcessing(ctx, payload, "LocalMediaPipeline.EnqueueRetry")
+}
+
+func (a *LocalMediaPipelineAdapter) enqueueProcessing(ctx context.Context, payload mediaprocessingmodel.MediaProcessingJobMessage, operation string) (string, error) {
+	ctx = utils.ContextWithLogger(ctx)
+	ctx, spanEnd, err := utils.GenerateBusinessTracer(ctx, operation)
+	if err != nil {
+		return "", derrors.Infra("failed to create tracer", err)
+	}
+	defer spanEnd()
+
+	messageID := uuid.New().String()
+	task := pipelineTask{
+		kind:         taskKindProcessing,
+		job:          payload,
+		executionARN: fmt.Sprintf("local:processing:%d:%d:%s", payload.ListingIdentityID, payload.JobID, messageID),
+	}
+	if err := a.submit(ctx, task); err != nil {
+		return "", err
+	}
+
+	utils.LoggerFromContext(ctx).Info("adapter.local_media_pipeline.job_enqueued",
+		"job_id", payload.JobID,
+		"listing_identity_id", payload.ListingIdentityID,
+		"assets", len(payload.Assets),
+		"retry", payload.Retry,
+		"execution_arn", task.executionARN)
+	return task.executionARN, nil
+}
+
+// submit hands the task to the pool without blocking the caller; a full buffer surfaces as an
+// infra error so the service rolls back exactly as it does when SQS is unavailable.
+func (a *LocalMediaPipelineAdapter) submit(ctx context.Context, task pipelineTask) error {
+	if a == nil || a.tasks == nil {
+		err := derrors.Infra("local media pipeline not configured", nil)
+		utils.SetSpanError(ctx, err)
+		return err
+	}
+	if a.ctx.Err() != nil {
+		err := derrors.Infra("local media pipeline is shutting down", a.ctx.Err())
+		utils.SetSpanError(ctx, err)
+		return err
+	}
+
+	task.spanContext = trace.SpanFromContext(ctx).SpanContext()
+	task.requestID = ctx.Value(globalmodel.RequestIDKey)
+
+	a.trackExecution(task.executionARN)
+	select {
+	case a.tasks <- task:
+		return nil
+	default:
+		a.finishExecution(task.executionARN)
+		err := derrors.Infra("local media pipeline queue is full", nil)
+		utils.SetSpanError(ctx, err)
+		utils.LoggerFromContext(ctx).Error("adapter.local_media_pipeline.queue_full", "kind", task.kind, "capacity", cap(a.tasks))
+		return err
+	}
+}
+
+// DecodeMessage parses a callback body using the same rules as the SQS adapter.
+func (a *LocalMediaPipelineAdapter) DecodeMessage(ctx context.Context, rawBody string) (mediaprocessingmodel.MediaProcessingCallback, error) {
+	if rawBody == "" {
+		return mediaprocessingmodel.MediaProcessingCallback{}, derrors.Validation("empty callback payload", nil)
+	}
+
+	var callback mediaprocessingmodel.MediaProcessingCallback
+	if err := json.Unmarshal([]byte(rawBody), &callback); err != nil {
+		return mediaprocessingmodel.MediaProcessingCallback{}, derrors.Validation("invalid callback payload", map[string]string{"error": err.Error()})
+	}
+	callback.RawBody = rawBody
+
+	return callback, nil
+}
+
+// Acknowledge is a no-op: in-process tasks are removed from the buffer when a worker takes them.
+func (a *LocalMediaPipelineAdapter) Acknowledge(ctx context.Context, receiptHandle string) error {
+	return nil
+}
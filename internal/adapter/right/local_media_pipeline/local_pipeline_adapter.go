@@ -0,0 +1,186 @@
+package localmediapipelineadapter
+
+import (
+	"context"
+	"os/exec"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/projeto-toq/toq_server/internal/core/domain/dto"
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	mediaprocessingqueue "github.com/projeto-toq/toq_server/internal/core/port/right/queue/mediaprocessingqueue"
+	storageport "github.com/projeto-toq/toq_server/internal/core/port/right/storage"
+	workflowport "github.com/projeto-toq/toq_server/internal/core/port/right/workflow"
+	imageprocessing "github.com/projeto-toq/toq_server/internal/core/service/media_pipeline/image_processing"
+	videoprocessing "github.com/projeto-toq/toq_server/internal/core/service/media_pipeline/video_processing"
+	zipservice "github.com/projeto-toq/toq_server/internal/core/service/media_pipeline/zip"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+const (
+	defaultWorkers             = 2
+	defaultQueueSize           = 100
+	defaultCallbackMaxAttempts = 5
+)
+
+// CallbackHandler receives the pipeline result, mirroring the HTTP callback endpoint contract.
+type CallbackHandler func(ctx context.Context, input dto.HandleProcessingCallbackInput) (dto.HandleProcessingCallbackOutput, error)
+
+// LocalMediaPipelineAdapter runs the media pipeline inside the server process.
+//
+// It implements both the processing queue port (validate → thumbnails → video thumbnails →
+// consolidate) and the finalization workflow port (ZIP bundle) with a bounded worker pool,
+// reusing the services deployed as Lambdas. Results are delivered through the CallbackHandler
+// with the same payload produced by the Step Functions pipelines, so the media service cannot
+// tell both backends apart.
+type LocalMediaPipelineAdapter struct {
+	storage         storageport.MediaObjectStoragePort
+	bucket          string
+	thumbnails      *imageprocessing.ThumbnailService
+	videoThumbnails *videoprocessing.VideoThumbnailService
+	hls             *videoprocessing.HLSService // nil when media_processing.local.hls.enabled is false
+	zip             *zipservice.ZipService
+
+	tasks               chan pipelineTask
+	workers             int
+	callbackMaxAttempts int
+
+	mu       sync.RWMutex
+	callback CallbackHandler
+
+	executionsMu sync.Mutex
+	executions   map[string]*localExecution // queued or running tasks, keyed by execution ARN
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	once   sync.Once
+}
+
+// pipelineTask is one unit of work consumed by the pool.
+type pipelineTask struct {
+	kind         pipelineTaskKind
+	job          mediaprocessingmodel.MediaProcessingJobMessage
+	finalization mediaprocessingmodel.MediaFinalizationInput
+	executionARN string
+	spanContext  trace.SpanContext
+	requestID    any
+}
+
+type pipelineTaskKind string
+
+// localExecution tracks a task so StopExecution can cancel it while queued or running.
+type localExecution struct {
+	cancel  context.CancelFunc
+	stopped bool
+}
+
+const (
+	taskKindProcessing   pipelineTaskKind = "processing"
+	taskKindFinalization pipelineTaskKind = "finalization"
+)
+
+// NewLocalMediaPipelineAdapter configures the pool from media_processing.local and starts the workers.
+// The callback handler must be registered with SetCallbackHandler once the media service exists.
+func NewLocalMediaPipelineAdapter(ctx context.Context, env *globalmodel.Environment, storage storageport.MediaObjectStoragePort, bucket string) *LocalMediaPipelineAdapter {
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	if storage == nil || bucket == "" {
+		logger.Warn("adapter.local_media_pipeline.storage_missing")
+		return nil
+	}
+
+	cfg := env.MediaProcessing.Local
+	workers := cfg.Workers
+	if workers <= 0 {
+		workers = defaultWorkers
+	}
+	queueSize := cfg.QueueSize
+	if queueSize <= 0 {
+		queueSize = defaultQueueSize
+	}
+	callbackMaxAttempts := cfg.CallbackMaxAttempts
+	if callbackMaxAttempts <= 0 {
+		callbackMaxAttempts = defaultCallbackMaxAttempts
+	}
+
+	ffmpegPath := cfg.FFmpegPath
+	if ffmpegPath == "" {
+		if resolved, err := exec.LookPath("ffmpeg"); err == nil {
+			ffmpegPath = resolved
+		}
+	}
+
+	extraEncoders, skipped := imageprocessing.BuildExtraEncoders(ctx, ffmpegPath, cfg.ImageFormats, cfg.ImageQuality)
+	for _, reason := range skipped {
+		logger.Warn("adapter.local_media_pipeline.image_format_disabled", "reason", reason)
+	}
+
+	// Workers outlive the request that enqueued the job; only the logger is carried over.
+	poolCtx, cancel := context.WithCancel(utils.ContextWithLogger(context.Background()))
+
+	var hls *videoprocessing.HLSService
+	if cfg.HLS.Enabled {
+		hls = videoprocessing.NewHLSService(storage, ffmpegPath, cfg.HLS.SegmentSeconds, cfg.HLS.PosterSecond, videoprocessing.ParseHLSRenditions(cfg.HLS.Renditions))
+	}
+
+	adapter := &LocalMediaPipelineAdapter{
+		storage:    storage,
+		bucket:     bucket,
+		thumbnails: imageprocessing.NewThumbnailService(storage, extraEncoders...),
+		videoThumbnails: videoprocessing.NewVideoThumbnailService(
+			storage,
+			ffmpegPath,
+			cfg.VideoThumbnailSeekSecond,
+			cfg.VideoThumbnailWidth,
+			cfg.VideoThumbnailQuality,
+		),
+		hls:                 hls,
+		zip:                 zipservice.NewZipService(storage, cfg.ZipPrefetch, int64(cfg.ZipReadAheadMB)<<20),
+		tasks:               make(chan pipelineTask, queueSize),
+		workers:             workers,
+		callbackMaxAttempts: callbackMaxAttempts,
+		executions:          make(map[string]*localExecution),
+		ctx:                 poolCtx,
+		cancel:              cancel,
+	}
+
+	for i := 0; i < workers; i++ {
+		adapter.wg.Add(1)
+		go adapter.worker(i)
+	}
+
+	logger.Info("adapter.local_media_pipeline.created", "workers", workers, "queue_size", queueSize, "bucket", bucket, "ffmpeg_path", ffmpegPath, "hls_enabled", hls != nil)
+	return adapter
+}
+
+// SetCallbackHandler registers the consumer of pipeline results (the media service).
+func (a *LocalMediaPipelineAdapter) SetCallbackHandler(handler CallbackHandler) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.callback = handler
+}
+
+// Close stops accepting work, cancels in-flight jobs and waits for the workers to exit.
+// Jobs interrupted here stay RUNNING and are picked up by the stuck job reconciler.
+func (a *LocalMediaPipelineAdapter) Close() error {
+	a.once.Do(func() {
+		a.cancel()
+		a.wg.Wait()
+	})
+	return nil
+}
+
+func (a *LocalMediaPipelineAdapter) callbackHandler() CallbackHandler {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.callback
+}
+
+var (
+	_ mediaprocessingqueue.QueuePortInterface = (*LocalMediaPipelineAdapter)(nil)
+	_ workflowport.WorkflowPortInterface      = (*LocalMediaPipelineAdapter)(nil)
+)
@@ -0,0 +1,94 @@
+package localmediapipelineadapter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+const (
+	listingMediaZipObject  = "listing-media.zip"
+	finalizationAttempts   = 3
+	finalizationRetryDelay = 10 * time.Second
+)
+
+// runFinalization builds the listing ZIP bundle with the retry policy of the finalization
+// state machine and reports either the bundle metadata or FINALIZATION_FAILED.
+func (a *LocalMediaPipelineAdapter) runFinalization(ctx context.Context, task pipelineTask) callbackPayload {
+	logger := utils.LoggerFromContext(ctx)
+	input := task.finalization
+
+	traceparent := input.Traceparent
+	if traceparent == "" {
+		traceparent = buildTraceparent(ctx)
+	}
+
+	payload := callbackPayload{
+		JobID:             input.JobID,
+		ListingIdentityID: input.ListingIdentityID,
+		Traceparent:       traceparent,
+	}
+
+	sourceKeys := make([]string, 0, len(input.Assets))
+	for _, asset := range input.Assets {
+		if asset.Key != "" {
+			sourceKeys = append(sourceKeys, asset.Key)
+		}
+	}
+
+	payload.Status = string(mediaprocessingmodel.MediaProcessingJobStatusSucceeded)
+	payload.Provider = string(mediaprocessingmodel.MediaProcessingProviderStepFunctionsFinalization)
+	payload.ZipBundles = []string{}
+	if len(sourceKeys) == 0 {
+		logger.Warn("adapter.local_media_pipeline.finalization_no_assets", "job_id", input.JobID, "listing_identity_id", input.ListingIdentityID)
+		return payload
+	}
+
+	destinationKey := fmt.Sprintf("%d/processed/zip/%s", input.ListingIdentityID, listingMediaZipObject)
+
+	var lastErr error
+	delay := finalizationRetryDelay
+	for attempt := 1; attempt <= finalizationAttempts; attempt++ {
+		result, err := a.zip.CreateZip(ctx, a.bucket, sourceKeys, destinationKey)
+		if err == nil {
+			payload.AssetsZipped = len(sourceKeys)
+			payload.ZipBundles = []string{destinationKey}
+			payload.ZipSizeBytes = result.ZipBytes
+			payload.UnzippedSizeBytes = result.UncompressedBytes
+			payload.ZipManifestKey = result.ManifestKey
+			payload.ZipSHA256 = result.SHA256
+
+			logger.Info("adapter.local_media_pipeline.finalization_finished",
+				"job_id", input.JobID,
+				"listing_identity_id", input.ListingIdentityID,
+				"zip_key", destinationKey,
+				"zip_size_bytes", result.ZipBytes,
+				"unzipped_size_bytes", result.UncompressedBytes)
+			return payload
+		}
+
+		lastErr = err
+		logger.Warn("adapter.local_media_pipeline.finalization_attempt_failed", "job_id", input.JobID, "attempt", attempt, "error", err)
+		if attempt == finalizationAttempts || !a.wait(delay) {
+			break
+		}
+		delay *= 2
+	}
+
+	utils.SetSpanError(ctx, lastErr)
+	logger.Error("adapter.local_media_pipeline.finalization_failed", "job_id", input.JobID, "listing_identity_id", input.ListingIdentityID, "error", lastErr)
+
+	return callbackPayload{
+		JobID:             input.JobID,
+		ListingIdentityID: input.ListingIdentityID,
+		Status:            "FINALIZATION_FAILED",
+		Traceparent:       traceparent,
+		Error: &callbackError{
+			Code:    "FINALIZATION_FAILED",
+			Message: lastErr.Error(),
+		},
+	}
+}
@@ -0,0 +1,159 @@
+package localmediapipelineadapter
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	"github.com/projeto-toq/toq_server/internal/core/service/media_pipeline/consolidate"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// runProcessing executes the same stages as the processing state machine: validate the raw
+// objects, generate image thumbnails, panorama tiles, video frames and HLS renditions, then
+// consolidate everything into the per-asset outputs reported to the backend.
+func (a *LocalMediaPipelineAdapter) runProcessing(ctx context.Context, task pipelineTask) callbackPayload {
+	logger := utils.LoggerFromContext(ctx)
+	startedAt := time.Now().UTC()
+	job := task.job
+
+	logger.Info("adapter.local_media_pipeline.processing_started", "job_id", job.JobID, "listing_identity_id", job.ListingIdentityID, "assets", len(job.Assets))
+
+	assets := a.validateAssets(ctx, job.Assets)
+
+	generated := make([]mediaprocessingmodel.JobAsset, 0, len(assets)*4)
+	branchErrors := make([]consolidate.BranchError, 0)
+
+	for _, asset := range assets {
+		if asset.Error != "" {
+			continue
+		}
+
+		if isVideo(asset) {
+			// Frame and HLS run independently, like the parallel branches of the state machine.
+			outputKey, err := a.videoThumbnails.GenerateThumbnail(ctx, a.bucket, asset.Key)
+			if err != nil {
+				logger.Error("adapter.local_media_pipeline.video_thumbnail_error", "job_id", job.JobID, "key", asset.Key, "error", err)
+				branchErrors = append(branchErrors, consolidate.BranchError{
+					SourceKey:    asset.Key,
+					ErrorCode:    "VIDEO_THUMBNAIL_FAILED",
+					ErrorMessage: err.Error(),
+				})
+			} else {
+				generated = append(generated, mediaprocessingmodel.JobAsset{
+					Key:       outputKey,
+					Type:      "VIDEO_THUMBNAIL",
+					SourceKey: asset.Key,
+				})
+			}
+
+			if a.hls == nil {
+				continue
+			}
+			hlsOutput, err := a.hls.Transcode(ctx, a.bucket, asset.Key)
+			if err != nil {
+				logger.Error("adapter.local_media_pipeline.video_hls_error", "job_id", job.JobID, "key", asset.Key, "error", err)
+				branchErrors = append(branchErrors, consolidate.BranchError{
+					SourceKey:    asset.Key,
+					ErrorCode:    "VIDEO_HLS_FAILED",
+					ErrorMessage: err.Error(),
+				})
+				continue
+			}
+			generated = append(generated, hlsOutput.GeneratedAssets(asset.Key)...)
+			continue
+		}
+
+		if asset.Type == string(mediaprocessingmodel.MediaAssetTypePanorama360) {
+			panorama, err := a.thumbnails.ProcessPanorama(ctx, a.bucket, asset.Key)
+			if err != nil {
+				logger.Error("adapter.local_media_pipeline.panorama_error", "job_id", job.JobID, "key", asset.Key, "error", err)
+				branchErrors = append(branchErrors, consolidate.BranchError{
+					SourceKey:    asset.Key,
+					ErrorCode:    mediaprocessingmodel.PanoramaErrorCode(err),
+					ErrorMessage: err.Error(),
+				})
+				continue
+			}
+			generated = append(generated, panorama.GeneratedAssets(asset.Type, asset.Key)...)
+			continue
+		}
+
+		result, err := a.thumbnails.ProcessImage(ctx, a.bucket, asset.Key, mediaprocessingmodel.ImageOptionsFor(job.ImageOptions, asset.Type))
+		for _, formatErr := range result.FormatErrs {
+			logger.Warn("adapter.local_media_pipeline.image_format_error", "job_id", job.JobID, "key", asset.Key, "error", formatErr)
+		}
+		if err != nil {
+			logger.Error("adapter.local_media_pipeline.thumbnail_error", "job_id", job.JobID, "key", asset.Key, "error", err)
+			branchErrors = append(branchErrors, consolidate.BranchError{
+				SourceKey:    asset.Key,
+				ErrorCode:    "THUMBNAIL_PROCESSING_FAILED",
+				ErrorMessage: err.Error(),
+			})
+			continue
+		}
+		generated = append(generated, result.GeneratedAssets(asset.Type, asset.Key)...)
+	}
+
+	accumulators := consolidate.InitializePayloads(assets)
+	for _, derivative := range generated {
+		if acc, ok := accumulators[derivative.SourceKey]; ok {
+			consolidate.MapGeneratedAsset(acc, derivative)
+		}
+	}
+	consolidate.ApplyBranchErrors(accumulators, branchErrors)
+
+	payload := callbackPayload{
+		JobID:             job.JobID,
+		ListingIdentityID: job.ListingIdentityID,
+		ExecutionARN:      task.executionARN,
+		StartedAt:         startedAt.Format(time.RFC3339),
+		Provider:          string(mediaprocessingmodel.MediaProcessingProviderLocal),
+		Status:            string(mediaprocessingmodel.MediaProcessingJobStatusSucceeded),
+		Outputs:           consolidate.FlattenPayloads(accumulators),
+		Traceparent:       buildTraceparent(ctx),
+	}
+	if len(branchErrors) > 0 {
+		payload.Status = string(mediaprocessingmodel.MediaProcessingJobStatusPartial)
+		payload.FailureReason = "DERIVATIVE_ERRORS_DETECTED"
+		payload.Error = &callbackError{
+			Code:    "DERIVATIVE_ERRORS_DETECTED",
+			Message: "one or more derivative branches reported errors",
+		}
+	}
+
+	logger.Info("adapter.local_media_pipeline.processing_finished",
+		"job_id", job.JobID,
+		"status", payload.Status,
+		"outputs", len(payload.Outputs),
+		"derivatives", len(generated),
+		"branch_errors", len(branchErrors),
+		"duration_ms", time.Since(startedAt).Milliseconds())
+	return payload
+}
+
+// validateAssets reads the object metadata of every raw asset; missing objects are flagged
+// instead of discarded so the backend can report them per asset.
+func (a *LocalMediaPipelineAdapter) validateAssets(ctx context.Context, assets []mediaprocessingmodel.JobAsset) []mediaprocessingmodel.JobAsset {
+	logger := utils.LoggerFromContext(ctx)
+
+	validated := make([]mediaprocessingmodel.JobAsset, 0, len(assets))
+	for _, asset := range assets {
+		asset.SourceKey = asset.Key
+		size, etag, err := a.storage.GetMetadata(ctx, a.bucket, asset.Key)
+		if err != nil {
+			logger.Warn("adapter.local_media_pipeline.asset_invalid", "key", asset.Key, "error", err)
+			asset.Error = err.Error()
+		} else {
+			asset.Size = size
+			asset.ETag = etag
+		}
+		validated = append(validated, asset)
+	}
+	return validated
+}
+
+func isVideo(asset mediaprocessingmodel.JobAsset) bool {
+	return strings.Contains(strings.ToUpper(asset.Type), "VIDEO")
+}
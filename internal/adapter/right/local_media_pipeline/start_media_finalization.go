@@ -0,0 +1,37 @@
+package localmediapipelineadapter
+
+import (
+	"context"
+	"fmt"
+
+	derrors "github.com/projeto-toq/toq_server/internal/core/derrors"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// StartMediaFinalization schedules the ZIP bundle build and returns a local execution identifier
+// following the naming used for Step Functions executions.
+func (a *LocalMediaPipelineAdapter) StartMediaFinalization(ctx context.Context, input mediaprocessingmodel.MediaFinalizationInput) (string, error) {
+	ctx = utils.ContextWithLogger(ctx)
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return "", derrors.Infra("failed to create tracer", err)
+	}
+	defer spanEnd()
+
+	executionARN := fmt.Sprintf("local:finalization-%d-%d", input.ListingIdentityID, input.JobID)
+	task := pipelineTask{
+		kind:         taskKindFinalization,
+		finalization: input,
+		executionARN: executionARN,
+	}
+	if err := a.submit(ctx, task); err != nil {
+		return "", fmt.Errorf("start finalization workflow: %w", err)
+	}
+
+	utils.LoggerFromContext(ctx).Info("adapter.local_media_pipeline.finalization_enqueued",
+		"execution_arn", executionARN,
+		"listing_identity_id", input.ListingIdentityID,
+		"job_id", input.JobID)
+	return executionARN, nil
+}
@@ -0,0 +1,76 @@
+package localmediapipelineadapter
+
+import (
+	"context"
+
+	workflowport "github.com/projeto-toq/toq_server/internal/core/port/right/workflow"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// StopExecution cancels a queued or running task. A queued task is dropped when a worker takes
+// it; a running one has its context cancelled and its result is not delivered.
+func (a *LocalMediaPipelineAdapter) StopExecution(ctx context.Context, executionARN string, cause string) error {
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	a.executionsMu.Lock()
+	execution, ok := a.executions[executionARN]
+	if ok {
+		execution.stopped = true
+		if execution.cancel != nil {
+			execution.cancel()
+		}
+	}
+	a.executionsMu.Unlock()
+
+	if !ok {
+		logger.Warn("adapter.local_media_pipeline.stop_execution_not_found", "execution_arn", executionARN)
+		return workflowport.ErrExecutionNotFound
+	}
+
+	logger.Info("adapter.local_media_pipeline.stop_execution_success", "execution_arn", executionARN, "cause", cause)
+	return nil
+}
+
+// trackExecution registers a task as queued.
+func (a *LocalMediaPipelineAdapter) trackExecution(executionARN string) {
+	a.executionsMu.Lock()
+	defer a.executionsMu.Unlock()
+	a.executions[executionARN] = &localExecution{}
+}
+
+// startExecution derives the cancellable context of a task; false when it was stopped while queued.
+func (a *LocalMediaPipelineAdapter) startExecution(ctx context.Context, executionARN string) (context.Context, bool) {
+	a.executionsMu.Lock()
+	defer a.executionsMu.Unlock()
+
+	execution, ok := a.executions[executionARN]
+	if !ok {
+		execution = &localExecution{}
+		a.executions[executionARN] = execution
+	}
+	if execution.stopped {
+		delete(a.executions, executionARN)
+		return ctx, false
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	execution.cancel = cancel
+	return ctx, true
+}
+
+// finishExecution forgets a task and reports whether it was stopped.
+func (a *LocalMediaPipelineAdapter) finishExecution(executionARN string) bool {
+	a.executionsMu.Lock()
+	defer a.executionsMu.Unlock()
+
+	execution, ok := a.executions[executionARN]
+	if !ok {
+		return false
+	}
+	delete(a.executions, executionARN)
+	if execution.cancel != nil {
+		execution.cancel()
+	}
+	return execution.stopped
+}
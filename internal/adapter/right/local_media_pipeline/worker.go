@@ -0,0 +1,138 @@
+package localmediapipelineadapter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// worker consumes tasks until the adapter is closed.
+func (a *LocalMediaPipelineAdapter) worker(id int) {
+	defer a.wg.Done()
+
+	for {
+		select {
+		case <-a.ctx.Done():
+			return
+		case task := <-a.tasks:
+			a.runTask(id, task)
+		}
+	}
+}
+
+// runTask rebuilds the trace/log context captured at enqueue time, executes the pipeline and
+// delivers its result. Panics raised by decoders are contained so one corrupt file cannot stop
+// the pool; the job is then reported as failed so it does not stay in processing.
+func (a *LocalMediaPipelineAdapter) runTask(workerID int, task pipelineTask) {
+	ctx := a.ctx
+	if task.spanContext.IsValid() {
+		ctx = trace.ContextWithSpanContext(ctx, task.spanContext)
+	}
+	if task.requestID != nil {
+		ctx = context.WithValue(ctx, globalmodel.RequestIDKey, task.requestID)
+	}
+	ctx = utils.ContextWithLogger(ctx)
+	ctx, spanEnd, _ := utils.GenerateBusinessTracer(ctx, fmt.Sprintf("LocalMediaPipeline.%s", task.kind))
+	defer spanEnd()
+
+	logger := utils.LoggerFromContext(ctx)
+
+	ctx, runnable := a.startExecution(ctx, task.executionARN)
+	if !runnable {
+		logger.Info("adapter.local_media_pipeline.task_skipped_stopped", "kind", task.kind, "execution_arn", task.executionARN)
+		return
+	}
+	defer a.finishExecution(task.executionARN)
+
+	delivering := false
+	defer func() {
+		if r := recover(); r != nil {
+			err := fmt.Errorf("panic: %v", r)
+			utils.SetSpanError(ctx, err)
+			logger.Error("adapter.local_media_pipeline.task_panic", "worker", workerID, "kind", task.kind, "error", err)
+
+			// A panic inside the callback itself is not retried: it would panic again.
+			if delivering || a.finishExecution(task.executionARN) {
+				return
+			}
+			a.deliver(ctx, panicPayload(ctx, task, err))
+		}
+	}()
+
+	var payload callbackPayload
+	switch task.kind {
+	case taskKindProcessing:
+		payload = a.runProcessing(ctx, task)
+	case taskKindFinalization:
+		payload = a.runFinalization(ctx, task)
+	default:
+		logger.Error("adapter.local_media_pipeline.unknown_task", "kind", task.kind)
+		return
+	}
+
+	if a.finishExecution(task.executionARN) {
+		// The job was already closed by whoever stopped it; its result is discarded.
+		logger.Info("adapter.local_media_pipeline.task_stopped", "kind", task.kind, "execution_arn", task.executionARN)
+		return
+	}
+	delivering = true
+	a.deliver(ctx, payload)
+}
+
+// panicPayload reports a task that panicked with the failure status of its kind.
+func panicPayload(ctx context.Context, task pipelineTask, err error) callbackPayload {
+	callbackErr := &callbackError{Code: "PIPELINE_PANIC", Message: err.Error()}
+
+	if task.kind == taskKindFinalization {
+		traceparent := task.finalization.Traceparent
+		if traceparent == "" {
+			traceparent = buildTraceparent(ctx)
+		}
+		return callbackPayload{
+			JobID:             task.finalization.JobID,
+			ListingIdentityID: task.finalization.ListingIdentityID,
+			Status:            "FINALIZATION_FAILED",
+			Traceparent:       traceparent,
+			Error:             callbackErr,
+		}
+	}
+
+	return callbackPayload{
+		JobID:             task.job.JobID,
+		ListingIdentityID: task.job.ListingIdentityID,
+		ExecutionARN:      task.executionARN,
+		Provider:          string(mediaprocessingmodel.MediaProcessingProviderLocal),
+		Status:            string(mediaprocessingmodel.MediaProcessingJobStatusFailed),
+		FailureReason:     "PIPELINE_PANIC",
+		Error:             callbackErr,
+		Traceparent:       buildTraceparent(ctx),
+	}
+}
+
+// wait sleeps for the given delay unless the adapter is closed first.
+func (a *LocalMediaPipelineAdapter) wait(delay time.Duration) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-a.ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// buildTraceparent renders the W3C header propagated in callback payloads.
+func buildTraceparent(ctx context.Context) string {
+	spanCtx := trace.SpanFromContext(ctx).SpanContext()
+	if !spanCtx.IsValid() {
+		return ""
+	}
+	return fmt.Sprintf("00-%s-%s-%s", spanCtx.TraceID().String(), spanCtx.SpanID().String(), spanCtx.TraceFlags().String())
+}
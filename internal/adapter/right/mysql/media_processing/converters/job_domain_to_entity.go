@@ -17,10 +17,12 @@ func JobDomainToEntity(job mediaprocessingmodel.MediaProcessingJob) mediaprocess
 		Provider:          string(job.Provider()),
 		ExternalID:        nullString(job.ExternalID()),
 		Payload:           EncodeJobPayload(job.Payload()),
+		RetryCount:        job.RetryCount(),
 		StartedAt:         nullTimeFromPtr(job.StartedAt()),
 		FinishedAt:        nullTimeFromPtr(job.CompletedAt()),
 		LastError:         nullString(job.LastError()),
 		CallbackBody:      nullString(job.CallbackBody()),
+		CreatedAt:         job.CreatedAt(),
 	}
 }
 
@@ -50,5 +52,8 @@ func isEmptyJobPayload(payload mediaprocessingmodel.MediaProcessingJobPayload) b
 		len(payload.ZipBundles) == 0 &&
 		payload.AssetsZipped == 0 &&
 		payload.ZipSizeBytes == 0 &&
-		payload.UnzippedSizeBytes == 0
+		payload.UnzippedSizeBytes == 0 &&
+		payload.ZipManifestKey == "" &&
+		payload.ZipSHA256 == "" &&
+		len(payload.AssetIDs) == 0
 }
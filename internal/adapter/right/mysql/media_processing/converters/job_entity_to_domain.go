@@ -17,11 +17,12 @@ func JobEntityToDomain(entity mediaprocessingentities.JobEntity) mediaprocessing
 		Provider:          mediaprocessingmodel.MediaProcessingProvider(entity.Provider),
 		ExternalID:        entity.ExternalID.String,
 		Payload:           decodeJobPayload(entity.Payload),
-		RetryCount:        0,
+		RetryCount:        entity.RetryCount,
 		StartedAt:         timePtrFromNull(entity.StartedAt),
 		CompletedAt:       timePtrFromNull(entity.FinishedAt),
 		LastError:         entity.LastError.String,
 		CallbackBody:      entity.CallbackBody.String,
+		CreatedAt:         entity.CreatedAt,
 	}
 
 	return mediaprocessingmodel.RestoreMediaProcessingJob(record)
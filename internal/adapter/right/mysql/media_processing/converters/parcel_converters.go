@@ -0,0 +1,55 @@
+package mediaprocessingconverters
+
+import (
+	"encoding/json"
+
+	mediaprocessingentities "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/media_processing/entities"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+)
+
+// ParcelEntityToDomain converts DB entity to domain; unreadable JSON columns are left empty.
+func ParcelEntityToDomain(entity mediaprocessingentities.ParcelEntity) mediaprocessingmodel.LandParcel {
+	parcel := mediaprocessingmodel.LandParcel{
+		ListingIdentityID: entity.ListingIdentityID,
+		AssetID:           entity.AssetID,
+		AreaM2:            entity.AreaM2,
+		PerimeterM:        entity.PerimeterM,
+		Centroid:          mediaprocessingmodel.ParcelCoordinate{Lat: entity.CentroidLat, Lng: entity.CentroidLng},
+		UpdatedAt:         entity.UpdatedAt,
+	}
+	if entity.Coordinates != "" {
+		_ = json.Unmarshal([]byte(entity.Coordinates), &parcel.Polygon)
+	}
+	if entity.Divergences != "" {
+		_ = json.Unmarshal([]byte(entity.Divergences), &parcel.Divergences)
+	}
+	return parcel
+}
+
+// ParcelDomainToEntity converts domain to DB entity.
+func ParcelDomainToEntity(parcel mediaprocessingmodel.LandParcel) (mediaprocessingentities.ParcelEntity, error) {
+	coordinates, err := json.Marshal(parcel.Polygon)
+	if err != nil {
+		return mediaprocessingentities.ParcelEntity{}, err
+	}
+	divergences := parcel.Divergences
+	if divergences == nil {
+		divergences = []mediaprocessingmodel.ParcelDivergence{}
+	}
+	divergencesPayload, err := json.Marshal(divergences)
+	if err != nil {
+		return mediaprocessingentities.ParcelEntity{}, err
+	}
+	return mediaprocessingentities.ParcelEntity{
+		ListingIdentityID: parcel.ListingIdentityID,
+		AssetID:           parcel.AssetID,
+		PolygonWKT:        parcel.Polygon.WKT(),
+		Coordinates:       string(coordinates),
+		AreaM2:            parcel.AreaM2,
+		PerimeterM:        parcel.PerimeterM,
+		CentroidLat:       parcel.Centroid.Lat,
+		CentroidLng:       parcel.Centroid.Lng,
+		Divergences:       string(divergencesPayload),
+		UpdatedAt:         parcel.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package mediaprocessingconverters
+
+import (
+	"encoding/json"
+
+	mediaprocessingentities "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/media_processing/entities"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+)
+
+// TourEntityToDomain converts DB entity to domain; an unreadable graph yields a tour without nodes.
+func TourEntityToDomain(entity mediaprocessingentities.TourEntity) mediaprocessingmodel.MediaTour {
+	tour := mediaprocessingmodel.MediaTour{
+		ListingIdentityID: entity.ListingIdentityID,
+		StartAssetID:      entity.StartAssetID,
+		UpdatedBy:         entity.UpdatedBy,
+		UpdatedAt:         entity.UpdatedAt,
+	}
+	if entity.Nodes != "" {
+		_ = json.Unmarshal([]byte(entity.Nodes), &tour.Nodes)
+	}
+	return tour
+}
+
+// TourDomainToEntity converts domain to DB entity.
+func TourDomainToEntity(tour mediaprocessingmodel.MediaTour) (mediaprocessingentities.TourEntity, error) {
+	nodes := tour.Nodes
+	if nodes == nil {
+		nodes = []mediaprocessingmodel.MediaTourNode{}
+	}
+	payload, err := json.Marshal(nodes)
+	if err != nil {
+		return mediaprocessingentities.TourEntity{}, err
+	}
+	return mediaprocessingentities.TourEntity{
+		ListingIdentityID: tour.ListingIdentityID,
+		StartAssetID:      tour.StartAssetID,
+		Nodes:             string(payload),
+		UpdatedBy:         tour.UpdatedBy,
+		UpdatedAt:         tour.UpdatedAt,
+	}, nil
+}
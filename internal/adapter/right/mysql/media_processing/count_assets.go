@@ -37,6 +37,15 @@ func (a *MediaProcessingAdapter) CountAssets(ctx context.Context, tx *sql.Tx, li
 		query += fmt.Sprintf(" AND status IN (%s)", strings.Join(placeholders, ","))
 	}
 
+	if len(filter.ExcludeStatus) > 0 {
+		placeholders := make([]string, len(filter.ExcludeStatus))
+		for i, s := range filter.ExcludeStatus {
+			placeholders[i] = "?"
+			args = append(args, string(s))
+		}
+		query += fmt.Sprintf(" AND status NOT IN (%s)", strings.Join(placeholders, ","))
+	}
+
 	if filter.Sequence != nil {
 		query += " AND sequence = ?"
 		args = append(args, *filter.Sequence)
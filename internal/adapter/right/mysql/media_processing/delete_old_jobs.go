@@ -29,7 +29,7 @@ func (a *MediaProcessingAdapter) DeleteOldJobs(ctx context.Context, tx *sql.Tx,
 	}
 
 	query := `DELETE FROM media_processing_jobs
-        WHERE status IN ('SUCCEEDED','PARTIAL_SUCCESS','FAILED')
+        WHERE status IN ('SUCCEEDED','PARTIAL_SUCCESS','FAILED','CANCELLED')
           AND COALESCE(completed_at, created_at) < ?
         LIMIT ?`
 
@@ -0,0 +1,17 @@
+package mysqlmediaprocessingadapter
+
+import (
+	"context"
+	"database/sql"
+)
+
+const deleteParcelQuery = `
+DELETE FROM listing_parcels
+WHERE listing_identity_id = ?
+`
+
+// DeleteParcel removes the parcel polygon of a listing.
+func (a *MediaProcessingAdapter) DeleteParcel(ctx context.Context, tx *sql.Tx, listingIdentityID uint64) error {
+	_, err := a.ExecContext(ctx, tx, "delete_parcel", deleteParcelQuery, listingIdentityID)
+	return err
+}
@@ -0,0 +1,17 @@
+package mysqlmediaprocessingadapter
+
+import (
+	"context"
+	"database/sql"
+)
+
+const deleteTourQuery = `
+DELETE FROM media_tours
+WHERE listing_identity_id = ?
+`
+
+// DeleteTour removes the virtual tour of a listing.
+func (a *MediaProcessingAdapter) DeleteTour(ctx context.Context, tx *sql.Tx, listingIdentityID uint64) error {
+	_, err := a.ExecContext(ctx, tx, "delete_tour", deleteTourQuery, listingIdentityID)
+	return err
+}
@@ -1,6 +1,9 @@
 package mediaprocessingentities
 
-import "database/sql"
+import (
+	"database/sql"
+	"time"
+)
 
 // JobEntity represents records in media_jobs.
 type JobEntity struct {
@@ -10,8 +13,10 @@ type JobEntity struct {
 	Provider          string         `db:"provider"`
 	ExternalID        sql.NullString `db:"external_id"`
 	Payload           sql.NullString `db:"payload"`
+	RetryCount        uint16         `db:"retry_count"`
 	StartedAt         sql.NullTime   `db:"started_at"`
 	FinishedAt        sql.NullTime   `db:"finished_at"`
 	LastError         sql.NullString `db:"last_error"`
 	CallbackBody      sql.NullString `db:"callback_body"`
+	CreatedAt         time.Time      `db:"created_at"`
 }
@@ -0,0 +1,18 @@
+package mediaprocessingentities
+
+import "time"
+
+// ParcelEntity represents records in listing_parcels. The polygon column is only written (WKT)
+// and used by spatial queries; reads use the coordinates JSON.
+type ParcelEntity struct {
+	ListingIdentityID uint64    `db:"listing_identity_id"`
+	AssetID           uint64    `db:"asset_id"`
+	PolygonWKT        string    `db:"polygon"`
+	Coordinates       string    `db:"coordinates"`
+	AreaM2            float64   `db:"area_m2"`
+	PerimeterM        float64   `db:"perimeter_m"`
+	CentroidLat       float64   `db:"centroid_lat"`
+	CentroidLng       float64   `db:"centroid_lng"`
+	Divergences       string    `db:"divergences"`
+	UpdatedAt         time.Time `db:"updated_at"`
+}
@@ -0,0 +1,12 @@
+package mediaprocessingentities
+
+import "time"
+
+// TourEntity represents records in media_tours.
+type TourEntity struct {
+	ListingIdentityID uint64    `db:"listing_identity_id"`
+	StartAssetID      uint64    `db:"start_asset_id"`
+	Nodes             string    `db:"nodes"`
+	UpdatedBy         int64     `db:"updated_by"`
+	UpdatedAt         time.Time `db:"updated_at"`
+}
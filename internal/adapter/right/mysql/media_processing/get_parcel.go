@@ -0,0 +1,39 @@
+package mysqlmediaprocessingadapter
+
+import (
+	"context"
+	"database/sql"
+
+	mediaprocessingconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/media_processing/converters"
+	mediaprocessingentities "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/media_processing/entities"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+)
+
+const getParcelQuery = `
+SELECT
+    listing_identity_id, asset_id, coordinates, area_m2, perimeter_m,
+    centroid_lat, centroid_lng, divergences, updated_at
+FROM listing_parcels
+WHERE listing_identity_id = ?
+`
+
+// GetParcel retrieves the parcel polygon of a listing; sql.ErrNoRows when none was read.
+func (a *MediaProcessingAdapter) GetParcel(ctx context.Context, tx *sql.Tx, listingIdentityID uint64) (mediaprocessingmodel.LandParcel, error) {
+	var entity mediaprocessingentities.ParcelEntity
+	err := a.QueryRowContext(ctx, tx, "get_parcel", getParcelQuery, listingIdentityID).Scan(
+		&entity.ListingIdentityID,
+		&entity.AssetID,
+		&entity.Coordinates,
+		&entity.AreaM2,
+		&entity.PerimeterM,
+		&entity.CentroidLat,
+		&entity.CentroidLng,
+		&entity.Divergences,
+		&entity.UpdatedAt,
+	)
+	if err != nil {
+		return mediaprocessingmodel.LandParcel{}, err
+	}
+
+	return mediaprocessingconverters.ParcelEntityToDomain(entity), nil
+}
@@ -11,8 +11,8 @@ import (
 )
 
 const selectProcessingJobByIDQuery = `
-SELECT id, listing_identity_id, status, provider, external_id, payload,
-       started_at, completed_at
+SELECT id, listing_identity_id, status, provider, external_id, payload, COALESCE(retry_count, 0),
+       started_at, completed_at, last_error, callback_body, created_at
 FROM media_processing_jobs
 WHERE id = ?
 `
@@ -31,8 +31,12 @@ func (a *MediaProcessingAdapter) GetProcessingJobByID(ctx context.Context, tx *s
 		&entity.Provider,
 		&entity.ExternalID,
 		&entity.Payload,
+		&entity.RetryCount,
 		&entity.StartedAt,
 		&entity.FinishedAt,
+		&entity.LastError,
+		&entity.CallbackBody,
+		&entity.CreatedAt,
 	); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return mediaprocessingmodel.MediaProcessingJob{}, err
@@ -0,0 +1,34 @@
+package mysqlmediaprocessingadapter
+
+import (
+	"context"
+	"database/sql"
+
+	mediaprocessingconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/media_processing/converters"
+	mediaprocessingentities "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/media_processing/entities"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+)
+
+const getTourQuery = `
+SELECT
+    listing_identity_id, start_asset_id, nodes, updated_by, updated_at
+FROM media_tours
+WHERE listing_identity_id = ?
+`
+
+// GetTour retrieves the virtual tour of a listing; sql.ErrNoRows when none was saved.
+func (a *MediaProcessingAdapter) GetTour(ctx context.Context, tx *sql.Tx, listingIdentityID uint64) (mediaprocessingmodel.MediaTour, error) {
+	var entity mediaprocessingentities.TourEntity
+	err := a.QueryRowContext(ctx, tx, "get_tour", getTourQuery, listingIdentityID).Scan(
+		&entity.ListingIdentityID,
+		&entity.StartAssetID,
+		&entity.Nodes,
+		&entity.UpdatedBy,
+		&entity.UpdatedAt,
+	)
+	if err != nil {
+		return mediaprocessingmodel.MediaTour{}, err
+	}
+
+	return mediaprocessingconverters.TourEntityToDomain(entity), nil
+}
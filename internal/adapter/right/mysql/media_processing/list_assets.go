@@ -42,6 +42,15 @@ func (a *MediaProcessingAdapter) ListAssets(ctx context.Context, tx *sql.Tx, lis
 		query += fmt.Sprintf(" AND status IN (%s)", strings.Join(placeholders, ","))
 	}
 
+	if len(filter.ExcludeStatus) > 0 {
+		placeholders := make([]string, len(filter.ExcludeStatus))
+		for i, s := range filter.ExcludeStatus {
+			placeholders[i] = "?"
+			args = append(args, string(s))
+		}
+		query += fmt.Sprintf(" AND status NOT IN (%s)", strings.Join(placeholders, ","))
+	}
+
 	if filter.Sequence != nil {
 		query += " AND sequence = ?"
 		args = append(args, *filter.Sequence)
@@ -73,6 +82,11 @@ func (a *MediaProcessingAdapter) ListAssets(ctx context.Context, tx *sql.Tx, lis
 	if err != nil {
 		return nil, err
 	}
+	return scanAssetRows(rows)
+}
+
+// scanAssetRows reads rows selected with listAssetsBaseQuery.
+func scanAssetRows(rows *sql.Rows) ([]mediaprocessingmodel.MediaAsset, error) {
 	defer rows.Close()
 
 	var assets []mediaprocessingmodel.MediaAsset
@@ -94,5 +108,5 @@ func (a *MediaProcessingAdapter) ListAssets(ctx context.Context, tx *sql.Tx, lis
 		assets = append(assets, mediaprocessingconverters.AssetEntityToDomain(entity))
 	}
 
-	return assets, nil
+	return assets, rows.Err()
 }
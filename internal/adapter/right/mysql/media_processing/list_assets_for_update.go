@@ -0,0 +1,25 @@
+package mysqlmediaprocessingadapter
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+)
+
+// ListAssetsForUpdate retrieves every asset of a listing with FOR UPDATE locking; requires a
+// non-nil transaction. The locking read on idx_listing_status also blocks new assets of the
+// listing until the transaction ends.
+func (a *MediaProcessingAdapter) ListAssetsForUpdate(ctx context.Context, tx *sql.Tx, listingIdentityID uint64) ([]mediaprocessingmodel.MediaAsset, error) {
+	if tx == nil {
+		return nil, errors.New("list assets for update requires a transaction")
+	}
+
+	query := listAssetsBaseQuery + " ORDER BY asset_type, sequence FOR UPDATE"
+	rows, err := a.QueryContext(ctx, tx, "list_assets_for_update", query, listingIdentityID)
+	if err != nil {
+		return nil, err
+	}
+	return scanAssetRows(rows)
+}
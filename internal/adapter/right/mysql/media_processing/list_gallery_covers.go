@@ -0,0 +1,63 @@
+package mysqlmediaprocessingadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	mediaprocessingconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/media_processing/converters"
+	mediaprocessingentities "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/media_processing/entities"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+)
+
+// ListGalleryCovers returns processed assets flagged as gallery cover for the given listings.
+func (a *MediaProcessingAdapter) ListGalleryCovers(ctx context.Context, tx *sql.Tx, listingIdentityIDs []uint64) ([]mediaprocessingmodel.MediaAsset, error) {
+	if len(listingIdentityIDs) == 0 {
+		return nil, nil
+	}
+
+	placeholders := make([]string, len(listingIdentityIDs))
+	args := make([]interface{}, 0, len(listingIdentityIDs)+2)
+	for i, id := range listingIdentityIDs {
+		placeholders[i] = "?"
+		args = append(args, id)
+	}
+	args = append(args, string(mediaprocessingmodel.MediaAssetStatusProcessed), "true")
+
+	query := fmt.Sprintf(`
+SELECT
+    id, listing_identity_id, asset_type, sequence, status, s3_key_raw, s3_key_processed, title, metadata
+FROM media_assets
+WHERE listing_identity_id IN (%s)
+  AND status = ?
+  AND JSON_UNQUOTE(JSON_EXTRACT(metadata, '$.%s')) = ?
+ORDER BY listing_identity_id, asset_type`, strings.Join(placeholders, ","), mediaprocessingmodel.GalleryCoverMetadataKey)
+
+	rows, err := a.QueryContext(ctx, tx, "list_gallery_covers", query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var assets []mediaprocessingmodel.MediaAsset
+	for rows.Next() {
+		var entity mediaprocessingentities.AssetEntity
+		if err := rows.Scan(
+			&entity.ID,
+			&entity.ListingIdentityID,
+			&entity.AssetType,
+			&entity.Sequence,
+			&entity.Status,
+			&entity.S3KeyRaw,
+			&entity.S3KeyProcessed,
+			&entity.Title,
+			&entity.Metadata,
+		); err != nil {
+			return nil, err
+		}
+		assets = append(assets, mediaprocessingconverters.AssetEntityToDomain(entity))
+	}
+
+	return assets, rows.Err()
+}
@@ -0,0 +1,121 @@
+package mysqlmediaprocessingadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	mediaprocessingconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/media_processing/converters"
+	mediaprocessingentities "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/media_processing/entities"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	mediaprocessingrepository "github.com/projeto-toq/toq_server/internal/core/port/right/repository/media_processing_repository"
+)
+
+const listProcessingJobsBaseQuery = `
+SELECT id, listing_identity_id, status, provider, external_id, payload, COALESCE(retry_count, 0),
+       started_at, completed_at, last_error, callback_body, created_at
+FROM media_processing_jobs
+WHERE 1 = 1
+`
+
+const countProcessingJobsBaseQuery = `
+SELECT COUNT(*)
+FROM media_processing_jobs
+WHERE 1 = 1
+`
+
+// ListProcessingJobs returns processing jobs matching the filter, newest first.
+func (a *MediaProcessingAdapter) ListProcessingJobs(ctx context.Context, tx *sql.Tx, filter mediaprocessingrepository.JobFilter, pagination *mediaprocessingrepository.Pagination) ([]mediaprocessingmodel.MediaProcessingJob, error) {
+	where, args := buildJobFilterClause(filter)
+	query := listProcessingJobsBaseQuery + where + " ORDER BY created_at DESC, id DESC"
+	if pagination != nil && pagination.Limit > 0 {
+		query += " LIMIT ? OFFSET ?"
+		args = append(args, pagination.Limit, (pagination.Page-1)*pagination.Limit)
+	}
+
+	observer := a.ObserveOnComplete("select", query)
+	defer observer()
+
+	rows, err := a.QueryContext(ctx, tx, "list_processing_jobs", query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	jobs := make([]mediaprocessingmodel.MediaProcessingJob, 0)
+	for rows.Next() {
+		var entity mediaprocessingentities.JobEntity
+		if scanErr := rows.Scan(
+			&entity.ID,
+			&entity.ListingIdentityID,
+			&entity.Status,
+			&entity.Provider,
+			&entity.ExternalID,
+			&entity.Payload,
+			&entity.RetryCount,
+			&entity.StartedAt,
+			&entity.FinishedAt,
+			&entity.LastError,
+			&entity.CallbackBody,
+			&entity.CreatedAt,
+		); scanErr != nil {
+			return nil, scanErr
+		}
+
+		jobs = append(jobs, mediaprocessingconverters.JobEntityToDomain(entity))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+// CountProcessingJobs returns how many processing jobs match the filter.
+func (a *MediaProcessingAdapter) CountProcessingJobs(ctx context.Context, tx *sql.Tx, filter mediaprocessingrepository.JobFilter) (int64, error) {
+	where, args := buildJobFilterClause(filter)
+	query := countProcessingJobsBaseQuery + where
+
+	var count int64
+	if err := a.QueryRowContext(ctx, tx, "count_processing_jobs", query, args...).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func buildJobFilterClause(filter mediaprocessingrepository.JobFilter) (string, []interface{}) {
+	var clause strings.Builder
+	args := make([]interface{}, 0)
+
+	if len(filter.Statuses) > 0 {
+		placeholders := make([]string, len(filter.Statuses))
+		for i, status := range filter.Statuses {
+			placeholders[i] = "?"
+			args = append(args, string(status))
+		}
+		clause.WriteString(fmt.Sprintf(" AND status IN (%s)", strings.Join(placeholders, ",")))
+	}
+
+	if len(filter.Providers) > 0 {
+		placeholders := make([]string, len(filter.Providers))
+		for i, provider := range filter.Providers {
+			placeholders[i] = "?"
+			args = append(args, string(provider))
+		}
+		clause.WriteString(fmt.Sprintf(" AND provider IN (%s)", strings.Join(placeholders, ",")))
+	}
+
+	if filter.ListingIdentityID != 0 {
+		clause.WriteString(" AND listing_identity_id = ?")
+		args = append(args, filter.ListingIdentityID)
+	}
+
+	if filter.CreatedBefore != nil {
+		clause.WriteString(" AND created_at < ?")
+		args = append(args, *filter.CreatedBefore)
+	}
+
+	return clause.String(), args
+}
@@ -15,11 +15,12 @@ INSERT INTO media_processing_jobs (
     provider,
     external_id,
     payload,
+    retry_count,
     started_at,
 	completed_at,
 	last_error,
 	callback_body
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 // RegisterProcessingJob cria um novo job associado ao lote.
@@ -34,6 +35,7 @@ func (a *MediaProcessingAdapter) RegisterProcessingJob(ctx context.Context, tx *
 		entity.Provider,
 		entity.ExternalID,
 		entity.Payload,
+		entity.RetryCount,
 		entity.StartedAt,
 		entity.FinishedAt,
 		entity.LastError,
@@ -0,0 +1,27 @@
+package mysqlmediaprocessingadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+)
+
+// UpdateAssetsStatusByIDs moves the given assets of a listing from one status to another.
+func (a *MediaProcessingAdapter) UpdateAssetsStatusByIDs(ctx context.Context, tx *sql.Tx, listingIdentityID uint64, assetIDs []uint64, fromStatus, toStatus mediaprocessingmodel.MediaAssetStatus) error {
+	if len(assetIDs) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(assetIDs))
+	args := []interface{}{string(toStatus), listingIdentityID, string(fromStatus)}
+	for i, assetID := range assetIDs {
+		placeholders[i] = "?"
+		args = append(args, assetID)
+	}
+	query := fmt.Sprintf("UPDATE media_assets SET status = ? WHERE listing_identity_id = ? AND status = ? AND id IN (%s)", strings.Join(placeholders, ","))
+	_, err := a.ExecContext(ctx, tx, "update_assets_status_by_ids", query, args...)
+	return err
+}
@@ -0,0 +1,56 @@
+package mysqlmediaprocessingadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	mediaprocessingconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/media_processing/converters"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+)
+
+// gallerySequenceOffset moves sequences out of the 1..255 range while they are rewritten, so
+// swaps do not hit uk_listing_asset_seq.
+const gallerySequenceOffset = 1000
+
+const updateGalleryAssetQuery = `
+UPDATE media_assets
+SET sequence = ?, metadata = ?
+WHERE id = ? AND listing_identity_id = ?
+`
+
+// UpdateGalleryArrangement rewrites sequence and metadata of the given assets in two steps.
+func (a *MediaProcessingAdapter) UpdateGalleryArrangement(ctx context.Context, tx *sql.Tx, listingIdentityID uint64, assets []mediaprocessingmodel.MediaAsset) error {
+	if len(assets) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, len(assets))
+	args := []interface{}{gallerySequenceOffset, listingIdentityID}
+	for i, asset := range assets {
+		placeholders[i] = "?"
+		args = append(args, asset.ID())
+	}
+	parkQuery := fmt.Sprintf("UPDATE media_assets SET sequence = sequence + ? WHERE listing_identity_id = ? AND id IN (%s)", strings.Join(placeholders, ","))
+	if _, err := a.ExecContext(ctx, tx, "park_gallery_sequences", parkQuery, args...); err != nil {
+		return err
+	}
+
+	for _, asset := range assets {
+		entity := mediaprocessingconverters.AssetDomainToEntity(asset)
+		result, err := a.ExecContext(ctx, tx, "update_gallery_asset", updateGalleryAssetQuery,
+			entity.Sequence,
+			entity.Metadata,
+			entity.ID,
+			listingIdentityID,
+		)
+		if err != nil {
+			return err
+		}
+		if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+			return sql.ErrNoRows
+		}
+	}
+	return nil
+}
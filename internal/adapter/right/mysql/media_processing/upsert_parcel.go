@@ -0,0 +1,50 @@
+package mysqlmediaprocessingadapter
+
+import (
+	"context"
+	"database/sql"
+
+	mediaprocessingconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/media_processing/converters"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+)
+
+// The WKT is written in longitude/latitude order; MySQL defaults to latitude first for SRID 4326.
+const upsertParcelQuery = `
+INSERT INTO listing_parcels (
+    listing_identity_id, asset_id, polygon, coordinates, area_m2, perimeter_m,
+    centroid_lat, centroid_lng, divergences, updated_at
+)
+VALUES (?, ?, ST_GeomFromText(?, 4326, 'axis-order=long-lat'), ?, ?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    asset_id = VALUES(asset_id),
+    polygon = VALUES(polygon),
+    coordinates = VALUES(coordinates),
+    area_m2 = VALUES(area_m2),
+    perimeter_m = VALUES(perimeter_m),
+    centroid_lat = VALUES(centroid_lat),
+    centroid_lng = VALUES(centroid_lng),
+    divergences = VALUES(divergences),
+    updated_at = VALUES(updated_at)
+`
+
+// UpsertParcel replaces the parcel polygon of a listing.
+func (a *MediaProcessingAdapter) UpsertParcel(ctx context.Context, tx *sql.Tx, parcel mediaprocessingmodel.LandParcel) error {
+	entity, err := mediaprocessingconverters.ParcelDomainToEntity(parcel)
+	if err != nil {
+		return err
+	}
+
+	_, err = a.ExecContext(ctx, tx, "upsert_parcel", upsertParcelQuery,
+		entity.ListingIdentityID,
+		entity.AssetID,
+		entity.PolygonWKT,
+		entity.Coordinates,
+		entity.AreaM2,
+		entity.PerimeterM,
+		entity.CentroidLat,
+		entity.CentroidLng,
+		entity.Divergences,
+		entity.UpdatedAt,
+	)
+	return err
+}
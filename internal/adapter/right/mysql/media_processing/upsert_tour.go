@@ -0,0 +1,36 @@
+package mysqlmediaprocessingadapter
+
+import (
+	"context"
+	"database/sql"
+
+	mediaprocessingconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/media_processing/converters"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+)
+
+const upsertTourQuery = `
+INSERT INTO media_tours (listing_identity_id, start_asset_id, nodes, updated_by, updated_at)
+VALUES (?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    start_asset_id = VALUES(start_asset_id),
+    nodes = VALUES(nodes),
+    updated_by = VALUES(updated_by),
+    updated_at = VALUES(updated_at)
+`
+
+// UpsertTour replaces the virtual tour of a listing.
+func (a *MediaProcessingAdapter) UpsertTour(ctx context.Context, tx *sql.Tx, tour mediaprocessingmodel.MediaTour) error {
+	entity, err := mediaprocessingconverters.TourDomainToEntity(tour)
+	if err != nil {
+		return err
+	}
+
+	_, err = a.ExecContext(ctx, tx, "upsert_tour", upsertTourQuery,
+		entity.ListingIdentityID,
+		entity.StartAssetID,
+		entity.Nodes,
+		entity.UpdatedBy,
+		entity.UpdatedAt,
+	)
+	return err
+}
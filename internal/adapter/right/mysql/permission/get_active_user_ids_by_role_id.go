@@ -29,6 +29,7 @@ func (p *PermissionAdapter) GetActiveUserIDsByRoleID(ctx context.Context, tx *sq
         WHERE ur.role_id = ?
           AND ur.is_active = 1
           AND (ur.expires_at IS NULL OR ur.expires_at > NOW())
+          AND (ur.valid_from IS NULL OR ur.valid_from <= NOW())
     `
 
 	rows, readErr := p.QueryContext(ctx, tx, "select", query, roleID)
@@ -31,6 +31,7 @@ func (p *PermissionAdapter) GetUserPermissions(ctx context.Context, tx *sql.Tx,
 		WHERE ur.user_id = ? 
 		  AND p.is_active = 1
 		  AND (ur.expires_at IS NULL OR ur.expires_at > NOW())
+		  AND (ur.valid_from IS NULL OR ur.valid_from <= NOW())
 		ORDER BY p.action
 	`
 
@@ -30,6 +30,7 @@ func (p *PermissionAdapter) HasUserPermission(ctx context.Context, tx *sql.Tx, u
 		  AND p.action = ?
 		  AND p.is_active = 1
 		  AND (ur.expires_at IS NULL OR ur.expires_at > NOW())
+		  AND (ur.valid_from IS NULL OR ur.valid_from <= NOW())
 		LIMIT 1
 	`
 
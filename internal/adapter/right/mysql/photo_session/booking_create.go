@@ -26,9 +26,15 @@ func (a *PhotoSessionAdapter) CreateBooking(ctx context.Context, tx *sql.Tx, boo
 		reason = entity.Reason.String
 	}
 
+	var assignmentDetails any
+	if entity.AssignmentDetails.Valid {
+		assignmentDetails = entity.AssignmentDetails.String
+	}
+
 	query := `INSERT INTO photographer_photo_session_bookings (
-		agenda_entry_id, photographer_user_id, listing_identity_id, starts_at, ends_at, status, reason
-	) VALUES (?, ?, ?, ?, ?, ?, ?)`
+		agenda_entry_id, photographer_user_id, listing_identity_id, starts_at, ends_at, status, reason,
+		assignment_mode, assignment_details, session_type
+	) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	result, execErr := a.ExecContext(
 		ctx,
@@ -42,6 +48,9 @@ func (a *PhotoSessionAdapter) CreateBooking(ctx context.Context, tx *sql.Tx, boo
 		entity.EndsAt,
 		entity.Status,
 		reason,
+		entity.AssignmentMode,
+		assignmentDetails,
+		entity.SessionType,
 	)
 	if execErr != nil {
 		utils.SetSpanError(ctx, execErr)
@@ -22,7 +22,7 @@ func (a *PhotoSessionAdapter) FindBookingByAgendaEntry(ctx context.Context, tx *
 	ctx = utils.ContextWithLogger(ctx)
 	logger := utils.LoggerFromContext(ctx)
 
-	query := `SELECT id, agenda_entry_id, photographer_user_id, listing_identity_id, starts_at, ends_at, status, reason, reservation_token, reserved_until
+	query := `SELECT id, agenda_entry_id, photographer_user_id, listing_identity_id, starts_at, ends_at, status, reason, reservation_token, reserved_until, assignment_mode, assignment_details, session_type
 		FROM photographer_photo_session_bookings WHERE agenda_entry_id = ?`
 
 	row := entity.Booking{}
@@ -37,6 +37,9 @@ func (a *PhotoSessionAdapter) FindBookingByAgendaEntry(ctx context.Context, tx *
 		&row.Reason,
 		&row.ReservationToken,
 		&row.ReservedUntil,
+		&row.AssignmentMode,
+		&row.AssignmentDetails,
+		&row.SessionType,
 	)
 	if scanErr != nil {
 		if scanErr == sql.ErrNoRows {
@@ -25,7 +25,7 @@ func (a *PhotoSessionAdapter) getBooking(ctx context.Context, tx *sql.Tx, bookin
 	ctx = utils.ContextWithLogger(ctx)
 	logger := utils.LoggerFromContext(ctx)
 
-	query := `SELECT id, agenda_entry_id, photographer_user_id, listing_identity_id, starts_at, ends_at, status, reason, reservation_token, reserved_until
+	query := `SELECT id, agenda_entry_id, photographer_user_id, listing_identity_id, starts_at, ends_at, status, reason, reservation_token, reserved_until, assignment_mode, assignment_details, session_type
 		FROM photographer_photo_session_bookings WHERE id = ?`
 	if forUpdate {
 		query += " FOR UPDATE"
@@ -43,6 +43,9 @@ func (a *PhotoSessionAdapter) getBooking(ctx context.Context, tx *sql.Tx, bookin
 		&row.Reason,
 		&row.ReservationToken,
 		&row.ReservedUntil,
+		&row.AssignmentMode,
+		&row.AssignmentDetails,
+		&row.SessionType,
 	)
 	if scanErr != nil {
 		if scanErr == sql.ErrNoRows {
@@ -0,0 +1,71 @@
+package mysqlphotosessionadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ListPhotographerWorkloads sums booked minutes inside [from, to) and counts accept/reject decisions per photographer.
+// Photographers without bookings are omitted from the result map.
+func (a *PhotoSessionAdapter) ListPhotographerWorkloads(ctx context.Context, tx *sql.Tx, photographerIDs []uint64, from, to time.Time) (map[uint64]photosessionmodel.PhotographerWorkload, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	result := make(map[uint64]photosessionmodel.PhotographerWorkload, len(photographerIDs))
+	if len(photographerIDs) == 0 {
+		return result, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(photographerIDs)), ",")
+	query := fmt.Sprintf(`SELECT photographer_user_id,
+		COALESCE(SUM(CASE WHEN status IN ('PENDING_APPROVAL', 'ACCEPTED', 'ACTIVE', 'DONE') AND starts_at < ? AND ends_at > ?
+			THEN TIMESTAMPDIFF(MINUTE, starts_at, ends_at) ELSE 0 END), 0) AS booked_minutes,
+		COALESCE(SUM(CASE WHEN status IN ('ACCEPTED', 'ACTIVE', 'DONE') THEN 1 ELSE 0 END), 0) AS accepted,
+		COALESCE(SUM(CASE WHEN status = 'REJECTED' THEN 1 ELSE 0 END), 0) AS rejected
+		FROM photographer_photo_session_bookings
+		WHERE photographer_user_id IN (%s)
+		GROUP BY photographer_user_id`, placeholders)
+
+	args := make([]any, 0, len(photographerIDs)+2)
+	args = append(args, to, from)
+	for _, id := range photographerIDs {
+		args = append(args, id)
+	}
+
+	rows, queryErr := a.QueryContext(ctx, tx, "select", query, args...)
+	if queryErr != nil {
+		utils.SetSpanError(ctx, queryErr)
+		logger.Error("mysql.photo_session.list_workloads.query_error", "photographers", len(photographerIDs), "err", queryErr)
+		return nil, fmt.Errorf("list photographer workloads: %w", queryErr)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var workload photosessionmodel.PhotographerWorkload
+		if scanErr := rows.Scan(&workload.PhotographerID, &workload.BookedMinutes, &workload.Accepted, &workload.Rejected); scanErr != nil {
+			utils.SetSpanError(ctx, scanErr)
+			logger.Error("mysql.photo_session.list_workloads.scan_error", "err", scanErr)
+			return nil, fmt.Errorf("scan photographer workload: %w", scanErr)
+		}
+		result[workload.PhotographerID] = workload
+	}
+
+	if err := rows.Err(); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.photo_session.list_workloads.rows_error", "err", err)
+		return nil, fmt.Errorf("iterate photographer workloads: %w", err)
+	}
+
+	return result, nil
+}
@@ -27,8 +27,14 @@ func (a *PhotoSessionAdapter) UpdateBooking(ctx context.Context, tx *sql.Tx, boo
 		reason = entity.Reason.String
 	}
 
+	var assignmentDetails any
+	if entity.AssignmentDetails.Valid {
+		assignmentDetails = entity.AssignmentDetails.String
+	}
+
 	query := `UPDATE photographer_photo_session_bookings
-		SET agenda_entry_id = ?, photographer_user_id = ?, listing_identity_id = ?, starts_at = ?, ends_at = ?, status = ?, reason = ?, updated_at = NOW()
+		SET agenda_entry_id = ?, photographer_user_id = ?, listing_identity_id = ?, starts_at = ?, ends_at = ?, status = ?, reason = ?,
+			assignment_mode = ?, assignment_details = ?, session_type = ?
 		WHERE id = ?`
 
 	result, execErr := a.ExecContext(
@@ -43,6 +49,9 @@ func (a *PhotoSessionAdapter) UpdateBooking(ctx context.Context, tx *sql.Tx, boo
 		entity.EndsAt,
 		entity.Status,
 		reason,
+		entity.AssignmentMode,
+		assignmentDetails,
+		entity.SessionType,
 		entity.ID,
 	)
 	if execErr != nil {
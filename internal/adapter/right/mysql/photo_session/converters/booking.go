@@ -2,14 +2,15 @@ package converters
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/photo_session/entity"
 	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
 )
 
-// ToBookingEntity maps a domain booking to its DB representation, keeping nullable columns (reason, reservation_token)
-// as sql.Null* and guarding against zeroed timestamps.
+// ToBookingEntity maps a domain booking to its DB representation, keeping nullable columns (reason, reservation_token,
+// assignment_details) as sql.Null* and guarding against zeroed timestamps.
 func ToBookingEntity(booking photosessionmodel.PhotoSessionBookingInterface) entity.Booking {
 	reason := sql.NullString{}
 	if val := booking.Reason(); val != nil {
@@ -36,6 +37,13 @@ func ToBookingEntity(booking photosessionmodel.PhotoSessionBookingInterface) ent
 		reservedUntil = sql.NullTime{Time: ts, Valid: true}
 	}
 
+	assignmentDetails := sql.NullString{}
+	if details := booking.Assignment(); details != nil {
+		if raw, err := json.Marshal(details); err == nil {
+			assignmentDetails = sql.NullString{String: string(raw), Valid: true}
+		}
+	}
+
 	return entity.Booking{
 		ID:                booking.ID(),
 		AgendaEntryID:     booking.AgendaEntryID(),
@@ -47,11 +55,14 @@ func ToBookingEntity(booking photosessionmodel.PhotoSessionBookingInterface) ent
 		Reason:            reason,
 		ReservationToken:  reservationToken,
 		ReservedUntil:     reservedUntil,
+		AssignmentMode:    string(booking.AssignmentMode()),
+		AssignmentDetails: assignmentDetails,
+		SessionType:       string(booking.SessionType()),
 	}
 }
 
 // ToBookingModel converts a DB entity into a domain booking model, applying zero values for NULL timestamps
-// and nil for optional textual fields.
+// and nil for optional textual fields. Unparseable assignment details are dropped rather than failing the read.
 func ToBookingModel(entity entity.Booking) photosessionmodel.PhotoSessionBookingInterface {
 	model := photosessionmodel.NewPhotoSessionBooking()
 	model.SetID(entity.ID)
@@ -92,5 +103,14 @@ func ToBookingModel(entity entity.Booking) photosessionmodel.PhotoSessionBooking
 		model.SetReservedUntil(time.Time{})
 	}
 
+	model.SetAssignmentMode(photosessionmodel.AssignmentMode(entity.AssignmentMode))
+	model.SetSessionType(photosessionmodel.SessionType(entity.SessionType))
+	if entity.AssignmentDetails.Valid {
+		var details photosessionmodel.PhotographerAssignment
+		if err := json.Unmarshal([]byte(entity.AssignmentDetails.String), &details); err == nil {
+			model.SetAssignment(&details)
+		}
+	}
+
 	return model
 }
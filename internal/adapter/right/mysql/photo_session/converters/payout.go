@@ -0,0 +1,106 @@
+package converters
+
+import (
+	"database/sql"
+
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/photo_session/entity"
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+)
+
+// ToPayoutRuleModel converts a rule row, keeping NULL criteria as nil (match any).
+func ToPayoutRuleModel(row entity.PayoutRule) photosessionmodel.PayoutRule {
+	rule := photosessionmodel.PayoutRule{
+		ID:                  row.ID,
+		BaseAmountCents:     row.BaseAmountCents,
+		WeekendSurchargePct: row.WeekendSurchargePct,
+		HolidaySurchargePct: row.HolidaySurchargePct,
+		Active:              row.IsActive,
+	}
+	if row.PropertyType.Valid {
+		propertyType := uint16(row.PropertyType.Int32)
+		rule.PropertyType = &propertyType
+	}
+	if row.SessionType.Valid {
+		sessionType := photosessionmodel.SessionType(row.SessionType.String)
+		rule.SessionType = &sessionType
+	}
+	if row.MinSizeM2.Valid {
+		minSize := row.MinSizeM2.Float64
+		rule.MinSizeM2 = &minSize
+	}
+	if row.MaxSizeM2.Valid {
+		maxSize := row.MaxSizeM2.Float64
+		rule.MaxSizeM2 = &maxSize
+	}
+	return rule
+}
+
+// ToPayoutLedgerLineEntity maps a domain ledger line to its DB representation.
+func ToPayoutLedgerLineEntity(line photosessionmodel.PayoutLedgerLineInterface) entity.PayoutLedgerLine {
+	row := entity.PayoutLedgerLine{
+		ID:                line.ID(),
+		BookingID:         line.BookingID(),
+		PhotographerID:    line.PhotographerUserID(),
+		ListingIdentityID: line.ListingIdentityID(),
+		SessionType:       string(line.SessionType()),
+		PropertyType:      line.PropertyType(),
+		SizeM2:            line.SizeM2(),
+		SessionStartsAt:   line.SessionStartsAt(),
+		Period:            line.Period(),
+		BaseAmountCents:   line.BaseAmountCents(),
+		SurchargePct:      line.SurchargePct(),
+		AmountCents:       line.AmountCents(),
+		Status:            string(line.Status()),
+		CreatedAt:         line.CreatedAt(),
+	}
+	if id := line.RuleID(); id != nil {
+		row.RuleID = sql.NullInt64{Int64: int64(*id), Valid: true}
+	}
+	if reason := line.SurchargeReason(); reason != nil {
+		row.SurchargeReason = sql.NullString{String: *reason, Valid: true}
+	}
+	if reference := line.PayoutReference(); reference != nil {
+		row.PayoutReference = sql.NullString{String: *reference, Valid: true}
+	}
+	if paidAt := line.PaidAt(); paidAt != nil {
+		row.PaidAt = sql.NullTime{Time: *paidAt, Valid: true}
+	}
+	return row
+}
+
+// ToPayoutLedgerLineModel converts a ledger row into the domain model.
+func ToPayoutLedgerLineModel(row entity.PayoutLedgerLine) photosessionmodel.PayoutLedgerLineInterface {
+	line := photosessionmodel.NewPayoutLedgerLine()
+	line.SetID(row.ID)
+	line.SetBookingID(row.BookingID)
+	line.SetPhotographerUserID(row.PhotographerID)
+	line.SetListingIdentityID(row.ListingIdentityID)
+	line.SetSessionType(photosessionmodel.SessionType(row.SessionType))
+	line.SetPropertyType(row.PropertyType)
+	line.SetSizeM2(row.SizeM2)
+	line.SetSessionStartsAt(row.SessionStartsAt)
+	line.SetPeriod(row.Period)
+	line.SetBaseAmountCents(row.BaseAmountCents)
+	line.SetSurchargePct(row.SurchargePct)
+	line.SetAmountCents(row.AmountCents)
+	line.SetStatus(photosessionmodel.PayoutStatus(row.Status))
+	line.SetCreatedAt(row.CreatedAt)
+
+	if row.RuleID.Valid {
+		id := uint64(row.RuleID.Int64)
+		line.SetRuleID(&id)
+	}
+	if row.SurchargeReason.Valid {
+		reason := row.SurchargeReason.String
+		line.SetSurchargeReason(&reason)
+	}
+	if row.PayoutReference.Valid {
+		reference := row.PayoutReference.String
+		line.SetPayoutReference(&reference)
+	}
+	if row.PaidAt.Valid {
+		paidAt := row.PaidAt.Time
+		line.SetPaidAt(&paidAt)
+	}
+	return line
+}
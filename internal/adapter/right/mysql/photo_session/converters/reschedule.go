@@ -0,0 +1,42 @@
+package converters
+
+import (
+	"database/sql"
+
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/photo_session/entity"
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+)
+
+// ToRescheduleEntity maps a domain reschedule record to its DB representation.
+func ToRescheduleEntity(reschedule photosessionmodel.PhotoSessionRescheduleInterface) entity.Reschedule {
+	reason := sql.NullString{}
+	if val := reschedule.Reason(); val != nil {
+		reason = sql.NullString{String: *val, Valid: true}
+	}
+
+	return entity.Reschedule{
+		ID:                 reschedule.ID(),
+		BookingID:          reschedule.BookingID(),
+		ListingIdentityID:  reschedule.ListingIdentityID(),
+		RequestedBy:        reschedule.RequestedBy(),
+		FromPhotographerID: reschedule.FromPhotographerUserID(),
+		ToPhotographerID:   reschedule.ToPhotographerUserID(),
+		FromStartsAt:       reschedule.FromStartsAt(),
+		ToStartsAt:         reschedule.ToStartsAt(),
+		NoticeMinutes:      reschedule.NoticeMinutes(),
+		Reason:             reason,
+		CreatedAt:          reschedule.CreatedAt(),
+	}
+}
+
+// ToRescheduleCountModel converts an aggregation row into the domain report structure.
+func ToRescheduleCountModel(row entity.RescheduleCount) photosessionmodel.RescheduleCount {
+	return photosessionmodel.RescheduleCount{
+		SubjectID:           row.SubjectID,
+		Total:               row.Total,
+		PhotographerChanged: row.PhotographerChanged,
+		AvgNoticeMinutes:    row.AvgNoticeMinutes,
+		MinNoticeMinutes:    row.MinNoticeMinutes,
+		LastRescheduledAt:   row.LastRescheduledAt,
+	}
+}
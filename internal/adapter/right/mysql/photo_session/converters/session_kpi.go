@@ -0,0 +1,110 @@
+package converters
+
+import (
+	"database/sql"
+
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/photo_session/entity"
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+)
+
+// ToSessionKPIEntity maps a domain session KPI to its DB representation.
+func ToSessionKPIEntity(kpi photosessionmodel.SessionKPIInterface) entity.SessionKPI {
+	row := entity.SessionKPI{
+		BookingID:         kpi.BookingID(),
+		PhotographerID:    kpi.PhotographerUserID(),
+		ListingIdentityID: kpi.ListingIdentityID(),
+		SessionStartsAt:   kpi.SessionStartsAt(),
+		OwnerRejections:   kpi.OwnerRejections(),
+	}
+	if v := kpi.ArrivedAt(); v != nil {
+		row.ArrivedAt = sql.NullTime{Time: *v, Valid: true}
+	}
+	if v := kpi.ArrivalOnTime(); v != nil {
+		row.ArrivalOnTime = sql.NullBool{Bool: *v, Valid: true}
+	}
+	if v := kpi.CompletedAt(); v != nil {
+		row.CompletedAt = sql.NullTime{Time: *v, Valid: true}
+	}
+	if v := kpi.MediaUploadedAt(); v != nil {
+		row.MediaUploadedAt = sql.NullTime{Time: *v, Valid: true}
+	}
+	if v := kpi.OwnerApprovedAt(); v != nil {
+		row.OwnerApprovedAt = sql.NullTime{Time: *v, Valid: true}
+	}
+	if v := kpi.Rating(); v != nil {
+		row.Rating = sql.NullInt16{Int16: int16(*v), Valid: true}
+	}
+	if v := kpi.RatingComment(); v != nil {
+		row.RatingComment = sql.NullString{String: *v, Valid: true}
+	}
+	if v := kpi.RatedAt(); v != nil {
+		row.RatedAt = sql.NullTime{Time: *v, Valid: true}
+	}
+	return row
+}
+
+// ToSessionKPIModel converts a photographer_session_kpis row into the domain model.
+func ToSessionKPIModel(row entity.SessionKPI) photosessionmodel.SessionKPIInterface {
+	kpi := photosessionmodel.NewSessionKPI()
+	kpi.SetBookingID(row.BookingID)
+	kpi.SetPhotographerUserID(row.PhotographerID)
+	kpi.SetListingIdentityID(row.ListingIdentityID)
+	kpi.SetSessionStartsAt(row.SessionStartsAt)
+	kpi.SetOwnerRejections(row.OwnerRejections)
+	if row.ArrivedAt.Valid {
+		v := row.ArrivedAt.Time
+		kpi.SetArrivedAt(&v)
+	}
+	if row.ArrivalOnTime.Valid {
+		v := row.ArrivalOnTime.Bool
+		kpi.SetArrivalOnTime(&v)
+	}
+	if row.CompletedAt.Valid {
+		v := row.CompletedAt.Time
+		kpi.SetCompletedAt(&v)
+	}
+	if row.MediaUploadedAt.Valid {
+		v := row.MediaUploadedAt.Time
+		kpi.SetMediaUploadedAt(&v)
+	}
+	if row.OwnerApprovedAt.Valid {
+		v := row.OwnerApprovedAt.Time
+		kpi.SetOwnerApprovedAt(&v)
+	}
+	if row.Rating.Valid {
+		v := int(row.Rating.Int16)
+		kpi.SetRating(&v)
+	}
+	if row.RatingComment.Valid {
+		v := row.RatingComment.String
+		kpi.SetRatingComment(&v)
+	}
+	if row.RatedAt.Valid {
+		v := row.RatedAt.Time
+		kpi.SetRatedAt(&v)
+	}
+	return kpi
+}
+
+// ToPhotographerKPIModel converts an aggregated KPI row, keeping averages without data as nil.
+func ToPhotographerKPIModel(row entity.PhotographerKPI) photosessionmodel.PhotographerKPI {
+	kpi := photosessionmodel.PhotographerKPI{
+		PhotographerID:    row.PhotographerID,
+		Sessions:          row.Sessions,
+		ArrivalsConfirmed: row.ArrivalsConfirmed,
+		ArrivalsOnTime:    row.ArrivalsOnTime,
+		Uploads:           row.Uploads,
+		OwnerApprovals:    row.OwnerApprovals,
+		OwnerRejections:   row.OwnerRejections,
+		Ratings:           row.Ratings,
+	}
+	if row.AvgUploadMinutes.Valid {
+		v := row.AvgUploadMinutes.Float64
+		kpi.AvgUploadMinutes = &v
+	}
+	if row.AvgRating.Valid {
+		v := row.AvgRating.Float64
+		kpi.AvgRating = &v
+	}
+	return kpi
+}
@@ -6,7 +6,9 @@ import "database/sql"
 // Columns: id (PK, NOT NULL), photographer_user_id (NOT NULL), listing_identity_id (NOT NULL),
 // agenda_entry_id (NOT NULL), starts_at (DATETIME(6) NOT NULL), ends_at (DATETIME(6) NOT NULL),
 // status (ENUM NOT NULL), reason (VARCHAR(255) NULL), reservation_token (VARCHAR(36) NULL),
-// reserved_until (DATETIME(6) NOT NULL DEFAULT DATE_ADD(CURRENT_TIMESTAMP(6), INTERVAL 3 DAY)).
+// reserved_until (DATETIME(6) NOT NULL DEFAULT DATE_ADD(CURRENT_TIMESTAMP(6), INTERVAL 3 DAY)),
+// assignment_mode (ENUM NOT NULL DEFAULT 'OWNER_CHOICE'), assignment_details (JSON NULL),
+// session_type (ENUM NOT NULL DEFAULT 'STANDARD').
 type Booking struct {
 	ID                uint64         // photographer_photo_session_bookings.id
 	AgendaEntryID     uint64         // photographer_photo_session_bookings.agenda_entry_id (NOT NULL)
@@ -18,4 +20,7 @@ type Booking struct {
 	Reason            sql.NullString // photographer_photo_session_bookings.reason (NULLABLE)
 	ReservationToken  sql.NullString // photographer_photo_session_bookings.reservation_token (NULLABLE)
 	ReservedUntil     sql.NullTime   // photographer_photo_session_bookings.reserved_until (DATETIME(6), NOT NULL DEFAULT)
+	AssignmentMode    string         // photographer_photo_session_bookings.assignment_mode (ENUM, NOT NULL DEFAULT)
+	AssignmentDetails sql.NullString // photographer_photo_session_bookings.assignment_details (JSON, NULLABLE)
+	SessionType       string         // photographer_photo_session_bookings.session_type (ENUM, NOT NULL DEFAULT)
 }
@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+)
+
+// PayoutRule models photographer_payout_rules.
+// Columns: id (PK, NOT NULL), property_type (SMALLINT NULL = any), session_type (ENUM NULL = any),
+// min_size_m2/max_size_m2 (DECIMAL(10,2) NULL), base_amount_cents (INT NOT NULL),
+// weekend_surcharge_pct/holiday_surcharge_pct (DECIMAL(5,2) NOT NULL DEFAULT 0), is_active (TINYINT NOT NULL DEFAULT 1).
+type PayoutRule struct {
+	ID                  uint64          // photographer_payout_rules.id
+	PropertyType        sql.NullInt32   // photographer_payout_rules.property_type (NULLABLE)
+	SessionType         sql.NullString  // photographer_payout_rules.session_type (NULLABLE)
+	MinSizeM2           sql.NullFloat64 // photographer_payout_rules.min_size_m2 (NULLABLE)
+	MaxSizeM2           sql.NullFloat64 // photographer_payout_rules.max_size_m2 (NULLABLE)
+	BaseAmountCents     int64           // photographer_payout_rules.base_amount_cents (NOT NULL)
+	WeekendSurchargePct float64         // photographer_payout_rules.weekend_surcharge_pct (NOT NULL DEFAULT 0)
+	HolidaySurchargePct float64         // photographer_payout_rules.holiday_surcharge_pct (NOT NULL DEFAULT 0)
+	IsActive            bool            // photographer_payout_rules.is_active (NOT NULL DEFAULT 1)
+}
+
+// PayoutLedgerLine models photographer_payout_ledger.
+// Columns: id (PK), booking_id (UNIQUE, no FK so lines outlive booking retention), photographer_user_id,
+// listing_identity_id, rule_id (NULL when no rule matched), session_type, property_type, size_m2,
+// session_starts_at (DATETIME(6)), period (CHAR(7) YYYY-MM), base_amount_cents, surcharge_pct, surcharge_reason (NULL),
+// amount_cents, status (ENUM PENDING|PAID), payout_reference (NULL), paid_at (NULL), created_at (DEFAULT CURRENT_TIMESTAMP(6)).
+type PayoutLedgerLine struct {
+	ID                uint64         // photographer_payout_ledger.id
+	BookingID         uint64         // photographer_payout_ledger.booking_id (UNIQUE)
+	PhotographerID    uint64         // photographer_payout_ledger.photographer_user_id (NOT NULL)
+	ListingIdentityID int64          // photographer_payout_ledger.listing_identity_id (NOT NULL)
+	RuleID            sql.NullInt64  // photographer_payout_ledger.rule_id (NULLABLE)
+	SessionType       string         // photographer_payout_ledger.session_type (NOT NULL)
+	PropertyType      uint16         // photographer_payout_ledger.property_type (NOT NULL)
+	SizeM2            float64        // photographer_payout_ledger.size_m2 (NOT NULL DEFAULT 0)
+	SessionStartsAt   time.Time      // photographer_payout_ledger.session_starts_at (DATETIME(6), NOT NULL)
+	Period            string         // photographer_payout_ledger.period (CHAR(7), NOT NULL)
+	BaseAmountCents   int64          // photographer_payout_ledger.base_amount_cents (NOT NULL)
+	SurchargePct      float64        // photographer_payout_ledger.surcharge_pct (NOT NULL DEFAULT 0)
+	SurchargeReason   sql.NullString // photographer_payout_ledger.surcharge_reason (NULLABLE)
+	AmountCents       int64          // photographer_payout_ledger.amount_cents (NOT NULL)
+	Status            string         // photographer_payout_ledger.status (ENUM, NOT NULL DEFAULT 'PENDING')
+	PayoutReference   sql.NullString // photographer_payout_ledger.payout_reference (NULLABLE)
+	PaidAt            sql.NullTime   // photographer_payout_ledger.paid_at (DATETIME(6), NULLABLE)
+	CreatedAt         time.Time      // photographer_payout_ledger.created_at (DATETIME(6), NOT NULL DEFAULT)
+}
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Reschedule models photo_session_reschedules.
+// Columns: id (PK, NOT NULL), booking_id (NOT NULL, no FK so records outlive booking retention),
+// listing_identity_id (NOT NULL), requested_by (NOT NULL), from_photographer_user_id (NOT NULL),
+// to_photographer_user_id (NOT NULL), from_starts_at (DATETIME(6) NOT NULL), to_starts_at (DATETIME(6) NOT NULL),
+// notice_minutes (INT NOT NULL), reason (VARCHAR(255) NULL), created_at (DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6)).
+type Reschedule struct {
+	ID                 uint64         // photo_session_reschedules.id
+	BookingID          uint64         // photo_session_reschedules.booking_id (NOT NULL)
+	ListingIdentityID  int64          // photo_session_reschedules.listing_identity_id (NOT NULL)
+	RequestedBy        int64          // photo_session_reschedules.requested_by (NOT NULL)
+	FromPhotographerID uint64         // photo_session_reschedules.from_photographer_user_id (NOT NULL)
+	ToPhotographerID   uint64         // photo_session_reschedules.to_photographer_user_id (NOT NULL)
+	FromStartsAt       time.Time      // photo_session_reschedules.from_starts_at (DATETIME(6), NOT NULL)
+	ToStartsAt         time.Time      // photo_session_reschedules.to_starts_at (DATETIME(6), NOT NULL)
+	NoticeMinutes      int64          // photo_session_reschedules.notice_minutes (NOT NULL)
+	Reason             sql.NullString // photo_session_reschedules.reason (NULLABLE)
+	CreatedAt          time.Time      // photo_session_reschedules.created_at (DATETIME(6), NOT NULL DEFAULT)
+}
+
+// RescheduleCount is the projection returned by the reschedule aggregation queries.
+type RescheduleCount struct {
+	SubjectID           uint64
+	Total               int64
+	PhotographerChanged int64
+	AvgNoticeMinutes    float64
+	MinNoticeMinutes    int64
+	LastRescheduledAt   time.Time
+}
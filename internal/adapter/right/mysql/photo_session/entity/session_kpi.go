@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+)
+
+// SessionKPI models photographer_session_kpis.
+// Columns: booking_id (PK, no FK so KPIs outlive booking retention), photographer_user_id, listing_identity_id,
+// session_starts_at (DATETIME(6)), arrived_at/completed_at/media_uploaded_at/owner_approved_at/rated_at (DATETIME(6) NULL),
+// arrival_on_time (TINYINT NULL), owner_rejections (INT NOT NULL DEFAULT 0), rating (TINYINT NULL 1-5),
+// rating_comment (VARCHAR(500) NULL).
+type SessionKPI struct {
+	BookingID         uint64         // photographer_session_kpis.booking_id (PK)
+	PhotographerID    uint64         // photographer_session_kpis.photographer_user_id (NOT NULL)
+	ListingIdentityID int64          // photographer_session_kpis.listing_identity_id (NOT NULL)
+	SessionStartsAt   time.Time      // photographer_session_kpis.session_starts_at (DATETIME(6), NOT NULL)
+	ArrivedAt         sql.NullTime   // photographer_session_kpis.arrived_at (NULLABLE)
+	ArrivalOnTime     sql.NullBool   // photographer_session_kpis.arrival_on_time (NULLABLE)
+	CompletedAt       sql.NullTime   // photographer_session_kpis.completed_at (NULLABLE)
+	MediaUploadedAt   sql.NullTime   // photographer_session_kpis.media_uploaded_at (NULLABLE)
+	OwnerRejections   int            // photographer_session_kpis.owner_rejections (NOT NULL DEFAULT 0)
+	OwnerApprovedAt   sql.NullTime   // photographer_session_kpis.owner_approved_at (NULLABLE)
+	Rating            sql.NullInt16  // photographer_session_kpis.rating (NULLABLE)
+	RatingComment     sql.NullString // photographer_session_kpis.rating_comment (NULLABLE)
+	RatedAt           sql.NullTime   // photographer_session_kpis.rated_at (NULLABLE)
+}
+
+// PhotographerKPI is the aggregated photographer_session_kpis row of one photographer.
+type PhotographerKPI struct {
+	PhotographerID    uint64
+	Sessions          int64
+	ArrivalsConfirmed int64
+	ArrivalsOnTime    int64
+	Uploads           int64
+	AvgUploadMinutes  sql.NullFloat64
+	OwnerApprovals    int64
+	OwnerRejections   int64
+	Ratings           int64
+	AvgRating         sql.NullFloat64
+}
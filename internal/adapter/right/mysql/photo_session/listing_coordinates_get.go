@@ -0,0 +1,35 @@
+package mysqlphotosessionadapter
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// GetListingCoordinates returns the parcel centroid of a listing identity; returns sql.ErrNoRows when no parcel was imported.
+func (a *PhotoSessionAdapter) GetListingCoordinates(ctx context.Context, tx *sql.Tx, listingIdentityID int64) (float64, float64, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `SELECT centroid_lat, centroid_lng FROM listing_parcels WHERE listing_identity_id = ?`
+
+	var lat, lng float64
+	if scanErr := a.QueryRowContext(ctx, tx, "select", query, listingIdentityID).Scan(&lat, &lng); scanErr != nil {
+		if errors.Is(scanErr, sql.ErrNoRows) {
+			return 0, 0, sql.ErrNoRows
+		}
+		utils.SetSpanError(ctx, scanErr)
+		logger.Error("mysql.photo_session.get_listing_coordinates.scan_error", "listing_identity_id", listingIdentityID, "err", scanErr)
+		return 0, 0, fmt.Errorf("get listing coordinates: %w", scanErr)
+	}
+
+	return lat, lng, nil
+}
@@ -0,0 +1,73 @@
+package mysqlphotosessionadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/photo_session/converters"
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// CreatePayoutLine inserts a photographer_payout_ledger row and sets the generated ID on the model.
+func (a *PhotoSessionAdapter) CreatePayoutLine(ctx context.Context, tx *sql.Tx, line photosessionmodel.PayoutLedgerLineInterface) (uint64, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	entity := converters.ToPayoutLedgerLineEntity(line)
+
+	var ruleID, surchargeReason any
+	if entity.RuleID.Valid {
+		ruleID = entity.RuleID.Int64
+	}
+	if entity.SurchargeReason.Valid {
+		surchargeReason = entity.SurchargeReason.String
+	}
+
+	query := `INSERT INTO photographer_payout_ledger (
+		booking_id, photographer_user_id, listing_identity_id, rule_id, session_type, property_type, size_m2,
+		session_starts_at, period, base_amount_cents, surcharge_pct, surcharge_reason, amount_cents, status
+	) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	result, execErr := a.ExecContext(
+		ctx,
+		tx,
+		"insert",
+		query,
+		entity.BookingID,
+		entity.PhotographerID,
+		entity.ListingIdentityID,
+		ruleID,
+		entity.SessionType,
+		entity.PropertyType,
+		entity.SizeM2,
+		entity.SessionStartsAt,
+		entity.Period,
+		entity.BaseAmountCents,
+		entity.SurchargePct,
+		surchargeReason,
+		entity.AmountCents,
+		entity.Status,
+	)
+	if execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.photo_session.create_payout_line.exec_error", "booking_id", entity.BookingID, "err", execErr)
+		return 0, fmt.Errorf("insert payout ledger line: %w", execErr)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.photo_session.create_payout_line.last_id_error", "booking_id", entity.BookingID, "err", err)
+		return 0, fmt.Errorf("payout line last insert id: %w", err)
+	}
+
+	line.SetID(uint64(id))
+	return uint64(id), nil
+}
@@ -0,0 +1,98 @@
+package mysqlphotosessionadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/photo_session/converters"
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/photo_session/entity"
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ListPayoutLines returns ledger lines matching the filter ordered by photographer and session start.
+func (a *PhotoSessionAdapter) ListPayoutLines(ctx context.Context, tx *sql.Tx, filter photosessionmodel.PayoutLedgerFilter) ([]photosessionmodel.PayoutLedgerLineInterface, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	conditions := make([]string, 0, 4)
+	args := make([]any, 0, 4)
+	if filter.PhotographerUserID > 0 {
+		conditions = append(conditions, "photographer_user_id = ?")
+		args = append(args, filter.PhotographerUserID)
+	}
+	if filter.Period != "" {
+		conditions = append(conditions, "period = ?")
+		args = append(args, filter.Period)
+	}
+	if filter.Status != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, string(filter.Status))
+	}
+	if filter.PayoutReference != "" {
+		conditions = append(conditions, "payout_reference = ?")
+		args = append(args, filter.PayoutReference)
+	}
+
+	query := `SELECT id, booking_id, photographer_user_id, listing_identity_id, rule_id, session_type, property_type,
+		size_m2, session_starts_at, period, base_amount_cents, surcharge_pct, surcharge_reason, amount_cents, status,
+		payout_reference, paid_at, created_at
+		FROM photographer_payout_ledger`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY photographer_user_id, session_starts_at, id"
+
+	rows, queryErr := a.QueryContext(ctx, tx, "select", query, args...)
+	if queryErr != nil {
+		utils.SetSpanError(ctx, queryErr)
+		logger.Error("mysql.photo_session.list_payout_lines.query_error", "period", filter.Period, "err", queryErr)
+		return nil, fmt.Errorf("list payout ledger lines: %w", queryErr)
+	}
+	defer rows.Close()
+
+	lines := make([]photosessionmodel.PayoutLedgerLineInterface, 0)
+	for rows.Next() {
+		var row entity.PayoutLedgerLine
+		if scanErr := rows.Scan(
+			&row.ID,
+			&row.BookingID,
+			&row.PhotographerID,
+			&row.ListingIdentityID,
+			&row.RuleID,
+			&row.SessionType,
+			&row.PropertyType,
+			&row.SizeM2,
+			&row.SessionStartsAt,
+			&row.Period,
+			&row.BaseAmountCents,
+			&row.SurchargePct,
+			&row.SurchargeReason,
+			&row.AmountCents,
+			&row.Status,
+			&row.PayoutReference,
+			&row.PaidAt,
+			&row.CreatedAt,
+		); scanErr != nil {
+			utils.SetSpanError(ctx, scanErr)
+			logger.Error("mysql.photo_session.list_payout_lines.scan_error", "err", scanErr)
+			return nil, fmt.Errorf("scan payout ledger line: %w", scanErr)
+		}
+		lines = append(lines, converters.ToPayoutLedgerLineModel(row))
+	}
+
+	if err := rows.Err(); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.photo_session.list_payout_lines.rows_error", "err", err)
+		return nil, fmt.Errorf("iterate payout ledger lines: %w", err)
+	}
+
+	return lines, nil
+}
@@ -0,0 +1,42 @@
+package mysqlphotosessionadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// MarkPayoutLinesPaid settles every priced PENDING line of the period with the given reference; lines recorded
+// without a matching rule stay PENDING. Returns affected rows.
+func (a *PhotoSessionAdapter) MarkPayoutLinesPaid(ctx context.Context, tx *sql.Tx, period string, reference string, paidAt time.Time) (int64, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `UPDATE photographer_payout_ledger
+		SET status = 'PAID', payout_reference = ?, paid_at = ?
+		WHERE period = ? AND status = 'PENDING' AND rule_id IS NOT NULL`
+
+	result, execErr := a.ExecContext(ctx, tx, "update", query, reference, paidAt, period)
+	if execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.photo_session.mark_payout_paid.exec_error", "period", period, "err", execErr)
+		return 0, fmt.Errorf("mark payout lines paid: %w", execErr)
+	}
+
+	affected, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		utils.SetSpanError(ctx, rowsErr)
+		logger.Error("mysql.photo_session.mark_payout_paid.rows_error", "period", period, "err", rowsErr)
+		return 0, fmt.Errorf("rows affected payout lines: %w", rowsErr)
+	}
+
+	return affected, nil
+}
@@ -0,0 +1,68 @@
+package mysqlphotosessionadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/photo_session/converters"
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/photo_session/entity"
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ListPayoutRules returns payout rules ordered by id; onlyActive drops disabled rules.
+func (a *PhotoSessionAdapter) ListPayoutRules(ctx context.Context, tx *sql.Tx, onlyActive bool) ([]photosessionmodel.PayoutRule, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `SELECT id, property_type, session_type, min_size_m2, max_size_m2, base_amount_cents,
+		weekend_surcharge_pct, holiday_surcharge_pct, is_active
+		FROM photographer_payout_rules`
+	if onlyActive {
+		query += ` WHERE is_active = 1`
+	}
+	query += ` ORDER BY id`
+
+	rows, queryErr := a.QueryContext(ctx, tx, "select", query)
+	if queryErr != nil {
+		utils.SetSpanError(ctx, queryErr)
+		logger.Error("mysql.photo_session.list_payout_rules.query_error", "err", queryErr)
+		return nil, fmt.Errorf("list payout rules: %w", queryErr)
+	}
+	defer rows.Close()
+
+	rules := make([]photosessionmodel.PayoutRule, 0)
+	for rows.Next() {
+		var row entity.PayoutRule
+		if scanErr := rows.Scan(
+			&row.ID,
+			&row.PropertyType,
+			&row.SessionType,
+			&row.MinSizeM2,
+			&row.MaxSizeM2,
+			&row.BaseAmountCents,
+			&row.WeekendSurchargePct,
+			&row.HolidaySurchargePct,
+			&row.IsActive,
+		); scanErr != nil {
+			utils.SetSpanError(ctx, scanErr)
+			logger.Error("mysql.photo_session.list_payout_rules.scan_error", "err", scanErr)
+			return nil, fmt.Errorf("scan payout rule: %w", scanErr)
+		}
+		rules = append(rules, converters.ToPayoutRuleModel(row))
+	}
+
+	if err := rows.Err(); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.photo_session.list_payout_rules.rows_error", "err", err)
+		return nil, fmt.Errorf("iterate payout rules: %w", err)
+	}
+
+	return rules, nil
+}
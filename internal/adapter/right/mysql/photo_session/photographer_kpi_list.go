@@ -0,0 +1,138 @@
+package mysqlphotosessionadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/photo_session/converters"
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/photo_session/entity"
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ListPhotographerKPIs aggregates photographer_session_kpis per photographer ordered by photographer id.
+// Upload time is measured from the session start to the first media submission.
+func (a *PhotoSessionAdapter) ListPhotographerKPIs(ctx context.Context, tx *sql.Tx, filter photosessionmodel.PhotographerKPIFilter) ([]photosessionmodel.PhotographerKPI, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	where, args := buildPhotographerKPIWhere(filter)
+
+	query := `SELECT photographer_user_id,
+			COALESCE(SUM(completed_at IS NOT NULL), 0),
+			COALESCE(SUM(arrived_at IS NOT NULL), 0),
+			COALESCE(SUM(arrival_on_time = 1), 0),
+			COALESCE(SUM(media_uploaded_at IS NOT NULL), 0),
+			AVG(TIMESTAMPDIFF(MINUTE, session_starts_at, media_uploaded_at)),
+			COALESCE(SUM(owner_approved_at IS NOT NULL), 0),
+			COALESCE(SUM(owner_rejections), 0),
+			COALESCE(SUM(rating IS NOT NULL), 0),
+			AVG(rating)
+		FROM photographer_session_kpis
+		` + where + `
+		GROUP BY photographer_user_id
+		ORDER BY photographer_user_id ASC`
+	if filter.Limit > 0 {
+		offset := filter.Offset
+		if offset < 0 {
+			offset = 0
+		}
+		query += ` LIMIT ? OFFSET ?`
+		args = append(args, filter.Limit, offset)
+	}
+
+	rows, queryErr := a.QueryContext(ctx, tx, "select", query, args...)
+	if queryErr != nil {
+		utils.SetSpanError(ctx, queryErr)
+		logger.Error("mysql.photo_session.list_photographer_kpis.query_error", "err", queryErr)
+		return nil, fmt.Errorf("list photographer kpis: %w", queryErr)
+	}
+	defer rows.Close()
+
+	kpis := make([]photosessionmodel.PhotographerKPI, 0)
+	for rows.Next() {
+		var row entity.PhotographerKPI
+		if scanErr := rows.Scan(
+			&row.PhotographerID,
+			&row.Sessions,
+			&row.ArrivalsConfirmed,
+			&row.ArrivalsOnTime,
+			&row.Uploads,
+			&row.AvgUploadMinutes,
+			&row.OwnerApprovals,
+			&row.OwnerRejections,
+			&row.Ratings,
+			&row.AvgRating,
+		); scanErr != nil {
+			utils.SetSpanError(ctx, scanErr)
+			logger.Error("mysql.photo_session.list_photographer_kpis.scan_error", "err", scanErr)
+			return nil, fmt.Errorf("scan photographer kpi: %w", scanErr)
+		}
+		kpis = append(kpis, converters.ToPhotographerKPIModel(row))
+	}
+
+	if err := rows.Err(); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.photo_session.list_photographer_kpis.rows_error", "err", err)
+		return nil, fmt.Errorf("iterate photographer kpis: %w", err)
+	}
+
+	return kpis, nil
+}
+
+// CountPhotographerKPIGroups returns how many photographers have KPI rows within the filter.
+func (a *PhotoSessionAdapter) CountPhotographerKPIGroups(ctx context.Context, tx *sql.Tx, filter photosessionmodel.PhotographerKPIFilter) (int64, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	where, args := buildPhotographerKPIWhere(filter)
+	query := `SELECT COUNT(DISTINCT photographer_user_id) FROM photographer_session_kpis ` + where
+
+	var total int64
+	if scanErr := a.QueryRowContext(ctx, tx, "select", query, args...).Scan(&total); scanErr != nil {
+		utils.SetSpanError(ctx, scanErr)
+		logger.Error("mysql.photo_session.count_photographer_kpis.scan_error", "err", scanErr)
+		return 0, fmt.Errorf("count photographer kpis: %w", scanErr)
+	}
+
+	return total, nil
+}
+
+func buildPhotographerKPIWhere(filter photosessionmodel.PhotographerKPIFilter) (string, []any) {
+	conditions := make([]string, 0, 3)
+	args := make([]any, 0, len(filter.PhotographerIDs)+2)
+
+	if len(filter.PhotographerIDs) > 0 {
+		placeholders := make([]string, 0, len(filter.PhotographerIDs))
+		for _, id := range filter.PhotographerIDs {
+			placeholders = append(placeholders, "?")
+			args = append(args, id)
+		}
+		conditions = append(conditions, "photographer_user_id IN ("+strings.Join(placeholders, ",")+")")
+	}
+	if filter.From != nil {
+		conditions = append(conditions, "session_starts_at >= ?")
+		args = append(args, filter.From.UTC())
+	}
+	if filter.To != nil {
+		conditions = append(conditions, "session_starts_at < ?")
+		args = append(args, filter.To.UTC())
+	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
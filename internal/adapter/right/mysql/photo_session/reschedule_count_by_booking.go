@@ -0,0 +1,31 @@
+package mysqlphotosessionadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// CountReschedulesByBooking returns how many reschedules were recorded for the booking.
+func (a *PhotoSessionAdapter) CountReschedulesByBooking(ctx context.Context, tx *sql.Tx, bookingID uint64) (int64, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `SELECT COUNT(*) FROM photo_session_reschedules WHERE booking_id = ?`
+
+	var total int64
+	if scanErr := a.QueryRowContext(ctx, tx, "select", query, bookingID).Scan(&total); scanErr != nil {
+		utils.SetSpanError(ctx, scanErr)
+		logger.Error("mysql.photo_session.count_reschedules_by_booking.scan_error", "booking_id", bookingID, "err", scanErr)
+		return 0, fmt.Errorf("count reschedules by booking: %w", scanErr)
+	}
+
+	return total, nil
+}
@@ -0,0 +1,65 @@
+package mysqlphotosessionadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/photo_session/converters"
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// CreateReschedule inserts a photo_session_reschedules row and sets the generated ID on the model.
+func (a *PhotoSessionAdapter) CreateReschedule(ctx context.Context, tx *sql.Tx, reschedule photosessionmodel.PhotoSessionRescheduleInterface) (uint64, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	entity := converters.ToRescheduleEntity(reschedule)
+
+	var reason any
+	if entity.Reason.Valid {
+		reason = entity.Reason.String
+	}
+
+	query := `INSERT INTO photo_session_reschedules (
+		booking_id, listing_identity_id, requested_by, from_photographer_user_id, to_photographer_user_id,
+		from_starts_at, to_starts_at, notice_minutes, reason
+	) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	result, execErr := a.ExecContext(
+		ctx,
+		tx,
+		"insert",
+		query,
+		entity.BookingID,
+		entity.ListingIdentityID,
+		entity.RequestedBy,
+		entity.FromPhotographerID,
+		entity.ToPhotographerID,
+		entity.FromStartsAt,
+		entity.ToStartsAt,
+		entity.NoticeMinutes,
+		reason,
+	)
+	if execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.photo_session.create_reschedule.exec_error", "booking_id", entity.BookingID, "err", execErr)
+		return 0, fmt.Errorf("insert photo session reschedule: %w", execErr)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.photo_session.create_reschedule.last_id_error", "booking_id", entity.BookingID, "err", err)
+		return 0, fmt.Errorf("reschedule last insert id: %w", err)
+	}
+
+	reschedule.SetID(uint64(id))
+	return uint64(id), nil
+}
@@ -0,0 +1,125 @@
+package mysqlphotosessionadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/photo_session/converters"
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/photo_session/entity"
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ListRescheduleCounts aggregates photo_session_reschedules per listing identity or per original photographer.
+func (a *PhotoSessionAdapter) ListRescheduleCounts(ctx context.Context, tx *sql.Tx, filter photosessionmodel.RescheduleReportFilter) ([]photosessionmodel.RescheduleCount, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	column := rescheduleGroupColumn(filter.GroupBy)
+	where, args := buildRescheduleWhere(filter)
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	offset := filter.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	query := fmt.Sprintf(`SELECT %[1]s,
+			COUNT(*),
+			SUM(from_photographer_user_id <> to_photographer_user_id),
+			AVG(notice_minutes),
+			MIN(notice_minutes),
+			MAX(created_at)
+		FROM photo_session_reschedules
+		%[2]s
+		GROUP BY %[1]s
+		ORDER BY COUNT(*) DESC, %[1]s ASC
+		LIMIT ? OFFSET ?`, column, where)
+	args = append(args, limit, offset)
+
+	rows, queryErr := a.QueryContext(ctx, tx, "select", query, args...)
+	if queryErr != nil {
+		utils.SetSpanError(ctx, queryErr)
+		logger.Error("mysql.photo_session.list_reschedule_counts.query_error", "group_by", filter.GroupBy, "err", queryErr)
+		return nil, fmt.Errorf("list reschedule counts: %w", queryErr)
+	}
+	defer rows.Close()
+
+	counts := make([]photosessionmodel.RescheduleCount, 0)
+	for rows.Next() {
+		var row entity.RescheduleCount
+		if scanErr := rows.Scan(&row.SubjectID, &row.Total, &row.PhotographerChanged, &row.AvgNoticeMinutes, &row.MinNoticeMinutes, &row.LastRescheduledAt); scanErr != nil {
+			utils.SetSpanError(ctx, scanErr)
+			logger.Error("mysql.photo_session.list_reschedule_counts.scan_error", "err", scanErr)
+			return nil, fmt.Errorf("scan reschedule count: %w", scanErr)
+		}
+		counts = append(counts, converters.ToRescheduleCountModel(row))
+	}
+
+	if err := rows.Err(); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.photo_session.list_reschedule_counts.rows_error", "err", err)
+		return nil, fmt.Errorf("iterate reschedule counts: %w", err)
+	}
+
+	return counts, nil
+}
+
+// CountRescheduleGroups returns how many listings or photographers have reschedules within the filter window.
+func (a *PhotoSessionAdapter) CountRescheduleGroups(ctx context.Context, tx *sql.Tx, filter photosessionmodel.RescheduleReportFilter) (int64, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	where, args := buildRescheduleWhere(filter)
+	query := fmt.Sprintf(`SELECT COUNT(DISTINCT %s) FROM photo_session_reschedules %s`, rescheduleGroupColumn(filter.GroupBy), where)
+
+	var total int64
+	if scanErr := a.QueryRowContext(ctx, tx, "select", query, args...).Scan(&total); scanErr != nil {
+		utils.SetSpanError(ctx, scanErr)
+		logger.Error("mysql.photo_session.count_reschedule_groups.scan_error", "group_by", filter.GroupBy, "err", scanErr)
+		return 0, fmt.Errorf("count reschedule groups: %w", scanErr)
+	}
+
+	return total, nil
+}
+
+func rescheduleGroupColumn(group photosessionmodel.RescheduleReportGroup) string {
+	if group == photosessionmodel.RescheduleReportGroupPhotographer {
+		return "from_photographer_user_id"
+	}
+	return "listing_identity_id"
+}
+
+func buildRescheduleWhere(filter photosessionmodel.RescheduleReportFilter) (string, []any) {
+	conditions := make([]string, 0, 2)
+	args := make([]any, 0, 4)
+
+	if filter.From != nil {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, filter.From.UTC())
+	}
+	if filter.To != nil {
+		conditions = append(conditions, "created_at < ?")
+		args = append(args, filter.To.UTC())
+	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
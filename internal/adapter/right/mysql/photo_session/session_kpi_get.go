@@ -0,0 +1,92 @@
+package mysqlphotosessionadapter
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/photo_session/converters"
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/photo_session/entity"
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+const sessionKPIColumns = `booking_id, photographer_user_id, listing_identity_id, session_starts_at, arrived_at, arrival_on_time,
+		completed_at, media_uploaded_at, owner_rejections, owner_approved_at, rating, rating_comment, rated_at`
+
+// GetSessionKPIForUpdate locks the KPI row of a booking; returns sql.ErrNoRows when none was recorded yet.
+func (a *PhotoSessionAdapter) GetSessionKPIForUpdate(ctx context.Context, tx *sql.Tx, bookingID uint64) (photosessionmodel.SessionKPIInterface, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `SELECT ` + sessionKPIColumns + ` FROM photographer_session_kpis WHERE booking_id = ? FOR UPDATE`
+
+	kpi, scanErr := scanSessionKPI(a.QueryRowContext(ctx, tx, "select", query, bookingID))
+	if scanErr != nil {
+		if errors.Is(scanErr, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		utils.SetSpanError(ctx, scanErr)
+		logger.Error("mysql.photo_session.get_session_kpi.scan_error", "booking_id", bookingID, "err", scanErr)
+		return nil, fmt.Errorf("get session kpi: %w", scanErr)
+	}
+
+	return kpi, nil
+}
+
+// GetLatestCompletedSessionKPIForUpdate locks the KPI row of the listing's most recent completed session;
+// returns sql.ErrNoRows when the listing has none.
+func (a *PhotoSessionAdapter) GetLatestCompletedSessionKPIForUpdate(ctx context.Context, tx *sql.Tx, listingIdentityID int64) (photosessionmodel.SessionKPIInterface, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `SELECT ` + sessionKPIColumns + ` FROM photographer_session_kpis
+		WHERE listing_identity_id = ? AND completed_at IS NOT NULL
+		ORDER BY session_starts_at DESC, booking_id DESC
+		LIMIT 1 FOR UPDATE`
+
+	kpi, scanErr := scanSessionKPI(a.QueryRowContext(ctx, tx, "select", query, listingIdentityID))
+	if scanErr != nil {
+		if errors.Is(scanErr, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		utils.SetSpanError(ctx, scanErr)
+		logger.Error("mysql.photo_session.get_latest_session_kpi.scan_error", "listing_identity_id", listingIdentityID, "err", scanErr)
+		return nil, fmt.Errorf("get latest session kpi: %w", scanErr)
+	}
+
+	return kpi, nil
+}
+
+func scanSessionKPI(row *sql.Row) (photosessionmodel.SessionKPIInterface, error) {
+	var e entity.SessionKPI
+	if err := row.Scan(
+		&e.BookingID,
+		&e.PhotographerID,
+		&e.ListingIdentityID,
+		&e.SessionStartsAt,
+		&e.ArrivedAt,
+		&e.ArrivalOnTime,
+		&e.CompletedAt,
+		&e.MediaUploadedAt,
+		&e.OwnerRejections,
+		&e.OwnerApprovedAt,
+		&e.Rating,
+		&e.RatingComment,
+		&e.RatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return converters.ToSessionKPIModel(e), nil
+}
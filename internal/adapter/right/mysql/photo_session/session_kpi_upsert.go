@@ -0,0 +1,68 @@
+package mysqlphotosessionadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/photo_session/converters"
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// UpsertSessionKPI inserts or fully overwrites the KPI row of a booking.
+func (a *PhotoSessionAdapter) UpsertSessionKPI(ctx context.Context, tx *sql.Tx, kpi photosessionmodel.SessionKPIInterface) error {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	row := converters.ToSessionKPIEntity(kpi)
+
+	query := `INSERT INTO photographer_session_kpis (
+		booking_id, photographer_user_id, listing_identity_id, session_starts_at, arrived_at, arrival_on_time,
+		completed_at, media_uploaded_at, owner_rejections, owner_approved_at, rating, rating_comment, rated_at
+	) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	ON DUPLICATE KEY UPDATE
+		photographer_user_id = VALUES(photographer_user_id),
+		listing_identity_id = VALUES(listing_identity_id),
+		session_starts_at = VALUES(session_starts_at),
+		arrived_at = VALUES(arrived_at),
+		arrival_on_time = VALUES(arrival_on_time),
+		completed_at = VALUES(completed_at),
+		media_uploaded_at = VALUES(media_uploaded_at),
+		owner_rejections = VALUES(owner_rejections),
+		owner_approved_at = VALUES(owner_approved_at),
+		rating = VALUES(rating),
+		rating_comment = VALUES(rating_comment),
+		rated_at = VALUES(rated_at)`
+
+	if _, execErr := a.ExecContext(
+		ctx,
+		tx,
+		"insert",
+		query,
+		row.BookingID,
+		row.PhotographerID,
+		row.ListingIdentityID,
+		row.SessionStartsAt,
+		row.ArrivedAt,
+		row.ArrivalOnTime,
+		row.CompletedAt,
+		row.MediaUploadedAt,
+		row.OwnerRejections,
+		row.OwnerApprovedAt,
+		row.Rating,
+		row.RatingComment,
+		row.RatedAt,
+	); execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.photo_session.upsert_session_kpi.exec_error", "booking_id", row.BookingID, "err", execErr)
+		return fmt.Errorf("upsert session kpi: %w", execErr)
+	}
+
+	return nil
+}
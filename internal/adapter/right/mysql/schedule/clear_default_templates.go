@@ -0,0 +1,38 @@
+package mysqlscheduleadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ClearDefaultTemplates unsets is_default on every template of an owner except keepID (0 clears all).
+//
+// Parameters:
+//   - ctx: request-scoped context with tracing/logging.
+//   - tx: required transaction, shared with the write that marks the new default template.
+//   - ownerID: users.id owning the templates.
+//   - keepID: template that keeps its flag.
+//
+// Returns: driver errors for execution failures; updating zero rows is not an error.
+// Observability: tracer span, logger propagation, span error marking on infra failures.
+func (a *ScheduleAdapter) ClearDefaultTemplates(ctx context.Context, tx *sql.Tx, ownerID int64, keepID uint64) error {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `UPDATE availability_templates SET is_default = 0 WHERE owner_id = ? AND is_default = 1 AND id <> ?`
+	if _, execErr := a.ExecContext(ctx, tx, "update", query, ownerID, keepID); execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.schedule.clear_default_templates.exec_error", "owner_id", ownerID, "err", execErr)
+		return fmt.Errorf("clear default availability templates: %w", execErr)
+	}
+
+	return nil
+}
@@ -3,6 +3,8 @@
 package scheduleconverters
 
 import (
+	"database/sql"
+
 	scheduleentity "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/schedule/entities"
 	schedulemodel "github.com/projeto-toq/toq_server/internal/core/model/schedule_model"
 )
@@ -15,16 +17,25 @@ func AgendaEntityToDomain(e scheduleentity.AgendaEntity) schedulemodel.AgendaInt
 	agenda.SetListingIdentityID(e.ListingIdentityID)
 	agenda.SetOwnerID(e.OwnerID)
 	agenda.SetTimezone(e.Timezone)
+	if e.TemplateID.Valid {
+		agenda.SetTemplateID(uint64(e.TemplateID.Int64))
+	}
+	agenda.SetTemplateOverridden(e.TemplateOverridden)
 	return agenda
 }
 
 // AgendaDomainToEntity converts a domain agenda into its persistence shape for INSERT/UPDATE operations.
 // Parameters: AgendaInterface with domain getters; Returns: AgendaEntity mirroring listing_agendas schema.
 func AgendaDomainToEntity(model schedulemodel.AgendaInterface) scheduleentity.AgendaEntity {
-	return scheduleentity.AgendaEntity{
-		ID:                model.ID(),
-		ListingIdentityID: model.ListingIdentityID(),
-		OwnerID:           model.OwnerID(),
-		Timezone:          model.Timezone(),
+	entity := scheduleentity.AgendaEntity{
+		ID:                 model.ID(),
+		ListingIdentityID:  model.ListingIdentityID(),
+		OwnerID:            model.OwnerID(),
+		Timezone:           model.Timezone(),
+		TemplateOverridden: model.TemplateOverridden(),
+	}
+	if templateID, ok := model.TemplateID(); ok {
+		entity.TemplateID = sql.NullInt64{Int64: int64(templateID), Valid: true}
 	}
+	return entity
 }
@@ -1,13 +1,18 @@
 package scheduleconverters
 
 import (
+	"database/sql"
 	"time"
 
 	scheduleentity "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/schedule/entities"
 	schedulemodel "github.com/projeto-toq/toq_server/internal/core/model/schedule_model"
 )
 
+// ruleDateLayout formats DATE columns; dates are civil days of the agenda timezone, carried as UTC midnights.
+const ruleDateLayout = "2006-01-02"
+
 // RuleEntityToDomain converts RuleEntity to the domain representation.
+// NULL handling: optional recurrence selectors and validity dates are mapped only when Valid.
 // Parameters: RuleEntity scanned from DB; Returns: AgendaRuleInterface with domain types (Weekday, RuleType).
 func RuleEntityToDomain(e scheduleentity.RuleEntity) schedulemodel.AgendaRuleInterface {
 	rule := schedulemodel.NewAgendaRule()
@@ -18,23 +23,76 @@ func RuleEntityToDomain(e scheduleentity.RuleEntity) schedulemodel.AgendaRuleInt
 	rule.SetEndMinutes(e.EndMinute)
 	rule.SetRuleType(schedulemodel.RuleType(e.RuleType))
 	rule.SetActive(e.IsActive)
+	rule.SetRecurrence(schedulemodel.RuleRecurrence(e.Recurrence))
+	if e.DayOfMonth.Valid {
+		rule.SetDayOfMonth(uint8(e.DayOfMonth.Int16))
+	}
+	if e.WeekOfMonth.Valid {
+		rule.SetWeekOfMonth(int8(e.WeekOfMonth.Int16))
+	}
+	if e.RuleDate.Valid {
+		rule.SetRuleDate(civilDate(e.RuleDate.Time))
+	}
+	if e.ValidFrom.Valid {
+		rule.SetValidFrom(civilDate(e.ValidFrom.Time))
+	}
+	if e.ValidUntil.Valid {
+		rule.SetValidUntil(civilDate(e.ValidUntil.Time))
+	}
 	return rule
 }
 
+// RuleDomainToEntity converts a domain rule into its persistence shape, encoding unset optional fields as NULL.
+// Parameters: AgendaRuleInterface; Returns: RuleEntity mirroring listing_agenda_rules schema.
+func RuleDomainToEntity(model schedulemodel.AgendaRuleInterface) scheduleentity.RuleEntity {
+	entity := scheduleentity.RuleEntity{
+		ID:          model.ID(),
+		AgendaID:    model.AgendaID(),
+		DayOfWeek:   uint8(model.DayOfWeek()),
+		StartMinute: model.StartMinutes(),
+		EndMinute:   model.EndMinutes(),
+		RuleType:    string(model.RuleType()),
+		IsActive:    model.IsActive(),
+		Recurrence:  string(model.Recurrence()),
+	}
+
+	switch model.Recurrence() {
+	case schedulemodel.RuleRecurrenceMonthlyDay:
+		entity.DayOfMonth = sql.NullInt16{Int16: int16(model.DayOfMonth()), Valid: true}
+	case schedulemodel.RuleRecurrenceMonthlyWeekday:
+		entity.WeekOfMonth = sql.NullInt16{Int16: int16(model.WeekOfMonth()), Valid: true}
+	}
+	if value, ok := model.RuleDate(); ok {
+		entity.RuleDate = sql.NullTime{Time: civilDate(value), Valid: true}
+	}
+	if value, ok := model.ValidFrom(); ok {
+		entity.ValidFrom = sql.NullTime{Time: civilDate(value), Valid: true}
+	}
+	if value, ok := model.ValidUntil(); ok {
+		entity.ValidUntil = sql.NullTime{Time: civilDate(value), Valid: true}
+	}
+	return entity
+}
+
 // RuleDomainsToEntities converts domain rules into persistence entities for bulk inserts/updates.
 // Parameters: slice of AgendaRuleInterface; Returns: slice of RuleEntity mirroring listing_agenda_rules schema.
 func RuleDomainsToEntities(models []schedulemodel.AgendaRuleInterface) []scheduleentity.RuleEntity {
 	entities := make([]scheduleentity.RuleEntity, 0, len(models))
 	for _, model := range models {
-		entities = append(entities, scheduleentity.RuleEntity{
-			ID:          model.ID(),
-			AgendaID:    model.AgendaID(),
-			DayOfWeek:   uint8(model.DayOfWeek()),
-			StartMinute: model.StartMinutes(),
-			EndMinute:   model.EndMinutes(),
-			RuleType:    string(model.RuleType()),
-			IsActive:    model.IsActive(),
-		})
+		entities = append(entities, RuleDomainToEntity(model))
 	}
 	return entities
 }
+
+// RuleDateString renders a nullable DATE as YYYY-MM-DD for query parameters, or nil for NULL.
+func RuleDateString(value sql.NullTime) any {
+	if !value.Valid {
+		return nil
+	}
+	return value.Time.Format(ruleDateLayout)
+}
+
+// civilDate drops the clock and location of a DATE value, keeping its calendar day as a UTC midnight.
+func civilDate(value time.Time) time.Time {
+	return time.Date(value.Year(), value.Month(), value.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,38 @@
+package scheduleconverters
+
+import (
+	scheduleentity "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/schedule/entities"
+	schedulemodel "github.com/projeto-toq/toq_server/internal/core/model/schedule_model"
+)
+
+// TemplateEntityToDomain converts a TemplateEntity and its scanned rules into the domain representation.
+// Parameters: TemplateEntity and rule entities of availability_template_rules; Returns: AvailabilityTemplateInterface.
+func TemplateEntityToDomain(e scheduleentity.TemplateEntity, rules []scheduleentity.RuleEntity) schedulemodel.AvailabilityTemplateInterface {
+	template := schedulemodel.NewAvailabilityTemplate()
+	template.SetID(e.ID)
+	template.SetOwnerID(e.OwnerID)
+	template.SetName(e.Name)
+	template.SetDefault(e.IsDefault)
+	template.SetCreatedAt(e.CreatedAt)
+	template.SetUpdatedAt(e.UpdatedAt)
+
+	domainRules := make([]schedulemodel.AgendaRuleInterface, 0, len(rules))
+	for _, rule := range rules {
+		domainRules = append(domainRules, RuleEntityToDomain(rule))
+	}
+	template.SetRules(domainRules)
+	return template
+}
+
+// TemplateDomainToEntity converts a domain template into its persistence shape; rules are converted separately.
+// Parameters: AvailabilityTemplateInterface; Returns: TemplateEntity mirroring availability_templates schema.
+func TemplateDomainToEntity(model schedulemodel.AvailabilityTemplateInterface) scheduleentity.TemplateEntity {
+	return scheduleentity.TemplateEntity{
+		ID:        model.ID(),
+		OwnerID:   model.OwnerID(),
+		Name:      model.Name(),
+		IsDefault: model.IsDefault(),
+		CreatedAt: model.CreatedAt(),
+		UpdatedAt: model.UpdatedAt(),
+	}
+}
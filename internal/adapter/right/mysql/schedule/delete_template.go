@@ -0,0 +1,49 @@
+package mysqlscheduleadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// DeleteTemplate removes an availability template; its rules cascade and linked agendas get template_id NULL.
+//
+// Parameters:
+//   - ctx: request-scoped context with tracing/logging.
+//   - tx: required transaction to maintain write atomicity.
+//   - templateID: target template identifier.
+//
+// Returns: sql.ErrNoRows when no template matched; driver errors for execution/rows affected failures.
+// Observability: initializes tracer, propagates logger, marks span on infra errors and logs with compact context.
+func (a *ScheduleAdapter) DeleteTemplate(ctx context.Context, tx *sql.Tx, templateID uint64) error {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `DELETE FROM availability_templates WHERE id = ?`
+	result, execErr := a.ExecContext(ctx, tx, "delete", query, templateID)
+	if execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.schedule.delete_template.exec_error", "template_id", templateID, "err", execErr)
+		return fmt.Errorf("delete availability template: %w", execErr)
+	}
+
+	affected, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		utils.SetSpanError(ctx, rowsErr)
+		logger.Error("mysql.schedule.delete_template.rows_error", "template_id", templateID, "err", rowsErr)
+		return fmt.Errorf("availability template rows affected: %w", rowsErr)
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -1,7 +1,10 @@
-// Package scheduleentity holds the MySQL persistence shapes for schedule data (listing_agendas, listing_agenda_rules, listing_agenda_entries).
+// Package scheduleentity holds the MySQL persistence shapes for schedule data (listing_agendas, listing_agenda_rules, listing_agenda_entries,
+// availability_templates, availability_template_rules).
 // These structs mirror the DB schema defined in scripts/db_creation.sql and are only used inside the MySQL adapter layer.
 package scheduleentity
 
+import "database/sql"
+
 // AgendaEntity maps listing_agendas rows (InnoDB, utf8mb4) and is restricted to the MySQL adapter layer.
 // Schema summary: PRIMARY KEY (id), FK listing_identity_id -> listing_identities.id, owner_id -> users.id, timezone default 'America/Sao_Paulo'.
 // Use scheduleconverters for domain conversions; do not import domain packages here.
@@ -14,4 +17,8 @@ type AgendaEntity struct {
 	OwnerID int64
 	// Timezone is the Olson timezone string (VARCHAR(50) NOT NULL, default 'America/Sao_Paulo').
 	Timezone string
+	// TemplateID references availability_templates.id (INT UNSIGNED NULL, SET NULL when the template is deleted).
+	TemplateID sql.NullInt64
+	// TemplateOverridden flags rules edited locally after the template was applied (TINYINT NOT NULL DEFAULT 0).
+	TemplateOverridden bool
 }
@@ -1,7 +1,9 @@
 package scheduleentity
 
+import "database/sql"
+
 // RuleEntity maps listing_agenda_rules rows (unique per agenda/day/start/end) for adapter use only.
-// Schema summary: PK (id), FK agenda_id -> listing_agendas.id, unique (agenda_id, day_of_week, start_minute, end_minute), default is_active=1.
+// Schema summary: PK (id), FK agenda_id -> listing_agendas.id, index (agenda_id, day_of_week), default is_active=1, default recurrence WEEKLY.
 // Conversions to/from domain are handled by scheduleconverters; no domain imports should be added here.
 type RuleEntity struct {
 	// ID is the AUTO_INCREMENT primary key (INT UNSIGNED NOT NULL).
@@ -18,4 +20,16 @@ type RuleEntity struct {
 	RuleType string
 	// IsActive indicates whether the rule is currently applied (TINYINT(1) NOT NULL DEFAULT 1).
 	IsActive bool
+	// Recurrence selects the days the rule applies to (ENUM('WEEKLY','MONTHLY_DAY','MONTHLY_WEEKDAY','DATE') NOT NULL DEFAULT 'WEEKLY').
+	Recurrence string
+	// DayOfMonth is the day used by MONTHLY_DAY rules (TINYINT UNSIGNED NULL).
+	DayOfMonth sql.NullInt16
+	// WeekOfMonth is the weekday occurrence used by MONTHLY_WEEKDAY rules, -1 meaning last (TINYINT NULL).
+	WeekOfMonth sql.NullInt16
+	// RuleDate is the day targeted by DATE rules (DATE NULL).
+	RuleDate sql.NullTime
+	// ValidFrom is the first day the rule applies, inclusive (DATE NULL).
+	ValidFrom sql.NullTime
+	// ValidUntil is the last day the rule applies, inclusive (DATE NULL).
+	ValidUntil sql.NullTime
 }
@@ -0,0 +1,22 @@
+package scheduleentity
+
+import "time"
+
+// TemplateEntity maps availability_templates rows for adapter use only.
+// Schema summary: PK (id), FK owner_id -> users.id, UNIQUE (owner_id, name), is_default default 0.
+// Template rules are stored in availability_template_rules with the same columns as listing_agenda_rules,
+// keyed by template_id instead of agenda_id; they are scanned into RuleEntity with AgendaID left at zero.
+type TemplateEntity struct {
+	// ID is the AUTO_INCREMENT primary key (INT UNSIGNED NOT NULL).
+	ID uint64
+	// OwnerID references users.id (INT UNSIGNED NOT NULL).
+	OwnerID int64
+	// Name is the owner-facing label (VARCHAR(80) NOT NULL, unique per owner).
+	Name string
+	// IsDefault marks the template applied to agendas of new listings (TINYINT NOT NULL DEFAULT 0).
+	IsDefault bool
+	// CreatedAt is the creation instant (DATETIME(6) NOT NULL).
+	CreatedAt time.Time
+	// UpdatedAt is the last name/rules change (DATETIME(6) NOT NULL).
+	UpdatedAt time.Time
+}
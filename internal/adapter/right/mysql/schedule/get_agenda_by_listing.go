@@ -20,7 +20,7 @@ import (
 //   - listingIdentityID: target listing_identity_id foreign key.
 //
 // Returns:
-//   - AgendaInterface: populated with id, listingIdentityID, ownerID and timezone.
+//   - AgendaInterface: populated with id, listingIdentityID, ownerID, timezone and template link.
 //   - error: sql.ErrNoRows when no agenda exists; driver errors for query/scan issues.
 //
 // Observability:
@@ -35,12 +35,12 @@ func (a *ScheduleAdapter) GetAgendaByListingIdentityID(ctx context.Context, tx *
 	ctx = utils.ContextWithLogger(ctx)
 	logger := utils.LoggerFromContext(ctx)
 
-	query := `SELECT id, listing_identity_id, owner_id, timezone FROM listing_agendas WHERE listing_identity_id = ? LIMIT 1`
+	query := `SELECT id, listing_identity_id, owner_id, timezone, template_id, template_overridden FROM listing_agendas WHERE listing_identity_id = ? LIMIT 1`
 
 	row := a.QueryRowContext(ctx, tx, "select", query, listingIdentityID)
 
 	var agendaEntity scheduleentity.AgendaEntity
-	if err = row.Scan(&agendaEntity.ID, &agendaEntity.ListingIdentityID, &agendaEntity.OwnerID, &agendaEntity.Timezone); err != nil {
+	if err = row.Scan(&agendaEntity.ID, &agendaEntity.ListingIdentityID, &agendaEntity.OwnerID, &agendaEntity.Timezone, &agendaEntity.TemplateID, &agendaEntity.TemplateOverridden); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, sql.ErrNoRows
 		}
@@ -0,0 +1,52 @@
+package mysqlscheduleadapter
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	scheduleconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/schedule/converters"
+	scheduleentity "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/schedule/entities"
+	schedulemodel "github.com/projeto-toq/toq_server/internal/core/model/schedule_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// GetDefaultTemplateByOwner fetches the owner's default availability template with its rules.
+//
+// Parameters:
+//   - ctx: request-scoped context for tracing/logging.
+//   - tx: transaction of the agenda creation so the template and the new agenda rules stay consistent.
+//   - ownerID: users.id owning the template.
+//
+// Returns: AvailabilityTemplateInterface; sql.ErrNoRows when the owner has no default template.
+// Observability: tracer span, logger propagation, span error marking on infra failures.
+func (a *ScheduleAdapter) GetDefaultTemplateByOwner(ctx context.Context, tx *sql.Tx, ownerID int64) (schedulemodel.AvailabilityTemplateInterface, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `SELECT ` + templateColumns + ` FROM availability_templates t WHERE t.owner_id = ? AND t.is_default = 1 ORDER BY t.id LIMIT 1`
+	row := a.QueryRowContext(ctx, tx, "select", query, ownerID)
+
+	var entity scheduleentity.TemplateEntity
+	if err = row.Scan(&entity.ID, &entity.OwnerID, &entity.Name, &entity.IsDefault, &entity.CreatedAt, &entity.UpdatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.schedule.get_default_template.scan_error", "owner_id", ownerID, "err", err)
+		return nil, fmt.Errorf("scan default availability template: %w", err)
+	}
+
+	rules, err := a.queryTemplateRules(ctx, tx, `r.template_id = ?`, entity.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduleconverters.TemplateEntityToDomain(entity, rules[entity.ID]), nil
+}
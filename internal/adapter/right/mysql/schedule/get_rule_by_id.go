@@ -30,10 +30,10 @@ func (a *ScheduleAdapter) GetRuleByID(ctx context.Context, tx *sql.Tx, ruleID ui
 	ctx = utils.ContextWithLogger(ctx)
 	logger := utils.LoggerFromContext(ctx)
 
-	query := `SELECT id, agenda_id, day_of_week, start_minute, end_minute, rule_type, is_active FROM listing_agenda_rules WHERE id = ?`
+	query := `SELECT id, agenda_id, day_of_week, start_minute, end_minute, rule_type, is_active, recurrence, day_of_month, week_of_month, rule_date, valid_from, valid_until FROM listing_agenda_rules WHERE id = ?`
 
 	var ruleEntity scheduleentity.RuleEntity
-	if err = a.QueryRowContext(ctx, tx, "select", query, ruleID).Scan(&ruleEntity.ID, &ruleEntity.AgendaID, &ruleEntity.DayOfWeek, &ruleEntity.StartMinute, &ruleEntity.EndMinute, &ruleEntity.RuleType, &ruleEntity.IsActive); err != nil {
+	if err = a.QueryRowContext(ctx, tx, "select", query, ruleID).Scan(&ruleEntity.ID, &ruleEntity.AgendaID, &ruleEntity.DayOfWeek, &ruleEntity.StartMinute, &ruleEntity.EndMinute, &ruleEntity.RuleType, &ruleEntity.IsActive, &ruleEntity.Recurrence, &ruleEntity.DayOfMonth, &ruleEntity.WeekOfMonth, &ruleEntity.RuleDate, &ruleEntity.ValidFrom, &ruleEntity.ValidUntil); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, sql.ErrNoRows
 		}
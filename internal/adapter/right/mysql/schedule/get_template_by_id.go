@@ -0,0 +1,93 @@
+package mysqlscheduleadapter
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	scheduleconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/schedule/converters"
+	scheduleentity "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/schedule/entities"
+	schedulemodel "github.com/projeto-toq/toq_server/internal/core/model/schedule_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+const templateColumns = `t.id, t.owner_id, t.name, t.is_default, t.created_at, t.updated_at`
+
+const templateRuleColumns = `r.template_id, r.id, r.day_of_week, r.start_minute, r.end_minute, r.rule_type, r.is_active, r.recurrence, r.day_of_month, r.week_of_month, r.rule_date, r.valid_from, r.valid_until`
+
+// GetTemplateByID fetches an availability template with its rules.
+//
+// Parameters:
+//   - ctx: request-scoped context for tracing/logging.
+//   - tx: optional transaction; use non-nil when the template is about to be modified or applied.
+//   - templateID: target template identifier.
+//
+// Returns: AvailabilityTemplateInterface with rules ordered like ListRulesByAgenda; sql.ErrNoRows when missing.
+// Observability: tracer span, logger propagation, span error marking on infra failures.
+func (a *ScheduleAdapter) GetTemplateByID(ctx context.Context, tx *sql.Tx, templateID uint64) (schedulemodel.AvailabilityTemplateInterface, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `SELECT ` + templateColumns + ` FROM availability_templates t WHERE t.id = ? LIMIT 1`
+	row := a.QueryRowContext(ctx, tx, "select", query, templateID)
+
+	var entity scheduleentity.TemplateEntity
+	if err = row.Scan(&entity.ID, &entity.OwnerID, &entity.Name, &entity.IsDefault, &entity.CreatedAt, &entity.UpdatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.schedule.get_template.scan_error", "template_id", templateID, "err", err)
+		return nil, fmt.Errorf("scan availability template: %w", err)
+	}
+
+	rules, err := a.queryTemplateRules(ctx, tx, `r.template_id = ?`, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduleconverters.TemplateEntityToDomain(entity, rules[entity.ID]), nil
+}
+
+// queryTemplateRules loads availability_template_rules matching where, grouped by template_id.
+func (a *ScheduleAdapter) queryTemplateRules(ctx context.Context, tx *sql.Tx, where string, args ...any) (map[uint64][]scheduleentity.RuleEntity, error) {
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `SELECT ` + templateRuleColumns + ` FROM availability_template_rules r
+		INNER JOIN availability_templates t ON t.id = r.template_id
+		WHERE ` + where + ` ORDER BY r.template_id, r.recurrence, r.day_of_week, r.start_minute`
+
+	rows, queryErr := a.QueryContext(ctx, tx, "select", query, args...)
+	if queryErr != nil {
+		utils.SetSpanError(ctx, queryErr)
+		logger.Error("mysql.schedule.template_rules.query_error", "err", queryErr)
+		return nil, fmt.Errorf("query availability template rules: %w", queryErr)
+	}
+	defer rows.Close()
+
+	results := make(map[uint64][]scheduleentity.RuleEntity)
+	for rows.Next() {
+		var templateID uint64
+		var ruleEntity scheduleentity.RuleEntity
+		if scanErr := rows.Scan(&templateID, &ruleEntity.ID, &ruleEntity.DayOfWeek, &ruleEntity.StartMinute, &ruleEntity.EndMinute, &ruleEntity.RuleType, &ruleEntity.IsActive, &ruleEntity.Recurrence, &ruleEntity.DayOfMonth, &ruleEntity.WeekOfMonth, &ruleEntity.RuleDate, &ruleEntity.ValidFrom, &ruleEntity.ValidUntil); scanErr != nil {
+			utils.SetSpanError(ctx, scanErr)
+			logger.Error("mysql.schedule.template_rules.scan_error", "err", scanErr)
+			return nil, fmt.Errorf("scan availability template rule: %w", scanErr)
+		}
+		results[templateID] = append(results[templateID], ruleEntity)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		utils.SetSpanError(ctx, rowsErr)
+		logger.Error("mysql.schedule.template_rules.rows_error", "err", rowsErr)
+		return nil, fmt.Errorf("iterate availability template rules: %w", rowsErr)
+	}
+
+	return results, nil
+}
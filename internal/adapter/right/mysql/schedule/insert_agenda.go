@@ -33,8 +33,8 @@ func (a *ScheduleAdapter) InsertAgenda(ctx context.Context, tx *sql.Tx, agenda s
 
 	entity := scheduleconverters.AgendaDomainToEntity(agenda)
 
-	query := `INSERT INTO listing_agendas (listing_identity_id, owner_id, timezone) VALUES (?, ?, ?)`
-	result, execErr := a.ExecContext(ctx, tx, "insert", query, entity.ListingIdentityID, entity.OwnerID, entity.Timezone)
+	query := `INSERT INTO listing_agendas (listing_identity_id, owner_id, timezone, template_id, template_overridden) VALUES (?, ?, ?, ?, ?)`
+	result, execErr := a.ExecContext(ctx, tx, "insert", query, entity.ListingIdentityID, entity.OwnerID, entity.Timezone, entity.TemplateID, entity.TemplateOverridden)
 	if execErr != nil {
 		utils.SetSpanError(ctx, execErr)
 		logger.Error("mysql.schedule.insert_agenda.exec_error", "listing_identity_id", entity.ListingIdentityID, "err", execErr)
@@ -5,7 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 
-	scheduleentity "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/schedule/entities"
+	scheduleconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/schedule/converters"
 	schedulemodel "github.com/projeto-toq/toq_server/internal/core/model/schedule_model"
 	"github.com/projeto-toq/toq_server/internal/core/utils"
 )
@@ -33,7 +33,7 @@ func (a *ScheduleAdapter) InsertRules(ctx context.Context, tx *sql.Tx, rules []s
 	ctx = utils.ContextWithLogger(ctx)
 	logger := utils.LoggerFromContext(ctx)
 
-	query := `INSERT INTO listing_agenda_rules (agenda_id, day_of_week, start_minute, end_minute, rule_type, is_active) VALUES (?, ?, ?, ?, ?, ?)`
+	query := `INSERT INTO listing_agenda_rules (agenda_id, day_of_week, start_minute, end_minute, rule_type, is_active, recurrence, day_of_month, week_of_month, rule_date, valid_from, valid_until) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 	stmt, cleanup, prepareErr := a.PrepareContext(ctx, tx, "insert", query)
 	if prepareErr != nil {
 		utils.SetSpanError(ctx, prepareErr)
@@ -43,15 +43,10 @@ func (a *ScheduleAdapter) InsertRules(ctx context.Context, tx *sql.Tx, rules []s
 	defer cleanup()
 
 	for _, rule := range rules {
-		record := scheduleentity.RuleEntity{
-			AgendaID:    rule.AgendaID(),
-			DayOfWeek:   uint8(rule.DayOfWeek()),
-			StartMinute: rule.StartMinutes(),
-			EndMinute:   rule.EndMinutes(),
-			RuleType:    string(rule.RuleType()),
-			IsActive:    rule.IsActive(),
-		}
-		result, execErr := stmt.ExecContext(ctx, record.AgendaID, record.DayOfWeek, record.StartMinute, record.EndMinute, record.RuleType, record.IsActive)
+		record := scheduleconverters.RuleDomainToEntity(rule)
+		result, execErr := stmt.ExecContext(ctx, record.AgendaID, record.DayOfWeek, record.StartMinute, record.EndMinute, record.RuleType, record.IsActive,
+			record.Recurrence, record.DayOfMonth, record.WeekOfMonth,
+			scheduleconverters.RuleDateString(record.RuleDate), scheduleconverters.RuleDateString(record.ValidFrom), scheduleconverters.RuleDateString(record.ValidUntil))
 		if execErr != nil {
 			utils.SetSpanError(ctx, execErr)
 			logger.Error("mysql.schedule.insert_rules.exec_error", "agenda_id", record.AgendaID, "err", execErr)
@@ -0,0 +1,53 @@
+package mysqlscheduleadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	scheduleconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/schedule/converters"
+	schedulemodel "github.com/projeto-toq/toq_server/internal/core/model/schedule_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// InsertTemplate persists a new availability template header and returns the generated ID.
+//
+// Parameters:
+//   - ctx: request-scoped context used for tracing and logging.
+//   - tx: active transaction required to keep atomicity with ReplaceTemplateRules.
+//   - template: domain template with ownerID, name, default flag and timestamps populated; rules are not written here.
+//
+// Returns:
+//   - uint64: generated primary key set back on the domain object.
+//   - error: infrastructure errors bubbled, including duplicate key errors for (owner_id, name).
+//
+// Observability: initializes tracer, propagates logger, marks span on infra errors, and logs failures with minimal context.
+func (a *ScheduleAdapter) InsertTemplate(ctx context.Context, tx *sql.Tx, template schedulemodel.AvailabilityTemplateInterface) (uint64, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	entity := scheduleconverters.TemplateDomainToEntity(template)
+
+	query := `INSERT INTO availability_templates (owner_id, name, is_default, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`
+	result, execErr := a.ExecContext(ctx, tx, "insert", query, entity.OwnerID, entity.Name, entity.IsDefault, entity.CreatedAt, entity.UpdatedAt)
+	if execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.schedule.insert_template.exec_error", "owner_id", entity.OwnerID, "err", execErr)
+		return 0, fmt.Errorf("insert availability template: %w", execErr)
+	}
+
+	id, lastIDErr := result.LastInsertId()
+	if lastIDErr != nil {
+		utils.SetSpanError(ctx, lastIDErr)
+		logger.Error("mysql.schedule.insert_template.last_id_error", "owner_id", entity.OwnerID, "err", lastIDErr)
+		return 0, fmt.Errorf("availability template last insert id: %w", lastIDErr)
+	}
+
+	template.SetID(uint64(id))
+	return uint64(id), nil
+}
@@ -0,0 +1,59 @@
+package mysqlscheduleadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	scheduleconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/schedule/converters"
+	scheduleentity "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/schedule/entities"
+	schedulemodel "github.com/projeto-toq/toq_server/internal/core/model/schedule_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ListAgendasByTemplate lists the agendas linked to an availability template, overridden ones included.
+//
+// Parameters:
+//   - ctx: request-scoped context for tracing/logging.
+//   - tx: transaction used by the template update so propagation sees a consistent set of agendas.
+//   - templateID: availability_templates.id referenced by listing_agendas.template_id.
+//
+// Returns: slice of AgendaInterface ordered by listing (empty when none) or infrastructure errors.
+// Observability: tracer span, logger propagation, span error marking on infra failures.
+func (a *ScheduleAdapter) ListAgendasByTemplate(ctx context.Context, tx *sql.Tx, templateID uint64) ([]schedulemodel.AgendaInterface, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `SELECT id, listing_identity_id, owner_id, timezone, template_id, template_overridden FROM listing_agendas WHERE template_id = ? ORDER BY listing_identity_id`
+	rows, queryErr := a.QueryContext(ctx, tx, "select", query, templateID)
+	if queryErr != nil {
+		utils.SetSpanError(ctx, queryErr)
+		logger.Error("mysql.schedule.list_agendas_by_template.query_error", "template_id", templateID, "err", queryErr)
+		return nil, fmt.Errorf("query agendas by template: %w", queryErr)
+	}
+	defer rows.Close()
+
+	var results []schedulemodel.AgendaInterface
+	for rows.Next() {
+		var entity scheduleentity.AgendaEntity
+		if scanErr := rows.Scan(&entity.ID, &entity.ListingIdentityID, &entity.OwnerID, &entity.Timezone, &entity.TemplateID, &entity.TemplateOverridden); scanErr != nil {
+			utils.SetSpanError(ctx, scanErr)
+			logger.Error("mysql.schedule.list_agendas_by_template.scan_error", "template_id", templateID, "err", scanErr)
+			return nil, fmt.Errorf("scan agenda by template: %w", scanErr)
+		}
+		results = append(results, scheduleconverters.AgendaEntityToDomain(entity))
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		utils.SetSpanError(ctx, rowsErr)
+		logger.Error("mysql.schedule.list_agendas_by_template.rows_error", "template_id", templateID, "err", rowsErr)
+		return nil, fmt.Errorf("iterate agendas by template: %w", rowsErr)
+	}
+
+	return results, nil
+}
@@ -13,7 +13,7 @@ import (
 	"github.com/projeto-toq/toq_server/internal/core/utils"
 )
 
-// ListBlockRules lists blocking rules filtered by owner, listing, and optional weekdays.
+// ListBlockRules lists blocking rules and monthly/date FREE exceptions filtered by owner, listing, and optional weekdays.
 //
 // Parameters:
 //   - ctx: request-scoped context for tracing/logging.
@@ -31,13 +31,16 @@ func (a *ScheduleAdapter) ListBlockRules(ctx context.Context, tx *sql.Tx, filter
 	ctx = utils.ContextWithLogger(ctx)
 	logger := utils.LoggerFromContext(ctx)
 
-	conditions := []string{"a.owner_id = ?", "a.listing_identity_id = ?", "r.rule_type = ?"}
-	args := []any{filter.OwnerID, filter.ListingIdentityID, schedulemodel.RuleTypeBlock}
+	// FREE rules only matter as monthly/date exceptions, so they are listed alongside the blocks they override.
+	conditions := []string{"a.owner_id = ?", "a.listing_identity_id = ?", "(r.rule_type = ? OR r.recurrence <> ?)"}
+	args := []any{filter.OwnerID, filter.ListingIdentityID, schedulemodel.RuleTypeBlock, schedulemodel.RuleRecurrenceWeekly}
 
 	if len(filter.Weekdays) > 0 {
 		placeholders, weekdayArgs := buildWeekdayConditions(filter.Weekdays)
-		conditions = append(conditions, fmt.Sprintf("r.day_of_week IN (%s)", placeholders))
+		// MONTHLY_DAY rules carry no weekday and never match a weekday filter.
+		conditions = append(conditions, fmt.Sprintf("r.day_of_week IN (%s)", placeholders), "r.recurrence <> ?")
 		args = append(args, weekdayArgs...)
+		args = append(args, schedulemodel.RuleRecurrenceMonthlyDay)
 	}
 
 	query := fmt.Sprintf(`
@@ -47,11 +50,17 @@ func (a *ScheduleAdapter) ListBlockRules(ctx context.Context, tx *sql.Tx, filter
                r.start_minute,
                r.end_minute,
                r.rule_type,
-               r.is_active
+               r.is_active,
+               r.recurrence,
+               r.day_of_month,
+               r.week_of_month,
+               r.rule_date,
+               r.valid_from,
+               r.valid_until
         FROM listing_agenda_rules r
         INNER JOIN listing_agendas a ON a.id = r.agenda_id
         WHERE %s
-        ORDER BY r.day_of_week, r.start_minute
+        ORDER BY r.recurrence, r.day_of_week, r.start_minute
     `, strings.Join(conditions, " AND "))
 
 	rows, queryErr := a.QueryContext(ctx, tx, "select", query, args...)
@@ -65,7 +74,7 @@ func (a *ScheduleAdapter) ListBlockRules(ctx context.Context, tx *sql.Tx, filter
 	rules := make([]schedulemodel.AgendaRuleInterface, 0)
 	for rows.Next() {
 		var ruleEntity scheduleentity.RuleEntity
-		if scanErr := rows.Scan(&ruleEntity.ID, &ruleEntity.AgendaID, &ruleEntity.DayOfWeek, &ruleEntity.StartMinute, &ruleEntity.EndMinute, &ruleEntity.RuleType, &ruleEntity.IsActive); scanErr != nil {
+		if scanErr := rows.Scan(&ruleEntity.ID, &ruleEntity.AgendaID, &ruleEntity.DayOfWeek, &ruleEntity.StartMinute, &ruleEntity.EndMinute, &ruleEntity.RuleType, &ruleEntity.IsActive, &ruleEntity.Recurrence, &ruleEntity.DayOfMonth, &ruleEntity.WeekOfMonth, &ruleEntity.RuleDate, &ruleEntity.ValidFrom, &ruleEntity.ValidUntil); scanErr != nil {
 			utils.SetSpanError(ctx, scanErr)
 			logger.Error("mysql.schedule.list_block_rules.scan_error", "listing_identity_id", filter.ListingIdentityID, "err", scanErr)
 			return nil, fmt.Errorf("scan block rule: %w", scanErr)
@@ -0,0 +1,85 @@
+package mysqlscheduleadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	scheduleconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/schedule/converters"
+	scheduleentity "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/schedule/entities"
+	schedulemodel "github.com/projeto-toq/toq_server/internal/core/model/schedule_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ListOwnerEntriesBetween returns the entries of every agenda owned by ownerID overlapping [from, to).
+//
+// Parameters:
+//   - ctx: request-scoped context for tracing/logging.
+//   - tx: optional transaction for consistent reads.
+//   - ownerID: listing_agendas.owner_id.
+//   - from/to: time window boundaries (inclusive start, exclusive end).
+//
+// Returns: entries ordered by start with their listing and agenda timezone (empty when none) or infrastructure errors.
+// Observability: tracer span, logger propagation, span error marking on infra failures.
+func (a *ScheduleAdapter) ListOwnerEntriesBetween(ctx context.Context, tx *sql.Tx, ownerID int64, from, to time.Time) ([]schedulemodel.OwnerAgendaEntry, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `
+		SELECT e.id, e.agenda_id, e.entry_type, e.starts_at, e.ends_at, e.blocking, e.reason, e.visit_id, e.photo_booking_id,
+			a.listing_identity_id, a.timezone
+		FROM listing_agenda_entries e
+		INNER JOIN listing_agendas a ON a.id = e.agenda_id
+		WHERE a.owner_id = ? AND e.ends_at > ? AND e.starts_at < ?
+		ORDER BY e.starts_at, e.id
+	`
+
+	rows, queryErr := a.QueryContext(ctx, tx, "select", query, ownerID, from, to)
+	if queryErr != nil {
+		utils.SetSpanError(ctx, queryErr)
+		logger.Error("mysql.schedule.list_owner_entries_between.query_error", "owner_id", ownerID, "err", queryErr)
+		return nil, fmt.Errorf("query owner agenda entries between: %w", queryErr)
+	}
+	defer rows.Close()
+
+	entries := make([]schedulemodel.OwnerAgendaEntry, 0)
+	for rows.Next() {
+		var (
+			entryEntity scheduleentity.EntryEntity
+			item        schedulemodel.OwnerAgendaEntry
+		)
+		if scanErr := rows.Scan(
+			&entryEntity.ID,
+			&entryEntity.AgendaID,
+			&entryEntity.EntryType,
+			&entryEntity.StartsAt,
+			&entryEntity.EndsAt,
+			&entryEntity.Blocking,
+			&entryEntity.Reason,
+			&entryEntity.VisitID,
+			&entryEntity.PhotoBookingID,
+			&item.ListingIdentityID,
+			&item.Timezone,
+		); scanErr != nil {
+			utils.SetSpanError(ctx, scanErr)
+			logger.Error("mysql.schedule.list_owner_entries_between.scan_error", "owner_id", ownerID, "err", scanErr)
+			return nil, fmt.Errorf("scan owner agenda entry between: %w", scanErr)
+		}
+		item.Entry = scheduleconverters.EntryEntityToDomain(entryEntity)
+		entries = append(entries, item)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		utils.SetSpanError(ctx, rowsErr)
+		logger.Error("mysql.schedule.list_owner_entries_between.rows_error", "owner_id", ownerID, "err", rowsErr)
+		return nil, fmt.Errorf("iterate owner agenda entries between: %w", rowsErr)
+	}
+
+	return entries, nil
+}
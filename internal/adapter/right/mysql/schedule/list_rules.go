@@ -29,7 +29,7 @@ func (a *ScheduleAdapter) ListRulesByAgenda(ctx context.Context, tx *sql.Tx, age
 	ctx = utils.ContextWithLogger(ctx)
 	logger := utils.LoggerFromContext(ctx)
 
-	query := `SELECT id, agenda_id, day_of_week, start_minute, end_minute, rule_type, is_active FROM listing_agenda_rules WHERE agenda_id = ? ORDER BY day_of_week, start_minute`
+	query := `SELECT id, agenda_id, day_of_week, start_minute, end_minute, rule_type, is_active, recurrence, day_of_month, week_of_month, rule_date, valid_from, valid_until FROM listing_agenda_rules WHERE agenda_id = ? ORDER BY recurrence, day_of_week, start_minute`
 
 	rows, queryErr := a.QueryContext(ctx, tx, "select", query, agendaID)
 	if queryErr != nil {
@@ -42,7 +42,7 @@ func (a *ScheduleAdapter) ListRulesByAgenda(ctx context.Context, tx *sql.Tx, age
 	var results []schedulemodel.AgendaRuleInterface
 	for rows.Next() {
 		var ruleEntity scheduleentity.RuleEntity
-		if scanErr := rows.Scan(&ruleEntity.ID, &ruleEntity.AgendaID, &ruleEntity.DayOfWeek, &ruleEntity.StartMinute, &ruleEntity.EndMinute, &ruleEntity.RuleType, &ruleEntity.IsActive); scanErr != nil {
+		if scanErr := rows.Scan(&ruleEntity.ID, &ruleEntity.AgendaID, &ruleEntity.DayOfWeek, &ruleEntity.StartMinute, &ruleEntity.EndMinute, &ruleEntity.RuleType, &ruleEntity.IsActive, &ruleEntity.Recurrence, &ruleEntity.DayOfMonth, &ruleEntity.WeekOfMonth, &ruleEntity.RuleDate, &ruleEntity.ValidFrom, &ruleEntity.ValidUntil); scanErr != nil {
 			utils.SetSpanError(ctx, scanErr)
 			logger.Error("mysql.schedule.list_rules.scan_error", "agenda_id", agendaID, "err", scanErr)
 			return nil, fmt.Errorf("scan agenda rule: %w", scanErr)
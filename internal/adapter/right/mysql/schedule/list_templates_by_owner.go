@@ -0,0 +1,72 @@
+package mysqlscheduleadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	scheduleconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/schedule/converters"
+	scheduleentity "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/schedule/entities"
+	schedulemodel "github.com/projeto-toq/toq_server/internal/core/model/schedule_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ListTemplatesByOwner lists the availability templates of an owner, with rules, ordered by name.
+//
+// Parameters:
+//   - ctx: request-scoped context for tracing/logging.
+//   - tx: optional transaction; use non-nil to keep consistency with sibling reads.
+//   - ownerID: users.id owning the templates.
+//
+// Returns: slice of AvailabilityTemplateInterface (empty when none) or infrastructure errors; sql.ErrNoRows is not used here.
+// Observability: tracer span, logger propagation, span error marking on infra failures.
+func (a *ScheduleAdapter) ListTemplatesByOwner(ctx context.Context, tx *sql.Tx, ownerID int64) ([]schedulemodel.AvailabilityTemplateInterface, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `SELECT ` + templateColumns + ` FROM availability_templates t WHERE t.owner_id = ? ORDER BY t.name, t.id`
+	rows, queryErr := a.QueryContext(ctx, tx, "select", query, ownerID)
+	if queryErr != nil {
+		utils.SetSpanError(ctx, queryErr)
+		logger.Error("mysql.schedule.list_templates.query_error", "owner_id", ownerID, "err", queryErr)
+		return nil, fmt.Errorf("query availability templates: %w", queryErr)
+	}
+	defer rows.Close()
+
+	var entities []scheduleentity.TemplateEntity
+	for rows.Next() {
+		var entity scheduleentity.TemplateEntity
+		if scanErr := rows.Scan(&entity.ID, &entity.OwnerID, &entity.Name, &entity.IsDefault, &entity.CreatedAt, &entity.UpdatedAt); scanErr != nil {
+			utils.SetSpanError(ctx, scanErr)
+			logger.Error("mysql.schedule.list_templates.scan_error", "owner_id", ownerID, "err", scanErr)
+			return nil, fmt.Errorf("scan availability template: %w", scanErr)
+		}
+		entities = append(entities, entity)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		utils.SetSpanError(ctx, rowsErr)
+		logger.Error("mysql.schedule.list_templates.rows_error", "owner_id", ownerID, "err", rowsErr)
+		return nil, fmt.Errorf("iterate availability templates: %w", rowsErr)
+	}
+
+	if len(entities) == 0 {
+		return nil, nil
+	}
+
+	rules, err := a.queryTemplateRules(ctx, tx, `t.owner_id = ?`, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]schedulemodel.AvailabilityTemplateInterface, 0, len(entities))
+	for _, entity := range entities {
+		results = append(results, scheduleconverters.TemplateEntityToDomain(entity, rules[entity.ID]))
+	}
+	return results, nil
+}
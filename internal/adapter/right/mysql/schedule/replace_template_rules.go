@@ -0,0 +1,72 @@
+package mysqlscheduleadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	scheduleconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/schedule/converters"
+	schedulemodel "github.com/projeto-toq/toq_server/internal/core/model/schedule_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ReplaceTemplateRules deletes every rule of a template and inserts the given ones, setting generated IDs back.
+//
+// Parameters:
+//   - ctx: request-scoped context with tracing/logging metadata.
+//   - tx: required transaction so the template never becomes visible half-replaced.
+//   - templateID: owning availability_templates.id.
+//   - rules: domain rules to insert; AgendaID is ignored. An empty slice leaves the template without rules.
+//
+// Returns: first infrastructure error encountered; sql.ErrNoRows is not used here.
+// Observability: span is created, logger propagated, span errors marked on infra failures.
+func (a *ScheduleAdapter) ReplaceTemplateRules(ctx context.Context, tx *sql.Tx, templateID uint64, rules []schedulemodel.AgendaRuleInterface) error {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	if _, execErr := a.ExecContext(ctx, tx, "delete", `DELETE FROM availability_template_rules WHERE template_id = ?`, templateID); execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.schedule.replace_template_rules.delete_error", "template_id", templateID, "err", execErr)
+		return fmt.Errorf("delete availability template rules: %w", execErr)
+	}
+
+	if len(rules) == 0 {
+		return nil
+	}
+
+	query := `INSERT INTO availability_template_rules (template_id, day_of_week, start_minute, end_minute, rule_type, is_active, recurrence, day_of_month, week_of_month, rule_date, valid_from, valid_until) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	stmt, cleanup, prepareErr := a.PrepareContext(ctx, tx, "insert", query)
+	if prepareErr != nil {
+		utils.SetSpanError(ctx, prepareErr)
+		logger.Error("mysql.schedule.replace_template_rules.prepare_error", "template_id", templateID, "err", prepareErr)
+		return fmt.Errorf("prepare insert availability template rules: %w", prepareErr)
+	}
+	defer cleanup()
+
+	for _, rule := range rules {
+		record := scheduleconverters.RuleDomainToEntity(rule)
+		result, execErr := stmt.ExecContext(ctx, templateID, record.DayOfWeek, record.StartMinute, record.EndMinute, record.RuleType, record.IsActive,
+			record.Recurrence, record.DayOfMonth, record.WeekOfMonth,
+			scheduleconverters.RuleDateString(record.RuleDate), scheduleconverters.RuleDateString(record.ValidFrom), scheduleconverters.RuleDateString(record.ValidUntil))
+		if execErr != nil {
+			utils.SetSpanError(ctx, execErr)
+			logger.Error("mysql.schedule.replace_template_rules.exec_error", "template_id", templateID, "err", execErr)
+			return fmt.Errorf("exec insert availability template rule: %w", execErr)
+		}
+		lastID, idErr := result.LastInsertId()
+		if idErr != nil {
+			utils.SetSpanError(ctx, idErr)
+			logger.Error("mysql.schedule.replace_template_rules.last_id_error", "template_id", templateID, "err", idErr)
+			return fmt.Errorf("retrieve availability template rule id: %w", idErr)
+		}
+		rule.SetID(uint64(lastID))
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package mysqlscheduleadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	scheduleconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/schedule/converters"
+	schedulemodel "github.com/projeto-toq/toq_server/internal/core/model/schedule_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// UpdateAgendaTemplate persists the template link (template_id, template_overridden) of an agenda.
+//
+// Parameters:
+//   - ctx: request-scoped context with tracing/logging.
+//   - tx: required transaction, shared with the rule writes that motivated the change.
+//   - agenda: domain agenda with ID and template link set.
+//
+// Returns: driver errors for execution failures. Rewriting identical values is not an error, so sql.ErrNoRows is not used.
+// Observability: tracer span, logger propagation, span error marking on infra failures.
+func (a *ScheduleAdapter) UpdateAgendaTemplate(ctx context.Context, tx *sql.Tx, agenda schedulemodel.AgendaInterface) error {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	entity := scheduleconverters.AgendaDomainToEntity(agenda)
+
+	query := `UPDATE listing_agendas SET template_id = ?, template_overridden = ? WHERE id = ?`
+	if _, execErr := a.ExecContext(ctx, tx, "update", query, entity.TemplateID, entity.TemplateOverridden, entity.ID); execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.schedule.update_agenda_template.exec_error", "agenda_id", entity.ID, "err", execErr)
+		return fmt.Errorf("update agenda template link: %w", execErr)
+	}
+
+	return nil
+}
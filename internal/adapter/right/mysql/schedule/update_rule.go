@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 
+	scheduleconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/schedule/converters"
 	schedulemodel "github.com/projeto-toq/toq_server/internal/core/model/schedule_model"
 	"github.com/projeto-toq/toq_server/internal/core/utils"
 )
@@ -27,9 +28,13 @@ func (a *ScheduleAdapter) UpdateRule(ctx context.Context, tx *sql.Tx, rule sched
 	ctx = utils.ContextWithLogger(ctx)
 	logger := utils.LoggerFromContext(ctx)
 
-	query := `UPDATE listing_agenda_rules SET agenda_id = ?, day_of_week = ?, start_minute = ?, end_minute = ?, rule_type = ?, is_active = ? WHERE id = ?`
+	query := `UPDATE listing_agenda_rules SET agenda_id = ?, day_of_week = ?, start_minute = ?, end_minute = ?, rule_type = ?, is_active = ?,
+		recurrence = ?, day_of_month = ?, week_of_month = ?, rule_date = ?, valid_from = ?, valid_until = ? WHERE id = ?`
 
-	result, execErr := a.ExecContext(ctx, tx, "update", query, rule.AgendaID(), rule.DayOfWeek(), rule.StartMinutes(), rule.EndMinutes(), rule.RuleType(), rule.IsActive(), rule.ID())
+	record := scheduleconverters.RuleDomainToEntity(rule)
+	result, execErr := a.ExecContext(ctx, tx, "update", query, record.AgendaID, record.DayOfWeek, record.StartMinute, record.EndMinute, record.RuleType, record.IsActive,
+		record.Recurrence, record.DayOfMonth, record.WeekOfMonth,
+		scheduleconverters.RuleDateString(record.RuleDate), scheduleconverters.RuleDateString(record.ValidFrom), scheduleconverters.RuleDateString(record.ValidUntil), record.ID)
 	if execErr != nil {
 		utils.SetSpanError(ctx, execErr)
 		logger.Error("mysql.schedule.update_rule.exec_error", "rule_id", rule.ID(), "err", execErr)
@@ -0,0 +1,53 @@
+package mysqlscheduleadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	scheduleconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/schedule/converters"
+	schedulemodel "github.com/projeto-toq/toq_server/internal/core/model/schedule_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// UpdateTemplate updates the name, default flag and updated_at of an availability template.
+//
+// Parameters:
+//   - ctx: request-scoped context with tracing/logging.
+//   - tx: required transaction to keep write atomicity with ReplaceTemplateRules.
+//   - template: domain template carrying new values; template.ID must be set.
+//
+// Returns: sql.ErrNoRows when the template no longer exists; driver errors for exec/rows affected failures.
+// Observability: tracer span, logger propagation, span error marking on infra failures.
+func (a *ScheduleAdapter) UpdateTemplate(ctx context.Context, tx *sql.Tx, template schedulemodel.AvailabilityTemplateInterface) error {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	entity := scheduleconverters.TemplateDomainToEntity(template)
+
+	query := `UPDATE availability_templates SET name = ?, is_default = ?, updated_at = ? WHERE id = ?`
+	result, execErr := a.ExecContext(ctx, tx, "update", query, entity.Name, entity.IsDefault, entity.UpdatedAt, entity.ID)
+	if execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.schedule.update_template.exec_error", "template_id", entity.ID, "err", execErr)
+		return fmt.Errorf("update availability template: %w", execErr)
+	}
+
+	affected, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		utils.SetSpanError(ctx, rowsErr)
+		logger.Error("mysql.schedule.update_template.rows_error", "template_id", entity.ID, "err", rowsErr)
+		return fmt.Errorf("availability template rows affected: %w", rowsErr)
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,247 @@
+package mysqluseradapter
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	userrepository "github.com/projeto-toq/toq_server/internal/core/port/right/repository/user_repository"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// AnonymizeUser replaces the user's personal data with irreversible tokens (LGPD)
+//
+// The users row is kept so that listings, visits, proposals and audit events retain their
+// foreign keys; only identifying columns are overwritten. Coarse attributes used by aggregate
+// metrics (city, state, creci_state, birth year, created_at) are preserved.
+//
+// Tables touched:
+//   - users: name, nickname, CPF, email, phone, street address, CRECI number/validity, password
+//   - temp_user_validations / temp_wrong_signin: pending email/phone changes and signin counters removed
+//   - agency_invites: invites sent to the original phone removed
+//   - user_notifications: in-app inbox removed (messages may quote names and addresses)
+//   - sessions: client IP, user agent and device id cleared (rows are kept, already revoked)
+//   - audit_events: actor network fingerprint cleared and PII keys removed from metadata at any depth
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (REQUIRED - all statements must commit atomically)
+//   - anon: Replacement values generated by the service
+//
+// Returns:
+//   - affected: Rows affected per table (used in the deletion certificate)
+//   - error: sql.ErrNoRows if the user does not exist, or database errors
+func (ua *UserAdapter) AnonymizeUser(ctx context.Context, tx *sql.Tx, anon userrepository.UserAnonymization) (map[string]int64, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	statements := []struct {
+		table string
+		kind  string
+		query string
+		args  []any
+	}{
+		{
+			table: "users",
+			kind:  "update",
+			query: `UPDATE users
+				SET full_name = ?, nick_name = ?, national_id = ?, email = ?, phone_number = ?,
+					creci_number = NULL, creci_validity = NULL, born_at = ?,
+					zip_code = '00000000', street = '', number = '', complement = NULL, neighborhood = '',
+					password = ?, opt_status = 0
+				WHERE id = ?`,
+			args: []any{anon.FullName, anon.NickName, anon.NationalID, anon.Email, anon.PhoneNumber,
+				anon.BornAt, anon.Password, anon.UserID},
+		},
+		{
+			table: "temp_user_validations",
+			kind:  "delete",
+			query: `DELETE FROM temp_user_validations WHERE user_id = ?`,
+			args:  []any{anon.UserID},
+		},
+		{
+			table: "temp_wrong_signin",
+			kind:  "delete",
+			query: `DELETE FROM temp_wrong_signin WHERE user_id = ?`,
+			args:  []any{anon.UserID},
+		},
+		{
+			table: "agency_invites",
+			kind:  "delete",
+			query: `DELETE FROM agency_invites WHERE phone_number = ?`,
+			args:  []any{anon.OriginalPhoneNumber},
+		},
+		{
+			table: "user_notifications",
+			kind:  "delete",
+			query: `DELETE FROM user_notifications WHERE user_id = ?`,
+			args:  []any{anon.UserID},
+		},
+		{
+			table: "user_calendar_feeds",
+			kind:  "delete",
+			query: `DELETE FROM user_calendar_feeds WHERE user_id = ?`,
+			args:  []any{anon.UserID},
+		},
+		{
+			table: "sessions",
+			kind:  "update",
+			query: `UPDATE sessions SET ip = NULL, user_agent = NULL, device_id = NULL WHERE user_id = ?`,
+			args:  []any{anon.UserID},
+		},
+		{
+			table: "audit_events",
+			kind:  "update",
+			query: `UPDATE audit_events
+				SET actor_ip = NULL, actor_user_agent = NULL, actor_device_id = NULL
+				WHERE actor_id = ? OR (target_type = 'users' AND target_id = ?)`,
+			args: []any{anon.UserID, anon.UserID},
+		},
+	}
+
+	affected := make(map[string]int64, len(statements))
+	for _, stmt := range statements {
+		result, execErr := ua.ExecContext(ctx, tx, stmt.kind, stmt.query, stmt.args...)
+		if execErr != nil {
+			utils.SetSpanError(ctx, execErr)
+			logger.Error("mysql.user.anonymize_user.exec_error", "user_id", anon.UserID, "table", stmt.table, "error", execErr)
+			return nil, fmt.Errorf("anonymize %s: %w", stmt.table, execErr)
+		}
+
+		rows, rowsErr := result.RowsAffected()
+		if rowsErr != nil {
+			utils.SetSpanError(ctx, rowsErr)
+			logger.Error("mysql.user.anonymize_user.rows_affected_error", "user_id", anon.UserID, "table", stmt.table, "error", rowsErr)
+			return nil, fmt.Errorf("anonymize %s rows affected: %w", stmt.table, rowsErr)
+		}
+		if stmt.table == "users" && rows == 0 {
+			return nil, sql.ErrNoRows
+		}
+		affected[stmt.table] = rows
+	}
+
+	scrubbed, err := ua.scrubAuditMetadata(ctx, tx, anon.UserID)
+	if err != nil {
+		return nil, err
+	}
+	affected["audit_events_metadata"] = scrubbed
+
+	logger.Debug("mysql.user.anonymize_user.success", "user_id", anon.UserID, "affected", affected)
+	return affected, nil
+}
+
+// auditPIIKeys are the metadata keys holding personal data; they are removed wherever they appear,
+// including inside nested change sets (changes, previous_state, new_state).
+var auditPIIKeys = map[string]bool{
+	"cpf": true, "national_id": true, "nationalId": true,
+	"email": true, "new_email": true, "newEmail": true,
+	"phone": true, "phone_number": true, "phoneNumber": true, "new_phone": true, "newPhone": true,
+	"full_name": true, "fullName": true, "nick_name": true, "nickName": true, "realtor_name": true,
+	"born_at": true, "bornAt": true, "creci_number": true, "creciNumber": true,
+	"zip_code": true, "zipCode": true, "street": true, "complement": true, "neighborhood": true,
+	"device_id": true, "deviceId": true, "ip": true, "user_agent": true, "userAgent": true,
+}
+
+// scrubAuditMetadata removes auditPIIKeys at any depth of the metadata of the user's audit events.
+// JSON_REMOVE only accepts literal paths, so nested documents are rewritten here.
+func (ua *UserAdapter) scrubAuditMetadata(ctx context.Context, tx *sql.Tx, userID int64) (int64, error) {
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `SELECT id, metadata FROM audit_events
+		WHERE (actor_id = ? OR (target_type = 'users' AND target_id = ?)) AND metadata IS NOT NULL`
+	rows, queryErr := ua.QueryContext(ctx, tx, "select", query, userID, userID)
+	if queryErr != nil {
+		utils.SetSpanError(ctx, queryErr)
+		logger.Error("mysql.user.anonymize_user.audit_query_error", "user_id", userID, "error", queryErr)
+		return 0, fmt.Errorf("query audit metadata: %w", queryErr)
+	}
+	defer rows.Close()
+
+	updates := make(map[int64][]byte)
+	for rows.Next() {
+		var (
+			id  int64
+			raw []byte
+		)
+		if scanErr := rows.Scan(&id, &raw); scanErr != nil {
+			utils.SetSpanError(ctx, scanErr)
+			logger.Error("mysql.user.anonymize_user.audit_scan_error", "user_id", userID, "error", scanErr)
+			return 0, fmt.Errorf("scan audit metadata: %w", scanErr)
+		}
+
+		var document any
+		if err := json.Unmarshal(raw, &document); err != nil {
+			// Not a JSON document we can walk: drop it rather than keep unreviewed data.
+			updates[id] = nil
+			continue
+		}
+		if !scrubPII(document) {
+			continue
+		}
+		cleaned, err := json.Marshal(document)
+		if err != nil {
+			return 0, fmt.Errorf("encode audit metadata: %w", err)
+		}
+		updates[id] = cleaned
+	}
+	if rowsErr := rows.Err(); rowsErr != nil {
+		utils.SetSpanError(ctx, rowsErr)
+		logger.Error("mysql.user.anonymize_user.audit_rows_error", "user_id", userID, "error", rowsErr)
+		return 0, fmt.Errorf("iterate audit metadata: %w", rowsErr)
+	}
+	rows.Close()
+
+	for id, metadata := range updates {
+		var value any
+		if metadata != nil {
+			value = string(metadata)
+		}
+		if _, execErr := ua.ExecContext(ctx, tx, "update", `UPDATE audit_events SET metadata = ? WHERE id = ?`, value, id); execErr != nil {
+			utils.SetSpanError(ctx, execErr)
+			logger.Error("mysql.user.anonymize_user.audit_update_error", "user_id", userID, "audit_event_id", id, "error", execErr)
+			return 0, fmt.Errorf("scrub audit metadata %d: %w", id, execErr)
+		}
+	}
+	return int64(len(updates)), nil
+}
+
+// scrubPII deletes auditPIIKeys from every object nested in document and reports whether anything changed.
+func scrubPII(document any) bool {
+	changed := false
+	switch value := document.(type) {
+	case map[string]any:
+		// Field changes are recorded as {"field": "email", "from": ..., "to": ...}.
+		if field, ok := value["field"].(string); ok && auditPIIKeys[field] {
+			for _, key := range []string{"from", "to"} {
+				if _, present := value[key]; present {
+					delete(value, key)
+					changed = true
+				}
+			}
+		}
+		for key, nested := range value {
+			if auditPIIKeys[key] {
+				delete(value, key)
+				changed = true
+				continue
+			}
+			if scrubPII(nested) {
+				changed = true
+			}
+		}
+	case []any:
+		for _, nested := range value {
+			if scrubPII(nested) {
+				changed = true
+			}
+		}
+	}
+	return changed
+}
@@ -0,0 +1,72 @@
+package mysqluseradapter
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestScrubPII(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		input    string
+		expected string
+		changed  bool
+	}{
+		{
+			name:     "top level keys",
+			input:    `{"cpf":"123","email":"a@b.c","reason":"self"}`,
+			expected: `{"reason":"self"}`,
+			changed:  true,
+		},
+		{
+			name:     "nested change set",
+			input:    `{"changes":{"email":{"from":"a@b.c","to":"d@e.f"},"role":{"from":"owner","to":"realtor"}}}`,
+			expected: `{"changes":{"role":{"from":"owner","to":"realtor"}}}`,
+			changed:  true,
+		},
+		{
+			name:     "field change values",
+			input:    `{"field":"phone","from":"+5511999990000","to":"+5511988880000","flow":"confirm"}`,
+			expected: `{"field":"phone","flow":"confirm"}`,
+			changed:  true,
+		},
+		{
+			name:     "objects inside arrays",
+			input:    `{"items":[{"full_name":"Ana","id":1},{"id":2}]}`,
+			expected: `{"items":[{"id":1},{"id":2}]}`,
+			changed:  true,
+		},
+		{
+			name:     "non pii field change is kept",
+			input:    `{"field":"timezone","from":"UTC","to":"America/Sao_Paulo"}`,
+			expected: `{"field":"timezone","from":"UTC","to":"America/Sao_Paulo"}`,
+		},
+		{
+			name:     "field names listed as values are kept",
+			input:    `{"updated_fields":["email","phone"]}`,
+			expected: `{"updated_fields":["email","phone"]}`,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var document any
+			if err := json.Unmarshal([]byte(tt.input), &document); err != nil {
+				t.Fatalf("invalid input %q: %v", tt.input, err)
+			}
+			changed := scrubPII(document)
+			got, err := json.Marshal(document)
+			if err != nil {
+				t.Fatalf("marshal scrubbed document: %v", err)
+			}
+			if string(got) != tt.expected || changed != tt.changed {
+				t.Fatalf("scrubPII(%q) = %q (changed %v), expected %q (changed %v)", tt.input, got, changed, tt.expected, tt.changed)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package userconverters
+
+import (
+	userentity "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/user/entities"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+)
+
+// CalendarFeedEntityToVO converts a user_calendar_feeds row into the domain Value Object
+func CalendarFeedEntityToVO(entity userentity.CalendarFeedEntity) usermodel.CalendarFeed {
+	feed := usermodel.CalendarFeed{
+		UserID:    entity.UserID,
+		TokenHash: entity.TokenHash,
+		CreatedAt: entity.CreatedAt,
+	}
+	if entity.LastAccessedAt.Valid {
+		accessed := entity.LastAccessedAt.Time
+		feed.LastAccessedAt = &accessed
+	}
+	return feed
+}
@@ -0,0 +1,47 @@
+package userconverters
+
+import (
+	"database/sql"
+
+	userentity "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/user/entities"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+)
+
+// DataExportDomainToEntity converts a domain DataExportInterface into a user_data_exports row
+//
+// Empty strings and nil pointers are persisted as NULL.
+func DataExportDomainToEntity(export usermodel.DataExportInterface) *userentity.DataExportEntity {
+	if export == nil {
+		return nil
+	}
+
+	entity := &userentity.DataExportEntity{
+		ID:     export.GetID(),
+		UserID: export.GetUserID(),
+		Status: string(export.GetStatus()),
+		ObjectKey: sql.NullString{
+			String: export.GetObjectKey(),
+			Valid:  export.GetObjectKey() != "",
+		},
+		ErrorMessage: sql.NullString{
+			String: export.GetErrorMessage(),
+			Valid:  export.GetErrorMessage() != "",
+		},
+		Attempts: export.GetAttempts(),
+	}
+
+	if requestedAt := export.GetRequestedAt(); !requestedAt.IsZero() {
+		entity.RequestedAt = sql.NullTime{Time: requestedAt, Valid: true}
+	}
+	if startedAt := export.GetStartedAt(); startedAt != nil {
+		entity.StartedAt = sql.NullTime{Time: *startedAt, Valid: true}
+	}
+	if completedAt := export.GetCompletedAt(); completedAt != nil {
+		entity.CompletedAt = sql.NullTime{Time: *completedAt, Valid: true}
+	}
+	if expiresAt := export.GetExpiresAt(); expiresAt != nil {
+		entity.ExpiresAt = sql.NullTime{Time: *expiresAt, Valid: true}
+	}
+
+	return entity
+}
@@ -0,0 +1,45 @@
+package userconverters
+
+import (
+	userentity "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/user/entities"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+)
+
+// DataExportEntityToDomain converts a user_data_exports row into the domain DataExportInterface
+//
+// NULL columns become empty strings (object_key, error_message) or nil pointers (started_at, completed_at, expires_at).
+func DataExportEntityToDomain(entity *userentity.DataExportEntity) usermodel.DataExportInterface {
+	if entity == nil {
+		return nil
+	}
+
+	export := usermodel.NewDataExport()
+	export.SetID(entity.ID)
+	export.SetUserID(entity.UserID)
+	export.SetStatus(usermodel.DataExportStatus(entity.Status))
+	export.SetAttempts(entity.Attempts)
+
+	if entity.ObjectKey.Valid {
+		export.SetObjectKey(entity.ObjectKey.String)
+	}
+	if entity.ErrorMessage.Valid {
+		export.SetErrorMessage(entity.ErrorMessage.String)
+	}
+	if entity.RequestedAt.Valid {
+		export.SetRequestedAt(entity.RequestedAt.Time)
+	}
+	if entity.StartedAt.Valid {
+		startedAt := entity.StartedAt.Time
+		export.SetStartedAt(&startedAt)
+	}
+	if entity.CompletedAt.Valid {
+		completedAt := entity.CompletedAt.Time
+		export.SetCompletedAt(&completedAt)
+	}
+	if entity.ExpiresAt.Valid {
+		expiresAt := entity.ExpiresAt.Time
+		export.SetExpiresAt(&expiresAt)
+	}
+
+	return export
+}
@@ -0,0 +1,73 @@
+package userconverters
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	userentity "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/user/entities"
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+)
+
+// InboxNotificationEntityToVO converts a user_notifications row into the domain Value Object
+//
+// The data JSON column is decoded into map[string]string; NULL becomes a nil map.
+func InboxNotificationEntityToVO(entity userentity.InboxNotificationEntity) (usermodel.InboxNotification, error) {
+	notification := usermodel.InboxNotification{
+		ID:        entity.ID,
+		UserID:    entity.UserID,
+		Channel:   globalmodel.NotificationChannel(entity.Channel),
+		Category:  globalmodel.NotificationCategory(entity.Category),
+		Title:     entity.Title,
+		Body:      entity.Body,
+		CreatedAt: entity.CreatedAt,
+	}
+
+	if entity.DeepLink.Valid {
+		notification.DeepLink = entity.DeepLink.String
+	}
+	if entity.ReadAt.Valid {
+		readAt := entity.ReadAt.Time
+		notification.ReadAt = &readAt
+	}
+	if entity.Data.Valid && entity.Data.String != "" {
+		if err := json.Unmarshal([]byte(entity.Data.String), &notification.Data); err != nil {
+			return usermodel.InboxNotification{}, fmt.Errorf("decode notification data: %w", err)
+		}
+	}
+
+	return notification, nil
+}
+
+// InboxNotificationVOToEntity converts the domain Value Object into a user_notifications row
+//
+// Empty deep link and data are persisted as NULL.
+func InboxNotificationVOToEntity(notification usermodel.InboxNotification) (userentity.InboxNotificationEntity, error) {
+	entity := userentity.InboxNotificationEntity{
+		ID:       notification.ID,
+		UserID:   notification.UserID,
+		Channel:  string(notification.Channel),
+		Category: string(notification.Category),
+		Title:    notification.Title,
+		Body:     notification.Body,
+		DeepLink: sql.NullString{
+			String: notification.DeepLink,
+			Valid:  notification.DeepLink != "",
+		},
+		CreatedAt: notification.CreatedAt,
+	}
+
+	if len(notification.Data) > 0 {
+		raw, err := json.Marshal(notification.Data)
+		if err != nil {
+			return userentity.InboxNotificationEntity{}, fmt.Errorf("encode notification data: %w", err)
+		}
+		entity.Data = sql.NullString{String: string(raw), Valid: true}
+	}
+	if notification.ReadAt != nil {
+		entity.ReadAt = sql.NullTime{Time: *notification.ReadAt, Valid: true}
+	}
+
+	return entity, nil
+}
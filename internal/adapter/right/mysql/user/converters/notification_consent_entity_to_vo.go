@@ -0,0 +1,20 @@
+package userconverters
+
+import (
+	userentity "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/user/entities"
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+)
+
+// NotificationConsentEntityToVO converts a user_notification_consents row into the domain Value Object
+func NotificationConsentEntityToVO(entity userentity.NotificationConsentEntity) usermodel.NotificationConsent {
+	return usermodel.NotificationConsent{
+		UserID:        entity.UserID,
+		Channel:       globalmodel.NotificationChannel(entity.Channel),
+		Category:      globalmodel.NotificationCategory(entity.Category),
+		Granted:       entity.Granted,
+		PolicyVersion: entity.PolicyVersion,
+		Source:        entity.Source,
+		UpdatedAt:     entity.UpdatedAt,
+	}
+}
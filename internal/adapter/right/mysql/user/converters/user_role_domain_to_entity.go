@@ -43,11 +43,12 @@ func UserRoleDomainToEntity(userRole usermodel.UserRoleInterface) (*userentity.U
 	}
 
 	entity := &userentity.UserRoleEntity{
-		ID:       uint32(userRole.GetID()),
-		UserID:   uint32(userRole.GetUserID()),
-		RoleID:   uint32(userRole.GetRoleID()),
-		IsActive: userRole.GetIsActive(),
-		Status:   int8(userRole.GetStatus()),
+		ID:         uint32(userRole.GetID()),
+		UserID:     uint32(userRole.GetUserID()),
+		RoleID:     uint32(userRole.GetRoleID()),
+		IsActive:   userRole.GetIsActive(),
+		Status:     int8(userRole.GetStatus()),
+		GrantState: uint8(userRole.GetGrantState()),
 	}
 
 	// Map optional ExpiresAt field (*time.Time → sql.NullTime)
@@ -58,5 +59,21 @@ func UserRoleDomainToEntity(userRole usermodel.UserRoleInterface) (*userentity.U
 		}
 	}
 
+	// Map optional ValidFrom field (*time.Time → sql.NullTime)
+	if validFrom := userRole.GetValidFrom(); validFrom != nil {
+		entity.ValidFrom = sql.NullTime{
+			Time:  *validFrom,
+			Valid: true,
+		}
+	}
+
+	// Map optional ExpiryNotifiedAt field (*time.Time → sql.NullTime)
+	if notifiedAt := userRole.GetExpiryNotifiedAt(); notifiedAt != nil {
+		entity.ExpiryNotifiedAt = sql.NullTime{
+			Time:  *notifiedAt,
+			Valid: true,
+		}
+	}
+
 	return entity, nil
 }
@@ -56,5 +56,17 @@ func UserRoleEntityToDomain(entity *userentity.UserRoleEntity) (usermodel.UserRo
 		userRole.SetExpiresAt(&entity.ExpiresAt.Time)
 	}
 
+	userRole.SetGrantState(usermodel.RoleGrantState(entity.GrantState))
+
+	// Map optional ValidFrom field (sql.NullTime → *time.Time)
+	if entity.ValidFrom.Valid {
+		userRole.SetValidFrom(&entity.ValidFrom.Time)
+	}
+
+	// Map optional ExpiryNotifiedAt field (sql.NullTime → *time.Time)
+	if entity.ExpiryNotifiedAt.Valid {
+		userRole.SetExpiryNotifiedAt(&entity.ExpiryNotifiedAt.Time)
+	}
+
 	return userRole, nil
 }
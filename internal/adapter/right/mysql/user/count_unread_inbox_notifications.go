@@ -0,0 +1,44 @@
+package mysqluseradapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// CountUnreadInboxNotifications returns how many inbox entries the user has not read (app badge)
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (can be nil for read-only queries)
+//   - userID: users.id
+//
+// Returns:
+//   - count: Unread entries (0 when none)
+//   - error: Database errors
+//
+// Performance:
+//   - Uses idx_user_notifications_user_unread (user_id, read_at)
+func (ua *UserAdapter) CountUnreadInboxNotifications(ctx context.Context, tx *sql.Tx, userID int64) (int64, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `SELECT COUNT(*) FROM user_notifications WHERE user_id = ? AND read_at IS NULL`
+
+	var count int64
+	if scanErr := ua.QueryRowContext(ctx, tx, "select", query, userID).Scan(&count); scanErr != nil {
+		utils.SetSpanError(ctx, scanErr)
+		logger.Error("mysql.user.count_unread_inbox_notifications.scan_error", "user_id", userID, "error", scanErr)
+		return 0, fmt.Errorf("count unread inbox notifications: %w", scanErr)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,53 @@
+package mysqluseradapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	userconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/user/converters"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// CreateDataExport inserts a new personal data export job and sets its generated ID
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (REQUIRED)
+//   - export: Job with user_id, status and requested_at populated
+//
+// Returns:
+//   - error: Database errors (FK violation if the user does not exist)
+func (ua *UserAdapter) CreateDataExport(ctx context.Context, tx *sql.Tx, export usermodel.DataExportInterface) error {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	entity := userconverters.DataExportDomainToEntity(export)
+
+	query := `INSERT INTO user_data_exports (user_id, status, requested_at) VALUES (?, ?, ?)`
+
+	result, execErr := ua.ExecContext(ctx, tx, "insert", query, entity.UserID, entity.Status, entity.RequestedAt)
+	if execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.user.create_data_export.exec_error", "user_id", entity.UserID, "error", execErr)
+		return fmt.Errorf("insert user data export: %w", execErr)
+	}
+
+	id, idErr := result.LastInsertId()
+	if idErr != nil {
+		utils.SetSpanError(ctx, idErr)
+		logger.Error("mysql.user.create_data_export.last_insert_id_error", "user_id", entity.UserID, "error", idErr)
+		return fmt.Errorf("user data export last insert id: %w", idErr)
+	}
+
+	export.SetID(id)
+	logger.Debug("mysql.user.create_data_export.success", "export_id", id, "user_id", entity.UserID)
+	return nil
+}
@@ -0,0 +1,69 @@
+package mysqluseradapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	userconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/user/converters"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// CreateInboxNotification stores a dispatched notification in the user's in-app inbox
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (can be nil - inbox writes are standalone)
+//   - notification: Entry to persist (created_at defaults to now when zero)
+//
+// Returns:
+//   - id: Generated user_notifications.id
+//   - error: Database errors (FK violation if the user does not exist)
+func (ua *UserAdapter) CreateInboxNotification(ctx context.Context, tx *sql.Tx, notification usermodel.InboxNotification) (int64, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	entity, convertErr := userconverters.InboxNotificationVOToEntity(notification)
+	if convertErr != nil {
+		utils.SetSpanError(ctx, convertErr)
+		logger.Error("mysql.user.create_inbox_notification.convert_error", "user_id", notification.UserID, "error", convertErr)
+		return 0, convertErr
+	}
+
+	query := `
+		INSERT INTO user_notifications (user_id, channel, category, title, body, deep_link, data)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	result, execErr := ua.ExecContext(ctx, tx, "insert", query,
+		entity.UserID,
+		entity.Channel,
+		entity.Category,
+		entity.Title,
+		entity.Body,
+		entity.DeepLink,
+		entity.Data,
+	)
+	if execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.user.create_inbox_notification.exec_error", "user_id", entity.UserID, "error", execErr)
+		return 0, fmt.Errorf("insert inbox notification: %w", execErr)
+	}
+
+	id, idErr := result.LastInsertId()
+	if idErr != nil {
+		utils.SetSpanError(ctx, idErr)
+		logger.Error("mysql.user.create_inbox_notification.last_insert_id_error", "user_id", entity.UserID, "error", idErr)
+		return 0, fmt.Errorf("inbox notification last insert id: %w", idErr)
+	}
+
+	logger.Debug("mysql.user.create_inbox_notification.success", "notification_id", id, "user_id", entity.UserID)
+	return id, nil
+}
@@ -33,6 +33,7 @@ import (
 //   - is_active defaults to 1 if not set
 //   - status defaults to 0 if not set
 //   - expires_at can be NULL for permanent role assignments
+//   - valid_from can be NULL for grants effective immediately (grant_state tracks scheduling)
 //
 // Database Constraints:
 //   - FK: user_id REFERENCES users(id) ON DELETE CASCADE
@@ -83,8 +84,8 @@ func (ua *UserAdapter) CreateUserRole(ctx context.Context, tx *sql.Tx, userRole
 
 	// Insert new user-role association
 	query := `
-		INSERT INTO user_roles (user_id, role_id, is_active, status, expires_at)
-		VALUES (?, ?, ?, ?, ?)
+		INSERT INTO user_roles (user_id, role_id, is_active, status, expires_at, valid_from, grant_state)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
 	`
 
 	// Execute insert using instrumented adapter
@@ -94,6 +95,8 @@ func (ua *UserAdapter) CreateUserRole(ctx context.Context, tx *sql.Tx, userRole
 		entity.IsActive,
 		entity.Status,
 		entity.ExpiresAt,
+		entity.ValidFrom,
+		entity.GrantState,
 	)
 	if execErr != nil {
 		utils.SetSpanError(ctx, execErr)
@@ -0,0 +1,51 @@
+package mysqluseradapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// DeleteCalendarFeed revokes the user's calendar feed
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (REQUIRED)
+//   - userID: users.id
+//
+// Returns:
+//   - error: sql.ErrNoRows if the user had no feed, or database errors
+func (ua *UserAdapter) DeleteCalendarFeed(ctx context.Context, tx *sql.Tx, userID int64) error {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `DELETE FROM user_calendar_feeds WHERE user_id = ?`
+
+	result, execErr := ua.ExecContext(ctx, tx, "delete", query, userID)
+	if execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.user.delete_calendar_feed.exec_error", "user_id", userID, "error", execErr)
+		return fmt.Errorf("delete calendar feed: %w", execErr)
+	}
+
+	rowsAffected, raErr := result.RowsAffected()
+	if raErr != nil {
+		utils.SetSpanError(ctx, raErr)
+		logger.Error("mysql.user.delete_calendar_feed.rows_affected_error", "user_id", userID, "error", raErr)
+		return fmt.Errorf("get rows affected: %w", raErr)
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package userentity
+
+import (
+	"database/sql"
+	"time"
+)
+
+// CalendarFeedEntity represents a row in the user_calendar_feeds table
+//
+// Schema Mapping:
+//   - Database table: user_calendar_feeds (InnoDB)
+//   - Primary Key: user_id (one feed per user)
+//   - Foreign Key: user_id → users.id (CASCADE on DELETE)
+//   - Unique Constraint: uk_user_calendar_feeds_token (token_hash)
+//
+// Conversion:
+//   - To Domain: Use userconverters.CalendarFeedEntityToVO()
+type CalendarFeedEntity struct {
+	UserID         int64
+	TokenHash      string
+	CreatedAt      time.Time
+	LastAccessedAt sql.NullTime
+}
@@ -0,0 +1,32 @@
+package userentity
+
+import "database/sql"
+
+// DataExportEntity represents a row in the user_data_exports table
+//
+// Schema Mapping:
+//   - Database table: user_data_exports (InnoDB)
+//   - Primary Key: id (INT UNSIGNED AUTO_INCREMENT)
+//   - Foreign Key: user_id → users.id (CASCADE on DELETE)
+//   - Indexes: idx_user_data_exports_user (user_id, requested_at), idx_user_data_exports_status (status, requested_at),
+//     idx_user_data_exports_started (status, started_at)
+//
+// NULL Handling:
+//   - sql.NullString: object_key, error_message
+//   - sql.NullTime: started_at, completed_at, expires_at
+//
+// Conversion:
+//   - To Domain: Use userconverters.DataExportEntityToDomain()
+//   - From Domain: Use userconverters.DataExportDomainToEntity()
+type DataExportEntity struct {
+	ID           int64
+	UserID       int64
+	Status       string
+	ObjectKey    sql.NullString
+	ErrorMessage sql.NullString
+	Attempts     int
+	RequestedAt  sql.NullTime
+	StartedAt    sql.NullTime
+	CompletedAt  sql.NullTime
+	ExpiresAt    sql.NullTime
+}
@@ -0,0 +1,33 @@
+package userentity
+
+import (
+	"database/sql"
+	"time"
+)
+
+// InboxNotificationEntity represents a row in the user_notifications table
+//
+// Schema Mapping:
+//   - Database table: user_notifications (InnoDB)
+//   - Primary Key: id (BIGINT UNSIGNED AUTO_INCREMENT)
+//   - Foreign Key: user_id → users.id (CASCADE on DELETE)
+//   - Indexes: idx_user_notifications_user_created (user_id, created_at), idx_user_notifications_user_unread (user_id, read_at)
+//
+// NULL Handling:
+//   - sql.NullString: deep_link, data (JSON text)
+//   - sql.NullTime: read_at
+//
+// Conversion:
+//   - To Domain: Use userconverters.InboxNotificationEntityToVO()
+type InboxNotificationEntity struct {
+	ID        int64
+	UserID    int64
+	Channel   string
+	Category  string
+	Title     string
+	Body      string
+	DeepLink  sql.NullString
+	Data      sql.NullString
+	ReadAt    sql.NullTime
+	CreatedAt time.Time
+}
@@ -0,0 +1,23 @@
+package userentity
+
+import "time"
+
+// NotificationConsentEntity represents a row in the user_notification_consents table
+//
+// Schema Mapping:
+//   - Database table: user_notification_consents (InnoDB)
+//   - Primary Key: id (INT UNSIGNED AUTO_INCREMENT)
+//   - Foreign Key: user_id → users.id (CASCADE on DELETE)
+//   - Unique Constraint: uk_user_notification_consents (user_id, channel, category)
+//
+// Conversion:
+//   - To Domain: Use userconverters.NotificationConsentEntityToVO()
+type NotificationConsentEntity struct {
+	UserID        int64
+	Channel       string
+	Category      string
+	Granted       bool
+	PolicyVersion string
+	Source        string
+	UpdatedAt     time.Time
+}
@@ -24,7 +24,7 @@ import (
 //   - Manage role lifecycle and approval workflows
 //
 // NULL Handling:
-//   - sql.NullTime: Used for expires_at and blocked_until (optional timestamps)
+//   - sql.NullTime: Used for expires_at, valid_from, expiry_notified_at and blocked_until (optional timestamps)
 //   - Direct types: Used for NOT NULL columns
 //
 // Conversion:
@@ -74,4 +74,17 @@ type UserRoleEntity struct {
 	// After this time, role should be deactivated by cron job
 	// NULL = no expiration (permanent assignment)
 	ExpiresAt sql.NullTime `db:"expires_at"`
+
+	// ValidFrom is the optional start of the grant window (NULL, TIMESTAMP(6))
+	// Permission queries ignore the role until NOW() >= valid_from
+	// NULL = effective immediately
+	ValidFrom sql.NullTime `db:"valid_from"`
+
+	// GrantState is the scheduler state of the grant (NOT NULL, TINYINT UNSIGNED, DEFAULT 0)
+	// 0 = effective, 1 = scheduled (valid_from in the future), 2 = expired (processed by worker)
+	GrantState uint8 `db:"grant_state"`
+
+	// ExpiryNotifiedAt records when the pre-expiration notice was sent (NULL, TIMESTAMP(6))
+	// NULL = notice not sent yet
+	ExpiryNotifiedAt sql.NullTime `db:"expiry_notified_at"`
 }
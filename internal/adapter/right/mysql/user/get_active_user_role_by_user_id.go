@@ -22,6 +22,7 @@ import (
 //   - JOINs user_roles with roles table
 //   - Filters by ur.is_active = 1 (only current active role)
 //   - Filters by ur.expires_at IS NULL OR expires_at > NOW() (not expired)
+//   - Filters by ur.valid_from IS NULL OR valid_from <= NOW() (scheduled grants not yet started)
 //   - Filters by r.is_active = 1 (role itself is active)
 //   - Orders by ur.id DESC to get latest assignment if multiple exist (data integrity issue)
 //   - LIMIT 1 ensures single result
@@ -37,7 +38,7 @@ import (
 //
 // Business Rules:
 //   - User has at most ONE active role at any time (enforced by service layer)
-//   - Expired roles (expires_at < NOW()) are excluded
+//   - Expired roles (expires_at < NOW()) and scheduled roles (valid_from > NOW()) are excluded
 //   - Inactive roles (r.is_active = 0) are excluded
 //   - Returns nil (not error) if user has no active role
 //
@@ -80,7 +81,7 @@ func (ua *UserAdapter) GetActiveUserRoleByUserID(ctx context.Context, tx *sql.Tx
 
 	// Query with JOIN to populate Role in UserRole in single round-trip
 	// Note: INNER JOIN excludes users without roles
-	// Note: Active role filters ensure only valid, non-expired assignments
+	// Note: Active role filters ensure only valid, started and non-expired assignments
 	query := `
 		SELECT 
 			ur.id,
@@ -100,6 +101,7 @@ func (ua *UserAdapter) GetActiveUserRoleByUserID(ctx context.Context, tx *sql.Tx
 		WHERE ur.user_id = ?
 		  AND ur.is_active = 1
 		  AND (ur.expires_at IS NULL OR ur.expires_at > NOW())
+		  AND (ur.valid_from IS NULL OR ur.valid_from <= NOW())
 		  AND r.is_active = 1
 		ORDER BY ur.id DESC
 		LIMIT 1`
@@ -0,0 +1,74 @@
+package mysqluseradapter
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	userconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/user/converters"
+	userentity "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/user/entities"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// GetCalendarFeedByUserID returns the user's calendar feed
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (can be nil for read-only queries)
+//   - userID: users.id
+//
+// Returns:
+//   - feed: Stored feed (token hash only)
+//   - error: sql.ErrNoRows when the user has no active feed, or database errors
+func (ua *UserAdapter) GetCalendarFeedByUserID(ctx context.Context, tx *sql.Tx, userID int64) (usermodel.CalendarFeed, error) {
+	return ua.getCalendarFeed(ctx, tx, "user_id", userID)
+}
+
+// GetCalendarFeedByTokenHash resolves the feed owning a token hash
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (can be nil for read-only queries)
+//   - tokenHash: hex encoded SHA-256 of the token presented in the feed URL
+//
+// Returns:
+//   - feed: Stored feed
+//   - error: sql.ErrNoRows when the token is unknown or was rotated/revoked, or database errors
+//
+// Performance:
+//   - Uses uk_user_calendar_feeds_token
+func (ua *UserAdapter) GetCalendarFeedByTokenHash(ctx context.Context, tx *sql.Tx, tokenHash string) (usermodel.CalendarFeed, error) {
+	return ua.getCalendarFeed(ctx, tx, "token_hash", tokenHash)
+}
+
+func (ua *UserAdapter) getCalendarFeed(ctx context.Context, tx *sql.Tx, column string, value any) (usermodel.CalendarFeed, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return usermodel.CalendarFeed{}, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := fmt.Sprintf(`
+		SELECT user_id, token_hash, created_at, last_accessed_at
+		FROM user_calendar_feeds
+		WHERE %s = ?
+	`, column)
+
+	var entity userentity.CalendarFeedEntity
+	row := ua.QueryRowContext(ctx, tx, "select", query, value)
+	if scanErr := row.Scan(&entity.UserID, &entity.TokenHash, &entity.CreatedAt, &entity.LastAccessedAt); scanErr != nil {
+		if errors.Is(scanErr, sql.ErrNoRows) {
+			return usermodel.CalendarFeed{}, sql.ErrNoRows
+		}
+		utils.SetSpanError(ctx, scanErr)
+		logger.Error("mysql.user.get_calendar_feed.scan_error", "column", column, "error", scanErr)
+		return usermodel.CalendarFeed{}, fmt.Errorf("get calendar feed: %w", scanErr)
+	}
+
+	return userconverters.CalendarFeedEntityToVO(entity), nil
+}
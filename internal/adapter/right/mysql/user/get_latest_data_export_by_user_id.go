@@ -0,0 +1,67 @@
+package mysqluseradapter
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	userconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/user/converters"
+	userentity "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/user/entities"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// GetLatestDataExportByUserID returns the most recent personal data export job of a user
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (can be nil for read-only queries)
+//   - userID: users.id
+//
+// Returns:
+//   - export: Latest job ordered by requested_at/id
+//   - error: sql.ErrNoRows if the user never requested an export, or database errors
+func (ua *UserAdapter) GetLatestDataExportByUserID(ctx context.Context, tx *sql.Tx, userID int64) (usermodel.DataExportInterface, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `
+		SELECT id, user_id, status, object_key, error_message, attempts, requested_at, started_at, completed_at, expires_at
+		FROM user_data_exports
+		WHERE user_id = ?
+		ORDER BY requested_at DESC, id DESC
+		LIMIT 1
+	`
+
+	var entity userentity.DataExportEntity
+	row := ua.QueryRowContext(ctx, tx, "select", query, userID)
+	scanErr := row.Scan(
+		&entity.ID,
+		&entity.UserID,
+		&entity.Status,
+		&entity.ObjectKey,
+		&entity.ErrorMessage,
+		&entity.Attempts,
+		&entity.RequestedAt,
+		&entity.StartedAt,
+		&entity.CompletedAt,
+		&entity.ExpiresAt,
+	)
+	if scanErr != nil {
+		if errors.Is(scanErr, sql.ErrNoRows) {
+			return nil, scanErr
+		}
+		utils.SetSpanError(ctx, scanErr)
+		logger.Error("mysql.user.get_latest_data_export_by_user_id.scan_error", "user_id", userID, "error", scanErr)
+		return nil, fmt.Errorf("scan latest user data export: %w", scanErr)
+	}
+
+	return userconverters.DataExportEntityToDomain(&entity), nil
+}
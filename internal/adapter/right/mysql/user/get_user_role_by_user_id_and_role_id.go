@@ -86,7 +86,7 @@ func (ua *UserAdapter) GetUserRoleByUserIDAndRoleID(ctx context.Context, tx *sql
 	// Note: No filter by is_active - returns active or inactive assignments
 	// Note: No filter by expires_at - returns expired assignments
 	query := `
-		SELECT id, user_id, role_id, is_active, status, expires_at
+		SELECT id, user_id, role_id, is_active, status, expires_at, valid_from, grant_state, expiry_notified_at
 		FROM user_roles 
 		WHERE user_id = ? AND role_id = ?
 		LIMIT 1
@@ -100,12 +100,15 @@ func (ua *UserAdapter) GetUserRoleByUserIDAndRoleID(ctx context.Context, tx *sql
 		isActiveInt int64
 		status      int64
 		expiresAt   sql.NullTime
+		validFrom   sql.NullTime
+		grantState  uint8
+		notifiedAt  sql.NullTime
 	)
 
 	// Execute query using instrumented adapter
 	row := ua.QueryRowContext(ctx, tx, "select", query, userID, roleID)
 	err = row.Scan(
-		&id, &uid, &roleIDOut, &isActiveInt, &status, &expiresAt,
+		&id, &uid, &roleIDOut, &isActiveInt, &status, &expiresAt, &validFrom, &grantState, &notifiedAt,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -123,11 +126,14 @@ func (ua *UserAdapter) GetUserRoleByUserIDAndRoleID(ctx context.Context, tx *sql
 
 	// Build strongly-typed entity from scanned values
 	entity := &userentity.UserRoleEntity{
-		ID:       uint32(id),
-		UserID:   uint32(userID),
-		RoleID:   uint32(roleIDOut),
-		IsActive: isActiveInt == 1,
-		Status:   int8(status),
+		ID:               uint32(id),
+		UserID:           uint32(userID),
+		RoleID:           uint32(roleIDOut),
+		IsActive:         isActiveInt == 1,
+		Status:           int8(status),
+		ValidFrom:        validFrom,
+		GrantState:       grantState,
+		ExpiryNotifiedAt: notifiedAt,
 	}
 	if expiresAt.Valid {
 		entity.ExpiresAt = sql.NullTime{
@@ -93,6 +93,9 @@ func (ua *UserAdapter) GetUserRolesByUserID(ctx context.Context, tx *sql.Tx, use
 			ur.is_active,
 			ur.status,
 			ur.expires_at,
+			ur.valid_from,
+			ur.grant_state,
+			ur.expiry_notified_at,
 			r.id,
 			r.slug,
 			r.name,
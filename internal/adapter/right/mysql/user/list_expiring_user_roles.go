@@ -0,0 +1,145 @@
+package mysqluseradapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	userconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/user/converters"
+	userentity "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/user/entities"
+	userrepository "github.com/projeto-toq/toq_server/internal/core/port/right/repository/user_repository"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ListExpiringUserRoles lists time-bound grants that will expire before filter.Until
+//
+// Query Structure:
+//   - user_roles JOIN users (non-deleted) JOIN roles
+//   - Filter: expires_at > NOW() AND expires_at <= filter.Until
+//   - Optional: expiry_notified_at IS NULL (pending notices only)
+//   - Order: expires_at ASC (soonest first)
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (can be nil for read-only queries)
+//   - filter: Until bound, notice filter and pagination (defaults page=1, limit=20)
+//
+// Returns:
+//   - result: Items with user/role data and Total matching rows
+//   - error: Query execution errors, scan errors
+func (ua *UserAdapter) ListExpiringUserRoles(ctx context.Context, tx *sql.Tx, filter userrepository.ExpiringUserRolesFilter) (userrepository.ExpiringUserRolesResult, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return userrepository.ExpiringUserRolesResult{}, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	page := filter.Page
+	if page <= 0 {
+		page = 1
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	offset := (page - 1) * limit
+
+	whereClause := `
+		WHERE u.deleted = 0
+		  AND ur.expires_at IS NOT NULL
+		  AND ur.expires_at > NOW()
+		  AND ur.expires_at <= ?`
+	args := []any{filter.Until}
+	if filter.OnlyNotNotified {
+		whereClause += " AND ur.expiry_notified_at IS NULL"
+	}
+
+	listQuery := `
+		SELECT ur.id, ur.user_id, ur.role_id, ur.is_active, ur.status, ur.expires_at,
+		       ur.valid_from, ur.grant_state, ur.expiry_notified_at,
+		       u.full_name, u.nick_name, u.email, r.slug, r.name
+		FROM user_roles ur
+		JOIN users u ON u.id = ur.user_id
+		JOIN roles r ON r.id = ur.role_id` + whereClause + `
+		ORDER BY ur.expires_at ASC, ur.id ASC
+		LIMIT ? OFFSET ?`
+
+	rows, queryErr := ua.QueryContext(ctx, tx, "select", listQuery, append(append([]any{}, args...), limit, offset)...)
+	if queryErr != nil {
+		utils.SetSpanError(ctx, queryErr)
+		logger.Error("mysql.user.list_expiring_user_roles.query_error", "error", queryErr)
+		return userrepository.ExpiringUserRolesResult{}, fmt.Errorf("query expiring user roles: %w", queryErr)
+	}
+	defer rows.Close()
+
+	items := make([]userrepository.ExpiringUserRole, 0)
+	for rows.Next() {
+		var (
+			entity   userentity.UserRoleEntity
+			fullName string
+			nickName sql.NullString
+			email    string
+			roleSlug string
+			roleName string
+		)
+		if scanErr := rows.Scan(
+			&entity.ID,
+			&entity.UserID,
+			&entity.RoleID,
+			&entity.IsActive,
+			&entity.Status,
+			&entity.ExpiresAt,
+			&entity.ValidFrom,
+			&entity.GrantState,
+			&entity.ExpiryNotifiedAt,
+			&fullName,
+			&nickName,
+			&email,
+			&roleSlug,
+			&roleName,
+		); scanErr != nil {
+			utils.SetSpanError(ctx, scanErr)
+			logger.Error("mysql.user.list_expiring_user_roles.scan_error", "error", scanErr)
+			return userrepository.ExpiringUserRolesResult{}, fmt.Errorf("scan expiring user role: %w", scanErr)
+		}
+
+		userRole, convertErr := userconverters.UserRoleEntityToDomain(&entity)
+		if convertErr != nil {
+			utils.SetSpanError(ctx, convertErr)
+			logger.Error("mysql.user.list_expiring_user_roles.convert_error", "error", convertErr)
+			return userrepository.ExpiringUserRolesResult{}, fmt.Errorf("convert user role entity to domain: %w", convertErr)
+		}
+
+		items = append(items, userrepository.ExpiringUserRole{
+			UserRole:     userRole,
+			UserFullName: fullName,
+			UserNickName: nickName.String,
+			UserEmail:    email,
+			RoleSlug:     roleSlug,
+			RoleName:     roleName,
+		})
+	}
+	if rowsErr := rows.Err(); rowsErr != nil {
+		utils.SetSpanError(ctx, rowsErr)
+		logger.Error("mysql.user.list_expiring_user_roles.rows_error", "error", rowsErr)
+		return userrepository.ExpiringUserRolesResult{}, fmt.Errorf("iterate expiring user roles: %w", rowsErr)
+	}
+
+	countQuery := `
+		SELECT COUNT(*)
+		FROM user_roles ur
+		JOIN users u ON u.id = ur.user_id` + whereClause
+
+	var total int64
+	if countErr := ua.QueryRowContext(ctx, tx, "select", countQuery, args...).Scan(&total); countErr != nil {
+		utils.SetSpanError(ctx, countErr)
+		logger.Error("mysql.user.list_expiring_user_roles.count_error", "error", countErr)
+		return userrepository.ExpiringUserRolesResult{}, fmt.Errorf("count expiring user roles: %w", countErr)
+	}
+
+	logger.Debug("mysql.user.list_expiring_user_roles.success", "count", len(items), "total", total)
+	return userrepository.ExpiringUserRolesResult{Items: items, Total: total}, nil
+}
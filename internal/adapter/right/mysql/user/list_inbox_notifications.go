@@ -0,0 +1,102 @@
+package mysqluseradapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	userconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/user/converters"
+	userentity "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/user/entities"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	userrepository "github.com/projeto-toq/toq_server/internal/core/port/right/repository/user_repository"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ListInboxNotifications returns a page of the user's inbox, newest first
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (can be nil for read-only queries)
+//   - filter: User, unread-only flag and pagination (Page >= 1, Limit > 0)
+//
+// Returns:
+//   - result: Items of the page plus total matching rows
+//   - error: Database, scan or JSON decode errors
+//
+// Performance:
+//   - Uses idx_user_notifications_user_created for ordering by created_at
+func (ua *UserAdapter) ListInboxNotifications(ctx context.Context, tx *sql.Tx, filter userrepository.InboxNotificationsFilter) (userrepository.InboxNotificationsResult, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return userrepository.InboxNotificationsResult{}, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	where := "WHERE user_id = ?"
+	args := []any{filter.UserID}
+	if filter.OnlyUnread {
+		where += " AND read_at IS NULL"
+	}
+
+	var total int64
+	countQuery := "SELECT COUNT(*) FROM user_notifications " + where
+	if scanErr := ua.QueryRowContext(ctx, tx, "select", countQuery, args...).Scan(&total); scanErr != nil {
+		utils.SetSpanError(ctx, scanErr)
+		logger.Error("mysql.user.list_inbox_notifications.count_error", "user_id", filter.UserID, "error", scanErr)
+		return userrepository.InboxNotificationsResult{}, fmt.Errorf("count inbox notifications: %w", scanErr)
+	}
+
+	offset := (filter.Page - 1) * filter.Limit
+	query := `SELECT id, user_id, channel, category, title, body, deep_link, data, read_at, created_at
+		FROM user_notifications ` + where + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT ? OFFSET ?`
+
+	rows, queryErr := ua.QueryContext(ctx, tx, "select", query, append(args, filter.Limit, offset)...)
+	if queryErr != nil {
+		utils.SetSpanError(ctx, queryErr)
+		logger.Error("mysql.user.list_inbox_notifications.query_error", "user_id", filter.UserID, "error", queryErr)
+		return userrepository.InboxNotificationsResult{}, fmt.Errorf("query inbox notifications: %w", queryErr)
+	}
+	defer rows.Close()
+
+	items := make([]usermodel.InboxNotification, 0)
+	for rows.Next() {
+		var entity userentity.InboxNotificationEntity
+		if scanErr := rows.Scan(
+			&entity.ID,
+			&entity.UserID,
+			&entity.Channel,
+			&entity.Category,
+			&entity.Title,
+			&entity.Body,
+			&entity.DeepLink,
+			&entity.Data,
+			&entity.ReadAt,
+			&entity.CreatedAt,
+		); scanErr != nil {
+			utils.SetSpanError(ctx, scanErr)
+			logger.Error("mysql.user.list_inbox_notifications.scan_error", "user_id", filter.UserID, "error", scanErr)
+			return userrepository.InboxNotificationsResult{}, fmt.Errorf("scan inbox notification: %w", scanErr)
+		}
+
+		item, convertErr := userconverters.InboxNotificationEntityToVO(entity)
+		if convertErr != nil {
+			utils.SetSpanError(ctx, convertErr)
+			logger.Error("mysql.user.list_inbox_notifications.convert_error", "notification_id", entity.ID, "error", convertErr)
+			return userrepository.InboxNotificationsResult{}, convertErr
+		}
+		items = append(items, item)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		utils.SetSpanError(ctx, rowsErr)
+		logger.Error("mysql.user.list_inbox_notifications.rows_error", "user_id", filter.UserID, "error", rowsErr)
+		return userrepository.InboxNotificationsResult{}, fmt.Errorf("iterate inbox notifications: %w", rowsErr)
+	}
+
+	return userrepository.InboxNotificationsResult{Items: items, Total: total}, nil
+}
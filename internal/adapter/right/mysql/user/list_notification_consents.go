@@ -0,0 +1,78 @@
+package mysqluseradapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	userconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/user/converters"
+	userentity "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/user/entities"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ListNotificationConsents returns every consent the user recorded, one per channel/category
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (can be nil for read-only queries)
+//   - userID: users.id
+//
+// Returns:
+//   - consents: Recorded consents (empty slice when the user never changed preferences)
+//   - error: Database or scan errors
+//
+// Performance:
+//   - Uses uk_user_notification_consents (user_id prefix)
+func (ua *UserAdapter) ListNotificationConsents(ctx context.Context, tx *sql.Tx, userID int64) ([]usermodel.NotificationConsent, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `
+		SELECT user_id, channel, category, granted, policy_version, source, updated_at
+		FROM user_notification_consents
+		WHERE user_id = ?
+		ORDER BY channel, category
+	`
+
+	rows, queryErr := ua.QueryContext(ctx, tx, "select", query, userID)
+	if queryErr != nil {
+		utils.SetSpanError(ctx, queryErr)
+		logger.Error("mysql.user.list_notification_consents.query_error", "user_id", userID, "error", queryErr)
+		return nil, fmt.Errorf("query notification consents: %w", queryErr)
+	}
+	defer rows.Close()
+
+	consents := make([]usermodel.NotificationConsent, 0)
+	for rows.Next() {
+		var entity userentity.NotificationConsentEntity
+		if scanErr := rows.Scan(
+			&entity.UserID,
+			&entity.Channel,
+			&entity.Category,
+			&entity.Granted,
+			&entity.PolicyVersion,
+			&entity.Source,
+			&entity.UpdatedAt,
+		); scanErr != nil {
+			utils.SetSpanError(ctx, scanErr)
+			logger.Error("mysql.user.list_notification_consents.scan_error", "user_id", userID, "error", scanErr)
+			return nil, fmt.Errorf("scan notification consent: %w", scanErr)
+		}
+		consents = append(consents, userconverters.NotificationConsentEntityToVO(entity))
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		utils.SetSpanError(ctx, rowsErr)
+		logger.Error("mysql.user.list_notification_consents.rows_error", "user_id", userID, "error", rowsErr)
+		return nil, fmt.Errorf("iterate notification consents: %w", rowsErr)
+	}
+
+	return consents, nil
+}
@@ -0,0 +1,84 @@
+package mysqluseradapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	userconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/user/converters"
+	userentity "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/user/entities"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ListPendingDataExports returns PENDING personal data export jobs, oldest first
+//
+// Rows are locked with FOR UPDATE SKIP LOCKED when a transaction is provided so that
+// concurrent worker instances never claim the same job.
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (REQUIRED to claim jobs)
+//   - limit: Maximum jobs returned per batch (must be > 0)
+//
+// Returns:
+//   - exports: Pending jobs (empty slice if none)
+//   - error: Database or scan errors
+func (ua *UserAdapter) ListPendingDataExports(ctx context.Context, tx *sql.Tx, limit int) ([]usermodel.DataExportInterface, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `
+		SELECT id, user_id, status, object_key, error_message, attempts, requested_at, started_at, completed_at, expires_at
+		FROM user_data_exports
+		WHERE status = ?
+		ORDER BY requested_at ASC, id ASC
+		LIMIT ?
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, queryErr := ua.QueryContext(ctx, tx, "select", query, string(usermodel.DataExportStatusPending), limit)
+	if queryErr != nil {
+		utils.SetSpanError(ctx, queryErr)
+		logger.Error("mysql.user.list_pending_data_exports.query_error", "error", queryErr)
+		return nil, fmt.Errorf("query pending user data exports: %w", queryErr)
+	}
+	defer rows.Close()
+
+	exports := make([]usermodel.DataExportInterface, 0)
+	for rows.Next() {
+		var entity userentity.DataExportEntity
+		if scanErr := rows.Scan(
+			&entity.ID,
+			&entity.UserID,
+			&entity.Status,
+			&entity.ObjectKey,
+			&entity.ErrorMessage,
+			&entity.Attempts,
+			&entity.RequestedAt,
+			&entity.StartedAt,
+			&entity.CompletedAt,
+			&entity.ExpiresAt,
+		); scanErr != nil {
+			utils.SetSpanError(ctx, scanErr)
+			logger.Error("mysql.user.list_pending_data_exports.scan_error", "error", scanErr)
+			return nil, fmt.Errorf("scan pending user data export: %w", scanErr)
+		}
+		exports = append(exports, userconverters.DataExportEntityToDomain(&entity))
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		utils.SetSpanError(ctx, rowsErr)
+		logger.Error("mysql.user.list_pending_data_exports.rows_error", "error", rowsErr)
+		return nil, fmt.Errorf("iterate pending user data exports: %w", rowsErr)
+	}
+
+	logger.Debug("mysql.user.list_pending_data_exports.success", "count", len(exports))
+	return exports, nil
+}
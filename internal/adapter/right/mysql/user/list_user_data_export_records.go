@@ -0,0 +1,164 @@
+package mysqluseradapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// dataExportSectionQueries maps each export section to an explicit-column query filtered by user id.
+//
+// Columns are listed explicitly so that credentials and secrets (password, refresh_hash,
+// token_jti, device_token) never reach the archive. Every query takes the user id as its
+// only bind parameter, repeated as many times as it has placeholders.
+var dataExportSectionQueries = map[usermodel.DataExportSection]struct {
+	query  string
+	params int
+}{
+	usermodel.DataExportSectionProfile: {
+		query: `SELECT id, full_name, nick_name, national_id, creci_number, creci_state, creci_validity, born_at,
+			phone_number, email, zip_code, street, number, complement, neighborhood, city, state,
+			opt_status, last_activity_at, blocked_until, permanently_blocked, created_at
+			FROM users WHERE id = ?`,
+		params: 1,
+	},
+	usermodel.DataExportSectionRoles: {
+		query: `SELECT ur.id, r.slug AS role_slug, r.name AS role_name, ur.is_active, ur.status,
+			ur.valid_from, ur.expires_at, ur.grant_state
+			FROM user_roles ur JOIN roles r ON r.id = ur.role_id
+			WHERE ur.user_id = ? ORDER BY ur.id`,
+		params: 1,
+	},
+	usermodel.DataExportSectionSessions: {
+		query: `SELECT id, created_at, expires_at, absolute_expires_at, rotated_at, last_refresh_at,
+			user_agent, ip, device_id, rotation_counter, revoked
+			FROM sessions WHERE user_id = ? ORDER BY id`,
+		params: 1,
+	},
+	usermodel.DataExportSectionDeviceTokens: {
+		query: `SELECT id, device_id, platform, created_at, updated_at
+			FROM device_tokens WHERE user_id = ? ORDER BY id`,
+		params: 1,
+	},
+	usermodel.DataExportSectionListings: {
+		query: `SELECT id, listing_uuid, code, active_version_id, has_pending_proposal, has_accepted_proposal, deleted
+			FROM listing_identities WHERE user_id = ? ORDER BY id`,
+		params: 1,
+	},
+	usermodel.DataExportSectionListingVersions: {
+		query: `SELECT id, listing_identity_id, code, version, status, title, zip_code, street, number, complement,
+			neighborhood, city, state, type, description, transaction, sell_net, rent_net, condominium,
+			tenant_name, tenant_email, tenant_phone, deleted, created_at, price_updated_at
+			FROM listing_versions WHERE user_id = ? ORDER BY listing_identity_id, version`,
+		params: 1,
+	},
+	usermodel.DataExportSectionVisits: {
+		query: `SELECT id, listing_identity_id, listing_version, scheduled_date, scheduled_time_start, scheduled_time_end,
+			status, source, notes, rejection_reason, requested_at
+			FROM listing_visits WHERE user_id = ? ORDER BY id`,
+		params: 1,
+	},
+	usermodel.DataExportSectionProposals: {
+		query: `SELECT id, listing_identity_id, realtor_id, owner_id, status, proposal_text, rejection_reason,
+			accepted_at, rejected_at, cancelled_at, deleted, created_at
+			FROM proposals WHERE realtor_id = ? OR owner_id = ? ORDER BY id`,
+		params: 2,
+	},
+	usermodel.DataExportSectionFavorites: {
+		query: `SELECT id, listing_identity_id
+			FROM listing_favorites WHERE user_id = ? ORDER BY id`,
+		params: 1,
+	},
+	usermodel.DataExportSectionAuditEvents: {
+		query: `SELECT id, occurred_at, actor_id, actor_role, actor_device_id, actor_ip, actor_user_agent,
+			target_type, target_id, target_version, operation, metadata, request_id
+			FROM audit_events WHERE actor_id = ? OR (target_type = 'users' AND target_id = ?) ORDER BY id`,
+		params: 2,
+	},
+}
+
+// ListUserDataExportRecords returns every row of one personal data section for a user
+//
+// Rows are returned as column-name keyed maps ready for JSON serialization: []byte values
+// become strings and NULL columns become nil.
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (can be nil for read-only queries)
+//   - userID: users.id of the data subject
+//   - section: One of usermodel.DataExportSections
+//
+// Returns:
+//   - records: Rows of the section (empty slice if none)
+//   - error: Unknown section, database or scan errors
+func (ua *UserAdapter) ListUserDataExportRecords(ctx context.Context, tx *sql.Tx, userID int64, section usermodel.DataExportSection) ([]map[string]any, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	sectionQuery, ok := dataExportSectionQueries[section]
+	if !ok {
+		return nil, fmt.Errorf("unknown data export section: %s", section)
+	}
+
+	args := make([]any, sectionQuery.params)
+	for i := range args {
+		args[i] = userID
+	}
+
+	rows, queryErr := ua.QueryContext(ctx, tx, "select", sectionQuery.query, args...)
+	if queryErr != nil {
+		utils.SetSpanError(ctx, queryErr)
+		logger.Error("mysql.user.list_user_data_export_records.query_error", "user_id", userID, "section", section, "error", queryErr)
+		return nil, fmt.Errorf("query data export section %s: %w", section, queryErr)
+	}
+	defer rows.Close()
+
+	columns, colErr := rows.Columns()
+	if colErr != nil {
+		utils.SetSpanError(ctx, colErr)
+		logger.Error("mysql.user.list_user_data_export_records.columns_error", "user_id", userID, "section", section, "error", colErr)
+		return nil, fmt.Errorf("read data export section %s columns: %w", section, colErr)
+	}
+
+	records := make([]map[string]any, 0)
+	for rows.Next() {
+		values := make([]any, len(columns))
+		pointers := make([]any, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if scanErr := rows.Scan(pointers...); scanErr != nil {
+			utils.SetSpanError(ctx, scanErr)
+			logger.Error("mysql.user.list_user_data_export_records.scan_error", "user_id", userID, "section", section, "error", scanErr)
+			return nil, fmt.Errorf("scan data export section %s: %w", section, scanErr)
+		}
+
+		record := make(map[string]any, len(columns))
+		for i, column := range columns {
+			if raw, isBytes := values[i].([]byte); isBytes {
+				record[column] = string(raw)
+				continue
+			}
+			record[column] = values[i]
+		}
+		records = append(records, record)
+	}
+
+	if rowsErr := rows.Err(); rowsErr != nil {
+		utils.SetSpanError(ctx, rowsErr)
+		logger.Error("mysql.user.list_user_data_export_records.rows_error", "user_id", userID, "section", section, "error", rowsErr)
+		return nil, fmt.Errorf("iterate data export section %s: %w", section, rowsErr)
+	}
+
+	logger.Debug("mysql.user.list_user_data_export_records.success", "user_id", userID, "section", section, "count", len(records))
+	return records, nil
+}
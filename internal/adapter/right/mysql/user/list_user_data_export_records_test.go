@@ -0,0 +1,118 @@
+package mysqluseradapter
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var (
+	schemaTablePattern  = regexp.MustCompile("(?i)^CREATE TABLE IF NOT EXISTS `toq_db`\\.`([a-z0-9_]+)`")
+	schemaColumnPattern = regexp.MustCompile("^\\s*`([a-z0-9_]+)`\\s+[A-Z]")
+	queryTablePattern   = regexp.MustCompile(`(?i)\b(?:FROM|JOIN)\s+([a-z0-9_]+)(?:\s+([a-z0-9_]+))?`)
+	queryTokenPattern   = regexp.MustCompile(`'[^']*'|[A-Za-z_][A-Za-z0-9_]*(?:\.[A-Za-z_][A-Za-z0-9_]*)?`)
+)
+
+var dataExportQueryKeywords = map[string]bool{
+	"SELECT": true, "FROM": true, "WHERE": true, "ORDER": true, "BY": true, "AND": true,
+	"OR": true, "AS": true, "JOIN": true, "ON": true, "ASC": true, "DESC": true,
+}
+
+// loadSchemaColumns parses scripts/db_creation.sql into a table -> column set map.
+func loadSchemaColumns(t *testing.T) map[string]map[string]bool {
+	t.Helper()
+
+	raw, err := os.ReadFile(filepath.Join("..", "..", "..", "..", "..", "scripts", "db_creation.sql"))
+	if err != nil {
+		t.Fatalf("read db_creation.sql: %v", err)
+	}
+
+	tables := make(map[string]map[string]bool)
+	var current map[string]bool
+	for _, line := range strings.Split(string(raw), "\n") {
+		line = strings.TrimRight(line, "\r")
+		if match := schemaTablePattern.FindStringSubmatch(line); match != nil {
+			current = make(map[string]bool)
+			tables[match[1]] = current
+			continue
+		}
+		if current == nil {
+			continue
+		}
+		if strings.HasPrefix(strings.TrimSpace(line), "ENGINE") {
+			current = nil
+			continue
+		}
+		if match := schemaColumnPattern.FindStringSubmatch(line); match != nil {
+			current[match[1]] = true
+		}
+	}
+	return tables
+}
+
+func TestDataExportSectionQueriesMatchSchema(t *testing.T) {
+	t.Parallel()
+
+	schema := loadSchemaColumns(t)
+
+	for section, sectionQuery := range dataExportSectionQueries {
+		section, sectionQuery := section, sectionQuery
+		t.Run(string(section), func(t *testing.T) {
+			t.Parallel()
+
+			query := sectionQuery.query
+			if got := strings.Count(query, "?"); got != sectionQuery.params {
+				t.Fatalf("section %s has %d placeholders, expected %d", section, got, sectionQuery.params)
+			}
+
+			aliases := make(map[string]string)
+			var primary string
+			for _, match := range queryTablePattern.FindAllStringSubmatch(query, -1) {
+				table := match[1]
+				if _, ok := schema[table]; !ok {
+					t.Fatalf("section %s references unknown table %q", section, table)
+				}
+				if primary == "" {
+					primary = table
+				}
+				aliases[table] = table
+				if alias := match[2]; alias != "" && !dataExportQueryKeywords[strings.ToUpper(alias)] {
+					aliases[alias] = table
+				}
+			}
+			if len(aliases) == 0 {
+				t.Fatalf("section %s has no FROM table", section)
+			}
+
+			tokens := queryTokenPattern.FindAllString(query, -1)
+			for i, token := range tokens {
+				if strings.HasPrefix(token, "'") || dataExportQueryKeywords[strings.ToUpper(token)] {
+					continue
+				}
+				if _, isAlias := aliases[token]; isAlias {
+					continue
+				}
+				if i > 0 && strings.EqualFold(tokens[i-1], "AS") {
+					continue
+				}
+
+				table, column := primary, token
+				if qualifier, name, qualified := strings.Cut(token, "."); qualified {
+					resolved, ok := aliases[qualifier]
+					if !ok {
+						t.Fatalf("section %s uses unknown alias %q", section, qualifier)
+					}
+					table, column = resolved, name
+				} else if len(aliases) > 2 {
+					t.Fatalf("section %s has unqualified column %q in a join", section, token)
+				}
+
+				if !schema[table][column] {
+					t.Fatalf("section %s selects %s.%s, which does not exist in db_creation.sql", section, table, column)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package mysqluseradapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	userconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/user/converters"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ListUserRolesPendingGrantTransition returns time-bound grants whose stored grant_state is stale
+//
+// A grant needs a transition when:
+//   - It is SCHEDULED (grant_state = 1) and valid_from has been reached → becomes EFFECTIVE
+//   - It is not EXPIRED (grant_state <> 2) and expires_at has been reached → becomes EXPIRED
+//
+// Permission queries already filter by valid_from/expires_at, so this list is only used by the
+// role grant scheduler to persist the new state, invalidate caches and audit the flip.
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (can be nil for read-only queries)
+//   - limit: Maximum rows returned per batch (must be > 0)
+//
+// Returns:
+//   - userRoles: Slice of UserRoleInterface ordered by id (empty slice if none)
+//   - error: Database errors, scan errors
+//
+// Performance:
+//   - Uses idx_user_roles_grant_state_valid_from and idx_user_roles_expires
+func (ua *UserAdapter) ListUserRolesPendingGrantTransition(ctx context.Context, tx *sql.Tx, limit int) ([]usermodel.UserRoleInterface, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `
+		SELECT id, user_id, role_id, is_active, status, expires_at, valid_from, grant_state, expiry_notified_at
+		FROM user_roles
+		WHERE (grant_state = ? AND (valid_from IS NULL OR valid_from <= NOW()))
+		   OR (grant_state <> ? AND expires_at IS NOT NULL AND expires_at <= NOW())
+		ORDER BY id ASC
+		LIMIT ?
+	`
+
+	rows, queryErr := ua.QueryContext(ctx, tx, "select", query,
+		uint8(usermodel.RoleGrantStateScheduled),
+		uint8(usermodel.RoleGrantStateExpired),
+		limit,
+	)
+	if queryErr != nil {
+		utils.SetSpanError(ctx, queryErr)
+		logger.Error("mysql.user.list_user_roles_pending_grant_transition.query_error", "error", queryErr)
+		return nil, fmt.Errorf("query user roles pending grant transition: %w", queryErr)
+	}
+	defer rows.Close()
+
+	entities, scanErr := scanUserRoleEntities(rows)
+	if scanErr != nil {
+		utils.SetSpanError(ctx, scanErr)
+		logger.Error("mysql.user.list_user_roles_pending_grant_transition.scan_error", "error", scanErr)
+		return nil, fmt.Errorf("scan user roles pending grant transition: %w", scanErr)
+	}
+
+	userRoles := make([]usermodel.UserRoleInterface, 0, len(entities))
+	for i := range entities {
+		userRole, convertErr := userconverters.UserRoleEntityToDomain(&entities[i])
+		if convertErr != nil {
+			utils.SetSpanError(ctx, convertErr)
+			logger.Error("mysql.user.list_user_roles_pending_grant_transition.convert_error", "error", convertErr)
+			return nil, fmt.Errorf("convert user role entity to domain: %w", convertErr)
+		}
+		userRoles = append(userRoles, userRole)
+	}
+
+	logger.Debug("mysql.user.list_user_roles_pending_grant_transition.success", "count", len(userRoles))
+	return userRoles, nil
+}
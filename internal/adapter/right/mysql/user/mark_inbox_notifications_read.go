@@ -0,0 +1,107 @@
+package mysqluseradapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// MarkInboxNotificationRead sets read_at on one inbox entry owned by the user
+//
+// Already-read entries keep their original read_at and are treated as success.
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (REQUIRED)
+//   - userID: Owner of the entry (entries of other users are never touched)
+//   - notificationID: user_notifications.id
+//   - readAt: Timestamp stored in read_at
+//
+// Returns:
+//   - error: sql.ErrNoRows if the entry does not exist for this user, or database errors
+func (ua *UserAdapter) MarkInboxNotificationRead(ctx context.Context, tx *sql.Tx, userID, notificationID int64, readAt time.Time) error {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `UPDATE user_notifications SET read_at = ? WHERE id = ? AND user_id = ? AND read_at IS NULL`
+
+	result, execErr := ua.ExecContext(ctx, tx, "update", query, readAt, notificationID, userID)
+	if execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.user.mark_inbox_notification_read.exec_error", "user_id", userID, "notification_id", notificationID, "error", execErr)
+		return fmt.Errorf("mark inbox notification read: %w", execErr)
+	}
+
+	rowsAffected, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		utils.SetSpanError(ctx, rowsErr)
+		logger.Error("mysql.user.mark_inbox_notification_read.rows_affected_error", "user_id", userID, "notification_id", notificationID, "error", rowsErr)
+		return fmt.Errorf("mark inbox notification read rows affected: %w", rowsErr)
+	}
+	if rowsAffected > 0 {
+		return nil
+	}
+
+	// Nothing updated: either already read (success) or not owned by the user (not found)
+	var exists int
+	existsQuery := `SELECT 1 FROM user_notifications WHERE id = ? AND user_id = ? LIMIT 1`
+	if scanErr := ua.QueryRowContext(ctx, tx, "select", existsQuery, notificationID, userID).Scan(&exists); scanErr != nil {
+		if scanErr == sql.ErrNoRows {
+			return sql.ErrNoRows
+		}
+		utils.SetSpanError(ctx, scanErr)
+		logger.Error("mysql.user.mark_inbox_notification_read.exists_error", "user_id", userID, "notification_id", notificationID, "error", scanErr)
+		return fmt.Errorf("check inbox notification: %w", scanErr)
+	}
+
+	return nil
+}
+
+// MarkAllInboxNotificationsRead sets read_at on every unread inbox entry of the user
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (REQUIRED)
+//   - userID: users.id
+//   - readAt: Timestamp stored in read_at
+//
+// Returns:
+//   - updated: Number of entries marked as read (0 when nothing was unread)
+//   - error: Database errors
+func (ua *UserAdapter) MarkAllInboxNotificationsRead(ctx context.Context, tx *sql.Tx, userID int64, readAt time.Time) (int64, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `UPDATE user_notifications SET read_at = ? WHERE user_id = ? AND read_at IS NULL`
+
+	result, execErr := ua.ExecContext(ctx, tx, "update", query, readAt, userID)
+	if execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.user.mark_all_inbox_notifications_read.exec_error", "user_id", userID, "error", execErr)
+		return 0, fmt.Errorf("mark all inbox notifications read: %w", execErr)
+	}
+
+	updated, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		utils.SetSpanError(ctx, rowsErr)
+		logger.Error("mysql.user.mark_all_inbox_notifications_read.rows_affected_error", "user_id", userID, "error", rowsErr)
+		return 0, fmt.Errorf("mark all inbox notifications read rows affected: %w", rowsErr)
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,56 @@
+package mysqluseradapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// MarkUserRoleExpiryNotified records that the pre-expiration notice was sent for a grant
+//
+// The scheduler only notifies grants with expiry_notified_at IS NULL, so this marker makes
+// notices idempotent across worker runs and instances.
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (REQUIRED)
+//   - userRoleID: user_roles.id
+//   - notifiedAt: Timestamp stored in expiry_notified_at
+//
+// Returns:
+//   - error: sql.ErrNoRows if user_role not found, or database errors
+func (ua *UserAdapter) MarkUserRoleExpiryNotified(ctx context.Context, tx *sql.Tx, userRoleID int64, notifiedAt time.Time) error {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `UPDATE user_roles SET expiry_notified_at = ? WHERE id = ?`
+
+	result, execErr := ua.ExecContext(ctx, tx, "update", query, notifiedAt, userRoleID)
+	if execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.user.mark_user_role_expiry_notified.exec_error", "user_role_id", userRoleID, "error", execErr)
+		return fmt.Errorf("mark user role expiry notified: %w", execErr)
+	}
+
+	rowsAffected, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		utils.SetSpanError(ctx, rowsErr)
+		logger.Error("mysql.user.mark_user_role_expiry_notified.rows_affected_error", "user_role_id", userRoleID, "error", rowsErr)
+		return fmt.Errorf("mark user role expiry notified rows affected: %w", rowsErr)
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	logger.Debug("mysql.user.mark_user_role_expiry_notified.success", "user_role_id", userRoleID)
+	return nil
+}
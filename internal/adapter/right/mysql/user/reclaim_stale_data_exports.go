@@ -0,0 +1,92 @@
+package mysqluseradapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// staleDataExportMessage is stored on jobs that exhausted their attempts while stuck in PROCESSING.
+const staleDataExportMessage = "processing timed out"
+
+// ReclaimStaleDataExports releases PROCESSING jobs whose worker stopped before finishing
+//
+// A job is stale when its current attempt started before staleBefore. Stale jobs with fewer
+// than maxAttempts attempts go back to PENDING to be claimed again; the others become FAILED.
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (REQUIRED - runs in the claim transaction)
+//   - staleBefore: Attempts started before this instant are considered abandoned
+//   - maxAttempts: Attempts allowed before the job is failed (must be > 0)
+//
+// Returns:
+//   - requeued: Jobs moved back to PENDING
+//   - failed: Jobs moved to FAILED
+//   - error: Database errors
+//
+// Performance:
+//   - Uses idx_user_data_exports_started (status, started_at)
+func (ua *UserAdapter) ReclaimStaleDataExports(ctx context.Context, tx *sql.Tx, staleBefore time.Time, maxAttempts int) (requeued, failed int64, err error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	failQuery := `
+		UPDATE user_data_exports
+		SET status = ?, error_message = ?, completed_at = ?
+		WHERE status = ? AND started_at < ? AND attempts >= ?
+	`
+	result, execErr := ua.ExecContext(ctx, tx, "update", failQuery,
+		string(usermodel.DataExportStatusFailed),
+		staleDataExportMessage,
+		time.Now().UTC(),
+		string(usermodel.DataExportStatusProcessing),
+		staleBefore,
+		maxAttempts,
+	)
+	if execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.user.reclaim_stale_data_exports.fail_exec_error", "error", execErr)
+		return 0, 0, fmt.Errorf("fail stale user data exports: %w", execErr)
+	}
+	if failed, err = result.RowsAffected(); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.user.reclaim_stale_data_exports.fail_rows_affected_error", "error", err)
+		return 0, 0, fmt.Errorf("fail stale user data exports rows affected: %w", err)
+	}
+
+	requeueQuery := `
+		UPDATE user_data_exports
+		SET status = ?
+		WHERE status = ? AND started_at < ? AND attempts < ?
+	`
+	result, execErr = ua.ExecContext(ctx, tx, "update", requeueQuery,
+		string(usermodel.DataExportStatusPending),
+		string(usermodel.DataExportStatusProcessing),
+		staleBefore,
+		maxAttempts,
+	)
+	if execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.user.reclaim_stale_data_exports.requeue_exec_error", "error", execErr)
+		return 0, 0, fmt.Errorf("requeue stale user data exports: %w", execErr)
+	}
+	if requeued, err = result.RowsAffected(); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.user.reclaim_stale_data_exports.requeue_rows_affected_error", "error", err)
+		return 0, 0, fmt.Errorf("requeue stale user data exports rows affected: %w", err)
+	}
+
+	logger.Debug("mysql.user.reclaim_stale_data_exports.success", "requeued", requeued, "failed", failed)
+	return requeued, failed, nil
+}
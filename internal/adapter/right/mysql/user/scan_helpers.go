@@ -172,7 +172,7 @@ func scanUserWithRoleEntities(rows *sql.Rows) ([]userentity.UserWithRoleEntity,
 // scanUserRoleWithRoleEntities scans multiple rows from a JOIN query (user_roles + roles)
 // into strongly-typed entities with embedded role data.
 //
-// This function handles scanning of 12 columns from the JOIN query, mapping each column
+// This function handles scanning of 15 columns from the JOIN query, mapping each column
 // to the appropriate entity field with proper NULL handling.
 //
 // Used By:
@@ -188,21 +188,24 @@ func scanUserWithRoleEntities(rows *sql.Rows) ([]userentity.UserWithRoleEntity,
 //
 // Column Order (MUST match query SELECT order exactly):
 //
-//	Columns 1-6: UserRole fields (user_roles table)
+//	Columns 1-9: UserRole fields (user_roles table)
 //	 1. ur.id (INT)
 //	 2. ur.user_id (INT)
 //	 3. ur.role_id (INT)
 //	 4. ur.is_active (TINYINT)
 //	 5. ur.status (TINYINT)
 //	 6. ur.expires_at (TIMESTAMP, nullable)
+//	 7. ur.valid_from (TIMESTAMP, nullable)
+//	 8. ur.grant_state (TINYINT)
+//	 9. ur.expiry_notified_at (TIMESTAMP, nullable)
 //
-//	Columns 7-12: Role fields (roles table)
-//	 7. r.id (INT)
-//	 8. r.slug (VARCHAR)
-//	 9. r.name (VARCHAR)
-//	10. r.description (TEXT, nullable)
-//	11. r.is_system_role (TINYINT)
-//	12. r.is_active (TINYINT)
+//	Columns 10-15: Role fields (roles table)
+//	10. r.id (INT)
+//	11. r.slug (VARCHAR)
+//	12. r.name (VARCHAR)
+//	13. r.description (TEXT, nullable)
+//	14. r.is_system_role (TINYINT)
+//	15. r.is_active (TINYINT)
 //
 // Performance:
 //   - Single Scan() call per row (efficient memory usage)
@@ -213,6 +216,7 @@ func scanUserWithRoleEntities(rows *sql.Rows) ([]userentity.UserWithRoleEntity,
 //
 //	query := `SELECT
 //	    ur.id, ur.user_id, ur.role_id, ur.is_active, ur.status, ur.expires_at,
+//	    ur.valid_from, ur.grant_state, ur.expiry_notified_at,
 //	    r.id, r.slug, r.name, r.description, r.is_system_role, r.is_active
 //	FROM user_roles ur
 //	JOIN roles r ON r.id = ur.role_id
@@ -234,6 +238,9 @@ func scanUserRoleWithRoleEntities(rows *sql.Rows) ([]userentity.UserRoleEntity,
 			isActiveInt int64
 			status      int64
 			expiresAt   sql.NullTime
+			validFrom   sql.NullTime
+			grantState  uint8
+			notifiedAt  sql.NullTime
 
 			// Role fields
 			rID          int64
@@ -244,10 +251,11 @@ func scanUserRoleWithRoleEntities(rows *sql.Rows) ([]userentity.UserRoleEntity,
 			rIsActiveInt int64
 		)
 
-		// Scan all 12 columns from JOIN query
+		// Scan all 15 columns from JOIN query
 		err := rows.Scan(
-			// UserRole fields (6 columns)
+			// UserRole fields (9 columns)
 			&id, &userID, &roleID, &isActiveInt, &status, &expiresAt,
+			&validFrom, &grantState, &notifiedAt,
 			// Role fields (6 columns)
 			&rID, &rSlug, &rName, &rDescription, &rIsSystemInt, &rIsActiveInt,
 		)
@@ -257,11 +265,14 @@ func scanUserRoleWithRoleEntities(rows *sql.Rows) ([]userentity.UserRoleEntity,
 
 		// Build UserRoleEntity
 		userRoleEntity := userentity.UserRoleEntity{
-			ID:       uint32(id),
-			UserID:   uint32(userID),
-			RoleID:   uint32(roleID),
-			IsActive: isActiveInt == 1,
-			Status:   int8(status),
+			ID:               uint32(id),
+			UserID:           uint32(userID),
+			RoleID:           uint32(roleID),
+			IsActive:         isActiveInt == 1,
+			Status:           int8(status),
+			ValidFrom:        validFrom,
+			GrantState:       grantState,
+			ExpiryNotifiedAt: notifiedAt,
 		}
 		if expiresAt.Valid {
 			userRoleEntity.ExpiresAt = expiresAt
@@ -293,3 +304,40 @@ func scanUserRoleWithRoleEntities(rows *sql.Rows) ([]userentity.UserRoleEntity,
 
 	return userRoleEntities, roleEntities, nil
 }
+
+// scanUserRoleEntities scans rows from queries that select only user_roles columns.
+//
+// Used By:
+//   - ListUserRolesPendingGrantTransition (internal/adapter/right/mysql/user/list_user_roles_pending_grant_transition.go)
+//
+// Column Order (MUST match query SELECT order exactly):
+//
+//  1. id, 2. user_id, 3. role_id, 4. is_active, 5. status, 6. expires_at (nullable),
+//  7. valid_from (nullable), 8. grant_state, 9. expiry_notified_at (nullable)
+func scanUserRoleEntities(rows *sql.Rows) ([]userentity.UserRoleEntity, error) {
+	var entities []userentity.UserRoleEntity
+
+	for rows.Next() {
+		var entity userentity.UserRoleEntity
+		if err := rows.Scan(
+			&entity.ID,
+			&entity.UserID,
+			&entity.RoleID,
+			&entity.IsActive,
+			&entity.Status,
+			&entity.ExpiresAt,
+			&entity.ValidFrom,
+			&entity.GrantState,
+			&entity.ExpiryNotifiedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan user role entity: %w", err)
+		}
+		entities = append(entities, entity)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return entities, nil
+}
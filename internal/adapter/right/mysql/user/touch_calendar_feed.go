@@ -0,0 +1,41 @@
+package mysqluseradapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// TouchCalendarFeed records the last time a calendar client fetched the feed
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (can be nil for standalone operation)
+//   - userID: users.id
+//   - accessedAt: fetch time (UTC)
+//
+// Returns:
+//   - error: Database errors; a missing feed is not an error (0 rows updated)
+func (ua *UserAdapter) TouchCalendarFeed(ctx context.Context, tx *sql.Tx, userID int64, accessedAt time.Time) error {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `UPDATE user_calendar_feeds SET last_accessed_at = ? WHERE user_id = ?`
+
+	if _, execErr := ua.ExecContext(ctx, tx, "update", query, accessedAt, userID); execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.user.touch_calendar_feed.exec_error", "user_id", userID, "error", execErr)
+		return fmt.Errorf("touch calendar feed: %w", execErr)
+	}
+
+	return nil
+}
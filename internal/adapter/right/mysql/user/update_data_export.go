@@ -0,0 +1,68 @@
+package mysqluseradapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	userconverters "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/user/converters"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// UpdateDataExport persists status, object key, error message, attempts and timestamps of an export job
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (REQUIRED)
+//   - export: Job with ID populated
+//
+// Returns:
+//   - error: sql.ErrNoRows if the job does not exist, or database errors
+func (ua *UserAdapter) UpdateDataExport(ctx context.Context, tx *sql.Tx, export usermodel.DataExportInterface) error {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	entity := userconverters.DataExportDomainToEntity(export)
+
+	query := `
+		UPDATE user_data_exports
+		SET status = ?, object_key = ?, error_message = ?, attempts = ?, started_at = ?, completed_at = ?, expires_at = ?
+		WHERE id = ?
+	`
+
+	result, execErr := ua.ExecContext(ctx, tx, "update", query,
+		entity.Status,
+		entity.ObjectKey,
+		entity.ErrorMessage,
+		entity.Attempts,
+		entity.StartedAt,
+		entity.CompletedAt,
+		entity.ExpiresAt,
+		entity.ID,
+	)
+	if execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.user.update_data_export.exec_error", "export_id", entity.ID, "error", execErr)
+		return fmt.Errorf("update user data export: %w", execErr)
+	}
+
+	rowsAffected, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		utils.SetSpanError(ctx, rowsErr)
+		logger.Error("mysql.user.update_data_export.rows_affected_error", "export_id", entity.ID, "error", rowsErr)
+		return fmt.Errorf("update user data export rows affected: %w", rowsErr)
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	logger.Debug("mysql.user.update_data_export.success", "export_id", entity.ID, "status", entity.Status)
+	return nil
+}
@@ -0,0 +1,53 @@
+package mysqluseradapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// UpdateUserRoleGrantState persists the scheduler state of a time-bound grant
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (REQUIRED - state flip is audited in the same transaction)
+//   - userRoleID: user_roles.id
+//   - state: New RoleGrantState
+//
+// Returns:
+//   - error: sql.ErrNoRows if user_role not found, or database errors
+func (ua *UserAdapter) UpdateUserRoleGrantState(ctx context.Context, tx *sql.Tx, userRoleID int64, state usermodel.RoleGrantState) error {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `UPDATE user_roles SET grant_state = ? WHERE id = ?`
+
+	result, execErr := ua.ExecContext(ctx, tx, "update", query, uint8(state), userRoleID)
+	if execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.user.update_user_role_grant_state.exec_error", "user_role_id", userRoleID, "error", execErr)
+		return fmt.Errorf("update user role grant state: %w", execErr)
+	}
+
+	rowsAffected, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		utils.SetSpanError(ctx, rowsErr)
+		logger.Error("mysql.user.update_user_role_grant_state.rows_affected_error", "user_role_id", userRoleID, "error", rowsErr)
+		return fmt.Errorf("update user role grant state rows affected: %w", rowsErr)
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	logger.Debug("mysql.user.update_user_role_grant_state.success", "user_role_id", userRoleID, "grant_state", state.String())
+	return nil
+}
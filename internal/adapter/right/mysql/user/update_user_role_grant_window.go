@@ -0,0 +1,67 @@
+package mysqluseradapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// UpdateUserRoleGrantWindow rewrites the validity window of a role grant
+//
+// Used when an admin grants, extends or shortens a time-bound role. The expiry notice
+// marker is cleared so the scheduler notifies the user again before the new expiration.
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (REQUIRED - window change is audited in the same transaction)
+//   - userRoleID: user_roles.id
+//   - validFrom: New start of the grant (nil = effective immediately)
+//   - expiresAt: New end of the grant (nil = no expiration)
+//   - state: RoleGrantState matching the new window
+//
+// Returns:
+//   - error: sql.ErrNoRows if user_role not found, or database errors
+func (ua *UserAdapter) UpdateUserRoleGrantWindow(ctx context.Context, tx *sql.Tx, userRoleID int64, validFrom, expiresAt *time.Time, state usermodel.RoleGrantState) error {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `UPDATE user_roles SET valid_from = ?, expires_at = ?, grant_state = ?, expiry_notified_at = NULL WHERE id = ?`
+
+	var validFromValue, expiresAtValue sql.NullTime
+	if validFrom != nil {
+		validFromValue = sql.NullTime{Time: *validFrom, Valid: true}
+	}
+	if expiresAt != nil {
+		expiresAtValue = sql.NullTime{Time: *expiresAt, Valid: true}
+	}
+
+	result, execErr := ua.ExecContext(ctx, tx, "update", query, validFromValue, expiresAtValue, uint8(state), userRoleID)
+	if execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.user.update_user_role_grant_window.exec_error", "user_role_id", userRoleID, "error", execErr)
+		return fmt.Errorf("update user role grant window: %w", execErr)
+	}
+
+	rowsAffected, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		utils.SetSpanError(ctx, rowsErr)
+		logger.Error("mysql.user.update_user_role_grant_window.rows_affected_error", "user_role_id", userRoleID, "error", rowsErr)
+		return fmt.Errorf("update user role grant window rows affected: %w", rowsErr)
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	logger.Debug("mysql.user.update_user_role_grant_window.success", "user_role_id", userRoleID, "grant_state", state.String())
+	return nil
+}
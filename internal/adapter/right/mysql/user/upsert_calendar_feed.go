@@ -0,0 +1,50 @@
+package mysqluseradapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// UpsertCalendarFeed stores a new calendar feed token hash for the user, replacing the previous one
+//
+// Uses INSERT ... ON DUPLICATE KEY UPDATE on the user_id primary key; created_at is reset and
+// last_accessed_at cleared so the feed status reflects the new URL only.
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (REQUIRED)
+//   - userID: users.id
+//   - tokenHash: hex encoded SHA-256 of the feed token
+//
+// Returns:
+//   - error: Database errors (FK violation if the user does not exist)
+func (ua *UserAdapter) UpsertCalendarFeed(ctx context.Context, tx *sql.Tx, userID int64, tokenHash string) error {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `
+		INSERT INTO user_calendar_feeds (user_id, token_hash)
+		VALUES (?, ?)
+		ON DUPLICATE KEY UPDATE
+			token_hash = VALUES(token_hash),
+			created_at = CURRENT_TIMESTAMP(6),
+			last_accessed_at = NULL
+	`
+
+	if _, execErr := ua.ExecContext(ctx, tx, "insert", query, userID, tokenHash); execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.user.upsert_calendar_feed.exec_error", "user_id", userID, "error", execErr)
+		return fmt.Errorf("upsert calendar feed: %w", execErr)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package mysqluseradapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// UpsertNotificationConsent records the user's consent for one channel/category
+//
+// Uses INSERT ... ON DUPLICATE KEY UPDATE on uk_user_notification_consents, so the row always
+// reflects the latest choice; updated_at is refreshed by the database.
+//
+// Parameters:
+//   - ctx: Context for tracing, cancellation, and logging
+//   - tx: Database transaction (REQUIRED)
+//   - consent: Consent with user, channel, category, granted flag, policy version and source
+//
+// Returns:
+//   - error: Database errors (FK violation if the user does not exist)
+func (ua *UserAdapter) UpsertNotificationConsent(ctx context.Context, tx *sql.Tx, consent usermodel.NotificationConsent) error {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := `
+		INSERT INTO user_notification_consents (user_id, channel, category, granted, policy_version, source)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			granted = VALUES(granted),
+			policy_version = VALUES(policy_version),
+			source = VALUES(source),
+			updated_at = CURRENT_TIMESTAMP(6)
+	`
+
+	_, execErr := ua.ExecContext(ctx, tx, "insert", query,
+		consent.UserID,
+		string(consent.Channel),
+		string(consent.Category),
+		consent.Granted,
+		consent.PolicyVersion,
+		consent.Source,
+	)
+	if execErr != nil {
+		utils.SetSpanError(ctx, execErr)
+		logger.Error("mysql.user.upsert_notification_consent.exec_error", "user_id", consent.UserID, "channel", consent.Channel, "category", consent.Category, "error", execErr)
+		return fmt.Errorf("upsert notification consent: %w", execErr)
+	}
+
+	logger.Debug("mysql.user.upsert_notification_consent.success", "user_id", consent.UserID, "channel", consent.Channel, "category", consent.Category, "granted", consent.Granted)
+	return nil
+}
@@ -0,0 +1,116 @@
+package converters
+
+import (
+	"database/sql"
+
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/visit/entities"
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+)
+
+// ToOpenHouseEntity converts a domain OpenHouseInterface to a database OpenHouseEntity.
+func ToOpenHouseEntity(model listingmodel.OpenHouseInterface) entities.OpenHouseEntity {
+	entity := entities.OpenHouseEntity{
+		ID:                model.ID(),
+		ListingIdentityID: model.ListingIdentityID(),
+		OwnerUserID:       model.OwnerUserID(),
+		StartsAt:          model.StartsAt(),
+		EndsAt:            model.EndsAt(),
+		Capacity:          model.Capacity(),
+		SlotMinutes:       model.SlotMinutes(),
+		Status:            string(model.Status()),
+		CreatedAt:         model.CreatedAt(),
+		UpdatedAt:         model.UpdatedAt(),
+	}
+
+	if value, ok := model.AgendaEntryID(); ok {
+		entity.AgendaEntryID = sql.NullInt64{Int64: int64(value), Valid: true}
+	}
+
+	if value, ok := model.Notes(); ok {
+		entity.Notes = sql.NullString{String: value, Valid: true}
+	}
+
+	if value, ok := model.CancellationReason(); ok {
+		entity.CancellationReason = sql.NullString{String: value, Valid: true}
+	}
+
+	return entity
+}
+
+// ToOpenHouseModel converts a database OpenHouseEntity to a domain OpenHouseInterface.
+func ToOpenHouseModel(e entities.OpenHouseEntity) listingmodel.OpenHouseInterface {
+	openHouse := listingmodel.NewOpenHouse()
+
+	openHouse.SetID(e.ID)
+	openHouse.SetListingIdentityID(e.ListingIdentityID)
+	openHouse.SetOwnerUserID(e.OwnerUserID)
+	openHouse.SetStartsAt(e.StartsAt)
+	openHouse.SetEndsAt(e.EndsAt)
+	openHouse.SetCapacity(e.Capacity)
+	openHouse.SetSlotMinutes(e.SlotMinutes)
+	openHouse.SetStatus(listingmodel.OpenHouseStatus(e.Status))
+	openHouse.SetCreatedAt(e.CreatedAt)
+	openHouse.SetUpdatedAt(e.UpdatedAt)
+
+	if e.AgendaEntryID.Valid {
+		openHouse.SetAgendaEntryID(uint64(e.AgendaEntryID.Int64))
+	}
+
+	if e.Notes.Valid {
+		openHouse.SetNotes(e.Notes.String)
+	}
+
+	if e.CancellationReason.Valid {
+		openHouse.SetCancellationReason(e.CancellationReason.String)
+	}
+
+	return openHouse
+}
+
+// ToOpenHouseRegistrationEntity converts a domain registration to a database entity.
+func ToOpenHouseRegistrationEntity(model listingmodel.OpenHouseRegistrationInterface) entities.OpenHouseRegistrationEntity {
+	entity := entities.OpenHouseRegistrationEntity{
+		ID:            model.ID(),
+		OpenHouseID:   model.OpenHouseID(),
+		RealtorUserID: model.RealtorUserID(),
+		ClientName:    model.ClientName(),
+		SlotStart:     model.SlotStart(),
+		Status:        string(model.Status()),
+		CreatedAt:     model.CreatedAt(),
+		UpdatedAt:     model.UpdatedAt(),
+	}
+
+	if value, ok := model.ClientPhone(); ok {
+		entity.ClientPhone = sql.NullString{String: value, Valid: true}
+	}
+
+	if value, ok := model.CheckedInAt(); ok {
+		entity.CheckedInAt = sql.NullTime{Time: value, Valid: true}
+	}
+
+	return entity
+}
+
+// ToOpenHouseRegistrationModel converts a database entity to a domain registration.
+func ToOpenHouseRegistrationModel(e entities.OpenHouseRegistrationEntity) listingmodel.OpenHouseRegistrationInterface {
+	registration := listingmodel.NewOpenHouseRegistration()
+
+	registration.SetID(e.ID)
+	registration.SetOpenHouseID(e.OpenHouseID)
+	registration.SetRealtorUserID(e.RealtorUserID)
+	registration.SetClientName(e.ClientName)
+	registration.SetSlotStart(e.SlotStart)
+	registration.SetStatus(listingmodel.OpenHouseRegistrationStatus(e.Status))
+	registration.SetCreatedAt(e.CreatedAt)
+	registration.SetUpdatedAt(e.UpdatedAt)
+
+	if e.ClientPhone.Valid {
+		registration.SetClientPhone(e.ClientPhone.String)
+	}
+
+	if e.CheckedInAt.Valid {
+		registration.SetCheckedInAt(e.CheckedInAt.Time)
+	}
+
+	return registration
+}
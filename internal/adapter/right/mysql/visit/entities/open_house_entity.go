@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"database/sql"
+	"time"
+)
+
+// OpenHouseEntity represents a row from the listing_open_houses table.
+//
+// Schema mapping:
+//   - Primary Key: id (INT UNSIGNED AUTO_INCREMENT)
+//   - Foreign Keys: listing_identity_id → listing_identities(id), owner_id → users(id)
+//   - agenda_entry_id references the blocking OPEN_HOUSE entry in listing_agenda_entries (NULL once cancelled)
+//   - Status: ENUM('SCHEDULED','CANCELLED')
+//
+// Usage rules:
+//   - Adapter layer only; convert via converters.ToOpenHouseEntity/ToOpenHouseModel
+//   - Keep field order aligned with openHouseColumns
+type OpenHouseEntity struct {
+	ID                 int64
+	ListingIdentityID  int64
+	OwnerUserID        int64
+	AgendaEntryID      sql.NullInt64
+	StartsAt           time.Time
+	EndsAt             time.Time
+	Capacity           uint16
+	SlotMinutes        uint16
+	Status             string
+	Notes              sql.NullString
+	CancellationReason sql.NullString
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// OpenHouseRegistrationEntity represents a row from the listing_open_house_registrations table.
+//
+// Schema mapping:
+//   - Primary Key: id (INT UNSIGNED AUTO_INCREMENT)
+//   - Foreign Keys: open_house_id → listing_open_houses(id), realtor_id → users(id)
+//   - Status: ENUM('REGISTERED','WAITLISTED','CANCELLED','CHECKED_IN','NO_SHOW')
+//   - created_at keeps microseconds so waitlist order is stable
+//
+// Usage rules:
+//   - Adapter layer only; keep field order aligned with openHouseRegistrationColumns
+type OpenHouseRegistrationEntity struct {
+	ID            int64
+	OpenHouseID   int64
+	RealtorUserID int64
+	ClientName    string
+	ClientPhone   sql.NullString
+	SlotStart     time.Time
+	Status        string
+	CheckedInAt   sql.NullTime
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
@@ -0,0 +1,56 @@
+package mysqlvisitadapter
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/visit/converters"
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// GetOpenHouseByID retrieves an open house by primary key; returns sql.ErrNoRows if absent.
+func (a *VisitAdapter) GetOpenHouseByID(ctx context.Context, tx *sql.Tx, id int64) (listingmodel.OpenHouseInterface, error) {
+	return a.getOpenHouse(ctx, tx, id, false)
+}
+
+// GetOpenHouseByIDForUpdate retrieves an open house locking its row (SELECT ... FOR UPDATE).
+//
+// Registrations lock the parent open house so capacity checks and waitlist promotions are serialized.
+// Must run inside a write transaction; returns sql.ErrNoRows if absent.
+func (a *VisitAdapter) GetOpenHouseByIDForUpdate(ctx context.Context, tx *sql.Tx, id int64) (listingmodel.OpenHouseInterface, error) {
+	return a.getOpenHouse(ctx, tx, id, true)
+}
+
+func (a *VisitAdapter) getOpenHouse(ctx context.Context, tx *sql.Tx, id int64, forUpdate bool) (listingmodel.OpenHouseInterface, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := fmt.Sprintf(`SELECT %s FROM listing_open_houses oh WHERE oh.id = ?`, openHouseColumns)
+	operation := "get_open_house_by_id"
+	if forUpdate {
+		query += " FOR UPDATE"
+		operation = "get_open_house_for_update"
+	}
+
+	row := a.QueryRowContext(ctx, tx, operation, query, id)
+	entity, err := scanOpenHouseEntity(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.visit.open_house.get.scan_error", "open_house_id", id, "err", err)
+		return nil, fmt.Errorf("scan open house: %w", err)
+	}
+
+	return converters.ToOpenHouseModel(entity), nil
+}
@@ -0,0 +1,39 @@
+package mysqlvisitadapter
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/visit/converters"
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// GetOpenHouseRegistrationByID retrieves a registration by primary key; returns sql.ErrNoRows if absent.
+func (a *VisitAdapter) GetOpenHouseRegistrationByID(ctx context.Context, tx *sql.Tx, id int64) (listingmodel.OpenHouseRegistrationInterface, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := fmt.Sprintf(`SELECT %s FROM listing_open_house_registrations r WHERE r.id = ?`, openHouseRegistrationColumns)
+
+	row := a.QueryRowContext(ctx, tx, "get_open_house_registration_by_id", query, id)
+	entity, err := scanOpenHouseRegistrationEntity(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.visit.open_house_registration.get.scan_error", "registration_id", id, "err", err)
+		return nil, fmt.Errorf("scan open house registration: %w", err)
+	}
+
+	return converters.ToOpenHouseRegistrationModel(entity), nil
+}
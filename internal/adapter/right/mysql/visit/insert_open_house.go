@@ -0,0 +1,69 @@
+package mysqlvisitadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/visit/converters"
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// InsertOpenHouse creates a new row in listing_open_houses and returns its generated ID.
+//
+// Must run inside the transaction that also creates the blocking agenda entry. created_at/updated_at
+// rely on database defaults. The generated ID is set back on the provided model.
+func (a *VisitAdapter) InsertOpenHouse(ctx context.Context, tx *sql.Tx, openHouse listingmodel.OpenHouseInterface) (int64, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	entity := converters.ToOpenHouseEntity(openHouse)
+
+	query := `INSERT INTO listing_open_houses (
+		listing_identity_id,
+		owner_id,
+		agenda_entry_id,
+		starts_at,
+		ends_at,
+		capacity,
+		slot_minutes,
+		status,
+		notes,
+		cancellation_reason
+	) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := a.ExecContext(ctx, tx, "insert_open_house", query,
+		entity.ListingIdentityID,
+		entity.OwnerUserID,
+		entity.AgendaEntryID,
+		entity.StartsAt,
+		entity.EndsAt,
+		entity.Capacity,
+		entity.SlotMinutes,
+		entity.Status,
+		entity.Notes,
+		entity.CancellationReason,
+	)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.visit.open_house.insert.exec_error", "listing_identity_id", entity.ListingIdentityID, "err", err)
+		return 0, fmt.Errorf("insert open house: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.visit.open_house.insert.last_id_error", "listing_identity_id", entity.ListingIdentityID, "err", err)
+		return 0, fmt.Errorf("open house last insert id: %w", err)
+	}
+
+	openHouse.SetID(id)
+	return id, nil
+}
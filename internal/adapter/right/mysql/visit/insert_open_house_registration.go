@@ -0,0 +1,63 @@
+package mysqlvisitadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/visit/converters"
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// InsertOpenHouseRegistration creates a client registration and returns its generated ID.
+//
+// Must run inside the transaction holding the open house lock so capacity checks stay consistent.
+// created_at relies on the database default (microsecond precision keeps waitlist order stable).
+func (a *VisitAdapter) InsertOpenHouseRegistration(ctx context.Context, tx *sql.Tx, registration listingmodel.OpenHouseRegistrationInterface) (int64, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	entity := converters.ToOpenHouseRegistrationEntity(registration)
+
+	query := `INSERT INTO listing_open_house_registrations (
+		open_house_id,
+		realtor_id,
+		client_name,
+		client_phone,
+		slot_start,
+		status,
+		checked_in_at
+	) VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := a.ExecContext(ctx, tx, "insert_open_house_registration", query,
+		entity.OpenHouseID,
+		entity.RealtorUserID,
+		entity.ClientName,
+		entity.ClientPhone,
+		entity.SlotStart,
+		entity.Status,
+		entity.CheckedInAt,
+	)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.visit.open_house_registration.insert.exec_error", "open_house_id", entity.OpenHouseID, "err", err)
+		return 0, fmt.Errorf("insert open house registration: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.visit.open_house_registration.insert.last_id_error", "open_house_id", entity.OpenHouseID, "err", err)
+		return 0, fmt.Errorf("open house registration last insert id: %w", err)
+	}
+
+	registration.SetID(id)
+	return id, nil
+}
@@ -0,0 +1,60 @@
+package mysqlvisitadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/visit/converters"
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ListOpenHouseRegistrations returns every registration of an open house, optionally restricted to one realtor.
+//
+// Rows are ordered by slot_start, created_at and id, which is also the waitlist promotion order.
+func (a *VisitAdapter) ListOpenHouseRegistrations(ctx context.Context, tx *sql.Tx, openHouseID int64, realtorUserID *int64) ([]listingmodel.OpenHouseRegistrationInterface, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	query := fmt.Sprintf(`SELECT %s FROM listing_open_house_registrations r WHERE r.open_house_id = ?`, openHouseRegistrationColumns)
+	args := []any{openHouseID}
+	if realtorUserID != nil {
+		query += " AND r.realtor_id = ?"
+		args = append(args, *realtorUserID)
+	}
+	query += " ORDER BY r.slot_start ASC, r.created_at ASC, r.id ASC"
+
+	rows, err := a.QueryContext(ctx, tx, "list_open_house_registrations", query, args...)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.visit.open_house_registration.list.query_error", "open_house_id", openHouseID, "err", err)
+		return nil, fmt.Errorf("query open house registrations: %w", err)
+	}
+	defer rows.Close()
+
+	registrations := make([]listingmodel.OpenHouseRegistrationInterface, 0)
+	for rows.Next() {
+		entity, scanErr := scanOpenHouseRegistrationEntity(rows)
+		if scanErr != nil {
+			utils.SetSpanError(ctx, scanErr)
+			logger.Error("mysql.visit.open_house_registration.list.scan_error", "open_house_id", openHouseID, "err", scanErr)
+			return nil, fmt.Errorf("scan open house registration: %w", scanErr)
+		}
+		registrations = append(registrations, converters.ToOpenHouseRegistrationModel(entity))
+	}
+
+	if err = rows.Err(); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.visit.open_house_registration.list.rows_error", "open_house_id", openHouseID, "err", err)
+		return nil, fmt.Errorf("iterate open house registrations: %w", err)
+	}
+
+	return registrations, nil
+}
@@ -0,0 +1,119 @@
+package mysqlvisitadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/visit/converters"
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ListOpenHouses retrieves a paginated list of open houses with registration counters.
+//
+// Filters (all optional, combined with AND): listing identity, owner, statuses, From (window ends after)
+// and To (window starts before). Results are ordered by starts_at ASC; page size is capped at visitsMaxPageSize.
+func (a *VisitAdapter) ListOpenHouses(ctx context.Context, tx *sql.Tx, filter listingmodel.OpenHouseListFilter) (listingmodel.OpenHouseListResult, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return listingmodel.OpenHouseListResult{}, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	conditions := make([]string, 0)
+	args := make([]any, 0)
+
+	if filter.ListingIdentityID != nil {
+		conditions = append(conditions, "oh.listing_identity_id = ?")
+		args = append(args, *filter.ListingIdentityID)
+	}
+
+	if filter.OwnerUserID != nil {
+		conditions = append(conditions, "oh.owner_id = ?")
+		args = append(args, *filter.OwnerUserID)
+	}
+
+	if len(filter.Statuses) > 0 {
+		placeholders := make([]string, len(filter.Statuses))
+		for i, status := range filter.Statuses {
+			placeholders[i] = "?"
+			args = append(args, string(status))
+		}
+		conditions = append(conditions, fmt.Sprintf("oh.status IN (%s)", strings.Join(placeholders, ",")))
+	}
+
+	if filter.From != nil {
+		conditions = append(conditions, "oh.ends_at >= ?")
+		args = append(args, *filter.From)
+	}
+
+	if filter.To != nil {
+		conditions = append(conditions, "oh.starts_at <= ?")
+		args = append(args, *filter.To)
+	}
+
+	where := "1=1"
+	if len(conditions) > 0 {
+		where = strings.Join(conditions, " AND ")
+	}
+
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM listing_open_houses oh WHERE %s", where)
+	var total int64
+	if err = a.QueryRowContext(ctx, tx, "list_open_houses_count", countQuery, args...).Scan(&total); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.visit.open_house.list.count_error", "err", err)
+		return listingmodel.OpenHouseListResult{}, fmt.Errorf("count open houses: %w", err)
+	}
+
+	limit, offset := defaultPagination(filter.Limit, filter.Page, visitsMaxPageSize)
+
+	query := fmt.Sprintf(`
+		SELECT %s,
+			COALESCE(SUM(r.status IN ('REGISTERED', 'CHECKED_IN', 'NO_SHOW')), 0) AS registered_count,
+			COALESCE(SUM(r.status = 'WAITLISTED'), 0) AS waitlist_count,
+			COALESCE(SUM(r.status = 'CHECKED_IN'), 0) AS checked_in_count
+		FROM listing_open_houses oh
+		LEFT JOIN listing_open_house_registrations r ON r.open_house_id = oh.id
+		WHERE %s
+		GROUP BY oh.id
+		ORDER BY oh.starts_at ASC, oh.id ASC
+		LIMIT ? OFFSET ?
+	`, openHouseColumns, where)
+
+	params := append(make([]any, 0, len(args)+2), args...)
+	params = append(params, limit, offset)
+
+	rows, err := a.QueryContext(ctx, tx, "list_open_houses", query, params...)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.visit.open_house.list.query_error", "err", err)
+		return listingmodel.OpenHouseListResult{}, fmt.Errorf("query open houses: %w", err)
+	}
+	defer rows.Close()
+
+	openHouses := make([]listingmodel.OpenHouseWithCounts, 0)
+	for rows.Next() {
+		var item listingmodel.OpenHouseWithCounts
+		entity, scanErr := scanOpenHouseEntity(rows, &item.RegisteredCount, &item.WaitlistCount, &item.CheckedInCount)
+		if scanErr != nil {
+			utils.SetSpanError(ctx, scanErr)
+			logger.Error("mysql.visit.open_house.list.scan_error", "err", scanErr)
+			return listingmodel.OpenHouseListResult{}, fmt.Errorf("scan open house: %w", scanErr)
+		}
+		item.OpenHouse = converters.ToOpenHouseModel(entity)
+		openHouses = append(openHouses, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.visit.open_house.list.rows_error", "err", err)
+		return listingmodel.OpenHouseListResult{}, fmt.Errorf("iterate open houses: %w", err)
+	}
+
+	return listingmodel.OpenHouseListResult{OpenHouses: openHouses, Total: total}, nil
+}
@@ -0,0 +1,52 @@
+package mysqlvisitadapter
+
+import "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/visit/entities"
+
+const (
+	openHouseColumns             = `oh.id, oh.listing_identity_id, oh.owner_id, oh.agenda_entry_id, oh.starts_at, oh.ends_at, oh.capacity, oh.slot_minutes, oh.status, oh.notes, oh.cancellation_reason, oh.created_at, oh.updated_at`
+	openHouseRegistrationColumns = `r.id, r.open_house_id, r.realtor_id, r.client_name, r.client_phone, r.slot_start, r.status, r.checked_in_at, r.created_at, r.updated_at`
+)
+
+// scanOpenHouseEntity scans a row selected with openHouseColumns (column order MUST match).
+func scanOpenHouseEntity(scanner rowScanner, extra ...any) (entities.OpenHouseEntity, error) {
+	var entity entities.OpenHouseEntity
+	dest := []any{
+		&entity.ID,
+		&entity.ListingIdentityID,
+		&entity.OwnerUserID,
+		&entity.AgendaEntryID,
+		&entity.StartsAt,
+		&entity.EndsAt,
+		&entity.Capacity,
+		&entity.SlotMinutes,
+		&entity.Status,
+		&entity.Notes,
+		&entity.CancellationReason,
+		&entity.CreatedAt,
+		&entity.UpdatedAt,
+	}
+	if err := scanner.Scan(append(dest, extra...)...); err != nil {
+		return entities.OpenHouseEntity{}, err
+	}
+	return entity, nil
+}
+
+// scanOpenHouseRegistrationEntity scans a row selected with openHouseRegistrationColumns (column order MUST match).
+func scanOpenHouseRegistrationEntity(scanner rowScanner) (entities.OpenHouseRegistrationEntity, error) {
+	var entity entities.OpenHouseRegistrationEntity
+	if err := scanner.Scan(
+		&entity.ID,
+		&entity.OpenHouseID,
+		&entity.RealtorUserID,
+		&entity.ClientName,
+		&entity.ClientPhone,
+		&entity.SlotStart,
+		&entity.Status,
+		&entity.CheckedInAt,
+		&entity.CreatedAt,
+		&entity.UpdatedAt,
+	); err != nil {
+		return entities.OpenHouseRegistrationEntity{}, err
+	}
+	return entity, nil
+}
@@ -0,0 +1,60 @@
+package mysqlvisitadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/visit/converters"
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// UpdateOpenHouse updates the mutable fields of an open house (window, capacity, status, agenda link, notes).
+// Returns sql.ErrNoRows when the ID does not exist.
+func (a *VisitAdapter) UpdateOpenHouse(ctx context.Context, tx *sql.Tx, openHouse listingmodel.OpenHouseInterface) error {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	entity := converters.ToOpenHouseEntity(openHouse)
+
+	query := `UPDATE listing_open_houses
+		SET agenda_entry_id = ?, starts_at = ?, ends_at = ?, capacity = ?, slot_minutes = ?, status = ?, notes = ?, cancellation_reason = ?
+		WHERE id = ?`
+
+	result, err := a.ExecContext(ctx, tx, "update_open_house", query,
+		entity.AgendaEntryID,
+		entity.StartsAt,
+		entity.EndsAt,
+		entity.Capacity,
+		entity.SlotMinutes,
+		entity.Status,
+		entity.Notes,
+		entity.CancellationReason,
+		entity.ID,
+	)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.visit.open_house.update.exec_error", "open_house_id", entity.ID, "err", err)
+		return fmt.Errorf("update open house: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.visit.open_house.update.rows_error", "open_house_id", entity.ID, "err", err)
+		return fmt.Errorf("open house rows affected: %w", err)
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
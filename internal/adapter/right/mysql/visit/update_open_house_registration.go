@@ -0,0 +1,55 @@
+package mysqlvisitadapter
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/projeto-toq/toq_server/internal/adapter/right/mysql/visit/converters"
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// UpdateOpenHouseRegistration updates the status, slot and check-in timestamp of a registration.
+// Returns sql.ErrNoRows when the ID does not exist.
+func (a *VisitAdapter) UpdateOpenHouseRegistration(ctx context.Context, tx *sql.Tx, registration listingmodel.OpenHouseRegistrationInterface) error {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	entity := converters.ToOpenHouseRegistrationEntity(registration)
+
+	query := `UPDATE listing_open_house_registrations
+		SET slot_start = ?, status = ?, checked_in_at = ?
+		WHERE id = ?`
+
+	result, err := a.ExecContext(ctx, tx, "update_open_house_registration", query,
+		entity.SlotStart,
+		entity.Status,
+		entity.CheckedInAt,
+		entity.ID,
+	)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.visit.open_house_registration.update.exec_error", "registration_id", entity.ID, "err", err)
+		return fmt.Errorf("update open house registration: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("mysql.visit.open_house_registration.update.rows_error", "registration_id", entity.ID, "err", err)
+		return fmt.Errorf("open house registration rows affected: %w", err)
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -22,7 +22,7 @@ import (
 //   - Location: internal/core/port/right/repository/visit_repository/
 //
 // Database Table:
-//   - Name: listing_visits
+//   - Name: listing_visits (plus listing_open_houses and listing_open_house_registrations for group visits)
 //   - Engine: InnoDB (supports transactions)
 //   - Charset: utf8mb4_unicode_ci
 //   - Key columns: id (PK), listing_id (FK), owner_id, realtor_id
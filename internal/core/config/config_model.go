@@ -30,6 +30,7 @@ import (
 	smsport "github.com/projeto-toq/toq_server/internal/core/port/right/sms"
 	storageport "github.com/projeto-toq/toq_server/internal/core/port/right/storage"
 	auditservice "github.com/projeto-toq/toq_server/internal/core/service/audit_service"
+	calendarfeedservice "github.com/projeto-toq/toq_server/internal/core/service/calendar_feed_service"
 	globalservice "github.com/projeto-toq/toq_server/internal/core/service/global_service"
 	holidayservices "github.com/projeto-toq/toq_server/internal/core/service/holiday_service"
 	listingservices "github.com/projeto-toq/toq_server/internal/core/service/listing_service"
@@ -74,6 +75,7 @@ type config struct {
 	propertyCoverageService propertycoverageservice.PropertyCoverageServiceInterface
 	mediaProcessingService  mediaprocessingservice.MediaProcessingServiceInterface
 	visitService            visitservice.Service
+	calendarFeedService     calendarfeedservice.Service
 	auditService            auditservice.AuditServiceInterface
 	metricsAdapter          *factory.MetricsAdapter
 	cep                     cepport.CEPPortInterface
@@ -105,6 +107,7 @@ type ConfigInterface interface {
 	InitHolidayService()
 	InitScheduleService()
 	InitVisitService()
+	InitCalendarFeedService()
 	InitMediaProcessingService()
 	InitPropertyCoverageService()
 	InitProposalService()
@@ -286,6 +289,7 @@ func (c *config) initializeServices() {
 	c.InitListingHandler()
 	c.InitUserHandler()
 	c.InitVisitService()
+	c.InitCalendarFeedService()
 	c.InitProposalService()
 
 	slog.Info("All services initialized successfully")
@@ -387,6 +391,35 @@ func (c *config) InitializeGoRoutines() {
 		logger.Warn("Device token cleaner prerequisites not met; skipping start")
 	}
 
+	// Start role grant scheduler (time-bound role activation/expiration)
+	if c.userService != nil {
+		// Unset values fall back to the scheduler defaults.
+		interval := time.Duration(c.env.RoleGrants.SchedulerIntervalMinutes) * time.Minute
+		noticeWindow := time.Duration(c.env.RoleGrants.ExpiryNoticeHours) * time.Hour
+		c.wg.Add(1)
+		go goroutines.RoleGrantScheduler(c.userService, c.wg, coreutils.ContextWithLogger(baseCtx), interval, noticeWindow, c.env.RoleGrants.BatchSize)
+		logger.Info("Role grant scheduler worker started")
+	} else {
+		logger.Warn("Role grant scheduler prerequisites not met; skipping start")
+	}
+
+	// Start LGPD personal data export processor
+	if c.userService != nil {
+		interval := time.Duration(c.env.DataExport.ProcessIntervalSeconds) * time.Second
+		if interval <= 0 {
+			interval = time.Minute
+		}
+		batchSize := c.env.DataExport.BatchSize
+		if batchSize <= 0 {
+			batchSize = 10
+		}
+		c.wg.Add(1)
+		go goroutines.DataExportProcessor(c.userService, c.wg, coreutils.ContextWithLogger(baseCtx), interval, batchSize)
+		logger.Info("Data export processor worker started", "interval", interval, "batch_size", batchSize)
+	} else {
+		logger.Warn("Data export processor prerequisites not met; skipping start")
+	}
+
 	// Start validation cleaner if user repository and global service are set
 	if c.repositoryAdapters != nil && c.repositoryAdapters.User != nil && c.globalService != nil {
 		validationSvc := validationservice.New(c.repositoryAdapters.User, c.globalService)
@@ -664,6 +697,7 @@ func (c *config) SetupHTTPHandlersAndRoutes() {
 		c.propertyCoverageService,
 		c.scheduleService,
 		c.visitService,
+		c.calendarFeedService,
 		c.holidayService,
 		c.permissionService,
 		c.photoSessionService,
@@ -9,6 +9,7 @@ import (
 	goroutines "github.com/projeto-toq/toq_server/internal/core/go_routines"
 	metricsport "github.com/projeto-toq/toq_server/internal/core/port/right/metrics"
 	auditservice "github.com/projeto-toq/toq_server/internal/core/service/audit_service"
+	calendarfeedservice "github.com/projeto-toq/toq_server/internal/core/service/calendar_feed_service"
 	globalservice "github.com/projeto-toq/toq_server/internal/core/service/global_service"
 	holidayservices "github.com/projeto-toq/toq_server/internal/core/service/holiday_service"
 	listingservices "github.com/projeto-toq/toq_server/internal/core/service/listing_service"
@@ -200,6 +201,10 @@ func (c *config) InitUserHandler() {
 		PhotographerAgendaRefreshInterval: refreshInterval * time.Hour,
 		MaxWrongSigninAttempts:            c.GetMaxWrongSigninAttempts(),
 		TempBlockDuration:                 c.GetTempBlockDuration(),
+		DataExportURLTTL:                  time.Duration(c.env.DataExport.DownloadURLTTLHours) * time.Hour,
+		DataExportStaleAfter:              time.Duration(c.env.DataExport.StaleAfterMinutes) * time.Minute,
+		DataExportMaxAttempts:             c.env.DataExport.MaxAttempts,
+		ConsentPolicyVersion:              c.env.Consent.PolicyVersion,
 	}
 	c.userService = userservices.NewUserService(
 		c.repositoryAdapters.User,
@@ -336,6 +341,36 @@ func (c *config) InitVisitService() {
 	)
 }
 
+func (c *config) InitCalendarFeedService() {
+	slog.Debug("Initializing Calendar Feed Service")
+
+	if c.repositoryAdapters == nil {
+		slog.Error("repositoryAdapters is nil")
+		return
+	}
+
+	if c.repositoryAdapters.User == nil || c.repositoryAdapters.Schedule == nil || c.repositoryAdapters.PhotoSession == nil ||
+		c.repositoryAdapters.Visit == nil || c.repositoryAdapters.Listing == nil {
+		slog.Error("calendar feed repositories are not initialized")
+		return
+	}
+
+	if c.globalService == nil {
+		slog.Error("globalService is nil")
+		return
+	}
+
+	c.calendarFeedService = calendarfeedservice.NewService(
+		c.globalService,
+		c.repositoryAdapters.User,
+		c.repositoryAdapters.Schedule,
+		c.repositoryAdapters.PhotoSession,
+		c.repositoryAdapters.Visit,
+		c.repositoryAdapters.Listing,
+		calendarfeedservice.ConfigFromEnvironment(&c.env),
+	)
+}
+
 func (c *config) InitPropertyCoverageService() {
 	slog.Debug("Initializing Property Coverage Service")
 
@@ -414,6 +449,7 @@ func (c *config) InitMediaProcessingService() {
 		c.externalServiceAdapters.ListingMediaStorage,
 		c.externalServiceAdapters.MediaProcessingQueue,
 		c.externalServiceAdapters.MediaProcessingWorkflow,
+		c.photoSessionService,
 		cfg,
 	)
 
@@ -424,6 +460,13 @@ func (c *config) InitMediaProcessingService() {
 	}
 
 	c.mediaProcessingService = service
+
+	// Pipeline local entrega os resultados direto no serviço (sem o endpoint HTTP de callback)
+	if c.externalServiceAdapters.LocalMediaPipeline != nil {
+		c.externalServiceAdapters.LocalMediaPipeline.SetCallbackHandler(service.HandleProcessingCallback)
+		slog.Info("Local media pipeline wired to MediaProcessing service")
+	}
+
 	slog.Info("✅ MediaProcessing service initialized successfully")
 }
 
@@ -461,6 +504,7 @@ func (c *config) InitListingHandler() {
 		c.repositoryAdapters.ListingFavorite,
 		c.repositoryAdapters.ListingView,
 		c.auditService,
+		c.mediaProcessingService,
 	)
 	// HTTP handler initialization is done during HTTP server setup
 }
@@ -567,6 +611,21 @@ func (c *config) InitPhotoSessionService() {
 		BusinessEndHour:             c.env.PhotoSession.BusinessEndHour,
 		AgendaHorizonMonths:         c.env.PhotoSession.PhotographerHorizonMonths,
 		RequirePhotographerApproval: c.env.PhotoSession.RequirePhotographerApproval,
+		RescheduleCutoffHours:       c.env.PhotoSession.RescheduleCutoffHours,
+		MaxReschedulesPerBooking:    c.env.PhotoSession.MaxReschedulesPerBooking,
+		AssignmentMode:              c.env.PhotoSession.Assignment.Mode,
+		AssignmentBalanceDays:       c.env.PhotoSession.Assignment.BalanceDays,
+		AssignmentLoadWeight:        c.env.PhotoSession.Assignment.LoadWeight,
+		AssignmentApprovalWeight:    c.env.PhotoSession.Assignment.ApprovalWeight,
+		AssignmentDistanceWeight:    c.env.PhotoSession.Assignment.DistanceWeight,
+		AssignmentMaxDistanceKm:     c.env.PhotoSession.Assignment.MaxDistanceKm,
+		TravelBufferEnabled:         c.env.PhotoSession.Travel.Enabled,
+		TravelSpeedKmh:              c.env.PhotoSession.Travel.AverageSpeedKmh,
+		TravelMinBufferMinutes:      c.env.PhotoSession.Travel.MinBufferMinutes,
+		TravelMaxBufferMinutes:      c.env.PhotoSession.Travel.MaxBufferMinutes,
+		KPIArrivalGraceMinutes:      c.env.PhotoSession.KPI.ArrivalGraceMinutes,
+		KPIUploadTargetHours:        c.env.PhotoSession.KPI.UploadTargetHours,
+		KPIRankingWindowDays:        c.env.PhotoSession.KPI.RankingWindowDays,
 	}
 
 	c.photoSessionService = photosessionservices.NewPhotoSessionService(
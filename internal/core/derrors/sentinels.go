@@ -30,12 +30,15 @@ var (
 	ErrCEPInvalid  = errors.New("invalid CEP")
 	ErrCEPNotFound = errors.New("CEP not found")
 
-	ErrSlotUnavailable           = errors.New("photographer slot unavailable")
-	ErrReservationExpired        = errors.New("photographer slot reservation expired")
-	ErrListingNotEligible        = errors.New("listing not eligible for photo session")
-	ErrPhotoSessionNotCancelable = errors.New("photo session cannot be cancelled")
-	ErrPhotoSessionPending       = errors.New("photo session awaiting photographer decision")
-	ErrPhotoSessionAlreadyFinal  = errors.New("photo session already finalized")
+	ErrSlotUnavailable              = errors.New("photographer slot unavailable")
+	ErrReservationExpired           = errors.New("photographer slot reservation expired")
+	ErrListingNotEligible           = errors.New("listing not eligible for photo session")
+	ErrPhotoSessionNotCancelable    = errors.New("photo session cannot be cancelled")
+	ErrPhotoSessionPending          = errors.New("photo session awaiting photographer decision")
+	ErrPhotoSessionAlreadyFinal     = errors.New("photo session already finalized")
+	ErrPhotoSessionNotReschedulable = errors.New("photo session cannot be rescheduled")
+	ErrRescheduleWindowClosed       = errors.New("photo session reschedule window closed")
+	ErrRescheduleLimitReached       = errors.New("photo session reschedule limit reached")
 
 	ErrRoleNotSystem          = errors.New("role is not marked as system role")
 	ErrAdminRoleProtected     = errors.New("admin role cannot be altered")
@@ -1,10 +1,12 @@
 package dto
 
 import (
+	"strings"
 	"time"
 
 	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
 	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	permissionmodel "github.com/projeto-toq/toq_server/internal/core/model/permission_model"
 )
 
 // RequestUploadURLsInput defines the input for generating upload URLs.
@@ -70,6 +72,51 @@ type UpdateMediaInput struct {
 	RequestedBy       uint64                              `json:"-"`
 }
 
+// ArrangeGalleryInput reorders a listing gallery and sets covers and room tags in one call.
+// Items are grouped by asset type; their order defines the new sequences and every asset of a
+// type present in the request must be listed.
+type ArrangeGalleryInput struct {
+	ListingIdentityID int64 `json:"listingIdentityId" validate:"required,gt=0"`
+	// Revision is the galleryRevision returned by the media list; a stale value is rejected.
+	Revision      string                   `json:"revision" validate:"required"`
+	Items         []ArrangeGalleryItem     `json:"items" validate:"required,min=1,dive"`
+	RequestedBy   uint64                   `json:"-"`
+	RequesterRole permissionmodel.RoleSlug `json:"-"`
+}
+
+// ArrangeGalleryItem places one asset in the gallery.
+type ArrangeGalleryItem struct {
+	AssetID       uint64                            `json:"assetId" validate:"required,gt=0"`
+	Cover         bool                              `json:"cover,omitempty"`
+	RoomTag       mediaprocessingmodel.MediaRoomTag `json:"roomTag,omitempty"`
+	RoomFeatureID int64                             `json:"roomFeatureId,omitempty"`
+}
+
+// ArrangeGalleryOutput returns the revision to be used by the next arrangement.
+type ArrangeGalleryOutput struct {
+	ListingIdentityID int64  `json:"listingIdentityId"`
+	Revision          string `json:"revision"`
+}
+
+// MediaGalleryCover is the cover photo of one orientation, signed for display.
+type MediaGalleryCover struct {
+	AssetID   uint64
+	AssetType mediaprocessingmodel.MediaAssetType
+	Title     string
+	Placement mediaprocessingmodel.GalleryPlacement
+	URL       string
+	SrcSets   []MediaImageSrcSet
+}
+
+// SaveMediaTourInput replaces the virtual tour of a listing. An empty node list removes the tour.
+type SaveMediaTourInput struct {
+	ListingIdentityID int64                                `json:"listingIdentityId" validate:"required,gt=0"`
+	StartAssetID      uint64                               `json:"startAssetId"`
+	Nodes             []mediaprocessingmodel.MediaTourNode `json:"nodes"`
+	RequestedBy       uint64                               `json:"-"`
+	RequesterRole     permissionmodel.RoleSlug             `json:"-"`
+}
+
 // DeleteMediaInput defines the input for deleting a media asset.
 type DeleteMediaInput struct {
 	ListingIdentityID int64                               `json:"listingIdentityId" validate:"required,gt=0"`
@@ -103,6 +150,8 @@ type HandleProcessingCallbackInput struct {
 	ZipBundles        []string           `json:"zipBundles,omitempty"`
 	ZipSizeBytes      int64              `json:"zipSizeBytes,omitempty"`
 	UnzippedSizeBytes int64              `json:"unzippedSizeBytes,omitempty"`
+	ZipManifestKey    string             `json:"zipManifestKey,omitempty"`
+	ZipSHA256         string             `json:"zipSha256,omitempty"`
 	Error             string             `json:"error,omitempty"`
 	ErrorCode         string             `json:"errorCode,omitempty"`
 	ErrorMetadata     map[string]string  `json:"errorMetadata,omitempty"`
@@ -123,6 +172,45 @@ type ProcessingResult struct {
 	ErrorCode    string            `json:"errorCode,omitempty"`
 }
 
+// ProcessingResultsFromPayloads converts the per-asset outputs of a processing callback into
+// service results. Shared by the HTTP callback endpoint and the in-process pipeline.
+func ProcessingResultsFromPayloads(outputs []mediaprocessingmodel.MediaProcessingJobPayload) []ProcessingResult {
+	if len(outputs) == 0 {
+		return nil
+	}
+	results := make([]ProcessingResult, 0, len(outputs))
+	for _, output := range outputs {
+		status := "PROCESSED"
+		if output.ErrorCode != "" || output.ErrorMessage != "" {
+			status = "FAILED"
+		}
+
+		var metadata map[string]string
+		if len(output.Outputs) > 0 {
+			metadata = make(map[string]string, len(output.Outputs))
+			for k, v := range output.Outputs {
+				trimmedKey := strings.TrimSpace(k)
+				trimmedValue := strings.TrimSpace(v)
+				if trimmedKey == "" || trimmedValue == "" {
+					continue
+				}
+				metadata[trimmedKey] = trimmedValue
+			}
+		}
+
+		results = append(results, ProcessingResult{
+			RawKey:       output.RawKey,
+			Status:       status,
+			ProcessedKey: output.ProcessedKey,
+			ThumbnailKey: output.ThumbnailKey,
+			Metadata:     metadata,
+			Error:        output.ErrorMessage,
+			ErrorCode:    output.ErrorCode,
+		})
+	}
+	return results
+}
+
 type HandleProcessingCallbackOutput struct {
 	Success bool `json:"success"`
 }
@@ -145,6 +233,55 @@ type ListMediaOutput struct {
 	Page       int
 	Limit      int
 	ZipBundle  *ListMediaZipBundle
+	// SrcSets holds, per asset ID, the signed responsive variants of processed photos.
+	SrcSets map[uint64][]MediaImageSrcSet
+	// Quality holds, per asset ID, the photo quality analysis and gate outcome.
+	Quality map[uint64]MediaPhotoQuality
+	// Gallery holds, per asset ID, the cover flag and room tag.
+	Gallery map[uint64]mediaprocessingmodel.GalleryPlacement
+	// GalleryRevision must be sent back by ArrangeGallery.
+	GalleryRevision string
+	// Panoramas holds, per asset ID, the tile pyramid of processed 360° panoramas.
+	Panoramas map[uint64]MediaPanorama
+	// Tour is the virtual tour linking the panoramas; nil when none was saved.
+	Tour *mediaprocessingmodel.MediaTour
+	// Parcel is the polygon read from the LAND_PARCEL document; nil when none was processed.
+	Parcel *mediaprocessingmodel.LandParcel
+}
+
+// MediaPanorama describes a processed 360° panorama for tiled viewers.
+type MediaPanorama struct {
+	Tiles mediaprocessingmodel.PanoramaTiles
+	// TileURLTemplate carries {level}, {row} and {col} placeholders and a token valid until
+	// TileURLExpiresAt.
+	TileURLTemplate  string
+	TileURLExpiresAt time.Time
+	// PreviewURL is the signed flat equirectangular preview, for viewers without tile support.
+	PreviewURL string
+}
+
+// MediaPhotoQuality combines the pipeline measurements with the quality gate outcome.
+type MediaPhotoQuality struct {
+	Report mediaprocessingmodel.PhotoQualityReport
+	// Status is empty when the gate was disabled at evaluation time.
+	Status      mediaprocessingmodel.PhotoQualityStatus
+	Issues      []mediaprocessingmodel.PhotoQualityIssue
+	DuplicateOf uint64
+}
+
+// MediaImageSrcSet groups the signed variants of one photo in a single format.
+type MediaImageSrcSet struct {
+	Format      mediaprocessingmodel.ImageVariantFormat
+	ContentType string
+	SrcSet      string // "url 200w, url 400w, ..." ready for <source srcset>
+	Sources     []MediaImageSource
+}
+
+// MediaImageSource is one signed width of a MediaImageSrcSet.
+type MediaImageSource struct {
+	Resolution string
+	Width      int
+	URL        string
 }
 
 // ListMediaZipBundle describes the finalized archive returned alongside the assets list.
@@ -153,6 +290,7 @@ type ListMediaZipBundle struct {
 	AssetsCount             int
 	ZipSizeBytes            int64
 	EstimatedExtractedBytes int64
+	SHA256                  string
 	CompletedAt             *time.Time
 }
 
@@ -166,6 +304,7 @@ type DownloadRequestItemInput struct {
 	AssetType  mediaprocessingmodel.MediaAssetType
 	Sequence   uint8
 	Resolution string
+	Format     mediaprocessingmodel.ImageVariantFormat // Optional; JPEG when empty or not generated
 }
 
 // GenerateDownloadURLsOutput define a saída com as URLs geradas.
@@ -178,6 +317,114 @@ type DownloadURLOutput struct {
 	AssetType  mediaprocessingmodel.MediaAssetType
 	Sequence   uint8
 	Resolution string
+	Format     mediaprocessingmodel.ImageVariantFormat // Format actually served
 	Url        string
 	ExpiresIn  int
 }
+
+// GetHLSPlaylistInput identifies a playlist of the signed HLS endpoint.
+type GetHLSPlaylistInput struct {
+	Token    string
+	Playlist string // "master.m3u8" or "{rendition}.m3u8"
+}
+
+// GetPanoramaTileInput identifies one tile of the signed panorama endpoint.
+type GetPanoramaTileInput struct {
+	Token string
+	Level int
+	Tile  string // "{row}_{col}.jpg"
+}
+
+// GetPanoramaTileOutput carries the pre-signed tile URL the client is redirected to.
+type GetPanoramaTileOutput struct {
+	URL    string
+	MaxAge time.Duration
+}
+
+// GetHLSPlaylistOutput carries the rewritten playlist body.
+type GetHLSPlaylistOutput struct {
+	Content string
+	MaxAge  time.Duration // how long clients may cache the playlist (shorter than the signatures)
+}
+
+// ListProcessingJobsInput filters the admin dashboard of processing jobs.
+type ListProcessingJobsInput struct {
+	Statuses          []mediaprocessingmodel.MediaProcessingJobStatus
+	Providers         []mediaprocessingmodel.MediaProcessingProvider
+	ListingIdentityID uint64
+	// OlderThan keeps only jobs created at least this long ago; zero disables the filter.
+	OlderThan time.Duration
+	Page      int
+	Limit     int
+}
+
+// ListProcessingJobsOutput returns one page of jobs.
+type ListProcessingJobsOutput struct {
+	Jobs  []ProcessingJobSummary
+	Total int64
+	Page  int
+	Limit int
+}
+
+// ProcessingJobSummary is a job row of the dashboard; FailedAssets counts failed outputs of the
+// stored callback.
+type ProcessingJobSummary struct {
+	Job          mediaprocessingmodel.MediaProcessingJob
+	FailedAssets int
+	TotalAssets  int
+}
+
+// GetProcessingJobInput identifies a job of the admin dashboard.
+type GetProcessingJobInput struct {
+	JobID uint64
+}
+
+// ProcessingJobDetailOutput exposes a job with the per-asset outcome reported by its callback.
+type ProcessingJobDetailOutput struct {
+	Job    mediaprocessingmodel.MediaProcessingJob
+	Assets []ProcessingJobAsset
+}
+
+// ProcessingJobAsset is one output of the callback matched with the current asset; AssetID is
+// zero when the asset was deleted since.
+type ProcessingJobAsset struct {
+	AssetID      uint64
+	AssetType    mediaprocessingmodel.MediaAssetType
+	Sequence     uint8
+	RawKey       string
+	Status       mediaprocessingmodel.MediaAssetStatus
+	Failed       bool
+	ErrorCode    string
+	ErrorMessage string
+}
+
+// RetryProcessingJobInput sends the failed assets of a job, or only AssetID, to a new job.
+type RetryProcessingJobInput struct {
+	JobID       uint64
+	AssetID     uint64
+	RequestedBy uint64
+}
+
+// RetryProcessingJobOutput identifies the job created by the retry.
+type RetryProcessingJobOutput struct {
+	JobID    uint64
+	AssetIDs []uint64
+}
+
+// CancelProcessingJobInput stops a job that has not finished yet.
+type CancelProcessingJobInput struct {
+	JobID       uint64
+	Reason      string
+	RequestedBy uint64
+}
+
+// ForceCompleteProcessingJobInput accepts the processed assets of a partially successful job.
+type ForceCompleteProcessingJobInput struct {
+	JobID       uint64
+	RequestedBy uint64
+}
+
+// ForceCompleteProcessingJobOutput lists the failed assets removed from the listing.
+type ForceCompleteProcessingJobOutput struct {
+	DiscardedAssetIDs []uint64
+}
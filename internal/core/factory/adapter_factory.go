@@ -10,6 +10,7 @@ import (
 	cacheport "github.com/projeto-toq/toq_server/internal/core/port/right/cache"
 	mediaprocessingcallbackport "github.com/projeto-toq/toq_server/internal/core/port/right/functions/mediaprocessingcallback"
 	metricsport "github.com/projeto-toq/toq_server/internal/core/port/right/metrics"
+	calendarfeedservice "github.com/projeto-toq/toq_server/internal/core/service/calendar_feed_service"
 	globalservice "github.com/projeto-toq/toq_server/internal/core/service/global_service"
 	holidayservices "github.com/projeto-toq/toq_server/internal/core/service/holiday_service"
 	listingservices "github.com/projeto-toq/toq_server/internal/core/service/listing_service"
@@ -59,6 +60,7 @@ type AdapterFactory interface {
 		propertyCoverageService propertycoverageservice.PropertyCoverageServiceInterface,
 		scheduleService scheduleservices.ScheduleServiceInterface,
 		visitService visitservice.Service,
+		calendarFeedService calendarfeedservice.Service,
 		holidayService holidayservices.HolidayServiceInterface,
 		permissionService permissionservices.PermissionServiceInterface,
 		photoSessionService photosessionservices.PhotoSessionServiceInterface,
@@ -17,6 +17,7 @@ import (
 	"github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers"
 	adminhandlers "github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/admin_handlers"
 	authhandlers "github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/auth_handlers"
+	calendarfeedhandlers "github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/calendar_feed_handlers"
 	holidayhandlers "github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/holiday_handlers"
 	listinghandlers "github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/listing_handlers"
 	mediaprocessinghandlers "github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/media_processing_handlers"
@@ -43,6 +44,7 @@ import (
 	awsstepfunctionsadapter "github.com/projeto-toq/toq_server/internal/adapter/right/aws/step_functions"
 	s3adapter "github.com/projeto-toq/toq_server/internal/adapter/right/aws_s3"
 	sqsmediaprocessingadapter "github.com/projeto-toq/toq_server/internal/adapter/right/aws_sqs/media_processing"
+	localmediapipelineadapter "github.com/projeto-toq/toq_server/internal/adapter/right/local_media_pipeline"
 	tokenblocklist "github.com/projeto-toq/toq_server/internal/adapter/right/redis/token_blocklist"
 	stepfunctionscallbackadapter "github.com/projeto-toq/toq_server/internal/adapter/right/step_functions"
 
@@ -68,6 +70,7 @@ import (
 	mysqlvisitadapter "github.com/projeto-toq/toq_server/internal/adapter/right/mysql/visit"
 
 	// Core services
+	calendarfeedservice "github.com/projeto-toq/toq_server/internal/core/service/calendar_feed_service"
 	globalservice "github.com/projeto-toq/toq_server/internal/core/service/global_service"
 	holidayservice "github.com/projeto-toq/toq_server/internal/core/service/holiday_service"
 	listingservice "github.com/projeto-toq/toq_server/internal/core/service/listing_service"
@@ -165,19 +168,37 @@ func (f *ConcreteAdapterFactory) CreateExternalServiceAdapters(ctx context.Conte
 
 	listingMediaStorage := s3adapter.NewListingMediaStorageAdapter(s3, env)
 
+	callbackAdapter := stepfunctionscallbackadapter.NewMediaProcessingCallbackAdapter(env)
+
 	var mediaQueue mediaprocessingqueue.QueuePortInterface
-	queueAdapter, err := sqsmediaprocessingadapter.NewMediaProcessingQueueAdapter(ctx, env)
-	if err != nil {
-		slog.Warn("failed to create media processing queue adapter", "error", err)
-	} else if queueAdapter != nil {
-		mediaQueue = queueAdapter
+	var workflowAdapter workflowport.WorkflowPortInterface
+	var localPipeline *localmediapipelineadapter.LocalMediaPipelineAdapter
+	closeFunc := s3Close
+
+	if env.MediaProcessing.Backend == mediaProcessingBackendLocal {
+		// Pipeline em processo: o mesmo adapter atende fila e workflow de finalização
+		if s3 != nil {
+			localPipeline = localmediapipelineadapter.NewLocalMediaPipelineAdapter(ctx, env, s3, s3.ListingBucketName())
+		}
+		if localPipeline != nil {
+			mediaQueue = localPipeline
+			workflowAdapter = localPipeline
+			closeFunc = chainCloseFuncs(localPipeline.Close, s3Close)
+		} else {
+			slog.Warn("local media pipeline unavailable (S3 adapter or listing bucket missing)")
+		}
 	} else {
-		slog.Warn("media processing queue adapter returned nil (configuration missing?)")
-	}
-
-	callbackAdapter := stepfunctionscallbackadapter.NewMediaProcessingCallbackAdapter(env)
+		queueAdapter, err := sqsmediaprocessingadapter.NewMediaProcessingQueueAdapter(ctx, env)
+		if err != nil {
+			slog.Warn("failed to create media processing queue adapter", "error", err)
+		} else if queueAdapter != nil {
+			mediaQueue = queueAdapter
+		} else {
+			slog.Warn("media processing queue adapter returned nil (configuration missing?)")
+		}
 
-	workflowAdapter := buildWorkflowAdapter(ctx, env)
+		workflowAdapter = buildWorkflowAdapter(ctx, env)
+	}
 
 	slog.Info("Successfully created all external service adapters")
 
@@ -190,10 +211,30 @@ func (f *ConcreteAdapterFactory) CreateExternalServiceAdapters(ctx context.Conte
 		MediaProcessingQueue:    mediaQueue,
 		MediaProcessingCallback: callbackAdapter,
 		MediaProcessingWorkflow: workflowAdapter,
-		CloseFunc:               s3Close, // Função de cleanup do S3
+		LocalMediaPipeline:      localPipeline,
+		CloseFunc:               closeFunc, // Cleanup do S3 (e do pipeline local, quando ativo)
 	}, nil
 }
 
+// mediaProcessingBackendLocal selects the in-process media pipeline instead of SQS + Step Functions.
+const mediaProcessingBackendLocal = "local"
+
+// chainCloseFuncs runs the cleanup functions in order and returns the first error.
+func chainCloseFuncs(funcs ...func() error) func() error {
+	return func() error {
+		var firstErr error
+		for _, fn := range funcs {
+			if fn == nil {
+				continue
+			}
+			if err := fn(); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+		return firstErr
+	}
+}
+
 func buildWorkflowAdapter(ctx context.Context, env *globalmodel.Environment) workflowport.WorkflowPortInterface {
 	if env == nil {
 		slog.Warn("adapter.stepfunctions.workflow.env_missing")
@@ -349,6 +390,7 @@ func (factory *ConcreteAdapterFactory) CreateHTTPHandlers(
 	propertyCoverageService propertycoverageservice.PropertyCoverageServiceInterface,
 	scheduleService scheduleservice.ScheduleServiceInterface,
 	visitService visitservice.Service,
+	calendarFeedService calendarfeedservice.Service,
 	holidayService holidayservice.HolidayServiceInterface,
 	permissionService permissionservice.PermissionServiceInterface,
 	photoSessionService photosessionservice.PhotoSessionServiceInterface,
@@ -397,6 +439,7 @@ func (factory *ConcreteAdapterFactory) CreateHTTPHandlers(
 		globalService,
 		userService,
 		propertyCoverageService,
+		mediaProcessingService,
 		listingHandlerConfig,
 	)
 
@@ -456,6 +499,11 @@ func (factory *ConcreteAdapterFactory) CreateHTTPHandlers(
 		globalService,
 	)
 
+	calendarFeedHandler := calendarfeedhandlers.NewCalendarFeedHandler(
+		calendarFeedService,
+		globalService,
+	)
+
 	visitHandlerPort := visithandlers.NewVisitHandler(
 		visitService,
 	)
@@ -493,5 +541,6 @@ func (factory *ConcreteAdapterFactory) CreateHTTPHandlers(
 		HolidayHandler:         holidayHandler,
 		PhotoSessionHandler:    photoSessionHandler,
 		VisitHandler:           visitHandler,
+		CalendarFeedHandler:    calendarFeedHandler,
 	}
 }
@@ -6,6 +6,7 @@ import (
 	metricshandlers "github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers"
 	adminhandlers "github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/admin_handlers"
 	authhandlers "github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/auth_handlers"
+	calendarfeedhandlers "github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/calendar_feed_handlers"
 	holidayhandlers "github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/holiday_handlers"
 	listinghandlers "github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/listing_handlers"
 	mediaprocessinghandlers "github.com/projeto-toq/toq_server/internal/adapter/left/http/handlers/media_processing_handlers"
@@ -29,6 +30,7 @@ import (
 	storageport "github.com/projeto-toq/toq_server/internal/core/port/right/storage"
 	workflowport "github.com/projeto-toq/toq_server/internal/core/port/right/workflow"
 
+	localmediapipelineadapter "github.com/projeto-toq/toq_server/internal/adapter/right/local_media_pipeline"
 	mysqladapter "github.com/projeto-toq/toq_server/internal/adapter/right/mysql"
 	auditrepository "github.com/projeto-toq/toq_server/internal/core/port/right/repository/audit_repository"
 	globalrepoport "github.com/projeto-toq/toq_server/internal/core/port/right/repository/global_repository"
@@ -65,7 +67,8 @@ type ExternalServiceAdapters struct {
 	MediaProcessingQueue    mediaprocessingqueue.QueuePortInterface
 	MediaProcessingCallback mediaprocessingcallbackport.CallbackPortInterface
 	MediaProcessingWorkflow workflowport.WorkflowPortInterface
-	CloseFunc               func() error // Função para cleanup de recursos
+	LocalMediaPipeline      *localmediapipelineadapter.LocalMediaPipelineAdapter // nil quando o backend é step_functions
+	CloseFunc               func() error                                         // Função para cleanup de recursos
 }
 
 // StorageAdapters agrupa adapters de armazenamento
@@ -109,6 +112,7 @@ type HTTPHandlers struct {
 	HolidayHandler         *holidayhandlers.HolidayHandler
 	PhotoSessionHandler    *photosessionhandlers.PhotoSessionHandler
 	VisitHandler           *visithandlers.VisitHandler
+	CalendarFeedHandler    *calendarfeedhandlers.CalendarFeedHandler
 }
 
 // MetricsAdapter contém o adapter de métricas
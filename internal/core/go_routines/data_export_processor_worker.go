@@ -0,0 +1,62 @@
+package goroutines
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	userservices "github.com/projeto-toq/toq_server/internal/core/service/user_service"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// DataExportProcessor periodically builds queued LGPD personal data exports,
+// uploads the archives and emails users their expiring download links.
+func DataExportProcessor(
+	svc userservices.UserServiceInterface,
+	wg *sync.WaitGroup,
+	ctx context.Context,
+	interval time.Duration,
+	batchSize int,
+) {
+	ctx = coreutils.ContextWithLogger(ctx)
+	logger := coreutils.LoggerFromContext(ctx)
+
+	if wg != nil {
+		defer wg.Done()
+	}
+
+	if svc == nil {
+		logger.Warn("data_export processor skipped: service unavailable")
+		return
+	}
+
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	if batchSize <= 0 {
+		batchSize = 10
+	}
+
+	logger.Info("data_export processor started", "interval", interval, "batch_size", batchSize)
+
+	runOnce := func(runCtx context.Context) {
+		noTraceCtx := coreutils.WithSkipTracing(runCtx)
+		if _, err := svc.ProcessPendingDataExports(noTraceCtx, batchSize); err != nil {
+			logger.Warn("data_export.processor.run_failed", "err", err)
+		}
+	}
+
+	runOnce(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("data_export processor stopped")
+			return
+		case <-ticker.C:
+			runOnce(ctx)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package goroutines
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	userservices "github.com/projeto-toq/toq_server/internal/core/service/user_service"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// RoleGrantScheduler periodically activates scheduled role grants, expires ended ones
+// and notifies users before their time-bound roles expire.
+func RoleGrantScheduler(
+	svc userservices.UserServiceInterface,
+	wg *sync.WaitGroup,
+	ctx context.Context,
+	interval time.Duration,
+	noticeWindow time.Duration,
+	batchSize int,
+) {
+	ctx = coreutils.ContextWithLogger(ctx)
+	logger := coreutils.LoggerFromContext(ctx)
+
+	if wg != nil {
+		defer wg.Done()
+	}
+
+	if svc == nil {
+		logger.Warn("role_grant scheduler skipped: service unavailable")
+		return
+	}
+
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+	if noticeWindow <= 0 {
+		noticeWindow = 72 * time.Hour
+	}
+	if batchSize <= 0 {
+		batchSize = 200
+	}
+
+	logger.Info("role_grant scheduler started", "interval", interval, "notice_window", noticeWindow, "batch_size", batchSize)
+
+	runOnce := func(runCtx context.Context) {
+		noTraceCtx := coreutils.WithSkipTracing(runCtx)
+		if _, err := svc.ProcessRoleGrantSchedule(noTraceCtx, noticeWindow, batchSize); err != nil {
+			logger.Warn("role_grant.scheduler.run_failed", "err", err)
+		}
+	}
+
+	runOnce(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("role_grant scheduler stopped")
+			return
+		case <-ticker.C:
+			runOnce(ctx)
+		}
+	}
+}
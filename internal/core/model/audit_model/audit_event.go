@@ -31,6 +31,9 @@ const (
 	OperationAuthSignin     AuditOperation = "auth_signin"
 	OperationAuthSignout    AuditOperation = "auth_signout"
 	OperationPasswordReset  AuditOperation = "password_reset"
+	OperationDataExport     AuditOperation = "data_export"
+	OperationAnonymize      AuditOperation = "anonymize"
+	OperationConsentChange  AuditOperation = "consent_change"
 )
 
 // TargetType represents the audited resource domain.
@@ -48,6 +51,8 @@ const (
 	TargetUserRole        TargetType = "user_roles"
 	TargetAgencyInvite    TargetType = "agency_invites"
 	TargetRealtorAgency   TargetType = "realtors_agency"
+	TargetUserDataExport  TargetType = "user_data_exports"
+	TargetUserConsent     TargetType = "user_notification_consents"
 )
 
 // AuditActor identifies who performed the action.
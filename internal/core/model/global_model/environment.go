@@ -122,6 +122,35 @@ type Environment struct {
 		// manual photographer acceptance (true) or are automatically approved (false).
 		// Default: false (automatic approval)
 		RequirePhotographerApproval bool `yaml:"require_photographer_approval"`
+		// RescheduleCutoffHours is the minimum notice (hours before the booked start) for owner reschedules.
+		// Default: 24
+		RescheduleCutoffHours int `yaml:"reschedule_cutoff_hours"`
+		// MaxReschedulesPerBooking caps reschedules per booking. Default: 0 (unlimited)
+		MaxReschedulesPerBooking int `yaml:"max_reschedules_per_booking"`
+		// Assignment controls automatic photographer assignment. Mode "auto" lets owners pick only a
+		// time window; "owner_choice" (default) keeps photographer-specific slots.
+		Assignment struct {
+			Mode           string  `yaml:"mode"`
+			BalanceDays    int     `yaml:"balance_days"`
+			LoadWeight     float64 `yaml:"load_weight"`
+			ApprovalWeight float64 `yaml:"approval_weight"`
+			DistanceWeight float64 `yaml:"distance_weight"`
+			MaxDistanceKm  float64 `yaml:"max_distance_km"`
+		} `yaml:"assignment"`
+		// Travel inserts buffers between sessions at different listings based on estimated distance.
+		// Default: disabled
+		Travel struct {
+			Enabled          bool    `yaml:"enabled"`
+			AverageSpeedKmh  float64 `yaml:"average_speed_kmh"`
+			MinBufferMinutes int     `yaml:"min_buffer_minutes"`
+			MaxBufferMinutes int     `yaml:"max_buffer_minutes"`
+		} `yaml:"travel"`
+		// KPI tunes photographer delivery KPIs and their weight in slot ranking.
+		KPI struct {
+			ArrivalGraceMinutes int `yaml:"arrival_grace_minutes"`
+			UploadTargetHours   int `yaml:"upload_target_hours"`
+			RankingWindowDays   int `yaml:"ranking_window_days"`
+		} `yaml:"kpi"`
 	} `yaml:"photo_session"`
 	FCM struct {
 		CredentialsFile string `yaml:"credentials_file"`
@@ -134,7 +163,11 @@ type Environment struct {
 		Region            string `yaml:"region"`
 		UserBucketName    string `yaml:"user_bucket_name"`
 		ListingBucketName string `yaml:"listing_bucket_name"`
-		AdminRole         struct {
+		// Endpoint overrides the AWS endpoint for S3-compatible stores (e.g. MinIO in local environments).
+		Endpoint string `yaml:"endpoint"`
+		// UsePathStyle forces bucket-in-path addressing, required by most S3-compatible stores.
+		UsePathStyle bool `yaml:"use_path_style"`
+		AdminRole    struct {
 			AccessKeyID     string `yaml:"access_key_id"`
 			SecretAccessKey string `yaml:"secret_access_key"`
 		} `yaml:"admin"`
@@ -148,14 +181,41 @@ type Environment struct {
 		} `yaml:"signed_url"`
 	}
 	MediaProcessing struct {
+		// Backend selects the processing pipeline: "step_functions" (default, SQS + Lambdas) or
+		// "local" (in-process worker pool, intended for development and self-hosted setups).
+		Backend string `yaml:"backend"`
+		Local   struct {
+			Workers                  int    `yaml:"workers"`
+			QueueSize                int    `yaml:"queue_size"`
+			FFmpegPath               string `yaml:"ffmpeg_path"`
+			VideoThumbnailSeekSecond int    `yaml:"video_thumbnail_seek_second"`
+			VideoThumbnailWidth      int    `yaml:"video_thumbnail_width"`
+			VideoThumbnailQuality    int    `yaml:"video_thumbnail_quality"`
+			CallbackMaxAttempts      int    `yaml:"callback_max_attempts"`
+			// ImageFormats lists optional photo formats generated next to JPEG ("webp", "avif").
+			ImageFormats []string `yaml:"image_formats"`
+			ImageQuality int      `yaml:"image_quality"`
+			// HLS transcodes videos into adaptive renditions (ffmpeg with libx264/aac required).
+			HLS struct {
+				Enabled        bool     `yaml:"enabled"`
+				SegmentSeconds int      `yaml:"segment_seconds"`
+				PosterSecond   int      `yaml:"poster_second"`
+				Renditions     []string `yaml:"renditions"`
+			} `yaml:"hls"`
+			// ZipPrefetch/ZipReadAheadMB bound the memory used while streaming listing ZIPs.
+			ZipPrefetch    int `yaml:"zip_prefetch"`
+			ZipReadAheadMB int `yaml:"zip_read_ahead_mb"`
+		} `yaml:"local"`
 		Storage struct {
 			UploadURLTTLSeconds   int `yaml:"upload_url_ttl_seconds"`
 			DownloadURLTTLSeconds int `yaml:"download_url_ttl_seconds"`
 		} `yaml:"storage"`
 		Workflow struct {
 			FinalizationStateMachineARN string `yaml:"finalization_state_machine_arn"`
-			Region                      string `yaml:"region"`
-			StuckJobTimeoutMinutes      int    `yaml:"stuck_job_timeout_minutes"`
+			// ProcessingStateMachineARN addresses processing executions that have not called back yet.
+			ProcessingStateMachineARN string `yaml:"processing_state_machine_arn"`
+			Region                    string `yaml:"region"`
+			StuckJobTimeoutMinutes    int    `yaml:"stuck_job_timeout_minutes"`
 		} `yaml:"workflow"`
 		Queue struct {
 			URL      string `yaml:"url"`
@@ -165,6 +225,41 @@ type Environment struct {
 		Callback struct {
 			SharedSecret string `yaml:"shared_secret"`
 		} `yaml:"callback"`
+		// Streaming configures the signed HLS playlist and panorama tile endpoints.
+		Streaming struct {
+			PlaylistBaseURL string `yaml:"playlist_base_url"`
+			PanoramaBaseURL string `yaml:"panorama_base_url"`
+			TokenSecret     string `yaml:"token_secret"`
+			TokenTTLSeconds int    `yaml:"token_ttl_seconds"`
+		} `yaml:"streaming"`
+		// Watermark brands the public photo variants of the listed asset types ("PHOTO_HORIZONTAL", ...).
+		// Key points to a PNG stored in the media bucket; an empty key or asset type list disables it.
+		Watermark struct {
+			Key           string   `yaml:"key"`
+			Position      string   `yaml:"position"`
+			Opacity       float64  `yaml:"opacity"`
+			WidthPercent  int      `yaml:"width_percent"`
+			MarginPercent int      `yaml:"margin_percent"`
+			Sizes         []string `yaml:"sizes"`
+			AssetTypes    []string `yaml:"asset_types"`
+		} `yaml:"watermark"`
+		// Quality configures the photo quality gate evaluated when processing results arrive.
+		// Zero thresholds use the defaults; Block lists the issues that keep the listing from
+		// owner approval (the others only flag the photo).
+		Quality struct {
+			Disabled             bool     `yaml:"disabled"`
+			MinShortSidePx       int      `yaml:"min_short_side_px"`
+			MinBlurScore         float64  `yaml:"min_blur_score"`
+			MinBrightness        float64  `yaml:"min_brightness"`
+			MaxBrightness        float64  `yaml:"max_brightness"`
+			MaxClippedRatio      float64  `yaml:"max_clipped_ratio"`
+			DuplicateMaxDistance int      `yaml:"duplicate_max_distance"`
+			Block                []string `yaml:"block"`
+		} `yaml:"quality"`
+		// Parcel configures the cross-check of KMZ/KML parcels against the declared land measures.
+		Parcel struct {
+			Tolerance float64 `yaml:"tolerance"`
+		} `yaml:"parcel"`
 		Limits struct {
 			MaxFilesPerBatch    int      `yaml:"max_files_per_batch"`
 			MaxTotalBytes       int64    `yaml:"max_total_bytes"`
@@ -199,6 +294,27 @@ type Environment struct {
 		NewListingHoursThreshold   int `yaml:"new_listing_hours_threshold"`
 		PriceChangedHoursThreshold int `yaml:"price_changed_hours_threshold"`
 	} `yaml:"listings"`
+	RoleGrants struct {
+		SchedulerIntervalMinutes int `yaml:"scheduler_interval_minutes"`
+		ExpiryNoticeHours        int `yaml:"expiry_notice_hours"`
+		BatchSize                int `yaml:"batch_size"`
+	} `yaml:"role_grants"`
+	DataExport struct {
+		ProcessIntervalSeconds int `yaml:"process_interval_seconds"`
+		BatchSize              int `yaml:"batch_size"`
+		DownloadURLTTLHours    int `yaml:"download_url_ttl_hours"`
+		StaleAfterMinutes      int `yaml:"stale_after_minutes"`
+		MaxAttempts            int `yaml:"max_attempts"`
+	} `yaml:"data_export"`
+	Consent struct {
+		PolicyVersion string `yaml:"policy_version"`
+	} `yaml:"consent"`
+	CalendarFeeds struct {
+		BaseURL                string `yaml:"base_url"`
+		PastDays               int    `yaml:"past_days"`
+		FutureDays             int    `yaml:"future_days"`
+		RefreshIntervalMinutes int    `yaml:"refresh_interval_minutes"`
+	} `yaml:"calendar_feeds"`
 	Retention struct {
 		DeviceTokens struct {
 			MaxAgeDays             int `yaml:"max_age_days"`
@@ -0,0 +1,70 @@
+package globalmodel
+
+// NotificationChannel identifies the medium a notification is delivered through,
+// as seen by the user in the preference center.
+type NotificationChannel string
+
+const (
+	NotificationChannelEmail NotificationChannel = "email"
+	NotificationChannelSMS   NotificationChannel = "sms"
+	NotificationChannelPush  NotificationChannel = "push"
+)
+
+// NotificationChannels lists every channel exposed in the preference center.
+var NotificationChannels = []NotificationChannel{
+	NotificationChannelEmail,
+	NotificationChannelSMS,
+	NotificationChannelPush,
+}
+
+// IsValid reports whether the channel is supported.
+func (c NotificationChannel) IsValid() bool {
+	for _, channel := range NotificationChannels {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
+
+// NotificationCategory groups notifications by purpose for consent management.
+type NotificationCategory string
+
+const (
+	// NotificationCategoryTransactional covers security, account and contractual messages; always delivered.
+	NotificationCategoryTransactional NotificationCategory = "transactional"
+	NotificationCategoryVisitUpdates  NotificationCategory = "visit_updates"
+	NotificationCategoryProposals     NotificationCategory = "proposal_updates"
+	NotificationCategorySavedSearch   NotificationCategory = "saved_search_alerts"
+	NotificationCategoryMarketing     NotificationCategory = "marketing"
+)
+
+// NotificationCategories lists every category exposed in the preference center.
+var NotificationCategories = []NotificationCategory{
+	NotificationCategoryTransactional,
+	NotificationCategoryVisitUpdates,
+	NotificationCategoryProposals,
+	NotificationCategorySavedSearch,
+	NotificationCategoryMarketing,
+}
+
+// IsValid reports whether the category is supported.
+func (c NotificationCategory) IsValid() bool {
+	for _, category := range NotificationCategories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// IsMandatory reports whether the category ignores user consent (transactional messages).
+func (c NotificationCategory) IsMandatory() bool {
+	return c == NotificationCategoryTransactional
+}
+
+// DefaultConsent returns the implicit consent used when the user never recorded a choice.
+// Service updates default to granted (legitimate interest); marketing requires explicit opt-in.
+func (c NotificationCategory) DefaultConsent() bool {
+	return c != NotificationCategoryMarketing
+}
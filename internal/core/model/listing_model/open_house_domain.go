@@ -0,0 +1,270 @@
+package listingmodel
+
+import "time"
+
+type openHouse struct {
+	id                 int64
+	listingIdentityID  int64
+	ownerUserID        int64
+	agendaEntryID      uint64
+	agendaEntryValid   bool
+	startsAt           time.Time
+	endsAt             time.Time
+	capacity           uint16
+	slotMinutes        uint16
+	status             OpenHouseStatus
+	notes              string
+	notesValid         bool
+	cancellationReason string
+	cancellationValid  bool
+	createdAt          time.Time
+	updatedAt          time.Time
+}
+
+func (o *openHouse) ID() int64 {
+	return o.id
+}
+
+func (o *openHouse) SetID(id int64) {
+	o.id = id
+}
+
+func (o *openHouse) ListingIdentityID() int64 {
+	return o.listingIdentityID
+}
+
+func (o *openHouse) SetListingIdentityID(id int64) {
+	o.listingIdentityID = id
+}
+
+func (o *openHouse) OwnerUserID() int64 {
+	return o.ownerUserID
+}
+
+func (o *openHouse) SetOwnerUserID(id int64) {
+	o.ownerUserID = id
+}
+
+func (o *openHouse) AgendaEntryID() (uint64, bool) {
+	return o.agendaEntryID, o.agendaEntryValid
+}
+
+func (o *openHouse) SetAgendaEntryID(id uint64) {
+	o.agendaEntryID = id
+	o.agendaEntryValid = true
+}
+
+func (o *openHouse) ClearAgendaEntryID() {
+	o.agendaEntryID = 0
+	o.agendaEntryValid = false
+}
+
+func (o *openHouse) StartsAt() time.Time {
+	return o.startsAt
+}
+
+func (o *openHouse) SetStartsAt(value time.Time) {
+	o.startsAt = value
+}
+
+func (o *openHouse) EndsAt() time.Time {
+	return o.endsAt
+}
+
+func (o *openHouse) SetEndsAt(value time.Time) {
+	o.endsAt = value
+}
+
+func (o *openHouse) Capacity() uint16 {
+	return o.capacity
+}
+
+func (o *openHouse) SetCapacity(value uint16) {
+	o.capacity = value
+}
+
+func (o *openHouse) SlotMinutes() uint16 {
+	return o.slotMinutes
+}
+
+func (o *openHouse) SetSlotMinutes(value uint16) {
+	o.slotMinutes = value
+}
+
+func (o *openHouse) Status() OpenHouseStatus {
+	return o.status
+}
+
+func (o *openHouse) SetStatus(value OpenHouseStatus) {
+	o.status = value
+}
+
+func (o *openHouse) Notes() (string, bool) {
+	return o.notes, o.notesValid
+}
+
+func (o *openHouse) SetNotes(value string) {
+	o.notes = value
+	o.notesValid = true
+}
+
+func (o *openHouse) ClearNotes() {
+	o.notes = ""
+	o.notesValid = false
+}
+
+func (o *openHouse) CancellationReason() (string, bool) {
+	return o.cancellationReason, o.cancellationValid
+}
+
+func (o *openHouse) SetCancellationReason(value string) {
+	o.cancellationReason = value
+	o.cancellationValid = true
+}
+
+func (o *openHouse) ClearCancellationReason() {
+	o.cancellationReason = ""
+	o.cancellationValid = false
+}
+
+func (o *openHouse) CreatedAt() time.Time {
+	return o.createdAt
+}
+
+func (o *openHouse) SetCreatedAt(value time.Time) {
+	o.createdAt = value
+}
+
+func (o *openHouse) UpdatedAt() time.Time {
+	return o.updatedAt
+}
+
+func (o *openHouse) SetUpdatedAt(value time.Time) {
+	o.updatedAt = value
+}
+
+func (o *openHouse) SlotStarts() []time.Time {
+	if o.slotMinutes == 0 {
+		return []time.Time{o.startsAt}
+	}
+	step := time.Duration(o.slotMinutes) * time.Minute
+	starts := make([]time.Time, 0)
+	for cursor := o.startsAt; !cursor.Add(step).After(o.endsAt); cursor = cursor.Add(step) {
+		starts = append(starts, cursor)
+	}
+	return starts
+}
+
+func (o *openHouse) SlotEnd(start time.Time) time.Time {
+	if o.slotMinutes == 0 {
+		return o.endsAt
+	}
+	return start.Add(time.Duration(o.slotMinutes) * time.Minute)
+}
+
+type openHouseRegistration struct {
+	id            int64
+	openHouseID   int64
+	realtorUserID int64
+	clientName    string
+	clientPhone   string
+	phoneValid    bool
+	slotStart     time.Time
+	status        OpenHouseRegistrationStatus
+	checkedInAt   time.Time
+	checkedValid  bool
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+func (r *openHouseRegistration) ID() int64 {
+	return r.id
+}
+
+func (r *openHouseRegistration) SetID(id int64) {
+	r.id = id
+}
+
+func (r *openHouseRegistration) OpenHouseID() int64 {
+	return r.openHouseID
+}
+
+func (r *openHouseRegistration) SetOpenHouseID(id int64) {
+	r.openHouseID = id
+}
+
+func (r *openHouseRegistration) RealtorUserID() int64 {
+	return r.realtorUserID
+}
+
+func (r *openHouseRegistration) SetRealtorUserID(id int64) {
+	r.realtorUserID = id
+}
+
+func (r *openHouseRegistration) ClientName() string {
+	return r.clientName
+}
+
+func (r *openHouseRegistration) SetClientName(value string) {
+	r.clientName = value
+}
+
+func (r *openHouseRegistration) ClientPhone() (string, bool) {
+	return r.clientPhone, r.phoneValid
+}
+
+func (r *openHouseRegistration) SetClientPhone(value string) {
+	r.clientPhone = value
+	r.phoneValid = true
+}
+
+func (r *openHouseRegistration) ClearClientPhone() {
+	r.clientPhone = ""
+	r.phoneValid = false
+}
+
+func (r *openHouseRegistration) SlotStart() time.Time {
+	return r.slotStart
+}
+
+func (r *openHouseRegistration) SetSlotStart(value time.Time) {
+	r.slotStart = value
+}
+
+func (r *openHouseRegistration) Status() OpenHouseRegistrationStatus {
+	return r.status
+}
+
+func (r *openHouseRegistration) SetStatus(value OpenHouseRegistrationStatus) {
+	r.status = value
+}
+
+func (r *openHouseRegistration) CheckedInAt() (time.Time, bool) {
+	return r.checkedInAt, r.checkedValid
+}
+
+func (r *openHouseRegistration) SetCheckedInAt(value time.Time) {
+	r.checkedInAt = value
+	r.checkedValid = true
+}
+
+func (r *openHouseRegistration) ClearCheckedInAt() {
+	r.checkedInAt = time.Time{}
+	r.checkedValid = false
+}
+
+func (r *openHouseRegistration) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+func (r *openHouseRegistration) SetCreatedAt(value time.Time) {
+	r.createdAt = value
+}
+
+func (r *openHouseRegistration) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+func (r *openHouseRegistration) SetUpdatedAt(value time.Time) {
+	r.updatedAt = value
+}
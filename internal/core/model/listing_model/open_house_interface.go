@@ -0,0 +1,98 @@
+package listingmodel
+
+import "time"
+
+// OpenHouseInterface represents a group visit window opened by the owner on a listing agenda.
+type OpenHouseInterface interface {
+	ID() int64
+	SetID(id int64)
+
+	ListingIdentityID() int64
+	SetListingIdentityID(id int64)
+
+	OwnerUserID() int64
+	SetOwnerUserID(id int64)
+
+	AgendaEntryID() (uint64, bool)
+	SetAgendaEntryID(id uint64)
+	ClearAgendaEntryID()
+
+	StartsAt() time.Time
+	SetStartsAt(value time.Time)
+
+	EndsAt() time.Time
+	SetEndsAt(value time.Time)
+
+	// Capacity is the number of clients accepted per slot (or for the whole window when there are no slots).
+	Capacity() uint16
+	SetCapacity(value uint16)
+
+	// SlotMinutes splits the window into fixed slots; zero means a single slot spanning the window.
+	SlotMinutes() uint16
+	SetSlotMinutes(value uint16)
+
+	Status() OpenHouseStatus
+	SetStatus(value OpenHouseStatus)
+
+	Notes() (string, bool)
+	SetNotes(value string)
+	ClearNotes()
+
+	CancellationReason() (string, bool)
+	SetCancellationReason(value string)
+	ClearCancellationReason()
+
+	CreatedAt() time.Time
+	SetCreatedAt(value time.Time)
+
+	UpdatedAt() time.Time
+	SetUpdatedAt(value time.Time)
+
+	// SlotStarts returns the start of every slot inside the window.
+	SlotStarts() []time.Time
+	// SlotEnd returns the end of the slot beginning at start.
+	SlotEnd(start time.Time) time.Time
+}
+
+// OpenHouseRegistrationInterface represents a client registered into an open house by a realtor.
+type OpenHouseRegistrationInterface interface {
+	ID() int64
+	SetID(id int64)
+
+	OpenHouseID() int64
+	SetOpenHouseID(id int64)
+
+	RealtorUserID() int64
+	SetRealtorUserID(id int64)
+
+	ClientName() string
+	SetClientName(value string)
+
+	ClientPhone() (string, bool)
+	SetClientPhone(value string)
+	ClearClientPhone()
+
+	SlotStart() time.Time
+	SetSlotStart(value time.Time)
+
+	Status() OpenHouseRegistrationStatus
+	SetStatus(value OpenHouseRegistrationStatus)
+
+	CheckedInAt() (time.Time, bool)
+	SetCheckedInAt(value time.Time)
+	ClearCheckedInAt()
+
+	CreatedAt() time.Time
+	SetCreatedAt(value time.Time)
+
+	UpdatedAt() time.Time
+	SetUpdatedAt(value time.Time)
+}
+
+func NewOpenHouse() OpenHouseInterface {
+	return &openHouse{}
+}
+
+func NewOpenHouseRegistration() OpenHouseRegistrationInterface {
+	return &openHouseRegistration{}
+}
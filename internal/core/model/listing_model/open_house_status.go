@@ -0,0 +1,67 @@
+package listingmodel
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OpenHouseStatus describes the lifecycle state of an open-house event.
+type OpenHouseStatus string
+
+const (
+	OpenHouseStatusScheduled OpenHouseStatus = "SCHEDULED"
+	OpenHouseStatusCancelled OpenHouseStatus = "CANCELLED"
+)
+
+// ParseOpenHouseStatus converts a string to OpenHouseStatus with case-insensitive matching.
+func ParseOpenHouseStatus(raw string) (OpenHouseStatus, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(raw))
+	switch normalized {
+	case "SCHEDULED":
+		return OpenHouseStatusScheduled, nil
+	case "CANCELLED":
+		return OpenHouseStatusCancelled, nil
+	default:
+		return "", fmt.Errorf("invalid open house status: %s", raw)
+	}
+}
+
+// OpenHouseRegistrationStatus describes the state of a client registered into an open house.
+type OpenHouseRegistrationStatus string
+
+const (
+	OpenHouseRegistrationRegistered OpenHouseRegistrationStatus = "REGISTERED"
+	OpenHouseRegistrationWaitlisted OpenHouseRegistrationStatus = "WAITLISTED"
+	OpenHouseRegistrationCancelled  OpenHouseRegistrationStatus = "CANCELLED"
+	OpenHouseRegistrationCheckedIn  OpenHouseRegistrationStatus = "CHECKED_IN"
+	OpenHouseRegistrationNoShow     OpenHouseRegistrationStatus = "NO_SHOW"
+)
+
+// ParseOpenHouseRegistrationStatus converts a string to OpenHouseRegistrationStatus with case-insensitive matching.
+func ParseOpenHouseRegistrationStatus(raw string) (OpenHouseRegistrationStatus, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(raw))
+	switch normalized {
+	case "REGISTERED":
+		return OpenHouseRegistrationRegistered, nil
+	case "WAITLISTED":
+		return OpenHouseRegistrationWaitlisted, nil
+	case "CANCELLED":
+		return OpenHouseRegistrationCancelled, nil
+	case "CHECKED_IN":
+		return OpenHouseRegistrationCheckedIn, nil
+	case "NO_SHOW":
+		return OpenHouseRegistrationNoShow, nil
+	default:
+		return "", fmt.Errorf("invalid open house registration status: %s", raw)
+	}
+}
+
+// HoldsSeat returns true when the registration consumes capacity of its slot.
+func (s OpenHouseRegistrationStatus) HoldsSeat() bool {
+	return s == OpenHouseRegistrationRegistered || s == OpenHouseRegistrationCheckedIn || s == OpenHouseRegistrationNoShow
+}
+
+// IsActive returns true when the registration still expects the client to attend.
+func (s OpenHouseRegistrationStatus) IsActive() bool {
+	return s == OpenHouseRegistrationRegistered || s == OpenHouseRegistrationWaitlisted
+}
@@ -27,3 +27,28 @@ type VisitListResult struct {
 	Visits []VisitWithListing
 	Total  int64
 }
+
+// OpenHouseListFilter constrains open-house lookups for owners or realtors.
+type OpenHouseListFilter struct {
+	ListingIdentityID *int64
+	OwnerUserID       *int64
+	Statuses          []OpenHouseStatus
+	From              *time.Time
+	To                *time.Time
+	Page              int
+	Limit             int
+}
+
+// OpenHouseWithCounts aggregates an open house with its registration counters.
+type OpenHouseWithCounts struct {
+	OpenHouse       OpenHouseInterface
+	RegisteredCount int64
+	WaitlistCount   int64
+	CheckedInCount  int64
+}
+
+// OpenHouseListResult holds a paginated open-house collection.
+type OpenHouseListResult struct {
+	OpenHouses []OpenHouseWithCounts
+	Total      int64
+}
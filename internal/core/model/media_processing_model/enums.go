@@ -38,6 +38,10 @@ const (
 	MediaAssetTypeZip             MediaAssetType = "ZIP"
 	MediaAssetTypeProjectDoc      MediaAssetType = "PROJECT_DOC"
 	MediaAssetTypeProjectRender   MediaAssetType = "PROJECT_RENDER"
+	// MediaAssetTypePanorama360 is an equirectangular (2:1) 360° photo, tiled for panorama viewers.
+	MediaAssetTypePanorama360 MediaAssetType = "PANORAMA_360"
+	// MediaAssetTypeLandParcel is the KMZ/KML document with the parcel polygon of a land listing.
+	MediaAssetTypeLandParcel MediaAssetType = "LAND_PARCEL"
 )
 
 // MediaAssetOrientation stores the canonical orientation for assets that support layout decisions.
@@ -55,8 +59,16 @@ const (
 	MediaProcessingProviderStepFunctions             MediaProcessingProvider = "STEP_FUNCTIONS"
 	MediaProcessingProviderStepFunctionsFinalization MediaProcessingProvider = "STEP_FUNCTIONS_FINALIZATION"
 	MediaProcessingProviderMediaConvert              MediaProcessingProvider = "MEDIACONVERT"
+	// MediaProcessingProviderLocal marks processing jobs run by the in-process pipeline.
+	MediaProcessingProviderLocal MediaProcessingProvider = "LOCAL"
 )
 
+// IsProcessing reports whether the provider runs the per-asset processing pipeline, either on
+// Step Functions or in process.
+func (p MediaProcessingProvider) IsProcessing() bool {
+	return p == MediaProcessingProviderStepFunctions || p == MediaProcessingProviderLocal
+}
+
 // MediaProcessingJobStatus mirrors the async job state reported by Step Functions/MediaConvert.
 type MediaProcessingJobStatus string
 
@@ -66,11 +78,19 @@ const (
 	MediaProcessingJobStatusSucceeded MediaProcessingJobStatus = "SUCCEEDED"
 	MediaProcessingJobStatusPartial   MediaProcessingJobStatus = "PARTIAL_SUCCESS"
 	MediaProcessingJobStatusFailed    MediaProcessingJobStatus = "FAILED"
+	// MediaProcessingJobStatusCancelled is set by an admin; late callbacks of the job are ignored.
+	MediaProcessingJobStatusCancelled MediaProcessingJobStatus = "CANCELLED"
 )
 
 // IsTerminal reports whether the async job reached a final outcome.
 func (s MediaProcessingJobStatus) IsTerminal() bool {
-	return s == MediaProcessingJobStatusSucceeded || s == MediaProcessingJobStatusPartial || s == MediaProcessingJobStatusFailed
+	return s == MediaProcessingJobStatusSucceeded || s == MediaProcessingJobStatusPartial || s == MediaProcessingJobStatusFailed ||
+		s == MediaProcessingJobStatusCancelled
+}
+
+// IsValid reports whether the status is one of the known job states.
+func (s MediaProcessingJobStatus) IsValid() bool {
+	return s == MediaProcessingJobStatusPending || s == MediaProcessingJobStatusRunning || s.IsTerminal()
 }
 
 // MediaAssetStatus represents the lifecycle of a single media asset.
@@ -81,4 +101,7 @@ const (
 	MediaAssetStatusProcessing    MediaAssetStatus = "PROCESSING"
 	MediaAssetStatusProcessed     MediaAssetStatus = "PROCESSED"
 	MediaAssetStatusFailed        MediaAssetStatus = "FAILED"
+	// MediaAssetStatusDiscarded hides a failed asset left out by a force-completed job; its raw
+	// upload is kept so the owner can still re-upload or delete it.
+	MediaAssetStatusDiscarded MediaAssetStatus = "DISCARDED"
 )
@@ -0,0 +1,127 @@
+package mediaprocessingmodel
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// Gallery arrangement is stored in the asset metadata next to the pipeline keys.
+const (
+	// GalleryCoverMetadataKey marks the cover photo of its orientation ("true").
+	GalleryCoverMetadataKey = "gallery_cover"
+	// RoomTagMetadataKey holds the MediaRoomTag of a photo.
+	RoomTagMetadataKey = "room_tag"
+	// RoomFeatureIDMetadataKey and RoomLabelMetadataKey identify the listing feature of a FEATURE tag.
+	RoomFeatureIDMetadataKey = "room_feature_id"
+	RoomLabelMetadataKey     = "room_label"
+)
+
+// GalleryMetadataKeys are rewritten on every arrangement.
+var GalleryMetadataKeys = []string{
+	GalleryCoverMetadataKey,
+	RoomTagMetadataKey,
+	RoomFeatureIDMetadataKey,
+	RoomLabelMetadataKey,
+}
+
+// MediaRoomTag classifies what a photo shows.
+type MediaRoomTag string
+
+const (
+	// MediaRoomTagFeature links the photo to one of the listing features (kitchen, suite, ...).
+	MediaRoomTagFeature    MediaRoomTag = "FEATURE"
+	MediaRoomTagFacade     MediaRoomTag = "FACADE"
+	MediaRoomTagCommonArea MediaRoomTag = "COMMON_AREA"
+)
+
+// IsValid reports whether the tag is supported.
+func (t MediaRoomTag) IsValid() bool {
+	switch t {
+	case MediaRoomTagFeature, MediaRoomTagFacade, MediaRoomTagCommonArea:
+		return true
+	default:
+		return false
+	}
+}
+
+// SupportsGalleryCover reports whether assets of the type can be picked as cover; there is one
+// cover per photo orientation.
+func SupportsGalleryCover(assetType MediaAssetType) bool {
+	return assetType == MediaAssetTypePhotoHorizontal || assetType == MediaAssetTypePhotoVertical
+}
+
+// SupportsRoomTag reports whether assets of the type can be tagged by room.
+func SupportsRoomTag(assetType MediaAssetType) bool {
+	return SupportsGalleryCover(assetType) || assetType == MediaAssetTypeProjectRender
+}
+
+// GalleryRevision fingerprints the arrangement of a listing gallery (assets, order, cover and
+// tags). Clients send it back when rearranging so concurrent edits are detected.
+func GalleryRevision(assets []MediaAsset) string {
+	lines := make([]string, 0, len(assets))
+	for _, asset := range assets {
+		metadata := make(map[string]string)
+		if raw := asset.Metadata(); raw != "" {
+			_ = json.Unmarshal([]byte(raw), &metadata)
+		}
+		lines = append(lines, fmt.Sprintf("%d|%s|%d|%s|%s|%s",
+			asset.ID(),
+			asset.AssetType(),
+			asset.Sequence(),
+			metadata[GalleryCoverMetadataKey],
+			metadata[RoomTagMetadataKey],
+			metadata[RoomFeatureIDMetadataKey],
+		))
+	}
+	sort.Strings(lines)
+
+	digest := sha256.New()
+	for _, line := range lines {
+		digest.Write([]byte(line))
+		digest.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(digest.Sum(nil))[:16]
+}
+
+// GalleryPlacement is the arrangement data of one asset.
+type GalleryPlacement struct {
+	Cover         bool
+	RoomTag       MediaRoomTag
+	RoomFeatureID int64
+	RoomLabel     string
+}
+
+// GalleryPlacementFromMetadata reads the arrangement stored in the asset metadata.
+func GalleryPlacementFromMetadata(metadata map[string]string) GalleryPlacement {
+	placement := GalleryPlacement{
+		Cover:     metadata[GalleryCoverMetadataKey] == "true",
+		RoomTag:   MediaRoomTag(metadata[RoomTagMetadataKey]),
+		RoomLabel: metadata[RoomLabelMetadataKey],
+	}
+	if raw := metadata[RoomFeatureIDMetadataKey]; raw != "" {
+		placement.RoomFeatureID, _ = strconv.ParseInt(raw, 10, 64)
+	}
+	return placement
+}
+
+// Apply writes the placement into metadata, clearing previous arrangement keys.
+func (p GalleryPlacement) Apply(metadata map[string]string) {
+	for _, key := range GalleryMetadataKeys {
+		delete(metadata, key)
+	}
+	if p.Cover {
+		metadata[GalleryCoverMetadataKey] = "true"
+	}
+	if p.RoomTag == "" {
+		return
+	}
+	metadata[RoomTagMetadataKey] = string(p.RoomTag)
+	if p.RoomTag == MediaRoomTagFeature {
+		metadata[RoomFeatureIDMetadataKey] = strconv.FormatInt(p.RoomFeatureID, 10)
+		metadata[RoomLabelMetadataKey] = p.RoomLabel
+	}
+}
@@ -0,0 +1,38 @@
+package mediaprocessingmodel
+
+import (
+	"path"
+	"strings"
+)
+
+// Generated asset types emitted by the HLS transcoding stage.
+const (
+	JobAssetTypeHLSMaster   = "VIDEO_HLS_MASTER"
+	JobAssetTypeHLSPlaylist = "VIDEO_HLS_PLAYLIST"
+	JobAssetTypeHLSPoster   = "VIDEO_HLS_POSTER"
+)
+
+// Metadata keys persisted on video assets once HLS renditions are available.
+const (
+	HLSMasterMetadataKey          = "hls_master"
+	HLSPosterMetadataKey          = "hls_poster"
+	HLSRenditionMetadataKeyPrefix = "hls_rendition_"
+)
+
+// HLSMasterPlaylistName is the file name of the master playlist inside the HLS directory.
+const HLSMasterPlaylistName = "master.m3u8"
+
+// HLSRenditionMetadataKey returns the metadata key holding the media playlist of a rendition.
+func HLSRenditionMetadataKey(rendition string) string {
+	return HLSRenditionMetadataKeyPrefix + strings.ToLower(rendition)
+}
+
+// HLSRenditionFromPlaylistKey extracts the rendition name from a media playlist key
+// ({listingId}/processed/video/{orientation}/hls/{name}/{rendition}/index.m3u8).
+func HLSRenditionFromPlaylistKey(key string) string {
+	rendition := path.Base(path.Dir(key))
+	if rendition == "." || rendition == "/" {
+		return ""
+	}
+	return rendition
+}
@@ -0,0 +1,80 @@
+package mediaprocessingmodel
+
+import "strings"
+
+// ImageProcessingOptions tunes how the photo derivatives of one asset type are generated.
+// Metadata (EXIF/XMP/GPS) is always stripped from derivatives and is therefore not an option;
+// the raw upload is never rewritten.
+type ImageProcessingOptions struct {
+	Watermark *WatermarkOptions `json:"watermark,omitempty"`
+}
+
+// WatermarkPosition anchors the watermark inside the variant.
+type WatermarkPosition string
+
+const (
+	WatermarkPositionBottomRight WatermarkPosition = "bottom-right"
+	WatermarkPositionBottomLeft  WatermarkPosition = "bottom-left"
+	WatermarkPositionTopRight    WatermarkPosition = "top-right"
+	WatermarkPositionTopLeft     WatermarkPosition = "top-left"
+	WatermarkPositionCenter      WatermarkPosition = "center"
+)
+
+// DefaultWatermarkSizes lists the public-resolution variants branded when no sizes are configured.
+// Thumbnails and small previews stay clean because the mark would not be legible.
+var DefaultWatermarkSizes = []string{"medium", "large"}
+
+// WatermarkOptions describes the branding overlay applied to public-resolution variants.
+type WatermarkOptions struct {
+	// Key points to a PNG (with alpha) stored in the media bucket.
+	Key      string            `json:"key"`
+	Position WatermarkPosition `json:"position,omitempty"`
+	// Opacity ranges from 0 (invisible) to 1 (opaque).
+	Opacity float64 `json:"opacity,omitempty"`
+	// WidthPercent sizes the watermark relative to the variant width.
+	WidthPercent int `json:"widthPercent,omitempty"`
+	// MarginPercent offsets the watermark from the anchored edges, relative to the shortest side.
+	MarginPercent int      `json:"marginPercent,omitempty"`
+	Sizes         []string `json:"sizes,omitempty"`
+}
+
+// Normalized fills unset fields with defaults and clamps out-of-range values.
+func (w WatermarkOptions) Normalized() WatermarkOptions {
+	w.Key = strings.TrimSpace(w.Key)
+	switch w.Position {
+	case WatermarkPositionBottomRight, WatermarkPositionBottomLeft, WatermarkPositionTopRight, WatermarkPositionTopLeft, WatermarkPositionCenter:
+	default:
+		w.Position = WatermarkPositionBottomRight
+	}
+	if w.Opacity <= 0 || w.Opacity > 1 {
+		w.Opacity = 0.6
+	}
+	if w.WidthPercent <= 0 || w.WidthPercent > 100 {
+		w.WidthPercent = 20
+	}
+	if w.MarginPercent <= 0 || w.MarginPercent > 25 {
+		w.MarginPercent = 3
+	}
+	if len(w.Sizes) == 0 {
+		w.Sizes = DefaultWatermarkSizes
+	}
+	return w
+}
+
+// AppliesTo reports whether the named variant size receives the watermark.
+func (w WatermarkOptions) AppliesTo(size string) bool {
+	for _, candidate := range w.Sizes {
+		if strings.EqualFold(strings.TrimSpace(candidate), size) {
+			return true
+		}
+	}
+	return false
+}
+
+// ImageOptionsFor returns the options configured for an asset type ("PHOTO_VERTICAL", ...).
+func ImageOptionsFor(options map[string]ImageProcessingOptions, assetType string) ImageProcessingOptions {
+	if opts, ok := options[strings.ToUpper(strings.TrimSpace(assetType))]; ok {
+		return opts
+	}
+	return ImageProcessingOptions{}
+}
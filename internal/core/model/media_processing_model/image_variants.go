@@ -0,0 +1,119 @@
+package mediaprocessingmodel
+
+import (
+	"path"
+	"strings"
+)
+
+// ImageVariantFormat identifies the encoding of a processed image variant.
+type ImageVariantFormat string
+
+const (
+	// ImageVariantFormatJPEG is the canonical format; every size is always produced as JPEG.
+	ImageVariantFormatJPEG ImageVariantFormat = "jpeg"
+	// ImageVariantFormatWebP is produced alongside JPEG when the pipeline encoder supports it.
+	ImageVariantFormatWebP ImageVariantFormat = "webp"
+	// ImageVariantFormatAVIF is produced alongside JPEG when the pipeline encoder supports it.
+	ImageVariantFormatAVIF ImageVariantFormat = "avif"
+)
+
+// ImageVariantFormats lists the formats in client preference order (smallest first).
+var ImageVariantFormats = []ImageVariantFormat{ImageVariantFormatAVIF, ImageVariantFormatWebP, ImageVariantFormatJPEG}
+
+// ParseImageVariantFormat normalizes user/config input ("jpg", "WEBP", "") into a format.
+// Empty input resolves to JPEG.
+func ParseImageVariantFormat(raw string) (ImageVariantFormat, bool) {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "", "jpeg", "jpg":
+		return ImageVariantFormatJPEG, true
+	case "webp":
+		return ImageVariantFormatWebP, true
+	case "avif":
+		return ImageVariantFormatAVIF, true
+	default:
+		return "", false
+	}
+}
+
+// Extension returns the file extension used in object keys for the format.
+func (f ImageVariantFormat) Extension() string {
+	switch f {
+	case ImageVariantFormatWebP:
+		return ".webp"
+	case ImageVariantFormatAVIF:
+		return ".avif"
+	default:
+		return ".jpg"
+	}
+}
+
+// ContentType returns the MIME type stored with the object.
+func (f ImageVariantFormat) ContentType() string {
+	switch f {
+	case ImageVariantFormatWebP:
+		return "image/webp"
+	case ImageVariantFormatAVIF:
+		return "image/avif"
+	default:
+		return "image/jpeg"
+	}
+}
+
+// ImageVariantFormatFromKey infers the format of a processed variant from its key.
+// JPEG variants keep the raw upload filename, so a variant sharing the raw key extension is
+// always JPEG (even for .webp uploads); otherwise .webp/.avif identify the alternative formats.
+func ImageVariantFormatFromKey(key, rawKey string) ImageVariantFormat {
+	ext := strings.ToLower(path.Ext(key))
+	if rawKey != "" && ext == strings.ToLower(path.Ext(rawKey)) {
+		return ImageVariantFormatJPEG
+	}
+	switch ext {
+	case ".webp":
+		return ImageVariantFormatWebP
+	case ".avif":
+		return ImageVariantFormatAVIF
+	default:
+		return ImageVariantFormatJPEG
+	}
+}
+
+// ImageVariantSize describes one responsive width generated for photos.
+type ImageVariantSize struct {
+	Name  string
+	Width int
+}
+
+// ImageVariantSizes lists the widths generated for every photo, smallest first.
+// The names double as the resolution segment in processed keys.
+var ImageVariantSizes = []ImageVariantSize{
+	{Name: "thumbnail", Width: 200},
+	{Name: "small", Width: 400},
+	{Name: "medium", Width: 800},
+	{Name: "large", Width: 1200},
+}
+
+// ImageVariantWidth returns the pixel width of a named size.
+func ImageVariantWidth(name string) (int, bool) {
+	for _, size := range ImageVariantSizes {
+		if strings.EqualFold(size.Name, name) {
+			return size.Width, true
+		}
+	}
+	return 0, false
+}
+
+// ProcessedKeyResolution extracts the size segment from a processed key
+// ({listingId}/processed/{mediaType}/{orientation}/{size}/{file}); empty when absent.
+func ProcessedKeyResolution(key string) string {
+	lowerKey := strings.ToLower(key)
+	idx := strings.Index(lowerKey, "/processed/")
+	if idx == -1 {
+		return ""
+	}
+
+	segments := strings.Split(key[idx+len("/processed/"):], "/")
+	if len(segments) < 4 {
+		return ""
+	}
+	return segments[2]
+}
@@ -3,6 +3,7 @@ package mediaprocessingmodel
 import (
 	"database/sql"
 	"encoding/json"
+	"strings"
 )
 
 // MediaAsset represents a single media file associated with a listing.
@@ -34,6 +35,7 @@ func (a *MediaAsset) SetID(id uint64)              { a.id = id }
 func (a *MediaAsset) ListingIdentityID() uint64    { return a.listingIdentityID }
 func (a *MediaAsset) AssetType() MediaAssetType    { return a.assetType }
 func (a *MediaAsset) Sequence() uint8              { return a.sequence }
+func (a *MediaAsset) SetSequence(sequence uint8)   { a.sequence = sequence }
 func (a *MediaAsset) Status() MediaAssetStatus     { return a.status }
 func (a *MediaAsset) SetStatus(s MediaAssetStatus) { a.status = s }
 
@@ -95,8 +97,9 @@ func (a *MediaAsset) GetAllS3Keys() []string {
 		var metaMap map[string]string
 		if err := json.Unmarshal([]byte(metaJSON), &metaMap); err == nil {
 			for _, v := range metaMap {
-				if v != "" {
-					// Simple heuristic: assume values in metadata are keys if they are not empty.
+				// Simple heuristic: object keys always carry a path; plain values (codes, scores,
+				// quality measurements) are skipped.
+				if strings.Contains(v, "/") {
 					keys = append(keys, v)
 				}
 			}
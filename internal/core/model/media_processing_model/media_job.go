@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -21,6 +22,7 @@ type MediaProcessingJob struct {
 	completedAt       *time.Time
 	lastError         string
 	callbackBody      string
+	createdAt         time.Time
 }
 
 // MediaProcessingJobRecord rehydrates a job from persistent storage.
@@ -36,6 +38,7 @@ type MediaProcessingJobRecord struct {
 	CompletedAt       *time.Time
 	LastError         string
 	CallbackBody      string
+	CreatedAt         time.Time
 }
 
 // RestoreMediaProcessingJob rebuilds a job from a storage record.
@@ -52,6 +55,7 @@ func RestoreMediaProcessingJob(record MediaProcessingJobRecord) MediaProcessingJ
 		completedAt:       record.CompletedAt,
 		lastError:         record.LastError,
 		callbackBody:      record.CallbackBody,
+		createdAt:         record.CreatedAt,
 	}
 }
 
@@ -74,6 +78,9 @@ func (j *MediaProcessingJob) Payload() MediaProcessingJobPayload {
 	return j.payload
 }
 func (j *MediaProcessingJob) RetryCount() uint16 { return j.retryCount }
+
+// SetRetryCount records how many times the assets of the job were sent to the pipeline before.
+func (j *MediaProcessingJob) SetRetryCount(retryCount uint16) { j.retryCount = retryCount }
 func (j *MediaProcessingJob) StartedAt() *time.Time {
 	return j.startedAt
 }
@@ -82,6 +89,22 @@ func (j *MediaProcessingJob) CompletedAt() *time.Time {
 }
 func (j *MediaProcessingJob) LastError() string    { return j.lastError }
 func (j *MediaProcessingJob) CallbackBody() string { return j.callbackBody }
+func (j *MediaProcessingJob) CreatedAt() time.Time { return j.createdAt }
+
+// CallbackOutputs returns the per-asset outputs of the stored callback body, nil when the job
+// received no callback or the body cannot be read.
+func (j *MediaProcessingJob) CallbackOutputs() []MediaProcessingJobPayload {
+	if j.callbackBody == "" {
+		return nil
+	}
+	var callback struct {
+		Outputs []MediaProcessingJobPayload `json:"outputs"`
+	}
+	if err := json.Unmarshal([]byte(j.callbackBody), &callback); err != nil {
+		return nil
+	}
+	return callback.Outputs
+}
 
 // EnsureStartedAt sets the initial start timestamp if not already defined.
 func (j *MediaProcessingJob) EnsureStartedAt(startedAt time.Time) {
@@ -96,7 +119,20 @@ func (j *MediaProcessingJob) MarkRunning(externalID string, startedAt time.Time)
 	j.startedAt = &startedAt
 }
 
+// AssetIDs returns the assets sent to the pipeline with the job; empty for jobs registered before
+// they were recorded.
+func (j *MediaProcessingJob) AssetIDs() []uint64 { return j.payload.AssetIDs }
+
+// SetAssetIDs records the assets sent to the pipeline with the job.
+func (j *MediaProcessingJob) SetAssetIDs(assetIDs []uint64) {
+	j.payload.AssetIDs = append([]uint64{}, assetIDs...)
+}
+
+// MarkCompleted closes the job with the provider payload; the dispatched asset IDs are kept.
 func (j *MediaProcessingJob) MarkCompleted(status MediaProcessingJobStatus, payload MediaProcessingJobPayload, completedAt time.Time) {
+	if len(payload.AssetIDs) == 0 {
+		payload.AssetIDs = j.payload.AssetIDs
+	}
 	j.status = status
 	j.payload = payload
 	j.completedAt = &completedAt
@@ -122,6 +158,14 @@ func (j *MediaProcessingJob) ApplyFinalizationPayload(bundles []string, assetsZi
 	j.payload.UnzippedSizeBytes = unzippedSizeBytes
 }
 
+// ApplyZipIntegrity records the bundle manifest and whether the backend could verify it; an
+// unverified bundle is kept for troubleshooting but never exposed for download.
+func (j *MediaProcessingJob) ApplyZipIntegrity(manifestKey, sha256 string, verified bool) {
+	j.payload.ZipManifestKey = manifestKey
+	j.payload.ZipSHA256 = sha256
+	j.payload.ZipVerified = verified
+}
+
 // JobAsset defines the contract between Backend -> SQS -> Lambdas.
 type JobAsset struct {
 	Key       string `json:"key"`
@@ -130,6 +174,8 @@ type JobAsset struct {
 	Size      int64  `json:"size,omitempty"`
 	ETag      string `json:"etag,omitempty"`
 	Error     string `json:"error,omitempty"`
+	// Metadata carries measurements of generated entries without an object (e.g. quality reports).
+	Metadata map[string]string `json:"metadata,omitempty"`
 }
 
 // StepFunctionPayload is the unified payload for Step Functions.
@@ -146,6 +192,27 @@ type StepFunctionPayload struct {
 	StartedAt         string     `json:"startedAt,omitempty"`
 	VideoInput        string     `json:"videoInput,omitempty"`
 	VideoOutputPath   string     `json:"videoOutputPath,omitempty"`
+	// ImageOptions is keyed by asset type and forwarded untouched to the thumbnails branch.
+	ImageOptions map[string]ImageProcessingOptions `json:"imageOptions,omitempty"`
+}
+
+// ProcessingExecutionName names the Step Functions execution of a processing job. It is derived
+// from the job so a redelivered message cannot start a second execution and the backend can
+// address the execution before the callback reports its ARN.
+func ProcessingExecutionName(listingIdentityID, jobID uint64) string {
+	return fmt.Sprintf("processing-%d-%d", listingIdentityID, jobID)
+}
+
+// ExecutionARN returns the ARN of a named execution of a state machine
+// (arn:aws:states:<region>:<account>:stateMachine:<name>); empty when the state machine ARN is
+// not in that form.
+func ExecutionARN(stateMachineARN, executionName string) string {
+	parts := strings.Split(stateMachineARN, ":")
+	if len(parts) != 7 || parts[5] != "stateMachine" || executionName == "" {
+		return ""
+	}
+	parts[5] = "execution"
+	return strings.Join(append(parts, executionName), ":")
 }
 
 // LambdaResponse wraps the output to match Step Functions expectation ($.body).
@@ -165,6 +232,16 @@ type MediaProcessingJobPayload struct {
 	AssetsZipped      int               `json:"assetsZipped,omitempty"`
 	ZipSizeBytes      int64             `json:"zipSizeBytes,omitempty"`
 	UnzippedSizeBytes int64             `json:"unzippedSizeBytes,omitempty"`
+	ZipManifestKey    string            `json:"zipManifestKey,omitempty"`
+	ZipSHA256         string            `json:"zipSha256,omitempty"`
+	ZipVerified       bool              `json:"zipVerified,omitempty"`
+	// AssetIDs lists the assets sent to the pipeline with the job, recorded when it is registered.
+	AssetIDs []uint64 `json:"assetIds,omitempty"`
+}
+
+// Failed reports whether a per-asset output carries an error.
+func (p MediaProcessingJobPayload) Failed() bool {
+	return p.ErrorCode != "" || p.ErrorMessage != ""
 }
 
 // MediaProcessingJobMessage is the payload sent to SQS/Step Functions.
@@ -173,6 +250,8 @@ type MediaProcessingJobMessage struct {
 	ListingIdentityID uint64     `json:"listingIdentityId"`
 	Assets            []JobAsset `json:"assets"`
 	Retry             uint16     `json:"retry"`
+	// ImageOptions configures photo derivatives per asset type (watermark, ...).
+	ImageOptions map[string]ImageProcessingOptions `json:"imageOptions,omitempty"`
 }
 
 // MediaProcessingCallback represents the structure received from the async workflow.
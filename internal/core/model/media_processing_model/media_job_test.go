@@ -0,0 +1,35 @@
+package mediaprocessingmodel
+
+import "testing"
+
+func TestExecutionARN(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name            string
+		stateMachineARN string
+		executionName   string
+		expected        string
+	}{
+		{
+			name:            "processing execution",
+			stateMachineARN: "arn:aws:states:us-east-1:058264253741:stateMachine:listing-media-processing-sm-staging",
+			executionName:   ProcessingExecutionName(28, 20),
+			expected:        "arn:aws:states:us-east-1:058264253741:execution:listing-media-processing-sm-staging:processing-28-20",
+		},
+		{name: "state machine not configured", executionName: "processing-28-20"},
+		{name: "execution arn instead of state machine", stateMachineARN: "arn:aws:states:us-east-1:058264253741:execution:sm:processing-28-20", executionName: "processing-28-20"},
+		{name: "missing execution name", stateMachineARN: "arn:aws:states:us-east-1:058264253741:stateMachine:sm"},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := ExecutionARN(tt.stateMachineARN, tt.executionName); got != tt.expected {
+				t.Fatalf("ExecutionARN(%q, %q) = %q, expected %q", tt.stateMachineARN, tt.executionName, got, tt.expected)
+			}
+		})
+	}
+}
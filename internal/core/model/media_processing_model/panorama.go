@@ -0,0 +1,172 @@
+package mediaprocessingmodel
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// JobAssetTypePanoramaTiles marks the generated entry describing the tile pyramid of a 360°
+// panorama. Its key is the tiles.json manifest and its metadata the pyramid layout.
+const JobAssetTypePanoramaTiles = "PANORAMA_TILES"
+
+// Metadata keys persisted on panorama assets once the tile pyramid is available.
+const (
+	PanoramaTilesMetadataKey    = "panorama_tiles"
+	PanoramaWidthMetadataKey    = "panorama_width"
+	PanoramaHeightMetadataKey   = "panorama_height"
+	PanoramaTileSizeMetadataKey = "panorama_tile_size"
+	PanoramaLevelsMetadataKey   = "panorama_levels"
+)
+
+const (
+	// PanoramaTileSize is the edge, in pixels, of every square tile.
+	PanoramaTileSize = 512
+	// PanoramaTilesManifestName is written next to the level directories.
+	PanoramaTilesManifestName = "tiles.json"
+	// PanoramaAspectTolerance is the accepted relative deviation from the 2:1 ratio; panoramas
+	// within it are stretched to exactly 2:1 when tiled.
+	PanoramaAspectTolerance = 0.01
+)
+
+// PanoramaLevelWidths are the equirectangular widths of the multi-resolution pyramid, lowest
+// first. Each level is width x width/2 cut into PanoramaTileSize tiles (4x2, 8x4 and 16x8), so
+// column counts stay powers of two as expected by tiled equirectangular viewers.
+var PanoramaLevelWidths = []int{2048, 4096, 8192}
+
+// ErrPanoramaNotEquirectangular reports an upload that cannot be a full 360° panorama.
+var ErrPanoramaNotEquirectangular = errors.New("panorama is not equirectangular")
+
+// Error codes reported by the pipeline for panorama assets.
+const (
+	PanoramaErrorCodeInvalidProjection = "PANORAMA_INVALID_PROJECTION"
+	PanoramaErrorCodeProcessingFailed  = "PANORAMA_PROCESSING_FAILED"
+)
+
+// PanoramaErrorCode maps a processing error to the code stored on the asset.
+func PanoramaErrorCode(err error) string {
+	if errors.Is(err, ErrPanoramaNotEquirectangular) {
+		return PanoramaErrorCodeInvalidProjection
+	}
+	return PanoramaErrorCodeProcessingFailed
+}
+
+// ValidateEquirectangular checks that the dimensions describe a 2:1 panorama large enough for
+// the first pyramid level.
+func ValidateEquirectangular(width, height int) error {
+	if width <= 0 || height <= 0 {
+		return fmt.Errorf("%w: invalid dimensions %dx%d", ErrPanoramaNotEquirectangular, width, height)
+	}
+	ratio := float64(width) / float64(height)
+	if math.Abs(ratio-2)/2 > PanoramaAspectTolerance {
+		return fmt.Errorf("%w: aspect ratio %.3f, expected 2:1", ErrPanoramaNotEquirectangular, ratio)
+	}
+	if minWidth := PanoramaLevelWidths[0]; width < minWidth {
+		return fmt.Errorf("%w: %dx%d is below the minimum %dx%d", ErrPanoramaNotEquirectangular, width, height, minWidth, minWidth/2)
+	}
+	return nil
+}
+
+// PanoramaLevel is one resolution of the tile pyramid.
+type PanoramaLevel struct {
+	Width int `json:"width"`
+	Cols  int `json:"cols"`
+	Rows  int `json:"rows"`
+}
+
+// NewPanoramaLevel derives the tile grid of a level width.
+func NewPanoramaLevel(width int) PanoramaLevel {
+	return PanoramaLevel{
+		Width: width,
+		Cols:  width / PanoramaTileSize,
+		Rows:  width / 2 / PanoramaTileSize,
+	}
+}
+
+// PanoramaTiles describes the tile pyramid of a panorama; it is the content of tiles.json.
+type PanoramaTiles struct {
+	// Width and Height are the dimensions of the uploaded panorama.
+	Width    int             `json:"width"`
+	Height   int             `json:"height"`
+	TileSize int             `json:"tileSize"`
+	Levels   []PanoramaLevel `json:"levels"`
+}
+
+// NewPanoramaTiles lists the levels available for a source, which is never upscaled.
+func NewPanoramaTiles(width, height int) PanoramaTiles {
+	tiles := PanoramaTiles{Width: width, Height: height, TileSize: PanoramaTileSize}
+	for _, levelWidth := range PanoramaLevelWidths {
+		if levelWidth > width {
+			break
+		}
+		tiles.Levels = append(tiles.Levels, NewPanoramaLevel(levelWidth))
+	}
+	return tiles
+}
+
+// Metadata encodes the layout into the flat asset metadata ("panorama_levels" = "2048,4096").
+func (t PanoramaTiles) Metadata() map[string]string {
+	widths := make([]string, 0, len(t.Levels))
+	for _, level := range t.Levels {
+		widths = append(widths, strconv.Itoa(level.Width))
+	}
+	return map[string]string{
+		PanoramaWidthMetadataKey:    strconv.Itoa(t.Width),
+		PanoramaHeightMetadataKey:   strconv.Itoa(t.Height),
+		PanoramaTileSizeMetadataKey: strconv.Itoa(t.TileSize),
+		PanoramaLevelsMetadataKey:   strings.Join(widths, ","),
+	}
+}
+
+// PanoramaTilesFromMetadata restores the layout and the manifest key stored on an asset.
+// ok is false when the asset has no usable tile pyramid.
+func PanoramaTilesFromMetadata(metadata map[string]string) (tiles PanoramaTiles, manifestKey string, ok bool) {
+	manifestKey = metadata[PanoramaTilesMetadataKey]
+	if manifestKey == "" {
+		return PanoramaTiles{}, "", false
+	}
+
+	tiles.Width, _ = strconv.Atoi(metadata[PanoramaWidthMetadataKey])
+	tiles.Height, _ = strconv.Atoi(metadata[PanoramaHeightMetadataKey])
+	tiles.TileSize, _ = strconv.Atoi(metadata[PanoramaTileSizeMetadataKey])
+	if tiles.TileSize != PanoramaTileSize {
+		return PanoramaTiles{}, "", false
+	}
+	for _, raw := range strings.Split(metadata[PanoramaLevelsMetadataKey], ",") {
+		width, err := strconv.Atoi(strings.TrimSpace(raw))
+		if err != nil || width <= 0 {
+			continue
+		}
+		tiles.Levels = append(tiles.Levels, NewPanoramaLevel(width))
+	}
+	if len(tiles.Levels) == 0 {
+		return PanoramaTiles{}, "", false
+	}
+	return tiles, manifestKey, true
+}
+
+// PanoramaTilesRoot returns the directory holding the levels of a tiles.json manifest.
+func PanoramaTilesRoot(manifestKey string) string {
+	return path.Dir(manifestKey)
+}
+
+// PanoramaTileKey builds the key of one tile: {root}/{level}/{row}_{col}.jpg.
+func PanoramaTileKey(root string, level, row, col int) string {
+	return fmt.Sprintf("%s/%d/%d_%d.jpg", root, level, row, col)
+}
+
+// TileKeys lists every tile of the pyramid.
+func (t PanoramaTiles) TileKeys(root string) []string {
+	keys := make([]string, 0)
+	for index, level := range t.Levels {
+		for row := 0; row < level.Rows; row++ {
+			for col := 0; col < level.Cols; col++ {
+				keys = append(keys, PanoramaTileKey(root, index, row, col))
+			}
+		}
+	}
+	return keys
+}
@@ -0,0 +1,249 @@
+package mediaprocessingmodel
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// Content types accepted for LAND_PARCEL uploads.
+const (
+	ParcelContentTypeKMZ = "application/vnd.google-earth.kmz"
+	ParcelContentTypeKML = "application/vnd.google-earth.kml+xml"
+)
+
+// IsParcelContentType reports whether a content type is a KMZ or KML document.
+func IsParcelContentType(contentType string) bool {
+	switch strings.ToLower(strings.TrimSpace(contentType)) {
+	case ParcelContentTypeKMZ, ParcelContentTypeKML:
+		return true
+	}
+	return false
+}
+
+const (
+	// ParcelDefaultTolerance is the relative difference between the declared and the computed
+	// measures above which a divergence is flagged.
+	ParcelDefaultTolerance = 0.10
+	// parcelEarthRadius is the mean Earth radius in meters (IUGG).
+	parcelEarthRadius = 6371008.8
+)
+
+// Errors reported while reading a parcel document.
+var (
+	ErrParcelInvalidDocument = errors.New("parcel document is not a valid KMZ/KML")
+	ErrParcelPolygonNotFound = errors.New("parcel document has no polygon")
+	ErrParcelMultiplePolygon = errors.New("parcel document has more than one polygon")
+	ErrParcelInvalidPolygon  = errors.New("parcel polygon is invalid")
+)
+
+// Error codes stored on LAND_PARCEL assets that could not be read.
+const (
+	ParcelErrorCodeInvalidDocument = "PARCEL_INVALID_DOCUMENT"
+	ParcelErrorCodePolygonNotFound = "PARCEL_POLYGON_NOT_FOUND"
+	ParcelErrorCodeMultiplePolygon = "PARCEL_MULTIPLE_POLYGONS"
+	ParcelErrorCodeInvalidPolygon  = "PARCEL_INVALID_POLYGON"
+)
+
+// ParcelErrorCode maps a parsing error to the code stored on the asset.
+func ParcelErrorCode(err error) string {
+	switch {
+	case errors.Is(err, ErrParcelPolygonNotFound):
+		return ParcelErrorCodePolygonNotFound
+	case errors.Is(err, ErrParcelMultiplePolygon):
+		return ParcelErrorCodeMultiplePolygon
+	case errors.Is(err, ErrParcelInvalidPolygon):
+		return ParcelErrorCodeInvalidPolygon
+	default:
+		return ParcelErrorCodeInvalidDocument
+	}
+}
+
+// ParcelCoordinate is a WGS84 vertex in decimal degrees.
+type ParcelCoordinate struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+// ParcelPolygon is the outer boundary of a parcel and its holes. Rings are open: the closing
+// vertex repeated by KML is dropped.
+type ParcelPolygon struct {
+	Outer []ParcelCoordinate   `json:"outer"`
+	Holes [][]ParcelCoordinate `json:"holes,omitempty"`
+}
+
+// NewParcelRing validates a ring read from a document and drops the closing vertex.
+func NewParcelRing(points []ParcelCoordinate) ([]ParcelCoordinate, error) {
+	if len(points) > 1 && points[0] == points[len(points)-1] {
+		points = points[:len(points)-1]
+	}
+	if len(points) < 3 {
+		return nil, fmt.Errorf("%w: a ring needs at least 3 vertices, got %d", ErrParcelInvalidPolygon, len(points))
+	}
+	for _, point := range points {
+		if math.IsNaN(point.Lat) || math.IsNaN(point.Lng) || math.Abs(point.Lat) > 90 || math.Abs(point.Lng) > 180 {
+			return nil, fmt.Errorf("%w: coordinate out of range (%f, %f)", ErrParcelInvalidPolygon, point.Lat, point.Lng)
+		}
+	}
+	return points, nil
+}
+
+// Centroid returns the mean of the outer vertices, enough to place a parcel on a map.
+func (p ParcelPolygon) Centroid() ParcelCoordinate {
+	var centroid ParcelCoordinate
+	if len(p.Outer) == 0 {
+		return centroid
+	}
+	for _, point := range p.Outer {
+		centroid.Lat += point.Lat
+		centroid.Lng += point.Lng
+	}
+	centroid.Lat /= float64(len(p.Outer))
+	centroid.Lng /= float64(len(p.Outer))
+	return centroid
+}
+
+// Area returns the area in square meters, holes excluded. Vertices are projected on a plane
+// tangent at the centroid, which is accurate well below 0.1% at parcel scale.
+func (p ParcelPolygon) Area() float64 {
+	origin := p.Centroid()
+	area := ringArea(p.Outer, origin)
+	for _, hole := range p.Holes {
+		area -= ringArea(hole, origin)
+	}
+	return math.Max(area, 0)
+}
+
+// Perimeter returns the length in meters of the outer boundary.
+func (p ParcelPolygon) Perimeter() float64 {
+	perimeter := 0.0
+	for i := range p.Outer {
+		perimeter += haversine(p.Outer[i], p.Outer[(i+1)%len(p.Outer)])
+	}
+	return perimeter
+}
+
+// WKT encodes the polygon as Well-Known Text in longitude/latitude order, rings closed.
+func (p ParcelPolygon) WKT() string {
+	rings := make([]string, 0, 1+len(p.Holes))
+	for _, ring := range append([][]ParcelCoordinate{p.Outer}, p.Holes...) {
+		points := make([]string, 0, len(ring)+1)
+		for i := 0; i <= len(ring); i++ {
+			point := ring[i%len(ring)]
+			points = append(points, fmt.Sprintf("%.8f %.8f", point.Lng, point.Lat))
+		}
+		rings = append(rings, "("+strings.Join(points, ", ")+")")
+	}
+	return "POLYGON(" + strings.Join(rings, ", ") + ")"
+}
+
+func ringArea(ring []ParcelCoordinate, origin ParcelCoordinate) float64 {
+	cosLat := math.Cos(origin.Lat * math.Pi / 180)
+	project := func(point ParcelCoordinate) (float64, float64) {
+		x := (point.Lng - origin.Lng) * math.Pi / 180 * parcelEarthRadius * cosLat
+		y := (point.Lat - origin.Lat) * math.Pi / 180 * parcelEarthRadius
+		return x, y
+	}
+
+	sum := 0.0
+	for i := range ring {
+		x1, y1 := project(ring[i])
+		x2, y2 := project(ring[(i+1)%len(ring)])
+		sum += x1*y2 - x2*y1
+	}
+	return math.Abs(sum) / 2
+}
+
+func haversine(a, b ParcelCoordinate) float64 {
+	lat1, lat2 := a.Lat*math.Pi/180, b.Lat*math.Pi/180
+	dLat := lat2 - lat1
+	dLng := (b.Lng - a.Lng) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * parcelEarthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// ParcelDivergenceField names the listing measure a divergence refers to.
+type ParcelDivergenceField string
+
+const (
+	// ParcelDivergenceLandSize compares landSize with the polygon area.
+	ParcelDivergenceLandSize ParcelDivergenceField = "landSize"
+	// ParcelDivergencePerimeter compares landFront + landBack + 2 x landSide with the perimeter.
+	ParcelDivergencePerimeter ParcelDivergenceField = "perimeter"
+)
+
+// ParcelDivergence flags a declared measure that differs from the polygon by more than the
+// tolerance. Difference is relative to the computed value.
+type ParcelDivergence struct {
+	Field      ParcelDivergenceField `json:"field"`
+	Declared   float64               `json:"declared"`
+	Computed   float64               `json:"computed"`
+	Difference float64               `json:"difference"`
+}
+
+// DeclaredLandMeasures carries the measures typed in the listing; zero means not informed.
+type DeclaredLandMeasures struct {
+	LandSize  float64
+	LandFront float64
+	LandSide  float64
+	LandBack  float64
+}
+
+// LandParcel is the parcel polygon read from the LAND_PARCEL asset of a listing.
+type LandParcel struct {
+	ListingIdentityID uint64
+	AssetID           uint64
+	Polygon           ParcelPolygon
+	AreaM2            float64
+	PerimeterM        float64
+	Centroid          ParcelCoordinate
+	Divergences       []ParcelDivergence
+	UpdatedAt         time.Time
+}
+
+// NewLandParcel computes the measures of a polygon.
+func NewLandParcel(listingIdentityID, assetID uint64, polygon ParcelPolygon) LandParcel {
+	return LandParcel{
+		ListingIdentityID: listingIdentityID,
+		AssetID:           assetID,
+		Polygon:           polygon,
+		AreaM2:            roundCentimeters(polygon.Area()),
+		PerimeterM:        roundCentimeters(polygon.Perimeter()),
+		Centroid:          polygon.Centroid(),
+	}
+}
+
+// CrossCheck replaces the divergences against the declared measures. The perimeter is only
+// checked when front, side and back are all informed, assuming both sides are equal.
+func (p *LandParcel) CrossCheck(declared DeclaredLandMeasures, tolerance float64) {
+	if tolerance <= 0 {
+		tolerance = ParcelDefaultTolerance
+	}
+	p.Divergences = nil
+
+	check := func(field ParcelDivergenceField, declaredValue, computed float64) {
+		if declaredValue <= 0 || computed <= 0 {
+			return
+		}
+		difference := math.Abs(declaredValue-computed) / computed
+		if difference > tolerance {
+			p.Divergences = append(p.Divergences, ParcelDivergence{
+				Field:      field,
+				Declared:   declaredValue,
+				Computed:   computed,
+				Difference: math.Round(difference*1000) / 1000,
+			})
+		}
+	}
+
+	check(ParcelDivergenceLandSize, declared.LandSize, p.AreaM2)
+	if declared.LandFront > 0 && declared.LandSide > 0 && declared.LandBack > 0 {
+		check(ParcelDivergencePerimeter, declared.LandFront+declared.LandBack+2*declared.LandSide, p.PerimeterM)
+	}
+}
+
+func roundCentimeters(value float64) float64 {
+	return math.Round(value*100) / 100
+}
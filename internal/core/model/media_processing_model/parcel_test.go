@@ -0,0 +1,105 @@
+package mediaprocessingmodel
+
+import (
+	"math"
+	"testing"
+)
+
+// testRectangle builds a width x height rectangle in meters with its south-west corner at the
+// given point.
+func testRectangle(lat, lng, width, height float64) []ParcelCoordinate {
+	metersPerDegree := parcelEarthRadius * math.Pi / 180
+	dLat := height / metersPerDegree
+	dLng := width / (metersPerDegree * math.Cos((lat+dLat/2)*math.Pi/180))
+	return []ParcelCoordinate{
+		{Lat: lat, Lng: lng},
+		{Lat: lat, Lng: lng + dLng},
+		{Lat: lat + dLat, Lng: lng + dLng},
+		{Lat: lat + dLat, Lng: lng},
+	}
+}
+
+func TestParcelPolygonMeasures(t *testing.T) {
+	t.Parallel()
+
+	reversed := func(ring []ParcelCoordinate) []ParcelCoordinate {
+		out := make([]ParcelCoordinate, 0, len(ring))
+		for i := len(ring) - 1; i >= 0; i-- {
+			out = append(out, ring[i])
+		}
+		return out
+	}
+
+	cases := []struct {
+		name              string
+		polygon           ParcelPolygon
+		expectedArea      float64
+		expectedPerimeter float64
+	}{
+		{name: "rectangle in São Paulo", polygon: ParcelPolygon{Outer: testRectangle(-23.5610, -46.6560, 20, 30)}, expectedArea: 600, expectedPerimeter: 100},
+		{name: "clockwise ring", polygon: ParcelPolygon{Outer: reversed(testRectangle(-23.5610, -46.6560, 20, 30))}, expectedArea: 600, expectedPerimeter: 100},
+		{name: "rectangle at the equator", polygon: ParcelPolygon{Outer: testRectangle(0, 0, 100, 50)}, expectedArea: 5000, expectedPerimeter: 300},
+		{name: "triangle", polygon: ParcelPolygon{Outer: testRectangle(-30.0346, -51.2177, 40, 30)[:3]}, expectedArea: 600, expectedPerimeter: 120},
+		{
+			name: "hole is subtracted from the area but not the perimeter",
+			polygon: ParcelPolygon{
+				Outer: testRectangle(-23.5610, -46.6560, 20, 30),
+				Holes: [][]ParcelCoordinate{testRectangle(-23.5609, -46.6559, 10, 10)},
+			},
+			expectedArea:      500,
+			expectedPerimeter: 100,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.polygon.Area(); math.Abs(got-tt.expectedArea) > tt.expectedArea*0.001 {
+				t.Fatalf("Area() = %.2fm², expected %.0fm²", got, tt.expectedArea)
+			}
+			if got := tt.polygon.Perimeter(); math.Abs(got-tt.expectedPerimeter) > tt.expectedPerimeter*0.001 {
+				t.Fatalf("Perimeter() = %.2fm, expected %.0fm", got, tt.expectedPerimeter)
+			}
+		})
+	}
+}
+
+func TestLandParcelCrossCheck(t *testing.T) {
+	t.Parallel()
+
+	parcel := NewLandParcel(1, 2, ParcelPolygon{Outer: testRectangle(-23.5610, -46.6560, 20, 30)})
+
+	cases := []struct {
+		name      string
+		declared  DeclaredLandMeasures
+		tolerance float64
+		expected  []ParcelDivergenceField
+	}{
+		{name: "nothing declared", expected: nil},
+		{name: "measures within the default tolerance", declared: DeclaredLandMeasures{LandSize: 650, LandFront: 20, LandSide: 30, LandBack: 22}, expected: nil},
+		{name: "land size above the tolerance", declared: DeclaredLandMeasures{LandSize: 700}, expected: []ParcelDivergenceField{ParcelDivergenceLandSize}},
+		{name: "configured tolerance", declared: DeclaredLandMeasures{LandSize: 650}, tolerance: 0.05, expected: []ParcelDivergenceField{ParcelDivergenceLandSize}},
+		{name: "perimeter needs front, side and back", declared: DeclaredLandMeasures{LandFront: 50, LandSide: 50}, expected: nil},
+		{name: "both measures diverge", declared: DeclaredLandMeasures{LandSize: 300, LandFront: 50, LandSide: 50, LandBack: 50}, expected: []ParcelDivergenceField{ParcelDivergenceLandSize, ParcelDivergencePerimeter}},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			checked := parcel
+			checked.CrossCheck(tt.declared, tt.tolerance)
+			if len(checked.Divergences) != len(tt.expected) {
+				t.Fatalf("CrossCheck() = %+v, expected fields %v", checked.Divergences, tt.expected)
+			}
+			for i, divergence := range checked.Divergences {
+				if divergence.Field != tt.expected[i] {
+					t.Fatalf("CrossCheck() = %+v, expected fields %v", checked.Divergences, tt.expected)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package mediaprocessingmodel
+
+import (
+	"math/bits"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// JobAssetTypeQualityReport marks the generated asset carrying the photo quality analysis.
+// It references no object: the measurements travel in JobAsset.Metadata.
+const JobAssetTypeQualityReport = "PHOTO_QUALITY_REPORT"
+
+// Metadata keys persisted on photo assets by the quality gate.
+const (
+	QualityWidthMetadataKey             = "quality_width"
+	QualityHeightMetadataKey            = "quality_height"
+	QualityBlurMetadataKey              = "quality_blur"
+	QualityBrightnessMetadataKey        = "quality_brightness"
+	QualityShadowsClippedMetadataKey    = "quality_shadows_clipped"
+	QualityHighlightsClippedMetadataKey = "quality_highlights_clipped"
+	QualityExposureMetadataKey          = "quality_exposure"
+	QualityPHashMetadataKey             = "quality_phash"
+	QualityStatusMetadataKey            = "quality_status"
+	QualityIssuesMetadataKey            = "quality_issues"
+	QualityDuplicateOfMetadataKey       = "quality_duplicate_of"
+)
+
+// PhotoQualityReport holds the measurements computed by the pipeline for one photo.
+type PhotoQualityReport struct {
+	Width  int
+	Height int
+	// BlurScore is the variance of the Laplacian on a normalized grayscale copy; lower is blurrier.
+	BlurScore float64
+	// Brightness is the mean luminance in [0,1].
+	Brightness float64
+	// ShadowsClipped and HighlightsClipped are the fractions of pixels at the histogram ends.
+	ShadowsClipped    float64
+	HighlightsClipped float64
+	// ExposureScore summarizes the histogram in [0,1]; 1 is a centered, unclipped histogram.
+	ExposureScore float64
+	// PHash is the 64-bit DCT perceptual hash in hex, used for near-duplicate detection.
+	PHash string
+}
+
+// Metadata serializes the report into asset metadata entries.
+func (r PhotoQualityReport) Metadata() map[string]string {
+	return map[string]string{
+		QualityWidthMetadataKey:             strconv.Itoa(r.Width),
+		QualityHeightMetadataKey:            strconv.Itoa(r.Height),
+		QualityBlurMetadataKey:              formatQualityFloat(r.BlurScore),
+		QualityBrightnessMetadataKey:        formatQualityFloat(r.Brightness),
+		QualityShadowsClippedMetadataKey:    formatQualityFloat(r.ShadowsClipped),
+		QualityHighlightsClippedMetadataKey: formatQualityFloat(r.HighlightsClipped),
+		QualityExposureMetadataKey:          formatQualityFloat(r.ExposureScore),
+		QualityPHashMetadataKey:             r.PHash,
+	}
+}
+
+// PhotoQualityReportFromMetadata rebuilds a report; ok is false when no analysis was recorded.
+func PhotoQualityReportFromMetadata(metadata map[string]string) (PhotoQualityReport, bool) {
+	if metadata[QualityWidthMetadataKey] == "" {
+		return PhotoQualityReport{}, false
+	}
+	parseFloat := func(key string) float64 {
+		v, _ := strconv.ParseFloat(metadata[key], 64)
+		return v
+	}
+	width, _ := strconv.Atoi(metadata[QualityWidthMetadataKey])
+	height, _ := strconv.Atoi(metadata[QualityHeightMetadataKey])
+	return PhotoQualityReport{
+		Width:             width,
+		Height:            height,
+		BlurScore:         parseFloat(QualityBlurMetadataKey),
+		Brightness:        parseFloat(QualityBrightnessMetadataKey),
+		ShadowsClipped:    parseFloat(QualityShadowsClippedMetadataKey),
+		HighlightsClipped: parseFloat(QualityHighlightsClippedMetadataKey),
+		ExposureScore:     parseFloat(QualityExposureMetadataKey),
+		PHash:             metadata[QualityPHashMetadataKey],
+	}, true
+}
+
+func formatQualityFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', 4, 64)
+}
+
+// PHashDistance returns the Hamming distance between two hex perceptual hashes.
+func PHashDistance(a, b string) (int, bool) {
+	ha, errA := strconv.ParseUint(a, 16, 64)
+	hb, errB := strconv.ParseUint(b, 16, 64)
+	if errA != nil || errB != nil {
+		return 0, false
+	}
+	return bits.OnesCount64(ha ^ hb), true
+}
+
+// PhotoQualityIssue names one failed quality check.
+type PhotoQualityIssue string
+
+const (
+	PhotoQualityIssueLowResolution PhotoQualityIssue = "low_resolution"
+	PhotoQualityIssueBlurry        PhotoQualityIssue = "blurry"
+	PhotoQualityIssueUnderexposed  PhotoQualityIssue = "underexposed"
+	PhotoQualityIssueOverexposed   PhotoQualityIssue = "overexposed"
+	PhotoQualityIssueDuplicate     PhotoQualityIssue = "duplicate"
+)
+
+// PhotoQualityStatus is the gate outcome stored on the asset.
+type PhotoQualityStatus string
+
+const (
+	PhotoQualityStatusOK      PhotoQualityStatus = "OK"
+	PhotoQualityStatusFlagged PhotoQualityStatus = "FLAGGED"
+	// PhotoQualityStatusBlocked keeps the listing from being sent to owner approval.
+	PhotoQualityStatusBlocked PhotoQualityStatus = "BLOCKED"
+)
+
+// PhotoQualityThresholds configures the gate. Issues listed in Blocking block the asset;
+// every other issue only flags it.
+type PhotoQualityThresholds struct {
+	MinShortSide         int
+	MinBlurScore         float64
+	MinBrightness        float64
+	MaxBrightness        float64
+	MaxClippedRatio      float64
+	DuplicateMaxDistance int
+	Blocking             map[PhotoQualityIssue]bool
+}
+
+// Evaluate lists the measurement issues of a report (duplicates are detected separately,
+// since they depend on the other photos of the listing).
+func (t PhotoQualityThresholds) Evaluate(r PhotoQualityReport) []PhotoQualityIssue {
+	issues := make([]PhotoQualityIssue, 0)
+	if t.MinShortSide > 0 && min(r.Width, r.Height) < t.MinShortSide {
+		issues = append(issues, PhotoQualityIssueLowResolution)
+	}
+	if t.MinBlurScore > 0 && r.BlurScore < t.MinBlurScore {
+		issues = append(issues, PhotoQualityIssueBlurry)
+	}
+	if (t.MinBrightness > 0 && r.Brightness < t.MinBrightness) || (t.MaxClippedRatio > 0 && r.ShadowsClipped > t.MaxClippedRatio) {
+		issues = append(issues, PhotoQualityIssueUnderexposed)
+	}
+	if (t.MaxBrightness > 0 && r.Brightness > t.MaxBrightness) || (t.MaxClippedRatio > 0 && r.HighlightsClipped > t.MaxClippedRatio) {
+		issues = append(issues, PhotoQualityIssueOverexposed)
+	}
+	return issues
+}
+
+// Status resolves the gate outcome for a set of issues.
+func (t PhotoQualityThresholds) Status(issues []PhotoQualityIssue) PhotoQualityStatus {
+	if len(issues) == 0 {
+		return PhotoQualityStatusOK
+	}
+	for _, issue := range issues {
+		if t.Blocking[issue] {
+			return PhotoQualityStatusBlocked
+		}
+	}
+	return PhotoQualityStatusFlagged
+}
+
+// FormatPhotoQualityIssues joins issues for metadata storage ("blurry,duplicate").
+func FormatPhotoQualityIssues(issues []PhotoQualityIssue) string {
+	values := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		values = append(values, string(issue))
+	}
+	sort.Strings(values)
+	return strings.Join(values, ",")
+}
+
+// ParsePhotoQualityIssues splits the stored issue list.
+func ParsePhotoQualityIssues(raw string) []PhotoQualityIssue {
+	issues := make([]PhotoQualityIssue, 0)
+	for _, part := range strings.Split(raw, ",") {
+		if trimmed := strings.TrimSpace(part); trimmed != "" {
+			issues = append(issues, PhotoQualityIssue(trimmed))
+		}
+	}
+	return issues
+}
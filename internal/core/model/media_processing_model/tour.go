@@ -0,0 +1,78 @@
+package mediaprocessingmodel
+
+import "time"
+
+// Hotspot angles are expressed in degrees, like the initial view of a node.
+const (
+	TourMaxYaw   = 180.0
+	TourMaxPitch = 90.0
+)
+
+// MediaTour links the 360° panoramas of a listing into a virtual tour: every node is a
+// PANORAMA_360 asset and hotspots move the viewer from one node to another.
+type MediaTour struct {
+	ListingIdentityID uint64
+	StartAssetID      uint64
+	Nodes             []MediaTourNode
+	UpdatedBy         int64
+	UpdatedAt         time.Time
+}
+
+// MediaTourNode is one panorama of the tour with the direction it opens at.
+type MediaTourNode struct {
+	AssetID      uint64             `json:"assetId"`
+	Label        string             `json:"label,omitempty"`
+	InitialYaw   float64            `json:"initialYaw"`
+	InitialPitch float64            `json:"initialPitch"`
+	Hotspots     []MediaTourHotspot `json:"hotspots,omitempty"`
+}
+
+// MediaTourHotspot is a link placed at yaw/pitch that opens the target node.
+type MediaTourHotspot struct {
+	TargetAssetID uint64  `json:"targetAssetId"`
+	Yaw           float64 `json:"yaw"`
+	Pitch         float64 `json:"pitch"`
+	Label         string  `json:"label,omitempty"`
+}
+
+// Node returns the node of an asset.
+func (t MediaTour) Node(assetID uint64) (MediaTourNode, bool) {
+	for _, node := range t.Nodes {
+		if node.AssetID == assetID {
+			return node, true
+		}
+	}
+	return MediaTourNode{}, false
+}
+
+// WithoutAsset removes the node of a deleted panorama and every hotspot pointing at it. When
+// the start node is removed the tour starts at the first remaining node. changed is false when
+// the asset was not part of the tour.
+func (t MediaTour) WithoutAsset(assetID uint64) (tour MediaTour, changed bool) {
+	tour = t
+	tour.Nodes = make([]MediaTourNode, 0, len(t.Nodes))
+	for _, node := range t.Nodes {
+		if node.AssetID == assetID {
+			changed = true
+			continue
+		}
+		hotspots := make([]MediaTourHotspot, 0, len(node.Hotspots))
+		for _, hotspot := range node.Hotspots {
+			if hotspot.TargetAssetID == assetID {
+				changed = true
+				continue
+			}
+			hotspots = append(hotspots, hotspot)
+		}
+		node.Hotspots = hotspots
+		tour.Nodes = append(tour.Nodes, node)
+	}
+
+	if tour.StartAssetID == assetID {
+		tour.StartAssetID = 0
+		if len(tour.Nodes) > 0 {
+			tour.StartAssetID = tour.Nodes[0].AssetID
+		}
+	}
+	return tour, changed
+}
@@ -0,0 +1,41 @@
+package mediaprocessingmodel
+
+import "strings"
+
+// ZipManifestName is the manifest entry written as the last file of every listing ZIP.
+const ZipManifestName = "manifest.json"
+
+// ZipManifestVersion identifies the manifest layout.
+const ZipManifestVersion = 1
+
+// ZipManifest describes the content of a listing ZIP. It is stored inside the archive and as a
+// sidecar object next to it, so the backend can verify the bundle without downloading it.
+type ZipManifest struct {
+	Version    int                `json:"version"`
+	ArchiveKey string             `json:"archiveKey"`
+	Entries    []ZipManifestEntry `json:"entries"`
+}
+
+// ZipManifestEntry records one archived object with the checksum computed while streaming it.
+type ZipManifestEntry struct {
+	Name      string `json:"name"`
+	SourceKey string `json:"sourceKey"`
+	Size      int64  `json:"size"`
+	// CRC32 is the IEEE checksum in hex, identical to the one stored in the ZIP headers.
+	CRC32 string `json:"crc32"`
+}
+
+// TotalSize returns the sum of the uncompressed entry sizes.
+func (m ZipManifest) TotalSize() int64 {
+	var total int64
+	for _, entry := range m.Entries {
+		total += entry.Size
+	}
+	return total
+}
+
+// ZipManifestKey returns the sidecar manifest key for an archive key
+// ("{l}/processed/zip/listing-media.zip" -> "{l}/processed/zip/listing-media.manifest.json").
+func ZipManifestKey(archiveKey string) string {
+	return strings.TrimSuffix(archiveKey, ".zip") + "." + ZipManifestName
+}
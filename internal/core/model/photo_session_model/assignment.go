@@ -0,0 +1,44 @@
+package photosessionmodel
+
+// AssignmentMode records how the photographer of a booking was chosen.
+type AssignmentMode string
+
+const (
+	// AssignmentModeOwnerChoice means the owner picked a photographer-specific slot.
+	AssignmentModeOwnerChoice AssignmentMode = "OWNER_CHOICE"
+	// AssignmentModeAuto means the owner picked only a time window and the service chose the photographer.
+	AssignmentModeAuto AssignmentMode = "AUTO"
+)
+
+// PhotographerAssignment explains an automatic assignment. Factor scores are normalised to [0,1]
+// (higher is better) and Score is their weighted sum; it is persisted as JSON on the booking.
+type PhotographerAssignment struct {
+	Score         float64  `json:"score"`
+	LoadScore     float64  `json:"loadScore"`
+	ApprovalScore float64  `json:"approvalScore"`
+	DistanceScore float64  `json:"distanceScore"`
+	BookedMinutes int64    `json:"bookedMinutes"`
+	ApprovalRate  float64  `json:"approvalRate"`
+	DistanceKm    *float64 `json:"distanceKm,omitempty"`
+	Candidates    int      `json:"candidates"`
+	Reason        string   `json:"reason"`
+}
+
+// SessionLocation is the address of a photo session used for travel estimates.
+// Latitude/Longitude are only set when the listing has geographic data (e.g. a land parcel centroid).
+type SessionLocation struct {
+	Neighborhood string
+	City         string
+	State        string
+	Latitude     *float64
+	Longitude    *float64
+}
+
+// PhotographerWorkload aggregates booking history used to rank photographers for automatic assignment.
+// BookedMinutes covers live and completed sessions inside the balancing window; Accepted/Rejected are all-time decisions.
+type PhotographerWorkload struct {
+	PhotographerID uint64
+	BookedMinutes  int64
+	Accepted       int64
+	Rejected       int64
+}
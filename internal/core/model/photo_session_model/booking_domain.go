@@ -14,6 +14,9 @@ type photoSessionBooking struct {
 	reservationToken  *string
 	reservedUntil     time.Time
 	reservedValid     bool
+	assignmentMode    AssignmentMode
+	assignment        *PhotographerAssignment
+	sessionType       SessionType
 }
 
 func (b *photoSessionBooking) ID() uint64 { return b.id }
@@ -58,3 +61,28 @@ func (b *photoSessionBooking) SetReservedUntil(value time.Time) {
 	b.reservedUntil = value
 	b.reservedValid = true
 }
+
+func (b *photoSessionBooking) AssignmentMode() AssignmentMode {
+	if b.assignmentMode == "" {
+		return AssignmentModeOwnerChoice
+	}
+	return b.assignmentMode
+}
+
+func (b *photoSessionBooking) SetAssignmentMode(mode AssignmentMode) { b.assignmentMode = mode }
+
+func (b *photoSessionBooking) Assignment() *PhotographerAssignment { return b.assignment }
+
+func (b *photoSessionBooking) SetAssignment(assignment *PhotographerAssignment) {
+	b.assignment = assignment
+}
+
+// SessionType defaults to SessionTypeStandard when unset.
+func (b *photoSessionBooking) SessionType() SessionType {
+	if b.sessionType == "" {
+		return SessionTypeStandard
+	}
+	return b.sessionType
+}
+
+func (b *photoSessionBooking) SetSessionType(sessionType SessionType) { b.sessionType = sessionType }
@@ -24,6 +24,13 @@ type PhotoSessionBookingInterface interface {
 	SetReservationToken(token *string)
 	ReservedUntil() time.Time
 	SetReservedUntil(value time.Time)
+	// AssignmentMode defaults to OWNER_CHOICE; Assignment is only set for AUTO bookings.
+	AssignmentMode() AssignmentMode
+	SetAssignmentMode(mode AssignmentMode)
+	Assignment() *PhotographerAssignment
+	SetAssignment(assignment *PhotographerAssignment)
+	SessionType() SessionType
+	SetSessionType(sessionType SessionType)
 }
 
 // NewPhotoSessionBooking creates a new mutable booking instance.
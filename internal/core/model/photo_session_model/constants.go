@@ -63,6 +63,28 @@ var validBookingStatus = map[BookingStatus]struct{}{
 	BookingStatusDone:            {},
 }
 
+// SessionType classifies what the photographer delivers in a session; it drives payout pricing.
+type SessionType string
+
+const (
+	// SessionTypeStandard is a regular still-photo session.
+	SessionTypeStandard SessionType = "STANDARD"
+	// SessionTypePanorama adds 360° panoramas for virtual tours.
+	SessionTypePanorama SessionType = "PANORAMA"
+)
+
+// SessionTypeFromString parses a session type; empty input yields SessionTypeStandard.
+func SessionTypeFromString(s string) (SessionType, error) {
+	switch SessionType(s) {
+	case "":
+		return SessionTypeStandard, nil
+	case SessionTypeStandard, SessionTypePanorama:
+		return SessionType(s), nil
+	default:
+		return "", fmt.Errorf("invalid session type: %s", s)
+	}
+}
+
 // BookingStatusFromString converts a string to a BookingStatus type, returning an error if invalid.
 func BookingStatusFromString(s string) (BookingStatus, error) {
 	status := BookingStatus(s)
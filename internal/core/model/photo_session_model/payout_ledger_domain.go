@@ -0,0 +1,96 @@
+package photosessionmodel
+
+import "time"
+
+type payoutLedgerLine struct {
+	id                uint64
+	bookingID         uint64
+	photographerID    uint64
+	listingIdentityID int64
+	ruleID            *uint64
+	sessionType       SessionType
+	propertyType      uint16
+	sizeM2            float64
+	sessionStartsAt   time.Time
+	period            string
+	baseAmountCents   int64
+	surchargePct      float64
+	surchargeReason   *string
+	amountCents       int64
+	status            PayoutStatus
+	payoutReference   *string
+	paidAt            *time.Time
+	createdAt         time.Time
+}
+
+func (l *payoutLedgerLine) ID() uint64 { return l.id }
+
+func (l *payoutLedgerLine) SetID(id uint64) { l.id = id }
+
+func (l *payoutLedgerLine) BookingID() uint64 { return l.bookingID }
+
+func (l *payoutLedgerLine) SetBookingID(id uint64) { l.bookingID = id }
+
+func (l *payoutLedgerLine) PhotographerUserID() uint64 { return l.photographerID }
+
+func (l *payoutLedgerLine) SetPhotographerUserID(id uint64) { l.photographerID = id }
+
+func (l *payoutLedgerLine) ListingIdentityID() int64 { return l.listingIdentityID }
+
+func (l *payoutLedgerLine) SetListingIdentityID(id int64) { l.listingIdentityID = id }
+
+func (l *payoutLedgerLine) RuleID() *uint64 { return l.ruleID }
+
+func (l *payoutLedgerLine) SetRuleID(id *uint64) { l.ruleID = id }
+
+func (l *payoutLedgerLine) SessionType() SessionType { return l.sessionType }
+
+func (l *payoutLedgerLine) SetSessionType(sessionType SessionType) { l.sessionType = sessionType }
+
+func (l *payoutLedgerLine) PropertyType() uint16 { return l.propertyType }
+
+func (l *payoutLedgerLine) SetPropertyType(propertyType uint16) { l.propertyType = propertyType }
+
+func (l *payoutLedgerLine) SizeM2() float64 { return l.sizeM2 }
+
+func (l *payoutLedgerLine) SetSizeM2(size float64) { l.sizeM2 = size }
+
+func (l *payoutLedgerLine) SessionStartsAt() time.Time { return l.sessionStartsAt }
+
+func (l *payoutLedgerLine) SetSessionStartsAt(value time.Time) { l.sessionStartsAt = value }
+
+func (l *payoutLedgerLine) Period() string { return l.period }
+
+func (l *payoutLedgerLine) SetPeriod(period string) { l.period = period }
+
+func (l *payoutLedgerLine) BaseAmountCents() int64 { return l.baseAmountCents }
+
+func (l *payoutLedgerLine) SetBaseAmountCents(amount int64) { l.baseAmountCents = amount }
+
+func (l *payoutLedgerLine) SurchargePct() float64 { return l.surchargePct }
+
+func (l *payoutLedgerLine) SetSurchargePct(pct float64) { l.surchargePct = pct }
+
+func (l *payoutLedgerLine) SurchargeReason() *string { return l.surchargeReason }
+
+func (l *payoutLedgerLine) SetSurchargeReason(reason *string) { l.surchargeReason = reason }
+
+func (l *payoutLedgerLine) AmountCents() int64 { return l.amountCents }
+
+func (l *payoutLedgerLine) SetAmountCents(amount int64) { l.amountCents = amount }
+
+func (l *payoutLedgerLine) Status() PayoutStatus { return l.status }
+
+func (l *payoutLedgerLine) SetStatus(status PayoutStatus) { l.status = status }
+
+func (l *payoutLedgerLine) PayoutReference() *string { return l.payoutReference }
+
+func (l *payoutLedgerLine) SetPayoutReference(reference *string) { l.payoutReference = reference }
+
+func (l *payoutLedgerLine) PaidAt() *time.Time { return l.paidAt }
+
+func (l *payoutLedgerLine) SetPaidAt(value *time.Time) { l.paidAt = value }
+
+func (l *payoutLedgerLine) CreatedAt() time.Time { return l.createdAt }
+
+func (l *payoutLedgerLine) SetCreatedAt(value time.Time) { l.createdAt = value }
@@ -0,0 +1,66 @@
+package photosessionmodel
+
+import "time"
+
+// PayoutStatus tracks whether a ledger line was settled with the photographer.
+type PayoutStatus string
+
+const (
+	PayoutStatusPending PayoutStatus = "PENDING"
+	PayoutStatusPaid    PayoutStatus = "PAID"
+)
+
+// PayoutLedgerLineInterface is what the platform owes a photographer for one completed booking.
+// Period is the month of the session start ("YYYY-MM", platform timezone); pricing inputs are snapshotted
+// so later listing edits or rule changes do not alter settled amounts.
+type PayoutLedgerLineInterface interface {
+	ID() uint64
+	SetID(id uint64)
+	BookingID() uint64
+	SetBookingID(id uint64)
+	PhotographerUserID() uint64
+	SetPhotographerUserID(id uint64)
+	ListingIdentityID() int64
+	SetListingIdentityID(id int64)
+	RuleID() *uint64
+	SetRuleID(id *uint64)
+	SessionType() SessionType
+	SetSessionType(sessionType SessionType)
+	PropertyType() uint16
+	SetPropertyType(propertyType uint16)
+	SizeM2() float64
+	SetSizeM2(size float64)
+	SessionStartsAt() time.Time
+	SetSessionStartsAt(value time.Time)
+	Period() string
+	SetPeriod(period string)
+	BaseAmountCents() int64
+	SetBaseAmountCents(amount int64)
+	SurchargePct() float64
+	SetSurchargePct(pct float64)
+	SurchargeReason() *string
+	SetSurchargeReason(reason *string)
+	AmountCents() int64
+	SetAmountCents(amount int64)
+	Status() PayoutStatus
+	SetStatus(status PayoutStatus)
+	PayoutReference() *string
+	SetPayoutReference(reference *string)
+	PaidAt() *time.Time
+	SetPaidAt(value *time.Time)
+	CreatedAt() time.Time
+	SetCreatedAt(value time.Time)
+}
+
+// NewPayoutLedgerLine creates a new mutable ledger line.
+func NewPayoutLedgerLine() PayoutLedgerLineInterface {
+	return &payoutLedgerLine{}
+}
+
+// PayoutLedgerFilter narrows ledger queries; zero values are ignored.
+type PayoutLedgerFilter struct {
+	PhotographerUserID uint64
+	Period             string
+	Status             PayoutStatus
+	PayoutReference    string
+}
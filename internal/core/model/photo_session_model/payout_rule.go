@@ -0,0 +1,51 @@
+package photosessionmodel
+
+// PayoutRule prices a completed session for the photographer. Nil criteria match anything; size bounds are
+// in square metres with MinSizeM2 inclusive and MaxSizeM2 exclusive. Surcharges are percentages over the base
+// amount and do not stack: a holiday on a weekend pays the larger of the two.
+type PayoutRule struct {
+	ID                  uint64
+	PropertyType        *uint16
+	SessionType         *SessionType
+	MinSizeM2           *float64
+	MaxSizeM2           *float64
+	BaseAmountCents     int64
+	WeekendSurchargePct float64
+	HolidaySurchargePct float64
+	Active              bool
+}
+
+// Matches reports whether the rule applies to the session attributes.
+func (r PayoutRule) Matches(propertyType uint16, sessionType SessionType, sizeM2 float64) bool {
+	if !r.Active {
+		return false
+	}
+	if r.PropertyType != nil && *r.PropertyType != propertyType {
+		return false
+	}
+	if r.SessionType != nil && *r.SessionType != sessionType {
+		return false
+	}
+	if r.MinSizeM2 != nil && sizeM2 < *r.MinSizeM2 {
+		return false
+	}
+	if r.MaxSizeM2 != nil && sizeM2 >= *r.MaxSizeM2 {
+		return false
+	}
+	return true
+}
+
+// Specificity ranks matching rules: property type and session type weigh more than a size band.
+func (r PayoutRule) Specificity() int {
+	score := 0
+	if r.PropertyType != nil {
+		score += 4
+	}
+	if r.SessionType != nil {
+		score += 2
+	}
+	if r.MinSizeM2 != nil || r.MaxSizeM2 != nil {
+		score++
+	}
+	return score
+}
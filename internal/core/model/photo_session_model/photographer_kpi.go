@@ -0,0 +1,52 @@
+package photosessionmodel
+
+import "time"
+
+// Star rating bounds accepted from listing owners.
+const (
+	MinSessionRating = 1
+	MaxSessionRating = 5
+)
+
+// PhotographerKPIFilter narrows KPI aggregation to sessions starting in [From, To) and, optionally,
+// to specific photographers. Offset/Limit paginate photographers; Limit 0 returns every group.
+type PhotographerKPIFilter struct {
+	PhotographerIDs []uint64
+	From            *time.Time
+	To              *time.Time
+	Offset          int
+	Limit           int
+}
+
+// PhotographerKPI aggregates the delivery history of a photographer.
+// Sessions counts completed sessions; AvgUploadMinutes and AvgRating are nil without data.
+// OwnerRejections sums every media rejection, so one session can contribute several.
+type PhotographerKPI struct {
+	PhotographerID    uint64
+	Sessions          int64
+	ArrivalsConfirmed int64
+	ArrivalsOnTime    int64
+	Uploads           int64
+	AvgUploadMinutes  *float64
+	OwnerApprovals    int64
+	OwnerRejections   int64
+	Ratings           int64
+	AvgRating         *float64
+}
+
+// OnTimeRate is the share of confirmed arrivals that were on time (0 without confirmations).
+func (k PhotographerKPI) OnTimeRate() float64 {
+	if k.ArrivalsConfirmed == 0 {
+		return 0
+	}
+	return float64(k.ArrivalsOnTime) / float64(k.ArrivalsConfirmed)
+}
+
+// RejectionRate is the share of owner media decisions that were rejections (0 without decisions).
+func (k PhotographerKPI) RejectionRate() float64 {
+	decisions := k.OwnerApprovals + k.OwnerRejections
+	if decisions == 0 {
+		return 0
+	}
+	return float64(k.OwnerRejections) / float64(decisions)
+}
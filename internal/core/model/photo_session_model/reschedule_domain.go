@@ -0,0 +1,61 @@
+package photosessionmodel
+
+import "time"
+
+type photoSessionReschedule struct {
+	id                 uint64
+	bookingID          uint64
+	listingIdentityID  int64
+	requestedBy        int64
+	fromPhotographerID uint64
+	toPhotographerID   uint64
+	fromStartsAt       time.Time
+	toStartsAt         time.Time
+	noticeMinutes      int64
+	reason             *string
+	createdAt          time.Time
+}
+
+func (r *photoSessionReschedule) ID() uint64 { return r.id }
+
+func (r *photoSessionReschedule) SetID(id uint64) { r.id = id }
+
+func (r *photoSessionReschedule) BookingID() uint64 { return r.bookingID }
+
+func (r *photoSessionReschedule) SetBookingID(id uint64) { r.bookingID = id }
+
+func (r *photoSessionReschedule) ListingIdentityID() int64 { return r.listingIdentityID }
+
+func (r *photoSessionReschedule) SetListingIdentityID(id int64) { r.listingIdentityID = id }
+
+func (r *photoSessionReschedule) RequestedBy() int64 { return r.requestedBy }
+
+func (r *photoSessionReschedule) SetRequestedBy(userID int64) { r.requestedBy = userID }
+
+func (r *photoSessionReschedule) FromPhotographerUserID() uint64 { return r.fromPhotographerID }
+
+func (r *photoSessionReschedule) SetFromPhotographerUserID(id uint64) { r.fromPhotographerID = id }
+
+func (r *photoSessionReschedule) ToPhotographerUserID() uint64 { return r.toPhotographerID }
+
+func (r *photoSessionReschedule) SetToPhotographerUserID(id uint64) { r.toPhotographerID = id }
+
+func (r *photoSessionReschedule) FromStartsAt() time.Time { return r.fromStartsAt }
+
+func (r *photoSessionReschedule) SetFromStartsAt(value time.Time) { r.fromStartsAt = value }
+
+func (r *photoSessionReschedule) ToStartsAt() time.Time { return r.toStartsAt }
+
+func (r *photoSessionReschedule) SetToStartsAt(value time.Time) { r.toStartsAt = value }
+
+func (r *photoSessionReschedule) NoticeMinutes() int64 { return r.noticeMinutes }
+
+func (r *photoSessionReschedule) SetNoticeMinutes(minutes int64) { r.noticeMinutes = minutes }
+
+func (r *photoSessionReschedule) Reason() *string { return r.reason }
+
+func (r *photoSessionReschedule) SetReason(reason *string) { r.reason = reason }
+
+func (r *photoSessionReschedule) CreatedAt() time.Time { return r.createdAt }
+
+func (r *photoSessionReschedule) SetCreatedAt(value time.Time) { r.createdAt = value }
@@ -0,0 +1,36 @@
+package photosessionmodel
+
+import "time"
+
+// PhotoSessionRescheduleInterface records a single slot swap of a booking.
+// NoticeMinutes is the lead time between the request and the original session start,
+// which is what the reschedule SLA is measured against.
+type PhotoSessionRescheduleInterface interface {
+	ID() uint64
+	SetID(id uint64)
+	BookingID() uint64
+	SetBookingID(id uint64)
+	ListingIdentityID() int64
+	SetListingIdentityID(id int64)
+	RequestedBy() int64
+	SetRequestedBy(userID int64)
+	FromPhotographerUserID() uint64
+	SetFromPhotographerUserID(id uint64)
+	ToPhotographerUserID() uint64
+	SetToPhotographerUserID(id uint64)
+	FromStartsAt() time.Time
+	SetFromStartsAt(value time.Time)
+	ToStartsAt() time.Time
+	SetToStartsAt(value time.Time)
+	NoticeMinutes() int64
+	SetNoticeMinutes(minutes int64)
+	Reason() *string
+	SetReason(reason *string)
+	CreatedAt() time.Time
+	SetCreatedAt(value time.Time)
+}
+
+// NewPhotoSessionReschedule creates a new mutable reschedule record.
+func NewPhotoSessionReschedule() PhotoSessionRescheduleInterface {
+	return &photoSessionReschedule{}
+}
@@ -0,0 +1,31 @@
+package photosessionmodel
+
+import "time"
+
+// RescheduleReportGroup selects the dimension used to aggregate reschedules.
+type RescheduleReportGroup string
+
+const (
+	RescheduleReportGroupListing      RescheduleReportGroup = "LISTING"
+	RescheduleReportGroupPhotographer RescheduleReportGroup = "PHOTOGRAPHER"
+)
+
+// RescheduleReportFilter narrows the reschedule aggregation window.
+// Photographer grouping uses the photographer that lost the original slot.
+type RescheduleReportFilter struct {
+	GroupBy RescheduleReportGroup
+	From    *time.Time
+	To      *time.Time
+	Offset  int
+	Limit   int
+}
+
+// RescheduleCount aggregates reschedules for a listing identity or photographer.
+type RescheduleCount struct {
+	SubjectID           uint64
+	Total               int64
+	PhotographerChanged int64
+	AvgNoticeMinutes    float64
+	MinNoticeMinutes    int64
+	LastRescheduledAt   time.Time
+}
@@ -0,0 +1,71 @@
+package photosessionmodel
+
+import "time"
+
+type sessionKPI struct {
+	bookingID          uint64
+	photographerUserID uint64
+	listingIdentityID  int64
+	sessionStartsAt    time.Time
+	arrivedAt          *time.Time
+	arrivalOnTime      *bool
+	completedAt        *time.Time
+	mediaUploadedAt    *time.Time
+	ownerRejections    int
+	ownerApprovedAt    *time.Time
+	rating             *int
+	ratingComment      *string
+	ratedAt            *time.Time
+}
+
+func (k *sessionKPI) BookingID() uint64 { return k.bookingID }
+
+func (k *sessionKPI) SetBookingID(id uint64) { k.bookingID = id }
+
+func (k *sessionKPI) PhotographerUserID() uint64 { return k.photographerUserID }
+
+func (k *sessionKPI) SetPhotographerUserID(id uint64) { k.photographerUserID = id }
+
+func (k *sessionKPI) ListingIdentityID() int64 { return k.listingIdentityID }
+
+func (k *sessionKPI) SetListingIdentityID(id int64) { k.listingIdentityID = id }
+
+func (k *sessionKPI) SessionStartsAt() time.Time { return k.sessionStartsAt }
+
+func (k *sessionKPI) SetSessionStartsAt(value time.Time) { k.sessionStartsAt = value }
+
+func (k *sessionKPI) ArrivedAt() *time.Time { return k.arrivedAt }
+
+func (k *sessionKPI) SetArrivedAt(value *time.Time) { k.arrivedAt = value }
+
+func (k *sessionKPI) ArrivalOnTime() *bool { return k.arrivalOnTime }
+
+func (k *sessionKPI) SetArrivalOnTime(value *bool) { k.arrivalOnTime = value }
+
+func (k *sessionKPI) CompletedAt() *time.Time { return k.completedAt }
+
+func (k *sessionKPI) SetCompletedAt(value *time.Time) { k.completedAt = value }
+
+func (k *sessionKPI) MediaUploadedAt() *time.Time { return k.mediaUploadedAt }
+
+func (k *sessionKPI) SetMediaUploadedAt(value *time.Time) { k.mediaUploadedAt = value }
+
+func (k *sessionKPI) OwnerRejections() int { return k.ownerRejections }
+
+func (k *sessionKPI) SetOwnerRejections(count int) { k.ownerRejections = count }
+
+func (k *sessionKPI) OwnerApprovedAt() *time.Time { return k.ownerApprovedAt }
+
+func (k *sessionKPI) SetOwnerApprovedAt(value *time.Time) { k.ownerApprovedAt = value }
+
+func (k *sessionKPI) Rating() *int { return k.rating }
+
+func (k *sessionKPI) SetRating(value *int) { k.rating = value }
+
+func (k *sessionKPI) RatingComment() *string { return k.ratingComment }
+
+func (k *sessionKPI) SetRatingComment(value *string) { k.ratingComment = value }
+
+func (k *sessionKPI) RatedAt() *time.Time { return k.ratedAt }
+
+func (k *sessionKPI) SetRatedAt(value *time.Time) { k.ratedAt = value }
@@ -0,0 +1,41 @@
+package photosessionmodel
+
+import "time"
+
+// SessionKPIInterface tracks the delivery milestones of one booking that feed photographer KPIs.
+// The row outlives booking retention; photographer and start are refreshed while the session is still open.
+type SessionKPIInterface interface {
+	BookingID() uint64
+	SetBookingID(id uint64)
+	PhotographerUserID() uint64
+	SetPhotographerUserID(id uint64)
+	ListingIdentityID() int64
+	SetListingIdentityID(id int64)
+	SessionStartsAt() time.Time
+	SetSessionStartsAt(value time.Time)
+	// ArrivedAt is when the photographer confirmed arrival; ArrivalOnTime is evaluated against the grace period.
+	ArrivedAt() *time.Time
+	SetArrivedAt(value *time.Time)
+	ArrivalOnTime() *bool
+	SetArrivalOnTime(value *bool)
+	CompletedAt() *time.Time
+	SetCompletedAt(value *time.Time)
+	// MediaUploadedAt is the first time the session media was submitted for processing.
+	MediaUploadedAt() *time.Time
+	SetMediaUploadedAt(value *time.Time)
+	OwnerRejections() int
+	SetOwnerRejections(count int)
+	OwnerApprovedAt() *time.Time
+	SetOwnerApprovedAt(value *time.Time)
+	Rating() *int
+	SetRating(value *int)
+	RatingComment() *string
+	SetRatingComment(value *string)
+	RatedAt() *time.Time
+	SetRatedAt(value *time.Time)
+}
+
+// NewSessionKPI creates a new mutable session KPI record.
+func NewSessionKPI() SessionKPIInterface {
+	return &sessionKPI{}
+}
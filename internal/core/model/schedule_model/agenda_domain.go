@@ -5,6 +5,8 @@ type agenda struct {
 	listingIdentityID int64
 	ownerID           int64
 	timezone          string
+	templateID        *uint64
+	overridden        bool
 }
 
 func (a *agenda) ID() uint64 {
@@ -38,3 +40,26 @@ func (a *agenda) Timezone() string {
 func (a *agenda) SetTimezone(value string) {
 	a.timezone = value
 }
+
+func (a *agenda) TemplateID() (uint64, bool) {
+	if a.templateID == nil {
+		return 0, false
+	}
+	return *a.templateID, true
+}
+
+func (a *agenda) SetTemplateID(templateID uint64) {
+	a.templateID = &templateID
+}
+
+func (a *agenda) ClearTemplateID() {
+	a.templateID = nil
+}
+
+func (a *agenda) TemplateOverridden() bool {
+	return a.overridden
+}
+
+func (a *agenda) SetTemplateOverridden(value bool) {
+	a.overridden = value
+}
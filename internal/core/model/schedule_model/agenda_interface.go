@@ -10,6 +10,14 @@ type AgendaInterface interface {
 	SetOwnerID(ownerID int64)
 	Timezone() string
 	SetTimezone(value string)
+	// TemplateID is the availability template the agenda rules were copied from, when linked.
+	TemplateID() (uint64, bool)
+	SetTemplateID(templateID uint64)
+	ClearTemplateID()
+	// TemplateOverridden reports whether the rules were edited locally after the template was applied;
+	// overridden agendas no longer receive template updates.
+	TemplateOverridden() bool
+	SetTemplateOverridden(value bool)
 }
 
 // NewAgenda builds an empty agenda domain object.
@@ -6,10 +6,26 @@ type RuleType string
 const (
 	// RuleTypeBlock marks the interval as unavailable until overridden by a specific entry.
 	RuleTypeBlock RuleType = "BLOCK"
-	// RuleTypeFree marks the interval as free and informative.
+	// RuleTypeFree marks the interval as free: informative for weekly rules, and reopens time blocked by broader
+	// recurrences for monthly and date rules.
 	RuleTypeFree RuleType = "FREE"
 )
 
+// RuleRecurrence describes which days an agenda rule applies to. Availability evaluates rules from the least to
+// the most specific recurrence (weekly, then monthly, then date), so a more specific rule overrides a broader one.
+type RuleRecurrence string
+
+const (
+	// RuleRecurrenceWeekly applies the rule every week on DayOfWeek.
+	RuleRecurrenceWeekly RuleRecurrence = "WEEKLY"
+	// RuleRecurrenceMonthlyDay applies the rule every month on DayOfMonth (skipped in shorter months).
+	RuleRecurrenceMonthlyDay RuleRecurrence = "MONTHLY_DAY"
+	// RuleRecurrenceMonthlyWeekday applies the rule on the WeekOfMonth-th DayOfWeek of every month.
+	RuleRecurrenceMonthlyWeekday RuleRecurrence = "MONTHLY_WEEKDAY"
+	// RuleRecurrenceDate applies the rule on a single calendar day (date-level override).
+	RuleRecurrenceDate RuleRecurrence = "DATE"
+)
+
 // EntryType describes the source that created a specific agenda entry.
 type EntryType string
 
@@ -20,6 +36,7 @@ const (
 	EntryTypeVisitConfirmed EntryType = "VISIT_CONFIRMED"
 	EntryTypePhotoSession   EntryType = "PHOTO_SESSION"
 	EntryTypeHolidayInfo    EntryType = "HOLIDAY_INFO"
+	EntryTypeOpenHouse      EntryType = "OPEN_HOUSE"
 )
 
 // TimelineSource identifies the origin of a timeline window.
@@ -96,6 +96,9 @@ type RuleListResult struct {
 	ListingIdentityID int64
 	Timezone          string
 	Rules             []AgendaRuleInterface
+	// TemplateID is set when the agenda is linked to an availability template.
+	TemplateID         *uint64
+	TemplateOverridden bool
 }
 
 // AvailabilityData groups raw entries and rules so services can compute free slots.
@@ -103,3 +106,10 @@ type AvailabilityData struct {
 	Entries []AgendaEntryInterface
 	Rules   []AgendaRuleInterface
 }
+
+// OwnerAgendaEntry is an agenda entry of one of the owner's listings, with the agenda timezone.
+type OwnerAgendaEntry struct {
+	ListingIdentityID int64
+	Timezone          string
+	Entry             AgendaEntryInterface
+}
@@ -10,6 +10,12 @@ type agendaRule struct {
 	endMinute   uint16
 	ruleType    RuleType
 	active      bool
+	recurrence  RuleRecurrence
+	dayOfMonth  uint8
+	weekOfMonth int8
+	ruleDate    *time.Time
+	validFrom   *time.Time
+	validUntil  *time.Time
 }
 
 func (r *agendaRule) ID() uint64 {
@@ -67,3 +73,75 @@ func (r *agendaRule) IsActive() bool {
 func (r *agendaRule) SetActive(value bool) {
 	r.active = value
 }
+
+func (r *agendaRule) Recurrence() RuleRecurrence {
+	if r.recurrence == "" {
+		return RuleRecurrenceWeekly
+	}
+	return r.recurrence
+}
+
+func (r *agendaRule) SetRecurrence(value RuleRecurrence) {
+	r.recurrence = value
+}
+
+func (r *agendaRule) DayOfMonth() uint8 {
+	return r.dayOfMonth
+}
+
+func (r *agendaRule) SetDayOfMonth(value uint8) {
+	r.dayOfMonth = value
+}
+
+func (r *agendaRule) WeekOfMonth() int8 {
+	return r.weekOfMonth
+}
+
+func (r *agendaRule) SetWeekOfMonth(value int8) {
+	r.weekOfMonth = value
+}
+
+func (r *agendaRule) RuleDate() (time.Time, bool) {
+	if r.ruleDate == nil {
+		return time.Time{}, false
+	}
+	return *r.ruleDate, true
+}
+
+func (r *agendaRule) SetRuleDate(value time.Time) {
+	r.ruleDate = &value
+}
+
+func (r *agendaRule) ClearRuleDate() {
+	r.ruleDate = nil
+}
+
+func (r *agendaRule) ValidFrom() (time.Time, bool) {
+	if r.validFrom == nil {
+		return time.Time{}, false
+	}
+	return *r.validFrom, true
+}
+
+func (r *agendaRule) SetValidFrom(value time.Time) {
+	r.validFrom = &value
+}
+
+func (r *agendaRule) ClearValidFrom() {
+	r.validFrom = nil
+}
+
+func (r *agendaRule) ValidUntil() (time.Time, bool) {
+	if r.validUntil == nil {
+		return time.Time{}, false
+	}
+	return *r.validUntil, true
+}
+
+func (r *agendaRule) SetValidUntil(value time.Time) {
+	r.validUntil = &value
+}
+
+func (r *agendaRule) ClearValidUntil() {
+	r.validUntil = nil
+}
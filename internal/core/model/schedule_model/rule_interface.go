@@ -18,6 +18,26 @@ type AgendaRuleInterface interface {
 	SetRuleType(value RuleType)
 	IsActive() bool
 	SetActive(value bool)
+	// Recurrence selects which days the rule applies to; WEEKLY when unset.
+	Recurrence() RuleRecurrence
+	SetRecurrence(value RuleRecurrence)
+	// DayOfMonth is the 1-31 day used by MONTHLY_DAY rules.
+	DayOfMonth() uint8
+	SetDayOfMonth(value uint8)
+	// WeekOfMonth is the 1-5 (or -1 for last) occurrence of DayOfWeek used by MONTHLY_WEEKDAY rules.
+	WeekOfMonth() int8
+	SetWeekOfMonth(value int8)
+	// RuleDate is the calendar day targeted by DATE rules.
+	RuleDate() (time.Time, bool)
+	SetRuleDate(value time.Time)
+	ClearRuleDate()
+	// ValidFrom and ValidUntil bound, inclusively and in agenda-local days, when a recurring rule applies.
+	ValidFrom() (time.Time, bool)
+	SetValidFrom(value time.Time)
+	ClearValidFrom()
+	ValidUntil() (time.Time, bool)
+	SetValidUntil(value time.Time)
+	ClearValidUntil()
 }
 
 // NewAgendaRule returns a new rule object.
@@ -0,0 +1,69 @@
+package schedulemodel
+
+import "time"
+
+type availabilityTemplate struct {
+	id        uint64
+	ownerID   int64
+	name      string
+	isDefault bool
+	createdAt time.Time
+	updatedAt time.Time
+	rules     []AgendaRuleInterface
+}
+
+func (t *availabilityTemplate) ID() uint64 {
+	return t.id
+}
+
+func (t *availabilityTemplate) SetID(id uint64) {
+	t.id = id
+}
+
+func (t *availabilityTemplate) OwnerID() int64 {
+	return t.ownerID
+}
+
+func (t *availabilityTemplate) SetOwnerID(ownerID int64) {
+	t.ownerID = ownerID
+}
+
+func (t *availabilityTemplate) Name() string {
+	return t.name
+}
+
+func (t *availabilityTemplate) SetName(value string) {
+	t.name = value
+}
+
+func (t *availabilityTemplate) IsDefault() bool {
+	return t.isDefault
+}
+
+func (t *availabilityTemplate) SetDefault(value bool) {
+	t.isDefault = value
+}
+
+func (t *availabilityTemplate) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t *availabilityTemplate) SetCreatedAt(value time.Time) {
+	t.createdAt = value
+}
+
+func (t *availabilityTemplate) UpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+func (t *availabilityTemplate) SetUpdatedAt(value time.Time) {
+	t.updatedAt = value
+}
+
+func (t *availabilityTemplate) Rules() []AgendaRuleInterface {
+	return t.rules
+}
+
+func (t *availabilityTemplate) SetRules(rules []AgendaRuleInterface) {
+	t.rules = rules
+}
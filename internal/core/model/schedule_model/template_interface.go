@@ -0,0 +1,28 @@
+package schedulemodel
+
+import "time"
+
+// AvailabilityTemplateInterface represents a named set of agenda rules owned by a user, reusable across listings.
+type AvailabilityTemplateInterface interface {
+	ID() uint64
+	SetID(id uint64)
+	OwnerID() int64
+	SetOwnerID(ownerID int64)
+	Name() string
+	SetName(value string)
+	// IsDefault marks the template applied to agendas created for the owner's new listings.
+	IsDefault() bool
+	SetDefault(value bool)
+	CreatedAt() time.Time
+	SetCreatedAt(value time.Time)
+	UpdatedAt() time.Time
+	SetUpdatedAt(value time.Time)
+	// Rules are the template rules; their AgendaID is always zero.
+	Rules() []AgendaRuleInterface
+	SetRules(rules []AgendaRuleInterface)
+}
+
+// NewAvailabilityTemplate builds an empty availability template domain object.
+func NewAvailabilityTemplate() AvailabilityTemplateInterface {
+	return &availabilityTemplate{}
+}
@@ -0,0 +1,15 @@
+package usermodel
+
+import "time"
+
+// CalendarFeed is the user's secret iCalendar subscription
+//
+// Value Object mapped to user_calendar_feeds (one row per user). Only the SHA-256 hash of the
+// token is stored, so the feed URL is shown once on regeneration and rotating the token
+// immediately invalidates every subscription made with the previous URL.
+type CalendarFeed struct {
+	UserID         int64
+	TokenHash      string
+	CreatedAt      time.Time
+	LastAccessedAt *time.Time
+}
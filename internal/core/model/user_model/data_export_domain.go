@@ -0,0 +1,96 @@
+package usermodel
+
+import "time"
+
+type dataExport struct {
+	id           int64
+	userID       int64
+	status       DataExportStatus
+	objectKey    string
+	errorMessage string
+	attempts     int
+	requestedAt  time.Time
+	startedAt    *time.Time
+	completedAt  *time.Time
+	expiresAt    *time.Time
+}
+
+func (d *dataExport) GetID() int64 {
+	return d.id
+}
+
+func (d *dataExport) SetID(id int64) {
+	d.id = id
+}
+
+func (d *dataExport) GetUserID() int64 {
+	return d.userID
+}
+
+func (d *dataExport) SetUserID(userID int64) {
+	d.userID = userID
+}
+
+func (d *dataExport) GetStatus() DataExportStatus {
+	return d.status
+}
+
+func (d *dataExport) SetStatus(status DataExportStatus) {
+	d.status = status
+}
+
+func (d *dataExport) GetObjectKey() string {
+	return d.objectKey
+}
+
+func (d *dataExport) SetObjectKey(key string) {
+	d.objectKey = key
+}
+
+func (d *dataExport) GetErrorMessage() string {
+	return d.errorMessage
+}
+
+func (d *dataExport) SetErrorMessage(message string) {
+	d.errorMessage = message
+}
+
+func (d *dataExport) GetRequestedAt() time.Time {
+	return d.requestedAt
+}
+
+func (d *dataExport) SetRequestedAt(requestedAt time.Time) {
+	d.requestedAt = requestedAt
+}
+
+func (d *dataExport) GetAttempts() int {
+	return d.attempts
+}
+
+func (d *dataExport) SetAttempts(attempts int) {
+	d.attempts = attempts
+}
+
+func (d *dataExport) GetStartedAt() *time.Time {
+	return d.startedAt
+}
+
+func (d *dataExport) SetStartedAt(startedAt *time.Time) {
+	d.startedAt = startedAt
+}
+
+func (d *dataExport) GetCompletedAt() *time.Time {
+	return d.completedAt
+}
+
+func (d *dataExport) SetCompletedAt(completedAt *time.Time) {
+	d.completedAt = completedAt
+}
+
+func (d *dataExport) GetExpiresAt() *time.Time {
+	return d.expiresAt
+}
+
+func (d *dataExport) SetExpiresAt(expiresAt *time.Time) {
+	d.expiresAt = expiresAt
+}
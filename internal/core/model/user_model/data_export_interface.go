@@ -0,0 +1,80 @@
+package usermodel
+
+import "time"
+
+// DataExportStatus tracks the lifecycle of an LGPD personal data export job.
+type DataExportStatus string
+
+const (
+	DataExportStatusPending    DataExportStatus = "PENDING"
+	DataExportStatusProcessing DataExportStatus = "PROCESSING"
+	DataExportStatusCompleted  DataExportStatus = "COMPLETED"
+	DataExportStatusFailed     DataExportStatus = "FAILED"
+)
+
+// IsOpen reports whether the job is still waiting for or under processing.
+func (s DataExportStatus) IsOpen() bool {
+	return s == DataExportStatusPending || s == DataExportStatusProcessing
+}
+
+// DataExportSection names one group of personal data collected into the export archive.
+type DataExportSection string
+
+const (
+	DataExportSectionProfile         DataExportSection = "profile"
+	DataExportSectionRoles           DataExportSection = "roles"
+	DataExportSectionSessions        DataExportSection = "sessions"
+	DataExportSectionDeviceTokens    DataExportSection = "device_tokens"
+	DataExportSectionListings        DataExportSection = "listings"
+	DataExportSectionListingVersions DataExportSection = "listing_versions"
+	DataExportSectionVisits          DataExportSection = "visits"
+	DataExportSectionProposals       DataExportSection = "proposals"
+	DataExportSectionFavorites       DataExportSection = "favorites"
+	DataExportSectionAuditEvents     DataExportSection = "audit_events"
+)
+
+// DataExportSections lists every section included in an export, in archive order.
+var DataExportSections = []DataExportSection{
+	DataExportSectionProfile,
+	DataExportSectionRoles,
+	DataExportSectionSessions,
+	DataExportSectionDeviceTokens,
+	DataExportSectionListings,
+	DataExportSectionListingVersions,
+	DataExportSectionVisits,
+	DataExportSectionProposals,
+	DataExportSectionFavorites,
+	DataExportSectionAuditEvents,
+}
+
+// DataExportInterface represents an asynchronous personal data export requested by a user.
+type DataExportInterface interface {
+	GetID() int64
+	SetID(id int64)
+	GetUserID() int64
+	SetUserID(userID int64)
+	GetStatus() DataExportStatus
+	SetStatus(status DataExportStatus)
+	// GetObjectKey returns the storage key of the generated archive (empty until completed).
+	GetObjectKey() string
+	SetObjectKey(key string)
+	GetErrorMessage() string
+	SetErrorMessage(message string)
+	// GetAttempts returns how many times a worker claimed the job.
+	GetAttempts() int
+	SetAttempts(attempts int)
+	GetRequestedAt() time.Time
+	SetRequestedAt(requestedAt time.Time)
+	// GetStartedAt returns when the current processing attempt started (nil while never claimed).
+	GetStartedAt() *time.Time
+	SetStartedAt(startedAt *time.Time)
+	GetCompletedAt() *time.Time
+	SetCompletedAt(completedAt *time.Time)
+	// GetExpiresAt returns when the download link sent to the user stops working.
+	GetExpiresAt() *time.Time
+	SetExpiresAt(expiresAt *time.Time)
+}
+
+func NewDataExport() DataExportInterface {
+	return &dataExport{}
+}
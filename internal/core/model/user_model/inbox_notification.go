@@ -0,0 +1,32 @@
+package usermodel
+
+import (
+	"time"
+
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
+)
+
+// InboxNotification is a notification persisted in the user's in-app inbox
+//
+// Value Object mapped to user_notifications. Every dispatch of the unified notification
+// service addressed to a known user is stored here, so messages missed on the delivery
+// channel (email, SMS, push) remain available in the app.
+type InboxNotification struct {
+	ID       int64
+	UserID   int64
+	Channel  globalmodel.NotificationChannel
+	Category globalmodel.NotificationCategory
+	Title    string
+	Body     string
+	// DeepLink is the optional in-app route opened when the entry is tapped.
+	DeepLink string
+	// Data carries the identifiers sent with the push payload (visit_id, proposalId, ...).
+	Data      map[string]string
+	ReadAt    *time.Time
+	CreatedAt time.Time
+}
+
+// IsRead reports whether the user already opened the notification.
+func (n InboxNotification) IsRead() bool {
+	return n.ReadAt != nil
+}
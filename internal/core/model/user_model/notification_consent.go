@@ -0,0 +1,38 @@
+package usermodel
+
+import (
+	"time"
+
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
+)
+
+// NotificationConsent is the consent a user recorded for one channel and category
+//
+// Value Object mapped to user_notification_consents (unique per user, channel and category).
+// Each change also produces an audit event, so the table holds the current state while the
+// audit trail keeps the full history required by LGPD.
+type NotificationConsent struct {
+	UserID        int64
+	Channel       globalmodel.NotificationChannel
+	Category      globalmodel.NotificationCategory
+	Granted       bool
+	PolicyVersion string
+	Source        string
+	UpdatedAt     time.Time
+}
+
+// ResolveNotificationConsent decides whether a notification may be sent given the recorded consents.
+//
+// Mandatory (transactional) categories are always allowed; otherwise the recorded choice for the
+// channel/category wins and the category default applies when nothing was recorded.
+func ResolveNotificationConsent(consents []NotificationConsent, channel globalmodel.NotificationChannel, category globalmodel.NotificationCategory) bool {
+	if category.IsMandatory() {
+		return true
+	}
+	for _, consent := range consents {
+		if consent.Channel == channel && consent.Category == category {
+			return consent.Granted
+		}
+	}
+	return category.DefaultConsent()
+}
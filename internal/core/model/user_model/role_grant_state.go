@@ -0,0 +1,33 @@
+package usermodel
+
+// RoleGrantState tracks where a time-bound role grant is in its validity window.
+//
+// The state is persisted in user_roles.grant_state and advanced by the role grant
+// scheduler worker. Permission queries always filter by valid_from/expires_at, so the
+// state exists to detect transitions (cache invalidation, notifications, audit) and
+// to let admins filter grants without recomputing the window.
+type RoleGrantState uint8
+
+const (
+	// RoleGrantStateEffective means the grant is currently inside its validity window
+	// (or has no window at all, which is the default for permanent grants).
+	RoleGrantStateEffective RoleGrantState = iota
+	// RoleGrantStateScheduled means valid_from is still in the future.
+	RoleGrantStateScheduled
+	// RoleGrantStateExpired means expires_at has passed and the worker already processed it.
+	RoleGrantStateExpired
+)
+
+// String returns the API representation of the grant state.
+func (s RoleGrantState) String() string {
+	switch s {
+	case RoleGrantStateEffective:
+		return "EFFECTIVE"
+	case RoleGrantStateScheduled:
+		return "SCHEDULED"
+	case RoleGrantStateExpired:
+		return "EXPIRED"
+	default:
+		return "UNKNOWN"
+	}
+}
@@ -15,6 +15,9 @@ type userRole struct {
 	isActive     bool
 	status       globalmodel.UserRoleStatus
 	expiresAt    *time.Time
+	validFrom    *time.Time
+	grantState   RoleGrantState
+	notifiedAt   *time.Time
 	blockedUntil *time.Time
 	role         permissionmodel.RoleInterface
 }
@@ -74,6 +77,30 @@ func (ur *userRole) SetExpiresAt(expiresAt *time.Time) {
 	ur.expiresAt = expiresAt
 }
 
+func (ur *userRole) GetValidFrom() *time.Time {
+	return ur.validFrom
+}
+
+func (ur *userRole) SetValidFrom(validFrom *time.Time) {
+	ur.validFrom = validFrom
+}
+
+func (ur *userRole) GetGrantState() RoleGrantState {
+	return ur.grantState
+}
+
+func (ur *userRole) SetGrantState(state RoleGrantState) {
+	ur.grantState = state
+}
+
+func (ur *userRole) GetExpiryNotifiedAt() *time.Time {
+	return ur.notifiedAt
+}
+
+func (ur *userRole) SetExpiryNotifiedAt(notifiedAt *time.Time) {
+	ur.notifiedAt = notifiedAt
+}
+
 func (ur *userRole) GetBlockedUntil() *time.Time {
 	return ur.blockedUntil
 }
@@ -20,6 +20,14 @@ type UserRoleInterface interface {
 	SetStatus(status globalmodel.UserRoleStatus)
 	GetExpiresAt() *time.Time
 	SetExpiresAt(expiresAt *time.Time)
+	// GetValidFrom returns the start of the grant window (nil = effective immediately).
+	GetValidFrom() *time.Time
+	SetValidFrom(validFrom *time.Time)
+	GetGrantState() RoleGrantState
+	SetGrantState(state RoleGrantState)
+	// GetExpiryNotifiedAt returns when the pre-expiration notice was sent (nil = not sent yet).
+	GetExpiryNotifiedAt() *time.Time
+	SetExpiryNotifiedAt(notifiedAt *time.Time)
 	GetBlockedUntil() *time.Time
 	SetBlockedUntil(blockedUntil *time.Time)
 	GetRole() permissionmodel.RoleInterface
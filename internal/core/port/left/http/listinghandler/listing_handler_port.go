@@ -18,6 +18,7 @@ type ListingHandlerPort interface {
 	ListPhotographerSlots(c *gin.Context)
 	ReservePhotoSession(c *gin.Context)
 	CancelPhotoSession(c *gin.Context)
+	ReschedulePhotoSession(c *gin.Context)
 	GetListing(c *gin.Context)
 	UpdateListing(c *gin.Context)
 	PromoteListingVersion(c *gin.Context)
@@ -11,13 +11,24 @@ type MediaProcessingHandlerPort interface {
 	// Retrieval
 	ListMedia(c *gin.Context)
 	GenerateDownloadURLs(c *gin.Context)
+	GetHLSPlaylist(c *gin.Context)  // Public, token-signed
+	GetPanoramaTile(c *gin.Context) // Public, token-signed
 
 	// Management
 	UpdateMedia(c *gin.Context)
+	ArrangeGallery(c *gin.Context)
+	SaveTour(c *gin.Context)
 	DeleteMedia(c *gin.Context)
 	CompleteMedia(c *gin.Context) // Finalização manual/zip
 	ApproveListingMedia(c *gin.Context)
 
+	// Admin job dashboard
+	ListProcessingJobs(c *gin.Context)
+	GetProcessingJob(c *gin.Context)
+	RetryProcessingJob(c *gin.Context)
+	CancelProcessingJob(c *gin.Context)
+	ForceCompleteProcessingJob(c *gin.Context)
+
 	// Callbacks (Internal/Webhook)
 	HandleProcessingCallback(c *gin.Context)
 }
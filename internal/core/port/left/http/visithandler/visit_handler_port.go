@@ -9,4 +9,13 @@ type VisitHandlerPort interface {
 	ListVisitsRealtor(c *gin.Context)
 	ListVisitsOwner(c *gin.Context)
 	GetVisit(c *gin.Context)
+	CreateOpenHouse(c *gin.Context)
+	ListOpenHousesListing(c *gin.Context)
+	ListOpenHousesOwner(c *gin.Context)
+	GetOpenHouse(c *gin.Context)
+	CancelOpenHouse(c *gin.Context)
+	NotifyOpenHouse(c *gin.Context)
+	RegisterOpenHouseClient(c *gin.Context)
+	CancelOpenHouseRegistration(c *gin.Context)
+	CheckInOpenHouseRegistration(c *gin.Context)
 }
@@ -7,6 +7,9 @@ import (
 )
 
 // QueuePortInterface defines the contract with the async pipeline used for media processing jobs.
+//
+// EnqueueJob and EnqueueRetry return the message id; the in-process pipeline returns the
+// execution ARN of the run instead, so it can be stopped before its first callback.
 type QueuePortInterface interface {
 	EnqueueJob(ctx context.Context, payload mediaprocessingmodel.MediaProcessingJobMessage) (string, error)
 	EnqueueRetry(ctx context.Context, payload mediaprocessingmodel.MediaProcessingJobMessage) (string, error)
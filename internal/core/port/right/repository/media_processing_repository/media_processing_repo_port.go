@@ -17,15 +17,35 @@ type RepositoryInterface interface {
 	GetAssetByID(ctx context.Context, tx *sql.Tx, assetID uint64) (mediaprocessingmodel.MediaAsset, error)
 	GetAssetByRawKey(ctx context.Context, tx *sql.Tx, rawKey string) (mediaprocessingmodel.MediaAsset, error)
 	ListAssets(ctx context.Context, tx *sql.Tx, listingIdentityID uint64, filter AssetFilter, pagination *Pagination) ([]mediaprocessingmodel.MediaAsset, error)
+	// ListAssetsForUpdate locks and returns every asset of a listing; tx required.
+	ListAssetsForUpdate(ctx context.Context, tx *sql.Tx, listingIdentityID uint64) ([]mediaprocessingmodel.MediaAsset, error)
 	CountAssets(ctx context.Context, tx *sql.Tx, listingIdentityID uint64, filter AssetFilter) (int64, error)
 	DeleteAsset(ctx context.Context, tx *sql.Tx, listingIdentityID uint64, assetType mediaprocessingmodel.MediaAssetType, sequence uint8) error
 	BulkUpdateAssetStatus(ctx context.Context, tx *sql.Tx, listingIdentityID uint64, fromStatus, toStatus mediaprocessingmodel.MediaAssetStatus) error
+	// UpdateAssetsStatusByIDs is BulkUpdateAssetStatus restricted to the given asset IDs.
+	UpdateAssetsStatusByIDs(ctx context.Context, tx *sql.Tx, listingIdentityID uint64, assetIDs []uint64, fromStatus, toStatus mediaprocessingmodel.MediaAssetStatus) error
+	// UpdateGalleryArrangement persists sequence and metadata of the given assets by ID; sequences
+	// may be swapped between assets of the same type.
+	UpdateGalleryArrangement(ctx context.Context, tx *sql.Tx, listingIdentityID uint64, assets []mediaprocessingmodel.MediaAsset) error
+	// ListGalleryCovers returns the processed cover photos of the given listings.
+	ListGalleryCovers(ctx context.Context, tx *sql.Tx, listingIdentityIDs []uint64) ([]mediaprocessingmodel.MediaAsset, error)
+	// GetTour returns the virtual tour of a listing (sql.ErrNoRows when none was saved).
+	GetTour(ctx context.Context, tx *sql.Tx, listingIdentityID uint64) (mediaprocessingmodel.MediaTour, error)
+	UpsertTour(ctx context.Context, tx *sql.Tx, tour mediaprocessingmodel.MediaTour) error
+	DeleteTour(ctx context.Context, tx *sql.Tx, listingIdentityID uint64) error
+	// GetParcel returns the parcel polygon of a land listing (sql.ErrNoRows when none was read).
+	GetParcel(ctx context.Context, tx *sql.Tx, listingIdentityID uint64) (mediaprocessingmodel.LandParcel, error)
+	UpsertParcel(ctx context.Context, tx *sql.Tx, parcel mediaprocessingmodel.LandParcel) error
+	DeleteParcel(ctx context.Context, tx *sql.Tx, listingIdentityID uint64) error
 
 	RegisterProcessingJob(ctx context.Context, tx *sql.Tx, job mediaprocessingmodel.MediaProcessingJob) (uint64, error)
 	GetProcessingJobByID(ctx context.Context, tx *sql.Tx, jobID uint64) (mediaprocessingmodel.MediaProcessingJob, error)
 	UpdateProcessingJob(ctx context.Context, tx *sql.Tx, job mediaprocessingmodel.MediaProcessingJob) error
 	GetLatestFinalizationJob(ctx context.Context, tx *sql.Tx, listingIdentityID uint64) (mediaprocessingmodel.MediaProcessingJob, error)
 	ListStuckJobs(ctx context.Context, tx *sql.Tx, status mediaprocessingmodel.MediaProcessingJobStatus, startedBefore time.Time) ([]mediaprocessingmodel.MediaProcessingJob, error)
+	// ListProcessingJobs returns jobs matching the filter, newest first.
+	ListProcessingJobs(ctx context.Context, tx *sql.Tx, filter JobFilter, pagination *Pagination) ([]mediaprocessingmodel.MediaProcessingJob, error)
+	CountProcessingJobs(ctx context.Context, tx *sql.Tx, filter JobFilter) (int64, error)
 	// DeleteOldJobs removes terminal jobs older than cutoff, capped by limit; returns rows deleted.
 	DeleteOldJobs(ctx context.Context, tx *sql.Tx, cutoff time.Time, limit int) (int64, error)
 }
@@ -42,5 +62,15 @@ type Pagination struct {
 type AssetFilter struct {
 	AssetTypes []mediaprocessingmodel.MediaAssetType
 	Status     []mediaprocessingmodel.MediaAssetStatus
-	Sequence   *uint8
+	// ExcludeStatus drops assets in any of these statuses.
+	ExcludeStatus []mediaprocessingmodel.MediaAssetStatus
+	Sequence      *uint8
+}
+
+// JobFilter narrows down processing job lookups.
+type JobFilter struct {
+	Statuses          []mediaprocessingmodel.MediaProcessingJobStatus
+	Providers         []mediaprocessingmodel.MediaProcessingProvider
+	ListingIdentityID uint64
+	CreatedBefore     *time.Time
 }
@@ -57,6 +57,38 @@ type PhotoSessionRepositoryInterface interface {
 	// GetActiveBookingByListingIdentityID returns latest active booking for a listing identity (statuses pending/accepted/active); sql.ErrNoRows when none.
 	GetActiveBookingByListingIdentityID(ctx context.Context, tx *sql.Tx, listingIdentityID int64) (photosessionmodel.PhotoSessionBookingInterface, error)
 
+	// CreateReschedule stores a reschedule audit record; tx required to stay atomic with the booking swap; returns new ID.
+	CreateReschedule(ctx context.Context, tx *sql.Tx, reschedule photosessionmodel.PhotoSessionRescheduleInterface) (uint64, error)
+	// CountReschedulesByBooking returns how many times a booking was rescheduled; tx optional; zero when none.
+	CountReschedulesByBooking(ctx context.Context, tx *sql.Tx, bookingID uint64) (int64, error)
+	// ListRescheduleCounts aggregates reschedules by listing identity or original photographer, most rescheduled first; tx optional; empty slice when none.
+	ListRescheduleCounts(ctx context.Context, tx *sql.Tx, filter photosessionmodel.RescheduleReportFilter) ([]photosessionmodel.RescheduleCount, error)
+	// CountRescheduleGroups returns the number of distinct groups matched by the filter (pagination ignored); tx optional.
+	CountRescheduleGroups(ctx context.Context, tx *sql.Tx, filter photosessionmodel.RescheduleReportFilter) (int64, error)
+	// ListPhotographerWorkloads sums booked minutes in [from, to) and all-time accept/reject decisions per photographer;
+	// tx optional; photographers without bookings are absent from the map.
+	ListPhotographerWorkloads(ctx context.Context, tx *sql.Tx, photographerIDs []uint64, from, to time.Time) (map[uint64]photosessionmodel.PhotographerWorkload, error)
+	// GetListingCoordinates returns the land parcel centroid (lat, lng) of a listing identity; tx optional; sql.ErrNoRows when absent.
+	GetListingCoordinates(ctx context.Context, tx *sql.Tx, listingIdentityID int64) (float64, float64, error)
+	// ListPayoutRules returns payout pricing rules ordered by id; onlyActive drops disabled rules; tx optional.
+	ListPayoutRules(ctx context.Context, tx *sql.Tx, onlyActive bool) ([]photosessionmodel.PayoutRule, error)
+	// CreatePayoutLine stores the ledger line of a completed booking; tx required to stay atomic with the DONE transition.
+	CreatePayoutLine(ctx context.Context, tx *sql.Tx, line photosessionmodel.PayoutLedgerLineInterface) (uint64, error)
+	// ListPayoutLines returns ledger lines matching the filter; tx optional; empty slice when none.
+	ListPayoutLines(ctx context.Context, tx *sql.Tx, filter photosessionmodel.PayoutLedgerFilter) ([]photosessionmodel.PayoutLedgerLineInterface, error)
+	// MarkPayoutLinesPaid settles the PENDING lines of a period that have a rule with the reference; tx required; returns affected rows.
+	MarkPayoutLinesPaid(ctx context.Context, tx *sql.Tx, period string, reference string, paidAt time.Time) (int64, error)
+	// GetSessionKPIForUpdate locks the KPI row of a booking; tx required; returns sql.ErrNoRows when none exists yet.
+	GetSessionKPIForUpdate(ctx context.Context, tx *sql.Tx, bookingID uint64) (photosessionmodel.SessionKPIInterface, error)
+	// GetLatestCompletedSessionKPIForUpdate locks the KPI row of the listing's latest completed session; tx required; sql.ErrNoRows when none.
+	GetLatestCompletedSessionKPIForUpdate(ctx context.Context, tx *sql.Tx, listingIdentityID int64) (photosessionmodel.SessionKPIInterface, error)
+	// UpsertSessionKPI inserts or overwrites the KPI row keyed by booking; tx required.
+	UpsertSessionKPI(ctx context.Context, tx *sql.Tx, kpi photosessionmodel.SessionKPIInterface) error
+	// ListPhotographerKPIs aggregates session KPIs per photographer; tx optional; empty slice when none.
+	ListPhotographerKPIs(ctx context.Context, tx *sql.Tx, filter photosessionmodel.PhotographerKPIFilter) ([]photosessionmodel.PhotographerKPI, error)
+	// CountPhotographerKPIGroups counts photographers with KPI rows matching the filter; tx optional.
+	CountPhotographerKPIGroups(ctx context.Context, tx *sql.Tx, filter photosessionmodel.PhotographerKPIFilter) (int64, error)
+
 	// ListServiceAreasByPhotographer lists service areas for a photographer; tx optional; empty slice when none.
 	ListServiceAreasByPhotographer(ctx context.Context, tx *sql.Tx, photographerID uint64) ([]photosessionmodel.PhotographerServiceAreaInterface, error)
 	// GetServiceAreaByID fetches a service area; tx optional; sql.ErrNoRows when absent.
@@ -41,6 +41,26 @@ type ScheduleRepositoryInterface interface {
 	UpdateRule(ctx context.Context, tx *sql.Tx, rule schedulemodel.AgendaRuleInterface) error
 	// DeleteRule removes a single rule; returns sql.ErrNoRows when no row matches the given id.
 	DeleteRule(ctx context.Context, tx *sql.Tx, ruleID uint64) error
+	// UpdateAgendaTemplate persists the agenda template link (template id and override flag); requires a transaction.
+	UpdateAgendaTemplate(ctx context.Context, tx *sql.Tx, agenda schedulemodel.AgendaInterface) error
+	// ListAgendasByTemplate lists agendas linked to a template, overridden ones included; returns empty slice when none.
+	ListAgendasByTemplate(ctx context.Context, tx *sql.Tx, templateID uint64) ([]schedulemodel.AgendaInterface, error)
+	// InsertTemplate creates an availability template header and sets its generated ID; rules are written by ReplaceTemplateRules.
+	InsertTemplate(ctx context.Context, tx *sql.Tx, template schedulemodel.AvailabilityTemplateInterface) (uint64, error)
+	// UpdateTemplate updates name, default flag and updated_at; returns sql.ErrNoRows when the template does not exist.
+	UpdateTemplate(ctx context.Context, tx *sql.Tx, template schedulemodel.AvailabilityTemplateInterface) error
+	// DeleteTemplate removes a template and its rules, unlinking agendas; returns sql.ErrNoRows when nothing is deleted.
+	DeleteTemplate(ctx context.Context, tx *sql.Tx, templateID uint64) error
+	// ReplaceTemplateRules swaps all rules of a template for the given ones inside the provided transaction.
+	ReplaceTemplateRules(ctx context.Context, tx *sql.Tx, templateID uint64, rules []schedulemodel.AgendaRuleInterface) error
+	// GetTemplateByID fetches a template with its rules; returns sql.ErrNoRows when missing.
+	GetTemplateByID(ctx context.Context, tx *sql.Tx, templateID uint64) (schedulemodel.AvailabilityTemplateInterface, error)
+	// ListTemplatesByOwner lists an owner's templates with rules ordered by name; returns empty slice when none.
+	ListTemplatesByOwner(ctx context.Context, tx *sql.Tx, ownerID int64) ([]schedulemodel.AvailabilityTemplateInterface, error)
+	// GetDefaultTemplateByOwner fetches the owner's default template with rules; returns sql.ErrNoRows when none is marked.
+	GetDefaultTemplateByOwner(ctx context.Context, tx *sql.Tx, ownerID int64) (schedulemodel.AvailabilityTemplateInterface, error)
+	// ClearDefaultTemplates unsets the default flag of the owner's templates except keepID; requires a transaction.
+	ClearDefaultTemplates(ctx context.Context, tx *sql.Tx, ownerID int64, keepID uint64) error
 	// ListBlockRules lists blocking rules filtered by owner, listing, and optional weekdays; returns empty slice when none.
 	ListBlockRules(ctx context.Context, tx *sql.Tx, filter schedulemodel.BlockRulesFilter) ([]schedulemodel.AgendaRuleInterface, error)
 	// ListOwnerSummary returns consolidated agenda summaries per listing for an owner with pagination.
@@ -61,6 +81,8 @@ type ScheduleRepositoryInterface interface {
 	DeleteEntry(ctx context.Context, tx *sql.Tx, entryID uint64) error
 	// ListEntriesBetween returns entries overlapping [from, to); returns empty slice when none match.
 	ListEntriesBetween(ctx context.Context, tx *sql.Tx, agendaID uint64, from time.Time, to time.Time) ([]schedulemodel.AgendaEntryInterface, error)
+	// ListOwnerEntriesBetween returns entries of all agendas owned by ownerID overlapping [from, to), with listing and timezone.
+	ListOwnerEntriesBetween(ctx context.Context, tx *sql.Tx, ownerID int64, from time.Time, to time.Time) ([]schedulemodel.OwnerAgendaEntry, error)
 	// GetAvailabilityData aggregates rules and entries to compute availability for a listing.
 	// Returns sql.ErrNoRows when the agenda is missing; otherwise bubbles infra errors for service mapping.
 	GetAvailabilityData(ctx context.Context, tx *sql.Tx, filter schedulemodel.AvailabilityFilter) (schedulemodel.AvailabilityData, error)
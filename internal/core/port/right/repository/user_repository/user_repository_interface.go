@@ -98,6 +98,62 @@ type UserRepoPortInterface interface {
 	DeactivateAllUserRoles(ctx context.Context, tx *sql.Tx, userID int64) error
 	// ActivateUserRole sets is_active=1 for role/user pair; tx required; sql.ErrNoRows if pair not found.
 	ActivateUserRole(ctx context.Context, tx *sql.Tx, userID, roleID int64) error
+	// ListUserRolesPendingGrantTransition lists grants whose valid_from/expires_at window changed grant_state; tx optional; empty slice when none.
+	ListUserRolesPendingGrantTransition(ctx context.Context, tx *sql.Tx, limit int) ([]usermodel.UserRoleInterface, error)
+	// UpdateUserRoleGrantState sets grant_state for a user_role; tx required; sql.ErrNoRows if id not found.
+	UpdateUserRoleGrantState(ctx context.Context, tx *sql.Tx, userRoleID int64, state usermodel.RoleGrantState) error
+	// UpdateUserRoleGrantWindow sets valid_from/expires_at/grant_state and clears expiry_notified_at; tx required; sql.ErrNoRows if id not found.
+	UpdateUserRoleGrantWindow(ctx context.Context, tx *sql.Tx, userRoleID int64, validFrom, expiresAt *time.Time, state usermodel.RoleGrantState) error
+	// ListExpiringUserRoles lists grants expiring before filter.Until with user/role data; tx optional; empty slice + total=0 when none.
+	ListExpiringUserRoles(ctx context.Context, tx *sql.Tx, filter ExpiringUserRolesFilter) (ExpiringUserRolesResult, error)
+	// MarkUserRoleExpiryNotified sets expiry_notified_at for a user_role; tx required; sql.ErrNoRows if id not found.
+	MarkUserRoleExpiryNotified(ctx context.Context, tx *sql.Tx, userRoleID int64, notifiedAt time.Time) error
+
+	// CreateDataExport inserts a personal data export job and sets its ID; tx required.
+	CreateDataExport(ctx context.Context, tx *sql.Tx, export usermodel.DataExportInterface) error
+	// GetLatestDataExportByUserID returns the user's most recent export job; tx optional; sql.ErrNoRows when none.
+	GetLatestDataExportByUserID(ctx context.Context, tx *sql.Tx, userID int64) (usermodel.DataExportInterface, error)
+	// ListPendingDataExports lists PENDING export jobs oldest first, locking them with SKIP LOCKED; tx required.
+	ListPendingDataExports(ctx context.Context, tx *sql.Tx, limit int) ([]usermodel.DataExportInterface, error)
+	// ReclaimStaleDataExports requeues PROCESSING jobs started before staleBefore, failing those at maxAttempts; tx required.
+	ReclaimStaleDataExports(ctx context.Context, tx *sql.Tx, staleBefore time.Time, maxAttempts int) (requeued, failed int64, err error)
+	// UpdateDataExport persists status/object key/error/attempts/timestamps of an export job; tx required; sql.ErrNoRows if id not found.
+	UpdateDataExport(ctx context.Context, tx *sql.Tx, export usermodel.DataExportInterface) error
+	// ListUserDataExportRecords returns the rows of one personal data section as column maps (secrets excluded); tx optional.
+	ListUserDataExportRecords(ctx context.Context, tx *sql.Tx, userID int64, section usermodel.DataExportSection) ([]map[string]any, error)
+	// AnonymizeUser overwrites the user's PII with irreversible tokens in every table that copies it; tx required.
+	// Returns affected rows per table; sql.ErrNoRows if the user row does not exist.
+	AnonymizeUser(ctx context.Context, tx *sql.Tx, anon UserAnonymization) (map[string]int64, error)
+	// ListNotificationConsents returns the user's recorded consents per channel/category; tx optional; empty slice when none.
+	ListNotificationConsents(ctx context.Context, tx *sql.Tx, userID int64) ([]usermodel.NotificationConsent, error)
+	// UpsertNotificationConsent inserts or replaces the consent for (user, channel, category); tx required.
+	UpsertNotificationConsent(ctx context.Context, tx *sql.Tx, consent usermodel.NotificationConsent) error
+
+	// Calendar feed subscriptions
+
+	// UpsertCalendarFeed stores the user's feed token hash, replacing any previous one; tx required.
+	UpsertCalendarFeed(ctx context.Context, tx *sql.Tx, userID int64, tokenHash string) error
+	// GetCalendarFeedByUserID returns the user's feed; tx optional; sql.ErrNoRows when none.
+	GetCalendarFeedByUserID(ctx context.Context, tx *sql.Tx, userID int64) (usermodel.CalendarFeed, error)
+	// GetCalendarFeedByTokenHash resolves a feed by token hash; tx optional; sql.ErrNoRows when unknown or revoked.
+	GetCalendarFeedByTokenHash(ctx context.Context, tx *sql.Tx, tokenHash string) (usermodel.CalendarFeed, error)
+	// DeleteCalendarFeed revokes the user's feed; tx required; sql.ErrNoRows when none.
+	DeleteCalendarFeed(ctx context.Context, tx *sql.Tx, userID int64) error
+	// TouchCalendarFeed sets last_accessed_at of the user's feed; tx optional; missing feed is not an error.
+	TouchCalendarFeed(ctx context.Context, tx *sql.Tx, userID int64, accessedAt time.Time) error
+
+	// In-app notification inbox
+
+	// CreateInboxNotification stores one dispatched notification in the user's inbox; tx optional; returns generated id.
+	CreateInboxNotification(ctx context.Context, tx *sql.Tx, notification usermodel.InboxNotification) (int64, error)
+	// ListInboxNotifications returns a page of the user's inbox newest first plus the total; tx optional.
+	ListInboxNotifications(ctx context.Context, tx *sql.Tx, filter InboxNotificationsFilter) (InboxNotificationsResult, error)
+	// CountUnreadInboxNotifications returns the number of unread inbox entries; tx optional.
+	CountUnreadInboxNotifications(ctx context.Context, tx *sql.Tx, userID int64) (int64, error)
+	// MarkInboxNotificationRead sets read_at on an entry owned by the user; tx required; already read is success; sql.ErrNoRows if not found for user.
+	MarkInboxNotificationRead(ctx context.Context, tx *sql.Tx, userID, notificationID int64, readAt time.Time) error
+	// MarkAllInboxNotificationsRead sets read_at on every unread entry of the user; tx required; returns rows updated.
+	MarkAllInboxNotificationsRead(ctx context.Context, tx *sql.Tx, userID int64, readAt time.Time) (int64, error)
 
 	// User blocking operations
 
@@ -163,3 +219,53 @@ type ListUsersResult struct {
 	Users []usermodel.UserInterface
 	Total int64
 }
+
+// ExpiringUserRolesFilter narrows ListExpiringUserRoles to grants expiring before Until.
+type ExpiringUserRolesFilter struct {
+	Until           time.Time
+	OnlyNotNotified bool
+	Page            int
+	Limit           int
+}
+
+// ExpiringUserRole couples a time-bound grant with the data needed to notify or list it.
+type ExpiringUserRole struct {
+	UserRole     usermodel.UserRoleInterface
+	UserFullName string
+	UserNickName string
+	UserEmail    string
+	RoleSlug     string
+	RoleName     string
+}
+
+type ExpiringUserRolesResult struct {
+	Items []ExpiringUserRole
+	Total int64
+}
+
+// UserAnonymization carries the replacement values written by AnonymizeUser.
+// OriginalPhoneNumber is only used to locate copies of the phone in other tables (e.g. agency_invites).
+type UserAnonymization struct {
+	UserID              int64
+	OriginalPhoneNumber string
+	FullName            string
+	NickName            string
+	NationalID          string
+	Email               string
+	PhoneNumber         string
+	Password            string
+	BornAt              time.Time
+}
+
+// InboxNotificationsFilter narrows ListInboxNotifications to one user's inbox.
+type InboxNotificationsFilter struct {
+	UserID     int64
+	OnlyUnread bool
+	Page       int
+	Limit      int
+}
+
+type InboxNotificationsResult struct {
+	Items []usermodel.InboxNotification
+	Total int64
+}
@@ -30,4 +30,31 @@ type VisitRepositoryInterface interface {
 
 	// ListVisits lists visits with filtering/pagination and hydrates the active listing snapshot for each row.
 	ListVisits(ctx context.Context, tx *sql.Tx, filter listingmodel.VisitListFilter) (listingmodel.VisitListResult, error)
+
+	// InsertOpenHouse persists a new open house and returns its generated ID.
+	InsertOpenHouse(ctx context.Context, tx *sql.Tx, openHouse listingmodel.OpenHouseInterface) (int64, error)
+
+	// UpdateOpenHouse updates the mutable fields of an open house; sql.ErrNoRows when PK not found.
+	UpdateOpenHouse(ctx context.Context, tx *sql.Tx, openHouse listingmodel.OpenHouseInterface) error
+
+	// GetOpenHouseByID retrieves an open house by primary key; returns sql.ErrNoRows if absent.
+	GetOpenHouseByID(ctx context.Context, tx *sql.Tx, id int64) (listingmodel.OpenHouseInterface, error)
+
+	// GetOpenHouseByIDForUpdate retrieves an open house locking its row to serialize registrations.
+	GetOpenHouseByIDForUpdate(ctx context.Context, tx *sql.Tx, id int64) (listingmodel.OpenHouseInterface, error)
+
+	// ListOpenHouses lists open houses with registration counters, filtering and pagination.
+	ListOpenHouses(ctx context.Context, tx *sql.Tx, filter listingmodel.OpenHouseListFilter) (listingmodel.OpenHouseListResult, error)
+
+	// InsertOpenHouseRegistration persists a client registration and returns its generated ID.
+	InsertOpenHouseRegistration(ctx context.Context, tx *sql.Tx, registration listingmodel.OpenHouseRegistrationInterface) (int64, error)
+
+	// UpdateOpenHouseRegistration updates status, slot and check-in of a registration; sql.ErrNoRows when PK not found.
+	UpdateOpenHouseRegistration(ctx context.Context, tx *sql.Tx, registration listingmodel.OpenHouseRegistrationInterface) error
+
+	// GetOpenHouseRegistrationByID retrieves a registration by primary key; returns sql.ErrNoRows if absent.
+	GetOpenHouseRegistrationByID(ctx context.Context, tx *sql.Tx, id int64) (listingmodel.OpenHouseRegistrationInterface, error)
+
+	// ListOpenHouseRegistrations lists the registrations of an open house in waitlist order, optionally for one realtor.
+	ListOpenHouseRegistrations(ctx context.Context, tx *sql.Tx, openHouseID int64, realtorUserID *int64) ([]listingmodel.OpenHouseRegistrationInterface, error)
 }
@@ -2,6 +2,8 @@ package storageport
 
 import (
 	"context"
+	"io"
+	"time"
 
 	storagemodel "github.com/projeto-toq/toq_server/internal/core/model/storage_model"
 )
@@ -19,6 +21,10 @@ type CloudStoragePortInterface interface {
 	// Object existence check
 	ObjectExists(ctx context.Context, bucketName, objectName string) (bool, error)
 
+	// User-scoped objects (stored under the "{userID}/" prefix of the user bucket)
+	UploadUserObject(ctx context.Context, userID int64, objectName string, content io.Reader, contentType string) (string, error)
+	GenerateUserObjectDownloadURL(objectKey string, ttl time.Duration) (string, error)
+
 	// Generic Signed URLs
 	GenerateUploadURL(bucketName, objectName, contentType string) (string, error)
 	GenerateDownloadURL(bucketName, objectName string) (string, error)
@@ -2,6 +2,7 @@ package storageport
 
 import (
 	"context"
+	"io"
 	"time"
 
 	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
@@ -16,6 +17,8 @@ type ListingMediaStoragePort interface {
 	DeleteObject(ctx context.Context, bucketKey string) error
 	DeleteKeys(ctx context.Context, keys []string) error
 	DownloadFile(ctx context.Context, key string) ([]byte, error)
+	// OpenFile streams an object; callers must close the reader. Prefer it to DownloadFile for large objects.
+	OpenFile(ctx context.Context, key string) (io.ReadCloser, error)
 	UploadFile(ctx context.Context, key string, content []byte, contentType string) error
 }
 
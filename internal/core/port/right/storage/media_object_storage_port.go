@@ -0,0 +1,20 @@
+package storageport
+
+import (
+	"context"
+	"io"
+)
+
+// MediaObjectStoragePort is the bucket/key level contract used by the media pipeline services
+// (thumbnails, video frames, zip bundles). It is implemented by the Lambda S3 adapter and by the
+// server S3 adapter when media is processed in-process.
+type MediaObjectStoragePort interface {
+	// Download retrieves an object from storage
+	Download(ctx context.Context, bucket, key string) (io.ReadCloser, error)
+
+	// Upload stores an object and returns its location/error
+	Upload(ctx context.Context, bucket, key string, body io.Reader, contentType string) error
+
+	// GetMetadata retrieves object metadata (size, etag)
+	GetMetadata(ctx context.Context, bucket, key string) (int64, string, error)
+}
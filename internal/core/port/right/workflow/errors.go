@@ -5,3 +5,6 @@ import "errors"
 // ErrFinalizationAccessDenied indicates the backend IAM role cannot start the
 // Step Functions execution responsible for media finalization.
 var ErrFinalizationAccessDenied = errors.New("workflow finalization access denied")
+
+// ErrExecutionNotFound indicates the execution to stop does not exist or is no longer running.
+var ErrExecutionNotFound = errors.New("workflow execution not found")
@@ -9,4 +9,6 @@ import (
 // WorkflowPortInterface defines the contract for interacting with workflow orchestration engines (e.g. AWS Step Functions).
 type WorkflowPortInterface interface {
 	StartMediaFinalization(ctx context.Context, input mediaprocessingmodel.MediaFinalizationInput) (executionARN string, err error)
+	// StopExecution aborts a running execution; ErrExecutionNotFound when it is unknown or already finished.
+	StopExecution(ctx context.Context, executionARN string, cause string) error
 }
@@ -0,0 +1,62 @@
+package calendarfeedservice
+
+import (
+	"context"
+	"time"
+
+	listingrepository "github.com/projeto-toq/toq_server/internal/core/port/right/repository/listing_repository"
+	photosessionrepository "github.com/projeto-toq/toq_server/internal/core/port/right/repository/photo_session_repository"
+	schedulerepository "github.com/projeto-toq/toq_server/internal/core/port/right/repository/schedule_repository"
+	userrepository "github.com/projeto-toq/toq_server/internal/core/port/right/repository/user_repository"
+	visitrepository "github.com/projeto-toq/toq_server/internal/core/port/right/repository/visit_repository"
+	globalservice "github.com/projeto-toq/toq_server/internal/core/service/global_service"
+)
+
+// FeedStatus describes the caller's calendar subscription without exposing the token.
+type FeedStatus struct {
+	Active         bool
+	CreatedAt      *time.Time
+	LastAccessedAt *time.Time
+}
+
+// FeedToken is returned once when a feed token is (re)generated.
+type FeedToken struct {
+	Token     string
+	URL       string
+	CreatedAt time.Time
+}
+
+// Service manages per-user iCalendar subscriptions for photo sessions, time-off, visits and agenda blocks.
+type Service interface {
+	// GetFeed reports whether the authenticated user has an active feed.
+	GetFeed(ctx context.Context) (FeedStatus, error)
+	// RegenerateFeedToken issues a new secret URL, invalidating the previous one.
+	RegenerateFeedToken(ctx context.Context) (FeedToken, error)
+	// RevokeFeedToken disables the feed; subscribed clients start receiving 404.
+	RevokeFeedToken(ctx context.Context) error
+	// RenderFeed resolves the token and renders the owner's events as an RFC 5545 document.
+	RenderFeed(ctx context.Context, token string) ([]byte, error)
+}
+
+// NewService wires the calendar feed service dependencies.
+func NewService(gs globalservice.GlobalServiceInterface, userRepo userrepository.UserRepoPortInterface, scheduleRepo schedulerepository.ScheduleRepositoryInterface, photoSessionRepo photosessionrepository.PhotoSessionRepositoryInterface, visitRepo visitrepository.VisitRepositoryInterface, listingRepo listingrepository.ListingRepoPortInterface, config Config) Service {
+	return &calendarFeedService{
+		globalService:    gs,
+		userRepo:         userRepo,
+		scheduleRepo:     scheduleRepo,
+		photoSessionRepo: photoSessionRepo,
+		visitRepo:        visitRepo,
+		listingRepo:      listingRepo,
+		config:           config,
+	}
+}
+
+type calendarFeedService struct {
+	globalService    globalservice.GlobalServiceInterface
+	userRepo         userrepository.UserRepoPortInterface
+	scheduleRepo     schedulerepository.ScheduleRepositoryInterface
+	photoSessionRepo photosessionrepository.PhotoSessionRepositoryInterface
+	visitRepo        visitrepository.VisitRepositoryInterface
+	listingRepo      listingrepository.ListingRepoPortInterface
+	config           Config
+}
@@ -0,0 +1,52 @@
+package calendarfeedservice
+
+import (
+	"strings"
+	"time"
+
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
+)
+
+// Config controls the feed URL and the window of events exported.
+// BaseURL is the public prefix the token is appended to (e.g. https://api.toq.com.br/api/v2/calendar/feeds).
+// PastDays/FutureDays bound the events included relative to the fetch time.
+// RefreshInterval is advertised to calendar clients as the polling hint.
+type Config struct {
+	BaseURL         string
+	PastDays        int
+	FutureDays      int
+	RefreshInterval time.Duration
+}
+
+// DefaultConfig returns the built-in safe defaults.
+func DefaultConfig() Config {
+	return Config{
+		BaseURL:         "/api/v2/calendar/feeds",
+		PastDays:        30,
+		FutureDays:      180,
+		RefreshInterval: time.Hour,
+	}
+}
+
+// ConfigFromEnvironment converts YAML/env values into a Config, falling back to defaults on missing fields.
+func ConfigFromEnvironment(env *globalmodel.Environment) Config {
+	cfg := DefaultConfig()
+	if env == nil {
+		return cfg
+	}
+
+	if baseURL := strings.TrimRight(strings.TrimSpace(env.CalendarFeeds.BaseURL), "/"); baseURL != "" {
+		cfg.BaseURL = baseURL
+	}
+	if env.CalendarFeeds.PastDays > 0 {
+		cfg.PastDays = env.CalendarFeeds.PastDays
+	}
+	if env.CalendarFeeds.FutureDays > 0 {
+		cfg.FutureDays = env.CalendarFeeds.FutureDays
+	}
+	if env.CalendarFeeds.RefreshIntervalMinutes > 0 {
+		cfg.RefreshInterval = time.Duration(env.CalendarFeeds.RefreshIntervalMinutes) * time.Minute
+	}
+
+	return cfg
+}
@@ -0,0 +1,165 @@
+package calendarfeedservice
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+const feedTokenBytes = 32
+
+// GetFeed reports whether the authenticated user has an active calendar feed.
+func (s *calendarFeedService) GetFeed(ctx context.Context) (FeedStatus, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return FeedStatus{}, err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	userID, uidErr := s.globalService.GetUserIDFromContext(ctx)
+	if uidErr != nil {
+		return FeedStatus{}, uidErr
+	}
+
+	feed, repoErr := s.userRepo.GetCalendarFeedByUserID(ctx, nil, userID)
+	if repoErr != nil {
+		if errors.Is(repoErr, sql.ErrNoRows) {
+			return FeedStatus{Active: false}, nil
+		}
+		utils.SetSpanError(ctx, repoErr)
+		logger.Error("calendar_feed.get.repo_error", "user_id", userID, "err", repoErr)
+		return FeedStatus{}, utils.InternalError("")
+	}
+
+	createdAt := feed.CreatedAt
+	return FeedStatus{Active: true, CreatedAt: &createdAt, LastAccessedAt: feed.LastAccessedAt}, nil
+}
+
+// RegenerateFeedToken issues a new secret token for the authenticated user.
+//
+// Only the SHA-256 hash is persisted, so the returned URL cannot be recovered later; any
+// previously issued URL stops working immediately.
+func (s *calendarFeedService) RegenerateFeedToken(ctx context.Context) (FeedToken, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return FeedToken{}, err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	userID, uidErr := s.globalService.GetUserIDFromContext(ctx)
+	if uidErr != nil {
+		return FeedToken{}, uidErr
+	}
+
+	raw := make([]byte, feedTokenBytes)
+	if _, randErr := rand.Read(raw); randErr != nil {
+		utils.SetSpanError(ctx, randErr)
+		logger.Error("calendar_feed.regenerate.random_error", "user_id", userID, "err", randErr)
+		return FeedToken{}, utils.InternalError("")
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	tx, txErr := s.globalService.StartTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("calendar_feed.regenerate.tx_start_error", "err", txErr)
+		return FeedToken{}, utils.InternalError("")
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+				utils.SetSpanError(ctx, rbErr)
+				logger.Error("calendar_feed.regenerate.tx_rollback_error", "err", rbErr)
+			}
+		}
+	}()
+
+	if upErr := s.userRepo.UpsertCalendarFeed(ctx, tx, userID, hashFeedToken(token)); upErr != nil {
+		utils.SetSpanError(ctx, upErr)
+		logger.Error("calendar_feed.regenerate.upsert_error", "user_id", userID, "err", upErr)
+		return FeedToken{}, utils.InternalError("")
+	}
+
+	if cmErr := s.globalService.CommitTransaction(ctx, tx); cmErr != nil {
+		utils.SetSpanError(ctx, cmErr)
+		logger.Error("calendar_feed.regenerate.tx_commit_error", "err", cmErr)
+		return FeedToken{}, utils.InternalError("")
+	}
+	committed = true
+
+	logger.Info("calendar_feed.regenerated", "user_id", userID)
+
+	return FeedToken{
+		Token:     token,
+		URL:       s.config.BaseURL + "/" + token + ".ics",
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
+
+// RevokeFeedToken removes the authenticated user's calendar feed.
+func (s *calendarFeedService) RevokeFeedToken(ctx context.Context) error {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	userID, uidErr := s.globalService.GetUserIDFromContext(ctx)
+	if uidErr != nil {
+		return uidErr
+	}
+
+	tx, txErr := s.globalService.StartTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("calendar_feed.revoke.tx_start_error", "err", txErr)
+		return utils.InternalError("")
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+				utils.SetSpanError(ctx, rbErr)
+				logger.Error("calendar_feed.revoke.tx_rollback_error", "err", rbErr)
+			}
+		}
+	}()
+
+	if delErr := s.userRepo.DeleteCalendarFeed(ctx, tx, userID); delErr != nil {
+		if errors.Is(delErr, sql.ErrNoRows) {
+			return utils.NotFoundError("Calendar feed")
+		}
+		utils.SetSpanError(ctx, delErr)
+		logger.Error("calendar_feed.revoke.delete_error", "user_id", userID, "err", delErr)
+		return utils.InternalError("")
+	}
+
+	if cmErr := s.globalService.CommitTransaction(ctx, tx); cmErr != nil {
+		utils.SetSpanError(ctx, cmErr)
+		logger.Error("calendar_feed.revoke.tx_commit_error", "err", cmErr)
+		return utils.InternalError("")
+	}
+	committed = true
+
+	logger.Info("calendar_feed.revoked", "user_id", userID)
+	return nil
+}
+
+func hashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,402 @@
+package calendarfeedservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+	schedulemodel "github.com/projeto-toq/toq_server/internal/core/model/schedule_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+	"github.com/projeto-toq/toq_server/internal/core/utils/ics"
+)
+
+const (
+	feedProductID     = "-//TOQ//Agenda TOQ//PT-BR"
+	feedCalendarName  = "TOQ"
+	feedUIDDomain     = "@toq"
+	requesterPageSize = 50
+)
+
+// RenderFeed resolves the secret token and renders every agenda the owner takes part in:
+// photographer sessions, time-off and blocks, listing agenda entries (visits, photo sessions, blocks)
+// and visits requested by the user. Unknown, rotated or revoked tokens return 404.
+func (s *calendarFeedService) RenderFeed(ctx context.Context, token string) ([]byte, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return nil, utils.NotFoundError("Calendar feed")
+	}
+
+	tx, txErr := s.globalService.StartReadOnlyTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("calendar_feed.render.tx_start_error", "err", txErr)
+		return nil, utils.InternalError("")
+	}
+	defer func() {
+		if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+			utils.SetSpanError(ctx, rbErr)
+			logger.Error("calendar_feed.render.tx_rollback_error", "err", rbErr)
+		}
+	}()
+
+	feed, feedErr := s.userRepo.GetCalendarFeedByTokenHash(ctx, tx, hashFeedToken(token))
+	if feedErr != nil {
+		if errors.Is(feedErr, sql.ErrNoRows) {
+			return nil, utils.NotFoundError("Calendar feed")
+		}
+		utils.SetSpanError(ctx, feedErr)
+		logger.Error("calendar_feed.render.get_feed_error", "err", feedErr)
+		return nil, utils.InternalError("")
+	}
+
+	now := time.Now().UTC()
+	builder := &feedBuilder{
+		service:   s,
+		tx:        tx,
+		userID:    feed.UserID,
+		from:      now.AddDate(0, 0, -s.config.PastDays),
+		to:        now.AddDate(0, 0, s.config.FutureDays),
+		listings:  make(map[int64]listingmodel.ListingInterface),
+		locations: make(map[string]*time.Location),
+	}
+
+	if err = builder.addPhotographerEntries(ctx); err != nil {
+		return nil, err
+	}
+	if err = builder.addListingEntries(ctx); err != nil {
+		return nil, err
+	}
+	if err = builder.addRequestedVisits(ctx); err != nil {
+		return nil, err
+	}
+
+	if touchErr := s.userRepo.TouchCalendarFeed(ctx, nil, feed.UserID, now); touchErr != nil {
+		// Access tracking is informational; never fail the subscription because of it.
+		logger.Warn("calendar_feed.render.touch_error", "user_id", feed.UserID, "err", touchErr)
+	}
+
+	events := builder.events
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].Start.Equal(events[j].Start) {
+			return events[i].Start.Before(events[j].Start)
+		}
+		return events[i].UID < events[j].UID
+	})
+
+	calendar := ics.Calendar{
+		ProductID:       feedProductID,
+		Name:            feedCalendarName,
+		RefreshInterval: s.config.RefreshInterval,
+		Events:          events,
+	}
+
+	logger.Debug("calendar_feed.render.success", "user_id", feed.UserID, "events", len(events))
+	return calendar.Marshal(now), nil
+}
+
+// feedBuilder collects the events of one feed render, caching listings and time zones.
+type feedBuilder struct {
+	service   *calendarFeedService
+	tx        *sql.Tx
+	userID    int64
+	from, to  time.Time
+	events    []ics.Event
+	listings  map[int64]listingmodel.ListingInterface
+	locations map[string]*time.Location
+}
+
+func (b *feedBuilder) addPhotographerEntries(ctx context.Context) error {
+	logger := utils.LoggerFromContext(ctx)
+
+	entries, err := b.service.photoSessionRepo.ListEntriesByRange(ctx, b.tx, uint64(b.userID), b.from, b.to, nil)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("calendar_feed.render.photographer_entries_error", "user_id", b.userID, "err", err)
+		return utils.InternalError("")
+	}
+
+	for _, entry := range entries {
+		event := ics.Event{
+			Start: entry.StartsAt(),
+			End:   entry.EndsAt(),
+			TZ:    b.location(entry.Timezone()),
+		}
+		reason, hasReason := entry.Reason()
+
+		switch entry.EntryType() {
+		case photosessionmodel.AgendaEntryTypePhotoSession:
+			booking, bookingErr := b.service.photoSessionRepo.FindBookingByAgendaEntry(ctx, b.tx, entry.ID())
+			if bookingErr != nil {
+				if errors.Is(bookingErr, sql.ErrNoRows) {
+					continue
+				}
+				utils.SetSpanError(ctx, bookingErr)
+				logger.Error("calendar_feed.render.find_booking_error", "agenda_entry_id", entry.ID(), "err", bookingErr)
+				return utils.InternalError("")
+			}
+			if !bookingVisible(booking.Status()) {
+				continue
+			}
+			listing, listingErr := b.listing(ctx, booking.ListingIdentityID())
+			if listingErr != nil {
+				return listingErr
+			}
+			event.UID = fmt.Sprintf("photo-session-%d%s", booking.ID(), feedUIDDomain)
+			event.Summary = withListing("Sessão de fotos", listing)
+			event.Location = listingAddress(listing)
+			event.Status = ics.StatusConfirmed
+			if booking.Status() == photosessionmodel.BookingStatusPendingApproval {
+				event.Status = ics.StatusTentative
+			}
+			event.Categories = []string{"Sessão de fotos"}
+		case photosessionmodel.AgendaEntryTypeTimeOff:
+			event.UID = fmt.Sprintf("photographer-entry-%d%s", entry.ID(), feedUIDDomain)
+			event.Summary = "Folga"
+			if hasReason {
+				event.Description = reason
+			}
+			event.Status = ics.StatusConfirmed
+			event.Categories = []string{"Folga"}
+		case photosessionmodel.AgendaEntryTypeHoliday:
+			event.UID = fmt.Sprintf("photographer-entry-%d%s", entry.ID(), feedUIDDomain)
+			event.Summary = "Feriado"
+			if hasReason {
+				event.Summary = reason
+			}
+			event.Transparent = !entry.Blocking()
+			event.Categories = []string{"Feriado"}
+		default:
+			event.UID = fmt.Sprintf("photographer-entry-%d%s", entry.ID(), feedUIDDomain)
+			event.Summary = "Bloqueio"
+			if hasReason {
+				event.Description = reason
+			}
+			event.Status = ics.StatusConfirmed
+			event.Categories = []string{"Bloqueio"}
+		}
+
+		b.events = append(b.events, event)
+	}
+
+	return nil
+}
+
+func (b *feedBuilder) addListingEntries(ctx context.Context) error {
+	logger := utils.LoggerFromContext(ctx)
+
+	entries, err := b.service.scheduleRepo.ListOwnerEntriesBetween(ctx, b.tx, b.userID, b.from, b.to)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("calendar_feed.render.listing_entries_error", "user_id", b.userID, "err", err)
+		return utils.InternalError("")
+	}
+
+	for _, item := range entries {
+		entry := item.Entry
+		listing, listingErr := b.listing(ctx, item.ListingIdentityID)
+		if listingErr != nil {
+			return listingErr
+		}
+
+		event := ics.Event{
+			UID:      fmt.Sprintf("listing-entry-%d%s", entry.ID(), feedUIDDomain),
+			Start:    entry.StartsAt(),
+			End:      entry.EndsAt(),
+			TZ:       b.location(item.Timezone),
+			Location: listingAddress(listing),
+			Status:   ics.StatusConfirmed,
+		}
+		reason, hasReason := entry.Reason()
+
+		switch entry.EntryType() {
+		case schedulemodel.EntryTypeVisitPending, schedulemodel.EntryTypeVisitConfirmed:
+			if visitID, ok := entry.VisitID(); ok {
+				event.UID = fmt.Sprintf("listing-visit-%d%s", visitID, feedUIDDomain)
+			}
+			event.Summary = withListing("Visita", listing)
+			if entry.EntryType() == schedulemodel.EntryTypeVisitPending {
+				event.Summary = withListing("Visita pendente", listing)
+				event.Status = ics.StatusTentative
+			}
+			event.Categories = []string{"Visita"}
+		case schedulemodel.EntryTypePhotoSession:
+			if bookingID, ok := entry.PhotoBookingID(); ok {
+				event.UID = fmt.Sprintf("listing-photo-session-%d%s", bookingID, feedUIDDomain)
+			}
+			event.Summary = withListing("Sessão de fotos", listing)
+			event.Categories = []string{"Sessão de fotos"}
+		case schedulemodel.EntryTypeOpenHouse:
+			event.Summary = withListing("Visita coletiva", listing)
+			event.Categories = []string{"Visita coletiva"}
+		case schedulemodel.EntryTypeHolidayInfo:
+			event.Summary = "Feriado"
+			if hasReason {
+				event.Summary = reason
+			}
+			event.Location = ""
+			event.Status = ""
+			event.Transparent = !entry.Blocking()
+			event.Categories = []string{"Feriado"}
+		default:
+			event.Summary = withListing("Bloqueio", listing)
+			if hasReason {
+				event.Description = reason
+			}
+			event.Categories = []string{"Bloqueio"}
+		}
+
+		b.events = append(b.events, event)
+	}
+
+	return nil
+}
+
+func (b *feedBuilder) addRequestedVisits(ctx context.Context) error {
+	logger := utils.LoggerFromContext(ctx)
+
+	requesterID := b.userID
+	from, to := b.from, b.to
+	filter := listingmodel.VisitListFilter{
+		RequesterUserID: &requesterID,
+		Statuses:        []listingmodel.VisitStatus{listingmodel.VisitStatusPending, listingmodel.VisitStatusApproved},
+		From:            &from,
+		To:              &to,
+		Limit:           requesterPageSize,
+	}
+
+	tz := b.location("")
+	for page := 1; ; page++ {
+		filter.Page = page
+		result, err := b.service.visitRepo.ListVisits(ctx, b.tx, filter)
+		if err != nil {
+			utils.SetSpanError(ctx, err)
+			logger.Error("calendar_feed.render.requested_visits_error", "user_id", b.userID, "page", page, "err", err)
+			return utils.InternalError("")
+		}
+
+		for _, item := range result.Visits {
+			visit := item.Visit
+			if visit.OwnerUserID() == b.userID {
+				// Already exported from the listing agenda.
+				continue
+			}
+			event := ics.Event{
+				UID:        fmt.Sprintf("visit-%d%s", visit.ID(), feedUIDDomain),
+				Start:      visit.ScheduledStart(),
+				End:        visit.ScheduledEnd(),
+				TZ:         tz,
+				Summary:    withListing("Visita", item.Listing),
+				Location:   listingAddress(item.Listing),
+				Status:     ics.StatusConfirmed,
+				Categories: []string{"Visita"},
+			}
+			if visit.Status() == listingmodel.VisitStatusPending {
+				event.Summary = withListing("Visita aguardando aprovação", item.Listing)
+				event.Status = ics.StatusTentative
+			}
+			b.events = append(b.events, event)
+		}
+
+		if len(result.Visits) < requesterPageSize || int64(page*requesterPageSize) >= result.Total {
+			return nil
+		}
+	}
+}
+
+// listing returns the active listing version, cached per identity; nil when it no longer exists.
+func (b *feedBuilder) listing(ctx context.Context, listingIdentityID int64) (listingmodel.ListingInterface, error) {
+	if listing, ok := b.listings[listingIdentityID]; ok {
+		return listing, nil
+	}
+
+	listing, err := b.service.listingRepo.GetActiveListingVersion(ctx, b.tx, listingIdentityID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			utils.SetSpanError(ctx, err)
+			utils.LoggerFromContext(ctx).Error("calendar_feed.render.get_listing_error", "listing_identity_id", listingIdentityID, "err", err)
+			return nil, utils.InternalError("")
+		}
+		listing = nil
+	}
+
+	b.listings[listingIdentityID] = listing
+	return listing, nil
+}
+
+// location resolves an IANA zone, falling back to the application default for blank or unknown names.
+func (b *feedBuilder) location(name string) *time.Location {
+	if loc, ok := b.locations[name]; ok {
+		return loc
+	}
+
+	loc, locErr := utils.ResolveLocation("timezone", name)
+	if locErr != nil {
+		loc, _ = utils.ResolveLocation("timezone", "")
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	b.locations[name] = loc
+	return loc
+}
+
+func bookingVisible(status photosessionmodel.BookingStatus) bool {
+	switch status {
+	case photosessionmodel.BookingStatusCancelled, photosessionmodel.BookingStatusRejected, photosessionmodel.BookingStatusRescheduled:
+		return false
+	default:
+		return true
+	}
+}
+
+func withListing(summary string, listing listingmodel.ListingInterface) string {
+	if listing == nil {
+		return summary
+	}
+	if title := strings.TrimSpace(listing.Title()); title != "" {
+		return summary + " - " + title
+	}
+	return fmt.Sprintf("%s - Imóvel %d", summary, listing.Code())
+}
+
+func listingAddress(listing listingmodel.ListingInterface) string {
+	if listing == nil {
+		return ""
+	}
+
+	street := strings.TrimSpace(listing.Street())
+	if number := strings.TrimSpace(listing.Number()); number != "" {
+		street = strings.TrimSpace(street + ", " + number)
+	}
+	if complement := strings.TrimSpace(listing.Complement()); complement != "" {
+		street = street + " - " + complement
+	}
+
+	city := strings.TrimSpace(listing.City())
+	if state := strings.TrimSpace(listing.State()); state != "" {
+		city = strings.TrimSpace(city + " - " + state)
+	}
+
+	parts := make([]string, 0, 4)
+	for _, part := range []string{street, strings.TrimSpace(listing.Neighborhood()), city, strings.TrimSpace(listing.ZipCode())} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,92 @@
+package globalservice
+
+import (
+	"context"
+	"html"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+const (
+	inboxTitleMaxLength = 255
+	inboxBodyMaxLength  = 1000
+)
+
+var (
+	inboxHTMLTagPattern    = regexp.MustCompile(`(?s)<(script|style)[^>]*>.*?</(script|style)>|<[^>]+>`)
+	inboxWhitespacePattern = regexp.MustCompile(`\s+`)
+)
+
+// StoreInbox writes the inbox entry of a message independently of its delivery.
+func (ns *unifiedNotificationService) StoreInbox(ctx context.Context, request NotificationRequest) {
+	ctx = coreutils.ContextWithLogger(ctx)
+	if request.UserID <= 0 || request.SkipInbox {
+		return
+	}
+	if !ns.isAllowedByConsent(ctx, request) {
+		coreutils.LoggerFromContext(ctx).Info("notification.inbox_suppressed_by_consent",
+			"user_id", request.UserID,
+			"category", request.Category)
+		return
+	}
+	ns.storeInInbox(ctx, request)
+}
+
+// storeInInbox persists a copy of the notification in the recipient's in-app inbox.
+// Requests without a recipient account (e.g. invitations to unregistered phones) or flagged with
+// SkipInbox (e.g. verification codes) are not stored. Failures are logged only: the inbox never blocks the delivery.
+func (ns *unifiedNotificationService) storeInInbox(ctx context.Context, request NotificationRequest) {
+	if request.UserID <= 0 || request.SkipInbox || ns.globalService.userRepo == nil {
+		return
+	}
+
+	category := request.Category
+	if category == "" {
+		category = globalmodel.NotificationCategoryTransactional
+	}
+
+	body := request.Body
+	if request.Type == NotificationTypeEmail {
+		body = plainTextFromHTML(body)
+	}
+
+	entry := usermodel.InboxNotification{
+		UserID:   request.UserID,
+		Channel:  channelForType(request.Type),
+		Category: category,
+		Title:    truncateRunes(strings.TrimSpace(request.Subject), inboxTitleMaxLength),
+		Body:     truncateRunes(strings.TrimSpace(body), inboxBodyMaxLength),
+		DeepLink: request.DeepLink,
+		Data:     cloneStringMap(request.Data),
+	}
+
+	id, err := ns.globalService.userRepo.CreateInboxNotification(ctx, nil, entry)
+	if err != nil {
+		coreutils.SetSpanError(ctx, err)
+		coreutils.LoggerFromContext(ctx).Error("notification.inbox_store_error", "err", err, "user_id", request.UserID)
+		return
+	}
+
+	coreutils.LoggerFromContext(ctx).Debug("notification.inbox_stored", "notification_id", id, "user_id", request.UserID)
+}
+
+// plainTextFromHTML reduces an e-mail body to readable text for the inbox preview.
+func plainTextFromHTML(body string) string {
+	text := inboxHTMLTagPattern.ReplaceAllString(body, " ")
+	text = html.UnescapeString(text)
+	return strings.TrimSpace(inboxWhitespacePattern.ReplaceAllString(text, " "))
+}
+
+// truncateRunes cuts value to at most limit characters without splitting multi-byte runes.
+func truncateRunes(value string, limit int) string {
+	if utf8.RuneCountInString(value) <= limit {
+		return value
+	}
+	runes := []rune(value)
+	return string(runes[:limit])
+}
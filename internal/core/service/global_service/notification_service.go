@@ -7,6 +7,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
 	coreutils "github.com/projeto-toq/toq_server/internal/core/utils"
 )
 
@@ -47,6 +48,20 @@ type NotificationRequest struct {
 
 	// Data delivers additional key/value metadata to FCM clients.
 	Data map[string]string `json:"data,omitempty"`
+
+	// UserID identifies the recipient account; when set, the user's consent preferences are enforced.
+	UserID int64 `json:"userId,omitempty"`
+
+	// Category classifies the message for consent purposes and is required on every request;
+	// transactional messages are always sent, every other category needs UserID.
+	Category globalmodel.NotificationCategory `json:"category,omitempty"`
+
+	// DeepLink is the in-app route stored with the inbox entry (e.g. "toq://visits/42").
+	DeepLink string `json:"deepLink,omitempty"`
+
+	// SkipInbox prevents the in-app inbox copy; set it on verification codes and on every push
+	// of a fan-out whose inbox entry was written with StoreInbox.
+	SkipInbox bool `json:"skipInbox,omitempty"`
 }
 
 // UnifiedNotificationService centralizes all notification flows for the application.
@@ -58,6 +73,11 @@ type UnifiedNotificationService interface {
 	// SendNotificationSync blocks until the underlying adapter finishes the delivery.
 	// Use only when the caller must guarantee the delivery result.
 	SendNotificationSync(ctx context.Context, request NotificationRequest) error
+
+	// StoreInbox writes the in-app inbox entry of a message without delivering it, subject to the
+	// same consent rules. Push fan-outs call it once before sending one request per device token
+	// with SkipInbox, so users without devices still get the entry. Failures are logged only.
+	StoreInbox(ctx context.Context, request NotificationRequest)
 }
 
 // unifiedNotificationService implementa UnifiedNotificationService
@@ -139,6 +159,16 @@ func (ns *unifiedNotificationService) sendNotificationSync(ctx context.Context,
 		return coreutils.BadRequest(err.Error())
 	}
 
+	if !ns.isAllowedByConsent(ctx, request) {
+		logger.Info("notification.suppressed_by_consent",
+			"type", request.Type,
+			"user_id", request.UserID,
+			"category", request.Category)
+		return nil
+	}
+
+	ns.storeInInbox(ctx, request)
+
 	switch request.Type {
 	case NotificationTypeEmail:
 		return ns.sendEmail(ctx, request)
@@ -152,11 +182,51 @@ func (ns *unifiedNotificationService) sendNotificationSync(ctx context.Context,
 	}
 }
 
+// isAllowedByConsent checks the recipient's consent for the request channel and category.
+// Transactional messages are always allowed; validateRequest guarantees every other category
+// carries a recipient account. When the consent lookup fails, service updates are still
+// delivered while marketing is suppressed.
+func (ns *unifiedNotificationService) isAllowedByConsent(ctx context.Context, request NotificationRequest) bool {
+	if request.Category.IsMandatory() {
+		return true
+	}
+	if ns.globalService.userRepo == nil {
+		return request.Category.DefaultConsent()
+	}
+
+	consents, err := ns.globalService.userRepo.ListNotificationConsents(ctx, nil, request.UserID)
+	if err != nil {
+		coreutils.SetSpanError(ctx, err)
+		coreutils.LoggerFromContext(ctx).Error("notification.consent_lookup_error", "err", err, "user_id", request.UserID)
+		return request.Category != globalmodel.NotificationCategoryMarketing
+	}
+
+	return usermodel.ResolveNotificationConsent(consents, channelForType(request.Type), request.Category)
+}
+
+// channelForType maps a delivery adapter to the channel shown in the preference center.
+func channelForType(notificationType NotificationType) globalmodel.NotificationChannel {
+	switch notificationType {
+	case NotificationTypeSMS:
+		return globalmodel.NotificationChannelSMS
+	case NotificationTypeFCM:
+		return globalmodel.NotificationChannelPush
+	default:
+		return globalmodel.NotificationChannelEmail
+	}
+}
+
 // validateRequest enforces the required fields per notification type before dispatching.
 func (ns *unifiedNotificationService) validateRequest(request NotificationRequest) error {
 	if request.Body == "" {
 		return fmt.Errorf("body is required for every notification type")
 	}
+	if !request.Category.IsValid() {
+		return fmt.Errorf("category is required for every notification type")
+	}
+	if !request.Category.IsMandatory() && request.UserID <= 0 {
+		return fmt.Errorf("%s notifications require the recipient user id", request.Category)
+	}
 
 	switch request.Type {
 	case NotificationTypeEmail:
@@ -57,7 +57,7 @@ func (ls *listingService) CancelPhotoSession(ctx context.Context, input CancelPh
 
 	logger.Info("listing.photo_session.cancel.success", "photo_session_id", cancelOutput.PhotoSessionID, "listing_identity_id", cancelOutput.ListingIdentityID, "user_id", userID)
 
-	ls.sendPhotographerCancellationSMS(ctx, photographerPhone, cancelOutput.SlotStart, cancelOutput.ListingCode)
+	ls.sendPhotographerCancellationSMS(ctx, cancelOutput.PhotographerID, photographerPhone, cancelOutput.SlotStart, cancelOutput.ListingCode)
 
 	return nil
 }
@@ -146,6 +146,8 @@ func normalizeSlotSort(sort string) (string, error) {
 		return "photographer_asc", nil
 	case "photographer_desc":
 		return "photographer_desc", nil
+	case "rank":
+		return "rank", nil
 	default:
 		return "", utils.BadRequest("unsupported sort parameter")
 	}
@@ -13,6 +13,7 @@ import (
 	storageport "github.com/projeto-toq/toq_server/internal/core/port/right/storage"
 	auditservice "github.com/projeto-toq/toq_server/internal/core/service/audit_service"
 	globalservice "github.com/projeto-toq/toq_server/internal/core/service/global_service"
+	mediaprocessingservice "github.com/projeto-toq/toq_server/internal/core/service/media_processing_service"
 	photosessionservices "github.com/projeto-toq/toq_server/internal/core/service/photo_session_service"
 	propertycoverageservice "github.com/projeto-toq/toq_server/internal/core/service/property_coverage_service"
 	scheduleservices "github.com/projeto-toq/toq_server/internal/core/service/schedule_service"
@@ -30,6 +31,7 @@ type listingService struct {
 	gcs               storageport.CloudStoragePortInterface
 	scheduleService   scheduleservices.ScheduleServiceInterface
 	auditService      auditservice.AuditServiceInterface
+	mediaProcessing   mediaprocessingservice.MediaProcessingServiceInterface
 }
 
 func NewListingService(
@@ -44,6 +46,7 @@ func NewListingService(
 	fr listingfavoriterepository.FavoriteRepoPortInterface,
 	vr listingviewrepository.Repository,
 	as auditservice.AuditServiceInterface,
+	mps mediaprocessingservice.MediaProcessingServiceInterface,
 ) ListingServiceInterface {
 	return &listingService{
 		listingRepository: lr,
@@ -57,6 +60,7 @@ func NewListingService(
 		gcs:               gcs,
 		scheduleService:   ss,
 		auditService:      as,
+		mediaProcessing:   mps,
 	}
 }
 
@@ -90,6 +94,8 @@ type ListingServiceInterface interface {
 	ListPhotographerSlots(ctx context.Context, input ListPhotographerSlotsInput) (ListPhotographerSlotsOutput, error)
 	ReservePhotoSession(ctx context.Context, input ReservePhotoSessionInput) (ReservePhotoSessionOutput, error)
 	CancelPhotoSession(ctx context.Context, input CancelPhotoSessionInput) error
+	ReschedulePhotoSession(ctx context.Context, input ReschedulePhotoSessionInput) (ReschedulePhotoSessionOutput, error)
+	RatePhotoSession(ctx context.Context, input RatePhotoSessionInput) (RatePhotoSessionOutput, error)
 	GetListingDetail(ctx context.Context, listingIdentityId int64) (ListingDetailOutput, error)
 	AddFavoriteListing(ctx context.Context, listingIdentityID int64) error
 	RemoveFavoriteListing(ctx context.Context, listingIdentityID int64) error
@@ -5,11 +5,12 @@ import (
 	"fmt"
 	"time"
 
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
 	globalservice "github.com/projeto-toq/toq_server/internal/core/service/global_service"
 	"github.com/projeto-toq/toq_server/internal/core/utils"
 )
 
-func (ls *listingService) sendPhotographerReservationSMS(ctx context.Context, phone string, start, end time.Time, listingCode uint32) {
+func (ls *listingService) sendPhotographerReservationSMS(ctx context.Context, photographerID uint64, phone string, start, end time.Time, listingCode uint32) {
 	if phone == "" {
 		return
 	}
@@ -27,10 +28,12 @@ func (ls *listingService) sendPhotographerReservationSMS(ctx context.Context, ph
 	body := fmt.Sprintf("Nova sessão de fotos reservada para o anúncio %d em %s-%s. Acesse o app TOQ para aceitar ou recusar.", listingCode, startFormatted, endFormatted)
 
 	req := globalservice.NotificationRequest{
-		Type:    globalservice.NotificationTypeSMS,
-		To:      phone,
-		Subject: "Sessão de fotos reservada",
-		Body:    body,
+		Type:     globalservice.NotificationTypeSMS,
+		To:       phone,
+		Subject:  "Sessão de fotos reservada",
+		Body:     body,
+		UserID:   int64(photographerID),
+		Category: globalmodel.NotificationCategoryTransactional,
 	}
 
 	if err := notifier.SendNotification(ctx, req); err != nil {
@@ -39,7 +42,7 @@ func (ls *listingService) sendPhotographerReservationSMS(ctx context.Context, ph
 	}
 }
 
-func (ls *listingService) sendPhotographerCancellationSMS(ctx context.Context, phone string, start time.Time, listingCode uint32) {
+func (ls *listingService) sendPhotographerCancellationSMS(ctx context.Context, photographerID uint64, phone string, start time.Time, listingCode uint32) {
 	if phone == "" {
 		return
 	}
@@ -54,10 +57,74 @@ func (ls *listingService) sendPhotographerCancellationSMS(ctx context.Context, p
 	body := fmt.Sprintf("A sessão de fotos do anúncio %d agendada para %s foi cancelada pelo proprietário.", listingCode, startFormatted)
 
 	req := globalservice.NotificationRequest{
-		Type:    globalservice.NotificationTypeSMS,
-		To:      phone,
-		Subject: "Sessão de fotos cancelada",
-		Body:    body,
+		Type:     globalservice.NotificationTypeSMS,
+		To:       phone,
+		Subject:  "Sessão de fotos cancelada",
+		Body:     body,
+		UserID:   int64(photographerID),
+		Category: globalmodel.NotificationCategoryTransactional,
+	}
+
+	if err := notifier.SendNotification(ctx, req); err != nil {
+		utils.SetSpanError(ctx, err)
+		utils.LoggerFromContext(ctx).Error("listing.notifications.sms_send_error", "err", err, "phone", phone)
+	}
+}
+
+func (ls *listingService) sendPhotographerRescheduleSMS(ctx context.Context, photographerID uint64, phone string, start, end time.Time, listingCode uint32, requiresApproval bool) {
+	if phone == "" {
+		return
+	}
+
+	notifier := ls.gsi.GetUnifiedNotificationService()
+	if notifier == nil {
+		utils.LoggerFromContext(ctx).Warn("listing.notifications.sms_service_unavailable")
+		return
+	}
+
+	startFormatted := start.In(time.Local).Format("02/01 15:04")
+	endFormatted := end.In(time.Local).Format("15:04")
+	body := fmt.Sprintf("A sessão de fotos do anúncio %d foi reagendada para %s-%s.", listingCode, startFormatted, endFormatted)
+	if requiresApproval {
+		body += " Acesse o app TOQ para aceitar ou recusar."
+	}
+
+	req := globalservice.NotificationRequest{
+		Type:     globalservice.NotificationTypeSMS,
+		To:       phone,
+		Subject:  "Sessão de fotos reagendada",
+		Body:     body,
+		UserID:   int64(photographerID),
+		Category: globalmodel.NotificationCategoryTransactional,
+	}
+
+	if err := notifier.SendNotification(ctx, req); err != nil {
+		utils.SetSpanError(ctx, err)
+		utils.LoggerFromContext(ctx).Error("listing.notifications.sms_send_error", "err", err, "phone", phone)
+	}
+}
+
+func (ls *listingService) sendPhotographerReleasedSMS(ctx context.Context, photographerID uint64, phone string, start time.Time, listingCode uint32) {
+	if phone == "" {
+		return
+	}
+
+	notifier := ls.gsi.GetUnifiedNotificationService()
+	if notifier == nil {
+		utils.LoggerFromContext(ctx).Warn("listing.notifications.sms_service_unavailable")
+		return
+	}
+
+	startFormatted := start.In(time.Local).Format("02/01 15:04")
+	body := fmt.Sprintf("A sessão de fotos do anúncio %d agendada para %s foi remarcada pelo proprietário com outro fotógrafo. O horário foi liberado na sua agenda.", listingCode, startFormatted)
+
+	req := globalservice.NotificationRequest{
+		Type:     globalservice.NotificationTypeSMS,
+		To:       phone,
+		Subject:  "Sessão de fotos remarcada",
+		Body:     body,
+		UserID:   int64(photographerID),
+		Category: globalmodel.NotificationCategoryTransactional,
 	}
 
 	if err := notifier.SendNotification(ctx, req); err != nil {
@@ -37,6 +37,7 @@ type ListPhotographerSlotsOutput struct {
 type ReservePhotoSessionInput struct {
 	ListingIdentityID int64
 	SlotID            uint64
+	SessionType       string
 }
 
 // ReservePhotoSessionOutput returns metadata about the reserved slot.
@@ -46,6 +47,7 @@ type ReservePhotoSessionOutput struct {
 	SlotEnd        time.Time
 	PhotoSessionID uint64
 	Photographer   PhotographerSummary
+	AutoAssigned   bool
 }
 
 // PhotographerSummary aggregates minimal photographer info returned to clients.
@@ -60,3 +62,35 @@ type PhotographerSummary struct {
 type CancelPhotoSessionInput struct {
 	PhotoSessionID uint64
 }
+
+// ReschedulePhotoSessionInput identifies the session to move and the replacement slot.
+type ReschedulePhotoSessionInput struct {
+	PhotoSessionID uint64
+	SlotID         uint64
+	Reason         *string
+}
+
+// ReschedulePhotoSessionOutput returns the session after the slot swap.
+type ReschedulePhotoSessionOutput struct {
+	PhotoSessionID  uint64
+	SlotID          uint64
+	SlotStart       time.Time
+	SlotEnd         time.Time
+	Status          photosessionmodel.BookingStatus
+	RescheduleCount int64
+	Photographer    PhotographerSummary
+}
+
+// RatePhotoSessionInput carries the owner's rating for the listing's latest completed photo session.
+type RatePhotoSessionInput struct {
+	ListingIdentityID int64
+	Rating            int
+	Comment           *string
+}
+
+// RatePhotoSessionOutput identifies the rated session and photographer.
+type RatePhotoSessionOutput struct {
+	PhotoSessionID uint64
+	PhotographerID uint64
+	Rating         int
+}
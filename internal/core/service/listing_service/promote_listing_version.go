@@ -143,6 +143,15 @@ func (ls *listingService) PromoteListingVersion(ctx context.Context, input Promo
 		return utils.InternalError("")
 	}
 
+	// The promoted version carries the land measures the parcel polygon is checked against.
+	if ls.mediaProcessing != nil {
+		if recheckErr := ls.mediaProcessing.RecheckParcel(ctx, tx, snapshot.ListingID); recheckErr != nil {
+			utils.SetSpanError(ctx, recheckErr)
+			logger.Error("listing.promote.recheck_parcel_error", "err", recheckErr, "listing_identity_id", snapshot.ListingID)
+			return utils.InternalError("")
+		}
+	}
+
 	version := int64(snapshot.Version)
 	auditRecord := auditservice.BuildRecordFromContext(
 		ctx,
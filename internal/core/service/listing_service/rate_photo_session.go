@@ -0,0 +1,40 @@
+package listingservices
+
+import (
+	"context"
+
+	photosessionservices "github.com/projeto-toq/toq_server/internal/core/service/photo_session_service"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+func (ls *listingService) RatePhotoSession(ctx context.Context, input RatePhotoSessionInput) (output RatePhotoSessionOutput, err error) {
+	if input.ListingIdentityID <= 0 {
+		return output, utils.BadRequest("listingIdentityId is required")
+	}
+
+	ctx, spanEnd, genErr := utils.GenerateTracer(ctx)
+	if genErr != nil {
+		return output, utils.InternalError("")
+	}
+	defer spanEnd()
+
+	userID, userErr := ls.gsi.GetUserIDFromContext(ctx)
+	if userErr != nil {
+		return output, userErr
+	}
+
+	rateOutput, rateErr := ls.photoSessionSvc.RateSession(ctx, photosessionservices.RateSessionInput{
+		ListingIdentityID: input.ListingIdentityID,
+		UserID:            userID,
+		Rating:            input.Rating,
+		Comment:           input.Comment,
+	})
+	if rateErr != nil {
+		return output, rateErr
+	}
+
+	output.PhotoSessionID = rateOutput.PhotoSessionID
+	output.PhotographerID = rateOutput.PhotographerID
+	output.Rating = rateOutput.Rating
+	return output, nil
+}
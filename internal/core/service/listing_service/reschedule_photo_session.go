@@ -0,0 +1,91 @@
+package listingservices
+
+import (
+	"context"
+
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+	photosessionservices "github.com/projeto-toq/toq_server/internal/core/service/photo_session_service"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+func (ls *listingService) ReschedulePhotoSession(ctx context.Context, input ReschedulePhotoSessionInput) (output ReschedulePhotoSessionOutput, err error) {
+	if input.PhotoSessionID == 0 || input.SlotID == 0 {
+		return output, utils.BadRequest("photoSessionId and slotId are required")
+	}
+
+	ctx, spanEnd, genErr := utils.GenerateTracer(ctx)
+	if genErr != nil {
+		return output, utils.InternalError("")
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	userID, userErr := ls.gsi.GetUserIDFromContext(ctx)
+	if userErr != nil {
+		return output, userErr
+	}
+
+	rescheduleOutput, rescheduleErr := ls.photoSessionSvc.ReschedulePhotoSession(ctx, photosessionservices.RescheduleSessionInput{
+		PhotoSessionID: input.PhotoSessionID,
+		SlotID:         input.SlotID,
+		UserID:         userID,
+		Reason:         input.Reason,
+	})
+	if rescheduleErr != nil {
+		return output, rescheduleErr
+	}
+
+	// The reschedule is already committed: a failure to load the photographers only costs the
+	// SMS, which are skipped when the phone is unknown.
+	photographerSummary := PhotographerSummary{}
+	previousPhone := ""
+	photographerChanged := rescheduleOutput.PreviousPhotographerID != rescheduleOutput.PhotographerID
+	if ls.userRepository != nil {
+		tx, txErr := ls.gsi.StartReadOnlyTransaction(ctx)
+		if txErr != nil {
+			utils.SetSpanError(ctx, txErr)
+			logger.Error("listing.photo_session.reschedule.photographer_ro_tx_error", "err", txErr, "photo_session_id", rescheduleOutput.PhotoSessionID)
+		} else {
+			summary, fetchErr := ls.fetchPhotographerProfile(ctx, tx, rescheduleOutput.PhotographerID)
+			if fetchErr != nil {
+				utils.SetSpanError(ctx, fetchErr)
+				logger.Error("listing.photo_session.reschedule.get_photographer_error", "err", fetchErr, "photographer_id", rescheduleOutput.PhotographerID)
+			} else {
+				photographerSummary = summary
+			}
+			if photographerChanged {
+				phone, phoneErr := ls.fetchPhotographerPhone(ctx, tx, rescheduleOutput.PreviousPhotographerID)
+				if phoneErr != nil {
+					utils.SetSpanError(ctx, phoneErr)
+					logger.Error("listing.photo_session.reschedule.get_photographer_error", "err", phoneErr, "photographer_id", rescheduleOutput.PreviousPhotographerID)
+				} else {
+					previousPhone = phone
+				}
+			}
+			if rbErr := ls.gsi.RollbackTransaction(ctx, tx); rbErr != nil {
+				utils.SetSpanError(ctx, rbErr)
+				logger.Error("listing.photo_session.reschedule.photographer_ro_rollback_error", "err", rbErr)
+			}
+		}
+	}
+
+	logger.Info("listing.photo_session.reschedule.success", "photo_session_id", rescheduleOutput.PhotoSessionID, "listing_identity_id", rescheduleOutput.ListingIdentityID, "slot_id", input.SlotID, "user_id", userID)
+
+	requiresApproval := rescheduleOutput.Status == photosessionmodel.BookingStatusPendingApproval
+	ls.sendPhotographerRescheduleSMS(ctx, rescheduleOutput.PhotographerID, photographerSummary.PhoneNumber, rescheduleOutput.SlotStart, rescheduleOutput.SlotEnd, rescheduleOutput.ListingCode, requiresApproval)
+	if photographerChanged {
+		ls.sendPhotographerReleasedSMS(ctx, rescheduleOutput.PreviousPhotographerID, previousPhone, rescheduleOutput.PreviousSlotStart, rescheduleOutput.ListingCode)
+	}
+
+	return ReschedulePhotoSessionOutput{
+		PhotoSessionID:  rescheduleOutput.PhotoSessionID,
+		SlotID:          rescheduleOutput.SlotID,
+		SlotStart:       rescheduleOutput.SlotStart,
+		SlotEnd:         rescheduleOutput.SlotEnd,
+		Status:          rescheduleOutput.Status,
+		RescheduleCount: rescheduleOutput.RescheduleCount,
+		Photographer:    photographerSummary,
+	}, nil
+}
@@ -8,6 +8,7 @@ import (
 
 	derrors "github.com/projeto-toq/toq_server/internal/core/derrors"
 	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
 	storagemodel "github.com/projeto-toq/toq_server/internal/core/model/storage_model"
 	photosessionservices "github.com/projeto-toq/toq_server/internal/core/service/photo_session_service"
 	"github.com/projeto-toq/toq_server/internal/core/utils"
@@ -97,6 +98,7 @@ func (ls *listingService) ReservePhotoSession(ctx context.Context, input Reserve
 		ListingIdentityID: listing.IdentityID(),
 		SlotID:            input.SlotID,
 		UserID:            userID,
+		SessionType:       input.SessionType,
 	})
 	if reserveErr != nil {
 		return output, reserveErr
@@ -125,7 +127,7 @@ func (ls *listingService) ReservePhotoSession(ctx context.Context, input Reserve
 
 	logger.Info("listing.photo_session.reserve.success", "listing_identity_id", input.ListingIdentityID, "listing_version_id", listing.ID(), "slot_id", input.SlotID, "booking_id", reserveOutput.PhotoSessionID, "user_id", userID)
 
-	ls.sendPhotographerReservationSMS(ctx, photographerSummary.PhoneNumber, reserveOutput.SlotStart, reserveOutput.SlotEnd, listing.Code())
+	ls.sendPhotographerReservationSMS(ctx, reserveOutput.PhotographerID, photographerSummary.PhoneNumber, reserveOutput.SlotStart, reserveOutput.SlotEnd, listing.Code())
 
 	return ReservePhotoSessionOutput{
 		SlotID:         reserveOutput.SlotID,
@@ -133,6 +135,7 @@ func (ls *listingService) ReservePhotoSession(ctx context.Context, input Reserve
 		SlotEnd:        reserveOutput.SlotEnd,
 		PhotoSessionID: reserveOutput.PhotoSessionID,
 		Photographer:   photographerSummary,
+		AutoAssigned:   reserveOutput.AssignmentMode == photosessionmodel.AssignmentModeAuto,
 	}, nil
 }
 
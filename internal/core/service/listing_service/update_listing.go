@@ -722,6 +722,17 @@ func (ls *listingService) updateListing(ctx context.Context, tx *sql.Tx, input U
 		return utils.InternalError("Failed to update listing")
 	}
 
+	// The parcel polygon is checked against the active version, so changes to its land measures
+	// refresh the divergences.
+	landChanged := input.LandSize.IsPresent() || input.LandFront.IsPresent() || input.LandSide.IsPresent() || input.LandBack.IsPresent()
+	if landChanged && identity.ActiveVersionID.Valid && identity.ActiveVersionID.Int64 == existing.ID() && ls.mediaProcessing != nil {
+		if err = ls.mediaProcessing.RecheckParcel(ctx, tx, identity.ID); err != nil {
+			utils.SetSpanError(ctx, err)
+			logger.Error("listing.update.recheck_parcel_error", "err", err, "listing_identity_id", identity.ID)
+			return utils.InternalError("Failed to recheck land parcel")
+		}
+	}
+
 	version := int64(existing.Version())
 	auditRecord := auditservice.BuildRecordFromContext(
 		ctx,
@@ -50,14 +50,25 @@ func InitializePayloads(assets []mediaprocessingmodel.JobAsset) map[string]*Payl
 
 // MapGeneratedAsset enriches the accumulator with a derived object (thumbnail or
 // resized image), updating the canonical processed key based on resolution
-// priority and storing thumbnails separately.
+// priority and storing thumbnails separately. WebP/AVIF variants are recorded
+// under a format-suffixed outputs key and never replace the JPEG canonical keys.
 func MapGeneratedAsset(acc *PayloadAccumulator, derivative mediaprocessingmodel.JobAsset) {
 	if acc == nil || acc.payload == nil {
 		return
 	}
 
+	if mapHLSAsset(acc, derivative) || mapQualityReport(acc, derivative) || mapPanoramaTiles(acc, derivative) {
+		return
+	}
+
 	resolution := extractResolution(derivative.Key)
 	outputsKey := buildOutputsKey(resolution, acc.assetType)
+
+	if format := mediaprocessingmodel.ImageVariantFormatFromKey(derivative.Key, acc.payload.RawKey); format != mediaprocessingmodel.ImageVariantFormatJPEG {
+		acc.payload.Outputs[outputsKey+"_"+string(format)] = derivative.Key
+		return
+	}
+
 	acc.payload.Outputs[outputsKey] = derivative.Key
 
 	if strings.EqualFold(resolution, "thumbnail") {
@@ -71,6 +82,49 @@ func MapGeneratedAsset(acc *PayloadAccumulator, derivative mediaprocessingmodel.
 	}
 }
 
+// mapHLSAsset records HLS transcoding outputs under the hls_* metadata keys. They never become
+// the processed/thumbnail key, so clients without HLS support keep the MP4/raw behaviour.
+func mapHLSAsset(acc *PayloadAccumulator, derivative mediaprocessingmodel.JobAsset) bool {
+	switch derivative.Type {
+	case mediaprocessingmodel.JobAssetTypeHLSMaster:
+		acc.payload.Outputs[mediaprocessingmodel.HLSMasterMetadataKey] = derivative.Key
+	case mediaprocessingmodel.JobAssetTypeHLSPoster:
+		acc.payload.Outputs[mediaprocessingmodel.HLSPosterMetadataKey] = derivative.Key
+	case mediaprocessingmodel.JobAssetTypeHLSPlaylist:
+		if rendition := mediaprocessingmodel.HLSRenditionFromPlaylistKey(derivative.Key); rendition != "" {
+			acc.payload.Outputs[mediaprocessingmodel.HLSRenditionMetadataKey(rendition)] = derivative.Key
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// mapQualityReport copies the photo quality measurements into the outputs, which the backend
+// merges into the asset metadata and evaluates against the quality gate.
+func mapQualityReport(acc *PayloadAccumulator, derivative mediaprocessingmodel.JobAsset) bool {
+	if derivative.Type != mediaprocessingmodel.JobAssetTypeQualityReport {
+		return false
+	}
+	for key, value := range derivative.Metadata {
+		acc.payload.Outputs[key] = value
+	}
+	return true
+}
+
+// mapPanoramaTiles records the tiles.json manifest of a panorama and its pyramid layout under the
+// panorama_* metadata keys.
+func mapPanoramaTiles(acc *PayloadAccumulator, derivative mediaprocessingmodel.JobAsset) bool {
+	if derivative.Type != mediaprocessingmodel.JobAssetTypePanoramaTiles {
+		return false
+	}
+	acc.payload.Outputs[mediaprocessingmodel.PanoramaTilesMetadataKey] = derivative.Key
+	for key, value := range derivative.Metadata {
+		acc.payload.Outputs[key] = value
+	}
+	return true
+}
+
 // ApplyBranchErrors attaches errors reported by derived processing stages to the
 // related payloads so the backend can expose them to clients.
 func ApplyBranchErrors(payloads map[string]*PayloadAccumulator, branchErrors []BranchError) {
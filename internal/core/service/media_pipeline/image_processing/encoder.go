@@ -0,0 +1,166 @@
+package imageprocessing
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+)
+
+// VariantEncoder encodes a resized image into one output format.
+type VariantEncoder interface {
+	Format() mediaprocessingmodel.ImageVariantFormat
+	Encode(ctx context.Context, img image.Image) ([]byte, error)
+}
+
+// jpegEncoder is the built-in canonical encoder.
+type jpegEncoder struct {
+	quality int
+}
+
+func (e jpegEncoder) Format() mediaprocessingmodel.ImageVariantFormat {
+	return mediaprocessingmodel.ImageVariantFormatJPEG
+}
+
+func (e jpegEncoder) Encode(_ context.Context, img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: e.quality}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// FFmpegEncoder produces WebP/AVIF variants through the ffmpeg binary already shipped for video
+// thumbnails (the Go ecosystem has no pure-Go lossy encoder for either format).
+type FFmpegEncoder struct {
+	ffmpegPath string
+	format     mediaprocessingmodel.ImageVariantFormat
+	codec      string
+	quality    int
+}
+
+// ffmpegCodecs lists, per format, the encoders tried in order of preference.
+var ffmpegCodecs = map[mediaprocessingmodel.ImageVariantFormat][]string{
+	mediaprocessingmodel.ImageVariantFormatWebP: {"libwebp"},
+	mediaprocessingmodel.ImageVariantFormatAVIF: {"libaom-av1", "libsvtav1"},
+}
+
+// NewFFmpegEncoder returns an encoder for the format when the ffmpeg build supports it.
+// An error means the format is not feasible in this environment and should be skipped.
+func NewFFmpegEncoder(ctx context.Context, ffmpegPath string, format mediaprocessingmodel.ImageVariantFormat, quality int) (*FFmpegEncoder, error) {
+	candidates, ok := ffmpegCodecs[format]
+	if !ok {
+		return nil, fmt.Errorf("format %s is not encoded through ffmpeg", format)
+	}
+	if ffmpegPath == "" {
+		return nil, fmt.Errorf("ffmpeg path not configured")
+	}
+
+	output, err := exec.CommandContext(ctx, ffmpegPath, "-hide_banner", "-encoders").Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ffmpeg encoders: %w", err)
+	}
+	available := string(output)
+
+	if quality <= 0 || quality > 100 {
+		quality = 80
+	}
+
+	for _, codec := range candidates {
+		if strings.Contains(available, " "+codec+" ") {
+			return &FFmpegEncoder{ffmpegPath: ffmpegPath, format: format, codec: codec, quality: quality}, nil
+		}
+	}
+	return nil, fmt.Errorf("ffmpeg build has no encoder for %s (tried %s)", format, strings.Join(candidates, ", "))
+}
+
+// Format reports the format produced by the encoder.
+func (e *FFmpegEncoder) Format() mediaprocessingmodel.ImageVariantFormat {
+	return e.format
+}
+
+// Encode writes the image as PNG to a temp file, converts it with ffmpeg and returns the result.
+// Temp files are used because the AVIF muxer requires a seekable output.
+func (e *FFmpegEncoder) Encode(ctx context.Context, img image.Image) ([]byte, error) {
+	input, err := os.CreateTemp("", "variant-input-*.png")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp input: %w", err)
+	}
+	defer os.Remove(input.Name())
+
+	if err := png.Encode(input, img); err != nil {
+		input.Close()
+		return nil, fmt.Errorf("failed to write temp input: %w", err)
+	}
+	if err := input.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close temp input: %w", err)
+	}
+
+	outputPath := strings.TrimSuffix(input.Name(), ".png") + e.format.Extension()
+	defer os.Remove(outputPath)
+
+	args := []string{"-hide_banner", "-loglevel", "error", "-y", "-i", input.Name(), "-frames:v", "1", "-map_metadata", "-1", "-c:v", e.codec}
+	args = append(args, e.codecArgs()...)
+	args = append(args, outputPath)
+
+	if output, err := exec.CommandContext(ctx, e.ffmpegPath, args...).CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("ffmpeg %s encode failed: %w | output: %s", e.format, err, string(output))
+	}
+
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s output: %w", e.format, err)
+	}
+	return data, nil
+}
+
+// codecArgs maps the 1-100 quality scale to each codec's own knob.
+func (e *FFmpegEncoder) codecArgs() []string {
+	switch e.codec {
+	case "libwebp":
+		return []string{"-quality", strconv.Itoa(e.quality)}
+	case "libaom-av1":
+		return []string{"-still-picture", "1", "-crf", strconv.Itoa(avifCRF(e.quality)), "-cpu-used", "6", "-pix_fmt", "yuv420p"}
+	default: // libsvtav1
+		return []string{"-crf", strconv.Itoa(avifCRF(e.quality)), "-preset", "8", "-pix_fmt", "yuv420p"}
+	}
+}
+
+// avifCRF converts quality (100 = best) into an AV1 CRF (0 = best, 63 = worst).
+func avifCRF(quality int) int {
+	return (100 - quality) * 63 / 100
+}
+
+// BuildExtraEncoders resolves the configured optional formats ("webp", "avif") into encoders.
+// Formats that cannot be produced here are returned in skipped so callers can log them once at
+// startup; JPEG entries are ignored because JPEG is always generated.
+func BuildExtraEncoders(ctx context.Context, ffmpegPath string, formats []string, quality int) (encoders []VariantEncoder, skipped []error) {
+	seen := make(map[mediaprocessingmodel.ImageVariantFormat]bool, len(formats))
+	for _, raw := range formats {
+		format, ok := mediaprocessingmodel.ParseImageVariantFormat(raw)
+		if !ok {
+			skipped = append(skipped, fmt.Errorf("unknown image variant format %q", raw))
+			continue
+		}
+		if format == mediaprocessingmodel.ImageVariantFormatJPEG || seen[format] {
+			continue
+		}
+		seen[format] = true
+
+		encoder, err := NewFFmpegEncoder(ctx, ffmpegPath, format, quality)
+		if err != nil {
+			skipped = append(skipped, fmt.Errorf("%s variants disabled: %w", format, err))
+			continue
+		}
+		encoders = append(encoders, encoder)
+	}
+	return encoders, skipped
+}
@@ -0,0 +1,122 @@
+package imageprocessing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"image"
+	"path"
+	"strings"
+
+	"github.com/disintegration/imaging"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+)
+
+// ProcessPanoramaResult lists what ProcessPanorama produced for one 360° photo.
+type ProcessPanoramaResult struct {
+	// Keys are the flat JPEG previews (regular photo sizes of the equirectangular image).
+	Keys []string
+	// TilesKey is the tiles.json manifest describing Tiles.
+	TilesKey string
+	Tiles    mediaprocessingmodel.PanoramaTiles
+}
+
+// GeneratedAssets returns the pipeline entries for the previews plus the tile pyramid.
+func (r ProcessPanoramaResult) GeneratedAssets(assetType, sourceKey string) []mediaprocessingmodel.JobAsset {
+	assets := make([]mediaprocessingmodel.JobAsset, 0, len(r.Keys)+1)
+	for _, key := range r.Keys {
+		assets = append(assets, mediaprocessingmodel.JobAsset{Key: key, Type: assetType, SourceKey: sourceKey})
+	}
+	return append(assets, mediaprocessingmodel.JobAsset{
+		Key:       r.TilesKey,
+		Type:      mediaprocessingmodel.JobAssetTypePanoramaTiles,
+		SourceKey: sourceKey,
+		Metadata:  r.Tiles.Metadata(),
+	})
+}
+
+// ProcessPanorama validates an equirectangular upload and generates its JPEG previews (used for
+// thumbnails, downloads and the ZIP bundle) and the multi-resolution tile pyramid read by
+// panorama viewers. Watermark and quality analysis are calibrated for flat photos and are not
+// applied. A non 2:1 upload fails with ErrPanoramaNotEquirectangular.
+func (s *ThumbnailService) ProcessPanorama(ctx context.Context, bucket, key string) (ProcessPanoramaResult, error) {
+	data, err := s.downloadBytes(ctx, bucket, key)
+	if err != nil {
+		return ProcessPanoramaResult{}, err
+	}
+
+	img, err := s.decodeWithOrientation(data)
+	if err != nil {
+		return ProcessPanoramaResult{}, err
+	}
+	bounds := img.Bounds()
+	if err := mediaprocessingmodel.ValidateEquirectangular(bounds.Dx(), bounds.Dy()); err != nil {
+		return ProcessPanoramaResult{}, err
+	}
+
+	// JPEG is always the first encoder; previews skip the optional formats.
+	jpeg := s.encoders[0]
+	result := ProcessPanoramaResult{Keys: make([]string, 0, len(mediaprocessingmodel.ImageVariantSizes))}
+	for _, size := range mediaprocessingmodel.ImageVariantSizes {
+		newKey, err := s.persistVariant(ctx, bucket, key, size.Name, jpeg, imaging.Resize(img, size.Width, 0, imaging.Lanczos))
+		if err != nil {
+			return ProcessPanoramaResult{}, err
+		}
+		result.Keys = append(result.Keys, newKey)
+	}
+
+	root, err := s.panoramaTilesRoot(key)
+	if err != nil {
+		return ProcessPanoramaResult{}, err
+	}
+	result.Tiles = mediaprocessingmodel.NewPanoramaTiles(bounds.Dx(), bounds.Dy())
+	for index, level := range result.Tiles.Levels {
+		if err := s.persistPanoramaLevel(ctx, bucket, root, index, level, img); err != nil {
+			return ProcessPanoramaResult{}, err
+		}
+	}
+
+	manifest, err := json.Marshal(result.Tiles)
+	if err != nil {
+		return ProcessPanoramaResult{}, fmt.Errorf("failed to encode tiles manifest: %w", err)
+	}
+	result.TilesKey = root + "/" + mediaprocessingmodel.PanoramaTilesManifestName
+	if err := s.storage.Upload(ctx, bucket, result.TilesKey, bytes.NewReader(manifest), "application/json"); err != nil {
+		return ProcessPanoramaResult{}, fmt.Errorf("failed to upload tiles manifest: %w", err)
+	}
+
+	return result, nil
+}
+
+// persistPanoramaLevel resizes the panorama to exactly width x width/2 and uploads its tiles.
+func (s *ThumbnailService) persistPanoramaLevel(ctx context.Context, bucket, root string, index int, level mediaprocessingmodel.PanoramaLevel, img image.Image) error {
+	resized := imaging.Resize(img, level.Width, level.Width/2, imaging.Lanczos)
+	encoder := s.encoders[0]
+	tileSize := mediaprocessingmodel.PanoramaTileSize
+
+	for row := 0; row < level.Rows; row++ {
+		for col := 0; col < level.Cols; col++ {
+			tile := imaging.Crop(resized, image.Rect(col*tileSize, row*tileSize, (col+1)*tileSize, (row+1)*tileSize))
+			encoded, err := encoder.Encode(ctx, tile)
+			if err != nil {
+				return fmt.Errorf("failed to encode tile %d/%d_%d: %w", index, row, col, err)
+			}
+			tileKey := mediaprocessingmodel.PanoramaTileKey(root, index, row, col)
+			if err := s.storage.Upload(ctx, bucket, tileKey, bytes.NewReader(encoded), encoder.Format().ContentType()); err != nil {
+				return fmt.Errorf("failed to upload tile %s: %w", tileKey, err)
+			}
+		}
+	}
+	return nil
+}
+
+// panoramaTilesRoot places the pyramid next to the previews:
+// {prefix}processed/panorama/360/tiles/{file stem}.
+func (s *ThumbnailService) panoramaTilesRoot(originalKey string) (string, error) {
+	key, err := s.generateKey(originalKey, "tiles")
+	if err != nil {
+		return "", fmt.Errorf("failed to generate tiles key: %w", err)
+	}
+	return strings.TrimSuffix(key, path.Ext(key)), nil
+}
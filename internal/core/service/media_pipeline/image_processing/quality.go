@@ -0,0 +1,167 @@
+package imageprocessing
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"sort"
+
+	"github.com/disintegration/imaging"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+)
+
+const (
+	// qualityAnalysisSide normalizes blur/exposure measurements so scores do not depend on the
+	// upload resolution (a sharp 48MP photo and its 12MP version score alike).
+	qualityAnalysisSide = 1024
+	// clippedShadowLevel/clippedHighlightLevel bound the histogram ends counted as clipped.
+	clippedShadowLevel    = 5
+	clippedHighlightLevel = 250
+)
+
+// AnalyzeQuality measures resolution, sharpness, exposure and the perceptual hash of a decoded
+// (orientation-normalized) photo.
+func AnalyzeQuality(img image.Image) mediaprocessingmodel.PhotoQualityReport {
+	bounds := img.Bounds()
+	report := mediaprocessingmodel.PhotoQualityReport{
+		Width:  bounds.Dx(),
+		Height: bounds.Dy(),
+	}
+	if report.Width == 0 || report.Height == 0 {
+		return report
+	}
+
+	analysis := img
+	if max(report.Width, report.Height) > qualityAnalysisSide {
+		analysis = imaging.Fit(img, qualityAnalysisSide, qualityAnalysisSide, imaging.Box)
+	}
+	gray := imaging.Grayscale(analysis)
+
+	report.BlurScore = laplacianVariance(gray)
+	report.Brightness, report.ShadowsClipped, report.HighlightsClipped = luminanceHistogram(gray)
+	report.ExposureScore = math.Max(0, (1-2*math.Abs(report.Brightness-0.5))*(1-report.ShadowsClipped-report.HighlightsClipped))
+	report.PHash = perceptualHash(img)
+	return report
+}
+
+// laplacianVariance convolves the 4-neighbour Laplacian kernel and returns the response variance.
+func laplacianVariance(gray *image.NRGBA) float64 {
+	w, h := gray.Bounds().Dx(), gray.Bounds().Dy()
+	if w < 3 || h < 3 {
+		return 0
+	}
+	at := func(x, y int) float64 { return float64(gray.Pix[y*gray.Stride+x*4]) }
+
+	var sum, sumSq float64
+	count := float64((w - 2) * (h - 2))
+	for y := 1; y < h-1; y++ {
+		for x := 1; x < w-1; x++ {
+			v := at(x-1, y) + at(x+1, y) + at(x, y-1) + at(x, y+1) - 4*at(x, y)
+			sum += v
+			sumSq += v * v
+		}
+	}
+	mean := sum / count
+	return sumSq/count - mean*mean
+}
+
+// luminanceHistogram returns the mean luminance in [0,1] and the clipped fractions at both ends.
+func luminanceHistogram(gray *image.NRGBA) (brightness, shadows, highlights float64) {
+	var histogram [256]int
+	w, h := gray.Bounds().Dx(), gray.Bounds().Dy()
+	for y := 0; y < h; y++ {
+		row := gray.Pix[y*gray.Stride : y*gray.Stride+w*4]
+		for x := 0; x < w; x++ {
+			histogram[row[x*4]]++
+		}
+	}
+
+	total := float64(w * h)
+	var weighted, dark, bright float64
+	for level, count := range histogram {
+		weighted += float64(level * count)
+		if level <= clippedShadowLevel {
+			dark += float64(count)
+		}
+		if level >= clippedHighlightLevel {
+			bright += float64(count)
+		}
+	}
+	return weighted / total / 255, dark / total, bright / total
+}
+
+// perceptualHash implements the DCT pHash: 32x32 grayscale, 2D DCT, then one bit per
+// low-frequency coefficient (top-left 8x8 without the DC term) compared to their median.
+func perceptualHash(img image.Image) string {
+	const size, lowFreq = 32, 8
+
+	small := imaging.Grayscale(imaging.Resize(img, size, size, imaging.Lanczos))
+	pixels := make([][]float64, size)
+	for y := 0; y < size; y++ {
+		pixels[y] = make([]float64, size)
+		for x := 0; x < size; x++ {
+			pixels[y][x] = float64(small.Pix[y*small.Stride+x*4])
+		}
+	}
+
+	dct := dct2D(pixels)
+	coefficients := make([]float64, 0, lowFreq*lowFreq-1)
+	for y := 0; y < lowFreq; y++ {
+		for x := 0; x < lowFreq; x++ {
+			if x == 0 && y == 0 {
+				continue
+			}
+			coefficients = append(coefficients, dct[y][x])
+		}
+	}
+
+	sorted := append([]float64(nil), coefficients...)
+	sort.Float64s(sorted)
+	median := sorted[len(sorted)/2]
+
+	var hash uint64
+	for i, c := range coefficients {
+		if c > median {
+			hash |= 1 << uint(i)
+		}
+	}
+	return fmt.Sprintf("%016x", hash)
+}
+
+func dct2D(input [][]float64) [][]float64 {
+	n := len(input)
+	cosines := make([][]float64, n)
+	for k := 0; k < n; k++ {
+		cosines[k] = make([]float64, n)
+		for i := 0; i < n; i++ {
+			cosines[k][i] = math.Cos(math.Pi / float64(n) * (float64(i) + 0.5) * float64(k))
+		}
+	}
+
+	rows := make([][]float64, n)
+	for y := 0; y < n; y++ {
+		rows[y] = make([]float64, n)
+		for k := 0; k < n; k++ {
+			var sum float64
+			for i := 0; i < n; i++ {
+				sum += input[y][i] * cosines[k][i]
+			}
+			rows[y][k] = sum
+		}
+	}
+
+	output := make([][]float64, n)
+	for k := 0; k < n; k++ {
+		output[k] = make([]float64, n)
+	}
+	for x := 0; x < n; x++ {
+		for k := 0; k < n; k++ {
+			var sum float64
+			for i := 0; i < n; i++ {
+				sum += rows[i][x] * cosines[k][i]
+			}
+			output[k][x] = sum
+		}
+	}
+	return output
+}
@@ -0,0 +1,203 @@
+package imageprocessing
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/disintegration/imaging"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	storageport "github.com/projeto-toq/toq_server/internal/core/port/right/storage"
+)
+
+// ThumbnailService generates the responsive photo variants. Variants are re-encoded from decoded
+// pixels, so EXIF/XMP/GPS and any other metadata of the upload never reach a derivative; the
+// EXIF orientation is baked into the pixels before it is dropped.
+type ThumbnailService struct {
+	storage    storageport.MediaObjectStoragePort
+	encoders   []VariantEncoder
+	watermarks watermarkCache
+}
+
+// NewThumbnailService builds the service; JPEG is always produced and extraEncoders add
+// modern formats (WebP/AVIF) for the same sizes.
+func NewThumbnailService(storage storageport.MediaObjectStoragePort, extraEncoders ...VariantEncoder) *ThumbnailService {
+	encoders := []VariantEncoder{jpegEncoder{quality: 85}}
+	for _, encoder := range extraEncoders {
+		if encoder == nil || encoder.Format() == mediaprocessingmodel.ImageVariantFormatJPEG {
+			continue
+		}
+		encoders = append(encoders, encoder)
+	}
+
+	return &ThumbnailService{
+		storage:  storage,
+		encoders: encoders,
+	}
+}
+
+// ProcessImageResult lists what ProcessImage produced for one photo.
+type ProcessImageResult struct {
+	// Keys are the uploaded variants.
+	Keys []string
+	// FormatErrs holds failures of the optional formats; they do not fail the asset.
+	FormatErrs []error
+	// Quality is measured on the oriented upload before any resize or watermark.
+	Quality mediaprocessingmodel.PhotoQualityReport
+}
+
+// GeneratedAssets returns the pipeline entries for the variants plus the quality report.
+func (r ProcessImageResult) GeneratedAssets(assetType, sourceKey string) []mediaprocessingmodel.JobAsset {
+	assets := make([]mediaprocessingmodel.JobAsset, 0, len(r.Keys)+1)
+	for _, key := range r.Keys {
+		assets = append(assets, mediaprocessingmodel.JobAsset{Key: key, Type: assetType, SourceKey: sourceKey})
+	}
+	return append(assets, mediaprocessingmodel.JobAsset{
+		Key:       sourceKey,
+		Type:      mediaprocessingmodel.JobAssetTypeQualityReport,
+		SourceKey: sourceKey,
+		Metadata:  r.Quality.Metadata(),
+	})
+}
+
+// ProcessImage analyzes the photo quality and generates every size in every configured format.
+// Failures of the optional formats are returned in the result so the caller can log them, while
+// err is reserved for the canonical JPEG path. When opts carries a watermark, it is blended into
+// the configured public sizes; an unreadable watermark fails the asset rather than publishing
+// unbranded photos.
+func (s *ThumbnailService) ProcessImage(ctx context.Context, bucket, key string, opts mediaprocessingmodel.ImageProcessingOptions) (ProcessImageResult, error) {
+	data, err := s.downloadBytes(ctx, bucket, key)
+	if err != nil {
+		return ProcessImageResult{}, err
+	}
+
+	img, err := s.decodeWithOrientation(data)
+	if err != nil {
+		return ProcessImageResult{}, err
+	}
+	result := ProcessImageResult{Quality: AnalyzeQuality(img)}
+
+	var (
+		watermark     mediaprocessingmodel.WatermarkOptions
+		watermarkMark image.Image
+	)
+	if opts.Watermark != nil && opts.Watermark.Key != "" {
+		watermark = opts.Watermark.Normalized()
+		watermarkMark, err = s.loadWatermark(ctx, bucket, watermark.Key)
+		if err != nil {
+			return ProcessImageResult{}, err
+		}
+	}
+
+	result.Keys = make([]string, 0, len(mediaprocessingmodel.ImageVariantSizes)*len(s.encoders))
+	for _, size := range mediaprocessingmodel.ImageVariantSizes {
+		var resizedImg image.Image = imaging.Resize(img, size.Width, 0, imaging.Lanczos)
+		if watermarkMark != nil && watermark.AppliesTo(size.Name) {
+			resizedImg = applyWatermark(resizedImg, watermarkMark, watermark)
+		}
+
+		for _, encoder := range s.encoders {
+			newKey, err := s.persistVariant(ctx, bucket, key, size.Name, encoder, resizedImg)
+			if err != nil {
+				if encoder.Format() == mediaprocessingmodel.ImageVariantFormatJPEG {
+					return ProcessImageResult{}, err
+				}
+				result.FormatErrs = append(result.FormatErrs, err)
+				continue
+			}
+			if newKey != "" {
+				result.Keys = append(result.Keys, newKey)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (s *ThumbnailService) downloadBytes(ctx context.Context, bucket, key string) ([]byte, error) {
+	reader, err := s.storage.Download(ctx, bucket, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download image: %w", err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %w", err)
+	}
+	return data, nil
+}
+
+func (s *ThumbnailService) decodeWithOrientation(data []byte) (image.Image, error) {
+	img, err := imaging.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+	orientation := readOrientation(data)
+	return normalizeOrientation(img, orientation), nil
+}
+
+// persistVariant encodes and uploads one size/format pair. It returns an empty key when the
+// format is skipped for this asset (see generateKey).
+func (s *ThumbnailService) persistVariant(ctx context.Context, bucket, originalKey, sizeName string, encoder VariantEncoder, img image.Image) (string, error) {
+	format := encoder.Format()
+
+	newKey, err := s.generateKey(originalKey, sizeName)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate key for %s: %w", sizeName, err)
+	}
+	if format != mediaprocessingmodel.ImageVariantFormatJPEG {
+		jpegKey := newKey
+		newKey = strings.TrimSuffix(newKey, path.Ext(newKey)) + format.Extension()
+		if newKey == jpegKey {
+			// Upload already carries this extension and its JPEG variant owns the key.
+			return "", nil
+		}
+	}
+
+	encoded, err := encoder.Encode(ctx, img)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode %s %s: %w", sizeName, format, err)
+	}
+
+	if err := s.storage.Upload(ctx, bucket, newKey, bytes.NewReader(encoded), format.ContentType()); err != nil {
+		return "", fmt.Errorf("failed to upload %s %s: %w", sizeName, format, err)
+	}
+
+	return newKey, nil
+}
+
+// generateKey transforms the raw key into the processed key
+func (s *ThumbnailService) generateKey(originalKey, sizeName string) (string, error) {
+	// Check for 'raw/' segment
+	if !strings.Contains(originalKey, "raw/") {
+		return "", fmt.Errorf("invalid key format: must contain 'raw/' segment")
+	}
+
+	// Split into prefix (e.g. "123/" or empty) and suffix (e.g. "photo/vertical/...")
+	parts := strings.SplitN(originalKey, "raw/", 2)
+	prefix := parts[0] // "123/" or ""
+	suffix := parts[1] // "photo/vertical/..."
+
+	// Remove date segment from suffix if present
+	dateRegex := regexp.MustCompile(`\d{4}-\d{2}-\d{2}/`)
+	cleanSuffix := dateRegex.ReplaceAllString(suffix, "")
+
+	// Construct new path components
+	dir := path.Dir(cleanSuffix)   // "photo/vertical"
+	file := path.Base(cleanSuffix) // "uuid.jpg"
+
+	// Final structure: {prefix}processed/{dir}/{size}/{file}
+	// Example: 123/processed/photo/vertical/thumbnail/uuid.jpg
+	newKey := fmt.Sprintf("%sprocessed/%s/%s/%s", prefix, dir, sizeName, file)
+
+	// Clean up any double slashes just in case
+	newKey = strings.ReplaceAll(newKey, "//", "/")
+
+	return newKey, nil
+}
@@ -0,0 +1,83 @@
+package imageprocessing
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"sync"
+
+	"github.com/disintegration/imaging"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+)
+
+// watermarkCache keeps decoded watermark images across invocations (warm Lambdas, local workers),
+// keyed by bucket and object key.
+type watermarkCache struct {
+	mu     sync.Mutex
+	images map[string]image.Image
+}
+
+func (s *ThumbnailService) loadWatermark(ctx context.Context, bucket, key string) (image.Image, error) {
+	cacheKey := bucket + "/" + key
+
+	s.watermarks.mu.Lock()
+	cached, ok := s.watermarks.images[cacheKey]
+	s.watermarks.mu.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	data, err := s.downloadBytes(ctx, bucket, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load watermark %s: %w", key, err)
+	}
+	img, err := imaging.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode watermark %s: %w", key, err)
+	}
+
+	s.watermarks.mu.Lock()
+	if s.watermarks.images == nil {
+		s.watermarks.images = make(map[string]image.Image)
+	}
+	s.watermarks.images[cacheKey] = img
+	s.watermarks.mu.Unlock()
+	return img, nil
+}
+
+// applyWatermark scales the mark relative to the variant width and blends it at the configured
+// anchor. The source image is left untouched; a new image is returned.
+func applyWatermark(img, mark image.Image, opts mediaprocessingmodel.WatermarkOptions) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	markWidth := width * opts.WidthPercent / 100
+	if markWidth <= 0 {
+		return img
+	}
+	scaled := imaging.Resize(mark, markWidth, 0, imaging.Lanczos)
+	// Very wide marks on vertical photos could exceed the height; keep them inside the frame.
+	if scaled.Bounds().Dy() > height {
+		scaled = imaging.Resize(mark, 0, height, imaging.Lanczos)
+	}
+
+	margin := min(width, height) * opts.MarginPercent / 100
+	markBounds := scaled.Bounds()
+
+	var anchor image.Point
+	switch opts.Position {
+	case mediaprocessingmodel.WatermarkPositionTopLeft:
+		anchor = image.Pt(margin, margin)
+	case mediaprocessingmodel.WatermarkPositionTopRight:
+		anchor = image.Pt(width-markBounds.Dx()-margin, margin)
+	case mediaprocessingmodel.WatermarkPositionBottomLeft:
+		anchor = image.Pt(margin, height-markBounds.Dy()-margin)
+	case mediaprocessingmodel.WatermarkPositionCenter:
+		anchor = image.Pt((width-markBounds.Dx())/2, (height-markBounds.Dy())/2)
+	default:
+		anchor = image.Pt(width-markBounds.Dx()-margin, height-markBounds.Dy()-margin)
+	}
+
+	return imaging.Overlay(img, scaled, anchor.Add(bounds.Min), opts.Opacity)
+}
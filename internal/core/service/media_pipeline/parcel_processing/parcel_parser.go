@@ -0,0 +1,166 @@
+package parcelprocessing
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+)
+
+// maxKMLBytes caps the KML extracted from a KMZ so a crafted archive cannot exhaust memory.
+const maxKMLBytes = 16 << 20
+
+var zipMagic = []byte("PK\x03\x04")
+
+// kmlPolygon maps a <Polygon>. Tags carry no namespace so both KML 2.2 and the Google
+// extensions namespace are accepted.
+type kmlPolygon struct {
+	Outer string   `xml:"outerBoundaryIs>LinearRing>coordinates"`
+	Inner []string `xml:"innerBoundaryIs>LinearRing>coordinates"`
+}
+
+// ParseParcel reads the single parcel polygon of a KMZ (zip holding doc.kml or another .kml
+// entry) or plain KML document. Points, lines and labels are ignored; documents with no polygon
+// or more than one are rejected.
+func ParseParcel(data []byte) (mediaprocessingmodel.ParcelPolygon, error) {
+	kml := data
+	if bytes.HasPrefix(data, zipMagic) {
+		extracted, err := extractKML(data)
+		if err != nil {
+			return mediaprocessingmodel.ParcelPolygon{}, err
+		}
+		kml = extracted
+	}
+
+	polygons, err := decodePolygons(kml)
+	if err != nil {
+		return mediaprocessingmodel.ParcelPolygon{}, err
+	}
+	switch len(polygons) {
+	case 0:
+		return mediaprocessingmodel.ParcelPolygon{}, mediaprocessingmodel.ErrParcelPolygonNotFound
+	case 1:
+	default:
+		return mediaprocessingmodel.ParcelPolygon{}, fmt.Errorf("%w: found %d", mediaprocessingmodel.ErrParcelMultiplePolygon, len(polygons))
+	}
+
+	outer, err := parseRing(polygons[0].Outer)
+	if err != nil {
+		return mediaprocessingmodel.ParcelPolygon{}, err
+	}
+	polygon := mediaprocessingmodel.ParcelPolygon{Outer: outer}
+	for _, raw := range polygons[0].Inner {
+		hole, err := parseRing(raw)
+		if err != nil {
+			return mediaprocessingmodel.ParcelPolygon{}, err
+		}
+		polygon.Holes = append(polygon.Holes, hole)
+	}
+	return polygon, nil
+}
+
+// extractKML returns doc.kml, the entry Google Earth writes, or the first .kml of the archive.
+func extractKML(data []byte) ([]byte, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", mediaprocessingmodel.ErrParcelInvalidDocument, err)
+	}
+
+	var entry *zip.File
+	for _, file := range archive.File {
+		if !strings.EqualFold(path.Ext(file.Name), ".kml") {
+			continue
+		}
+		if strings.EqualFold(file.Name, "doc.kml") {
+			entry = file
+			break
+		}
+		if entry == nil {
+			entry = file
+		}
+	}
+	if entry == nil {
+		return nil, fmt.Errorf("%w: no .kml entry in archive", mediaprocessingmodel.ErrParcelInvalidDocument)
+	}
+
+	reader, err := entry.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", mediaprocessingmodel.ErrParcelInvalidDocument, err)
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(io.LimitReader(reader, maxKMLBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", mediaprocessingmodel.ErrParcelInvalidDocument, err)
+	}
+	if len(content) > maxKMLBytes {
+		return nil, fmt.Errorf("%w: %s exceeds %d bytes", mediaprocessingmodel.ErrParcelInvalidDocument, entry.Name, maxKMLBytes)
+	}
+	return content, nil
+}
+
+// decodePolygons collects every <Polygon>, wherever it is nested (Document, Folder, Placemark
+// or MultiGeometry).
+func decodePolygons(kml []byte) ([]kmlPolygon, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(kml))
+	var polygons []kmlPolygon
+	sawRoot := false
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", mediaprocessingmodel.ErrParcelInvalidDocument, err)
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if !sawRoot {
+			if start.Name.Local != "kml" {
+				return nil, fmt.Errorf("%w: root element is <%s>", mediaprocessingmodel.ErrParcelInvalidDocument, start.Name.Local)
+			}
+			sawRoot = true
+			continue
+		}
+		if start.Name.Local != "Polygon" {
+			continue
+		}
+		var polygon kmlPolygon
+		if err := decoder.DecodeElement(&polygon, &start); err != nil {
+			return nil, fmt.Errorf("%w: %v", mediaprocessingmodel.ErrParcelInvalidDocument, err)
+		}
+		polygons = append(polygons, polygon)
+	}
+	if !sawRoot {
+		return nil, fmt.Errorf("%w: empty document", mediaprocessingmodel.ErrParcelInvalidDocument)
+	}
+	return polygons, nil
+}
+
+// parseRing reads a KML coordinates list: whitespace separated "lon,lat[,alt]" tuples.
+func parseRing(raw string) ([]mediaprocessingmodel.ParcelCoordinate, error) {
+	fields := strings.Fields(raw)
+	points := make([]mediaprocessingmodel.ParcelCoordinate, 0, len(fields))
+	for _, field := range fields {
+		parts := strings.Split(field, ",")
+		if len(parts) < 2 || len(parts) > 3 {
+			return nil, fmt.Errorf("%w: malformed coordinate %q", mediaprocessingmodel.ErrParcelInvalidPolygon, field)
+		}
+		lng, lngErr := strconv.ParseFloat(parts[0], 64)
+		lat, latErr := strconv.ParseFloat(parts[1], 64)
+		if lngErr != nil || latErr != nil {
+			return nil, fmt.Errorf("%w: malformed coordinate %q", mediaprocessingmodel.ErrParcelInvalidPolygon, field)
+		}
+		points = append(points, mediaprocessingmodel.ParcelCoordinate{Lat: lat, Lng: lng})
+	}
+	return mediaprocessingmodel.NewParcelRing(points)
+}
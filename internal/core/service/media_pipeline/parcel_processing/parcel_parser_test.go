@@ -0,0 +1,101 @@
+package parcelprocessing
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"testing"
+
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+)
+
+const (
+	parcelOuter = "-46.6560,-23.5610,0 -46.6550,-23.5610,0 -46.6550,-23.5600,0 -46.6560,-23.5600,0 -46.6560,-23.5610,0"
+	parcelHole  = "-46.6557,-23.5607 -46.6553,-23.5607 -46.6553,-23.5603 -46.6557,-23.5603"
+)
+
+func testKML(body string) string {
+	return `<?xml version="1.0" encoding="UTF-8"?><kml xmlns="http://www.opengis.net/kml/2.2"><Document>` + body + `</Document></kml>`
+}
+
+func testPolygon(outer string, holes ...string) string {
+	polygon := `<Placemark><Polygon><outerBoundaryIs><LinearRing><coordinates>` + outer + `</coordinates></LinearRing></outerBoundaryIs>`
+	for _, hole := range holes {
+		polygon += `<innerBoundaryIs><LinearRing><coordinates>` + hole + `</coordinates></LinearRing></innerBoundaryIs>`
+	}
+	return polygon + `</Polygon></Placemark>`
+}
+
+// testKMZ zips the given entries in order.
+func testKMZ(t *testing.T, entries ...[2]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, entry := range entries {
+		writer, err := archive.Create(entry[0])
+		if err != nil {
+			t.Fatalf("create %s: %v", entry[0], err)
+		}
+		if _, err := writer.Write([]byte(entry[1])); err != nil {
+			t.Fatalf("write %s: %v", entry[0], err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatalf("close archive: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestParseParcel(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		data          []byte
+		expectedOuter int
+		expectedHoles int
+		expectErr     error
+	}{
+		{name: "kml polygon drops the closing vertex", data: []byte(testKML(testPolygon(parcelOuter))), expectedOuter: 4},
+		{name: "kml polygon with a hole", data: []byte(testKML(testPolygon(parcelOuter, parcelHole))), expectedOuter: 4, expectedHoles: 1},
+		{name: "points and lines are ignored", data: []byte(testKML(`<Placemark><Point><coordinates>-46.6555,-23.5605</coordinates></Point></Placemark>` + testPolygon(parcelOuter))), expectedOuter: 4},
+		{name: "polygon nested in a multigeometry", data: []byte(testKML(`<Placemark><MultiGeometry><Polygon><outerBoundaryIs><LinearRing><coordinates>` + parcelOuter + `</coordinates></LinearRing></outerBoundaryIs></Polygon></MultiGeometry></Placemark>`)), expectedOuter: 4},
+		{name: "kmz prefers doc.kml", data: testKMZ(t, [2]string{"other.kml", testKML("")}, [2]string{"doc.kml", testKML(testPolygon(parcelOuter))}), expectedOuter: 4},
+		{name: "kmz falls back to the first kml entry", data: testKMZ(t, [2]string{"files/readme.txt", "x"}, [2]string{"parcel.KML", testKML(testPolygon(parcelOuter))}), expectedOuter: 4},
+		{name: "kmz without kml", data: testKMZ(t, [2]string{"image.png", "x"}), expectErr: mediaprocessingmodel.ErrParcelInvalidDocument},
+		{name: "not xml", data: []byte("not a parcel"), expectErr: mediaprocessingmodel.ErrParcelInvalidDocument},
+		{name: "empty document", data: nil, expectErr: mediaprocessingmodel.ErrParcelInvalidDocument},
+		{name: "root is not kml", data: []byte(`<gpx>` + testPolygon(parcelOuter) + `</gpx>`), expectErr: mediaprocessingmodel.ErrParcelInvalidDocument},
+		{name: "no polygon", data: []byte(testKML(`<Placemark><name>lot</name></Placemark>`)), expectErr: mediaprocessingmodel.ErrParcelPolygonNotFound},
+		{name: "two polygons", data: []byte(testKML(testPolygon(parcelOuter) + testPolygon(parcelOuter))), expectErr: mediaprocessingmodel.ErrParcelMultiplePolygon},
+		{name: "ring with two vertices", data: []byte(testKML(testPolygon("-46.6560,-23.5610 -46.6550,-23.5610 -46.6560,-23.5610"))), expectErr: mediaprocessingmodel.ErrParcelInvalidPolygon},
+		{name: "malformed coordinate", data: []byte(testKML(testPolygon("-46.6560;-23.5610 -46.6550,-23.5610 -46.6550,-23.5600"))), expectErr: mediaprocessingmodel.ErrParcelInvalidPolygon},
+		{name: "latitude out of range", data: []byte(testKML(testPolygon("-46.6560,-93.5610 -46.6550,-23.5610 -46.6550,-23.5600"))), expectErr: mediaprocessingmodel.ErrParcelInvalidPolygon},
+		{name: "malformed hole", data: []byte(testKML(testPolygon(parcelOuter, "-46.6557,-23.5607"))), expectErr: mediaprocessingmodel.ErrParcelInvalidPolygon},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			polygon, err := ParseParcel(tt.data)
+			if tt.expectErr != nil {
+				if !errors.Is(err, tt.expectErr) {
+					t.Fatalf("ParseParcel() error = %v, expected %v", err, tt.expectErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseParcel() unexpected error: %v", err)
+			}
+			if len(polygon.Outer) != tt.expectedOuter || len(polygon.Holes) != tt.expectedHoles {
+				t.Fatalf("ParseParcel() = %d vertices and %d holes, expected %d and %d", len(polygon.Outer), len(polygon.Holes), tt.expectedOuter, tt.expectedHoles)
+			}
+			if first := polygon.Outer[0]; first.Lat != -23.5610 || first.Lng != -46.6560 {
+				t.Fatalf("ParseParcel() first vertex = %+v, expected latitude -23.5610 and longitude -46.6560", first)
+			}
+		})
+	}
+}
@@ -0,0 +1,363 @@
+package videoprocessing
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	storageport "github.com/projeto-toq/toq_server/internal/core/port/right/storage"
+)
+
+// HLSRendition describes one rung of the adaptive bitrate ladder. Height refers to the short
+// side of the picture so the same ladder serves vertical and horizontal videos.
+type HLSRendition struct {
+	Name             string
+	Height           int
+	VideoBitrateKbps int
+	AudioBitrateKbps int
+}
+
+// DefaultHLSRenditions is the ladder used when none is configured, lowest first.
+var DefaultHLSRenditions = []HLSRendition{
+	{Name: "360p", Height: 360, VideoBitrateKbps: 800, AudioBitrateKbps: 96},
+	{Name: "540p", Height: 540, VideoBitrateKbps: 1400, AudioBitrateKbps: 128},
+	{Name: "720p", Height: 720, VideoBitrateKbps: 2800, AudioBitrateKbps: 128},
+	{Name: "1080p", Height: 1080, VideoBitrateKbps: 5000, AudioBitrateKbps: 160},
+}
+
+// HLSOutput lists the objects uploaded for one video.
+type HLSOutput struct {
+	MasterKey  string
+	PosterKey  string
+	Playlists  map[string]string // rendition name -> media playlist key
+	ObjectKeys []string          // every uploaded object, segments included
+}
+
+// GeneratedAssets lists the outputs consumed by the consolidation step (segments are omitted:
+// they are always addressed through their playlist).
+func (o HLSOutput) GeneratedAssets(sourceKey string) []mediaprocessingmodel.JobAsset {
+	assets := make([]mediaprocessingmodel.JobAsset, 0, len(o.Playlists)+2)
+	assets = append(assets,
+		mediaprocessingmodel.JobAsset{Key: o.MasterKey, Type: mediaprocessingmodel.JobAssetTypeHLSMaster, SourceKey: sourceKey},
+		mediaprocessingmodel.JobAsset{Key: o.PosterKey, Type: mediaprocessingmodel.JobAssetTypeHLSPoster, SourceKey: sourceKey},
+	)
+	for _, playlistKey := range o.Playlists {
+		assets = append(assets, mediaprocessingmodel.JobAsset{Key: playlistKey, Type: mediaprocessingmodel.JobAssetTypeHLSPlaylist, SourceKey: sourceKey})
+	}
+	return assets
+}
+
+// HLSService transcodes a video into multi-bitrate HLS (H.264/AAC, MPEG-TS segments) plus a poster.
+type HLSService struct {
+	storage        storageport.MediaObjectStoragePort
+	ffmpegPath     string
+	segmentSeconds int
+	posterSecond   int
+	renditions     []HLSRendition
+}
+
+// NewHLSService configures the transcoder; zero values fall back to 6s segments, a poster taken
+// at second 1 and DefaultHLSRenditions.
+func NewHLSService(storage storageport.MediaObjectStoragePort, ffmpegPath string, segmentSeconds, posterSecond int, renditions []HLSRendition) *HLSService {
+	if segmentSeconds <= 0 {
+		segmentSeconds = 6
+	}
+	if posterSecond <= 0 {
+		posterSecond = 1
+	}
+	if len(renditions) == 0 {
+		renditions = DefaultHLSRenditions
+	}
+
+	return &HLSService{
+		storage:        storage,
+		ffmpegPath:     resolveFFmpegPath(ffmpegPath),
+		segmentSeconds: segmentSeconds,
+		posterSecond:   posterSecond,
+		renditions:     renditions,
+	}
+}
+
+// ParseHLSRenditions keeps the default ladder rungs whose names are listed (e.g. "360p,720p").
+func ParseHLSRenditions(names []string) []HLSRendition {
+	if len(names) == 0 {
+		return nil
+	}
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		wanted[strings.ToLower(strings.TrimSpace(name))] = true
+	}
+	selected := make([]HLSRendition, 0, len(names))
+	for _, rendition := range DefaultHLSRenditions {
+		if wanted[rendition.Name] {
+			selected = append(selected, rendition)
+		}
+	}
+	return selected
+}
+
+// Transcode downloads the raw video, produces the renditions that do not upscale the source and
+// uploads playlists, segments and poster under processed/.../hls/{name}/.
+func (s *HLSService) Transcode(ctx context.Context, bucket, key string) (HLSOutput, error) {
+	destPrefix, err := s.generatePrefix(key)
+	if err != nil {
+		return HLSOutput{}, err
+	}
+
+	workDir, err := os.MkdirTemp("", "video-hls-*")
+	if err != nil {
+		return HLSOutput{}, fmt.Errorf("failed to create work dir: %w", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	inputPath := filepath.Join(workDir, "input.bin")
+	if err := s.download(ctx, bucket, key, inputPath); err != nil {
+		return HLSOutput{}, err
+	}
+
+	width, height, err := s.probeDimensions(ctx, inputPath)
+	if err != nil {
+		return HLSOutput{}, err
+	}
+	renditions := s.selectRenditions(min(width, height))
+
+	outputDir := filepath.Join(workDir, "hls")
+	if err := s.runTranscode(ctx, inputPath, outputDir, renditions); err != nil {
+		return HLSOutput{}, err
+	}
+	if err := s.runPoster(ctx, inputPath, filepath.Join(outputDir, "poster.jpg")); err != nil {
+		return HLSOutput{}, err
+	}
+	master := buildMasterPlaylist(renditions, width, height)
+	if err := os.WriteFile(filepath.Join(outputDir, mediaprocessingmodel.HLSMasterPlaylistName), []byte(master), 0o644); err != nil {
+		return HLSOutput{}, fmt.Errorf("failed to write master playlist: %w", err)
+	}
+
+	output := HLSOutput{
+		MasterKey: destPrefix + mediaprocessingmodel.HLSMasterPlaylistName,
+		PosterKey: destPrefix + "poster.jpg",
+		Playlists: make(map[string]string, len(renditions)),
+	}
+	for _, rendition := range renditions {
+		output.Playlists[rendition.Name] = destPrefix + rendition.Name + "/index.m3u8"
+	}
+
+	err = filepath.WalkDir(outputDir, func(filePath string, entry fs.DirEntry, walkErr error) error {
+		if walkErr != nil || entry.IsDir() {
+			return walkErr
+		}
+		rel, err := filepath.Rel(outputDir, filePath)
+		if err != nil {
+			return err
+		}
+		objectKey := destPrefix + filepath.ToSlash(rel)
+		if err := s.upload(ctx, bucket, objectKey, filePath); err != nil {
+			return err
+		}
+		output.ObjectKeys = append(output.ObjectKeys, objectKey)
+		return nil
+	})
+	if err != nil {
+		return HLSOutput{}, err
+	}
+
+	return output, nil
+}
+
+func (s *HLSService) download(ctx context.Context, bucket, key, target string) error {
+	reader, err := s.storage.Download(ctx, bucket, key)
+	if err != nil {
+		return fmt.Errorf("failed to download video: %w", err)
+	}
+	defer reader.Close()
+
+	file, err := os.Create(target)
+	if err != nil {
+		return fmt.Errorf("failed to create temp input file: %w", err)
+	}
+	if _, err := file.ReadFrom(reader); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write temp input file: %w", err)
+	}
+	return file.Close()
+}
+
+func (s *HLSService) upload(ctx context.Context, bucket, key, filePath string) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", filePath, err)
+	}
+	defer file.Close()
+
+	if err := s.storage.Upload(ctx, bucket, key, file, hlsContentType(key)); err != nil {
+		return fmt.Errorf("failed to upload %s: %w", key, err)
+	}
+	return nil
+}
+
+var (
+	streamDimensionsRegex = regexp.MustCompile(`Video: .*?, (\d{2,5})x(\d{2,5})`)
+	rotationRegex         = regexp.MustCompile(`(?:rotation of|rotate\s*:)\s*(-?\d+)`)
+)
+
+// probeDimensions reads the display size from `ffmpeg -i`, swapping width/height for rotated
+// phone videos so the master playlist advertises the orientation players will render.
+func (s *HLSService) probeDimensions(ctx context.Context, inputPath string) (int, int, error) {
+	// ffmpeg exits non-zero without an output file; the stream info is still printed to stderr.
+	output, _ := exec.CommandContext(ctx, s.ffmpegPath, "-hide_banner", "-i", inputPath).CombinedOutput()
+
+	match := streamDimensionsRegex.FindSubmatch(output)
+	if match == nil {
+		return 0, 0, fmt.Errorf("could not read video dimensions: %s", strings.TrimSpace(string(output)))
+	}
+	width, _ := strconv.Atoi(string(match[1]))
+	height, _ := strconv.Atoi(string(match[2]))
+
+	if rotation := rotationRegex.FindSubmatch(output); rotation != nil {
+		if degrees, _ := strconv.Atoi(string(rotation[1])); degrees%180 != 0 {
+			width, height = height, width
+		}
+	}
+	return width, height, nil
+}
+
+// selectRenditions drops rungs above the source resolution, always keeping the lowest one.
+func (s *HLSService) selectRenditions(sourceShortSide int) []HLSRendition {
+	selected := make([]HLSRendition, 0, len(s.renditions))
+	for _, rendition := range s.renditions {
+		if rendition.Height <= sourceShortSide {
+			selected = append(selected, rendition)
+		}
+	}
+	if len(selected) == 0 {
+		selected = append(selected, s.renditions[0])
+	}
+	return selected
+}
+
+func (s *HLSService) runTranscode(ctx context.Context, inputPath, outputDir string, renditions []HLSRendition) error {
+	split := fmt.Sprintf("[0:v]split=%d", len(renditions))
+	scales := make([]string, 0, len(renditions))
+	for i, rendition := range renditions {
+		split += fmt.Sprintf("[v%d]", i)
+		// Scale the short side to the rung height; autorotation runs before the filter graph.
+		scales = append(scales, fmt.Sprintf("[v%d]scale=w='if(gt(iw,ih),-2,%d)':h='if(gt(iw,ih),%d,-2)'[v%do]", i, rendition.Height, rendition.Height, i))
+	}
+
+	args := []string{"-hide_banner", "-loglevel", "error", "-y", "-i", inputPath,
+		"-filter_complex", split + ";" + strings.Join(scales, ";")}
+
+	// Keyframes forced on segment boundaries keep renditions aligned for seamless switching; metadata
+	// (recording location, device tags) is dropped from every output.
+	keyframes := fmt.Sprintf("expr:gte(t,n_forced*%d)", s.segmentSeconds)
+	for i, rendition := range renditions {
+		renditionDir := filepath.Join(outputDir, rendition.Name)
+		if err := os.MkdirAll(renditionDir, 0o755); err != nil {
+			return fmt.Errorf("failed to create rendition dir: %w", err)
+		}
+		args = append(args,
+			"-map", fmt.Sprintf("[v%do]", i), "-map", "0:a?", "-map_metadata", "-1",
+			"-c:v", "libx264", "-preset", "veryfast", "-profile:v", "main", "-pix_fmt", "yuv420p",
+			"-b:v", fmt.Sprintf("%dk", rendition.VideoBitrateKbps),
+			"-maxrate", fmt.Sprintf("%dk", rendition.VideoBitrateKbps*107/100),
+			"-bufsize", fmt.Sprintf("%dk", rendition.VideoBitrateKbps*3/2),
+			"-force_key_frames", keyframes, "-sc_threshold", "0",
+			"-c:a", "aac", "-b:a", fmt.Sprintf("%dk", rendition.AudioBitrateKbps), "-ac", "2",
+			"-f", "hls", "-hls_time", strconv.Itoa(s.segmentSeconds), "-hls_playlist_type", "vod",
+			"-hls_segment_filename", filepath.Join(renditionDir, "segment_%04d.ts"),
+			filepath.Join(renditionDir, "index.m3u8"),
+		)
+	}
+
+	if output, err := exec.CommandContext(ctx, s.ffmpegPath, args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("ffmpeg hls transcode failed: %w | output: %s", err, string(output))
+	}
+	return nil
+}
+
+func (s *HLSService) runPoster(ctx context.Context, inputPath, outputPath string) error {
+	cmd := exec.CommandContext(ctx, s.ffmpegPath,
+		"-hide_banner", "-loglevel", "error", "-y",
+		"-ss", strconv.Itoa(s.posterSecond),
+		"-i", inputPath,
+		"-vframes", "1",
+		"-map_metadata", "-1",
+		"-vf", "scale='if(gt(iw,ih),min(1280,iw),-2)':'if(gt(iw,ih),-2,min(1280,ih))'",
+		"-q:v", "3",
+		outputPath,
+	)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("ffmpeg poster failed: %w | output: %s", err, string(output))
+	}
+	return nil
+}
+
+// buildMasterPlaylist references the media playlists with paths relative to the master so the
+// HLS directory can be served from any origin (or rewritten by the backend).
+func buildMasterPlaylist(renditions []HLSRendition, sourceWidth, sourceHeight int) string {
+	var b strings.Builder
+	b.WriteString("#EXTM3U\n#EXT-X-VERSION:3\n#EXT-X-INDEPENDENT-SEGMENTS\n")
+	for _, rendition := range renditions {
+		width, height := scaledDimensions(sourceWidth, sourceHeight, rendition.Height)
+		bandwidth := (rendition.VideoBitrateKbps*107/100 + rendition.AudioBitrateKbps) * 1000
+		// H.264 Main profile: level 3.1 up to 720p, 4.0 above (libx264 never picks a higher level).
+		codec := "avc1.4d401f"
+		if rendition.Height > 720 {
+			codec = "avc1.4d4028"
+		}
+		fmt.Fprintf(&b, "#EXT-X-STREAM-INF:BANDWIDTH=%d,AVERAGE-BANDWIDTH=%d,RESOLUTION=%dx%d,CODECS=\"%s,mp4a.40.2\",NAME=\"%s\"\n",
+			bandwidth, (rendition.VideoBitrateKbps+rendition.AudioBitrateKbps)*1000, width, height, codec, rendition.Name)
+		fmt.Fprintf(&b, "%s/index.m3u8\n", rendition.Name)
+	}
+	return b.String()
+}
+
+// scaledDimensions mirrors the ffmpeg scale expression (short side = target, even long side).
+func scaledDimensions(width, height, shortSide int) (int, int) {
+	if width > height {
+		long := width * shortSide / height
+		return long - long%2, shortSide
+	}
+	long := height * shortSide / width
+	return shortSide, long - long%2
+}
+
+func hlsContentType(key string) string {
+	switch strings.ToLower(path.Ext(key)) {
+	case ".m3u8":
+		return "application/vnd.apple.mpegurl"
+	case ".ts":
+		return "video/mp2t"
+	case ".jpg":
+		return "image/jpeg"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// generatePrefix maps {listingId}/raw/video/{orientation}/{file} to
+// {listingId}/processed/video/{orientation}/hls/{file stem}/.
+func (s *HLSService) generatePrefix(originalKey string) (string, error) {
+	if !strings.Contains(originalKey, "raw/") {
+		return "", fmt.Errorf("invalid key format: must contain 'raw/' segment")
+	}
+
+	parts := strings.SplitN(originalKey, "raw/", 2)
+	prefix := parts[0]
+	suffix := regexp.MustCompile(`\d{4}-\d{2}-\d{2}/`).ReplaceAllString(parts[1], "")
+
+	dir := path.Dir(suffix)
+	file := path.Base(suffix)
+	stem := strings.TrimSuffix(file, path.Ext(file))
+
+	newPrefix := fmt.Sprintf("%sprocessed/%s/hls/%s/", prefix, dir, stem)
+	return strings.ReplaceAll(newPrefix, "//", "/"), nil
+}
@@ -11,12 +11,12 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/projeto-toq/toq_server/aws/lambdas/go_src/internal/core/port"
+	storageport "github.com/projeto-toq/toq_server/internal/core/port/right/storage"
 )
 
 // VideoThumbnailService extracts a frame from a video and uploads a resized JPEG thumbnail.
 type VideoThumbnailService struct {
-	storage    port.StoragePort
+	storage    storageport.MediaObjectStoragePort
 	ffmpegPath string
 	seekSecond int
 	width      int
@@ -24,7 +24,7 @@ type VideoThumbnailService struct {
 }
 
 // NewVideoThumbnailService configures the service with sensible defaults and an injected storage adapter.
-func NewVideoThumbnailService(storage port.StoragePort, ffmpegPath string, seekSecond, width, quality int) *VideoThumbnailService {
+func NewVideoThumbnailService(storage storageport.MediaObjectStoragePort, ffmpegPath string, seekSecond, width, quality int) *VideoThumbnailService {
 	ffmpegPath = resolveFFmpegPath(ffmpegPath)
 	if seekSecond <= 0 {
 		seekSecond = 1
@@ -126,6 +126,7 @@ func (s *VideoThumbnailService) runFFmpeg(ctx context.Context, inputPath, output
 		"-ss", strconv.Itoa(s.seekSecond),
 		"-i", inputPath,
 		"-vframes", "1",
+		"-map_metadata", "-1", // drop container tags such as the recording location
 		"-vf", fmt.Sprintf("scale=%d:-1", s.width),
 		"-q:v", strconv.Itoa(s.quality),
 		"-f", "image2",
@@ -0,0 +1,117 @@
+package zip
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// prefetchedObject is one source object opened ahead of the writer. Up to readAheadBytes are
+// already buffered; larger objects continue streaming from the open body.
+type prefetchedObject struct {
+	io.Reader
+	body io.Closer
+}
+
+func (o *prefetchedObject) Close() error {
+	if o.body == nil {
+		return nil
+	}
+	return o.body.Close()
+}
+
+type prefetchResult struct {
+	object *prefetchedObject
+	err    error
+}
+
+// prefetcher downloads objects in order with at most `prefetch` of them in flight or waiting
+// to be written; a slot is released once the writer is done with an object.
+type prefetcher struct {
+	results []chan prefetchResult
+	slots   chan struct{}
+}
+
+func (s *ZipService) startPrefetch(ctx context.Context, bucket string, keys []string) *prefetcher {
+	p := &prefetcher{
+		results: make([]chan prefetchResult, len(keys)),
+		slots:   make(chan struct{}, s.prefetch),
+	}
+	for i := range keys {
+		p.results[i] = make(chan prefetchResult, 1)
+	}
+
+	go func() {
+		for i, key := range keys {
+			select {
+			case p.slots <- struct{}{}:
+			case <-ctx.Done():
+				for _, ch := range p.results[i:] {
+					ch <- prefetchResult{err: ctx.Err()}
+				}
+				return
+			}
+			go func(ch chan<- prefetchResult, key string) {
+				object, err := s.fetch(ctx, bucket, key)
+				ch <- prefetchResult{object: object, err: err}
+			}(p.results[i], key)
+		}
+	}()
+	return p
+}
+
+func (s *ZipService) fetch(ctx context.Context, bucket, key string) (*prefetchedObject, error) {
+	body, err := s.storage.Download(ctx, bucket, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", key, err)
+	}
+
+	var head bytes.Buffer
+	n, err := io.CopyN(&head, body, s.readAheadBytes)
+	if errors.Is(err, io.EOF) || (err == nil && n < s.readAheadBytes) {
+		// Whole object buffered: free the connection right away.
+		body.Close()
+		return &prefetchedObject{Reader: &head}, nil
+	}
+	if err != nil {
+		body.Close()
+		return nil, fmt.Errorf("failed to read %s: %w", key, err)
+	}
+	return &prefetchedObject{Reader: io.MultiReader(&head, body), body: body}, nil
+}
+
+// next waits for the i-th object in key order.
+func (p *prefetcher) next(ctx context.Context, i int) (*prefetchedObject, error) {
+	select {
+	case result := <-p.results[i]:
+		p.results[i] = nil
+		if result.err != nil {
+			return nil, result.err
+		}
+		return result.object, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// release frees the slot of a consumed object so the next download can start.
+func (p *prefetcher) release() {
+	<-p.slots
+}
+
+// drain closes objects fetched but never consumed (writer aborted). The caller cancels the
+// context first, so pending downloads finish promptly.
+func (p *prefetcher) drain() {
+	for _, ch := range p.results {
+		if ch == nil {
+			continue
+		}
+		go func(ch <-chan prefetchResult) {
+			if result := <-ch; result.object != nil {
+				result.object.Close()
+			}
+		}(ch)
+	}
+}
@@ -0,0 +1,214 @@
+package zip
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"regexp"
+	"strings"
+
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	storageport "github.com/projeto-toq/toq_server/internal/core/port/right/storage"
+)
+
+const (
+	defaultPrefetch       = 4
+	defaultReadAheadBytes = 8 << 20 // 8 MiB
+)
+
+// ZipService streams listing bundles: objects are downloaded ahead by a bounded pool, written
+// through the ZIP encoder and piped into the multipart upload, so memory stays at roughly
+// prefetch*readAheadBytes plus the uploader part buffers, regardless of the listing size.
+type ZipService struct {
+	storage        storageport.MediaObjectStoragePort
+	prefetch       int
+	readAheadBytes int64
+}
+
+// ZipResult summarizes a generated bundle.
+type ZipResult struct {
+	// UncompressedBytes is the total read from the source objects.
+	UncompressedBytes int64
+	// ZipBytes is the final archive size.
+	ZipBytes int64
+	// SHA256 is the hex digest of the whole archive, computed while streaming.
+	SHA256      string
+	ManifestKey string
+	Manifest    mediaprocessingmodel.ZipManifest
+}
+
+// NewZipService builds the service; prefetch is the number of objects downloaded ahead of the
+// writer and readAheadBytes the amount buffered per object (zero values use the defaults).
+func NewZipService(storage storageport.MediaObjectStoragePort, prefetch int, readAheadBytes int64) *ZipService {
+	if prefetch <= 0 {
+		prefetch = defaultPrefetch
+	}
+	if readAheadBytes <= 0 {
+		readAheadBytes = defaultReadAheadBytes
+	}
+	return &ZipService{
+		storage:        storage,
+		prefetch:       prefetch,
+		readAheadBytes: readAheadBytes,
+	}
+}
+
+// CreateZip streams the given keys into a ZIP uploaded to destinationKey. The archive ends with
+// a manifest (name, source key, size and CRC32 per entry) that is also uploaded as a sidecar
+// object next to the archive.
+func (s *ZipService) CreateZip(ctx context.Context, bucket string, sourceKeys []string, destinationKey string) (ZipResult, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pipeReader, pipeWriter := io.Pipe()
+	counter := &countingWriter{}
+	digest := sha256.New()
+
+	manifest := mediaprocessingmodel.ZipManifest{
+		Version:    mediaprocessingmodel.ZipManifestVersion,
+		ArchiveKey: destinationKey,
+		Entries:    make([]mediaprocessingmodel.ZipManifestEntry, 0, len(sourceKeys)),
+	}
+
+	writeDone := make(chan error, 1)
+	go func() {
+		err := s.writeArchive(ctx, bucket, sourceKeys, io.MultiWriter(pipeWriter, counter, digest), &manifest)
+		pipeWriter.CloseWithError(err)
+		writeDone <- err
+	}()
+
+	uploadErr := s.storage.Upload(ctx, bucket, destinationKey, pipeReader, "application/zip")
+	if uploadErr != nil {
+		// Unblock the writer if the upload gave up before consuming the whole stream.
+		pipeReader.CloseWithError(uploadErr)
+		cancel()
+	}
+	writeErr := <-writeDone
+
+	// A writer failure aborts the pipe, so the upload usually fails with the same error: report
+	// the root cause rather than the upload.
+	if writeErr != nil && (uploadErr == nil || errors.Is(uploadErr, writeErr)) {
+		return ZipResult{}, writeErr
+	}
+	if uploadErr != nil {
+		return ZipResult{}, fmt.Errorf("failed to upload zip to %s: %w", destinationKey, uploadErr)
+	}
+
+	manifestKey := mediaprocessingmodel.ZipManifestKey(destinationKey)
+	manifestJSON, err := json.Marshal(manifest)
+	if err != nil {
+		return ZipResult{}, fmt.Errorf("failed to encode zip manifest: %w", err)
+	}
+	if err := s.storage.Upload(ctx, bucket, manifestKey, bytes.NewReader(manifestJSON), "application/json"); err != nil {
+		return ZipResult{}, fmt.Errorf("failed to upload zip manifest to %s: %w", manifestKey, err)
+	}
+
+	return ZipResult{
+		UncompressedBytes: manifest.TotalSize(),
+		ZipBytes:          counter.n,
+		SHA256:            hex.EncodeToString(digest.Sum(nil)),
+		ManifestKey:       manifestKey,
+		Manifest:          manifest,
+	}, nil
+}
+
+func (s *ZipService) writeArchive(ctx context.Context, bucket string, sourceKeys []string, out io.Writer, manifest *mediaprocessingmodel.ZipManifest) error {
+	zipWriter := zip.NewWriter(out)
+	objects := s.startPrefetch(ctx, bucket, sourceKeys)
+	defer objects.drain()
+
+	for i, key := range sourceKeys {
+		object, err := objects.next(ctx, i)
+		if err != nil {
+			return err
+		}
+
+		entry, err := s.writeEntry(zipWriter, key, object)
+		object.Close()
+		objects.release()
+		if err != nil {
+			return err
+		}
+		manifest.Entries = append(manifest.Entries, entry)
+	}
+
+	manifestJSON, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode zip manifest: %w", err)
+	}
+	writer, err := zipWriter.CreateHeader(&zip.FileHeader{Name: mediaprocessingmodel.ZipManifestName, Method: zip.Deflate})
+	if err != nil {
+		return fmt.Errorf("failed to create manifest entry: %w", err)
+	}
+	if _, err := writer.Write(manifestJSON); err != nil {
+		return fmt.Errorf("failed to write manifest entry: %w", err)
+	}
+
+	if err := zipWriter.Close(); err != nil {
+		return fmt.Errorf("failed to close zip writer: %w", err)
+	}
+	return nil
+}
+
+func (s *ZipService) writeEntry(zipWriter *zip.Writer, key string, object io.Reader) (mediaprocessingmodel.ZipManifestEntry, error) {
+	internalPath := s.cleanPath(key)
+	writer, err := zipWriter.CreateHeader(&zip.FileHeader{
+		Name:   internalPath,
+		Method: zip.Deflate,
+	})
+	if err != nil {
+		return mediaprocessingmodel.ZipManifestEntry{}, fmt.Errorf("failed to create zip header for %s: %w", key, err)
+	}
+
+	checksum := crc32.NewIEEE()
+	written, err := io.Copy(io.MultiWriter(writer, checksum), object)
+	if err != nil {
+		return mediaprocessingmodel.ZipManifestEntry{}, fmt.Errorf("failed to write %s to zip: %w", key, err)
+	}
+
+	return mediaprocessingmodel.ZipManifestEntry{
+		Name:      internalPath,
+		SourceKey: key,
+		Size:      written,
+		CRC32:     fmt.Sprintf("%08x", checksum.Sum32()),
+	}, nil
+}
+
+// cleanPath removes 'processed/' prefix and date segments to create a clean internal zip path
+func (s *ZipService) cleanPath(key string) string {
+	original := key
+	path := key
+
+	if parts := strings.SplitN(path, "/", 2); len(parts) == 2 {
+		path = parts[1]
+	}
+
+	path = strings.TrimPrefix(path, "processed/")
+	path = strings.TrimPrefix(path, "raw/")
+
+	// Remove date segment (YYYY-MM-DD/)
+	dateRegex := regexp.MustCompile(`\d{4}-\d{2}-\d{2}/`)
+	path = dateRegex.ReplaceAllString(path, "")
+
+	if path == "" {
+		return original
+	}
+
+	return path
+}
+
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
@@ -85,6 +85,15 @@ func mergeAssetMetadata(asset mediaprocessingmodel.MediaAsset, updates map[strin
 	return asset
 }
 
+// assetMetadataMap decodes the asset metadata; invalid or empty metadata yields an empty map.
+func assetMetadataMap(asset mediaprocessingmodel.MediaAsset) map[string]string {
+	metadata := make(map[string]string)
+	if asset.Metadata() != "" {
+		_ = json.Unmarshal([]byte(asset.Metadata()), &metadata)
+	}
+	return metadata
+}
+
 func cloneMetadata(source map[string]string) map[string]string {
 	if len(source) == 0 {
 		return make(map[string]string)
@@ -0,0 +1,275 @@
+package mediaprocessingservice
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	"github.com/projeto-toq/toq_server/internal/core/domain/dto"
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	permissionmodel "github.com/projeto-toq/toq_server/internal/core/model/permission_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ArrangeGallery reorders the gallery, picks the cover of each photo orientation and tags photos
+// by room in a single transaction. The caller must send the current gallery revision; any change
+// made in between (new upload, another arrangement) makes the request fail with a conflict.
+func (s *mediaProcessingService) ArrangeGallery(ctx context.Context, input dto.ArrangeGalleryInput) (dto.ArrangeGalleryOutput, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return dto.ArrangeGalleryOutput{}, derrors.Infra("failed to create tracer", err)
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	if input.ListingIdentityID <= 0 {
+		return dto.ArrangeGalleryOutput{}, derrors.Validation("listingIdentityId must be greater than zero", map[string]any{"listingIdentityId": "required"})
+	}
+	if input.Revision == "" {
+		return dto.ArrangeGalleryOutput{}, derrors.Validation("revision is required", map[string]any{"revision": "required"})
+	}
+	if len(input.Items) == 0 {
+		return dto.ArrangeGalleryOutput{}, derrors.Validation("items must not be empty", map[string]any{"items": "required"})
+	}
+
+	tx, txErr := s.globalService.StartTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("service.media.gallery.tx_start_error", "err", txErr, "listing_identity_id", input.ListingIdentityID)
+		return dto.ArrangeGalleryOutput{}, derrors.Infra("failed to start transaction", txErr)
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+				logger.Error("service.media.gallery.rollback_error", "err", rbErr)
+			}
+		}
+	}()
+
+	listing, err := s.listingRepo.GetActiveListingVersion(ctx, tx, input.ListingIdentityID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return dto.ArrangeGalleryOutput{}, derrors.NotFound("listing not found")
+		}
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.gallery.get_listing_error", "err", err, "listing_identity_id", input.ListingIdentityID)
+		return dto.ArrangeGalleryOutput{}, derrors.Infra("failed to load listing", err)
+	}
+	if input.RequesterRole == permissionmodel.RoleSlugOwner && listing.UserID() != int64(input.RequestedBy) {
+		return dto.ArrangeGalleryOutput{}, derrors.Forbidden("only the listing owner can arrange its gallery")
+	}
+
+	// Locking the assets keeps uploads, callbacks and other arrangements from changing the gallery
+	// between the revision check and the update.
+	allAssets, err := s.repo.ListAssetsForUpdate(ctx, tx, uint64(input.ListingIdentityID))
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.gallery.list_assets_error", "err", err, "listing_identity_id", input.ListingIdentityID)
+		return dto.ArrangeGalleryOutput{}, derrors.Infra("failed to list assets", err)
+	}
+	assets, discarded := splitDiscardedAssets(allAssets)
+
+	if current := mediaprocessingmodel.GalleryRevision(assets); current != input.Revision {
+		return dto.ArrangeGalleryOutput{}, derrors.Conflict(
+			"gallery was changed since it was loaded, reload and try again",
+			derrors.WithDetails(map[string]any{"revision": current}),
+		)
+	}
+
+	features, err := s.listingFeatureLabels(ctx, tx, listing.Features())
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.gallery.features_error", "err", err, "listing_identity_id", input.ListingIdentityID)
+		return dto.ArrangeGalleryOutput{}, derrors.Infra("failed to load listing features", err)
+	}
+
+	arranged, err := arrangeGalleryAssets(assets, input.Items, features)
+	if err != nil {
+		return dto.ArrangeGalleryOutput{}, err
+	}
+	arranged = appendDiscardedAssets(arranged, discarded)
+
+	if err := s.repo.UpdateGalleryArrangement(ctx, tx, uint64(input.ListingIdentityID), arranged); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.gallery.update_error", "err", err, "listing_identity_id", input.ListingIdentityID)
+		return dto.ArrangeGalleryOutput{}, derrors.Infra("failed to update gallery", err)
+	}
+
+	if err := s.globalService.CommitTransaction(ctx, tx); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.gallery.commit_error", "err", err, "listing_identity_id", input.ListingIdentityID)
+		return dto.ArrangeGalleryOutput{}, derrors.Infra("failed to commit transaction", err)
+	}
+	committed = true
+
+	byID := make(map[uint64]mediaprocessingmodel.MediaAsset, len(arranged))
+	for _, asset := range arranged {
+		byID[asset.ID()] = asset
+	}
+	for i, asset := range assets {
+		if updated, ok := byID[asset.ID()]; ok {
+			assets[i] = updated
+		}
+	}
+	revision := mediaprocessingmodel.GalleryRevision(assets)
+
+	logger.Info("service.media.gallery.arranged", "listing_identity_id", input.ListingIdentityID, "assets", len(arranged), "revision", revision)
+	return dto.ArrangeGalleryOutput{ListingIdentityID: input.ListingIdentityID, Revision: revision}, nil
+}
+
+// arrangeGalleryAssets validates the requested arrangement against the listing assets and returns
+// the assets of the touched types with their new sequence and metadata.
+func arrangeGalleryAssets(assets []mediaprocessingmodel.MediaAsset, items []dto.ArrangeGalleryItem, features map[int64]string) ([]mediaprocessingmodel.MediaAsset, error) {
+	byID := make(map[uint64]mediaprocessingmodel.MediaAsset, len(assets))
+	typeCounts := make(map[mediaprocessingmodel.MediaAssetType]int)
+	for _, asset := range assets {
+		byID[asset.ID()] = asset
+		typeCounts[asset.AssetType()]++
+	}
+
+	seen := make(map[uint64]bool, len(items))
+	positions := make(map[mediaprocessingmodel.MediaAssetType]int)
+	covers := make(map[mediaprocessingmodel.MediaAssetType]uint64)
+	arranged := make([]mediaprocessingmodel.MediaAsset, 0, len(items))
+
+	for _, item := range items {
+		field := fmt.Sprintf("assetId:%d", item.AssetID)
+		asset, ok := byID[item.AssetID]
+		if !ok {
+			return nil, derrors.Validation("asset does not belong to this listing", map[string]any{"asset": field})
+		}
+		if seen[item.AssetID] {
+			return nil, derrors.Validation("asset listed more than once", map[string]any{"asset": field})
+		}
+		seen[item.AssetID] = true
+		// Uploads in flight still refer to their sequence until they are processed.
+		if asset.Status() == mediaprocessingmodel.MediaAssetStatusPendingUpload {
+			return nil, derrors.Conflict("asset upload is still pending, process or remove it before arranging", derrors.WithDetails(map[string]any{"asset": field}))
+		}
+
+		assetType := asset.AssetType()
+		positions[assetType]++
+		asset.SetSequence(uint8(positions[assetType]))
+
+		if item.Cover {
+			if !mediaprocessingmodel.SupportsGalleryCover(assetType) {
+				return nil, derrors.Validation("only photos can be used as cover", map[string]any{"asset": field})
+			}
+			if asset.Status() != mediaprocessingmodel.MediaAssetStatusProcessed {
+				return nil, derrors.Validation("cover photo must be processed", map[string]any{"asset": field})
+			}
+			if previous, exists := covers[assetType]; exists {
+				return nil, derrors.Validation("only one cover per orientation is allowed", map[string]any{"assetType": assetType, "assets": []uint64{previous, item.AssetID}})
+			}
+			covers[assetType] = item.AssetID
+		}
+
+		placement := mediaprocessingmodel.GalleryPlacement{Cover: item.Cover, RoomTag: item.RoomTag}
+		if item.RoomTag != "" {
+			if !item.RoomTag.IsValid() {
+				return nil, derrors.Validation("invalid room tag", map[string]any{"asset": field, "roomTag": item.RoomTag})
+			}
+			if !mediaprocessingmodel.SupportsRoomTag(assetType) {
+				return nil, derrors.Validation("room tags are only supported on photos", map[string]any{"asset": field})
+			}
+		}
+		if item.RoomTag == mediaprocessingmodel.MediaRoomTagFeature {
+			label, ok := features[item.RoomFeatureID]
+			if !ok {
+				return nil, derrors.Validation("roomFeatureId must be one of the listing features", map[string]any{"asset": field, "roomFeatureId": item.RoomFeatureID})
+			}
+			placement.RoomFeatureID = item.RoomFeatureID
+			placement.RoomLabel = label
+		} else if item.RoomFeatureID != 0 {
+			return nil, derrors.Validation("roomFeatureId requires roomTag FEATURE", map[string]any{"asset": field})
+		}
+
+		metadata := assetMetadataMap(asset)
+		placement.Apply(metadata)
+		payload, err := json.Marshal(metadata)
+		if err != nil {
+			return nil, derrors.Infra("failed to encode asset metadata", err)
+		}
+		asset.SetMetadata(string(payload))
+		arranged = append(arranged, asset)
+	}
+
+	for assetType, count := range positions {
+		if count != typeCounts[assetType] {
+			return nil, derrors.Validation(
+				"items must list every asset of each arranged type",
+				map[string]any{"assetType": assetType, "expected": typeCounts[assetType], "received": count},
+			)
+		}
+	}
+	return arranged, nil
+}
+
+// galleryPlacementFromAsset exposes the cover flag and room tag stored in the asset metadata.
+func galleryPlacementFromAsset(asset mediaprocessingmodel.MediaAsset) mediaprocessingmodel.GalleryPlacement {
+	return mediaprocessingmodel.GalleryPlacementFromMetadata(assetMetadataMap(asset))
+}
+
+// listingFeatureLabels maps the base feature IDs present in the listing to their names.
+func (s *mediaProcessingService) listingFeatureLabels(ctx context.Context, tx *sql.Tx, features []listingmodel.FeatureInterface) (map[int64]string, error) {
+	ids := make([]int64, 0, len(features))
+	for _, feature := range features {
+		ids = append(ids, feature.FeatureID())
+	}
+	labels := make(map[int64]string, len(ids))
+	if len(ids) == 0 {
+		return labels, nil
+	}
+
+	baseFeatures, err := s.listingRepo.GetBaseFeaturesByIDs(ctx, tx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for id, base := range baseFeatures {
+		labels[id] = base.Feature()
+	}
+	return labels, nil
+}
+
+// splitDiscardedAssets separates discarded assets, which are hidden from the gallery.
+func splitDiscardedAssets(assets []mediaprocessingmodel.MediaAsset) (visible, discarded []mediaprocessingmodel.MediaAsset) {
+	visible = make([]mediaprocessingmodel.MediaAsset, 0, len(assets))
+	for _, asset := range assets {
+		if asset.Status() == mediaprocessingmodel.MediaAssetStatusDiscarded {
+			discarded = append(discarded, asset)
+			continue
+		}
+		visible = append(visible, asset)
+	}
+	return visible, discarded
+}
+
+// appendDiscardedAssets moves the discarded assets of the arranged types after the visible ones,
+// so the new sequences never collide with a hidden asset.
+func appendDiscardedAssets(arranged, discarded []mediaprocessingmodel.MediaAsset) []mediaprocessingmodel.MediaAsset {
+	last := make(map[mediaprocessingmodel.MediaAssetType]uint8)
+	for _, asset := range arranged {
+		if asset.Sequence() > last[asset.AssetType()] {
+			last[asset.AssetType()] = asset.Sequence()
+		}
+	}
+	for _, asset := range discarded {
+		position, touched := last[asset.AssetType()]
+		if !touched {
+			continue
+		}
+		position++
+		last[asset.AssetType()] = position
+		asset.SetSequence(position)
+		arranged = append(arranged, asset)
+	}
+	return arranged
+}
@@ -12,13 +12,8 @@ import (
 func buildFinalizationInput(ctx context.Context, jobID uint64, listingID uint64, assets []mediaprocessingmodel.MediaAsset) mediaprocessingmodel.MediaFinalizationInput {
 	jobAssets := make([]mediaprocessingmodel.JobAsset, 0, len(assets))
 	for _, asset := range assets {
-		rawKey := asset.S3KeyRaw()
-		if rawKey == "" {
-			rawKey = asset.S3KeyProcessed()
-		}
-
 		jobAssets = append(jobAssets, mediaprocessingmodel.JobAsset{
-			Key:  rawKey,
+			Key:  finalizationSourceKey(asset),
 			Type: string(asset.AssetType()),
 		})
 	}
@@ -31,6 +26,14 @@ func buildFinalizationInput(ctx context.Context, jobID uint64, listingID uint64,
 	}
 }
 
+// finalizationSourceKey is the object zipped for an asset: the original upload when available.
+func finalizationSourceKey(asset mediaprocessingmodel.MediaAsset) string {
+	if rawKey := asset.S3KeyRaw(); rawKey != "" {
+		return rawKey
+	}
+	return asset.S3KeyProcessed()
+}
+
 func traceparentFromContext(ctx context.Context) string {
 	spanCtx := trace.SpanFromContext(ctx).SpanContext()
 	if !spanCtx.IsValid() {
@@ -0,0 +1,111 @@
+package mediaprocessingservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	"github.com/projeto-toq/toq_server/internal/core/domain/dto"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	"github.com/projeto-toq/toq_server/internal/core/port/right/workflow"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// CancelProcessingJob stops the execution of an unfinished job and closes it as CANCELLED.
+//
+// Step Functions processing executions are named after the job, so a job still waiting for its
+// callback is stopped through the ARN built from the processing state machine; LOCAL jobs record
+// their execution when enqueued. An execution that was not started yet cannot be stopped, but its
+// late callback is ignored. Assets of the job left
+// PROCESSING are marked FAILED, ready to be retried; other jobs of the listing are not touched.
+func (s *mediaProcessingService) CancelProcessingJob(ctx context.Context, input dto.CancelProcessingJobInput) error {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return derrors.Infra("failed to create tracer", err)
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	if input.JobID == 0 {
+		return derrors.Validation("jobId must be greater than zero", map[string]any{"jobId": "required"})
+	}
+
+	tx, txErr := s.globalService.StartTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("service.media.jobs.cancel.tx_start_error", "err", txErr, "job_id", input.JobID)
+		return derrors.Infra("failed to start transaction", txErr)
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+				utils.SetSpanError(ctx, rbErr)
+				logger.Error("service.media.jobs.cancel.tx_rollback_error", "err", rbErr, "job_id", input.JobID)
+			}
+		}
+	}()
+
+	job, err := s.loadProcessingJob(ctx, tx, input.JobID)
+	if err != nil {
+		return err
+	}
+	if job.Status().IsTerminal() {
+		return derrors.Conflict("job already finished", derrors.WithDetails(map[string]any{"status": job.Status()}))
+	}
+
+	cause := fmt.Sprintf("cancelled by admin %d", input.RequestedBy)
+	if reason := strings.TrimSpace(input.Reason); reason != "" {
+		cause = fmt.Sprintf("%s: %s", cause, reason)
+	}
+
+	executionARN := job.ExternalID()
+	if executionARN == "" && job.Provider() == mediaprocessingmodel.MediaProcessingProviderStepFunctions {
+		executionARN = mediaprocessingmodel.ExecutionARN(s.cfg.ProcessingStateMachineARN,
+			mediaprocessingmodel.ProcessingExecutionName(job.ListingIdentityID(), job.ID()))
+	}
+	if executionARN != "" {
+		if stopErr := s.workflow.StopExecution(ctx, executionARN, cause); stopErr != nil {
+			if !errors.Is(stopErr, workflow.ErrExecutionNotFound) {
+				utils.SetSpanError(ctx, stopErr)
+				logger.Error("service.media.jobs.cancel.stop_error", "err", stopErr, "job_id", job.ID(), "execution_arn", executionARN)
+				return derrors.Infra("failed to stop workflow execution", stopErr)
+			}
+			logger.Warn("service.media.jobs.cancel.execution_not_found", "job_id", job.ID(), "execution_arn", executionARN)
+		}
+	}
+
+	job.MarkCompleted(mediaprocessingmodel.MediaProcessingJobStatusCancelled, job.Payload(), s.nowUTC())
+	job.AppendError(cause)
+	if err := s.repo.UpdateProcessingJob(ctx, tx, job); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.jobs.cancel.update_job_error", "err", err, "job_id", job.ID())
+		return derrors.Infra("failed to update job", err)
+	}
+
+	if job.Provider().IsProcessing() {
+		if len(job.AssetIDs()) == 0 {
+			logger.Warn("service.media.jobs.cancel.asset_ids_missing", "job_id", job.ID(), "listing_identity_id", job.ListingIdentityID())
+		}
+		if err := s.repo.UpdateAssetsStatusByIDs(ctx, tx, job.ListingIdentityID(), job.AssetIDs(), mediaprocessingmodel.MediaAssetStatusProcessing, mediaprocessingmodel.MediaAssetStatusFailed); err != nil {
+			utils.SetSpanError(ctx, err)
+			logger.Error("service.media.jobs.cancel.fail_assets_error", "err", err, "job_id", job.ID(), "listing_identity_id", job.ListingIdentityID())
+			return derrors.Infra("failed to update asset status", err)
+		}
+	}
+
+	if err := s.globalService.CommitTransaction(ctx, tx); err != nil {
+		utils.SetSpanError(ctx, err)
+		return derrors.Infra("failed to commit cancellation", err)
+	}
+	committed = true
+
+	logger.Warn("service.media.jobs.cancel.completed", "job_id", job.ID(), "provider", job.Provider(),
+		"execution_arn", job.ExternalID(), "requested_by", input.RequestedBy)
+	return nil
+}
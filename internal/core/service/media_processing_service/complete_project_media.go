@@ -94,6 +94,8 @@ func (s *mediaProcessingService) CompleteProjectMedia(ctx context.Context, input
 		}
 
 		switch status {
+		case mediaprocessingmodel.MediaAssetStatusDiscarded:
+			continue
 		case mediaprocessingmodel.MediaAssetStatusPendingUpload:
 			return derrors.Conflict("project asset is still pending upload", derrors.WithDetails(map[string]any{"assetType": asset.AssetType(), "sequence": asset.Sequence()}))
 		case mediaprocessingmodel.MediaAssetStatusFailed:
@@ -48,26 +48,48 @@ func (s *mediaProcessingService) DeleteMedia(ctx context.Context, input dto.Dele
 		return derrors.Infra("failed to get asset", err)
 	}
 
+	if err := s.purgeAsset(ctx, tx, asset); err != nil {
+		return err
+	}
+
+	if err := s.globalService.CommitTransaction(ctx, tx); err != nil {
+		utils.SetSpanError(ctx, err)
+		return derrors.Infra("failed to commit transaction", err)
+	}
+	committed = true
+
+	return nil
+}
+
+// purgeAsset removes the S3 objects of an asset, detaches it from the tour and parcel, then deletes
+// its row.
+func (s *mediaProcessingService) purgeAsset(ctx context.Context, tx *sql.Tx, asset mediaprocessingmodel.MediaAsset) error {
+	listingIdentityID := asset.ListingIdentityID()
 	keys := collectDeletionKeys(asset)
+	keys = append(keys, s.hlsSegmentKeys(ctx, asset)...)
+	keys = dedupeDeletionKeys(append(keys, panoramaTileKeys(asset)...))
 	if len(keys) > 0 {
 		if err := s.storage.DeleteKeys(ctx, keys); err != nil {
 			utils.SetSpanError(ctx, err)
-			utils.LoggerFromContext(ctx).Error("service.media.delete.s3_error", "err", err, "listing_identity_id", input.ListingIdentityID, "asset_type", input.AssetType, "sequence", input.Sequence)
+			utils.LoggerFromContext(ctx).Error("service.media.delete.s3_error", "err", err, "listing_identity_id", listingIdentityID, "asset_type", asset.AssetType(), "sequence", asset.Sequence())
 			return err
 		}
 	}
 
-	if err := s.repo.DeleteAsset(ctx, tx, uint64(input.ListingIdentityID), input.AssetType, input.Sequence); err != nil {
+	if err := s.removeFromTour(ctx, tx, asset); err != nil {
 		utils.SetSpanError(ctx, err)
-		return derrors.Infra("failed to delete asset from db", err)
+		return derrors.Infra("failed to update tour", err)
 	}
 
-	if err := s.globalService.CommitTransaction(ctx, tx); err != nil {
+	if err := s.removeParcel(ctx, tx, asset); err != nil {
 		utils.SetSpanError(ctx, err)
-		return derrors.Infra("failed to commit transaction", err)
+		return derrors.Infra("failed to remove parcel", err)
 	}
-	committed = true
 
+	if err := s.repo.DeleteAsset(ctx, tx, listingIdentityID, asset.AssetType(), asset.Sequence()); err != nil {
+		utils.SetSpanError(ctx, err)
+		return derrors.Infra("failed to delete asset from db", err)
+	}
 	return nil
 }
 
@@ -0,0 +1,108 @@
+package mediaprocessingservice
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	"github.com/projeto-toq/toq_server/internal/core/domain/dto"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// hiddenAssetStatuses are left out of the media listing and gallery.
+var hiddenAssetStatuses = []mediaprocessingmodel.MediaAssetStatus{mediaprocessingmodel.MediaAssetStatusDiscarded}
+
+// ForceCompleteProcessingJob accepts a PARTIAL_SUCCESS job as it is: its failed assets are marked
+// DISCARDED, which hides them from the gallery and the finalization while keeping their raw
+// uploads, and the job is closed as SUCCEEDED.
+func (s *mediaProcessingService) ForceCompleteProcessingJob(ctx context.Context, input dto.ForceCompleteProcessingJobInput) (dto.ForceCompleteProcessingJobOutput, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return dto.ForceCompleteProcessingJobOutput{}, derrors.Infra("failed to create tracer", err)
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	if input.JobID == 0 {
+		return dto.ForceCompleteProcessingJobOutput{}, derrors.Validation("jobId must be greater than zero", map[string]any{"jobId": "required"})
+	}
+
+	tx, txErr := s.globalService.StartTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("service.media.jobs.force_complete.tx_start_error", "err", txErr, "job_id", input.JobID)
+		return dto.ForceCompleteProcessingJobOutput{}, derrors.Infra("failed to start transaction", txErr)
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+				utils.SetSpanError(ctx, rbErr)
+				logger.Error("service.media.jobs.force_complete.tx_rollback_error", "err", rbErr, "job_id", input.JobID)
+			}
+		}
+	}()
+
+	job, err := s.loadProcessingJob(ctx, tx, input.JobID)
+	if err != nil {
+		return dto.ForceCompleteProcessingJobOutput{}, err
+	}
+	if !job.Provider().IsProcessing() || job.Status() != mediaprocessingmodel.MediaProcessingJobStatusPartial {
+		return dto.ForceCompleteProcessingJobOutput{}, derrors.Conflict("only PARTIAL_SUCCESS processing jobs can be force-completed",
+			derrors.WithDetails(map[string]any{"status": job.Status(), "provider": job.Provider()}))
+	}
+
+	failed, err := s.failedJobAssets(ctx, tx, job)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.jobs.force_complete.list_failed_error", "err", err, "job_id", job.ID())
+		return dto.ForceCompleteProcessingJobOutput{}, derrors.Infra("failed to list failed assets", err)
+	}
+
+	output := dto.ForceCompleteProcessingJobOutput{DiscardedAssetIDs: make([]uint64, 0, len(failed))}
+	for _, asset := range failed {
+		if err := s.discardAsset(ctx, tx, asset); err != nil {
+			utils.SetSpanError(ctx, err)
+			logger.Error("service.media.jobs.force_complete.discard_asset_error", "err", err, "job_id", job.ID(), "asset_id", asset.ID())
+			return dto.ForceCompleteProcessingJobOutput{}, derrors.Infra("failed to discard asset", err)
+		}
+		output.DiscardedAssetIDs = append(output.DiscardedAssetIDs, asset.ID())
+	}
+
+	completedAt := s.nowUTC()
+	if job.CompletedAt() != nil {
+		completedAt = *job.CompletedAt()
+	}
+	job.MarkCompleted(mediaprocessingmodel.MediaProcessingJobStatusSucceeded, job.Payload(), completedAt)
+	job.AppendError(fmt.Sprintf("force-completed by admin %d: discarded %d failed assets", input.RequestedBy, len(failed)))
+	if err := s.repo.UpdateProcessingJob(ctx, tx, job); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.jobs.force_complete.update_job_error", "err", err, "job_id", job.ID())
+		return dto.ForceCompleteProcessingJobOutput{}, derrors.Infra("failed to update job", err)
+	}
+
+	if err := s.globalService.CommitTransaction(ctx, tx); err != nil {
+		utils.SetSpanError(ctx, err)
+		return dto.ForceCompleteProcessingJobOutput{}, derrors.Infra("failed to commit force completion", err)
+	}
+	committed = true
+
+	logger.Warn("service.media.jobs.force_complete.completed", "job_id", job.ID(), "listing_identity_id", job.ListingIdentityID(),
+		"discarded_assets", len(failed), "requested_by", input.RequestedBy)
+	return output, nil
+}
+
+// discardAsset hides a failed asset without touching its stored objects; a panorama also leaves
+// the tour.
+func (s *mediaProcessingService) discardAsset(ctx context.Context, tx *sql.Tx, asset mediaprocessingmodel.MediaAsset) error {
+	if err := s.removeFromTour(ctx, tx, asset); err != nil {
+		return err
+	}
+	asset.SetStatus(mediaprocessingmodel.MediaAssetStatusDiscarded)
+	return s.repo.UpsertAsset(ctx, tx, asset)
+}
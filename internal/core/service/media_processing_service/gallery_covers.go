@@ -0,0 +1,67 @@
+package mediaprocessingservice
+
+import (
+	"context"
+
+	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	"github.com/projeto-toq/toq_server/internal/core/domain/dto"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ListGalleryCovers returns the cover photos picked by ArrangeGallery for each listing, with
+// signed URLs for the processed image and its responsive variants.
+func (s *mediaProcessingService) ListGalleryCovers(ctx context.Context, listingIdentityIDs []int64) (map[int64][]dto.MediaGalleryCover, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, derrors.Infra("failed to create tracer", err)
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	covers := make(map[int64][]dto.MediaGalleryCover)
+	ids := make([]uint64, 0, len(listingIdentityIDs))
+	for _, id := range listingIdentityIDs {
+		if id > 0 {
+			ids = append(ids, uint64(id))
+		}
+	}
+	if len(ids) == 0 {
+		return covers, nil
+	}
+
+	tx, txErr := s.globalService.StartReadOnlyTransaction(ctx)
+	if txErr != nil {
+		return nil, derrors.Infra("failed to start transaction", txErr)
+	}
+	defer func() { _ = s.globalService.RollbackTransaction(ctx, tx) }()
+
+	assets, err := s.repo.ListGalleryCovers(ctx, tx, ids)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.gallery.list_covers_error", "err", err, "listings", len(ids))
+		return nil, derrors.Infra("failed to list gallery covers", err)
+	}
+
+	for _, asset := range assets {
+		cover := dto.MediaGalleryCover{
+			AssetID:   asset.ID(),
+			AssetType: asset.AssetType(),
+			Title:     asset.Title(),
+			Placement: galleryPlacementFromAsset(asset),
+			SrcSets:   s.buildImageSrcSets(ctx, asset),
+		}
+		if key := asset.S3KeyProcessed(); key != "" {
+			signed, signErr := s.storage.GenerateDownloadURL(ctx, key)
+			if signErr != nil {
+				logger.Warn("service.media.gallery.cover_sign_failed", "asset_id", asset.ID(), "key", key, "error", signErr)
+			} else {
+				cover.URL = signed.URL
+			}
+		}
+		listingID := int64(asset.ListingIdentityID())
+		covers[listingID] = append(covers[listingID], cover)
+	}
+	return covers, nil
+}
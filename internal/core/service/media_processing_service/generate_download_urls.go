@@ -2,6 +2,7 @@ package mediaprocessingservice
 
 import (
 	"context"
+	"strings"
 
 	"github.com/projeto-toq/toq_server/internal/core/derrors"
 	"github.com/projeto-toq/toq_server/internal/core/domain/dto"
@@ -98,6 +99,27 @@ func (s *mediaProcessingService) GenerateDownloadURLs(ctx context.Context, input
 		}
 
 		var signedURL storageport.SignedURL
+		// servedFormat reports the variant actually signed; empty for raw files, originals and videos.
+		servedFormat := req.Format
+		if servedFormat == "" {
+			servedFormat = mediaprocessingmodel.ImageVariantFormatJPEG
+		}
+
+		// Adaptive streaming: "hls" returns the signed master playlist, "poster" its cover frame.
+		if req.Resolution == hlsResolution || req.Resolution == posterResolution {
+			url, expiresIn, ok := s.streamingURL(ctx, asset, req.Resolution)
+			if !ok {
+				continue
+			}
+			output.Urls = append(output.Urls, dto.DownloadURLOutput{
+				AssetType:  asset.AssetType(),
+				Sequence:   asset.Sequence(),
+				Resolution: req.Resolution,
+				Url:        url,
+				ExpiresIn:  expiresIn,
+			})
+			continue
+		}
 
 		// 2. Determine if we can serve the file
 		// If resolution is "original" and status is NOT processed, we try to serve the raw file.
@@ -109,6 +131,7 @@ func (s *mediaProcessingService) GenerateDownloadURLs(ctx context.Context, input
 					"sequence", req.Sequence)
 				continue
 			}
+			servedFormat = "" // raw upload, served as-is
 			signedURL, err = s.storage.GenerateDownloadURL(ctx, asset.S3KeyRaw())
 		} else {
 			// Standard flow: must be processed OR processing (for incremental feedback)
@@ -122,7 +145,22 @@ func (s *mediaProcessingService) GenerateDownloadURLs(ctx context.Context, input
 					"expected_status", "PROCESSED or PROCESSING")
 				continue // Skip if PENDING_UPLOAD or FAILED
 			}
-			signedURL, err = s.storage.GenerateProcessedDownloadURL(ctx, uint64(input.ListingIdentityID), asset, req.Resolution)
+			// Alternative formats are only served when the pipeline generated them; otherwise JPEG.
+			key, ok := imageVariantKeys(asset)[strings.ToLower(req.Resolution)][servedFormat]
+			switch {
+			case ok && servedFormat != mediaprocessingmodel.ImageVariantFormatJPEG:
+				signedURL, err = s.storage.GenerateDownloadURL(ctx, key)
+			case ok:
+				signedURL, err = s.storage.GenerateProcessedDownloadURL(ctx, uint64(input.ListingIdentityID), asset, req.Resolution)
+			default:
+				// Videos, originals and photos processed before multi-format support.
+				if _, sized := mediaprocessingmodel.ImageVariantWidth(req.Resolution); sized && isPhotoAsset(asset.AssetType()) {
+					servedFormat = mediaprocessingmodel.ImageVariantFormatJPEG
+				} else {
+					servedFormat = ""
+				}
+				signedURL, err = s.storage.GenerateProcessedDownloadURL(ctx, uint64(input.ListingIdentityID), asset, req.Resolution)
+			}
 		}
 
 		if err != nil {
@@ -139,6 +177,7 @@ func (s *mediaProcessingService) GenerateDownloadURLs(ctx context.Context, input
 			AssetType:  asset.AssetType(),
 			Sequence:   asset.Sequence(),
 			Resolution: req.Resolution,
+			Format:     servedFormat,
 			Url:        signedURL.URL,
 			ExpiresIn:  int(signedURL.ExpiresIn.Seconds()),
 		})
@@ -146,3 +185,40 @@ func (s *mediaProcessingService) GenerateDownloadURLs(ctx context.Context, input
 
 	return output, nil
 }
+
+const (
+	hlsResolution    = "hls"
+	posterResolution = "poster"
+)
+
+// streamingURL resolves the HLS master or poster of a processed video; ok is false when the
+// asset has no HLS outputs (not a video, still processing or transcoded before HLS existed).
+func (s *mediaProcessingService) streamingURL(ctx context.Context, asset mediaprocessingmodel.MediaAsset, resolution string) (string, int, bool) {
+	logger := utils.LoggerFromContext(ctx)
+	metadata := assetMetadataMap(asset)
+
+	if resolution == posterResolution {
+		posterKey := metadata[mediaprocessingmodel.HLSPosterMetadataKey]
+		if posterKey == "" {
+			logger.Warn("service.media.generate_urls.poster_missing", "asset_id", asset.ID())
+			return "", 0, false
+		}
+		signedURL, err := s.storage.GenerateDownloadURL(ctx, posterKey)
+		if err != nil {
+			logger.Error("service.media.generate_urls.poster_failed", "asset_id", asset.ID(), "error", err)
+			return "", 0, false
+		}
+		return signedURL.URL, int(signedURL.ExpiresIn.Seconds()), true
+	}
+
+	if metadata[mediaprocessingmodel.HLSMasterMetadataKey] == "" {
+		logger.Warn("service.media.generate_urls.hls_missing", "asset_id", asset.ID())
+		return "", 0, false
+	}
+	url, err := s.hlsPlaylistURL(asset.ID())
+	if err != nil {
+		logger.Error("service.media.generate_urls.hls_failed", "asset_id", asset.ID(), "error", err)
+		return "", 0, false
+	}
+	return url, int(s.cfg.HLSTokenTTL.Seconds()), true
+}
@@ -25,6 +25,14 @@ func (s *mediaProcessingService) HandleProcessingCallback(ctx context.Context, i
 	logger := utils.LoggerFromContext(ctx)
 	logger.Info("service.media.callback.received", "job_id", input.JobID, "status", input.Status)
 
+	// Verifying a zip bundle reads the whole archive from storage, so it runs before the
+	// transaction is opened; only the processed-asset check below needs the database.
+	var zipArchived map[string]bool
+	var zipArchiveErr error
+	if input.ZipManifestKey != "" && (input.Status == "SUCCEEDED" || input.Status == "PARTIAL_SUCCESS") {
+		zipArchived, zipArchiveErr = s.verifyZipArchive(ctx, input)
+	}
+
 	tx, txErr := s.globalService.StartTransaction(ctx)
 	if txErr != nil {
 		utils.SetSpanError(ctx, txErr)
@@ -53,6 +61,11 @@ func (s *mediaProcessingService) HandleProcessingCallback(ctx context.Context, i
 		return dto.HandleProcessingCallbackOutput{}, derrors.Infra("failed to get job", err)
 	}
 
+	if job.Status() == mediaprocessingmodel.MediaProcessingJobStatusCancelled {
+		logger.Warn("service.media.callback.job_cancelled", "job_id", input.JobID, "status", input.Status)
+		return dto.HandleProcessingCallbackOutput{Success: true}, nil
+	}
+
 	if input.ListingIdentityID != 0 && job.ListingIdentityID() != input.ListingIdentityID {
 		logger.Warn("service.media.callback.listing_mismatch", "job_id", input.JobID, "job_listing", job.ListingIdentityID(), "payload_listing", input.ListingIdentityID)
 	}
@@ -84,6 +97,7 @@ func (s *mediaProcessingService) HandleProcessingCallback(ctx context.Context, i
 		jobStatus = mediaprocessingmodel.MediaProcessingJobStatusRunning
 	}
 
+	zipVerificationFailed := false
 	if jobStatus.IsTerminal() {
 		payload := mediaprocessingmodel.MediaProcessingJobPayload{}
 		isSuccess := jobStatus == mediaprocessingmodel.MediaProcessingJobStatusSucceeded || jobStatus == mediaprocessingmodel.MediaProcessingJobStatusPartial
@@ -91,6 +105,24 @@ func (s *mediaProcessingService) HandleProcessingCallback(ctx context.Context, i
 
 		if isSuccess && (job.Provider() == mediaprocessingmodel.MediaProcessingProviderStepFunctionsFinalization || zipDataPresent) {
 			job.ApplyFinalizationPayload(input.ZipBundles, input.AssetsZipped, input.ZipSizeBytes, input.UnzippedSizeBytes)
+			if input.ZipManifestKey != "" {
+				verifyErr := zipArchiveErr
+				if verifyErr == nil {
+					verifyErr = s.verifyZipAssets(ctx, tx, job.ListingIdentityID(), zipArchived)
+				}
+				if verifyErr != nil {
+					logger.Error("service.media.callback.zip_verification_failed", "job_id", input.JobID, "manifest_key", input.ZipManifestKey, "err", verifyErr)
+					if input.ErrorCode == "" {
+						input.ErrorCode = zipVerificationFailedCode
+					}
+					input.Error = verifyErr.Error()
+					// A bundle that does not match what the pipeline reported is never exposed and the job fails,
+					// so the owner can finalize again.
+					jobStatus = mediaprocessingmodel.MediaProcessingJobStatusFailed
+					zipVerificationFailed = true
+				}
+				job.ApplyZipIntegrity(input.ZipManifestKey, input.ZipSHA256, verifyErr == nil)
+			}
 			payload = job.Payload()
 		}
 
@@ -124,7 +156,8 @@ func (s *mediaProcessingService) HandleProcessingCallback(ctx context.Context, i
 
 	// FAIL-SAFE: If job failed globally, mark all associated assets as FAILED
 	// This prevents assets from being stuck in PROCESSING forever.
-	if jobStatus == mediaprocessingmodel.MediaProcessingJobStatusFailed && len(input.Results) == 0 {
+	// A failed zip verification concerns the bundle only; the listing assets stay as they are.
+	if jobStatus == mediaprocessingmodel.MediaProcessingJobStatusFailed && len(input.Results) == 0 && !zipVerificationFailed {
 		if err := s.repo.BulkUpdateAssetStatus(ctx, tx, job.ListingIdentityID(), mediaprocessingmodel.MediaAssetStatusProcessing, mediaprocessingmodel.MediaAssetStatusFailed); err != nil {
 			utils.SetSpanError(ctx, err)
 			logger.Error("service.media.callback.bulk_fail_assets_error", "err", err, "listing_identity_id", job.ListingIdentityID())
@@ -133,6 +166,7 @@ func (s *mediaProcessingService) HandleProcessingCallback(ctx context.Context, i
 
 	failedCount := 0
 	errorCodeHistogram := make(map[string]int)
+	peers := &qualityPeers{}
 
 	// Update assets based on results
 	for _, result := range input.Results {
@@ -163,6 +197,7 @@ func (s *mediaProcessingService) HandleProcessingCallback(ctx context.Context, i
 		if updatedAsset.Status() == mediaprocessingmodel.MediaAssetStatusFailed && !resultFailed {
 			failedCount++
 		}
+		updatedAsset = s.applyQualityGate(ctx, tx, updatedAsset, peers)
 
 		if err := s.repo.UpsertAsset(ctx, tx, updatedAsset); err != nil {
 			utils.SetSpanError(ctx, err)
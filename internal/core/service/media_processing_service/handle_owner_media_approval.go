@@ -85,6 +85,10 @@ func (s *mediaProcessingService) HandleOwnerMediaApproval(
 		return dto.ListingMediaApprovalOutput{}, derrors.Infra("failed to update listing status", err)
 	}
 
+	if err := s.recordOwnerMediaDecision(ctx, tx, input.ListingIdentityID, input.Approve); err != nil {
+		return dto.ListingMediaApprovalOutput{}, err
+	}
+
 	if err := s.globalService.CommitTransaction(ctx, tx); err != nil {
 		utils.SetSpanError(ctx, err)
 		logger.Error("service.media.owner_approval.commit_error", "err", err, "listing_identity_id", input.ListingIdentityID)
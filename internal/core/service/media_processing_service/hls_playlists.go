@@ -0,0 +1,214 @@
+package mediaprocessingservice
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	"github.com/projeto-toq/toq_server/internal/core/domain/dto"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// HLS objects stay private in the bucket. Players cannot sign each segment request, so the
+// backend serves the playlists itself: the master and media playlists are reachable through a
+// short-lived HMAC token ({assetId}.{expiresUnix}.{signature}) embedded in the URL path, and every
+// segment line is rewritten into a pre-signed storage URL at request time. Rendition links in the
+// master are relative ("720p.m3u8"), so they inherit the token from the master URL.
+
+// GetHLSPlaylist validates the token and returns the requested playlist with signed segment URLs.
+func (s *mediaProcessingService) GetHLSPlaylist(ctx context.Context, input dto.GetHLSPlaylistInput) (dto.GetHLSPlaylistOutput, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return dto.GetHLSPlaylistOutput{}, derrors.Infra("failed to create tracer", err)
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	assetID, expiresAt, err := s.verifyStreamToken(streamTokenScopeHLS, input.Token)
+	if err != nil {
+		return dto.GetHLSPlaylistOutput{}, err
+	}
+
+	tx, txErr := s.globalService.StartTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		return dto.GetHLSPlaylistOutput{}, derrors.Infra("failed to start transaction", txErr)
+	}
+	defer func() { _ = s.globalService.RollbackTransaction(ctx, tx) }()
+
+	asset, err := s.repo.GetAssetByID(ctx, tx, assetID)
+	if err != nil {
+		logger.Warn("service.media.hls.asset_not_found", "asset_id", assetID, "error", err)
+		return dto.GetHLSPlaylistOutput{}, derrors.NotFound("stream not found")
+	}
+
+	metadata := assetMetadataMap(asset)
+	playlist := strings.ToLower(strings.TrimSpace(input.Playlist))
+
+	var key string
+	isMaster := playlist == mediaprocessingmodel.HLSMasterPlaylistName
+	if isMaster {
+		key = metadata[mediaprocessingmodel.HLSMasterMetadataKey]
+	} else {
+		key = metadata[mediaprocessingmodel.HLSRenditionMetadataKey(strings.TrimSuffix(playlist, ".m3u8"))]
+	}
+	if key == "" {
+		return dto.GetHLSPlaylistOutput{}, derrors.NotFound("stream not found")
+	}
+
+	raw, err := s.storage.DownloadFile(ctx, key)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.hls.download_failed", "asset_id", assetID, "key", key, "error", err)
+		return dto.GetHLSPlaylistOutput{}, derrors.Infra("failed to load playlist", err)
+	}
+
+	var content string
+	if isMaster {
+		content, err = rewriteMasterPlaylist(string(raw))
+	} else {
+		content, err = s.rewriteMediaPlaylist(ctx, key, string(raw))
+	}
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.hls.rewrite_failed", "asset_id", assetID, "key", key, "error", err)
+		return dto.GetHLSPlaylistOutput{}, err
+	}
+
+	// Let players cache the playlist for a fraction of the token lifetime only.
+	maxAge := min(s.cfg.HLSTokenTTL/10, expiresAt.Sub(s.now()))
+	return dto.GetHLSPlaylistOutput{Content: content, MaxAge: max(maxAge, 0)}, nil
+}
+
+// hlsPlaylistURL builds the signed master playlist URL returned by GenerateDownloadURLs.
+func (s *mediaProcessingService) hlsPlaylistURL(assetID uint64) (string, error) {
+	token, _, err := s.newStreamToken(streamTokenScopeHLS, assetID)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/%s/%s", strings.TrimRight(s.cfg.HLSPlaylistBaseURL, "/"), token, mediaprocessingmodel.HLSMasterPlaylistName), nil
+}
+
+// Stream tokens are scoped so a token issued for one public endpoint cannot be replayed on another.
+const (
+	streamTokenScopeHLS      = "hls"
+	streamTokenScopePanorama = "panorama"
+)
+
+// newStreamToken issues a token for the asset valid for HLSTokenTTL.
+func (s *mediaProcessingService) newStreamToken(scope string, assetID uint64) (string, time.Time, error) {
+	if s.cfg.HLSTokenSecret == "" {
+		return "", time.Time{}, derrors.Infra("stream token secret not configured", nil)
+	}
+	expiresAt := s.now().Add(s.cfg.HLSTokenTTL).Truncate(time.Second)
+	token := fmt.Sprintf("%d.%d.%s", assetID, expiresAt.Unix(), s.signStreamToken(scope, assetID, expiresAt.Unix()))
+	return token, expiresAt, nil
+}
+
+func (s *mediaProcessingService) signStreamToken(scope string, assetID uint64, expiresAt int64) string {
+	mac := hmac.New(sha256.New, []byte(s.cfg.HLSTokenSecret))
+	fmt.Fprintf(mac, "%s:%d:%d", scope, assetID, expiresAt)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (s *mediaProcessingService) verifyStreamToken(scope, token string) (uint64, time.Time, error) {
+	if s.cfg.HLSTokenSecret == "" {
+		return 0, time.Time{}, derrors.NotFound("stream not found")
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return 0, time.Time{}, derrors.Forbidden("invalid stream token")
+	}
+	assetID, idErr := strconv.ParseUint(parts[0], 10, 64)
+	expiresUnix, expErr := strconv.ParseInt(parts[1], 10, 64)
+	if idErr != nil || expErr != nil {
+		return 0, time.Time{}, derrors.Forbidden("invalid stream token")
+	}
+	if !hmac.Equal([]byte(parts[2]), []byte(s.signStreamToken(scope, assetID, expiresUnix))) {
+		return 0, time.Time{}, derrors.Forbidden("invalid stream token")
+	}
+
+	expiresAt := time.Unix(expiresUnix, 0)
+	if !s.now().Before(expiresAt) {
+		return 0, time.Time{}, derrors.Forbidden("stream token expired")
+	}
+	return assetID, expiresAt, nil
+}
+
+// rewriteMasterPlaylist maps "{rendition}/index.m3u8" entries to "{rendition}.m3u8", which
+// resolves to this endpoint under the same token.
+func rewriteMasterPlaylist(raw string) (string, error) {
+	return rewritePlaylistURIs(raw, func(uri string) (string, error) {
+		return path.Dir(uri) + ".m3u8", nil
+	})
+}
+
+// rewriteMediaPlaylist replaces each relative segment URI with a pre-signed URL.
+func (s *mediaProcessingService) rewriteMediaPlaylist(ctx context.Context, playlistKey, raw string) (string, error) {
+	dir := path.Dir(playlistKey)
+	return rewritePlaylistURIs(raw, func(uri string) (string, error) {
+		signed, err := s.storage.GenerateDownloadURL(ctx, path.Join(dir, uri))
+		if err != nil {
+			return "", err
+		}
+		return signed.URL, nil
+	})
+}
+
+func rewritePlaylistURIs(raw string, rewrite func(uri string) (string, error)) (string, error) {
+	var b strings.Builder
+	scanner := bufio.NewScanner(strings.NewReader(raw))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") && !strings.Contains(line, "://") {
+			rewritten, err := rewrite(line)
+			if err != nil {
+				return "", err
+			}
+			line = rewritten
+		}
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+	if err := scanner.Err(); err != nil {
+		return "", derrors.Infra("failed to parse playlist", err)
+	}
+	return b.String(), nil
+}
+
+// hlsSegmentKeys lists the segments referenced by the rendition playlists so deleting a video
+// also removes them (playlists, master and poster are already part of the asset metadata).
+// Best effort: unreadable playlists are logged and their segments left behind.
+func (s *mediaProcessingService) hlsSegmentKeys(ctx context.Context, asset mediaprocessingmodel.MediaAsset) []string {
+	logger := utils.LoggerFromContext(ctx)
+
+	keys := make([]string, 0)
+	for name, playlistKey := range assetMetadataMap(asset) {
+		if !strings.HasPrefix(name, mediaprocessingmodel.HLSRenditionMetadataKeyPrefix) {
+			continue
+		}
+		raw, err := s.storage.DownloadFile(ctx, playlistKey)
+		if err != nil {
+			logger.Warn("service.media.hls.segments_unreadable", "asset_id", asset.ID(), "key", playlistKey, "error", err)
+			continue
+		}
+		dir := path.Dir(playlistKey)
+		_, _ = rewritePlaylistURIs(string(raw), func(uri string) (string, error) {
+			keys = append(keys, path.Join(dir, uri))
+			return uri, nil
+		})
+	}
+	return keys
+}
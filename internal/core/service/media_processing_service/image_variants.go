@@ -0,0 +1,96 @@
+package mediaprocessingservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/projeto-toq/toq_server/internal/core/domain/dto"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// imageVariantKeys indexes the processed image keys recorded in the asset metadata by
+// resolution and format. Only sizes known to the pipeline are considered.
+func imageVariantKeys(asset mediaprocessingmodel.MediaAsset) map[string]map[mediaprocessingmodel.ImageVariantFormat]string {
+	if asset.Metadata() == "" {
+		return nil
+	}
+
+	var metadata map[string]string
+	if err := json.Unmarshal([]byte(asset.Metadata()), &metadata); err != nil {
+		return nil
+	}
+
+	variants := make(map[string]map[mediaprocessingmodel.ImageVariantFormat]string)
+	for _, key := range metadata {
+		resolution := mediaprocessingmodel.ProcessedKeyResolution(key)
+		if _, ok := mediaprocessingmodel.ImageVariantWidth(resolution); !ok {
+			continue
+		}
+		resolution = strings.ToLower(resolution)
+		if variants[resolution] == nil {
+			variants[resolution] = make(map[mediaprocessingmodel.ImageVariantFormat]string)
+		}
+		variants[resolution][mediaprocessingmodel.ImageVariantFormatFromKey(key, asset.S3KeyRaw())] = key
+	}
+	return variants
+}
+
+// buildImageSrcSets signs every processed variant of a photo and groups them per format, in
+// client preference order, ready to be rendered as <picture><source srcset> entries.
+// Assets without recorded variants yield nil and clients fall back to the download endpoint.
+func (s *mediaProcessingService) buildImageSrcSets(ctx context.Context, asset mediaprocessingmodel.MediaAsset) []dto.MediaImageSrcSet {
+	if asset.Status() != mediaprocessingmodel.MediaAssetStatusProcessed || !isPhotoAsset(asset.AssetType()) {
+		return nil
+	}
+
+	variants := imageVariantKeys(asset)
+	if len(variants) == 0 {
+		return nil
+	}
+
+	logger := utils.LoggerFromContext(ctx)
+	srcSets := make([]dto.MediaImageSrcSet, 0, len(mediaprocessingmodel.ImageVariantFormats))
+	for _, format := range mediaprocessingmodel.ImageVariantFormats {
+		srcSet := dto.MediaImageSrcSet{Format: format, ContentType: format.ContentType()}
+		candidates := make([]string, 0, len(mediaprocessingmodel.ImageVariantSizes))
+
+		for _, size := range mediaprocessingmodel.ImageVariantSizes {
+			key, ok := variants[size.Name][format]
+			if !ok {
+				continue
+			}
+			signedURL, err := s.storage.GenerateDownloadURL(ctx, key)
+			if err != nil {
+				logger.Warn("service.media.srcset.sign_failed", "asset_id", asset.ID(), "key", key, "error", err)
+				continue
+			}
+			srcSet.Sources = append(srcSet.Sources, dto.MediaImageSource{
+				Resolution: size.Name,
+				Width:      size.Width,
+				URL:        signedURL.URL,
+			})
+			candidates = append(candidates, fmt.Sprintf("%s %dw", signedURL.URL, size.Width))
+		}
+
+		if len(srcSet.Sources) == 0 {
+			continue
+		}
+		srcSet.SrcSet = strings.Join(candidates, ", ")
+		srcSets = append(srcSets, srcSet)
+	}
+	return srcSets
+}
+
+func isPhotoAsset(assetType mediaprocessingmodel.MediaAssetType) bool {
+	switch assetType {
+	case mediaprocessingmodel.MediaAssetTypePhotoVertical,
+		mediaprocessingmodel.MediaAssetTypePhotoHorizontal,
+		mediaprocessingmodel.MediaAssetTypeProjectRender:
+		return true
+	default:
+		return false
+	}
+}
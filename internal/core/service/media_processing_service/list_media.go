@@ -30,7 +30,8 @@ func (s *mediaProcessingService) ListMedia(ctx context.Context, input dto.ListMe
 	defer func() { _ = s.globalService.RollbackTransaction(ctx, tx) }()
 
 	filter := mediaprocessingrepository.AssetFilter{
-		Sequence: input.Sequence,
+		Sequence:      input.Sequence,
+		ExcludeStatus: hiddenAssetStatuses,
 	}
 	if input.AssetType != "" {
 		filter.AssetTypes = []mediaprocessingmodel.MediaAssetType{mediaprocessingmodel.MediaAssetType(input.AssetType)}
@@ -58,12 +59,54 @@ func (s *mediaProcessingService) ListMedia(ctx context.Context, input dto.ListMe
 		return dto.ListMediaOutput{}, err
 	}
 
+	// The revision covers the whole gallery, not only the requested page.
+	galleryAssets, err := s.repo.ListAssets(ctx, tx, uint64(input.ListingIdentityID), mediaprocessingrepository.AssetFilter{ExcludeStatus: hiddenAssetStatuses}, nil)
+	if err != nil {
+		return dto.ListMediaOutput{}, derrors.Infra("failed to list gallery", err)
+	}
+
+	tour, err := s.loadTour(ctx, tx, uint64(input.ListingIdentityID))
+	if err != nil {
+		return dto.ListMediaOutput{}, derrors.Infra("failed to load tour", err)
+	}
+
+	parcel, err := s.loadParcel(ctx, tx, uint64(input.ListingIdentityID))
+	if err != nil {
+		return dto.ListMediaOutput{}, derrors.Infra("failed to load parcel", err)
+	}
+
+	srcSets := make(map[uint64][]dto.MediaImageSrcSet)
+	quality := make(map[uint64]dto.MediaPhotoQuality)
+	gallery := make(map[uint64]mediaprocessingmodel.GalleryPlacement)
+	panoramas := make(map[uint64]dto.MediaPanorama)
+	for _, asset := range assets {
+		if placement := galleryPlacementFromAsset(asset); placement.Cover || placement.RoomTag != "" {
+			gallery[asset.ID()] = placement
+		}
+		if sets := s.buildImageSrcSets(ctx, asset); len(sets) > 0 {
+			srcSets[asset.ID()] = sets
+		}
+		if report, ok := photoQualityFromAsset(asset); ok {
+			quality[asset.ID()] = report
+		}
+		if panorama, ok := s.buildPanorama(ctx, asset); ok {
+			panoramas[asset.ID()] = panorama
+		}
+	}
+
 	return dto.ListMediaOutput{
-		Assets:     assets,
-		TotalCount: count,
-		Page:       input.Page,
-		Limit:      input.Limit,
-		ZipBundle:  zipBundle,
+		Assets:          assets,
+		TotalCount:      count,
+		Page:            input.Page,
+		Limit:           input.Limit,
+		ZipBundle:       zipBundle,
+		SrcSets:         srcSets,
+		Quality:         quality,
+		Gallery:         gallery,
+		GalleryRevision: mediaprocessingmodel.GalleryRevision(galleryAssets),
+		Panoramas:       panoramas,
+		Tour:            tour,
+		Parcel:          parcel,
 	}, nil
 }
 
@@ -85,12 +128,18 @@ func (s *mediaProcessingService) latestZipBundle(ctx context.Context, tx *sql.Tx
 	if bundleKey == "" && payload.ZipSizeBytes == 0 && payload.UnzippedSizeBytes == 0 && payload.AssetsZipped == 0 {
 		return nil, nil
 	}
+	// Bundles produced with a manifest are only exposed once the callback verified them; older
+	// bundles (no manifest) keep being served as before.
+	if payload.ZipManifestKey != "" && !payload.ZipVerified {
+		return nil, nil
+	}
 
 	return &dto.ListMediaZipBundle{
 		BundleKey:               bundleKey,
 		AssetsCount:             payload.AssetsZipped,
 		ZipSizeBytes:            payload.ZipSizeBytes,
 		EstimatedExtractedBytes: payload.UnzippedSizeBytes,
+		SHA256:                  payload.ZipSHA256,
 		CompletedAt:             job.CompletedAt(),
 	}, nil
 }
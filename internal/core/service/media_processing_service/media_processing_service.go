@@ -2,6 +2,7 @@ package mediaprocessingservice
 
 import (
 	"context"
+	"database/sql"
 	"os"
 	"strings"
 	"time"
@@ -9,6 +10,7 @@ import (
 	derrors "github.com/projeto-toq/toq_server/internal/core/derrors"
 	"github.com/projeto-toq/toq_server/internal/core/domain/dto"
 	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
 	mediaprocessingqueue "github.com/projeto-toq/toq_server/internal/core/port/right/queue/mediaprocessingqueue"
 	listingrepository "github.com/projeto-toq/toq_server/internal/core/port/right/repository/listing_repository"
 	mediaprocessingrepository "github.com/projeto-toq/toq_server/internal/core/port/right/repository/media_processing_repository"
@@ -31,15 +33,41 @@ type MediaProcessingServiceInterface interface {
 
 	// Management
 	UpdateMedia(ctx context.Context, input dto.UpdateMediaInput) error
+	ArrangeGallery(ctx context.Context, input dto.ArrangeGalleryInput) (dto.ArrangeGalleryOutput, error)
+	// ListGalleryCovers returns the signed cover photos of each listing, keyed by listing identity.
+	ListGalleryCovers(ctx context.Context, listingIdentityIDs []int64) (map[int64][]dto.MediaGalleryCover, error)
+	// RecheckParcel repeats the parcel cross-check after the land measures of the active version
+	// change; joins the caller's transaction.
+	RecheckParcel(ctx context.Context, tx *sql.Tx, listingIdentityID int64) error
+	// SaveTour replaces the virtual tour linking the listing panoramas.
+	SaveTour(ctx context.Context, input dto.SaveMediaTourInput) (mediaprocessingmodel.MediaTour, error)
 	DeleteMedia(ctx context.Context, input dto.DeleteMediaInput) error
 	CompleteMedia(ctx context.Context, input dto.CompleteMediaInput) error
 	CompleteProjectMedia(ctx context.Context, input dto.CompleteMediaInput) error
 	HandleOwnerMediaApproval(ctx context.Context, input dto.ListingMediaApprovalInput) (dto.ListingMediaApprovalOutput, error)
 
+	// Streaming (public, token-signed)
+	GetHLSPlaylist(ctx context.Context, input dto.GetHLSPlaylistInput) (dto.GetHLSPlaylistOutput, error)
+	GetPanoramaTile(ctx context.Context, input dto.GetPanoramaTileInput) (dto.GetPanoramaTileOutput, error)
+
+	// Admin job dashboard
+	ListProcessingJobs(ctx context.Context, input dto.ListProcessingJobsInput) (dto.ListProcessingJobsOutput, error)
+	GetProcessingJob(ctx context.Context, input dto.GetProcessingJobInput) (dto.ProcessingJobDetailOutput, error)
+	RetryProcessingJob(ctx context.Context, input dto.RetryProcessingJobInput) (dto.RetryProcessingJobOutput, error)
+	CancelProcessingJob(ctx context.Context, input dto.CancelProcessingJobInput) error
+	ForceCompleteProcessingJob(ctx context.Context, input dto.ForceCompleteProcessingJobInput) (dto.ForceCompleteProcessingJobOutput, error)
+
 	// Legacy/Internal
 	HandleProcessingCallback(ctx context.Context, input dto.HandleProcessingCallbackInput) (dto.HandleProcessingCallbackOutput, error)
 }
 
+// SessionKPIRecorder receives the media milestones that feed the photographer KPIs.
+// Implemented by the photo session service; both calls join the caller's transaction.
+type SessionKPIRecorder interface {
+	RecordMediaUploadWithTx(ctx context.Context, tx *sql.Tx, listingIdentityID int64) error
+	RecordOwnerMediaDecisionWithTx(ctx context.Context, tx *sql.Tx, listingIdentityID int64, approved bool) error
+}
+
 // Config centralizes tunable parameters leveraged by the service.
 type Config struct {
 	MaxFilesPerBatch        int
@@ -48,6 +76,25 @@ type Config struct {
 	AllowedContentTypes     []string
 	AllowOwnerProjectUpload bool
 	RequireAdminReview      bool
+	// HLS playlists are served by the backend with segment URLs signed per request.
+	HLSPlaylistBaseURL string
+	HLSTokenSecret     string
+	HLSTokenTTL        time.Duration
+	// PanoramaTileBaseURL serves panorama tiles under the same token secret and TTL as HLS.
+	PanoramaTileBaseURL string
+	// ImageOptions is keyed by asset type and forwarded to the pipeline with every job.
+	ImageOptions map[string]mediaprocessingmodel.ImageProcessingOptions
+	// QualityGate evaluates the photo quality reports; disabled when nil.
+	QualityGate *mediaprocessingmodel.PhotoQualityThresholds
+	// ParcelTolerance is the relative difference above which declared land measures diverge from
+	// the parcel polygon.
+	ParcelTolerance float64
+	// ProcessingProvider is persisted on processing jobs: STEP_FUNCTIONS, or LOCAL when the
+	// in-process pipeline is the backend.
+	ProcessingProvider mediaprocessingmodel.MediaProcessingProvider
+	// ProcessingStateMachineARN lets cancellation stop Step Functions executions before their
+	// callback reports the ARN.
+	ProcessingStateMachineARN string
 }
 
 type mediaProcessingService struct {
@@ -57,6 +104,7 @@ type mediaProcessingService struct {
 	storage              storageport.ListingMediaStoragePort
 	queue                mediaprocessingqueue.QueuePortInterface
 	workflow             workflowport.WorkflowPortInterface
+	kpiRecorder          SessionKPIRecorder
 	cfg                  Config
 	now                  func() time.Time
 	allowedContentLookup map[string]struct{}
@@ -75,6 +123,25 @@ func NewConfigFromEnvironment(env *globalmodel.Environment) Config {
 	cfg.AllowedContentTypes = append(cfg.AllowedContentTypes, env.MediaProcessing.Limits.AllowedContentTypes...)
 	cfg.AllowOwnerProjectUpload = env.MediaProcessing.Features.AllowOwnerProjectUploads
 	cfg.RequireAdminReview = env.MediaProcessing.Features.ListingApprovalAdminReview
+	cfg.HLSPlaylistBaseURL = env.MediaProcessing.Streaming.PlaylistBaseURL
+	cfg.PanoramaTileBaseURL = env.MediaProcessing.Streaming.PanoramaBaseURL
+	cfg.HLSTokenSecret = env.MediaProcessing.Streaming.TokenSecret
+	if cfg.HLSTokenSecret == "" {
+		cfg.HLSTokenSecret = env.SECURITY.HMAC.Secret
+	}
+	ttlSeconds := env.MediaProcessing.Streaming.TokenTTLSeconds
+	if ttlSeconds <= 0 {
+		ttlSeconds = env.MediaProcessing.Storage.DownloadURLTTLSeconds
+	}
+	cfg.HLSTokenTTL = time.Duration(ttlSeconds) * time.Second
+	cfg.ImageOptions = imageOptionsFromEnvironment(env)
+	cfg.QualityGate = qualityGateFromEnvironment(env)
+	cfg.ParcelTolerance = env.MediaProcessing.Parcel.Tolerance
+	cfg.ProcessingStateMachineARN = env.MediaProcessing.Workflow.ProcessingStateMachineARN
+	cfg.ProcessingProvider = mediaprocessingmodel.MediaProcessingProviderStepFunctions
+	if strings.EqualFold(strings.TrimSpace(env.MediaProcessing.Backend), "local") {
+		cfg.ProcessingProvider = mediaprocessingmodel.MediaProcessingProviderLocal
+	}
 
 	if raw := strings.TrimSpace(os.Getenv("LISTING_APPROVAL_ADMIN_REVIEW")); raw != "" {
 		switch strings.ToLower(raw) {
@@ -88,6 +155,74 @@ func NewConfigFromEnvironment(env *globalmodel.Environment) Config {
 	return cfg
 }
 
+// imageOptionsFromEnvironment maps the watermark settings onto each configured photo asset type.
+func imageOptionsFromEnvironment(env *globalmodel.Environment) map[string]mediaprocessingmodel.ImageProcessingOptions {
+	wm := env.MediaProcessing.Watermark
+	if strings.TrimSpace(wm.Key) == "" {
+		return nil
+	}
+
+	watermark := mediaprocessingmodel.WatermarkOptions{
+		Key:           wm.Key,
+		Position:      mediaprocessingmodel.WatermarkPosition(strings.ToLower(strings.TrimSpace(wm.Position))),
+		Opacity:       wm.Opacity,
+		WidthPercent:  wm.WidthPercent,
+		MarginPercent: wm.MarginPercent,
+		Sizes:         wm.Sizes,
+	}.Normalized()
+
+	options := make(map[string]mediaprocessingmodel.ImageProcessingOptions, len(wm.AssetTypes))
+	for _, assetType := range wm.AssetTypes {
+		normalized := strings.ToUpper(strings.TrimSpace(assetType))
+		if normalized == "" {
+			continue
+		}
+		options[normalized] = mediaprocessingmodel.ImageProcessingOptions{Watermark: &watermark}
+	}
+	return options
+}
+
+// qualityGateFromEnvironment builds the quality thresholds, falling back to the defaults for
+// unset values. Nothing blocks unless configured.
+func qualityGateFromEnvironment(env *globalmodel.Environment) *mediaprocessingmodel.PhotoQualityThresholds {
+	q := env.MediaProcessing.Quality
+	if q.Disabled {
+		return nil
+	}
+
+	gate := &mediaprocessingmodel.PhotoQualityThresholds{
+		MinShortSide:         q.MinShortSidePx,
+		MinBlurScore:         q.MinBlurScore,
+		MinBrightness:        q.MinBrightness,
+		MaxBrightness:        q.MaxBrightness,
+		MaxClippedRatio:      q.MaxClippedRatio,
+		DuplicateMaxDistance: q.DuplicateMaxDistance,
+		Blocking:             make(map[mediaprocessingmodel.PhotoQualityIssue]bool, len(q.Block)),
+	}
+	if gate.MinShortSide <= 0 {
+		gate.MinShortSide = 1080
+	}
+	if gate.MinBlurScore <= 0 {
+		gate.MinBlurScore = 60
+	}
+	if gate.MinBrightness <= 0 {
+		gate.MinBrightness = 0.15
+	}
+	if gate.MaxBrightness <= 0 {
+		gate.MaxBrightness = 0.85
+	}
+	if gate.MaxClippedRatio <= 0 {
+		gate.MaxClippedRatio = 0.25
+	}
+	if gate.DuplicateMaxDistance <= 0 {
+		gate.DuplicateMaxDistance = 6
+	}
+	for _, issue := range q.Block {
+		gate.Blocking[mediaprocessingmodel.PhotoQualityIssue(strings.ToLower(strings.TrimSpace(issue)))] = true
+	}
+	return gate
+}
+
 // NewMediaProcessingService wires all dependencies required to orchestrate listing media batches.
 func NewMediaProcessingService(
 	repo mediaprocessingrepository.RepositoryInterface,
@@ -96,6 +231,7 @@ func NewMediaProcessingService(
 	storage storageport.ListingMediaStoragePort,
 	queue mediaprocessingqueue.QueuePortInterface,
 	workflow workflowport.WorkflowPortInterface,
+	kpiRecorder SessionKPIRecorder,
 	cfg Config,
 ) (MediaProcessingServiceInterface, error) {
 	if repo == nil {
@@ -126,6 +262,21 @@ func NewMediaProcessingService(
 	if cfg.MaxFileBytes <= 0 {
 		cfg.MaxFileBytes = 256 << 20 // 256 MiB
 	}
+	if cfg.HLSPlaylistBaseURL == "" {
+		cfg.HLSPlaylistBaseURL = "/api/v2/listings/media/hls"
+	}
+	if cfg.PanoramaTileBaseURL == "" {
+		cfg.PanoramaTileBaseURL = "/api/v2/listings/media/panorama"
+	}
+	if cfg.HLSTokenTTL <= 0 {
+		cfg.HLSTokenTTL = time.Hour
+	}
+	if cfg.ProcessingProvider == "" {
+		cfg.ProcessingProvider = mediaprocessingmodel.MediaProcessingProviderStepFunctions
+	}
+	if cfg.ParcelTolerance <= 0 {
+		cfg.ParcelTolerance = mediaprocessingmodel.ParcelDefaultTolerance
+	}
 	if len(cfg.AllowedContentTypes) == 0 {
 		cfg.AllowedContentTypes = []string{"image/jpeg", "image/png", "image/heic", "video/mp4", "video/quicktime", "application/pdf"}
 	}
@@ -146,12 +297,29 @@ func NewMediaProcessingService(
 		storage:              storage,
 		queue:                queue,
 		workflow:             workflow,
+		kpiRecorder:          kpiRecorder,
 		cfg:                  cfg,
 		now:                  time.Now,
 		allowedContentLookup: lookup,
 	}, nil
 }
 
+// recordMediaUpload feeds the upload milestone to the photographer KPIs when a recorder is wired.
+func (s *mediaProcessingService) recordMediaUpload(ctx context.Context, tx *sql.Tx, listingIdentityID int64) error {
+	if s.kpiRecorder == nil {
+		return nil
+	}
+	return s.kpiRecorder.RecordMediaUploadWithTx(ctx, tx, listingIdentityID)
+}
+
+// recordOwnerMediaDecision feeds the owner's approval or rejection to the photographer KPIs when a recorder is wired.
+func (s *mediaProcessingService) recordOwnerMediaDecision(ctx context.Context, tx *sql.Tx, listingIdentityID int64, approved bool) error {
+	if s.kpiRecorder == nil {
+		return nil
+	}
+	return s.kpiRecorder.RecordOwnerMediaDecisionWithTx(ctx, tx, listingIdentityID, approved)
+}
+
 func (s *mediaProcessingService) ensureContentTypeAllowed(contentType string) error {
 	if len(s.allowedContentLookup) == 0 {
 		return nil
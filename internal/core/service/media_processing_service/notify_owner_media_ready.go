@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
 	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
 	globalservice "github.com/projeto-toq/toq_server/internal/core/service/global_service"
 	"github.com/projeto-toq/toq_server/internal/core/templates"
@@ -23,17 +24,6 @@ func (s *mediaProcessingService) notifyOwnerMediaReady(ctx context.Context, list
 		return derrors.Infra("listing owner undefined", nil)
 	}
 
-	tokens, err := s.globalService.ListDeviceTokensByUserIDIfOptedIn(ctx, ownerID)
-	if err != nil {
-		return derrors.Infra("failed to list owner device tokens", err)
-	}
-	if len(tokens) == 0 {
-		utils.LoggerFromContext(ctx).Warn("service.media.complete.owner_notification.no_tokens",
-			"listing_identity_id", listing.ListingIdentityID(),
-			"owner_id", ownerID)
-		return nil
-	}
-
 	listingTitle := listing.Title()
 	if strings.TrimSpace(listingTitle) == "" {
 		listingTitle = fmt.Sprintf("Anuncio %d", listing.ListingIdentityID())
@@ -48,16 +38,39 @@ func (s *mediaProcessingService) notifyOwnerMediaReady(ctx context.Context, list
 		return derrors.Infra("failed to render owner notification template", err)
 	}
 
+	notifier.StoreInbox(ctx, globalservice.NotificationRequest{
+		Type:     globalservice.NotificationTypeFCM,
+		Subject:  rendered.Title,
+		Body:     rendered.Body,
+		Data:     cloneData(rendered.Data),
+		UserID:   ownerID,
+		Category: globalmodel.NotificationCategoryTransactional,
+	})
+
+	tokens, err := s.globalService.ListDeviceTokensByUserIDIfOptedIn(ctx, ownerID)
+	if err != nil {
+		return derrors.Infra("failed to list owner device tokens", err)
+	}
+	if len(tokens) == 0 {
+		utils.LoggerFromContext(ctx).Warn("service.media.complete.owner_notification.no_tokens",
+			"listing_identity_id", listing.ListingIdentityID(),
+			"owner_id", ownerID)
+		return nil
+	}
+
 	for _, token := range tokens {
 		if token == "" {
 			continue
 		}
 		req := globalservice.NotificationRequest{
-			Type:    globalservice.NotificationTypeFCM,
-			Subject: rendered.Title,
-			Body:    rendered.Body,
-			Token:   token,
-			Data:    cloneData(rendered.Data),
+			Type:      globalservice.NotificationTypeFCM,
+			Subject:   rendered.Title,
+			Body:      rendered.Body,
+			Token:     token,
+			Data:      cloneData(rendered.Data),
+			UserID:    ownerID,
+			Category:  globalmodel.NotificationCategoryTransactional,
+			SkipInbox: true,
 		}
 		if err := notifier.SendNotification(ctx, req); err != nil {
 			return derrors.Infra("failed to enqueue owner notification", err)
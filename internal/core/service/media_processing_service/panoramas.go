@@ -0,0 +1,125 @@
+package mediaprocessingservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	"github.com/projeto-toq/toq_server/internal/core/domain/dto"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// Panorama tiles stay private in the bucket like HLS segments. A tiled viewer requests up to a
+// few hundred tiles per panorama, so ListMedia returns a URL template carrying a stream token
+// ({base}/{token}/{level}/{row}_{col}.jpg) and each tile request is redirected to a pre-signed
+// storage URL.
+
+// GetPanoramaTile validates the token and returns the signed URL of the requested tile.
+func (s *mediaProcessingService) GetPanoramaTile(ctx context.Context, input dto.GetPanoramaTileInput) (dto.GetPanoramaTileOutput, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return dto.GetPanoramaTileOutput{}, derrors.Infra("failed to create tracer", err)
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	assetID, expiresAt, err := s.verifyStreamToken(streamTokenScopePanorama, input.Token)
+	if err != nil {
+		return dto.GetPanoramaTileOutput{}, err
+	}
+
+	var row, col int
+	if _, scanErr := fmt.Sscanf(input.Tile, "%d_%d.jpg", &row, &col); scanErr != nil || !strings.HasSuffix(input.Tile, ".jpg") {
+		return dto.GetPanoramaTileOutput{}, derrors.NotFound("tile not found")
+	}
+
+	tx, txErr := s.globalService.StartReadOnlyTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		return dto.GetPanoramaTileOutput{}, derrors.Infra("failed to start transaction", txErr)
+	}
+	defer func() { _ = s.globalService.RollbackTransaction(ctx, tx) }()
+
+	asset, err := s.repo.GetAssetByID(ctx, tx, assetID)
+	if err != nil {
+		logger.Warn("service.media.panorama.asset_not_found", "asset_id", assetID, "error", err)
+		return dto.GetPanoramaTileOutput{}, derrors.NotFound("panorama not found")
+	}
+	tiles, manifestKey, ok := panoramaTilesFromAsset(asset)
+	if !ok {
+		return dto.GetPanoramaTileOutput{}, derrors.NotFound("panorama not found")
+	}
+	if input.Level < 0 || input.Level >= len(tiles.Levels) {
+		return dto.GetPanoramaTileOutput{}, derrors.NotFound("tile not found")
+	}
+	level := tiles.Levels[input.Level]
+	if row < 0 || row >= level.Rows || col < 0 || col >= level.Cols {
+		return dto.GetPanoramaTileOutput{}, derrors.NotFound("tile not found")
+	}
+
+	key := mediaprocessingmodel.PanoramaTileKey(mediaprocessingmodel.PanoramaTilesRoot(manifestKey), input.Level, row, col)
+	signed, err := s.storage.GenerateDownloadURL(ctx, key)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.panorama.sign_failed", "asset_id", assetID, "key", key, "error", err)
+		return dto.GetPanoramaTileOutput{}, derrors.Infra("failed to sign tile", err)
+	}
+
+	// The redirect may be cached while both the token and the signed URL remain valid.
+	maxAge := min(signed.ExpiresIn/2, expiresAt.Sub(s.now()))
+	return dto.GetPanoramaTileOutput{URL: signed.URL, MaxAge: max(maxAge, 0)}, nil
+}
+
+// buildPanorama describes a processed panorama for ListMedia: tile layout, tokenized tile URL
+// template and the signed flat preview. ok is false for other assets or panoramas without tiles.
+func (s *mediaProcessingService) buildPanorama(ctx context.Context, asset mediaprocessingmodel.MediaAsset) (dto.MediaPanorama, bool) {
+	if asset.Status() != mediaprocessingmodel.MediaAssetStatusProcessed {
+		return dto.MediaPanorama{}, false
+	}
+	tiles, _, ok := panoramaTilesFromAsset(asset)
+	if !ok {
+		return dto.MediaPanorama{}, false
+	}
+
+	logger := utils.LoggerFromContext(ctx)
+	token, expiresAt, err := s.newStreamToken(streamTokenScopePanorama, asset.ID())
+	if err != nil {
+		logger.Warn("service.media.panorama.token_failed", "asset_id", asset.ID(), "error", err)
+		return dto.MediaPanorama{}, false
+	}
+
+	panorama := dto.MediaPanorama{
+		Tiles:            tiles,
+		TileURLTemplate:  fmt.Sprintf("%s/%s/{level}/{row}_{col}.jpg", strings.TrimRight(s.cfg.PanoramaTileBaseURL, "/"), token),
+		TileURLExpiresAt: expiresAt,
+	}
+	if key := asset.S3KeyProcessed(); key != "" {
+		if signed, signErr := s.storage.GenerateDownloadURL(ctx, key); signErr != nil {
+			logger.Warn("service.media.panorama.preview_sign_failed", "asset_id", asset.ID(), "key", key, "error", signErr)
+		} else {
+			panorama.PreviewURL = signed.URL
+		}
+	}
+	return panorama, true
+}
+
+// panoramaTileKeys lists the tiles of a panorama so deleting it also removes them (the tiles.json
+// manifest and the previews are already part of the asset metadata).
+func panoramaTileKeys(asset mediaprocessingmodel.MediaAsset) []string {
+	tiles, manifestKey, ok := panoramaTilesFromAsset(asset)
+	if !ok {
+		return nil
+	}
+	return tiles.TileKeys(mediaprocessingmodel.PanoramaTilesRoot(manifestKey))
+}
+
+func panoramaTilesFromAsset(asset mediaprocessingmodel.MediaAsset) (mediaprocessingmodel.PanoramaTiles, string, bool) {
+	if asset.AssetType() != mediaprocessingmodel.MediaAssetTypePanorama360 {
+		return mediaprocessingmodel.PanoramaTiles{}, "", false
+	}
+	return mediaprocessingmodel.PanoramaTilesFromMetadata(assetMetadataMap(asset))
+}
@@ -0,0 +1,236 @@
+package mediaprocessingservice
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	parcelprocessing "github.com/projeto-toq/toq_server/internal/core/service/media_pipeline/parcel_processing"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// Metadata keys written on processed LAND_PARCEL assets.
+const (
+	parcelAreaMetadataKey        = "parcel_area_m2"
+	parcelPerimeterMetadataKey   = "parcel_perimeter_m"
+	parcelDivergencesMetadataKey = "parcel_divergences"
+)
+
+// Parcel documents are small and only need to be read, so they skip the pipeline: ProcessMedia
+// parses them in the request, stores the polygon and points the listing kmzFile to the processed
+// copy.
+
+// processParcelAsset reads the polygon of a LAND_PARCEL asset. A document that cannot be read
+// marks the asset FAILED with the parcel error code; only infrastructure failures are returned.
+func (s *mediaProcessingService) processParcelAsset(ctx context.Context, tx *sql.Tx, listing listingmodel.ListingInterface, asset mediaprocessingmodel.MediaAsset) error {
+	logger := utils.LoggerFromContext(ctx)
+	listingIdentityID := asset.ListingIdentityID()
+
+	content, err := s.storage.DownloadFile(ctx, asset.S3KeyRaw())
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.parcel.download_error", "err", err, "asset_id", asset.ID(), "key", asset.S3KeyRaw())
+		return derrors.Infra("failed to download parcel document", err)
+	}
+
+	polygon, parseErr := parcelprocessing.ParseParcel(content)
+	if parseErr != nil {
+		logger.Warn("service.media.parcel.invalid_document", "asset_id", asset.ID(), "listing_identity_id", listingIdentityID, "error", parseErr)
+		asset.SetStatus(mediaprocessingmodel.MediaAssetStatusFailed)
+		asset = mergeAssetMetadata(asset, map[string]string{
+			"errorCode": mediaprocessingmodel.ParcelErrorCode(parseErr),
+			"error":     parseErr.Error(),
+		})
+		if err := s.repo.UpsertAsset(ctx, tx, asset); err != nil {
+			utils.SetSpanError(ctx, err)
+			return derrors.Infra("failed to update asset status", err)
+		}
+		return nil
+	}
+
+	processedKey := buildProjectProcessedKey(listingIdentityID, asset)
+	contentType := inferContentTypeFromMetadata(asset.Metadata())
+	if !mediaprocessingmodel.IsParcelContentType(contentType) {
+		contentType = mediaprocessingmodel.ParcelContentTypeKMZ
+	}
+	if err := s.storage.UploadFile(ctx, processedKey, content, contentType); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.parcel.upload_processed_error", "err", err, "asset_id", asset.ID(), "key", processedKey)
+		return derrors.Infra("failed to upload processed parcel document", err)
+	}
+
+	parcel := mediaprocessingmodel.NewLandParcel(listingIdentityID, asset.ID(), polygon)
+	parcel.CrossCheck(declaredLandMeasures(listing), s.cfg.ParcelTolerance)
+	parcel.UpdatedAt = s.now().UTC()
+	if err := s.repo.UpsertParcel(ctx, tx, parcel); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.parcel.upsert_error", "err", err, "listing_identity_id", listingIdentityID)
+		return derrors.Infra("failed to save parcel", err)
+	}
+
+	asset.SetStatus(mediaprocessingmodel.MediaAssetStatusProcessed)
+	asset.SetS3KeyProcessed(processedKey)
+	asset = mergeAssetMetadata(asset, map[string]string{
+		parcelAreaMetadataKey:        fmt.Sprintf("%.2f", parcel.AreaM2),
+		parcelPerimeterMetadataKey:   fmt.Sprintf("%.2f", parcel.PerimeterM),
+		parcelDivergencesMetadataKey: parcelDivergenceFields(parcel),
+	})
+	if err := s.repo.UpsertAsset(ctx, tx, asset); err != nil {
+		utils.SetSpanError(ctx, err)
+		return derrors.Infra("failed to update asset status", err)
+	}
+
+	listing.SetHasKmz(true)
+	listing.SetKmzFile(processedKey)
+	if err := s.listingRepo.UpdateListingVersion(ctx, tx, listing); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.parcel.update_listing_error", "err", err, "listing_identity_id", listingIdentityID)
+		return derrors.Infra("failed to update listing kmz file", err)
+	}
+
+	logger.Info("service.media.parcel.processed", "listing_identity_id", listingIdentityID, "asset_id", asset.ID(),
+		"area_m2", parcel.AreaM2, "perimeter_m", parcel.PerimeterM, "divergences", len(parcel.Divergences))
+	return nil
+}
+
+// RecheckParcel repeats the cross-check of the parcel polygon against the land measures of the
+// active listing version, so divergences follow later edits of landSize, landFront, landSide and
+// landBack. Listings without a parcel are left untouched.
+func (s *mediaProcessingService) RecheckParcel(ctx context.Context, tx *sql.Tx, listingIdentityID int64) error {
+	logger := utils.LoggerFromContext(ctx)
+
+	parcel, err := s.loadParcel(ctx, tx, uint64(listingIdentityID))
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.parcel.recheck_load_error", "err", err, "listing_identity_id", listingIdentityID)
+		return derrors.Infra("failed to load parcel", err)
+	}
+	if parcel == nil {
+		return nil
+	}
+
+	listing, err := s.listingRepo.GetActiveListingVersion(ctx, tx, listingIdentityID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return derrors.NotFound("listing not found")
+		}
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.parcel.recheck_listing_error", "err", err, "listing_identity_id", listingIdentityID)
+		return derrors.Infra("failed to load listing", err)
+	}
+
+	parcel.CrossCheck(declaredLandMeasures(listing), s.cfg.ParcelTolerance)
+	parcel.UpdatedAt = s.now().UTC()
+	if err := s.repo.UpsertParcel(ctx, tx, *parcel); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.parcel.upsert_error", "err", err, "listing_identity_id", listingIdentityID)
+		return derrors.Infra("failed to save parcel", err)
+	}
+
+	asset, err := s.repo.GetAssetByID(ctx, tx, parcel.AssetID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.parcel.recheck_asset_error", "err", err, "asset_id", parcel.AssetID)
+		return derrors.Infra("failed to load parcel asset", err)
+	}
+	// mergeAssetMetadata ignores empty values, so the key is rewritten here to clear divergences
+	// that no longer apply.
+	metadata := assetMetadataMap(asset)
+	if fields := parcelDivergenceFields(*parcel); fields != "" {
+		metadata[parcelDivergencesMetadataKey] = fields
+	} else {
+		delete(metadata, parcelDivergencesMetadataKey)
+	}
+	payload, err := json.Marshal(metadata)
+	if err != nil {
+		return derrors.Infra("failed to encode asset metadata", err)
+	}
+	asset.SetMetadata(string(payload))
+	if err := s.repo.UpsertAsset(ctx, tx, asset); err != nil {
+		utils.SetSpanError(ctx, err)
+		return derrors.Infra("failed to update asset metadata", err)
+	}
+
+	logger.Info("service.media.parcel.rechecked", "listing_identity_id", listingIdentityID, "divergences", len(parcel.Divergences))
+	return nil
+}
+
+// removeParcel drops the polygon of a deleted LAND_PARCEL asset and clears the listing kmzFile
+// when it still points to the asset.
+func (s *mediaProcessingService) removeParcel(ctx context.Context, tx *sql.Tx, asset mediaprocessingmodel.MediaAsset) error {
+	if asset.AssetType() != mediaprocessingmodel.MediaAssetTypeLandParcel {
+		return nil
+	}
+	if err := s.repo.DeleteParcel(ctx, tx, asset.ListingIdentityID()); err != nil {
+		return err
+	}
+
+	processedKey := asset.S3KeyProcessed()
+	if processedKey == "" {
+		return nil
+	}
+	listing, err := s.listingRepo.GetActiveListingVersion(ctx, tx, int64(asset.ListingIdentityID()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	if listing.KmzFile() != processedKey {
+		return nil
+	}
+	listing.UnsetKmzFile()
+	return s.listingRepo.UpdateListingVersion(ctx, tx, listing)
+}
+
+// loadParcel returns the parcel polygon of a listing, nil when there is none.
+func (s *mediaProcessingService) loadParcel(ctx context.Context, tx *sql.Tx, listingIdentityID uint64) (*mediaprocessingmodel.LandParcel, error) {
+	parcel, err := s.repo.GetParcel(ctx, tx, listingIdentityID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &parcel, nil
+}
+
+func isLandListing(listing listingmodel.ListingInterface) bool {
+	return listing.ListingType() == globalmodel.ResidencialLand || listing.ListingType() == globalmodel.CommercialLand
+}
+
+// parcelDivergenceFields lists the diverging measures as stored in the asset metadata.
+func parcelDivergenceFields(parcel mediaprocessingmodel.LandParcel) string {
+	fields := make([]string, 0, len(parcel.Divergences))
+	for _, divergence := range parcel.Divergences {
+		fields = append(fields, string(divergence.Field))
+	}
+	return strings.Join(fields, ",")
+}
+
+func declaredLandMeasures(listing listingmodel.ListingInterface) mediaprocessingmodel.DeclaredLandMeasures {
+	var declared mediaprocessingmodel.DeclaredLandMeasures
+	if listing.HasLandSize() {
+		declared.LandSize = listing.LandSize()
+	}
+	if listing.HasLandFront() {
+		declared.LandFront = listing.LandFront()
+	}
+	if listing.HasLandSide() {
+		declared.LandSide = listing.LandSide()
+	}
+	if listing.HasLandBack() {
+		declared.LandBack = listing.LandBack()
+	}
+	return declared
+}
@@ -0,0 +1,161 @@
+package mediaprocessingservice
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	"github.com/projeto-toq/toq_server/internal/core/domain/dto"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	mediaprocessingrepository "github.com/projeto-toq/toq_server/internal/core/port/right/repository/media_processing_repository"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// qualityGateKeys are recomputed on every evaluation, so stale values are removed first.
+var qualityGateKeys = []string{
+	mediaprocessingmodel.QualityStatusMetadataKey,
+	mediaprocessingmodel.QualityIssuesMetadataKey,
+	mediaprocessingmodel.QualityDuplicateOfMetadataKey,
+}
+
+// qualityPeers caches the perceptual hashes of a listing's photos during one callback, so photos
+// of the same batch are compared with each other as well.
+type qualityPeers struct {
+	loaded bool
+	hashes map[uint64]string
+}
+
+// applyQualityGate evaluates the quality report of a processed photo against the configured
+// thresholds and records the outcome in its metadata. Assets without a report (videos, documents,
+// results from older pipelines) are left untouched.
+func (s *mediaProcessingService) applyQualityGate(ctx context.Context, tx *sql.Tx, asset mediaprocessingmodel.MediaAsset, peers *qualityPeers) mediaprocessingmodel.MediaAsset {
+	if s.cfg.QualityGate == nil || asset.Status() != mediaprocessingmodel.MediaAssetStatusProcessed || !isPhotoAsset(asset.AssetType()) {
+		return asset
+	}
+
+	metadata := assetMetadataMap(asset)
+	report, ok := mediaprocessingmodel.PhotoQualityReportFromMetadata(metadata)
+	if !ok {
+		return asset
+	}
+
+	gate := s.cfg.QualityGate
+	issues := gate.Evaluate(report)
+
+	if err := s.loadQualityPeers(ctx, tx, asset.ListingIdentityID(), peers); err != nil {
+		utils.LoggerFromContext(ctx).Warn("service.media.quality.peers_unavailable", "asset_id", asset.ID(), "err", err)
+	}
+	duplicateOf, isDuplicate := findDuplicatePhoto(asset.ID(), report.PHash, peers.hashes, gate.DuplicateMaxDistance)
+	if isDuplicate {
+		issues = append(issues, mediaprocessingmodel.PhotoQualityIssueDuplicate)
+	}
+	if report.PHash != "" {
+		peers.hashes[asset.ID()] = report.PHash
+	}
+
+	for _, key := range qualityGateKeys {
+		delete(metadata, key)
+	}
+	status := gate.Status(issues)
+	metadata[mediaprocessingmodel.QualityStatusMetadataKey] = string(status)
+	if len(issues) > 0 {
+		metadata[mediaprocessingmodel.QualityIssuesMetadataKey] = mediaprocessingmodel.FormatPhotoQualityIssues(issues)
+	}
+	if isDuplicate {
+		metadata[mediaprocessingmodel.QualityDuplicateOfMetadataKey] = strconv.FormatUint(duplicateOf, 10)
+	}
+	if payload, err := json.Marshal(metadata); err == nil {
+		asset.SetMetadata(string(payload))
+	}
+
+	if status != mediaprocessingmodel.PhotoQualityStatusOK {
+		utils.LoggerFromContext(ctx).Info("service.media.quality.evaluated", "asset_id", asset.ID(), "status", status, "issues", issues, "duplicate_of", duplicateOf)
+	}
+	return asset
+}
+
+func (s *mediaProcessingService) loadQualityPeers(ctx context.Context, tx *sql.Tx, listingIdentityID uint64, peers *qualityPeers) error {
+	if peers.hashes == nil {
+		peers.hashes = make(map[uint64]string)
+	}
+	if peers.loaded {
+		return nil
+	}
+	peers.loaded = true
+
+	filter := mediaprocessingrepository.AssetFilter{
+		Status: []mediaprocessingmodel.MediaAssetStatus{mediaprocessingmodel.MediaAssetStatusProcessed},
+	}
+	assets, err := s.repo.ListAssets(ctx, tx, listingIdentityID, filter, nil)
+	if err != nil {
+		return err
+	}
+	for _, asset := range assets {
+		if hash := assetMetadataMap(asset)[mediaprocessingmodel.QualityPHashMetadataKey]; hash != "" && isPhotoAsset(asset.AssetType()) {
+			peers.hashes[asset.ID()] = hash
+		}
+	}
+	return nil
+}
+
+// findDuplicatePhoto returns the closest other photo within maxDistance bits of hash.
+func findDuplicatePhoto(assetID uint64, hash string, hashes map[uint64]string, maxDistance int) (uint64, bool) {
+	if hash == "" {
+		return 0, false
+	}
+	best, bestDistance := uint64(0), maxDistance+1
+	for peerID, peerHash := range hashes {
+		if peerID == assetID {
+			continue
+		}
+		distance, ok := mediaprocessingmodel.PHashDistance(hash, peerHash)
+		if !ok {
+			continue
+		}
+		if distance < bestDistance || (distance == bestDistance && peerID < best) {
+			best, bestDistance = peerID, distance
+		}
+	}
+	return best, bestDistance <= maxDistance
+}
+
+// ensureNoBlockedPhotos rejects finalization while any photo is blocked by the quality gate.
+func ensureNoBlockedPhotos(assets []mediaprocessingmodel.MediaAsset) error {
+	blocked := make([]string, 0)
+	for _, asset := range assets {
+		metadata := assetMetadataMap(asset)
+		if metadata[mediaprocessingmodel.QualityStatusMetadataKey] == string(mediaprocessingmodel.PhotoQualityStatusBlocked) {
+			blocked = append(blocked, fmt.Sprintf("assetId:%d (%s)", asset.ID(), metadata[mediaprocessingmodel.QualityIssuesMetadataKey]))
+		}
+	}
+	if len(blocked) == 0 {
+		return nil
+	}
+	return derrors.Conflict(
+		"some photos failed the quality check, please replace or remove them",
+		derrors.WithDetails(map[string]any{"assets": blocked}),
+	)
+}
+
+// photoQualityFromAsset exposes the stored report and gate outcome for listings.
+func photoQualityFromAsset(asset mediaprocessingmodel.MediaAsset) (dto.MediaPhotoQuality, bool) {
+	metadata := assetMetadataMap(asset)
+	report, ok := mediaprocessingmodel.PhotoQualityReportFromMetadata(metadata)
+	if !ok {
+		return dto.MediaPhotoQuality{}, false
+	}
+	quality := dto.MediaPhotoQuality{
+		Report: report,
+		Status: mediaprocessingmodel.PhotoQualityStatus(metadata[mediaprocessingmodel.QualityStatusMetadataKey]),
+		Issues: mediaprocessingmodel.ParsePhotoQualityIssues(metadata[mediaprocessingmodel.QualityIssuesMetadataKey]),
+	}
+	if raw := metadata[mediaprocessingmodel.QualityDuplicateOfMetadataKey]; raw != "" {
+		if id, err := strconv.ParseUint(raw, 10, 64); err == nil {
+			quality.DuplicateOf = id
+		}
+	}
+	return quality, true
+}
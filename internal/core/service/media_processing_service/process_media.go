@@ -80,13 +80,53 @@ func (s *mediaProcessingService) ProcessMedia(ctx context.Context, input dto.Pro
 		return err
 	}
 
+	// Parcel documents are read here; everything else goes to the pipeline.
+	pipelineAssets := make([]mediaprocessingmodel.MediaAsset, 0, len(assets))
+	parcels := 0
+	for _, asset := range assets {
+		if asset.AssetType() != mediaprocessingmodel.MediaAssetTypeLandParcel || asset.S3KeyRaw() == "" {
+			pipelineAssets = append(pipelineAssets, asset)
+			continue
+		}
+		if err := s.processParcelAsset(ctx, tx, listing, asset); err != nil {
+			return err
+		}
+		parcels++
+	}
+
+	if len(pipelineAssets) == 0 {
+		if err := s.globalService.CommitTransaction(ctx, tx); err != nil {
+			utils.SetSpanError(ctx, err)
+			return derrors.Infra("failed to commit process request", err)
+		}
+		committed = true
+
+		logger.Info("service.media.process.parcels_only", "listing_identity_id", input.ListingIdentityID, "parcels", parcels)
+		return nil
+	}
+	assets = make([]mediaprocessingmodel.MediaAsset, 0, len(pipelineAssets))
+	assetIDs := make([]uint64, 0, len(pipelineAssets))
+	for _, asset := range pipelineAssets {
+		if asset.S3KeyRaw() == "" {
+			logger.Warn("service.media.process.asset_missing_raw_key", "asset_id", asset.ID(), "listing_identity_id", input.ListingIdentityID)
+			continue
+		}
+		assets = append(assets, asset)
+		assetIDs = append(assetIDs, asset.ID())
+	}
+	if len(assets) == 0 {
+		return derrors.Validation("no assets ready for processing", map[string]any{"listingIdentityId": input.ListingIdentityID})
+	}
+
 	// Register Job first to get ID
-	job := mediaprocessingmodel.NewMediaProcessingJob(uint64(input.ListingIdentityID), mediaprocessingmodel.MediaProcessingProviderStepFunctions)
+	job := mediaprocessingmodel.NewMediaProcessingJob(uint64(input.ListingIdentityID), s.cfg.ProcessingProvider)
+	job.SetAssetIDs(assetIDs)
 	jobID, err := s.repo.RegisterProcessingJob(ctx, tx, job)
 	if err != nil {
 		utils.SetSpanError(ctx, err)
 		return derrors.Infra("failed to register job", err)
 	}
+	job.SetID(jobID)
 
 	// Prepare payload for Step Function
 	jobMsg := mediaprocessingmodel.MediaProcessingJobMessage{
@@ -96,11 +136,6 @@ func (s *mediaProcessingService) ProcessMedia(ctx context.Context, input dto.Pro
 	}
 
 	for _, asset := range assets {
-		if asset.S3KeyRaw() == "" {
-			logger.Warn("service.media.process.asset_missing_raw_key", "asset_id", asset.ID(), "listing_identity_id", input.ListingIdentityID)
-			continue
-		}
-
 		jobMsg.Assets = append(jobMsg.Assets, mediaprocessingmodel.JobAsset{
 			Key:  asset.S3KeyRaw(),
 			Type: string(asset.AssetType()),
@@ -114,15 +149,21 @@ func (s *mediaProcessingService) ProcessMedia(ctx context.Context, input dto.Pro
 		}
 	}
 
-	if len(jobMsg.Assets) == 0 {
-		return derrors.Validation("no assets ready for processing", map[string]any{"listingIdentityId": input.ListingIdentityID})
+	jobMsg.ImageOptions = s.imageOptionsForJob(jobMsg.Assets)
+
+	if err := s.recordMediaUpload(ctx, tx, input.ListingIdentityID); err != nil {
+		return err
 	}
 
 	// Send to Queue (which triggers Step Function)
-	if _, err := s.queue.EnqueueJob(ctx, jobMsg); err != nil {
+	executionID, err := s.queue.EnqueueJob(ctx, jobMsg)
+	if err != nil {
 		utils.SetSpanError(ctx, err)
 		return derrors.Infra("failed to publish job", err)
 	}
+	if err := s.recordLocalExecution(ctx, tx, &job, executionID); err != nil {
+		return err
+	}
 
 	if err := s.globalService.CommitTransaction(ctx, tx); err != nil {
 		utils.SetSpanError(ctx, err)
@@ -159,3 +200,20 @@ func (s *mediaProcessingService) ensureRawObjectsExist(ctx context.Context, asse
 
 	return nil
 }
+
+// imageOptionsForJob forwards only the options relevant to the asset types present in the job.
+func (s *mediaProcessingService) imageOptionsForJob(assets []mediaprocessingmodel.JobAsset) map[string]mediaprocessingmodel.ImageProcessingOptions {
+	if len(s.cfg.ImageOptions) == 0 {
+		return nil
+	}
+	options := make(map[string]mediaprocessingmodel.ImageProcessingOptions)
+	for _, asset := range assets {
+		if opts, ok := s.cfg.ImageOptions[asset.Type]; ok {
+			options[asset.Type] = opts
+		}
+	}
+	if len(options) == 0 {
+		return nil
+	}
+	return options
+}
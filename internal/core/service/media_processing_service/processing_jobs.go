@@ -0,0 +1,241 @@
+package mediaprocessingservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	"github.com/projeto-toq/toq_server/internal/core/domain/dto"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	mediaprocessingrepository "github.com/projeto-toq/toq_server/internal/core/port/right/repository/media_processing_repository"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+const (
+	defaultProcessingJobsLimit = 20
+	maxProcessingJobsLimit     = 100
+)
+
+// ListProcessingJobs feeds the admin dashboard with jobs filtered by status, provider and age.
+func (s *mediaProcessingService) ListProcessingJobs(ctx context.Context, input dto.ListProcessingJobsInput) (dto.ListProcessingJobsOutput, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return dto.ListProcessingJobsOutput{}, derrors.Infra("failed to create tracer", err)
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	for _, status := range input.Statuses {
+		if !status.IsValid() {
+			return dto.ListProcessingJobsOutput{}, derrors.Validation("invalid job status", map[string]any{"status": status})
+		}
+	}
+	for _, provider := range input.Providers {
+		switch provider {
+		case mediaprocessingmodel.MediaProcessingProviderStepFunctions,
+			mediaprocessingmodel.MediaProcessingProviderLocal,
+			mediaprocessingmodel.MediaProcessingProviderStepFunctionsFinalization,
+			mediaprocessingmodel.MediaProcessingProviderMediaConvert:
+		default:
+			return dto.ListProcessingJobsOutput{}, derrors.Validation("invalid job provider", map[string]any{"provider": provider})
+		}
+	}
+	if input.OlderThan < 0 {
+		return dto.ListProcessingJobsOutput{}, derrors.Validation("olderThan must not be negative", map[string]any{"olderThan": input.OlderThan.String()})
+	}
+
+	page := input.Page
+	if page < 1 {
+		page = 1
+	}
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultProcessingJobsLimit
+	}
+	if limit > maxProcessingJobsLimit {
+		limit = maxProcessingJobsLimit
+	}
+
+	filter := mediaprocessingrepository.JobFilter{
+		Statuses:          input.Statuses,
+		Providers:         input.Providers,
+		ListingIdentityID: input.ListingIdentityID,
+	}
+	if input.OlderThan > 0 {
+		createdBefore := s.nowUTC().Add(-input.OlderThan)
+		filter.CreatedBefore = &createdBefore
+	}
+
+	tx, txErr := s.globalService.StartReadOnlyTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		return dto.ListProcessingJobsOutput{}, derrors.Infra("failed to start transaction", txErr)
+	}
+	defer func() { _ = s.globalService.RollbackTransaction(ctx, tx) }()
+
+	total, err := s.repo.CountProcessingJobs(ctx, tx, filter)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.jobs.count_error", "err", err)
+		return dto.ListProcessingJobsOutput{}, derrors.Infra("failed to count processing jobs", err)
+	}
+
+	jobs, err := s.repo.ListProcessingJobs(ctx, tx, filter, &mediaprocessingrepository.Pagination{Page: page, Limit: limit})
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.jobs.list_error", "err", err)
+		return dto.ListProcessingJobsOutput{}, derrors.Infra("failed to list processing jobs", err)
+	}
+
+	output := dto.ListProcessingJobsOutput{
+		Jobs:  make([]dto.ProcessingJobSummary, 0, len(jobs)),
+		Total: total,
+		Page:  page,
+		Limit: limit,
+	}
+	for _, job := range jobs {
+		summary := dto.ProcessingJobSummary{Job: job}
+		for _, out := range job.CallbackOutputs() {
+			summary.TotalAssets++
+			if out.Failed() {
+				summary.FailedAssets++
+			}
+		}
+		output.Jobs = append(output.Jobs, summary)
+	}
+	return output, nil
+}
+
+// GetProcessingJob returns a job with the outcome of each asset reported by its callback.
+func (s *mediaProcessingService) GetProcessingJob(ctx context.Context, input dto.GetProcessingJobInput) (dto.ProcessingJobDetailOutput, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return dto.ProcessingJobDetailOutput{}, derrors.Infra("failed to create tracer", err)
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	if input.JobID == 0 {
+		return dto.ProcessingJobDetailOutput{}, derrors.Validation("jobId must be greater than zero", map[string]any{"jobId": "required"})
+	}
+
+	tx, txErr := s.globalService.StartReadOnlyTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		return dto.ProcessingJobDetailOutput{}, derrors.Infra("failed to start transaction", txErr)
+	}
+	defer func() { _ = s.globalService.RollbackTransaction(ctx, tx) }()
+
+	job, err := s.loadProcessingJob(ctx, tx, input.JobID)
+	if err != nil {
+		return dto.ProcessingJobDetailOutput{}, err
+	}
+
+	outputs := job.CallbackOutputs()
+	detail := dto.ProcessingJobDetailOutput{
+		Job:    job,
+		Assets: make([]dto.ProcessingJobAsset, 0, len(outputs)),
+	}
+	for _, out := range outputs {
+		item := dto.ProcessingJobAsset{
+			RawKey:       out.RawKey,
+			Failed:       out.Failed(),
+			ErrorCode:    out.ErrorCode,
+			ErrorMessage: out.ErrorMessage,
+		}
+		if out.RawKey != "" {
+			asset, assetErr := s.repo.GetAssetByRawKey(ctx, tx, out.RawKey)
+			switch {
+			case assetErr == nil:
+				item.AssetID = asset.ID()
+				item.AssetType = asset.AssetType()
+				item.Sequence = asset.Sequence()
+				item.Status = asset.Status()
+			case !errors.Is(assetErr, sql.ErrNoRows):
+				utils.SetSpanError(ctx, assetErr)
+				logger.Error("service.media.jobs.asset_lookup_error", "err", assetErr, "job_id", job.ID(), "raw_key", out.RawKey)
+				return dto.ProcessingJobDetailOutput{}, derrors.Infra("failed to load job asset", assetErr)
+			}
+		}
+		detail.Assets = append(detail.Assets, item)
+	}
+	return detail, nil
+}
+
+// loadProcessingJob reads a job for the admin operations, mapping a missing row to NotFound.
+func (s *mediaProcessingService) loadProcessingJob(ctx context.Context, tx *sql.Tx, jobID uint64) (mediaprocessingmodel.MediaProcessingJob, error) {
+	job, err := s.repo.GetProcessingJobByID(ctx, tx, jobID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return mediaprocessingmodel.MediaProcessingJob{}, derrors.NotFound("processing job not found")
+		}
+		utils.SetSpanError(ctx, err)
+		utils.LoggerFromContext(ctx).Error("service.media.jobs.get_job_error", "err", err, "job_id", jobID)
+		return mediaprocessingmodel.MediaProcessingJob{}, derrors.Infra("failed to load processing job", err)
+	}
+	return job, nil
+}
+
+// failedJobAssets returns the assets of a job that are FAILED now. Jobs closed without per-asset
+// outputs (global failure, reconciler, cancellation) failed every asset in flight, so all FAILED
+// assets of the listing are returned, parcel documents excepted as they never reach the pipeline.
+func (s *mediaProcessingService) failedJobAssets(ctx context.Context, tx *sql.Tx, job mediaprocessingmodel.MediaProcessingJob) ([]mediaprocessingmodel.MediaAsset, error) {
+	outputs := job.CallbackOutputs()
+	if len(outputs) == 0 {
+		assets, err := s.repo.ListAssets(ctx, tx, job.ListingIdentityID(), mediaprocessingrepository.AssetFilter{
+			Status: []mediaprocessingmodel.MediaAssetStatus{mediaprocessingmodel.MediaAssetStatusFailed},
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+		failed := make([]mediaprocessingmodel.MediaAsset, 0, len(assets))
+		for _, asset := range assets {
+			if asset.AssetType() != mediaprocessingmodel.MediaAssetTypeLandParcel {
+				failed = append(failed, asset)
+			}
+		}
+		return failed, nil
+	}
+
+	seen := make(map[uint64]struct{}, len(outputs))
+	failed := make([]mediaprocessingmodel.MediaAsset, 0)
+	for _, out := range outputs {
+		if out.RawKey == "" {
+			continue
+		}
+		asset, err := s.repo.GetAssetByRawKey(ctx, tx, out.RawKey)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			return nil, err
+		}
+		if _, ok := seen[asset.ID()]; ok || asset.Status() != mediaprocessingmodel.MediaAssetStatusFailed {
+			continue
+		}
+		seen[asset.ID()] = struct{}{}
+		failed = append(failed, asset)
+	}
+	return failed, nil
+}
+
+// recordLocalExecution stores the execution ARN returned by the in-process pipeline as soon as the
+// job is enqueued, so a cancellation before the first callback can stop the run. Step Functions
+// jobs learn their ARN from the callback and are left untouched.
+func (s *mediaProcessingService) recordLocalExecution(ctx context.Context, tx *sql.Tx, job *mediaprocessingmodel.MediaProcessingJob, executionID string) error {
+	if job.Provider() != mediaprocessingmodel.MediaProcessingProviderLocal || executionID == "" {
+		return nil
+	}
+	job.SetExternalID(executionID)
+	if err := s.repo.UpdateProcessingJob(ctx, tx, *job); err != nil {
+		utils.SetSpanError(ctx, err)
+		utils.LoggerFromContext(ctx).Error("service.media.jobs.record_execution_error", "err", err, "job_id", job.ID(), "execution_arn", executionID)
+		return derrors.Infra("failed to record job execution", err)
+	}
+	return nil
+}
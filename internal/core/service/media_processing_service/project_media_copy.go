@@ -72,6 +72,8 @@ func projectMediaTypeSegment(assetType mediaprocessingmodel.MediaAssetType) stri
 		return "project/doc"
 	case mediaprocessingmodel.MediaAssetTypeProjectRender:
 		return "project/render"
+	case mediaprocessingmodel.MediaAssetTypeLandParcel:
+		return "land/parcel"
 	default:
 		return "misc"
 	}
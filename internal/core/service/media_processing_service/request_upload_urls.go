@@ -66,6 +66,12 @@ func (s *mediaProcessingService) RequestUploadURLs(ctx context.Context, input dt
 		return dto.RequestUploadURLsOutput{}, derrors.Conflict("listing is not awaiting media uploads")
 	}
 
+	for _, file := range validatedFiles {
+		if file.AssetType == mediaprocessingmodel.MediaAssetTypeLandParcel && !isLandListing(listing) {
+			return dto.RequestUploadURLsOutput{}, derrors.Validation("parcel documents are only accepted for land listings", map[string]any{"assetType": file.AssetType})
+		}
+	}
+
 	instructions := make([]dto.UploadInstruction, 0, len(validatedFiles))
 	var uploadTTLSeconds int
 
@@ -115,6 +121,7 @@ func (s *mediaProcessingService) RequestUploadURLs(ctx context.Context, input dt
 		asset.SetS3KeyRaw(signedURL.ObjectKey)
 		// Reset status to PENDING_UPLOAD in case it was failed/processed/processing, allowing re-upload
 		if asset.Status() == mediaprocessingmodel.MediaAssetStatusFailed ||
+			asset.Status() == mediaprocessingmodel.MediaAssetStatusDiscarded ||
 			asset.Status() == mediaprocessingmodel.MediaAssetStatusProcessed ||
 			asset.Status() == mediaprocessingmodel.MediaAssetStatusProcessing {
 			asset.SetStatus(mediaprocessingmodel.MediaAssetStatusPendingUpload)
@@ -179,7 +186,17 @@ func (s *mediaProcessingService) validateUploadManifest(input dto.RequestUploadU
 		}
 		uniqueSet[key] = struct{}{}
 
-		if err := s.ensureContentTypeAllowed(file.ContentType); err != nil {
+		isParcel := mediaprocessingmodel.MediaAssetType(strings.ToUpper(strings.TrimSpace(string(file.AssetType)))) == mediaprocessingmodel.MediaAssetTypeLandParcel
+		if isParcel {
+			// Parcel documents are read by the backend, so KMZ/KML are accepted regardless of the
+			// configured content types; one parcel per listing.
+			if !mediaprocessingmodel.IsParcelContentType(file.ContentType) {
+				return nil, derrors.Validation("parcel must be a KMZ or KML document", map[string]any{"key": key, "contentType": file.ContentType})
+			}
+			if file.Sequence != 1 {
+				return nil, derrors.Validation("only one parcel document is allowed per listing", map[string]any{"key": key, "sequence": "must be 1"})
+			}
+		} else if err := s.ensureContentTypeAllowed(file.ContentType); err != nil {
 			return nil, err
 		}
 		if file.Bytes <= 0 {
@@ -217,7 +234,9 @@ func isSupportedAssetType(t mediaprocessingmodel.MediaAssetType) bool {
 		mediaprocessingmodel.MediaAssetTypeThumbnail,
 		mediaprocessingmodel.MediaAssetTypeZip,
 		mediaprocessingmodel.MediaAssetTypeProjectDoc,
-		mediaprocessingmodel.MediaAssetTypeProjectRender:
+		mediaprocessingmodel.MediaAssetTypeProjectRender,
+		mediaprocessingmodel.MediaAssetTypePanorama360,
+		mediaprocessingmodel.MediaAssetTypeLandParcel:
 		return true
 	}
 	return false
@@ -0,0 +1,161 @@
+package mediaprocessingservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	"github.com/projeto-toq/toq_server/internal/core/domain/dto"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// RetryProcessingJob sends the failed assets of a finished processing job, or a single failed
+// asset of its listing, to a new job published on the retry queue.
+func (s *mediaProcessingService) RetryProcessingJob(ctx context.Context, input dto.RetryProcessingJobInput) (dto.RetryProcessingJobOutput, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return dto.RetryProcessingJobOutput{}, derrors.Infra("failed to create tracer", err)
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	if input.JobID == 0 {
+		return dto.RetryProcessingJobOutput{}, derrors.Validation("jobId must be greater than zero", map[string]any{"jobId": "required"})
+	}
+
+	tx, txErr := s.globalService.StartTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("service.media.jobs.retry.tx_start_error", "err", txErr, "job_id", input.JobID)
+		return dto.RetryProcessingJobOutput{}, derrors.Infra("failed to start transaction", txErr)
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+				utils.SetSpanError(ctx, rbErr)
+				logger.Error("service.media.jobs.retry.tx_rollback_error", "err", rbErr, "job_id", input.JobID)
+			}
+		}
+	}()
+
+	job, err := s.loadProcessingJob(ctx, tx, input.JobID)
+	if err != nil {
+		return dto.RetryProcessingJobOutput{}, err
+	}
+	if !job.Provider().IsProcessing() {
+		return dto.RetryProcessingJobOutput{}, derrors.Conflict("only processing jobs can be retried",
+			derrors.WithDetails(map[string]any{"provider": job.Provider()}))
+	}
+	if !job.Status().IsTerminal() {
+		return dto.RetryProcessingJobOutput{}, derrors.Conflict("job is still running, cancel it before retrying",
+			derrors.WithDetails(map[string]any{"status": job.Status()}))
+	}
+
+	var assets []mediaprocessingmodel.MediaAsset
+	if input.AssetID != 0 {
+		asset, assetErr := s.repo.GetAssetByID(ctx, tx, input.AssetID)
+		if assetErr != nil {
+			if errors.Is(assetErr, sql.ErrNoRows) {
+				return dto.RetryProcessingJobOutput{}, derrors.NotFound("asset not found")
+			}
+			utils.SetSpanError(ctx, assetErr)
+			return dto.RetryProcessingJobOutput{}, derrors.Infra("failed to get asset", assetErr)
+		}
+		if asset.ListingIdentityID() != job.ListingIdentityID() {
+			return dto.RetryProcessingJobOutput{}, derrors.Validation("asset does not belong to the job listing", map[string]any{"assetId": input.AssetID})
+		}
+		if asset.AssetType() == mediaprocessingmodel.MediaAssetTypeLandParcel {
+			return dto.RetryProcessingJobOutput{}, derrors.Conflict("parcel documents are read by the process endpoint")
+		}
+		if asset.Status() != mediaprocessingmodel.MediaAssetStatusFailed {
+			return dto.RetryProcessingJobOutput{}, derrors.Conflict("only failed assets can be retried",
+				derrors.WithDetails(map[string]any{"status": asset.Status()}))
+		}
+		assets = []mediaprocessingmodel.MediaAsset{asset}
+	} else {
+		assets, err = s.failedJobAssets(ctx, tx, job)
+		if err != nil {
+			utils.SetSpanError(ctx, err)
+			logger.Error("service.media.jobs.retry.list_failed_error", "err", err, "job_id", job.ID())
+			return dto.RetryProcessingJobOutput{}, derrors.Infra("failed to list failed assets", err)
+		}
+		if len(assets) == 0 {
+			return dto.RetryProcessingJobOutput{}, derrors.Conflict("job has no failed assets to retry")
+		}
+	}
+
+	if err := s.ensureRawObjectsExist(ctx, assets); err != nil {
+		return dto.RetryProcessingJobOutput{}, err
+	}
+
+	dispatched := make([]mediaprocessingmodel.MediaAsset, 0, len(assets))
+	assetIDs := make([]uint64, 0, len(assets))
+	for _, asset := range assets {
+		if asset.S3KeyRaw() == "" {
+			logger.Warn("service.media.jobs.retry.asset_missing_raw_key", "asset_id", asset.ID(), "job_id", job.ID())
+			continue
+		}
+		dispatched = append(dispatched, asset)
+		assetIDs = append(assetIDs, asset.ID())
+	}
+	if len(dispatched) == 0 {
+		return dto.RetryProcessingJobOutput{}, derrors.Validation("no assets ready for processing", map[string]any{"jobId": input.JobID})
+	}
+
+	retryJob := mediaprocessingmodel.NewMediaProcessingJob(job.ListingIdentityID(), s.cfg.ProcessingProvider)
+	retryJob.SetRetryCount(job.RetryCount() + 1)
+	retryJob.SetAssetIDs(assetIDs)
+	retryJobID, err := s.repo.RegisterProcessingJob(ctx, tx, retryJob)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		return dto.RetryProcessingJobOutput{}, derrors.Infra("failed to register job", err)
+	}
+	retryJob.SetID(retryJobID)
+
+	jobMsg := mediaprocessingmodel.MediaProcessingJobMessage{
+		JobID:             retryJobID,
+		ListingIdentityID: job.ListingIdentityID(),
+		Assets:            make([]mediaprocessingmodel.JobAsset, 0, len(dispatched)),
+		Retry:             retryJob.RetryCount(),
+	}
+	output := dto.RetryProcessingJobOutput{JobID: retryJobID, AssetIDs: assetIDs}
+	for _, asset := range dispatched {
+		jobMsg.Assets = append(jobMsg.Assets, mediaprocessingmodel.JobAsset{
+			Key:  asset.S3KeyRaw(),
+			Type: string(asset.AssetType()),
+		})
+
+		asset.SetStatus(mediaprocessingmodel.MediaAssetStatusProcessing)
+		if err := s.repo.UpsertAsset(ctx, tx, asset); err != nil {
+			utils.SetSpanError(ctx, err)
+			return dto.RetryProcessingJobOutput{}, derrors.Infra("failed to update asset status", err)
+		}
+	}
+
+	jobMsg.ImageOptions = s.imageOptionsForJob(jobMsg.Assets)
+
+	executionID, err := s.queue.EnqueueRetry(ctx, jobMsg)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		return dto.RetryProcessingJobOutput{}, derrors.Infra("failed to publish job", err)
+	}
+	if err := s.recordLocalExecution(ctx, tx, &retryJob, executionID); err != nil {
+		return dto.RetryProcessingJobOutput{}, err
+	}
+
+	if err := s.globalService.CommitTransaction(ctx, tx); err != nil {
+		utils.SetSpanError(ctx, err)
+		return dto.RetryProcessingJobOutput{}, derrors.Infra("failed to commit retry request", err)
+	}
+	committed = true
+
+	logger.Info("service.media.jobs.retry.started", "job_id", job.ID(), "retry_job_id", retryJobID,
+		"retry", retryJob.RetryCount(), "assets_count", len(jobMsg.Assets), "requested_by", input.RequestedBy)
+	return output, nil
+}
@@ -0,0 +1,208 @@
+package mediaprocessingservice
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	"github.com/projeto-toq/toq_server/internal/core/domain/dto"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	permissionmodel "github.com/projeto-toq/toq_server/internal/core/model/permission_model"
+	mediaprocessingrepository "github.com/projeto-toq/toq_server/internal/core/port/right/repository/media_processing_repository"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+const tourLabelMaxLength = 80
+
+// SaveTour replaces the virtual tour of a listing. Nodes must be processed PANORAMA_360 assets
+// of the listing and hotspots may only link nodes of the same tour. Sending no node removes the
+// tour.
+func (s *mediaProcessingService) SaveTour(ctx context.Context, input dto.SaveMediaTourInput) (mediaprocessingmodel.MediaTour, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return mediaprocessingmodel.MediaTour{}, derrors.Infra("failed to create tracer", err)
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	if input.ListingIdentityID <= 0 {
+		return mediaprocessingmodel.MediaTour{}, derrors.Validation("listingIdentityId must be greater than zero", map[string]any{"listingIdentityId": "required"})
+	}
+
+	tx, txErr := s.globalService.StartTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("service.media.tour.tx_start_error", "err", txErr, "listing_identity_id", input.ListingIdentityID)
+		return mediaprocessingmodel.MediaTour{}, derrors.Infra("failed to start transaction", txErr)
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+				logger.Error("service.media.tour.rollback_error", "err", rbErr)
+			}
+		}
+	}()
+
+	listing, err := s.listingRepo.GetActiveListingVersion(ctx, tx, input.ListingIdentityID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return mediaprocessingmodel.MediaTour{}, derrors.NotFound("listing not found")
+		}
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.tour.get_listing_error", "err", err, "listing_identity_id", input.ListingIdentityID)
+		return mediaprocessingmodel.MediaTour{}, derrors.Infra("failed to load listing", err)
+	}
+	if input.RequesterRole == permissionmodel.RoleSlugOwner && listing.UserID() != int64(input.RequestedBy) {
+		return mediaprocessingmodel.MediaTour{}, derrors.Forbidden("only the listing owner can edit its tour")
+	}
+
+	tour := mediaprocessingmodel.MediaTour{
+		ListingIdentityID: uint64(input.ListingIdentityID),
+		StartAssetID:      input.StartAssetID,
+		Nodes:             input.Nodes,
+		UpdatedBy:         int64(input.RequestedBy),
+		UpdatedAt:         s.now().UTC(),
+	}
+
+	if len(tour.Nodes) == 0 {
+		if err := s.repo.DeleteTour(ctx, tx, tour.ListingIdentityID); err != nil {
+			utils.SetSpanError(ctx, err)
+			logger.Error("service.media.tour.delete_error", "err", err, "listing_identity_id", input.ListingIdentityID)
+			return mediaprocessingmodel.MediaTour{}, derrors.Infra("failed to delete tour", err)
+		}
+	} else {
+		panoramas, err := s.repo.ListAssets(ctx, tx, tour.ListingIdentityID, mediaprocessingrepository.AssetFilter{
+			AssetTypes: []mediaprocessingmodel.MediaAssetType{mediaprocessingmodel.MediaAssetTypePanorama360},
+		}, nil)
+		if err != nil {
+			utils.SetSpanError(ctx, err)
+			logger.Error("service.media.tour.list_panoramas_error", "err", err, "listing_identity_id", input.ListingIdentityID)
+			return mediaprocessingmodel.MediaTour{}, derrors.Infra("failed to list panoramas", err)
+		}
+
+		if tour, err = validateTour(tour, panoramas); err != nil {
+			return mediaprocessingmodel.MediaTour{}, err
+		}
+
+		if err := s.repo.UpsertTour(ctx, tx, tour); err != nil {
+			utils.SetSpanError(ctx, err)
+			logger.Error("service.media.tour.upsert_error", "err", err, "listing_identity_id", input.ListingIdentityID)
+			return mediaprocessingmodel.MediaTour{}, derrors.Infra("failed to save tour", err)
+		}
+	}
+
+	if err := s.globalService.CommitTransaction(ctx, tx); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("service.media.tour.commit_error", "err", err, "listing_identity_id", input.ListingIdentityID)
+		return mediaprocessingmodel.MediaTour{}, derrors.Infra("failed to commit transaction", err)
+	}
+	committed = true
+
+	logger.Info("service.media.tour.saved", "listing_identity_id", input.ListingIdentityID, "nodes", len(tour.Nodes))
+	return tour, nil
+}
+
+// validateTour checks the graph against the listing panoramas and defaults the start node to the
+// first node.
+func validateTour(tour mediaprocessingmodel.MediaTour, panoramas []mediaprocessingmodel.MediaAsset) (mediaprocessingmodel.MediaTour, error) {
+	available := make(map[uint64]mediaprocessingmodel.MediaAsset, len(panoramas))
+	for _, asset := range panoramas {
+		available[asset.ID()] = asset
+	}
+
+	nodes := make(map[uint64]bool, len(tour.Nodes))
+	for i, node := range tour.Nodes {
+		field := fmt.Sprintf("nodes[%d]", i)
+		asset, ok := available[node.AssetID]
+		if !ok {
+			return tour, derrors.Validation("tour nodes must be panoramas of this listing", map[string]any{field: node.AssetID})
+		}
+		if asset.Status() != mediaprocessingmodel.MediaAssetStatusProcessed {
+			return tour, derrors.Validation("tour panoramas must be processed", map[string]any{field: node.AssetID})
+		}
+		if nodes[node.AssetID] {
+			return tour, derrors.Validation("panorama listed more than once", map[string]any{field: node.AssetID})
+		}
+		nodes[node.AssetID] = true
+
+		tour.Nodes[i].Label = strings.TrimSpace(node.Label)
+		if len(tour.Nodes[i].Label) > tourLabelMaxLength {
+			return tour, derrors.Validation("label is too long", map[string]any{field + ".label": fmt.Sprintf("max=%d", tourLabelMaxLength)})
+		}
+		if !validTourAngles(node.InitialYaw, node.InitialPitch) {
+			return tour, derrors.Validation("initial view out of range", map[string]any{field: "yaw must be within [-180,180] and pitch within [-90,90]"})
+		}
+	}
+
+	for i, node := range tour.Nodes {
+		for j, hotspot := range node.Hotspots {
+			field := fmt.Sprintf("nodes[%d].hotspots[%d]", i, j)
+			if !nodes[hotspot.TargetAssetID] || hotspot.TargetAssetID == node.AssetID {
+				return tour, derrors.Validation("hotspot must link to another node of the tour", map[string]any{field: hotspot.TargetAssetID})
+			}
+			if !validTourAngles(hotspot.Yaw, hotspot.Pitch) {
+				return tour, derrors.Validation("hotspot position out of range", map[string]any{field: "yaw must be within [-180,180] and pitch within [-90,90]"})
+			}
+			tour.Nodes[i].Hotspots[j].Label = strings.TrimSpace(hotspot.Label)
+			if len(tour.Nodes[i].Hotspots[j].Label) > tourLabelMaxLength {
+				return tour, derrors.Validation("label is too long", map[string]any{field + ".label": fmt.Sprintf("max=%d", tourLabelMaxLength)})
+			}
+		}
+	}
+
+	if tour.StartAssetID == 0 {
+		tour.StartAssetID = tour.Nodes[0].AssetID
+	} else if !nodes[tour.StartAssetID] {
+		return tour, derrors.Validation("startAssetId must be one of the tour nodes", map[string]any{"startAssetId": tour.StartAssetID})
+	}
+	return tour, nil
+}
+
+func validTourAngles(yaw, pitch float64) bool {
+	return math.Abs(yaw) <= mediaprocessingmodel.TourMaxYaw && math.Abs(pitch) <= mediaprocessingmodel.TourMaxPitch
+}
+
+// loadTour returns the saved tour of a listing, nil when there is none.
+func (s *mediaProcessingService) loadTour(ctx context.Context, tx *sql.Tx, listingIdentityID uint64) (*mediaprocessingmodel.MediaTour, error) {
+	tour, err := s.repo.GetTour(ctx, tx, listingIdentityID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(tour.Nodes) == 0 {
+		return nil, nil
+	}
+	return &tour, nil
+}
+
+// removeFromTour drops a deleted panorama from the listing tour; the tour is removed with its
+// last node.
+func (s *mediaProcessingService) removeFromTour(ctx context.Context, tx *sql.Tx, asset mediaprocessingmodel.MediaAsset) error {
+	if asset.AssetType() != mediaprocessingmodel.MediaAssetTypePanorama360 {
+		return nil
+	}
+	tour, err := s.loadTour(ctx, tx, asset.ListingIdentityID())
+	if err != nil || tour == nil {
+		return err
+	}
+
+	pruned, changed := tour.WithoutAsset(asset.ID())
+	if !changed {
+		return nil
+	}
+	if len(pruned.Nodes) == 0 {
+		return s.repo.DeleteTour(ctx, tx, pruned.ListingIdentityID)
+	}
+	pruned.UpdatedAt = s.now().UTC()
+	return s.repo.UpsertTour(ctx, tx, pruned)
+}
@@ -45,5 +45,9 @@ func ensureAssetsReadyForFinalization(assets []mediaprocessingmodel.MediaAsset)
 		return nil, derrors.Conflict("no processed assets found to finalize")
 	}
 
+	if err := ensureNoBlockedPhotos(processed); err != nil {
+		return nil, err
+	}
+
 	return processed, nil
 }
@@ -0,0 +1,188 @@
+package mediaprocessingservice
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/projeto-toq/toq_server/internal/core/domain/dto"
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	mediaprocessingrepository "github.com/projeto-toq/toq_server/internal/core/port/right/repository/media_processing_repository"
+)
+
+const (
+	zipVerificationFailedCode = "ZIP_VERIFICATION_FAILED"
+	// zipDirectoryTailBytes bounds the archive tail kept while hashing; it must hold the central
+	// directory (roughly 100 bytes per entry) and the end-of-directory records.
+	zipDirectoryTailBytes = 4 << 20
+)
+
+var crc32Pattern = regexp.MustCompile(`^[0-9a-f]{8}$`)
+
+// verifyZipArchive checks the sidecar manifest written by the zip pipeline against the callback
+// totals and the stored archive, returning the source keys the archive holds. The stored archive
+// is streamed once: its SHA-256 must match the digest reported by the callback and its central
+// directory must carry the manifest names, sizes and CRC32s. It only touches storage, so callers
+// run it before opening the callback transaction.
+func (s *mediaProcessingService) verifyZipArchive(ctx context.Context, input dto.HandleProcessingCallbackInput) (map[string]bool, error) {
+	if len(input.ZipBundles) == 0 {
+		return nil, fmt.Errorf("manifest %s received without zip bundle", input.ZipManifestKey)
+	}
+	bundleKey := input.ZipBundles[0]
+
+	content, err := s.storage.DownloadFile(ctx, input.ZipManifestKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download manifest: %w", err)
+	}
+	var manifest mediaprocessingmodel.ZipManifest
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+
+	if manifest.Version != mediaprocessingmodel.ZipManifestVersion {
+		return nil, fmt.Errorf("unsupported manifest version %d", manifest.Version)
+	}
+	if manifest.ArchiveKey != bundleKey {
+		return nil, fmt.Errorf("manifest describes %s, expected %s", manifest.ArchiveKey, bundleKey)
+	}
+	if len(manifest.Entries) != input.AssetsZipped {
+		return nil, fmt.Errorf("manifest lists %d entries, callback reported %d", len(manifest.Entries), input.AssetsZipped)
+	}
+	if total := manifest.TotalSize(); total != input.UnzippedSizeBytes {
+		return nil, fmt.Errorf("manifest totals %d bytes, callback reported %d", total, input.UnzippedSizeBytes)
+	}
+
+	archived := make(map[string]bool, len(manifest.Entries))
+	for _, entry := range manifest.Entries {
+		if !crc32Pattern.MatchString(entry.CRC32) {
+			return nil, fmt.Errorf("entry %s has an invalid crc32 %q", entry.Name, entry.CRC32)
+		}
+		archived[entry.SourceKey] = true
+	}
+
+	object, err := s.storage.ValidateObjectChecksum(ctx, bundleKey, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive metadata: %w", err)
+	}
+	if object.SizeInBytes != input.ZipSizeBytes {
+		return nil, fmt.Errorf("archive has %d bytes, callback reported %d", object.SizeInBytes, input.ZipSizeBytes)
+	}
+	if err := s.verifyStoredArchive(ctx, bundleKey, input.ZipSHA256, manifest); err != nil {
+		return nil, err
+	}
+	return archived, nil
+}
+
+// verifyZipAssets checks that every processed asset of the listing is in the verified archive.
+func (s *mediaProcessingService) verifyZipAssets(ctx context.Context, tx *sql.Tx, listingIdentityID uint64, archived map[string]bool) error {
+	filter := mediaprocessingrepository.AssetFilter{
+		Status: []mediaprocessingmodel.MediaAssetStatus{mediaprocessingmodel.MediaAssetStatusProcessed},
+	}
+	assets, err := s.repo.ListAssets(ctx, tx, listingIdentityID, filter, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list processed assets: %w", err)
+	}
+	for _, asset := range assets {
+		if key := finalizationSourceKey(asset); key != "" && !archived[key] {
+			return fmt.Errorf("asset %d (%s) is missing from the archive", asset.ID(), key)
+		}
+	}
+	return nil
+}
+
+// verifyStoredArchive hashes the stored archive and compares its central directory with the manifest.
+func (s *mediaProcessingService) verifyStoredArchive(ctx context.Context, bundleKey, expectedSHA256 string, manifest mediaprocessingmodel.ZipManifest) error {
+	expectedSHA256 = strings.ToLower(strings.TrimSpace(expectedSHA256))
+	if expectedSHA256 == "" {
+		return errors.New("callback did not report the archive sha256")
+	}
+
+	reader, err := s.storage.OpenFile(ctx, bundleKey)
+	if err != nil {
+		return fmt.Errorf("failed to open archive: %w", err)
+	}
+	defer reader.Close()
+
+	digest := sha256.New()
+	tail := &tailBuffer{limit: zipDirectoryTailBytes}
+	size, err := io.Copy(io.MultiWriter(digest, tail), reader)
+	if err != nil {
+		return fmt.Errorf("failed to read archive: %w", err)
+	}
+	if actual := hex.EncodeToString(digest.Sum(nil)); actual != expectedSHA256 {
+		return fmt.Errorf("archive sha256 is %s, callback reported %s", actual, expectedSHA256)
+	}
+
+	directory, err := zip.NewReader(tail.readerAt(size), size)
+	if err != nil {
+		return fmt.Errorf("failed to read archive directory: %w", err)
+	}
+	// The archive also embeds its own manifest as the last entry, which the manifest does not list.
+	stored := make(map[string]*zip.File, len(directory.File))
+	for _, file := range directory.File {
+		if file.Name != mediaprocessingmodel.ZipManifestName {
+			stored[file.Name] = file
+		}
+	}
+	if len(stored) != len(manifest.Entries) {
+		return fmt.Errorf("archive has %d entries, manifest lists %d", len(stored), len(manifest.Entries))
+	}
+	for _, entry := range manifest.Entries {
+		file, ok := stored[entry.Name]
+		if !ok {
+			return fmt.Errorf("entry %s is missing from the archive", entry.Name)
+		}
+		if crc := fmt.Sprintf("%08x", file.CRC32); crc != entry.CRC32 {
+			return fmt.Errorf("entry %s has crc32 %s in the archive, manifest lists %s", entry.Name, crc, entry.CRC32)
+		}
+		if int64(file.UncompressedSize64) != entry.Size {
+			return fmt.Errorf("entry %s has %d bytes in the archive, manifest lists %d", entry.Name, file.UncompressedSize64, entry.Size)
+		}
+	}
+	return nil
+}
+
+// tailBuffer keeps the last bytes written to it, so the central directory at the end of a
+// streamed archive can be parsed without holding the whole archive in memory.
+type tailBuffer struct {
+	limit int
+	data  []byte
+}
+
+func (t *tailBuffer) Write(p []byte) (int, error) {
+	t.data = append(t.data, p...)
+	if excess := len(t.data) - t.limit; excess > t.limit {
+		t.data = append(t.data[:0], t.data[excess:]...)
+	}
+	return len(p), nil
+}
+
+// readerAt exposes the kept bytes at their offsets in an object of the given size; reads before
+// the kept tail fail.
+func (t *tailBuffer) readerAt(size int64) io.ReaderAt {
+	if len(t.data) > t.limit {
+		t.data = t.data[len(t.data)-t.limit:]
+	}
+	return &tailReaderAt{data: bytes.NewReader(t.data), base: size - int64(len(t.data))}
+}
+
+type tailReaderAt struct {
+	data *bytes.Reader
+	base int64
+}
+
+func (r *tailReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off < r.base {
+		return 0, fmt.Errorf("archive directory exceeds the last %d bytes", zipDirectoryTailBytes)
+	}
+	return r.data.ReadAt(p, off-r.base)
+}
@@ -0,0 +1,210 @@
+package mediaprocessingservice
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"strings"
+	"testing"
+
+	mediaprocessingmodel "github.com/projeto-toq/toq_server/internal/core/model/media_processing_model"
+	storageport "github.com/projeto-toq/toq_server/internal/core/port/right/storage"
+)
+
+// archiveStorageStub serves a single archive through OpenFile; other storage calls are not expected.
+type archiveStorageStub struct {
+	storageport.ListingMediaStoragePort
+	archive []byte
+}
+
+func (s archiveStorageStub) OpenFile(context.Context, string) (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(s.archive)), nil
+}
+
+func buildTestArchive(t *testing.T, entries int, entrySize int) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for i := 0; i < entries; i++ {
+		file, err := writer.CreateHeader(&zip.FileHeader{Name: fmt.Sprintf("photos/%03d.jpg", i), Method: zip.Store})
+		if err != nil {
+			t.Fatalf("create entry: %v", err)
+		}
+		if _, err := io.CopyN(file, rand.Reader, int64(entrySize)); err != nil {
+			t.Fatalf("write entry: %v", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("close archive: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestTailBufferReadsArchiveDirectory(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		limit     int
+		chunkSize int
+		expectErr bool
+	}{
+		{name: "tail larger than archive", limit: 1 << 20, chunkSize: 4096},
+		{name: "tail trimmed while streaming", limit: 2048, chunkSize: 700},
+		{name: "directory larger than tail", limit: 64, chunkSize: 512, expectErr: true},
+	}
+
+	archive := buildTestArchive(t, 8, 8192)
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tail := &tailBuffer{limit: tt.limit}
+			for offset := 0; offset < len(archive); offset += tt.chunkSize {
+				end := min(offset+tt.chunkSize, len(archive))
+				if _, err := tail.Write(archive[offset:end]); err != nil {
+					t.Fatalf("write chunk: %v", err)
+				}
+			}
+
+			size := int64(len(archive))
+			directory, err := zip.NewReader(tail.readerAt(size), size)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatalf("zip.NewReader with a %d bytes tail succeeded, expected an error", tt.limit)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("zip.NewReader unexpected error: %v", err)
+			}
+
+			expected, err := zip.NewReader(bytes.NewReader(archive), size)
+			if err != nil {
+				t.Fatalf("zip.NewReader on the full archive: %v", err)
+			}
+			if len(directory.File) != len(expected.File) {
+				t.Fatalf("tail directory has %d entries, expected %d", len(directory.File), len(expected.File))
+			}
+			for i, file := range directory.File {
+				if file.Name != expected.File[i].Name || file.CRC32 != expected.File[i].CRC32 || file.UncompressedSize64 != expected.File[i].UncompressedSize64 {
+					t.Fatalf("entry %d = (%s, %08x, %d), expected (%s, %08x, %d)", i, file.Name, file.CRC32, file.UncompressedSize64,
+						expected.File[i].Name, expected.File[i].CRC32, expected.File[i].UncompressedSize64)
+				}
+			}
+		})
+	}
+}
+
+// buildManifestArchive writes an archive shaped like the zip pipeline output: the listed entries
+// followed by the embedded manifest.
+func buildManifestArchive(t *testing.T, contents map[string]string) ([]byte, mediaprocessingmodel.ZipManifest) {
+	t.Helper()
+
+	manifest := mediaprocessingmodel.ZipManifest{Version: mediaprocessingmodel.ZipManifestVersion}
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for _, name := range []string{"photos/001.jpg", "photos/002.jpg"} {
+		file, err := writer.Create(name)
+		if err != nil {
+			t.Fatalf("create entry: %v", err)
+		}
+		if _, err := io.WriteString(file, contents[name]); err != nil {
+			t.Fatalf("write entry: %v", err)
+		}
+		manifest.Entries = append(manifest.Entries, mediaprocessingmodel.ZipManifestEntry{
+			Name:  name,
+			Size:  int64(len(contents[name])),
+			CRC32: fmt.Sprintf("%08x", crc32.ChecksumIEEE([]byte(contents[name]))),
+		})
+	}
+	file, err := writer.Create(mediaprocessingmodel.ZipManifestName)
+	if err != nil {
+		t.Fatalf("create manifest entry: %v", err)
+	}
+	if _, err := io.WriteString(file, "{}"); err != nil {
+		t.Fatalf("write manifest entry: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("close archive: %v", err)
+	}
+	return buf.Bytes(), manifest
+}
+
+func TestVerifyStoredArchive(t *testing.T) {
+	t.Parallel()
+
+	archive, manifest := buildManifestArchive(t, map[string]string{"photos/001.jpg": "first photo", "photos/002.jpg": "second photo"})
+	sum := sha256.Sum256(archive)
+	digest := hex.EncodeToString(sum[:])
+
+	cases := []struct {
+		name     string
+		sha256   string
+		mutate   func(m *mediaprocessingmodel.ZipManifest)
+		expected string
+	}{
+		{name: "matching archive", sha256: digest},
+		{name: "digest is case insensitive", sha256: strings.ToUpper(digest)},
+		{name: "missing digest", sha256: "", expected: "did not report the archive sha256"},
+		{name: "digest mismatch", sha256: strings.Repeat("0", 64), expected: "archive sha256 is"},
+		{
+			name:     "crc mismatch",
+			sha256:   digest,
+			mutate:   func(m *mediaprocessingmodel.ZipManifest) { m.Entries[1].CRC32 = "00000000" },
+			expected: "has crc32",
+		},
+		{
+			name:     "size mismatch",
+			sha256:   digest,
+			mutate:   func(m *mediaprocessingmodel.ZipManifest) { m.Entries[0].Size++ },
+			expected: "bytes in the archive",
+		},
+		{
+			name:     "entry missing from archive",
+			sha256:   digest,
+			mutate:   func(m *mediaprocessingmodel.ZipManifest) { m.Entries[0].Name = "photos/003.jpg" },
+			expected: "missing from the archive",
+		},
+		{
+			name:     "archive has unlisted entries",
+			sha256:   digest,
+			mutate:   func(m *mediaprocessingmodel.ZipManifest) { m.Entries = m.Entries[:1] },
+			expected: "archive has 2 entries, manifest lists 1",
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			current := manifest
+			current.Entries = append([]mediaprocessingmodel.ZipManifestEntry{}, manifest.Entries...)
+			if tt.mutate != nil {
+				tt.mutate(&current)
+			}
+
+			svc := &mediaProcessingService{storage: archiveStorageStub{archive: archive}}
+			err := svc.verifyStoredArchive(context.Background(), "1/processed/zip/listing-media.zip", tt.sha256, current)
+			if tt.expected == "" {
+				if err != nil {
+					t.Fatalf("verifyStoredArchive unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Fatalf("verifyStoredArchive() error = %v, expected it to contain %q", err, tt.expected)
+			}
+		})
+	}
+}
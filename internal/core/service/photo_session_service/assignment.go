@@ -0,0 +1,271 @@
+package photosessionservices
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+type assignmentCandidate struct {
+	photographerID uint64
+	workload       photosessionmodel.PhotographerWorkload
+	distanceKm     *float64
+	detail         photosessionmodel.PhotographerAssignment
+}
+
+// autoAssignmentEnabled reports whether owners book time windows and the service chooses the photographer.
+func (s *photoSessionService) autoAssignmentEnabled() bool {
+	return strings.EqualFold(strings.TrimSpace(s.cfg.AssignmentMode), string(photosessionmodel.AssignmentModeAuto))
+}
+
+// assignPhotographer picks the best free photographer covering the listing city for [start, end).
+// With travel buffers enabled, photographers who cannot reach the listing in time are not candidates.
+//
+// Candidates are scored on three normalised factors (higher is better):
+//   - load: booked minutes within ±balance_days of the window, relative to the busiest candidate
+//   - approval: Laplace-smoothed accept/(accept+reject) ratio of past bookings
+//   - distance: travel from the photographer's previous session that day, 0 at max_distance_km;
+//     a photographer with no earlier session that day scores 1
+//
+// Ties fall back to fewer booked minutes and then the lowest photographer ID. ignoreEntryID excludes
+// the caller's own agenda entry from conflict checks (reschedules). Must run inside the caller's transaction.
+// Returns derrors.ErrSlotUnavailable when no photographer is free.
+func (s *photoSessionService) assignPhotographer(ctx context.Context, tx *sql.Tx, listing listingmodel.ListingInterface, start, end time.Time, ignoreEntryID uint64) (uint64, *photosessionmodel.PhotographerAssignment, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return 0, nil, derrors.Infra("failed to generate tracer", err)
+	}
+	defer spanEnd()
+
+	logger := utils.LoggerFromContext(ctx)
+
+	city := strings.TrimSpace(listing.City())
+	state := strings.TrimSpace(listing.State())
+	if city == "" || state == "" {
+		return 0, nil, derrors.Validation("listing address must contain city and state", map[string]any{"listing": "missing_city_state"})
+	}
+
+	photographerIDs, err := s.repo.ListPhotographerIDsByLocation(ctx, tx, city, state)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.assignment.list_photographers_error", "city", city, "state", state, "err", err)
+		return 0, nil, derrors.Infra("failed to list photographers", err)
+	}
+
+	target, err := s.sessionLocation(ctx, tx, listing)
+	if err != nil {
+		return 0, nil, err
+	}
+	locations := locationCache{listing.IdentityID(): target}
+
+	free := make([]uint64, 0, len(photographerIDs))
+	for _, photographerID := range photographerIDs {
+		conflicts, findErr := s.repo.FindBlockingEntries(ctx, tx, photographerID, start.UTC(), end.UTC())
+		if findErr != nil {
+			utils.SetSpanError(ctx, findErr)
+			logger.Error("photo_session.assignment.find_blocking_error", "photographer_id", photographerID, "err", findErr)
+			return 0, nil, derrors.Infra("failed to verify photographer agenda", findErr)
+		}
+		blocked := false
+		for _, conflict := range conflicts {
+			if conflict.ID() != ignoreEntryID {
+				blocked = true
+				break
+			}
+		}
+		if blocked {
+			continue
+		}
+		reachable, reachErr := s.photographerCanReach(ctx, tx, locations, photographerID, listing.IdentityID(), target, start, end, ignoreEntryID)
+		if reachErr != nil {
+			return 0, nil, reachErr
+		}
+		if reachable {
+			free = append(free, photographerID)
+		}
+	}
+
+	if len(free) == 0 {
+		metricPhotoSessionAutoAssignments.WithLabelValues("no_candidate").Inc()
+		logger.Info("photo_session.assignment.no_candidate", "listing_identity_id", listing.IdentityID(), "slot_start", start, "covering", len(photographerIDs))
+		return 0, nil, derrors.ErrSlotUnavailable
+	}
+
+	balanceDays := s.cfg.AssignmentBalanceDays
+	if balanceDays <= 0 {
+		balanceDays = defaultAssignmentBalanceDays
+	}
+	balance := time.Duration(balanceDays) * 24 * time.Hour
+
+	workloads, err := s.repo.ListPhotographerWorkloads(ctx, tx, free, start.Add(-balance).UTC(), start.Add(balance).UTC())
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.assignment.list_workloads_error", "err", err)
+		return 0, nil, derrors.Infra("failed to load photographer workloads", err)
+	}
+
+	loc := start.Location()
+	dayStart := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc)
+	sessionType := photosessionmodel.AgendaEntryTypePhotoSession
+
+	candidates := make([]assignmentCandidate, 0, len(free))
+	var maxBooked int64
+	for _, photographerID := range free {
+		candidate := assignmentCandidate{photographerID: photographerID, workload: workloads[photographerID]}
+		if candidate.workload.BookedMinutes > maxBooked {
+			maxBooked = candidate.workload.BookedMinutes
+		}
+
+		entries, listErr := s.repo.ListEntriesByRange(ctx, tx, photographerID, dayStart.UTC(), start.UTC(), &sessionType)
+		if listErr != nil {
+			utils.SetSpanError(ctx, listErr)
+			logger.Error("photo_session.assignment.list_entries_error", "photographer_id", photographerID, "err", listErr)
+			return 0, nil, derrors.Infra("failed to load agenda entries", listErr)
+		}
+		var previous photosessionmodel.AgendaEntryInterface
+		var previousListingID int64
+		for _, entry := range entries {
+			sourceID, ok := entry.SourceID()
+			if !ok || sourceID == nil || entry.ID() == ignoreEntryID || entry.EndsAt().After(start) {
+				continue
+			}
+			if previous == nil || entry.EndsAt().After(previous.EndsAt()) {
+				previous = entry
+				previousListingID = int64(*sourceID)
+			}
+		}
+		if previous != nil {
+			from, locErr := s.cachedLocation(ctx, tx, locations, previousListingID)
+			if locErr != nil {
+				return 0, nil, locErr
+			}
+			km := s.distance.EstimateKm(from, target)
+			candidate.distanceKm = &km
+		}
+
+		candidates = append(candidates, candidate)
+	}
+
+	loadWeight, approvalWeight, distanceWeight := s.assignmentWeights()
+	maxKm := s.cfg.AssignmentMaxDistanceKm
+	if maxKm <= 0 {
+		maxKm = defaultAssignmentMaxDistanceKm
+	}
+
+	for i := range candidates {
+		c := &candidates[i]
+		loadScore := 1.0
+		if maxBooked > 0 {
+			loadScore = 1 - float64(c.workload.BookedMinutes)/float64(maxBooked)
+		}
+		approvalRate := float64(c.workload.Accepted+1) / float64(c.workload.Accepted+c.workload.Rejected+2)
+		distanceScore := 1.0
+		if c.distanceKm != nil {
+			distanceScore = 1 - *c.distanceKm/maxKm
+			if distanceScore < 0 {
+				distanceScore = 0
+			}
+		}
+
+		c.detail = photosessionmodel.PhotographerAssignment{
+			Score:         (loadWeight*loadScore + approvalWeight*approvalRate + distanceWeight*distanceScore) / (loadWeight + approvalWeight + distanceWeight),
+			LoadScore:     loadScore,
+			ApprovalScore: approvalRate,
+			DistanceScore: distanceScore,
+			BookedMinutes: c.workload.BookedMinutes,
+			ApprovalRate:  approvalRate,
+			DistanceKm:    c.distanceKm,
+			Candidates:    len(candidates),
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].detail.Score != candidates[j].detail.Score {
+			return candidates[i].detail.Score > candidates[j].detail.Score
+		}
+		if candidates[i].workload.BookedMinutes != candidates[j].workload.BookedMinutes {
+			return candidates[i].workload.BookedMinutes < candidates[j].workload.BookedMinutes
+		}
+		return candidates[i].photographerID < candidates[j].photographerID
+	})
+
+	chosen := candidates[0]
+	detail := chosen.detail
+	detail.Reason = describeAssignment(detail, balanceDays)
+
+	metricPhotoSessionAutoAssignments.WithLabelValues("assigned").Inc()
+	logger.Info("photo_session.assignment.chosen",
+		"listing_identity_id", listing.IdentityID(),
+		"photographer_id", chosen.photographerID,
+		"slot_start", start,
+		"score", detail.Score,
+		"candidates", detail.Candidates)
+
+	return chosen.photographerID, &detail, nil
+}
+
+// assignmentWeights returns the configured factor weights, falling back to defaults when none is positive.
+func (s *photoSessionService) assignmentWeights() (float64, float64, float64) {
+	load := max(s.cfg.AssignmentLoadWeight, 0)
+	approval := max(s.cfg.AssignmentApprovalWeight, 0)
+	distance := max(s.cfg.AssignmentDistanceWeight, 0)
+	if load+approval+distance == 0 {
+		return defaultAssignmentLoadWeight, defaultAssignmentApprovalWeight, defaultAssignmentDistanceWeight
+	}
+	return load, approval, distance
+}
+
+// sessionLocation builds the travel location of a listing, adding its parcel centroid when available.
+func (s *photoSessionService) sessionLocation(ctx context.Context, tx *sql.Tx, listing listingmodel.ListingInterface) (photosessionmodel.SessionLocation, error) {
+	location := photosessionmodel.SessionLocation{
+		Neighborhood: strings.TrimSpace(listing.Neighborhood()),
+		City:         strings.TrimSpace(listing.City()),
+		State:        strings.TrimSpace(listing.State()),
+	}
+
+	lat, lng, err := s.repo.GetListingCoordinates(ctx, tx, listing.IdentityID())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return location, nil
+		}
+		utils.SetSpanError(ctx, err)
+		utils.LoggerFromContext(ctx).Error("photo_session.assignment.get_coordinates_error", "listing_identity_id", listing.IdentityID(), "err", err)
+		return location, derrors.Infra("failed to load listing coordinates", err)
+	}
+	location.Latitude = &lat
+	location.Longitude = &lng
+	return location, nil
+}
+
+// sessionLocationByIdentity resolves the location of another booked listing; a listing that no longer has an
+// active version yields an empty location (treated as another city by the default estimator).
+func (s *photoSessionService) sessionLocationByIdentity(ctx context.Context, tx *sql.Tx, listingIdentityID int64) (photosessionmodel.SessionLocation, error) {
+	listing, err := s.listingRepo.GetActiveListingVersion(ctx, tx, listingIdentityID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return photosessionmodel.SessionLocation{}, nil
+		}
+		utils.SetSpanError(ctx, err)
+		utils.LoggerFromContext(ctx).Error("photo_session.assignment.get_listing_error", "listing_identity_id", listingIdentityID, "err", err)
+		return photosessionmodel.SessionLocation{}, derrors.Infra("failed to load listing", err)
+	}
+	return s.sessionLocation(ctx, tx, listing)
+}
+
+func describeAssignment(detail photosessionmodel.PhotographerAssignment, balanceDays int) string {
+	distance := "no earlier session that day"
+	if detail.DistanceKm != nil {
+		distance = fmt.Sprintf("%.1fkm from previous session", *detail.DistanceKm)
+	}
+	return fmt.Sprintf("best of %d candidates (score %.2f): %d booked minutes within ±%dd, approval rate %.0f%%, %s",
+		detail.Candidates, detail.Score, detail.BookedMinutes, balanceDays, detail.ApprovalRate*100, distance)
+}
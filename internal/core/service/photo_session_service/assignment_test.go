@@ -0,0 +1,97 @@
+package photosessionservices
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+)
+
+func TestMergeSlotsByWindow(t *testing.T) {
+	t.Parallel()
+
+	at := func(hour int) time.Time { return time.Date(2026, time.March, 2, hour, 0, 0, 0, time.UTC) }
+	slots := []AvailabilitySlot{
+		{PhotographerID: 3, Start: at(9), End: at(11)},
+		{PhotographerID: 5, Start: at(9), End: at(11)},
+		{PhotographerID: 5, Start: at(11), End: at(13)},
+		{PhotographerID: 3, Start: at(14), End: at(16)},
+	}
+
+	merged := mergeSlotsByWindow(slots)
+	parts := make([]string, 0, len(merged))
+	for _, slot := range merged {
+		photographerID, start := decodeSlotID(slot.SlotID)
+		if slot.PhotographerID != 0 || photographerID != 0 || !start.Equal(slot.Start) {
+			t.Fatalf("merged slot %+v keeps a photographer or a slotId not matching its start", slot)
+		}
+		parts = append(parts, slot.Start.Format("15")+"-"+slot.End.Format("15"))
+	}
+
+	if got, expected := strings.Join(parts, " "), "09-11 11-13 14-16"; got != expected {
+		t.Fatalf("mergeSlotsByWindow() = %q, expected %q", got, expected)
+	}
+}
+
+func TestAssignmentWeights(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		cfg      Config
+		expected [3]float64
+	}{
+		{name: "defaults", expected: [3]float64{defaultAssignmentLoadWeight, defaultAssignmentApprovalWeight, defaultAssignmentDistanceWeight}},
+		{name: "negative weights fall back to defaults", cfg: Config{AssignmentLoadWeight: -1, AssignmentApprovalWeight: -1}, expected: [3]float64{defaultAssignmentLoadWeight, defaultAssignmentApprovalWeight, defaultAssignmentDistanceWeight}},
+		{name: "configured weights", cfg: Config{AssignmentLoadWeight: 1, AssignmentApprovalWeight: 2, AssignmentDistanceWeight: 3}, expected: [3]float64{1, 2, 3}},
+		{name: "a single positive weight disables the others", cfg: Config{AssignmentLoadWeight: 1, AssignmentDistanceWeight: -2}, expected: [3]float64{1, 0, 0}},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := &photoSessionService{cfg: tt.cfg}
+			load, approval, distance := svc.assignmentWeights()
+			if got := [3]float64{load, approval, distance}; got != tt.expected {
+				t.Fatalf("assignmentWeights() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHaversineEstimator(t *testing.T) {
+	t.Parallel()
+
+	coordinate := func(value float64) *float64 { return &value }
+	paulista := photosessionmodel.SessionLocation{City: "São Paulo", State: "SP", Latitude: coordinate(-23.5614), Longitude: coordinate(-46.6559)}
+	ibirapuera := photosessionmodel.SessionLocation{City: "São Paulo", State: "SP", Latitude: coordinate(-23.5874), Longitude: coordinate(-46.6576)}
+
+	cases := []struct {
+		name     string
+		from     photosessionmodel.SessionLocation
+		to       photosessionmodel.SessionLocation
+		expected float64
+	}{
+		{name: "coordinates on both sides", from: paulista, to: ibirapuera, expected: 2.9},
+		{name: "same neighborhood without coordinates", from: photosessionmodel.SessionLocation{Neighborhood: "Pinheiros", City: "São Paulo", State: "SP"}, to: photosessionmodel.SessionLocation{Neighborhood: " pinheiros ", City: "SÃO PAULO", State: "sp"}, expected: sameNeighborhoodKm},
+		{name: "same city", from: photosessionmodel.SessionLocation{Neighborhood: "Pinheiros", City: "São Paulo", State: "SP"}, to: paulista, expected: sameCityKm},
+		{name: "same city name in another state", from: photosessionmodel.SessionLocation{City: "Bom Jesus", State: "PI"}, to: photosessionmodel.SessionLocation{City: "Bom Jesus", State: "RS"}, expected: otherCityKm},
+		{name: "unknown location", from: photosessionmodel.SessionLocation{}, to: paulista, expected: otherCityKm},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewHaversineEstimator().EstimateKm(tt.from, tt.to)
+			if math.Abs(got-tt.expected) > 0.05 {
+				t.Fatalf("EstimateKm() = %.2fkm, expected %.1fkm", got, tt.expected)
+			}
+		})
+	}
+}
@@ -28,6 +28,23 @@ func decodeSlotID(slotID uint64) (uint64, time.Time) {
 	return photographerID, time.Unix(seconds, 0).UTC()
 }
 
+// mergeSlotsByWindow collapses photographer slots with the same start into one window slot without photographer.
+func mergeSlotsByWindow(slots []AvailabilitySlot) []AvailabilitySlot {
+	seen := make(map[int64]struct{}, len(slots))
+	merged := make([]AvailabilitySlot, 0, len(slots))
+	for _, slot := range slots {
+		key := slot.Start.Unix()
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		slot.PhotographerID = 0
+		slot.SlotID = encodeSlotID(0, slot.Start.UTC())
+		merged = append(merged, slot)
+	}
+	return merged
+}
+
 func clampRange(r timeRange, min, max time.Time) (timeRange, bool) {
 	if r.end.Before(min) || r.start.After(max) {
 		return timeRange{}, false
@@ -186,6 +203,26 @@ func sortAvailabilitySlots(slots []AvailabilitySlot, sortKey string) {
 	}
 }
 
+// rankAvailabilitySlots keeps slots grouped by day and, within each day, puts photographers with a better
+// KPI score first. Ties fall back to the chronological order.
+func rankAvailabilitySlots(slots []AvailabilitySlot, scores map[uint64]float64) {
+	sort.Slice(slots, func(i, j int) bool {
+		dayI := slots[i].Start.Format(time.DateOnly)
+		dayJ := slots[j].Start.Format(time.DateOnly)
+		if dayI != dayJ {
+			return dayI < dayJ
+		}
+		scoreI, scoreJ := scores[slots[i].PhotographerID], scores[slots[j].PhotographerID]
+		if scoreI != scoreJ {
+			return scoreI > scoreJ
+		}
+		if !slots[i].Start.Equal(slots[j].Start) {
+			return slots[i].Start.Before(slots[j].Start)
+		}
+		return slots[i].PhotographerID < slots[j].PhotographerID
+	})
+}
+
 func deriveSlotDuration(requested, configured int) time.Duration {
 	base := configured
 	if base <= 0 {
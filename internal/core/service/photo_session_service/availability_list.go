@@ -8,10 +8,15 @@ import (
 	"time"
 
 	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
 	"github.com/projeto-toq/toq_server/internal/core/utils"
 )
 
 // ListAvailability computes booking availability windows for photographers.
+// With automatic assignment enabled, slots of different photographers sharing a start are merged into a single
+// window slot (PhotographerID 0) whose slotId is resolved to a photographer by ReservePhotoSession.
+// With travel buffers enabled, slots the photographer cannot reach from/to adjacent sessions are hidden.
+// Sorting by rank orders each day by photographer KPI score and requires manual assignment.
 func (s *photoSessionService) ListAvailability(ctx context.Context, input ListAvailabilityInput) (ListAvailabilityOutput, error) {
 	ctx, spanEnd, err := utils.GenerateBusinessTracer(ctx, "service.ListAvailability")
 	if err != nil {
@@ -69,6 +74,11 @@ func (s *photoSessionService) ListAvailability(ctx context.Context, input ListAv
 		return ListAvailabilityOutput{}, derrors.Validation("to must be after from", nil)
 	}
 
+	if input.Sort == availabilitySortRank && s.autoAssignmentEnabled() {
+		// Merged window slots have no photographer to rank.
+		return ListAvailabilityOutput{}, derrors.Validation("sort rank is not available with automatic assignment", map[string]any{"sort": "rank_requires_manual_assignment"})
+	}
+
 	slotDuration := deriveSlotDuration(input.DurationMinutes, s.cfg.SlotDurationMinutes)
 	if slotDuration < 0 {
 		return ListAvailabilityOutput{}, derrors.Validation("durationMinutes must match configured slot duration", map[string]any{"expected": s.cfg.SlotDurationMinutes})
@@ -128,9 +138,22 @@ func (s *photoSessionService) ListAvailability(ctx context.Context, input ListAv
 		}, nil
 	}
 
+	// With travel buffers, sessions just outside the range still constrain its first/last slots.
+	var travelMargin time.Duration
+	var target photosessionmodel.SessionLocation
+	locations := locationCache{}
+	if s.travelEnabled() {
+		travelMargin = s.maxTravelBuffer()
+		target, err = s.sessionLocation(ctx, tx, listing)
+		if err != nil {
+			return ListAvailabilityOutput{}, err
+		}
+		locations[input.ListingIdentityID] = target
+	}
+
 	availability := make([]AvailabilitySlot, 0)
 	for _, photographerID := range photographerIDs {
-		entries, err := s.repo.ListEntriesByRange(ctx, tx, photographerID, rangeStart.UTC(), rangeEnd.UTC(), nil)
+		entries, err := s.repo.ListEntriesByRange(ctx, tx, photographerID, rangeStart.Add(-travelMargin).UTC(), rangeEnd.Add(travelMargin).UTC(), nil)
 		if err != nil {
 			utils.SetSpanError(ctx, err)
 			logger.Error("photo_session.list_availability.list_entries_error", "photographer_id", photographerID, "err", err)
@@ -141,9 +164,20 @@ func (s *photoSessionService) ListAvailability(ctx context.Context, input ListAv
 		freeRanges := applyBlockingEntries(workingRanges, entries, loc)
 		freeRanges = prunePastRanges(freeRanges, earliestStart)
 
+		var sessions []travelSession
+		if s.travelEnabled() {
+			sessions, err = s.travelSessionsFromEntries(ctx, tx, locations, entries, 0)
+			if err != nil {
+				return ListAvailabilityOutput{}, err
+			}
+		}
+
 		for _, free := range freeRanges {
 			slots := splitIntoSlots(free, slotDuration)
 			for _, slot := range slots {
+				if len(sessions) > 0 && !s.slotReachable(slot.start, slot.end, input.ListingIdentityID, target, sessions) {
+					continue
+				}
 				period := determineSlotPeriod(slot.start)
 				id := encodeSlotID(photographerID, slot.start.UTC())
 				availability = append(availability, AvailabilitySlot{
@@ -158,7 +192,20 @@ func (s *photoSessionService) ListAvailability(ctx context.Context, input ListAv
 		}
 	}
 
-	sortAvailabilitySlots(availability, input.Sort)
+	if s.autoAssignmentEnabled() {
+		// Owners choose only the window; the photographer is assigned on reservation.
+		availability = mergeSlotsByWindow(availability)
+	}
+
+	if input.Sort == availabilitySortRank {
+		scores, err := s.photographerScores(ctx, tx, photographerIDs)
+		if err != nil {
+			return ListAvailabilityOutput{}, err
+		}
+		rankAvailabilitySlots(availability, scores)
+	} else {
+		sortAvailabilitySlots(availability, input.Sort)
+	}
 
 	total := len(availability)
 	start := (page - 1) * size
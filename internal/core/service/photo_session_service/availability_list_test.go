@@ -0,0 +1,84 @@
+package photosessionservices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/projeto-toq/toq_server/internal/core/derrors"
+)
+
+func TestRankAvailabilitySlots(t *testing.T) {
+	t.Parallel()
+
+	at := func(day, hour int) time.Time { return time.Date(2026, time.March, day, hour, 0, 0, 0, time.UTC) }
+	slot := func(photographerID uint64, day, hour int) AvailabilitySlot {
+		return AvailabilitySlot{PhotographerID: photographerID, Start: at(day, hour), End: at(day, hour+2)}
+	}
+
+	cases := []struct {
+		name     string
+		slots    []AvailabilitySlot
+		scores   map[uint64]float64
+		expected string
+	}{
+		{
+			name:     "higher score first within the day",
+			slots:    []AvailabilitySlot{slot(1, 2, 9), slot(2, 2, 14), slot(3, 2, 11)},
+			scores:   map[uint64]float64{1: 0.4, 2: 0.9, 3: 0.6},
+			expected: "2@02T14 3@02T11 1@02T09",
+		},
+		{
+			name:     "days stay chronological",
+			slots:    []AvailabilitySlot{slot(1, 3, 9), slot(2, 2, 14), slot(1, 2, 9)},
+			scores:   map[uint64]float64{1: 0.9, 2: 0.1},
+			expected: "1@02T09 2@02T14 1@03T09",
+		},
+		{
+			name:     "same score keeps start then photographer order",
+			slots:    []AvailabilitySlot{slot(2, 2, 11), slot(3, 2, 9), slot(1, 2, 11)},
+			scores:   map[uint64]float64{1: 0.5, 2: 0.5, 3: 0.5},
+			expected: "3@02T09 1@02T11 2@02T11",
+		},
+		{
+			name:     "unknown photographers rank last",
+			slots:    []AvailabilitySlot{slot(9, 2, 9), slot(1, 2, 14)},
+			scores:   map[uint64]float64{1: 0.3},
+			expected: "1@02T14 9@02T09",
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rankAvailabilitySlots(tt.slots, tt.scores)
+			parts := make([]string, 0, len(tt.slots))
+			for _, s := range tt.slots {
+				parts = append(parts, fmt.Sprintf("%d@%s", s.PhotographerID, s.Start.Format("02T15")))
+			}
+			if got := strings.Join(parts, " "); got != tt.expected {
+				t.Fatalf("rankAvailabilitySlots() = %q, expected %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestListAvailabilityRejectsRankWithAutoAssignment(t *testing.T) {
+	t.Parallel()
+
+	svc := &photoSessionService{
+		cfg: Config{AssignmentMode: "AUTO", BusinessStartHour: 8, BusinessEndHour: 18, AgendaHorizonMonths: 1},
+		now: func() time.Time { return time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC) },
+	}
+
+	_, err := svc.ListAvailability(context.Background(), ListAvailabilityInput{ListingIdentityID: 1, Sort: availabilitySortRank})
+	var derr *derrors.E
+	if !errors.As(err, &derr) || derr.Kind() != derrors.KindValidation {
+		t.Fatalf("ListAvailability(sort rank, auto assignment) error = %v, expected a validation error", err)
+	}
+}
@@ -10,4 +10,35 @@ type Config struct {
 	BusinessEndHour             int
 	AgendaHorizonMonths         int
 	RequirePhotographerApproval bool
+	// RescheduleCutoffHours is the minimum notice before the booked start for an owner reschedule (<= 0 → 24h).
+	RescheduleCutoffHours int
+	// MaxReschedulesPerBooking caps how many times a booking can be moved (<= 0 → unlimited).
+	MaxReschedulesPerBooking int
+	// AssignmentMode selects "owner_choice" (photographer-specific slots) or "auto" (time windows, the service
+	// picks the photographer); case-insensitive, empty or unknown values behave as owner_choice.
+	AssignmentMode string
+	// AssignmentBalanceDays is the half-width of the window used to sum booked minutes (<= 0 → 7 days).
+	AssignmentBalanceDays int
+	// Assignment weights for load, approval rate and travel distance; all <= 0 → 0.5/0.2/0.3.
+	AssignmentLoadWeight     float64
+	AssignmentApprovalWeight float64
+	AssignmentDistanceWeight float64
+	// AssignmentMaxDistanceKm is the distance at which the travel score reaches zero (<= 0 → 30km).
+	AssignmentMaxDistanceKm float64
+	// TravelBufferEnabled hides slots a photographer cannot reach from/to adjacent sessions in time.
+	TravelBufferEnabled bool
+	// TravelSpeedKmh is the average driving speed used to turn distance into minutes (<= 0 → 25 km/h).
+	TravelSpeedKmh float64
+	// TravelMinBufferMinutes is a fixed setup gap added between sessions at different listings (<= 0 → none).
+	TravelMinBufferMinutes int
+	// TravelMaxBufferMinutes caps a single travel buffer (<= 0 → 120).
+	TravelMaxBufferMinutes int
+	// KPIArrivalGraceMinutes is how late after the booked start an arrival still counts as on time (<= 0 → 15).
+	KPIArrivalGraceMinutes int
+	// KPIUploadTargetHours is the session-to-upload time that scores 0.5 in slot ranking (<= 0 → 48).
+	KPIUploadTargetHours int
+	// KPIRankingWindowDays limits the session history used to rank photographers in slot listings (<= 0 → 180).
+	KPIRankingWindowDays int
+	// DistanceEstimator overrides the travel estimate between sessions (nil → haversine with address fallback).
+	DistanceEstimator DistanceEstimator
 }
@@ -1,5 +1,7 @@
 package photosessionservices
 
+import "time"
+
 const (
 	// defaultHorizonMonths    = 3
 	// defaultWorkdayStartHour = 8
@@ -14,4 +16,37 @@ const (
 	defaultServiceAreaPage = 1
 	defaultServiceAreaSize = 20
 	maxServiceAreaPageSize = 100
+	// defaultRescheduleCutoff applies when photo_session.reschedule_cutoff_hours is not configured.
+	defaultRescheduleCutoff = 24 * time.Hour
+	maxRescheduleReasonLen  = 255
+	defaultReschedulePage   = 1
+	defaultRescheduleSize   = 20
+	maxReschedulePageSize   = 100
+	// Automatic assignment defaults (photo_session.assignment.*).
+	defaultAssignmentBalanceDays    = 7
+	defaultAssignmentLoadWeight     = 0.5
+	defaultAssignmentApprovalWeight = 0.2
+	defaultAssignmentDistanceWeight = 0.3
+	defaultAssignmentMaxDistanceKm  = 30.0
+	// Travel buffer defaults (photo_session.travel.*).
+	defaultTravelSpeedKmh         = 25.0
+	defaultTravelMaxBufferMinutes = 120
+	// Photographer KPI defaults (photo_session.kpi.*).
+	defaultKPIArrivalGraceMinutes = 15
+	defaultKPIUploadTargetHours   = 48
+	defaultKPIRankingWindowDays   = 180
+	// arrivalWindowBefore is how early before the booked start a photographer may confirm arrival.
+	arrivalWindowBefore  = 2 * time.Hour
+	maxRatingCommentLen  = 500
+	defaultKPIReportPage = 1
+	defaultKPIReportSize = 20
+	maxKPIReportPageSize = 100
+	// availabilitySortRank orders slots by photographer KPI score within each day.
+	availabilitySortRank = "rank"
+	// payoutPeriodLayout formats ledger periods (month of the session start in defaultTimezone).
+	payoutPeriodLayout = "2006-01"
+	// Calendar import limits: document size, expanded occurrences and how far ahead events are read.
+	maxImportCalendarBytes = 1 << 20
+	maxImportOccurrences   = 500
+	importWindow           = 365 * 24 * time.Hour
 )
@@ -0,0 +1,56 @@
+package photosessionservices
+
+import (
+	"math"
+	"strings"
+
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+)
+
+const (
+	earthRadiusKm = 6371.0
+	// Address-based fallbacks used when either side has no coordinates.
+	sameNeighborhoodKm = 2.0
+	sameCityKm         = 10.0
+	otherCityKm        = 40.0
+)
+
+// DistanceEstimator estimates the travel distance in kilometres between two session locations.
+// Implementations must be safe for concurrent use.
+type DistanceEstimator interface {
+	EstimateKm(from, to photosessionmodel.SessionLocation) float64
+}
+
+type haversineEstimator struct{}
+
+// NewHaversineEstimator returns the default estimator: great-circle distance when both locations carry
+// coordinates, otherwise a coarse guess based on neighborhood/city equality.
+func NewHaversineEstimator() DistanceEstimator {
+	return haversineEstimator{}
+}
+
+func (haversineEstimator) EstimateKm(from, to photosessionmodel.SessionLocation) float64 {
+	if from.Latitude != nil && from.Longitude != nil && to.Latitude != nil && to.Longitude != nil {
+		return haversineKm(*from.Latitude, *from.Longitude, *to.Latitude, *to.Longitude)
+	}
+
+	sameCity := strings.EqualFold(strings.TrimSpace(from.City), strings.TrimSpace(to.City)) &&
+		strings.EqualFold(strings.TrimSpace(from.State), strings.TrimSpace(to.State))
+	if !sameCity {
+		return otherCityKm
+	}
+	neighborhood := strings.TrimSpace(from.Neighborhood)
+	if neighborhood != "" && strings.EqualFold(neighborhood, strings.TrimSpace(to.Neighborhood)) {
+		return sameNeighborhoodKm
+	}
+	return sameCityKm
+}
+
+func haversineKm(lat1, lng1, lat2, lng2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat := toRad(lat2 - lat1)
+	dLng := toRad(lng2 - lng1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
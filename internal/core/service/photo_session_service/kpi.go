@@ -0,0 +1,419 @@
+package photosessionservices
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// sessionKPIForBooking loads the KPI row of a booking, or starts a new one. While the session is still open
+// the photographer and start are refreshed from the booking, so reschedules move the KPI along.
+func (s *photoSessionService) sessionKPIForBooking(ctx context.Context, tx *sql.Tx, booking photosessionmodel.PhotoSessionBookingInterface) (photosessionmodel.SessionKPIInterface, error) {
+	kpi, err := s.repo.GetSessionKPIForUpdate(ctx, tx, booking.ID())
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			utils.SetSpanError(ctx, err)
+			utils.LoggerFromContext(ctx).Error("photo_session.kpi.get_error", "booking_id", booking.ID(), "err", err)
+			return nil, derrors.Infra("failed to load session kpi", err)
+		}
+		kpi = photosessionmodel.NewSessionKPI()
+		kpi.SetBookingID(booking.ID())
+	}
+	if kpi.CompletedAt() == nil {
+		kpi.SetPhotographerUserID(booking.PhotographerUserID())
+		kpi.SetListingIdentityID(booking.ListingIdentityID())
+		kpi.SetSessionStartsAt(booking.StartsAt().UTC())
+	}
+	return kpi, nil
+}
+
+func (s *photoSessionService) saveSessionKPI(ctx context.Context, tx *sql.Tx, kpi photosessionmodel.SessionKPIInterface) error {
+	if err := s.repo.UpsertSessionKPI(ctx, tx, kpi); err != nil {
+		utils.SetSpanError(ctx, err)
+		utils.LoggerFromContext(ctx).Error("photo_session.kpi.upsert_error", "booking_id", kpi.BookingID(), "err", err)
+		return derrors.Infra("failed to save session kpi", err)
+	}
+	return nil
+}
+
+// recordSessionCompletion stamps the completion time of a booking marked DONE inside the caller's transaction.
+func (s *photoSessionService) recordSessionCompletion(ctx context.Context, tx *sql.Tx, booking photosessionmodel.PhotoSessionBookingInterface) error {
+	kpi, err := s.sessionKPIForBooking(ctx, tx, booking)
+	if err != nil {
+		return err
+	}
+	now := s.now().UTC()
+	kpi.SetCompletedAt(&now)
+	return s.saveSessionKPI(ctx, tx, kpi)
+}
+
+// ConfirmArrival records that the photographer arrived at the session location.
+// Arrival can be confirmed once, from arrivalWindowBefore the booked start until the booked end; it is on time
+// when confirmed up to kpi.arrival_grace_minutes after the start.
+func (s *photoSessionService) ConfirmArrival(ctx context.Context, input ConfirmArrivalInput) (ConfirmArrivalOutput, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return ConfirmArrivalOutput{}, derrors.Infra("failed to generate tracer", err)
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	if input.PhotographerID == 0 {
+		return ConfirmArrivalOutput{}, derrors.Auth("unauthorized")
+	}
+	if input.SessionID == 0 {
+		return ConfirmArrivalOutput{}, derrors.Validation("photoSessionId must be greater than zero", map[string]any{"photoSessionId": "greater_than_zero"})
+	}
+
+	tx, err := s.globalService.StartTransaction(ctx)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.arrival.tx_start_error", "err", err)
+		return ConfirmArrivalOutput{}, derrors.Infra("failed to start transaction", err)
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			if rollbackErr := s.globalService.RollbackTransaction(ctx, tx); rollbackErr != nil {
+				utils.SetSpanError(ctx, rollbackErr)
+				logger.Error("photo_session.arrival.tx_rollback_error", "err", rollbackErr)
+			}
+		}
+	}()
+
+	booking, err := s.repo.GetBookingByIDForUpdate(ctx, tx, input.SessionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ConfirmArrivalOutput{}, derrors.NotFound("session not found")
+		}
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.arrival.get_booking_error", "session_id", input.SessionID, "err", err)
+		return ConfirmArrivalOutput{}, derrors.Infra("failed to load session booking", err)
+	}
+
+	if booking.PhotographerUserID() != input.PhotographerID {
+		return ConfirmArrivalOutput{}, derrors.Forbidden("session does not belong to photographer")
+	}
+	if booking.Status() != photosessionmodel.BookingStatusAccepted && booking.Status() != photosessionmodel.BookingStatusActive {
+		return ConfirmArrivalOutput{}, derrors.Conflict("session must be accepted or active to confirm arrival")
+	}
+
+	now := s.now().UTC()
+	if now.Before(booking.StartsAt().Add(-arrivalWindowBefore)) || now.After(booking.EndsAt()) {
+		return ConfirmArrivalOutput{}, derrors.Conflict("arrival can only be confirmed around the session time")
+	}
+
+	kpi, err := s.sessionKPIForBooking(ctx, tx, booking)
+	if err != nil {
+		return ConfirmArrivalOutput{}, err
+	}
+	if kpi.ArrivedAt() != nil {
+		return ConfirmArrivalOutput{}, derrors.Conflict("arrival already confirmed")
+	}
+
+	grace := s.cfg.KPIArrivalGraceMinutes
+	if grace <= 0 {
+		grace = defaultKPIArrivalGraceMinutes
+	}
+	onTime := !now.After(booking.StartsAt().Add(time.Duration(grace) * time.Minute))
+	kpi.SetArrivedAt(&now)
+	kpi.SetArrivalOnTime(&onTime)
+
+	if err := s.saveSessionKPI(ctx, tx, kpi); err != nil {
+		return ConfirmArrivalOutput{}, err
+	}
+
+	if err := s.globalService.CommitTransaction(ctx, tx); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.arrival.tx_commit_error", "session_id", booking.ID(), "err", err)
+		return ConfirmArrivalOutput{}, derrors.Infra("failed to commit transaction", err)
+	}
+	committed = true
+
+	metricPhotoSessionArrivals.WithLabelValues(strconv.FormatBool(onTime)).Inc()
+	logger.Info("photo_session.arrival.confirmed", "session_id", booking.ID(), "photographer_id", input.PhotographerID, "on_time", onTime)
+
+	return ConfirmArrivalOutput{SessionID: booking.ID(), ArrivedAt: now, OnTime: onTime}, nil
+}
+
+// RecordMediaUploadWithTx stamps the first media submission of the listing's latest completed session.
+func (s *photoSessionService) RecordMediaUploadWithTx(ctx context.Context, tx *sql.Tx, listingIdentityID int64) error {
+	kpi, found, err := s.latestCompletedSessionKPI(ctx, tx, listingIdentityID)
+	if err != nil || !found || kpi.MediaUploadedAt() != nil {
+		return err
+	}
+	now := s.now().UTC()
+	kpi.SetMediaUploadedAt(&now)
+	return s.saveSessionKPI(ctx, tx, kpi)
+}
+
+// RecordOwnerMediaDecisionWithTx ties the owner's media approval or rejection back to the photographer of the
+// listing's latest completed session. Rejections accumulate; the first approval is kept.
+func (s *photoSessionService) RecordOwnerMediaDecisionWithTx(ctx context.Context, tx *sql.Tx, listingIdentityID int64, approved bool) error {
+	kpi, found, err := s.latestCompletedSessionKPI(ctx, tx, listingIdentityID)
+	if err != nil || !found {
+		return err
+	}
+	if approved {
+		if kpi.OwnerApprovedAt() != nil {
+			return nil
+		}
+		now := s.now().UTC()
+		kpi.SetOwnerApprovedAt(&now)
+	} else {
+		kpi.SetOwnerRejections(kpi.OwnerRejections() + 1)
+	}
+	return s.saveSessionKPI(ctx, tx, kpi)
+}
+
+func (s *photoSessionService) latestCompletedSessionKPI(ctx context.Context, tx *sql.Tx, listingIdentityID int64) (photosessionmodel.SessionKPIInterface, bool, error) {
+	kpi, err := s.repo.GetLatestCompletedSessionKPIForUpdate(ctx, tx, listingIdentityID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, nil
+		}
+		utils.SetSpanError(ctx, err)
+		utils.LoggerFromContext(ctx).Error("photo_session.kpi.get_latest_error", "listing_identity_id", listingIdentityID, "err", err)
+		return nil, false, derrors.Infra("failed to load session kpi", err)
+	}
+	return kpi, true, nil
+}
+
+// RateSession stores the owner's 1–5 star rating of the photographer for the listing's latest completed
+// session. Only allowed after the owner approved the media, and only once.
+func (s *photoSessionService) RateSession(ctx context.Context, input RateSessionInput) (RateSessionOutput, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return RateSessionOutput{}, derrors.Infra("failed to generate tracer", err)
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	if input.UserID <= 0 {
+		return RateSessionOutput{}, derrors.Auth("unauthorized")
+	}
+	if input.ListingIdentityID <= 0 {
+		return RateSessionOutput{}, derrors.Validation("listingIdentityId must be greater than zero", map[string]any{"listingIdentityId": "greater_than_zero"})
+	}
+	if input.Rating < photosessionmodel.MinSessionRating || input.Rating > photosessionmodel.MaxSessionRating {
+		return RateSessionOutput{}, derrors.Validation("rating must be between 1 and 5", map[string]any{"rating": input.Rating})
+	}
+	var comment *string
+	if input.Comment != nil {
+		trimmed := strings.TrimSpace(*input.Comment)
+		if len(trimmed) > maxRatingCommentLen {
+			return RateSessionOutput{}, derrors.Validation("comment must have at most 500 characters", map[string]any{"comment": "too_long"})
+		}
+		if trimmed != "" {
+			comment = &trimmed
+		}
+	}
+
+	tx, err := s.globalService.StartTransaction(ctx)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.rating.tx_start_error", "err", err)
+		return RateSessionOutput{}, derrors.Infra("failed to start transaction", err)
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			if rollbackErr := s.globalService.RollbackTransaction(ctx, tx); rollbackErr != nil {
+				utils.SetSpanError(ctx, rollbackErr)
+				logger.Error("photo_session.rating.tx_rollback_error", "err", rollbackErr)
+			}
+		}
+	}()
+
+	listing, err := s.listingRepo.GetActiveListingVersion(ctx, tx, input.ListingIdentityID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return RateSessionOutput{}, utils.NotFoundError("Listing")
+		}
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.rating.get_listing_error", "listing_identity_id", input.ListingIdentityID, "err", err)
+		return RateSessionOutput{}, derrors.Infra("failed to load listing", err)
+	}
+	if listing.UserID() != input.UserID {
+		return RateSessionOutput{}, derrors.Forbidden("listing does not belong to user")
+	}
+
+	kpi, found, err := s.latestCompletedSessionKPI(ctx, tx, input.ListingIdentityID)
+	if err != nil {
+		return RateSessionOutput{}, err
+	}
+	if !found {
+		return RateSessionOutput{}, derrors.NotFound("completed photo session not found")
+	}
+	if kpi.OwnerApprovedAt() == nil {
+		return RateSessionOutput{}, derrors.Conflict("media must be approved before rating the photographer")
+	}
+	if kpi.Rating() != nil {
+		return RateSessionOutput{}, derrors.Conflict("photo session already rated")
+	}
+
+	rating := input.Rating
+	now := s.now().UTC()
+	kpi.SetRating(&rating)
+	kpi.SetRatingComment(comment)
+	kpi.SetRatedAt(&now)
+
+	if err := s.saveSessionKPI(ctx, tx, kpi); err != nil {
+		return RateSessionOutput{}, err
+	}
+
+	if err := s.globalService.CommitTransaction(ctx, tx); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.rating.tx_commit_error", "booking_id", kpi.BookingID(), "err", err)
+		return RateSessionOutput{}, derrors.Infra("failed to commit transaction", err)
+	}
+	committed = true
+
+	logger.Info("photo_session.rating.recorded", "booking_id", kpi.BookingID(), "photographer_id", kpi.PhotographerUserID(), "rating", rating)
+
+	return RateSessionOutput{PhotoSessionID: kpi.BookingID(), PhotographerID: kpi.PhotographerUserID(), Rating: rating}, nil
+}
+
+// GetPhotographerKPIReport aggregates delivery KPIs per photographer for sessions starting in [From, To),
+// together with the score used to rank their slots.
+func (s *photoSessionService) GetPhotographerKPIReport(ctx context.Context, input PhotographerKPIReportInput) (PhotographerKPIReportOutput, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return PhotographerKPIReportOutput{}, derrors.Infra("failed to generate tracer", err)
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	if input.From != nil && input.To != nil && !input.To.After(*input.From) {
+		return PhotographerKPIReportOutput{}, derrors.Validation("to must be after from", map[string]any{"to": "after_from"})
+	}
+
+	page := input.Page
+	if page <= 0 {
+		page = defaultKPIReportPage
+	}
+	size := input.Size
+	if size <= 0 {
+		size = defaultKPIReportSize
+	}
+	if size > maxKPIReportPageSize {
+		size = maxKPIReportPageSize
+	}
+
+	filter := photosessionmodel.PhotographerKPIFilter{
+		From:   input.From,
+		To:     input.To,
+		Offset: (page - 1) * size,
+		Limit:  size,
+	}
+	if input.PhotographerID > 0 {
+		filter.PhotographerIDs = []uint64{input.PhotographerID}
+	}
+
+	tx, err := s.globalService.StartReadOnlyTransaction(ctx)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.kpi_report.tx_start_error", "err", err)
+		return PhotographerKPIReportOutput{}, derrors.Infra("failed to start transaction", err)
+	}
+	defer func() {
+		if rollbackErr := s.globalService.RollbackTransaction(ctx, tx); rollbackErr != nil {
+			utils.SetSpanError(ctx, rollbackErr)
+			logger.Error("photo_session.kpi_report.tx_rollback_error", "err", rollbackErr)
+		}
+	}()
+
+	kpis, err := s.repo.ListPhotographerKPIs(ctx, tx, filter)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.kpi_report.list_error", "err", err)
+		return PhotographerKPIReportOutput{}, derrors.Infra("failed to list photographer kpis", err)
+	}
+
+	total, err := s.repo.CountPhotographerKPIGroups(ctx, tx, filter)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.kpi_report.count_error", "err", err)
+		return PhotographerKPIReportOutput{}, derrors.Infra("failed to count photographer kpis", err)
+	}
+
+	items := make([]PhotographerKPIReportItem, 0, len(kpis))
+	for _, kpi := range kpis {
+		items = append(items, PhotographerKPIReportItem{PhotographerKPI: kpi, Score: s.kpiScore(kpi)})
+	}
+
+	return PhotographerKPIReportOutput{Items: items, Total: total, Page: page, Size: size}, nil
+}
+
+// kpiScore blends the KPIs into [0,1], averaging four smoothed factors so photographers with little history
+// sit near the middle instead of the extremes:
+//   - punctuality: (on time+1)/(confirmed arrivals+2)
+//   - acceptance: (owner approvals+1)/(approvals+rejections+2)
+//   - delivery: target/(target+average upload time), 0.5 without uploads
+//   - rating: average stars with a 3-star prior worth two ratings, divided by 5
+func (s *photoSessionService) kpiScore(kpi photosessionmodel.PhotographerKPI) float64 {
+	punctuality := float64(kpi.ArrivalsOnTime+1) / float64(kpi.ArrivalsConfirmed+2)
+	acceptance := float64(kpi.OwnerApprovals+1) / float64(kpi.OwnerApprovals+kpi.OwnerRejections+2)
+
+	delivery := 0.5
+	if kpi.AvgUploadMinutes != nil {
+		targetHours := s.cfg.KPIUploadTargetHours
+		if targetHours <= 0 {
+			targetHours = defaultKPIUploadTargetHours
+		}
+		target := float64(targetHours * 60)
+		delivery = target / (target + max(*kpi.AvgUploadMinutes, 0))
+	}
+
+	var ratingSum float64
+	if kpi.AvgRating != nil {
+		ratingSum = *kpi.AvgRating * float64(kpi.Ratings)
+	}
+	rating := (ratingSum + 3*2) / float64(kpi.Ratings+2) / photosessionmodel.MaxSessionRating
+
+	return (punctuality + acceptance + delivery + rating) / 4
+}
+
+// photographerScores returns the ranking score of each photographer over the configured KPI window.
+// Photographers without history get the score of an empty record.
+func (s *photoSessionService) photographerScores(ctx context.Context, tx *sql.Tx, photographerIDs []uint64) (map[uint64]float64, error) {
+	windowDays := s.cfg.KPIRankingWindowDays
+	if windowDays <= 0 {
+		windowDays = defaultKPIRankingWindowDays
+	}
+	from := s.now().UTC().AddDate(0, 0, -windowDays)
+
+	kpis, err := s.repo.ListPhotographerKPIs(ctx, tx, photosessionmodel.PhotographerKPIFilter{
+		PhotographerIDs: photographerIDs,
+		From:            &from,
+	})
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		utils.LoggerFromContext(ctx).Error("photo_session.kpi.scores_error", "err", err)
+		return nil, derrors.Infra("failed to load photographer kpis", err)
+	}
+
+	scores := make(map[uint64]float64, len(photographerIDs))
+	for _, id := range photographerIDs {
+		scores[id] = s.kpiScore(photosessionmodel.PhotographerKPI{PhotographerID: id})
+	}
+	for _, kpi := range kpis {
+		scores[kpi.PhotographerID] = s.kpiScore(kpi)
+	}
+	return scores, nil
+}
@@ -0,0 +1,89 @@
+package photosessionservices
+
+import (
+	"math"
+	"testing"
+
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+)
+
+func TestKPIScore(t *testing.T) {
+	t.Parallel()
+
+	minutes := func(value float64) *float64 { return &value }
+	stars := func(value float64) *float64 { return &value }
+
+	cases := []struct {
+		name        string
+		targetHours int
+		kpi         photosessionmodel.PhotographerKPI
+		expected    float64
+	}{
+		{
+			// Every factor at its prior: (0.5 + 0.5 + 0.5 + 3/5) / 4.
+			name:     "no history",
+			expected: 0.525,
+		},
+		{
+			name: "perfect record",
+			kpi: photosessionmodel.PhotographerKPI{
+				ArrivalsConfirmed: 8, ArrivalsOnTime: 8,
+				OwnerApprovals: 8,
+				Uploads:        8, AvgUploadMinutes: minutes(0),
+				Ratings: 8, AvgRating: stars(5),
+			},
+			// (9/10 + 9/10 + 1 + (40+6)/10/5) / 4
+			expected: (0.9 + 0.9 + 1 + 0.92) / 4,
+		},
+		{
+			name: "poor record",
+			kpi: photosessionmodel.PhotographerKPI{
+				ArrivalsConfirmed: 8,
+				OwnerRejections:   8,
+				Uploads:           8, AvgUploadMinutes: minutes(3 * 48 * 60),
+				Ratings: 8, AvgRating: stars(1),
+			},
+			// (1/10 + 1/10 + 1/4 + (8+6)/10/5) / 4
+			expected: (0.1 + 0.1 + 0.25 + 0.28) / 4,
+		},
+		{
+			name:        "upload at the configured target scores half",
+			targetHours: 24,
+			kpi:         photosessionmodel.PhotographerKPI{Uploads: 3, AvgUploadMinutes: minutes(24 * 60)},
+			expected:    0.525,
+		},
+		{
+			name:     "negative upload time counts as immediate",
+			kpi:      photosessionmodel.PhotographerKPI{Uploads: 1, AvgUploadMinutes: minutes(-30)},
+			expected: (0.5 + 0.5 + 1 + 0.6) / 4,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := &photoSessionService{cfg: Config{KPIUploadTargetHours: tt.targetHours}}
+			if got := svc.kpiScore(tt.kpi); math.Abs(got-tt.expected) > 1e-9 {
+				t.Fatalf("kpiScore(%+v) = %v, expected %v", tt.kpi, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestKPIScoreSmoothsShortHistories(t *testing.T) {
+	t.Parallel()
+
+	svc := &photoSessionService{}
+	oneLate := svc.kpiScore(photosessionmodel.PhotographerKPI{ArrivalsConfirmed: 1})
+	manyOnTime := svc.kpiScore(photosessionmodel.PhotographerKPI{ArrivalsConfirmed: 20, ArrivalsOnTime: 19})
+	empty := svc.kpiScore(photosessionmodel.PhotographerKPI{})
+
+	if !(oneLate < empty && empty < manyOnTime) {
+		t.Fatalf("kpiScore ordering = one late %v, empty %v, many on time %v; expected increasing", oneLate, empty, manyOnTime)
+	}
+	if oneLate < 0.4 {
+		t.Fatalf("kpiScore with a single late arrival = %v, expected the prior to keep it near the middle", oneLate)
+	}
+}
@@ -11,9 +11,29 @@ var (
 		Name: "photo_session_agenda_cleaner_deleted_total",
 		Help: "Total number of agenda entries deleted by the retention cleaner",
 	})
+	metricPhotoSessionReschedules = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "photo_session_reschedules_total",
+		Help: "Total number of photo session reschedules, labelled by whether the photographer changed",
+	}, []string{"photographer_changed"})
+	metricPhotoSessionAutoAssignments = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "photo_session_auto_assignments_total",
+		Help: "Total number of automatic photographer assignment attempts, labelled by outcome",
+	}, []string{"outcome"})
+	metricPhotoSessionPayoutLines = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "photo_session_payout_lines_total",
+		Help: "Total number of payout ledger lines created, labelled by whether a pricing rule matched",
+	}, []string{"rule_matched"})
+	metricPhotoSessionArrivals = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "photo_session_arrivals_total",
+		Help: "Total number of photographer arrival confirmations, labelled by whether they were on time",
+	}, []string{"on_time"})
 )
 
 func init() {
 	prometheus.MustRegister(metricPhotoSessionBookingsDeleted)
 	prometheus.MustRegister(metricPhotoSessionAgendaDeleted)
+	prometheus.MustRegister(metricPhotoSessionReschedules)
+	prometheus.MustRegister(metricPhotoSessionAutoAssignments)
+	prometheus.MustRegister(metricPhotoSessionPayoutLines)
+	prometheus.MustRegister(metricPhotoSessionArrivals)
 }
@@ -0,0 +1,400 @@
+package photosessionservices
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	holidaymodel "github.com/projeto-toq/toq_server/internal/core/model/holiday_model"
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+const noPayoutRuleReason = "no matching payout rule"
+
+// recordPayoutLine prices a booking that has just been marked DONE and stores its ledger line inside the
+// caller's transaction.
+//
+// Pricing picks the active rule matching property type, session type and land size with the highest
+// specificity (ties → lowest rule ID). Sessions starting on a weekend or on a holiday of the listing city
+// get the larger of the two surcharges; they never stack. Without a matching rule the line is still
+// recorded with amount 0 so finance can spot and fix the gap.
+func (s *photoSessionService) recordPayoutLine(ctx context.Context, tx *sql.Tx, booking photosessionmodel.PhotoSessionBookingInterface, listing listingmodel.ListingInterface) error {
+	logger := utils.LoggerFromContext(ctx)
+
+	loc, err := resolveLocation("")
+	if err != nil {
+		return err
+	}
+
+	rules, err := s.repo.ListPayoutRules(ctx, tx, true)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.payout.list_rules_error", "err", err)
+		return derrors.Infra("failed to load payout rules", err)
+	}
+
+	start := booking.StartsAt().In(loc)
+	propertyType := uint16(listing.ListingType())
+	sessionType := booking.SessionType()
+	size := listing.LandSize()
+
+	line := photosessionmodel.NewPayoutLedgerLine()
+	line.SetBookingID(booking.ID())
+	line.SetPhotographerUserID(booking.PhotographerUserID())
+	line.SetListingIdentityID(booking.ListingIdentityID())
+	line.SetSessionType(sessionType)
+	line.SetPropertyType(propertyType)
+	line.SetSizeM2(size)
+	line.SetSessionStartsAt(booking.StartsAt().UTC())
+	line.SetPeriod(start.Format(payoutPeriodLayout))
+	line.SetStatus(photosessionmodel.PayoutStatusPending)
+
+	rule, found := selectPayoutRule(rules, propertyType, sessionType, size)
+	if !found {
+		reason := noPayoutRuleReason
+		line.SetSurchargeReason(&reason)
+		logger.Warn("photo_session.payout.no_rule",
+			"booking_id", booking.ID(),
+			"property_type", propertyType,
+			"session_type", sessionType,
+			"size_m2", size)
+	} else {
+		ruleID := rule.ID
+		line.SetRuleID(&ruleID)
+		line.SetBaseAmountCents(rule.BaseAmountCents)
+
+		var pct float64
+		var reason string
+		if weekday := start.Weekday(); weekday == time.Saturday || weekday == time.Sunday {
+			pct = rule.WeekendSurchargePct
+			reason = "weekend"
+		}
+		if rule.HolidaySurchargePct > pct {
+			label, holiday, holidayErr := s.holidayOn(ctx, listing, start, loc)
+			if holidayErr != nil {
+				return holidayErr
+			}
+			if holiday {
+				pct = rule.HolidaySurchargePct
+				reason = "holiday: " + label
+			}
+		}
+
+		line.SetSurchargePct(pct)
+		if pct > 0 {
+			line.SetSurchargeReason(&reason)
+		}
+		line.SetAmountCents(rule.BaseAmountCents + int64(math.Round(float64(rule.BaseAmountCents)*pct/100)))
+	}
+
+	if _, err := s.repo.CreatePayoutLine(ctx, tx, line); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.payout.create_line_error", "booking_id", booking.ID(), "err", err)
+		return derrors.Infra("failed to record payout line", err)
+	}
+
+	metricPhotoSessionPayoutLines.WithLabelValues(fmt.Sprintf("%t", found)).Inc()
+	logger.Info("photo_session.payout.line_recorded",
+		"booking_id", booking.ID(),
+		"photographer_id", booking.PhotographerUserID(),
+		"period", line.Period(),
+		"rule_id", line.RuleID(),
+		"amount_cents", line.AmountCents())
+
+	return nil
+}
+
+// selectPayoutRule returns the most specific active rule matching the session; ties go to the lowest ID.
+func selectPayoutRule(rules []photosessionmodel.PayoutRule, propertyType uint16, sessionType photosessionmodel.SessionType, size float64) (photosessionmodel.PayoutRule, bool) {
+	var best photosessionmodel.PayoutRule
+	found := false
+	for _, rule := range rules {
+		if !rule.Matches(propertyType, sessionType, size) {
+			continue
+		}
+		if !found || rule.Specificity() > best.Specificity() ||
+			(rule.Specificity() == best.Specificity() && rule.ID < best.ID) {
+			best = rule
+			found = true
+		}
+	}
+	return best, found
+}
+
+// holidayOn reports whether the session day is a holiday in any calendar covering the listing city.
+func (s *photoSessionService) holidayOn(ctx context.Context, listing listingmodel.ListingInterface, day time.Time, loc *time.Location) (string, bool, error) {
+	calendars, err := s.listCalendarsByLocation(ctx, photographerLocation{
+		city:  strings.TrimSpace(listing.City()),
+		state: strings.ToUpper(strings.TrimSpace(listing.State())),
+	})
+	if err != nil {
+		return "", false, err
+	}
+
+	from := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, loc).UTC()
+	to := from.Add(24 * time.Hour)
+	for _, calendar := range calendars {
+		result, listErr := s.holidayService.ListCalendarDates(ctx, holidaymodel.CalendarDatesFilter{
+			CalendarID: calendar.ID(),
+			From:       &from,
+			To:         &to,
+			Timezone:   loc.String(),
+			Limit:      10,
+			Page:       1,
+		})
+		if listErr != nil {
+			utils.SetSpanError(ctx, listErr)
+			utils.LoggerFromContext(ctx).Error("photo_session.payout.holiday_dates_error", "calendar_id", calendar.ID(), "err", listErr)
+			return "", false, derrors.Wrap(listErr, derrors.KindInfra, "failed to list holiday dates")
+		}
+		if len(result.Dates) > 0 {
+			label := strings.TrimSpace(result.Dates[0].Label())
+			if label == "" {
+				label = "Holiday"
+			}
+			return label, true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// parsePayoutPeriod validates a "YYYY-MM" period, returning the first instant of the month in loc.
+func parsePayoutPeriod(period string, loc *time.Location) (time.Time, error) {
+	start, err := time.ParseInLocation(payoutPeriodLayout, period, loc)
+	if err != nil {
+		return time.Time{}, derrors.Validation("period must be formatted as YYYY-MM", map[string]any{"period": period})
+	}
+	return start, nil
+}
+
+// GetPhotographerStatement lists the photographer's ledger lines for a month with pending/paid totals.
+func (s *photoSessionService) GetPhotographerStatement(ctx context.Context, input PhotographerStatementInput) (PhotographerStatementOutput, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return PhotographerStatementOutput{}, derrors.Infra("failed to generate tracer", err)
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	if input.PhotographerID == 0 {
+		return PhotographerStatementOutput{}, derrors.Auth("unauthorized")
+	}
+
+	loc, err := resolveLocation("")
+	if err != nil {
+		return PhotographerStatementOutput{}, err
+	}
+
+	period := strings.TrimSpace(input.Period)
+	if period == "" {
+		period = s.now().In(loc).Format(payoutPeriodLayout)
+	} else if _, err := parsePayoutPeriod(period, loc); err != nil {
+		return PhotographerStatementOutput{}, err
+	}
+
+	tx, err := s.globalService.StartReadOnlyTransaction(ctx)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.payout.statement.tx_start_error", "err", err)
+		return PhotographerStatementOutput{}, derrors.Infra("failed to start transaction", err)
+	}
+	defer func() {
+		if rollbackErr := s.globalService.RollbackTransaction(ctx, tx); rollbackErr != nil {
+			utils.SetSpanError(ctx, rollbackErr)
+			logger.Error("photo_session.payout.statement.tx_rollback_error", "err", rollbackErr)
+		}
+	}()
+
+	lines, err := s.repo.ListPayoutLines(ctx, tx, photosessionmodel.PayoutLedgerFilter{
+		PhotographerUserID: input.PhotographerID,
+		Period:             period,
+	})
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.payout.statement.list_error", "photographer_id", input.PhotographerID, "period", period, "err", err)
+		return PhotographerStatementOutput{}, derrors.Infra("failed to list payout lines", err)
+	}
+
+	output := PhotographerStatementOutput{Period: period, Lines: lines}
+	for _, line := range lines {
+		output.TotalCents += line.AmountCents()
+		if line.Status() == photosessionmodel.PayoutStatusPaid {
+			output.PaidCents += line.AmountCents()
+		} else {
+			output.PendingCents += line.AmountCents()
+		}
+	}
+
+	return output, nil
+}
+
+// ClosePayoutPeriod settles every priced pending line of a past month under a new payout reference and
+// returns the lines of that reference for the finance export. Lines recorded without a matching rule are
+// worth nothing yet and stay PENDING until finance prices them. Lines of bookings completed after a close
+// keep the period of their session, so closing the period again settles them under another reference;
+// when nothing is pending the lines of the latest reference are re-exported.
+func (s *photoSessionService) ClosePayoutPeriod(ctx context.Context, input ClosePayoutPeriodInput) (ClosePayoutPeriodOutput, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return ClosePayoutPeriodOutput{}, derrors.Infra("failed to generate tracer", err)
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	loc, err := resolveLocation("")
+	if err != nil {
+		return ClosePayoutPeriodOutput{}, err
+	}
+
+	period := strings.TrimSpace(input.Period)
+	if period == "" {
+		return ClosePayoutPeriodOutput{}, derrors.Validation("period is required", map[string]any{"period": "required"})
+	}
+	periodStart, err := parsePayoutPeriod(period, loc)
+	if err != nil {
+		return ClosePayoutPeriodOutput{}, err
+	}
+	now := s.now().In(loc)
+	if now.Before(periodStart.AddDate(0, 1, 0)) {
+		return ClosePayoutPeriodOutput{}, derrors.Validation("only past periods can be closed", map[string]any{"period": "not_past"})
+	}
+
+	tx, err := s.globalService.StartTransaction(ctx)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.payout.close.tx_start_error", "err", err)
+		return ClosePayoutPeriodOutput{}, derrors.Infra("failed to start transaction", err)
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			if rollbackErr := s.globalService.RollbackTransaction(ctx, tx); rollbackErr != nil {
+				utils.SetSpanError(ctx, rollbackErr)
+				logger.Error("photo_session.payout.close.tx_rollback_error", "err", rollbackErr)
+			}
+		}
+	}()
+
+	reference := fmt.Sprintf("PAYOUT-%s-%d", period, now.Unix())
+	closed, err := s.repo.MarkPayoutLinesPaid(ctx, tx, period, reference, now.UTC())
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.payout.close.mark_paid_error", "period", period, "err", err)
+		return ClosePayoutPeriodOutput{}, derrors.Infra("failed to settle payout lines", err)
+	}
+
+	exportReference := reference
+	if closed == 0 {
+		exportReference, err = s.latestPayoutReference(ctx, tx, period)
+		if err != nil {
+			return ClosePayoutPeriodOutput{}, err
+		}
+	}
+
+	var lines []photosessionmodel.PayoutLedgerLineInterface
+	if exportReference != "" {
+		lines, err = s.repo.ListPayoutLines(ctx, tx, photosessionmodel.PayoutLedgerFilter{
+			Period:          period,
+			Status:          photosessionmodel.PayoutStatusPaid,
+			PayoutReference: exportReference,
+		})
+		if err != nil {
+			utils.SetSpanError(ctx, err)
+			logger.Error("photo_session.payout.close.list_error", "period", period, "err", err)
+			return ClosePayoutPeriodOutput{}, derrors.Infra("failed to list payout lines", err)
+		}
+	}
+
+	unpriced, err := s.repo.ListPayoutLines(ctx, tx, photosessionmodel.PayoutLedgerFilter{
+		Period: period,
+		Status: photosessionmodel.PayoutStatusPending,
+	})
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.payout.close.list_error", "period", period, "err", err)
+		return ClosePayoutPeriodOutput{}, derrors.Infra("failed to list payout lines", err)
+	}
+	if len(unpriced) > 0 {
+		logger.Warn("photo_session.payout.close.unpriced_lines", "period", period, "count", len(unpriced))
+	}
+
+	if err := s.globalService.CommitTransaction(ctx, tx); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.payout.close.tx_commit_error", "period", period, "err", err)
+		return ClosePayoutPeriodOutput{}, derrors.Infra("failed to commit transaction", err)
+	}
+	committed = true
+
+	output := ClosePayoutPeriodOutput{Period: period, Reference: exportReference, Closed: closed, Lines: lines}
+	for _, line := range lines {
+		output.TotalCents += line.AmountCents()
+	}
+
+	logger.Info("photo_session.payout.period_closed",
+		"period", period,
+		"closed_by", input.ClosedBy,
+		"reference", output.Reference,
+		"closed", closed,
+		"exported", len(lines),
+		"total_cents", output.TotalCents)
+
+	return output, nil
+}
+
+// latestPayoutReference returns the reference of the most recent close of a period, empty when it was never
+// closed.
+func (s *photoSessionService) latestPayoutReference(ctx context.Context, tx *sql.Tx, period string) (string, error) {
+	paid, err := s.repo.ListPayoutLines(ctx, tx, photosessionmodel.PayoutLedgerFilter{
+		Period: period,
+		Status: photosessionmodel.PayoutStatusPaid,
+	})
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		utils.LoggerFromContext(ctx).Error("photo_session.payout.close.list_error", "period", period, "err", err)
+		return "", derrors.Infra("failed to list payout lines", err)
+	}
+
+	reference := ""
+	var latest time.Time
+	for _, line := range paid {
+		if line.PayoutReference() == nil || line.PaidAt() == nil {
+			continue
+		}
+		if reference == "" || line.PaidAt().After(latest) {
+			reference = *line.PayoutReference()
+			latest = *line.PaidAt()
+		}
+	}
+	return reference, nil
+}
+
+// ListPayoutRules returns every payout rule, including inactive ones, for admin review.
+func (s *photoSessionService) ListPayoutRules(ctx context.Context) ([]photosessionmodel.PayoutRule, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, derrors.Infra("failed to generate tracer", err)
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	rules, err := s.repo.ListPayoutRules(ctx, nil, false)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.payout.list_rules_error", "err", err)
+		return nil, derrors.Infra("failed to list payout rules", err)
+	}
+	return rules, nil
+}
@@ -0,0 +1,105 @@
+package photosessionservices
+
+import (
+	"testing"
+	"time"
+
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+)
+
+func TestSelectPayoutRule(t *testing.T) {
+	t.Parallel()
+
+	propertyType := func(value uint16) *uint16 { return &value }
+	sessionType := func(value photosessionmodel.SessionType) *photosessionmodel.SessionType { return &value }
+	size := func(value float64) *float64 { return &value }
+
+	rules := []photosessionmodel.PayoutRule{
+		{ID: 1, Active: true},
+		{ID: 2, Active: true, MinSizeM2: size(100), MaxSizeM2: size(300)},
+		{ID: 3, Active: true, SessionType: sessionType(photosessionmodel.SessionTypePanorama)},
+		{ID: 4, Active: true, PropertyType: propertyType(2)},
+		{ID: 5, Active: true, PropertyType: propertyType(2), SessionType: sessionType(photosessionmodel.SessionTypePanorama)},
+		{ID: 6, Active: false, PropertyType: propertyType(2), SessionType: sessionType(photosessionmodel.SessionTypePanorama), MinSizeM2: size(0)},
+		{ID: 7, Active: true, MinSizeM2: size(300)},
+		{ID: 8, Active: true, MinSizeM2: size(100), MaxSizeM2: size(300)},
+	}
+
+	cases := []struct {
+		name         string
+		rules        []photosessionmodel.PayoutRule
+		propertyType uint16
+		sessionType  photosessionmodel.SessionType
+		size         float64
+		expectedID   uint64
+		expectFound  bool
+	}{
+		{name: "catch-all", rules: rules, propertyType: 1, sessionType: photosessionmodel.SessionTypeStandard, size: 50, expectedID: 1, expectFound: true},
+		{name: "size band beats catch-all, lowest id on ties", rules: rules, propertyType: 1, sessionType: photosessionmodel.SessionTypeStandard, size: 100, expectedID: 2, expectFound: true},
+		{name: "maximum size is exclusive", rules: rules, propertyType: 1, sessionType: photosessionmodel.SessionTypeStandard, size: 300, expectedID: 7, expectFound: true},
+		{name: "session type beats size band", rules: rules, propertyType: 1, sessionType: photosessionmodel.SessionTypePanorama, size: 150, expectedID: 3, expectFound: true},
+		{name: "property type beats session type and size", rules: rules, propertyType: 2, sessionType: photosessionmodel.SessionTypeStandard, size: 150, expectedID: 4, expectFound: true},
+		{name: "property and session type together win", rules: rules, propertyType: 2, sessionType: photosessionmodel.SessionTypePanorama, size: 150, expectedID: 5, expectFound: true},
+		{name: "inactive rules never match", rules: rules[5:6], propertyType: 2, sessionType: photosessionmodel.SessionTypePanorama, size: 150},
+		{name: "no matching rule", rules: rules[3:5], propertyType: 1, sessionType: photosessionmodel.SessionTypeStandard, size: 150},
+		{name: "no rules", propertyType: 1, sessionType: photosessionmodel.SessionTypeStandard, size: 150},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule, found := selectPayoutRule(tt.rules, tt.propertyType, tt.sessionType, tt.size)
+			if found != tt.expectFound {
+				t.Fatalf("selectPayoutRule() found = %t, expected %t", found, tt.expectFound)
+			}
+			if found && rule.ID != tt.expectedID {
+				t.Fatalf("selectPayoutRule() = rule %d, expected rule %d", rule.ID, tt.expectedID)
+			}
+		})
+	}
+}
+
+func TestParsePayoutPeriod(t *testing.T) {
+	t.Parallel()
+
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	cases := []struct {
+		period    string
+		expected  time.Time
+		expectErr bool
+	}{
+		{period: "2026-03", expected: time.Date(2026, time.March, 1, 0, 0, 0, 0, loc)},
+		{period: "2026-12", expected: time.Date(2026, time.December, 1, 0, 0, 0, 0, loc)},
+		{period: "2026-13", expectErr: true},
+		{period: "2026-3", expectErr: true},
+		{period: "03/2026", expectErr: true},
+		{period: "", expectErr: true},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.period, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parsePayoutPeriod(tt.period, loc)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatalf("parsePayoutPeriod(%q) = %s, expected an error", tt.period, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parsePayoutPeriod(%q) unexpected error: %v", tt.period, err)
+			}
+			if !got.Equal(tt.expected) {
+				t.Fatalf("parsePayoutPeriod(%q) = %s, expected %s", tt.period, got, tt.expected)
+			}
+		})
+	}
+}
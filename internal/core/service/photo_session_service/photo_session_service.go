@@ -23,12 +23,25 @@ type PhotoSessionServiceInterface interface {
 	ListTimeOff(ctx context.Context, input ListTimeOffInput) (ListTimeOffOutput, error)
 	GetTimeOffDetail(ctx context.Context, input TimeOffDetailInput) (TimeOffDetailResult, error)
 	UpdateTimeOff(ctx context.Context, input UpdateTimeOffInput) (TimeOffDetailResult, error)
+	PreviewTimeOffImport(ctx context.Context, input ImportTimeOffInput) (ImportTimeOffResult, error)
+	ImportTimeOff(ctx context.Context, input ImportTimeOffInput) (ImportTimeOffResult, error)
 	UpdateSessionStatus(ctx context.Context, input UpdateSessionStatusInput) error
 	ListAgenda(ctx context.Context, input ListAgendaInput) (ListAgendaOutput, error)
 	ListAvailability(ctx context.Context, input ListAvailabilityInput) (ListAvailabilityOutput, error)
 	ReservePhotoSession(ctx context.Context, input ReserveSessionInput) (ReserveSessionOutput, error)
 	ConfirmPhotoSession(ctx context.Context, input ConfirmSessionInput) (ConfirmSessionOutput, error)
 	CancelPhotoSession(ctx context.Context, input CancelSessionInput) (CancelSessionOutput, error)
+	ReschedulePhotoSession(ctx context.Context, input RescheduleSessionInput) (RescheduleSessionOutput, error)
+	GetRescheduleReport(ctx context.Context, input RescheduleReportInput) (RescheduleReportOutput, error)
+	GetPhotographerStatement(ctx context.Context, input PhotographerStatementInput) (PhotographerStatementOutput, error)
+	ClosePayoutPeriod(ctx context.Context, input ClosePayoutPeriodInput) (ClosePayoutPeriodOutput, error)
+	ListPayoutRules(ctx context.Context) ([]photosessionmodel.PayoutRule, error)
+	ConfirmArrival(ctx context.Context, input ConfirmArrivalInput) (ConfirmArrivalOutput, error)
+	RateSession(ctx context.Context, input RateSessionInput) (RateSessionOutput, error)
+	GetPhotographerKPIReport(ctx context.Context, input PhotographerKPIReportInput) (PhotographerKPIReportOutput, error)
+	// KPI hooks for the media pipeline; no-ops when the listing has no completed session.
+	RecordMediaUploadWithTx(ctx context.Context, tx *sql.Tx, listingIdentityID int64) error
+	RecordOwnerMediaDecisionWithTx(ctx context.Context, tx *sql.Tx, listingIdentityID int64, approved bool) error
 	GetActiveBookingByListingIdentityID(ctx context.Context, tx *sql.Tx, listingIdentityID int64) (photosessionmodel.PhotoSessionBookingInterface, error)
 	ListServiceAreas(ctx context.Context, input ListServiceAreasInput) (ListServiceAreasOutput, error)
 	CreateServiceArea(ctx context.Context, input CreateServiceAreaInput) (ServiceAreaResult, error)
@@ -47,6 +60,7 @@ type photoSessionService struct {
 	holidayService holidayservices.HolidayServiceInterface
 	globalService  globalservice.GlobalServiceInterface
 	cfg            Config
+	distance       DistanceEstimator
 	now            func() time.Time
 }
 
@@ -59,6 +73,10 @@ func NewPhotoSessionService(
 	globalService globalservice.GlobalServiceInterface,
 	cfg Config,
 ) PhotoSessionServiceInterface {
+	distance := cfg.DistanceEstimator
+	if distance == nil {
+		distance = NewHaversineEstimator()
+	}
 	return &photoSessionService{
 		repo:           repo,
 		listingRepo:    listingRepo,
@@ -66,6 +84,7 @@ func NewPhotoSessionService(
 		holidayService: holidayService,
 		globalService:  globalService,
 		cfg:            cfg,
+		distance:       distance,
 		now:            time.Now,
 	}
 }
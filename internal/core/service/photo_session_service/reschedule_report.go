@@ -0,0 +1,91 @@
+package photosessionservices
+
+import (
+	"context"
+	"strings"
+
+	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// GetRescheduleReport aggregates recorded reschedules per listing or per photographer.
+// Photographer grouping counts the photographer that held the original slot; GroupBy defaults to LISTING.
+func (s *photoSessionService) GetRescheduleReport(ctx context.Context, input RescheduleReportInput) (RescheduleReportOutput, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return RescheduleReportOutput{}, derrors.Infra("failed to generate tracer", err)
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	group := photosessionmodel.RescheduleReportGroup(strings.ToUpper(strings.TrimSpace(input.GroupBy)))
+	switch group {
+	case "":
+		group = photosessionmodel.RescheduleReportGroupListing
+	case photosessionmodel.RescheduleReportGroupListing, photosessionmodel.RescheduleReportGroupPhotographer:
+	default:
+		return RescheduleReportOutput{}, derrors.Validation("groupBy must be LISTING or PHOTOGRAPHER", map[string]any{"groupBy": input.GroupBy})
+	}
+
+	if input.From != nil && input.To != nil && !input.To.After(*input.From) {
+		return RescheduleReportOutput{}, derrors.Validation("to must be after from", map[string]any{"to": "after_from"})
+	}
+
+	page := input.Page
+	if page <= 0 {
+		page = defaultReschedulePage
+	}
+	size := input.Size
+	if size <= 0 {
+		size = defaultRescheduleSize
+	}
+	if size > maxReschedulePageSize {
+		size = maxReschedulePageSize
+	}
+
+	filter := photosessionmodel.RescheduleReportFilter{
+		GroupBy: group,
+		From:    input.From,
+		To:      input.To,
+		Offset:  (page - 1) * size,
+		Limit:   size,
+	}
+
+	tx, err := s.globalService.StartReadOnlyTransaction(ctx)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.reschedule_report.tx_start_error", "err", err)
+		return RescheduleReportOutput{}, derrors.Infra("failed to start transaction", err)
+	}
+	defer func() {
+		if rollbackErr := s.globalService.RollbackTransaction(ctx, tx); rollbackErr != nil {
+			utils.SetSpanError(ctx, rollbackErr)
+			logger.Error("photo_session.reschedule_report.tx_rollback_error", "err", rollbackErr)
+		}
+	}()
+
+	items, err := s.repo.ListRescheduleCounts(ctx, tx, filter)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.reschedule_report.list_error", "group_by", group, "err", err)
+		return RescheduleReportOutput{}, derrors.Infra("failed to list reschedule counts", err)
+	}
+
+	total, err := s.repo.CountRescheduleGroups(ctx, tx, filter)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.reschedule_report.count_error", "group_by", group, "err", err)
+		return RescheduleReportOutput{}, derrors.Infra("failed to count reschedule groups", err)
+	}
+
+	return RescheduleReportOutput{
+		GroupBy: group,
+		Items:   items,
+		Total:   total,
+		Page:    page,
+		Size:    size,
+	}, nil
+}
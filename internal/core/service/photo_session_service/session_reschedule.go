@@ -0,0 +1,346 @@
+package photosessionservices
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ReschedulePhotoSession moves an owner's booking to another slot in a single transaction.
+//
+// The booking row is kept (same photoSessionId) and re-pointed to a new agenda entry before the
+// previous entry is deleted, so the old window is only released once the new one is held and the
+// listing never goes back to StatusPendingPhotoScheduling.
+//
+// Rules:
+//   - Only PENDING_APPROVAL and ACCEPTED bookings can be moved
+//   - The request must arrive at least photo_session.reschedule_cutoff_hours before the booked start
+//   - A booking can be moved at most photo_session.max_reschedules_per_booking times (0 = unlimited)
+//   - With travel buffers enabled, the photographer must be able to reach the listing from/to adjacent sessions
+//   - The new slot may belong to another photographer (slotId encodes the photographer); window slots
+//     (automatic assignment mode) are re-assigned by assignPhotographer, ignoring the booking's own entry
+//
+// Approval mode:
+//   - Automatic: booking stays ACCEPTED and the listing keeps StatusPhotosScheduled
+//   - Manual: booking returns to PENDING_APPROVAL and a StatusPhotosScheduled listing moves to
+//     StatusPendingPhotoConfirmation until the photographer accepts the new window
+//
+// Returns:
+//   - 401 (Auth) if the listing does not belong to the user
+//   - 404 (NotFound) if booking, listing or agenda entry are missing
+//   - 409 (Conflict) if the slot is taken, the status does not allow it, the cut-off passed or the limit was reached
+//   - 422 (Validation) for invalid input
+//   - 500 (Infra) for infrastructure failures
+//
+// Side Effects:
+//   - Creates the new photographer_agenda_entries row and deletes the previous one
+//   - Updates photographer_photo_session_bookings (entry, photographer, window, status)
+//   - Inserts a photo_session_reschedules row used for SLA and reporting
+//   - Sends FCM push notification to the owner (photographers are notified by the listing service)
+func (s *photoSessionService) ReschedulePhotoSession(ctx context.Context, input RescheduleSessionInput) (RescheduleSessionOutput, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return RescheduleSessionOutput{}, derrors.Infra("failed to generate tracer", err)
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	if input.UserID <= 0 {
+		return RescheduleSessionOutput{}, derrors.Auth("unauthorized")
+	}
+	if input.PhotoSessionID == 0 {
+		return RescheduleSessionOutput{}, derrors.Validation("photoSessionId must be greater than zero", map[string]any{"photoSessionId": "greater_than_zero"})
+	}
+	if input.SlotID == 0 {
+		return RescheduleSessionOutput{}, derrors.Validation("slotId must be greater than zero", map[string]any{"slotId": "greater_than_zero"})
+	}
+
+	var reason *string
+	if input.Reason != nil {
+		trimmed := strings.TrimSpace(*input.Reason)
+		if len(trimmed) > maxRescheduleReasonLen {
+			return RescheduleSessionOutput{}, derrors.Validation(fmt.Sprintf("reason must be at most %d characters", maxRescheduleReasonLen), map[string]any{"reason": "too_long"})
+		}
+		if trimmed != "" {
+			reason = &trimmed
+		}
+	}
+
+	photographerID, slotStartUTC := decodeSlotID(input.SlotID)
+	autoAssign := photographerID == 0
+	if autoAssign && !s.autoAssignmentEnabled() {
+		return RescheduleSessionOutput{}, derrors.Validation("slotId is invalid", map[string]any{"slotId": "invalid"})
+	}
+
+	loc, tzErr := resolveLocation("")
+	if tzErr != nil {
+		return RescheduleSessionOutput{}, tzErr
+	}
+
+	slotDuration := time.Duration(s.cfg.SlotDurationMinutes) * time.Minute
+	if slotDuration <= 0 {
+		slotDuration = 4 * time.Hour
+	}
+
+	now := s.now()
+	slotStart := slotStartUTC.In(loc)
+	slotEnd := slotStart.Add(slotDuration)
+	if !slotStart.After(now) {
+		return RescheduleSessionOutput{}, derrors.ErrSlotUnavailable
+	}
+
+	cutoff := time.Duration(s.cfg.RescheduleCutoffHours) * time.Hour
+	if cutoff <= 0 {
+		cutoff = defaultRescheduleCutoff
+	}
+
+	tx, txErr := s.globalService.StartTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("photo_session.reschedule.tx_start_error", "err", txErr)
+		return RescheduleSessionOutput{}, derrors.Infra("failed to start transaction", txErr)
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+				utils.SetSpanError(ctx, rbErr)
+				logger.Error("photo_session.reschedule.tx_rollback_error", "err", rbErr)
+			}
+		}
+	}()
+
+	booking, err := s.repo.GetBookingByIDForUpdate(ctx, tx, input.PhotoSessionID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return RescheduleSessionOutput{}, utils.NotFoundError("Photo session")
+		}
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.reschedule.get_booking_error", "photo_session_id", input.PhotoSessionID, "err", err)
+		return RescheduleSessionOutput{}, derrors.Infra("failed to load booking", err)
+	}
+
+	listing, err := s.listingRepo.GetActiveListingVersion(ctx, tx, booking.ListingIdentityID())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return RescheduleSessionOutput{}, utils.NotFoundError("Listing")
+		}
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.reschedule.get_listing_error", "listing_identity_id", booking.ListingIdentityID(), "err", err)
+		return RescheduleSessionOutput{}, derrors.Infra("failed to load listing", err)
+	}
+
+	if listing.Deleted() {
+		return RescheduleSessionOutput{}, utils.BadRequest("listing is not available")
+	}
+	if listing.UserID() != input.UserID {
+		return RescheduleSessionOutput{}, derrors.Auth("listing does not belong to user")
+	}
+
+	switch booking.Status() {
+	case photosessionmodel.BookingStatusPendingApproval, photosessionmodel.BookingStatusAccepted:
+	default:
+		return RescheduleSessionOutput{}, derrors.ErrPhotoSessionNotReschedulable
+	}
+
+	notice := booking.StartsAt().Sub(now)
+	if notice < cutoff {
+		logger.Info("photo_session.reschedule.window_closed",
+			"booking_id", booking.ID(),
+			"starts_at", booking.StartsAt(),
+			"cutoff_hours", cutoff.Hours())
+		return RescheduleSessionOutput{}, derrors.ErrRescheduleWindowClosed
+	}
+
+	previousCount, err := s.repo.CountReschedulesByBooking(ctx, tx, booking.ID())
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.reschedule.count_error", "booking_id", booking.ID(), "err", err)
+		return RescheduleSessionOutput{}, derrors.Infra("failed to count reschedules", err)
+	}
+	if s.cfg.MaxReschedulesPerBooking > 0 && previousCount >= int64(s.cfg.MaxReschedulesPerBooking) {
+		return RescheduleSessionOutput{}, derrors.ErrRescheduleLimitReached
+	}
+
+	if (autoAssign || photographerID == booking.PhotographerUserID()) && slotStart.Equal(booking.StartsAt()) {
+		return RescheduleSessionOutput{}, derrors.Validation("slotId must differ from the current session slot", map[string]any{"slotId": "unchanged"})
+	}
+
+	previousEntryID := booking.AgendaEntryID()
+	if _, err = s.repo.GetEntryByIDForUpdate(ctx, tx, previousEntryID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return RescheduleSessionOutput{}, utils.NotFoundError("Photographer agenda entry")
+		}
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.reschedule.get_entry_error", "agenda_entry_id", previousEntryID, "err", err)
+		return RescheduleSessionOutput{}, derrors.Infra("failed to load agenda entry", err)
+	}
+
+	assignmentMode := photosessionmodel.AssignmentModeOwnerChoice
+	var assignment *photosessionmodel.PhotographerAssignment
+	if autoAssign {
+		photographerID, assignment, err = s.assignPhotographer(ctx, tx, listing, slotStart, slotEnd, previousEntryID)
+		if err != nil {
+			return RescheduleSessionOutput{}, err
+		}
+		assignmentMode = photosessionmodel.AssignmentModeAuto
+	} else {
+		conflicts, findErr := s.repo.FindBlockingEntries(ctx, tx, photographerID, slotStart.UTC(), slotEnd.UTC())
+		if findErr != nil {
+			utils.SetSpanError(ctx, findErr)
+			logger.Error("photo_session.reschedule.find_blocking_error", "photographer_id", photographerID, "err", findErr)
+			return RescheduleSessionOutput{}, derrors.Infra("failed to verify photographer agenda", findErr)
+		}
+		for _, conflict := range conflicts {
+			// The booking's own window is about to be released, so it never blocks the move.
+			if conflict.ID() != previousEntryID {
+				return RescheduleSessionOutput{}, derrors.ErrSlotUnavailable
+			}
+		}
+		if err := s.ensureReachable(ctx, tx, listing, photographerID, slotStart, slotEnd, previousEntryID); err != nil {
+			return RescheduleSessionOutput{}, err
+		}
+	}
+
+	agendaEntry := photosessionmodel.NewAgendaEntry()
+	agendaEntry.SetPhotographerUserID(photographerID)
+	agendaEntry.SetEntryType(photosessionmodel.AgendaEntryTypePhotoSession)
+	agendaEntry.SetSource(photosessionmodel.AgendaEntrySourceBooking)
+	agendaEntry.SetSourceID(uint64(booking.ListingIdentityID()))
+	agendaEntry.SetStartsAt(slotStart.UTC())
+	agendaEntry.SetEndsAt(slotEnd.UTC())
+	agendaEntry.SetBlocking(true)
+	agendaEntry.SetTimezone(loc.String())
+
+	entryIDs, err := s.repo.CreateEntries(ctx, tx, []photosessionmodel.AgendaEntryInterface{agendaEntry})
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.reschedule.create_entry_error", "photographer_id", photographerID, "err", err)
+		return RescheduleSessionOutput{}, derrors.Infra("failed to create agenda entry", err)
+	}
+	if len(entryIDs) == 0 {
+		return RescheduleSessionOutput{}, derrors.Infra("failed to create agenda entry", fmt.Errorf("no entry id returned"))
+	}
+
+	previousPhotographerID := booking.PhotographerUserID()
+	previousStart := booking.StartsAt()
+
+	// Manual approval: the (possibly new) photographer must accept the new window again.
+	newStatus := photosessionmodel.BookingStatusAccepted
+	if s.cfg.RequirePhotographerApproval {
+		newStatus = photosessionmodel.BookingStatusPendingApproval
+	}
+
+	booking.SetAgendaEntryID(entryIDs[0])
+	booking.SetPhotographerUserID(photographerID)
+	booking.SetStartsAt(slotStart.UTC())
+	booking.SetEndsAt(slotEnd.UTC())
+	booking.SetStatus(newStatus)
+	booking.SetAssignmentMode(assignmentMode)
+	booking.SetAssignment(assignment)
+
+	// The booking must point at the new entry before the old one is deleted (FK cascades to bookings).
+	if err := s.repo.UpdateBooking(ctx, tx, booking); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return RescheduleSessionOutput{}, utils.NotFoundError("Photo session")
+		}
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.reschedule.update_booking_error", "booking_id", booking.ID(), "err", err)
+		return RescheduleSessionOutput{}, derrors.Infra("failed to update booking", err)
+	}
+
+	if err := s.repo.DeleteEntryByID(ctx, tx, previousEntryID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return RescheduleSessionOutput{}, utils.NotFoundError("Photographer agenda entry")
+		}
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.reschedule.delete_entry_error", "agenda_entry_id", previousEntryID, "err", err)
+		return RescheduleSessionOutput{}, derrors.Infra("failed to release previous agenda entry", err)
+	}
+
+	listingStatus := listing.Status()
+	if newStatus == photosessionmodel.BookingStatusPendingApproval && listingStatus == listingmodel.StatusPhotosScheduled {
+		if updateErr := s.listingRepo.UpdateListingStatus(ctx, tx, listing.ID(), listingmodel.StatusPendingPhotoConfirmation, listingStatus); updateErr != nil {
+			if errors.Is(updateErr, sql.ErrNoRows) {
+				return RescheduleSessionOutput{}, derrors.ErrListingNotEligible
+			}
+			utils.SetSpanError(ctx, updateErr)
+			logger.Error("photo_session.reschedule.update_listing_status_error", "listing_id", listing.ID(), "err", updateErr)
+			return RescheduleSessionOutput{}, derrors.Infra("failed to update listing status", updateErr)
+		}
+		listingStatus = listingmodel.StatusPendingPhotoConfirmation
+	}
+
+	record := photosessionmodel.NewPhotoSessionReschedule()
+	record.SetBookingID(booking.ID())
+	record.SetListingIdentityID(booking.ListingIdentityID())
+	record.SetRequestedBy(input.UserID)
+	record.SetFromPhotographerUserID(previousPhotographerID)
+	record.SetToPhotographerUserID(photographerID)
+	record.SetFromStartsAt(previousStart.UTC())
+	record.SetToStartsAt(slotStart.UTC())
+	record.SetNoticeMinutes(int64(notice / time.Minute))
+	record.SetReason(reason)
+
+	if _, err := s.repo.CreateReschedule(ctx, tx, record); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.reschedule.create_record_error", "booking_id", booking.ID(), "err", err)
+		return RescheduleSessionOutput{}, derrors.Infra("failed to record reschedule", err)
+	}
+
+	if commitErr := s.globalService.CommitTransaction(ctx, tx); commitErr != nil {
+		utils.SetSpanError(ctx, commitErr)
+		logger.Error("photo_session.reschedule.commit_error", "booking_id", booking.ID(), "err", commitErr)
+		return RescheduleSessionOutput{}, derrors.Infra("failed to commit reschedule", commitErr)
+	}
+	committed = true
+
+	photographerChanged := previousPhotographerID != photographerID
+	metricPhotoSessionReschedules.WithLabelValues(strconv.FormatBool(photographerChanged)).Inc()
+
+	notificationTitle := "Sessão de Fotos Reagendada"
+	notificationBody := fmt.Sprintf("Sua sessão de fotos foi reagendada para %s.", slotStart.Format("02/01 15:04"))
+	if newStatus == photosessionmodel.BookingStatusPendingApproval {
+		notificationBody = fmt.Sprintf("Sua sessão de fotos foi reagendada para %s e aguarda a confirmação do fotógrafo.", slotStart.Format("02/01 15:04"))
+	}
+	go s.sendOwnerNotifications(context.Background(), input.UserID, notificationTitle, notificationBody, listing.ID(), booking.ID())
+
+	logger.Info("photo_session.reschedule.success",
+		"booking_id", booking.ID(),
+		"listing_id", listing.ID(),
+		"from_photographer_id", previousPhotographerID,
+		"to_photographer_id", photographerID,
+		"from_start", previousStart,
+		"to_start", slotStart,
+		"notice_minutes", record.NoticeMinutes(),
+		"reschedule_count", previousCount+1,
+		"booking_status", newStatus,
+		"assignment_mode", assignmentMode,
+		"listing_status", listingStatus.String())
+
+	return RescheduleSessionOutput{
+		PhotoSessionID:         booking.ID(),
+		SlotID:                 input.SlotID,
+		SlotStart:              slotStart,
+		SlotEnd:                slotEnd,
+		PhotographerID:         photographerID,
+		PreviousSlotStart:      previousStart.In(loc),
+		PreviousPhotographerID: previousPhotographerID,
+		ListingIdentityID:      booking.ListingIdentityID(),
+		ListingCode:            listing.Code(),
+		Status:                 newStatus,
+		RescheduleCount:        previousCount + 1,
+	}, nil
+}
@@ -5,9 +5,11 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
 	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
 	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
 	globalservice "github.com/projeto-toq/toq_server/internal/core/service/global_service"
@@ -18,7 +20,8 @@ import (
 //
 // This method orchestrates the complete photo session reservation flow:
 //  1. Validates listing ownership and eligibility
-//  2. Checks photographer availability (no conflicts)
+//  2. Checks photographer availability (no conflicts, travel buffers when enabled); window slots (photographer 0, automatic
+//     assignment mode) are resolved to the best free photographer by assignPhotographer
 //  3. Creates agenda entry (blocks the slot)
 //  4. Creates booking with status determined by config:
 //     - If require_photographer_approval=false: ACCEPTED (automatic)
@@ -36,7 +39,7 @@ import (
 //
 // Parameters:
 //   - ctx: Context for tracing, cancellation, and logging
-//   - input: ReserveSessionInput with userID, listingID, slotID
+//   - input: ReserveSessionInput with userID, listingID, slotID and optional sessionType (STANDARD/PANORAMA)
 //
 // Returns:
 //   - output: ReserveSessionOutput with photoSessionID, slotID, timestamps, photographerID
@@ -73,8 +76,14 @@ func (s *photoSessionService) ReservePhotoSession(ctx context.Context, input Res
 		return ReserveSessionOutput{}, derrors.Validation("slotId must be greater than zero", map[string]any{"slotId": "greater_than_zero"})
 	}
 
+	sessionType, typeErr := photosessionmodel.SessionTypeFromString(strings.ToUpper(strings.TrimSpace(input.SessionType)))
+	if typeErr != nil {
+		return ReserveSessionOutput{}, derrors.Validation("sessionType must be STANDARD or PANORAMA", map[string]any{"sessionType": input.SessionType})
+	}
+
 	photographerID, slotStartUTC := decodeSlotID(input.SlotID)
-	if photographerID == 0 {
+	autoAssign := photographerID == 0
+	if autoAssign && !s.autoAssignmentEnabled() {
 		return ReserveSessionOutput{}, derrors.Validation("slotId is invalid", map[string]any{"slotId": "invalid"})
 	}
 
@@ -143,14 +152,27 @@ func (s *photoSessionService) ReservePhotoSession(ctx context.Context, input Res
 		return ReserveSessionOutput{}, derrors.ErrListingNotEligible
 	}
 
-	conflicts, err := s.repo.FindBlockingEntries(ctx, tx, photographerID, slotStart.UTC(), slotEnd.UTC())
-	if err != nil {
-		utils.SetSpanError(ctx, err)
-		logger.Error("photo_session.reserve.find_blocking_error", "photographer_id", photographerID, "err", err)
-		return ReserveSessionOutput{}, derrors.Infra("failed to verify photographer agenda", err)
-	}
-	if len(conflicts) > 0 {
-		return ReserveSessionOutput{}, derrors.ErrSlotUnavailable
+	assignmentMode := photosessionmodel.AssignmentModeOwnerChoice
+	var assignment *photosessionmodel.PhotographerAssignment
+	if autoAssign {
+		photographerID, assignment, err = s.assignPhotographer(ctx, tx, listing, slotStart, slotEnd, 0)
+		if err != nil {
+			return ReserveSessionOutput{}, err
+		}
+		assignmentMode = photosessionmodel.AssignmentModeAuto
+	} else {
+		conflicts, findErr := s.repo.FindBlockingEntries(ctx, tx, photographerID, slotStart.UTC(), slotEnd.UTC())
+		if findErr != nil {
+			utils.SetSpanError(ctx, findErr)
+			logger.Error("photo_session.reserve.find_blocking_error", "photographer_id", photographerID, "err", findErr)
+			return ReserveSessionOutput{}, derrors.Infra("failed to verify photographer agenda", findErr)
+		}
+		if len(conflicts) > 0 {
+			return ReserveSessionOutput{}, derrors.ErrSlotUnavailable
+		}
+		if err := s.ensureReachable(ctx, tx, listing, photographerID, slotStart, slotEnd, 0); err != nil {
+			return ReserveSessionOutput{}, err
+		}
 	}
 
 	agendaEntry := photosessionmodel.NewAgendaEntry()
@@ -194,6 +216,9 @@ func (s *photoSessionService) ReservePhotoSession(ctx context.Context, input Res
 	booking.SetStartsAt(slotStart.UTC())
 	booking.SetEndsAt(slotEnd.UTC())
 	booking.SetStatus(bookingStatus)
+	booking.SetAssignmentMode(assignmentMode)
+	booking.SetAssignment(assignment)
+	booking.SetSessionType(sessionType)
 
 	bookingID, err := s.repo.CreateBooking(ctx, tx, booking)
 	if err != nil {
@@ -244,7 +269,9 @@ func (s *photoSessionService) ReservePhotoSession(ctx context.Context, input Res
 		"photographer_id", photographerID,
 		"slot_start", slotStart,
 		"approval_mode", approvalMode,
+		"assignment_mode", assignmentMode,
 		"booking_status", bookingStatus,
+		"session_type", sessionType,
 		"listing_status", targetListingStatus.String())
 
 	return ReserveSessionOutput{
@@ -254,6 +281,8 @@ func (s *photoSessionService) ReservePhotoSession(ctx context.Context, input Res
 		SlotEnd:           slotEnd,
 		PhotographerID:    photographerID,
 		ListingIdentityID: input.ListingIdentityID,
+		AssignmentMode:    assignmentMode,
+		Assignment:        assignment,
 	}, nil
 }
 
@@ -273,6 +302,23 @@ func (s *photoSessionService) sendOwnerNotifications(ctx context.Context, userID
 	ctx = utils.ContextWithLogger(ctx)
 	logger := utils.LoggerFromContext(ctx)
 
+	// Get unified notification service
+	notifier := s.globalService.GetUnifiedNotificationService()
+	if notifier == nil {
+		logger.Error("photo_session.notification.service_unavailable",
+			"user_id", userID)
+		return
+	}
+
+	// One inbox entry per notification, written even when the user has no device
+	notifier.StoreInbox(ctx, globalservice.NotificationRequest{
+		Type:     globalservice.NotificationTypeFCM,
+		Subject:  title,
+		Body:     body,
+		UserID:   userID,
+		Category: globalmodel.NotificationCategoryTransactional,
+	})
+
 	// Fetch all device tokens for user (only opted-in devices)
 	tokens, err := s.globalService.ListDeviceTokensByUserIDIfOptedIn(ctx, userID)
 	if err != nil {
@@ -290,22 +336,17 @@ func (s *photoSessionService) sendOwnerNotifications(ctx context.Context, userID
 		return
 	}
 
-	// Get unified notification service
-	notifier := s.globalService.GetUnifiedNotificationService()
-	if notifier == nil {
-		logger.Error("photo_session.notification.service_unavailable",
-			"user_id", userID)
-		return
-	}
-
 	// Send notification to each token (supports multiple devices per user)
 	sentCount := 0
 	for _, token := range tokens {
 		req := globalservice.NotificationRequest{
-			Type:    globalservice.NotificationTypeFCM,
-			Token:   token,
-			Subject: title,
-			Body:    body,
+			Type:      globalservice.NotificationTypeFCM,
+			Token:     token,
+			Subject:   title,
+			Body:      body,
+			UserID:    userID,
+			Category:  globalmodel.NotificationCategoryTransactional,
+			SkipInbox: true,
 		}
 
 		// SendNotification is async by default, but we're already in a goroutine
@@ -55,6 +55,7 @@ import (
 // Side Effects:
 //   - Updates photographer_photo_session_bookings.status
 //   - Updates listings.status
+//   - DONE: records the photographer_payout_ledger line (pricing rules + weekend/holiday surcharge)
 //   - Sends FCM push notification to listing owner
 func (s *photoSessionService) UpdateSessionStatus(ctx context.Context, input UpdateSessionStatusInput) error {
 	ctx, spanEnd, err := utils.GenerateTracer(ctx)
@@ -223,6 +224,16 @@ func (s *photoSessionService) UpdateSessionStatus(ctx context.Context, input Upd
 		return derrors.Infra("failed to update listing status", updateErr)
 	}
 
+	// Sessão concluída gera a linha de pagamento do fotógrafo e marca a conclusão nos KPIs na mesma transação
+	if status == photosessionmodel.BookingStatusDone {
+		if err := s.recordPayoutLine(ctx, tx, booking, listing); err != nil {
+			return err
+		}
+		if err := s.recordSessionCompletion(ctx, tx, booking); err != nil {
+			return err
+		}
+	}
+
 	// Commit da transação antes de enviar notificações
 	if err := s.globalService.CommitTransaction(ctx, tx); err != nil {
 		utils.SetSpanError(ctx, err)
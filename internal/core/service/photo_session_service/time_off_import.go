@@ -0,0 +1,302 @@
+package photosessionservices
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+	"github.com/projeto-toq/toq_server/internal/core/utils/ics"
+)
+
+// PreviewTimeOffImport expands an iCalendar document against the photographer agenda without persisting anything.
+func (s *photoSessionService) PreviewTimeOffImport(ctx context.Context, input ImportTimeOffInput) (ImportTimeOffResult, error) {
+	if err := validateImportTimeOffInput(input); err != nil {
+		return ImportTimeOffResult{}, err
+	}
+
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return ImportTimeOffResult{}, utils.InternalError("")
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	tx, err := s.globalService.StartReadOnlyTransaction(ctx)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.time_off.import_preview.tx_start_error", "err", err)
+		return ImportTimeOffResult{}, utils.InternalError("")
+	}
+	defer func() {
+		if rollbackErr := s.globalService.RollbackTransaction(ctx, tx); rollbackErr != nil {
+			utils.SetSpanError(ctx, rollbackErr)
+			logger.Error("photo_session.time_off.import_preview.tx_rollback_error", "err", rollbackErr)
+		}
+	}()
+
+	return s.planTimeOffImport(ctx, tx, input)
+}
+
+// ImportTimeOff creates one time-off entry per importable occurrence. Occurrences overlapping bookings abort the
+// import unless SkipConflicts is set; the parts of an occurrence already covered or repeated in the file are always
+// skipped, and only the remaining parts are created.
+func (s *photoSessionService) ImportTimeOff(ctx context.Context, input ImportTimeOffInput) (ImportTimeOffResult, error) {
+	if err := validateImportTimeOffInput(input); err != nil {
+		return ImportTimeOffResult{}, err
+	}
+
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return ImportTimeOffResult{}, utils.InternalError("")
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	tx, err := s.globalService.StartTransaction(ctx)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.time_off.import.tx_start_error", "err", err)
+		return ImportTimeOffResult{}, utils.InternalError("")
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			if rollbackErr := s.globalService.RollbackTransaction(ctx, tx); rollbackErr != nil {
+				utils.SetSpanError(ctx, rollbackErr)
+				logger.Error("photo_session.time_off.import.tx_rollback_error", "err", rollbackErr)
+			}
+		}
+	}()
+
+	result, err := s.planTimeOffImport(ctx, tx, input)
+	if err != nil {
+		return ImportTimeOffResult{}, err
+	}
+	if result.Conflicts > 0 && !input.SkipConflicts {
+		return ImportTimeOffResult{}, utils.ConflictError("Imported calendar conflicts with booked photo sessions")
+	}
+
+	for i := range result.Items {
+		item := &result.Items[i]
+		if item.Status != ImportItemReady {
+			continue
+		}
+
+		var reason *string
+		if summary := truncateReason(strings.TrimSpace(item.Summary), maxTimeOffReasonLength); summary != "" {
+			reason = &summary
+		}
+
+		id, createErr := s.createTimeOffInternal(ctx, tx, TimeOffInput{
+			PhotographerID: input.PhotographerID,
+			StartDate:      item.StartsAt,
+			EndDate:        item.EndsAt,
+			Reason:         reason,
+			Location:       input.Location,
+		})
+		if createErr != nil {
+			return ImportTimeOffResult{}, createErr
+		}
+
+		item.TimeOffID = id
+		item.Status = ImportItemCreated
+		result.Ready--
+		result.Created++
+	}
+
+	if err := s.globalService.CommitTransaction(ctx, tx); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.time_off.import.tx_commit_error", "err", err)
+		return ImportTimeOffResult{}, utils.InternalError("")
+	}
+	committed = true
+
+	logger.Info("photo_session.time_off.import.completed", "photographer_id", input.PhotographerID, "created", result.Created, "conflicts", result.Conflicts)
+
+	return result, nil
+}
+
+func validateImportTimeOffInput(input ImportTimeOffInput) error {
+	if input.PhotographerID == 0 {
+		return utils.ValidationError("photographerId", "photographerId must be greater than zero")
+	}
+	if input.Location == nil {
+		return utils.ValidationError("timezone", "timezone is required")
+	}
+	if strings.TrimSpace(input.Calendar) == "" {
+		return utils.ValidationError("calendar", "calendar is required")
+	}
+	if len(input.Calendar) > maxImportCalendarBytes {
+		return utils.ValidationError("calendar", "calendar must not exceed 1 MiB")
+	}
+	return nil
+}
+
+// planTimeOffImport expands the calendar and classifies every occurrence against the photographer agenda.
+// Occurrences partly covered by existing time-off, blocks or earlier occurrences are split into one item per part.
+func (s *photoSessionService) planTimeOffImport(ctx context.Context, tx *sql.Tx, input ImportTimeOffInput) (ImportTimeOffResult, error) {
+	logger := utils.LoggerFromContext(ctx)
+	loc := input.Location
+
+	windowStart := time.Now().In(loc).Truncate(time.Minute)
+	windowEnd := windowStart.Add(importWindow)
+
+	events, err := ics.Parse(strings.NewReader(input.Calendar), loc)
+	if err != nil {
+		return ImportTimeOffResult{}, utils.ValidationError("calendar", "calendar is not a valid iCalendar document")
+	}
+	expansion, err := ics.Expand(events, windowStart, windowEnd, maxImportOccurrences)
+	if err != nil {
+		if errors.Is(err, ics.ErrTooManyOccurrences) {
+			return ImportTimeOffResult{}, utils.ValidationError("calendar", "calendar expands to more than 500 occurrences in the next 365 days")
+		}
+		return ImportTimeOffResult{}, utils.ValidationError("calendar", "calendar could not be expanded")
+	}
+
+	result := ImportTimeOffResult{
+		Timezone:    loc.String(),
+		WindowStart: windowStart,
+		WindowEnd:   windowEnd,
+		Items:       make([]ImportTimeOffItem, 0, len(expansion.Occurrences)),
+		Unsupported: make([]ImportUnsupportedEvent, 0, len(expansion.Unsupported)),
+	}
+	for _, event := range expansion.Unsupported {
+		reason := "unsupported recurrence rule"
+		if event.RecurrenceErr != nil {
+			reason = event.RecurrenceErr.Error()
+		}
+		result.Unsupported = append(result.Unsupported, ImportUnsupportedEvent{UID: event.UID, Summary: event.Summary, Reason: reason})
+	}
+	if len(expansion.Occurrences) == 0 {
+		return result, nil
+	}
+
+	spanEnd := expansion.Occurrences[0].End
+	for _, occurrence := range expansion.Occurrences {
+		if occurrence.End.After(spanEnd) {
+			spanEnd = occurrence.End
+		}
+	}
+
+	existing, err := s.repo.ListEntriesByRange(ctx, tx, input.PhotographerID, windowStart.UTC(), spanEnd.UTC(), nil)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.time_off.import.list_entries_error", "photographer_id", input.PhotographerID, "err", err)
+		return ImportTimeOffResult{}, utils.InternalError("")
+	}
+
+	var busyEntries []photosessionmodel.AgendaEntryInterface
+	var busy, blocked []ics.Span
+	for _, entry := range existing {
+		span := ics.Span{Start: entry.StartsAt(), End: entry.EndsAt()}
+		switch entry.EntryType() {
+		case photosessionmodel.AgendaEntryTypePhotoSession:
+			busyEntries = append(busyEntries, entry)
+			busy = append(busy, span)
+		case photosessionmodel.AgendaEntryTypeTimeOff, photosessionmodel.AgendaEntryTypeBlock:
+			blocked = append(blocked, span)
+		}
+	}
+
+	planner := ics.NewImportPlanner(busy, blocked)
+	for _, occurrence := range expansion.Occurrences {
+		start := occurrence.Start
+		if start.Before(windowStart) {
+			start = windowStart
+		}
+		item := ImportTimeOffItem{
+			UID:      occurrence.UID,
+			Summary:  occurrence.Summary,
+			StartsAt: start.In(loc),
+			EndsAt:   occurrence.End.In(loc),
+			AllDay:   occurrence.AllDay,
+		}
+
+		classification := planner.Classify(ics.Span{Start: item.StartsAt, End: item.EndsAt})
+		if len(classification.Conflicts) > 0 {
+			for _, index := range classification.Conflicts {
+				conflict, conflictErr := s.timeOffImportConflict(ctx, tx, busyEntries[index], loc)
+				if conflictErr != nil {
+					return ImportTimeOffResult{}, conflictErr
+				}
+				item.Conflicts = append(item.Conflicts, conflict)
+			}
+			item.Status = ImportItemConflict
+			result.Conflicts++
+			result.Items = append(result.Items, item)
+			continue
+		}
+
+		for _, segment := range classification.Segments {
+			part := item
+			part.StartsAt = segment.Start.In(loc)
+			part.EndsAt = segment.End.In(loc)
+			part.Partial = len(classification.Segments) > 1
+			part.Status = importItemStatusForCoverage(segment.Coverage)
+			if part.Status == ImportItemReady {
+				result.Ready++
+			}
+			result.Items = append(result.Items, part)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *photoSessionService) timeOffImportConflict(ctx context.Context, tx *sql.Tx, entry photosessionmodel.AgendaEntryInterface, loc *time.Location) (TimeOffImportConflict, error) {
+	conflict := TimeOffImportConflict{
+		EntryID:  entry.ID(),
+		StartsAt: entry.StartsAt().In(loc),
+		EndsAt:   entry.EndsAt().In(loc),
+	}
+
+	booking, err := s.repo.FindBookingByAgendaEntry(ctx, tx, entry.ID())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return conflict, nil
+		}
+		utils.SetSpanError(ctx, err)
+		utils.LoggerFromContext(ctx).Error("photo_session.time_off.import.find_booking_error", "agenda_entry_id", entry.ID(), "err", err)
+		return TimeOffImportConflict{}, utils.InternalError("")
+	}
+
+	bookingID := booking.ID()
+	listingIdentityID := booking.ListingIdentityID()
+	conflict.BookingID = &bookingID
+	conflict.ListingIdentityID = &listingIdentityID
+	return conflict, nil
+}
+
+// importItemStatusForCoverage maps the coverage of an occurrence segment to its import status.
+func importItemStatusForCoverage(coverage ics.Coverage) ImportItemStatus {
+	switch coverage {
+	case ics.CoverageBlocked:
+		return ImportItemAlreadyBlocked
+	case ics.CoverageDuplicate:
+		return ImportItemDuplicate
+	default:
+		return ImportItemReady
+	}
+}
+
+// truncateReason cuts value to at most limit bytes without splitting a UTF-8 sequence.
+func truncateReason(value string, limit int) string {
+	if len(value) <= limit {
+		return value
+	}
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(value[cut]) {
+		cut--
+	}
+	return strings.TrimSpace(value[:cut])
+}
@@ -0,0 +1,177 @@
+package photosessionservices
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"time"
+
+	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// travelSession is a booked photo session with the location the photographer travels from/to.
+type travelSession struct {
+	listingIdentityID int64
+	start             time.Time
+	end               time.Time
+	location          photosessionmodel.SessionLocation
+}
+
+// locationCache memoises listing locations within a single request.
+type locationCache map[int64]photosessionmodel.SessionLocation
+
+// travelEnabled reports whether travel buffers are applied between sessions.
+func (s *photoSessionService) travelEnabled() bool {
+	return s.cfg.TravelBufferEnabled
+}
+
+// maxTravelBuffer is the largest buffer ever required; used to widen agenda lookups around a slot.
+func (s *photoSessionService) maxTravelBuffer() time.Duration {
+	minutes := s.cfg.TravelMaxBufferMinutes
+	if minutes <= 0 {
+		minutes = defaultTravelMaxBufferMinutes
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+// travelBuffer returns the gap needed between two sessions: the fixed setup buffer plus the estimated drive
+// at the configured average speed, rounded up to 5 minutes and capped at max_buffer_minutes.
+// Consecutive sessions at the same listing need no buffer.
+func (s *photoSessionService) travelBuffer(fromListingID int64, from photosessionmodel.SessionLocation, toListingID int64, to photosessionmodel.SessionLocation) time.Duration {
+	if fromListingID == toListingID {
+		return 0
+	}
+
+	speed := s.cfg.TravelSpeedKmh
+	if speed <= 0 {
+		speed = defaultTravelSpeedKmh
+	}
+
+	minutes := float64(max(s.cfg.TravelMinBufferMinutes, 0)) + s.distance.EstimateKm(from, to)/speed*60
+	minutes = math.Ceil(minutes/5) * 5
+
+	buffer := time.Duration(minutes) * time.Minute
+	if maxBuffer := s.maxTravelBuffer(); buffer > maxBuffer {
+		buffer = maxBuffer
+	}
+	return buffer
+}
+
+// cachedLocation resolves a listing location once per request.
+func (s *photoSessionService) cachedLocation(ctx context.Context, tx *sql.Tx, cache locationCache, listingIdentityID int64) (photosessionmodel.SessionLocation, error) {
+	if location, ok := cache[listingIdentityID]; ok {
+		return location, nil
+	}
+	location, err := s.sessionLocationByIdentity(ctx, tx, listingIdentityID)
+	if err != nil {
+		return photosessionmodel.SessionLocation{}, err
+	}
+	cache[listingIdentityID] = location
+	return location, nil
+}
+
+// travelSessionsFromEntries keeps photo session entries bound to a listing and resolves their locations,
+// skipping ignoreEntryID (the caller's own entry during reschedules).
+func (s *photoSessionService) travelSessionsFromEntries(ctx context.Context, tx *sql.Tx, cache locationCache, entries []photosessionmodel.AgendaEntryInterface, ignoreEntryID uint64) ([]travelSession, error) {
+	sessions := make([]travelSession, 0, len(entries))
+	for _, entry := range entries {
+		if entry.EntryType() != photosessionmodel.AgendaEntryTypePhotoSession || entry.ID() == ignoreEntryID {
+			continue
+		}
+		sourceID, ok := entry.SourceID()
+		if !ok || sourceID == nil {
+			continue
+		}
+		listingIdentityID := int64(*sourceID)
+		location, err := s.cachedLocation(ctx, tx, cache, listingIdentityID)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, travelSession{
+			listingIdentityID: listingIdentityID,
+			start:             entry.StartsAt(),
+			end:               entry.EndsAt(),
+			location:          location,
+		})
+	}
+	return sessions, nil
+}
+
+// slotReachable reports whether [start, end) at the target listing leaves enough travel time after the
+// closest earlier session and before the closest later one.
+func (s *photoSessionService) slotReachable(start, end time.Time, targetListingID int64, target photosessionmodel.SessionLocation, sessions []travelSession) bool {
+	var previous, next *travelSession
+	for i := range sessions {
+		session := &sessions[i]
+		if !session.end.After(start) {
+			if previous == nil || session.end.After(previous.end) {
+				previous = session
+			}
+			continue
+		}
+		if !session.start.Before(end) {
+			if next == nil || session.start.Before(next.start) {
+				next = session
+			}
+		}
+	}
+
+	if previous != nil && start.Sub(previous.end) < s.travelBuffer(previous.listingIdentityID, previous.location, targetListingID, target) {
+		return false
+	}
+	if next != nil && next.start.Sub(end) < s.travelBuffer(targetListingID, target, next.listingIdentityID, next.location) {
+		return false
+	}
+	return true
+}
+
+// photographerCanReach loads the photographer's sessions around the window and checks travel buffers.
+// Always true when travel buffers are disabled.
+func (s *photoSessionService) photographerCanReach(ctx context.Context, tx *sql.Tx, cache locationCache, photographerID uint64, targetListingID int64, target photosessionmodel.SessionLocation, start, end time.Time, ignoreEntryID uint64) (bool, error) {
+	if !s.travelEnabled() {
+		return true, nil
+	}
+
+	logger := utils.LoggerFromContext(ctx)
+	margin := s.maxTravelBuffer()
+	sessionType := photosessionmodel.AgendaEntryTypePhotoSession
+
+	entries, err := s.repo.ListEntriesByRange(ctx, tx, photographerID, start.Add(-margin).UTC(), end.Add(margin).UTC(), &sessionType)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("photo_session.travel.list_entries_error", "photographer_id", photographerID, "err", err)
+		return false, derrors.Infra("failed to load agenda entries", err)
+	}
+
+	sessions, err := s.travelSessionsFromEntries(ctx, tx, cache, entries, ignoreEntryID)
+	if err != nil {
+		return false, err
+	}
+
+	return s.slotReachable(start, end, targetListingID, target, sessions), nil
+}
+
+// ensureReachable rejects an explicitly chosen slot that violates travel buffers with derrors.ErrSlotUnavailable.
+func (s *photoSessionService) ensureReachable(ctx context.Context, tx *sql.Tx, listing listingmodel.ListingInterface, photographerID uint64, start, end time.Time, ignoreEntryID uint64) error {
+	if !s.travelEnabled() {
+		return nil
+	}
+
+	target, err := s.sessionLocation(ctx, tx, listing)
+	if err != nil {
+		return err
+	}
+
+	reachable, err := s.photographerCanReach(ctx, tx, locationCache{listing.IdentityID(): target}, photographerID, listing.IdentityID(), target, start, end, ignoreEntryID)
+	if err != nil {
+		return err
+	}
+	if !reachable {
+		utils.LoggerFromContext(ctx).Info("photo_session.travel.unreachable", "photographer_id", photographerID, "listing_identity_id", listing.IdentityID(), "slot_start", start)
+		return derrors.ErrSlotUnavailable
+	}
+	return nil
+}
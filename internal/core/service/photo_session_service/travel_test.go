@@ -0,0 +1,92 @@
+package photosessionservices
+
+import (
+	"testing"
+	"time"
+
+	photosessionmodel "github.com/projeto-toq/toq_server/internal/core/model/photo_session_model"
+)
+
+// fixedDistance estimates every trip at the same distance.
+type fixedDistance float64
+
+func (d fixedDistance) EstimateKm(photosessionmodel.SessionLocation, photosessionmodel.SessionLocation) float64 {
+	return float64(d)
+}
+
+func TestTravelBuffer(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		cfg           Config
+		distanceKm    float64
+		fromListingID int64
+		toListingID   int64
+		expected      time.Duration
+	}{
+		{name: "same listing needs no buffer", distanceKm: 50, fromListingID: 7, toListingID: 7, expected: 0},
+		{name: "drive at the default speed rounded up to 5 minutes", distanceKm: 10, fromListingID: 1, toListingID: 2, expected: 25 * time.Minute},
+		{name: "fixed setup gap is added", cfg: Config{TravelMinBufferMinutes: 10}, distanceKm: 10, fromListingID: 1, toListingID: 2, expected: 35 * time.Minute},
+		{name: "configured speed", cfg: Config{TravelSpeedKmh: 50}, distanceKm: 10, fromListingID: 1, toListingID: 2, expected: 15 * time.Minute},
+		{name: "zero distance keeps the setup gap", cfg: Config{TravelMinBufferMinutes: 10}, fromListingID: 1, toListingID: 2, expected: 10 * time.Minute},
+		{name: "capped at the default maximum", distanceKm: 100, fromListingID: 1, toListingID: 2, expected: 120 * time.Minute},
+		{name: "capped at the configured maximum", cfg: Config{TravelMaxBufferMinutes: 60}, distanceKm: 100, fromListingID: 1, toListingID: 2, expected: 60 * time.Minute},
+		{name: "negative setup gap is ignored", cfg: Config{TravelMinBufferMinutes: -30}, distanceKm: 10, fromListingID: 1, toListingID: 2, expected: 25 * time.Minute},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := &photoSessionService{cfg: tt.cfg, distance: fixedDistance(tt.distanceKm)}
+			got := svc.travelBuffer(tt.fromListingID, photosessionmodel.SessionLocation{}, tt.toListingID, photosessionmodel.SessionLocation{})
+			if got != tt.expected {
+				t.Fatalf("travelBuffer(%.0fkm) = %s, expected %s", tt.distanceKm, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSlotReachable(t *testing.T) {
+	t.Parallel()
+
+	at := func(hour, minute int) time.Time { return time.Date(2026, time.March, 2, hour, minute, 0, 0, time.UTC) }
+	// Sessions at listing 2; every trip to or from another listing needs 25 minutes.
+	sessions := []travelSession{
+		{listingIdentityID: 2, start: at(15, 0), end: at(17, 0)},
+		{listingIdentityID: 2, start: at(7, 0), end: at(8, 0)},
+		{listingIdentityID: 2, start: at(9, 0), end: at(11, 0)},
+	}
+
+	cases := []struct {
+		name      string
+		start     time.Time
+		end       time.Time
+		listingID int64
+		expected  bool
+	}{
+		{name: "no gap after the previous session", start: at(11, 0), end: at(13, 0), listingID: 1, expected: false},
+		{name: "gap shorter than the drive after the previous session", start: at(11, 20), end: at(13, 20), listingID: 1, expected: false},
+		{name: "gap covers the drive on both sides", start: at(11, 30), end: at(13, 30), listingID: 1, expected: true},
+		{name: "gap shorter than the drive before the next session", start: at(12, 40), end: at(14, 40), listingID: 1, expected: false},
+		{name: "exact buffer before the next session", start: at(12, 35), end: at(14, 35), listingID: 1, expected: true},
+		{name: "same listing needs no travel", start: at(11, 0), end: at(13, 0), listingID: 2, expected: true},
+		{name: "the closest earlier session is checked", start: at(17, 10), end: at(19, 10), listingID: 1, expected: false},
+		{name: "no sessions around the slot", start: at(19, 0), end: at(21, 0), listingID: 1, expected: true},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := &photoSessionService{cfg: Config{TravelBufferEnabled: true}, distance: fixedDistance(10)}
+			got := svc.slotReachable(tt.start, tt.end, tt.listingID, photosessionmodel.SessionLocation{}, sessions)
+			if got != tt.expected {
+				t.Fatalf("slotReachable(%s-%s) = %t, expected %t", tt.start.Format("15:04"), tt.end.Format("15:04"), got, tt.expected)
+			}
+		})
+	}
+}
@@ -183,13 +183,16 @@ type AvailabilitySlot struct {
 }
 
 // ReserveSessionInput captures the necessary identifiers to reserve a photo session window.
+// SessionType is optional and defaults to STANDARD.
 type ReserveSessionInput struct {
 	ListingIdentityID int64
 	SlotID            uint64
 	UserID            int64
+	SessionType       string
 }
 
 // ReserveSessionOutput returns metadata about the reserved session.
+// Assignment is only set when the photographer was chosen automatically.
 type ReserveSessionOutput struct {
 	PhotoSessionID    uint64
 	SlotID            uint64
@@ -197,6 +200,8 @@ type ReserveSessionOutput struct {
 	SlotEnd           time.Time
 	PhotographerID    uint64
 	ListingIdentityID int64
+	AssignmentMode    photosessionmodel.AssignmentMode
+	Assignment        *photosessionmodel.PhotographerAssignment
 }
 
 // ConfirmSessionInput holds data required to confirm a reserved session.
@@ -232,6 +237,47 @@ type CancelSessionOutput struct {
 	ListingCode       uint32
 }
 
+// RescheduleSessionInput identifies the booking to move and the replacement slot.
+type RescheduleSessionInput struct {
+	PhotoSessionID uint64
+	SlotID         uint64
+	UserID         int64
+	Reason         *string
+}
+
+// RescheduleSessionOutput reports the booking after the slot swap together with the released window.
+type RescheduleSessionOutput struct {
+	PhotoSessionID         uint64
+	SlotID                 uint64
+	SlotStart              time.Time
+	SlotEnd                time.Time
+	PhotographerID         uint64
+	PreviousSlotStart      time.Time
+	PreviousPhotographerID uint64
+	ListingIdentityID      int64
+	ListingCode            uint32
+	Status                 photosessionmodel.BookingStatus
+	RescheduleCount        int64
+}
+
+// RescheduleReportInput captures the aggregation dimension, window and pagination for the reschedule report.
+type RescheduleReportInput struct {
+	GroupBy string
+	From    *time.Time
+	To      *time.Time
+	Page    int
+	Size    int
+}
+
+// RescheduleReportOutput bundles aggregated reschedule counts with pagination metadata.
+type RescheduleReportOutput struct {
+	GroupBy photosessionmodel.RescheduleReportGroup
+	Items   []photosessionmodel.RescheduleCount
+	Total   int64
+	Page    int
+	Size    int
+}
+
 // ListServiceAreasInput captures filters and pagination options when listing service areas.
 type ListServiceAreasInput struct {
 	PhotographerID uint64
@@ -278,3 +324,153 @@ type ServiceAreaDetailInput struct {
 type ServiceAreaResult struct {
 	Area photosessionmodel.PhotographerServiceAreaInterface
 }
+
+// PhotographerStatementInput selects the photographer and month ("YYYY-MM", empty → current month).
+type PhotographerStatementInput struct {
+	PhotographerID uint64
+	Period         string
+}
+
+// PhotographerStatementOutput lists the ledger lines of a month with totals split by payout status.
+type PhotographerStatementOutput struct {
+	Period       string
+	Lines        []photosessionmodel.PayoutLedgerLineInterface
+	TotalCents   int64
+	PendingCents int64
+	PaidCents    int64
+}
+
+// ClosePayoutPeriodInput identifies the month to settle and the admin closing it.
+type ClosePayoutPeriodInput struct {
+	Period   string
+	ClosedBy int64
+}
+
+// ClosePayoutPeriodOutput returns every settled line of the period for the finance export.
+// Closed counts the lines settled by this call; re-closing a period settles nothing and re-exports.
+type ClosePayoutPeriodOutput struct {
+	Period     string
+	Reference  string
+	Closed     int64
+	Lines      []photosessionmodel.PayoutLedgerLineInterface
+	TotalCents int64
+}
+
+// ConfirmArrivalInput identifies the session the photographer arrived at.
+type ConfirmArrivalInput struct {
+	SessionID      uint64
+	PhotographerID uint64
+}
+
+// ConfirmArrivalOutput reports when the arrival was recorded and whether it was within the grace period.
+type ConfirmArrivalOutput struct {
+	SessionID uint64
+	ArrivedAt time.Time
+	OnTime    bool
+}
+
+// RateSessionInput carries the owner's star rating for the listing's latest completed session.
+type RateSessionInput struct {
+	ListingIdentityID int64
+	UserID            int64
+	Rating            int
+	Comment           *string
+}
+
+// RateSessionOutput identifies the rated session and photographer.
+type RateSessionOutput struct {
+	PhotoSessionID uint64
+	PhotographerID uint64
+	Rating         int
+}
+
+// PhotographerKPIReportInput filters the admin KPI report by session start window and photographer.
+type PhotographerKPIReportInput struct {
+	PhotographerID uint64
+	From           *time.Time
+	To             *time.Time
+	Page           int
+	Size           int
+}
+
+// PhotographerKPIReportItem pairs aggregated KPIs with the ranking score used in slot listings.
+type PhotographerKPIReportItem struct {
+	photosessionmodel.PhotographerKPI
+	Score float64
+}
+
+// PhotographerKPIReportOutput bundles per-photographer KPIs with pagination metadata.
+type PhotographerKPIReportOutput struct {
+	Items []PhotographerKPIReportItem
+	Total int64
+	Page  int
+	Size  int
+}
+
+// ImportItemStatus classifies an occurrence of an imported calendar.
+type ImportItemStatus string
+
+const (
+	// ImportItemReady means the occurrence can be created as time-off.
+	ImportItemReady ImportItemStatus = "READY"
+	// ImportItemConflict means the occurrence overlaps a photo session booking.
+	ImportItemConflict ImportItemStatus = "CONFLICT"
+	// ImportItemAlreadyBlocked means the period (or this part of the occurrence) is already covered by time-off or a block.
+	ImportItemAlreadyBlocked ImportItemStatus = "ALREADY_BLOCKED"
+	// ImportItemDuplicate means the period (or this part of the occurrence) is covered by an earlier occurrence of the same import.
+	ImportItemDuplicate ImportItemStatus = "DUPLICATE"
+	// ImportItemCreated means the time-off was persisted by the import.
+	ImportItemCreated ImportItemStatus = "CREATED"
+)
+
+// ImportTimeOffInput carries an iCalendar document to be converted into time-off entries.
+type ImportTimeOffInput struct {
+	PhotographerID uint64
+	Calendar       string
+	// Location is used for floating and all-day values of the calendar.
+	Location *time.Location
+	// SkipConflicts imports the remaining occurrences instead of failing when some conflict.
+	SkipConflicts bool
+}
+
+// TimeOffImportConflict describes a booked photo session overlapping an imported occurrence.
+type TimeOffImportConflict struct {
+	EntryID           uint64
+	BookingID         *uint64
+	ListingIdentityID *int64
+	StartsAt          time.Time
+	EndsAt            time.Time
+}
+
+// ImportTimeOffItem is one expanded occurrence of the imported calendar, or one part of it.
+type ImportTimeOffItem struct {
+	UID      string
+	Summary  string
+	StartsAt time.Time
+	EndsAt   time.Time
+	AllDay   bool
+	// Partial marks a part of an occurrence that was split because it is only partly covered.
+	Partial   bool
+	Status    ImportItemStatus
+	TimeOffID uint64
+	Conflicts []TimeOffImportConflict
+}
+
+// ImportUnsupportedEvent is a calendar event left out of the import.
+type ImportUnsupportedEvent struct {
+	UID     string
+	Summary string
+	Reason  string
+}
+
+// ImportTimeOffResult is the preview or outcome of a calendar import. Times are in the requested timezone.
+type ImportTimeOffResult struct {
+	Timezone    string
+	WindowStart time.Time
+	WindowEnd   time.Time
+	Items       []ImportTimeOffItem
+	Unsupported []ImportUnsupportedEvent
+	Ready       int
+	Conflicts   int
+	Created     int
+}
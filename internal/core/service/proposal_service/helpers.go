@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
 	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
 	proposalmodel "github.com/projeto-toq/toq_server/internal/core/model/proposal_model"
 	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
@@ -610,26 +611,35 @@ func (s *proposalService) notifyUserDevices(ctx context.Context, userID int64, s
 	ctx = utils.ContextWithLogger(ctx)
 	logger := utils.LoggerFromContext(ctx)
 
+	s.notifier.StoreInbox(ctx, globalservice.NotificationRequest{
+		Type:     globalservice.NotificationTypeFCM,
+		Subject:  subject,
+		Body:     body,
+		Data:     cloneData(data),
+		UserID:   userID,
+		Category: globalmodel.NotificationCategoryProposals,
+	})
+
 	tokens, err := s.globalSvc.ListDeviceTokensByUserIDIfOptedIn(ctx, userID)
 	if err != nil {
 		utils.SetSpanError(ctx, err)
 		logger.Error("proposal.notifications.tokens_error", "err", err, "user_id", userID)
 		return
 	}
-	if len(tokens) == 0 {
-		return
-	}
 
 	for _, token := range tokens {
 		if token == "" {
 			continue
 		}
 		payload := globalservice.NotificationRequest{
-			Type:    globalservice.NotificationTypeFCM,
-			Subject: subject,
-			Body:    body,
-			Token:   token,
-			Data:    cloneData(data),
+			Type:      globalservice.NotificationTypeFCM,
+			Subject:   subject,
+			Body:      body,
+			Token:     token,
+			Data:      cloneData(data),
+			UserID:    userID,
+			Category:  globalmodel.NotificationCategoryProposals,
+			SkipInbox: true,
 		}
 		if err := s.notifier.SendNotification(ctx, payload); err != nil {
 			utils.SetSpanError(ctx, err)
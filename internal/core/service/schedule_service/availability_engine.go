@@ -105,22 +105,39 @@ func buildInitialAvailability(from, to time.Time) []*dailyAvailability {
 // applyRules removes blocked ranges configured by agenda rules.
 // Rules must already be normalized to half-open semantics ([start,end)), meaning end_minute in DB is inclusive
 // and was converted to an exclusive upper bound before this step.
+//
+// Precedence, from lowest to highest: weekly rules, monthly rules (day of month or nth weekday), date rules.
+// Each level first reopens its FREE windows and then removes its BLOCK windows, so a more specific rule
+// overrides a broader one and, within the same level, BLOCK wins over FREE. Rules outside their validity
+// range are ignored. Agenda entries are applied afterwards by applyEntries and are never reopened.
 func applyRules(days []*dailyAvailability, rules []schedulemodel.AgendaRuleInterface, from, to time.Time) {
-	for _, rule := range rules {
-		if !rule.IsActive() || rule.RuleType() != schedulemodel.RuleTypeBlock {
-			continue
-		}
+	for _, level := range ruleLevels {
 		for _, day := range days {
-			if day.dayStart.Weekday() != rule.DayOfWeek() {
-				continue
-			}
-			block := timeRange{
-				start: day.dayStart.Add(time.Duration(rule.StartMinutes()) * time.Minute),
-				end:   day.dayStart.Add(time.Duration(rule.EndMinutes()) * time.Minute),
-			}
-			if clamped, ok := clampRange(block, day.dayStart, day.dayStart.Add(24*time.Hour)); ok {
-				if final, ok := clampRange(clamped, from, to); ok {
-					day.ranges = subtractRange(day.ranges, final)
+			for _, ruleType := range []schedulemodel.RuleType{schedulemodel.RuleTypeFree, schedulemodel.RuleTypeBlock} {
+				for _, rule := range rules {
+					if !rule.IsActive() || rule.RuleType() != ruleType || ruleLevel(rule) != level {
+						continue
+					}
+					if !ruleAppliesOn(rule, day.dayStart) {
+						continue
+					}
+					window := timeRange{
+						start: day.dayStart.Add(time.Duration(rule.StartMinutes()) * time.Minute),
+						end:   day.dayStart.Add(time.Duration(rule.EndMinutes()) * time.Minute),
+					}
+					clamped, ok := clampRange(window, day.dayStart, day.dayStart.Add(24*time.Hour))
+					if !ok {
+						continue
+					}
+					final, ok := clampRange(clamped, from, to)
+					if !ok {
+						continue
+					}
+					if ruleType == schedulemodel.RuleTypeFree {
+						day.ranges = addRange(day.ranges, final)
+					} else {
+						day.ranges = subtractRange(day.ranges, final)
+					}
 				}
 			}
 		}
@@ -0,0 +1,212 @@
+package scheduleservices
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	schedulemodel "github.com/projeto-toq/toq_server/internal/core/model/schedule_model"
+)
+
+// ruleDay is the second (not the last) Tuesday of March 2026.
+var ruleDay = time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+type ruleOption func(schedulemodel.AgendaRuleInterface)
+
+func weekly(day time.Weekday) ruleOption {
+	return func(r schedulemodel.AgendaRuleInterface) {
+		r.SetRecurrence(schedulemodel.RuleRecurrenceWeekly)
+		r.SetDayOfWeek(day)
+	}
+}
+
+func monthlyDay(day uint8) ruleOption {
+	return func(r schedulemodel.AgendaRuleInterface) {
+		r.SetRecurrence(schedulemodel.RuleRecurrenceMonthlyDay)
+		r.SetDayOfMonth(day)
+	}
+}
+
+func monthlyWeekday(week int8, day time.Weekday) ruleOption {
+	return func(r schedulemodel.AgendaRuleInterface) {
+		r.SetRecurrence(schedulemodel.RuleRecurrenceMonthlyWeekday)
+		r.SetWeekOfMonth(week)
+		r.SetDayOfWeek(day)
+	}
+}
+
+func onDate(date time.Time) ruleOption {
+	return func(r schedulemodel.AgendaRuleInterface) {
+		r.SetRecurrence(schedulemodel.RuleRecurrenceDate)
+		r.SetRuleDate(date)
+	}
+}
+
+func validUntil(date time.Time) ruleOption {
+	return func(r schedulemodel.AgendaRuleInterface) { r.SetValidUntil(date) }
+}
+
+func validFrom(date time.Time) ruleOption {
+	return func(r schedulemodel.AgendaRuleInterface) { r.SetValidFrom(date) }
+}
+
+func inactive(r schedulemodel.AgendaRuleInterface) { r.SetActive(false) }
+
+func testRule(ruleType schedulemodel.RuleType, startHour, endHour uint16, options ...ruleOption) schedulemodel.AgendaRuleInterface {
+	rule := schedulemodel.NewAgendaRule()
+	rule.SetRuleType(ruleType)
+	rule.SetActive(true)
+	rule.SetStartMinutes(startHour * 60)
+	rule.SetEndMinutes(endHour * 60)
+	for _, option := range options {
+		option(rule)
+	}
+	return rule
+}
+
+func formatDayRanges(day *dailyAvailability) string {
+	parts := make([]string, 0, len(day.ranges))
+	for _, r := range day.ranges {
+		start := r.start.Sub(day.dayStart)
+		end := r.end.Sub(day.dayStart)
+		parts = append(parts, fmt.Sprintf("%02d:%02d-%02d:%02d", int(start.Hours()), int(start.Minutes())%60, int(end.Hours()), int(end.Minutes())%60))
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestApplyRulesPrecedence(t *testing.T) {
+	t.Parallel()
+
+	const (
+		block = schedulemodel.RuleTypeBlock
+		free  = schedulemodel.RuleTypeFree
+	)
+	closedTuesday := testRule(block, 0, 24, weekly(time.Tuesday))
+
+	cases := []struct {
+		name     string
+		rules    []schedulemodel.AgendaRuleInterface
+		expected string
+	}{
+		{
+			name:     "no rules keeps the whole day",
+			expected: "00:00-24:00",
+		},
+		{
+			name: "weekly blocks on the weekday",
+			rules: []schedulemodel.AgendaRuleInterface{
+				testRule(block, 0, 8, weekly(time.Tuesday)),
+				testRule(block, 18, 24, weekly(time.Tuesday)),
+				testRule(block, 8, 18, weekly(time.Monday)),
+			},
+			expected: "08:00-18:00",
+		},
+		{
+			name: "monthly free reopens a weekly block",
+			rules: []schedulemodel.AgendaRuleInterface{
+				closedTuesday,
+				testRule(free, 9, 12, monthlyDay(10)),
+			},
+			expected: "09:00-12:00",
+		},
+		{
+			name: "block wins over free at the same level",
+			rules: []schedulemodel.AgendaRuleInterface{
+				closedTuesday,
+				testRule(free, 9, 12, monthlyDay(10)),
+				testRule(block, 10, 11, monthlyWeekday(2, time.Tuesday)),
+			},
+			expected: "09:00-10:00 11:00-12:00",
+		},
+		{
+			name: "block wins over free regardless of rule order",
+			rules: []schedulemodel.AgendaRuleInterface{
+				testRule(block, 10, 11, monthlyWeekday(2, time.Tuesday)),
+				testRule(free, 9, 12, monthlyDay(10)),
+				closedTuesday,
+			},
+			expected: "09:00-10:00 11:00-12:00",
+		},
+		{
+			name: "date free overrides a monthly block",
+			rules: []schedulemodel.AgendaRuleInterface{
+				closedTuesday,
+				testRule(free, 9, 17, monthlyDay(10)),
+				testRule(block, 9, 17, monthlyDay(10)),
+				testRule(free, 14, 15, onDate(ruleDay)),
+			},
+			expected: "14:00-15:00",
+		},
+		{
+			name: "date block overrides a monthly free",
+			rules: []schedulemodel.AgendaRuleInterface{
+				closedTuesday,
+				testRule(free, 9, 17, monthlyWeekday(2, time.Tuesday)),
+				testRule(block, 12, 13, onDate(ruleDay)),
+			},
+			expected: "09:00-12:00 13:00-17:00",
+		},
+		{
+			name: "rules for other days do not apply",
+			rules: []schedulemodel.AgendaRuleInterface{
+				testRule(block, 9, 12, monthlyDay(11)),
+				testRule(block, 12, 13, monthlyWeekday(-1, time.Tuesday)),
+				testRule(block, 13, 14, monthlyWeekday(1, time.Tuesday)),
+				testRule(block, 14, 15, onDate(ruleDay.AddDate(0, 0, 7))),
+			},
+			expected: "00:00-24:00",
+		},
+		{
+			name: "rules outside their validity are ignored",
+			rules: []schedulemodel.AgendaRuleInterface{
+				closedTuesday,
+				testRule(free, 9, 12, monthlyDay(10), validUntil(ruleDay.AddDate(0, 0, -1))),
+				testRule(free, 13, 15, monthlyDay(10), validFrom(ruleDay.AddDate(0, 0, 1))),
+				testRule(free, 16, 18, monthlyDay(10), validFrom(ruleDay), validUntil(ruleDay)),
+			},
+			expected: "16:00-18:00",
+		},
+		{
+			name: "inactive rules are ignored",
+			rules: []schedulemodel.AgendaRuleInterface{
+				testRule(block, 0, 24, weekly(time.Tuesday), inactive),
+				testRule(block, 12, 13, onDate(ruleDay), inactive),
+			},
+			expected: "00:00-24:00",
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			from, to := ruleDay, ruleDay.Add(24*time.Hour)
+			days := buildInitialAvailability(from, to)
+			if len(days) != 1 {
+				t.Fatalf("buildInitialAvailability returned %d days, expected 1", len(days))
+			}
+			applyRules(days, tt.rules, from, to)
+			if got := formatDayRanges(days[0]); got != tt.expected {
+				t.Fatalf("applyRules() = %q, expected %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestApplyRulesLastWeekday(t *testing.T) {
+	t.Parallel()
+
+	lastTuesday := time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC)
+	from, to := lastTuesday, lastTuesday.Add(24*time.Hour)
+	days := buildInitialAvailability(from, to)
+	applyRules(days, []schedulemodel.AgendaRuleInterface{
+		testRule(schedulemodel.RuleTypeBlock, 12, 13, monthlyWeekday(-1, time.Tuesday)),
+		testRule(schedulemodel.RuleTypeBlock, 9, 10, monthlyWeekday(5, time.Tuesday)),
+	}, from, to)
+
+	if got, expected := formatDayRanges(days[0]), "00:00-09:00 10:00-12:00 13:00-24:00"; got != expected {
+		t.Fatalf("applyRules() on the last Tuesday = %q, expected %q", got, expected)
+	}
+}
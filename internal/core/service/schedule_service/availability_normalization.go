@@ -6,17 +6,13 @@ import (
 
 // normalizeAvailabilityRulesToExclusive converts stored inclusive end minutes into half-open ranges.
 // listing_agenda_rules persists end_minute as the last blocked minute (inclusive). The availability engine
-// works with half-open intervals [start, end), so we bump the end minute by 1 (clamped at 1440) for
-// blocking rules before computing availability.
+// works with half-open intervals [start, end), so we bump the end minute by 1 (clamped at 1440) before
+// computing availability. FREE rules follow the same convention since they reopen blocked minutes.
 func normalizeAvailabilityRulesToExclusive(rules []schedulemodel.AgendaRuleInterface) {
 	for _, rule := range rules {
 		if rule == nil {
 			continue
 		}
-		if rule.RuleType() != schedulemodel.RuleTypeBlock {
-			continue
-		}
-
 		end := rule.EndMinutes()
 		if end < minutesPerDay {
 			end++
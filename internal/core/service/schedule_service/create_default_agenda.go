@@ -102,6 +102,17 @@ func (s *scheduleService) createDefaultAgendaTx(ctx context.Context, tx *sql.Tx,
 		agenda.SetTimezone(strings.TrimSpace(input.Timezone))
 	}
 
+	// The owner's default availability template, when set, replaces the global default block rules.
+	template, err := s.scheduleRepo.GetDefaultTemplateByOwner(ctx, tx, input.OwnerID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		utils.SetSpanError(ctx, err)
+		logger.Error("schedule.create_default_agenda.get_template_error", "owner_id", input.OwnerID, "err", err)
+		return nil, utils.InternalError("")
+	}
+	if template != nil {
+		agenda.SetTemplateID(template.ID())
+	}
+
 	id, err := s.scheduleRepo.InsertAgenda(ctx, tx, agenda)
 	if err != nil {
 		utils.SetSpanError(ctx, err)
@@ -110,7 +121,12 @@ func (s *scheduleService) createDefaultAgendaTx(ctx context.Context, tx *sql.Tx,
 	}
 	agenda.SetID(id)
 
-	rules := s.buildDefaultBlockRules(agenda.ID())
+	var rules []schedulemodel.AgendaRuleInterface
+	if template != nil {
+		rules = cloneTemplateRules(template.Rules(), agenda.ID())
+	} else {
+		rules = s.buildDefaultBlockRules(agenda.ID())
+	}
 	if len(rules) > 0 {
 		if err := s.scheduleRepo.InsertRules(ctx, tx, rules); err != nil {
 			utils.SetSpanError(ctx, err)
@@ -0,0 +1,331 @@
+package scheduleservices
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	schedulemodel "github.com/projeto-toq/toq_server/internal/core/model/schedule_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+	"github.com/projeto-toq/toq_server/internal/core/utils/ics"
+)
+
+const (
+	maxImportCalendarBytes = 1 << 20
+	maxImportOccurrences   = 500
+	importWindow           = 365 * 24 * time.Hour
+	maxBlockReasonLength   = 120
+)
+
+// PreviewBlockImport expands an iCalendar document against the listing agenda without persisting anything.
+func (s *scheduleService) PreviewBlockImport(ctx context.Context, input ImportBlocksInput) (ImportBlocksResult, error) {
+	if err := validateImportBlocksInput(input); err != nil {
+		return ImportBlocksResult{}, err
+	}
+
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return ImportBlocksResult{}, utils.InternalError("")
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	tx, txErr := s.globalService.StartReadOnlyTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("schedule.preview_block_import.tx_start_error", "err", txErr, "listing_identity_id", input.ListingIdentityID)
+		return ImportBlocksResult{}, utils.InternalError("")
+	}
+	defer func() {
+		if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+			utils.SetSpanError(ctx, rbErr)
+			logger.Error("schedule.preview_block_import.tx_rollback_error", "err", rbErr, "listing_identity_id", input.ListingIdentityID)
+		}
+	}()
+
+	result, _, err := s.planBlockImport(ctx, tx, input)
+	return result, err
+}
+
+// ImportBlocks creates one blocking entry per importable occurrence. Conflicting occurrences abort the import
+// unless SkipConflicts is set; the parts of an occurrence already covered by blocks or repeated in the file are
+// always skipped, and only the remaining parts are created.
+func (s *scheduleService) ImportBlocks(ctx context.Context, input ImportBlocksInput) (ImportBlocksResult, error) {
+	if err := validateImportBlocksInput(input); err != nil {
+		return ImportBlocksResult{}, err
+	}
+
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return ImportBlocksResult{}, utils.InternalError("")
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	tx, txErr := s.globalService.StartTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("schedule.import_blocks.tx_start_error", "err", txErr)
+		return ImportBlocksResult{}, utils.InternalError("")
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+				utils.SetSpanError(ctx, rbErr)
+				logger.Error("schedule.import_blocks.tx_rollback_error", "err", rbErr)
+			}
+		}
+	}()
+
+	result, agenda, err := s.planBlockImport(ctx, tx, input)
+	if err != nil {
+		return ImportBlocksResult{}, err
+	}
+	if result.Conflicts > 0 && !input.SkipConflicts {
+		return ImportBlocksResult{}, utils.ConflictError("Imported calendar conflicts with confirmed visits or photo sessions")
+	}
+
+	for i := range result.Items {
+		item := &result.Items[i]
+		if item.Status != ImportItemReady {
+			continue
+		}
+
+		domain := schedulemodel.NewAgendaEntry()
+		domain.SetAgendaID(agenda.ID())
+		domain.SetEntryType(input.EntryType)
+		domain.SetStartsAt(item.StartsAt.UTC())
+		domain.SetEndsAt(item.EndsAt.UTC())
+		domain.SetBlocking(true)
+		if reason := truncateRunes(strings.TrimSpace(item.Summary), maxBlockReasonLength); reason != "" {
+			domain.SetReason(reason)
+		}
+
+		id, insertErr := s.scheduleRepo.InsertEntry(ctx, tx, domain)
+		if insertErr != nil {
+			utils.SetSpanError(ctx, insertErr)
+			logger.Error("schedule.import_blocks.insert_error", "listing_identity_id", input.ListingIdentityID, "uid", item.UID, "err", insertErr)
+			return ImportBlocksResult{}, utils.InternalError("")
+		}
+
+		item.EntryID = id
+		item.Status = ImportItemCreated
+		result.Ready--
+		result.Created++
+	}
+
+	if cmErr := s.globalService.CommitTransaction(ctx, tx); cmErr != nil {
+		utils.SetSpanError(ctx, cmErr)
+		logger.Error("schedule.import_blocks.tx_commit_error", "err", cmErr)
+		return ImportBlocksResult{}, utils.InternalError("")
+	}
+	committed = true
+
+	logger.Info("schedule.import_blocks.completed", "listing_identity_id", input.ListingIdentityID, "created", result.Created, "conflicts", result.Conflicts, "actor_id", input.ActorID)
+
+	return result, nil
+}
+
+func validateImportBlocksInput(input ImportBlocksInput) error {
+	if input.ListingIdentityID <= 0 {
+		return utils.ValidationError("listingIdentityId", "listingIdentityId must be greater than zero")
+	}
+	if input.OwnerID <= 0 {
+		return utils.ValidationError("ownerId", "ownerId must be greater than zero")
+	}
+	if input.ActorID <= 0 {
+		return utils.ValidationError("actorId", "actorId must be greater than zero")
+	}
+	if !isBlockEntryType(input.EntryType) {
+		return utils.ValidationError("entryType", "entry type must be BLOCK or TEMP_BLOCK")
+	}
+	if strings.TrimSpace(input.Calendar) == "" {
+		return utils.ValidationError("calendar", "calendar is required")
+	}
+	if len(input.Calendar) > maxImportCalendarBytes {
+		return utils.ValidationError("calendar", "calendar must not exceed 1 MiB")
+	}
+	return nil
+}
+
+// planBlockImport loads the agenda, expands the calendar in its timezone and classifies every occurrence.
+// Occurrences partly covered by existing blocks or earlier occurrences are split into one item per part.
+func (s *scheduleService) planBlockImport(ctx context.Context, tx *sql.Tx, input ImportBlocksInput) (ImportBlocksResult, schedulemodel.AgendaInterface, error) {
+	logger := utils.LoggerFromContext(ctx)
+
+	agenda, err := s.scheduleRepo.GetAgendaByListingIdentityID(ctx, tx, input.ListingIdentityID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ImportBlocksResult{}, nil, utils.NotFoundError("Agenda")
+		}
+		utils.SetSpanError(ctx, err)
+		logger.Error("schedule.import_blocks.get_agenda_error", "listing_identity_id", input.ListingIdentityID, "err", err)
+		return ImportBlocksResult{}, nil, utils.InternalError("")
+	}
+
+	if agenda.OwnerID() != input.OwnerID {
+		return ImportBlocksResult{}, nil, utils.AuthorizationError("Owner does not match listing agenda")
+	}
+
+	loc, tzErr := utils.ResolveLocation("timezone", agenda.Timezone())
+	if tzErr != nil {
+		return ImportBlocksResult{}, nil, tzErr
+	}
+
+	windowStart := time.Now().In(loc).Truncate(time.Minute)
+	windowEnd := windowStart.Add(importWindow)
+
+	expansion, expErr := expandImportCalendar(input.Calendar, loc, windowStart, windowEnd)
+	if expErr != nil {
+		return ImportBlocksResult{}, nil, expErr
+	}
+
+	result := ImportBlocksResult{
+		ListingIdentityID: input.ListingIdentityID,
+		EntryType:         input.EntryType,
+		Timezone:          loc.String(),
+		WindowStart:       windowStart,
+		WindowEnd:         windowEnd,
+		Items:             make([]ImportBlockItem, 0, len(expansion.Occurrences)),
+		Unsupported:       unsupportedImportEvents(expansion.Unsupported),
+	}
+	if len(expansion.Occurrences) == 0 {
+		return result, agenda, nil
+	}
+
+	spanStart, spanEnd := expansion.Occurrences[0].Start, expansion.Occurrences[0].End
+	for _, occurrence := range expansion.Occurrences {
+		spanEnd = maxTime(spanEnd, occurrence.End)
+	}
+	spanStart = maxTime(spanStart, windowStart)
+
+	existing, err := s.scheduleRepo.ListEntriesBetween(ctx, tx, agenda.ID(), spanStart.UTC(), spanEnd.UTC())
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("schedule.import_blocks.list_entries_error", "listing_identity_id", input.ListingIdentityID, "err", err)
+		return ImportBlocksResult{}, nil, utils.InternalError("")
+	}
+
+	var busyEntries []schedulemodel.AgendaEntryInterface
+	var busy, blocked []ics.Span
+	for _, entry := range existing {
+		span := ics.Span{Start: entry.StartsAt(), End: entry.EndsAt()}
+		switch entry.EntryType() {
+		case schedulemodel.EntryTypeVisitConfirmed, schedulemodel.EntryTypePhotoSession, schedulemodel.EntryTypeOpenHouse:
+			busyEntries = append(busyEntries, entry)
+			busy = append(busy, span)
+		case schedulemodel.EntryTypeBlock, schedulemodel.EntryTypeTemporaryBlock:
+			blocked = append(blocked, span)
+		}
+	}
+
+	planner := ics.NewImportPlanner(busy, blocked)
+	for _, occurrence := range expansion.Occurrences {
+		item := ImportBlockItem{
+			UID:      occurrence.UID,
+			Summary:  occurrence.Summary,
+			StartsAt: maxTime(occurrence.Start, windowStart).In(loc),
+			EndsAt:   occurrence.End.In(loc),
+			AllDay:   occurrence.AllDay,
+		}
+
+		classification := planner.Classify(ics.Span{Start: item.StartsAt, End: item.EndsAt})
+		if len(classification.Conflicts) > 0 {
+			for _, index := range classification.Conflicts {
+				item.Conflicts = append(item.Conflicts, importConflictFromEntry(busyEntries[index], loc))
+			}
+			item.Status = ImportItemConflict
+			result.Conflicts++
+			result.Items = append(result.Items, item)
+			continue
+		}
+
+		for _, segment := range classification.Segments {
+			part := item
+			part.StartsAt = segment.Start.In(loc)
+			part.EndsAt = segment.End.In(loc)
+			part.Partial = len(classification.Segments) > 1
+			part.Status = importItemStatusForCoverage(segment.Coverage)
+			if part.Status == ImportItemReady {
+				result.Ready++
+			}
+			result.Items = append(result.Items, part)
+		}
+	}
+
+	return result, agenda, nil
+}
+
+// expandImportCalendar parses the document and expands its events inside [from, to).
+func expandImportCalendar(content string, loc *time.Location, from, to time.Time) (ics.Expansion, error) {
+	events, err := ics.Parse(strings.NewReader(content), loc)
+	if err != nil {
+		return ics.Expansion{}, utils.ValidationError("calendar", "calendar is not a valid iCalendar document")
+	}
+
+	expansion, err := ics.Expand(events, from, to, maxImportOccurrences)
+	if err != nil {
+		if errors.Is(err, ics.ErrTooManyOccurrences) {
+			return ics.Expansion{}, utils.ValidationError("calendar", "calendar expands to more than 500 occurrences in the next 365 days")
+		}
+		return ics.Expansion{}, utils.ValidationError("calendar", "calendar could not be expanded")
+	}
+
+	return expansion, nil
+}
+
+func unsupportedImportEvents(events []ics.ParsedEvent) []ImportUnsupportedEvent {
+	result := make([]ImportUnsupportedEvent, 0, len(events))
+	for _, event := range events {
+		reason := "unsupported recurrence rule"
+		if event.RecurrenceErr != nil {
+			reason = event.RecurrenceErr.Error()
+		}
+		result = append(result, ImportUnsupportedEvent{UID: event.UID, Summary: event.Summary, Reason: reason})
+	}
+	return result
+}
+
+func importConflictFromEntry(entry schedulemodel.AgendaEntryInterface, loc *time.Location) ImportConflict {
+	conflict := ImportConflict{
+		EntryID:   entry.ID(),
+		EntryType: entry.EntryType(),
+		StartsAt:  entry.StartsAt().In(loc),
+		EndsAt:    entry.EndsAt().In(loc),
+	}
+	if visitID, ok := entry.VisitID(); ok {
+		conflict.VisitID = &visitID
+	}
+	if bookingID, ok := entry.PhotoBookingID(); ok {
+		conflict.PhotoBookingID = &bookingID
+	}
+	return conflict
+}
+
+// importItemStatusForCoverage maps the coverage of an occurrence segment to its import status.
+func importItemStatusForCoverage(coverage ics.Coverage) ImportItemStatus {
+	switch coverage {
+	case ics.CoverageBlocked:
+		return ImportItemAlreadyBlocked
+	case ics.CoverageDuplicate:
+		return ImportItemDuplicate
+	default:
+		return ImportItemReady
+	}
+}
+
+func truncateRunes(value string, limit int) string {
+	runes := []rune(value)
+	if len(runes) <= limit {
+		return value
+	}
+	return string(runes[:limit])
+}
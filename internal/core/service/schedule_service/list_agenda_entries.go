@@ -154,10 +154,12 @@ func composeTimeline(entries []schedulemodel.AgendaEntryInterface, rules []sched
 			if rule == nil || !rule.IsActive() {
 				continue
 			}
-			if rule.RuleType() != schedulemodel.RuleTypeBlock {
+			// Weekly FREE rules are informative only; monthly and date FREE rules are shown as exceptions.
+			blocking := rule.RuleType() == schedulemodel.RuleTypeBlock
+			if !blocking && ruleLevel(rule) == ruleLevelWeekly {
 				continue
 			}
-			if day.Weekday() != rule.DayOfWeek() {
+			if !ruleAppliesOn(rule, day) {
 				continue
 			}
 			window := buildRuleWindow(day, RuleTimeRange{StartMinute: rule.StartMinutes(), EndMinute: rule.EndMinutes()}, loc)
@@ -168,8 +170,8 @@ func composeTimeline(entries []schedulemodel.AgendaEntryInterface, rules []sched
 					StartsAt:  clamped.start,
 					EndsAt:    clamped.end,
 					Weekday:   day.Weekday(),
-					Recurring: true,
-					Blocking:  true,
+					Recurring: rule.Recurrence() != schedulemodel.RuleRecurrenceDate,
+					Blocking:  blocking,
 				})
 			}
 		}
@@ -67,10 +67,13 @@ func (s *scheduleService) ListBlockRules(ctx context.Context, filter schedulemod
 		return schedulemodel.RuleListResult{}, utils.InternalError("")
 	}
 
+	templateID, overridden := templateLink(agenda)
 	return schedulemodel.RuleListResult{
-		ListingIdentityID: filter.ListingIdentityID,
-		Timezone:          agenda.Timezone(),
-		Rules:             rules,
+		ListingIdentityID:  filter.ListingIdentityID,
+		Timezone:           agenda.Timezone(),
+		Rules:              rules,
+		TemplateID:         templateID,
+		TemplateOverridden: overridden,
 	}, nil
 }
 
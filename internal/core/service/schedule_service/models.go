@@ -20,12 +20,29 @@ type RuleTimeRange struct {
 	EndMinute   uint16
 }
 
+// RuleSchedule carries the recurrence, effect and validity of a rule. The zero value is a weekly BLOCK rule
+// valid forever, which is what the weekly-only API created.
+type RuleSchedule struct {
+	Recurrence schedulemodel.RuleRecurrence
+	RuleType   schedulemodel.RuleType
+	// DayOfMonth is required for MONTHLY_DAY rules.
+	DayOfMonth uint8
+	// WeekOfMonth (1-5, or -1 for the last one) is required for MONTHLY_WEEKDAY rules.
+	WeekOfMonth int8
+	// Date is required for DATE rules; only its calendar day is used.
+	Date *time.Time
+	// ValidFrom and ValidUntil optionally bound recurring rules to a range of agenda-local days (inclusive).
+	ValidFrom  *time.Time
+	ValidUntil *time.Time
+}
+
 // CreateRuleInput captures data required to persist new recurring rules.
 type CreateRuleInput struct {
 	ListingIdentityID int64
 	OwnerID           int64
 	Weekdays          []time.Weekday
 	Range             RuleTimeRange
+	Schedule          RuleSchedule
 	Active            bool
 	Timezone          string
 	ActorID           int64
@@ -38,6 +55,7 @@ type UpdateRuleInput struct {
 	OwnerID           int64
 	Weekday           time.Weekday
 	Range             RuleTimeRange
+	Schedule          RuleSchedule
 	Active            bool
 	Timezone          string
 	ActorID           int64
@@ -51,6 +69,51 @@ type DeleteRuleInput struct {
 	ActorID           int64
 }
 
+// TemplateRuleInput describes one rule of an availability template; weekday-based recurrences expand to one rule per weekday.
+type TemplateRuleInput struct {
+	Weekdays []time.Weekday
+	Range    RuleTimeRange
+	Schedule RuleSchedule
+	Active   bool
+}
+
+// SaveTemplateInput carries the data to create (TemplateID zero) or replace an availability template.
+type SaveTemplateInput struct {
+	TemplateID uint64
+	OwnerID    int64
+	Name       string
+	IsDefault  bool
+	Rules      []TemplateRuleInput
+}
+
+// DeleteTemplateInput identifies the availability template to remove.
+type DeleteTemplateInput struct {
+	TemplateID uint64
+	OwnerID    int64
+}
+
+// ApplyTemplateInput lists the listings whose agendas must adopt the template rules.
+type ApplyTemplateInput struct {
+	TemplateID         uint64
+	OwnerID            int64
+	ListingIdentityIDs []int64
+}
+
+// TemplateListItem pairs a template with the agendas currently linked to it.
+type TemplateListItem struct {
+	Template      schedulemodel.AvailabilityTemplateInterface
+	LinkedAgendas []schedulemodel.AgendaInterface
+}
+
+// TemplateMutationResult reports a saved template and how its rules reached the linked agendas.
+type TemplateMutationResult struct {
+	Template schedulemodel.AvailabilityTemplateInterface
+	// SyncedListingIdentityIDs lists agendas whose rules were replaced with the template rules.
+	SyncedListingIdentityIDs []int64
+	// OverriddenListingIdentityIDs lists linked agendas left untouched because their rules were edited locally.
+	OverriddenListingIdentityIDs []int64
+}
+
 // CreateBlockEntryInput captures the information to create a blocking entry.
 type CreateBlockEntryInput struct {
 	ListingIdentityID int64
@@ -109,3 +172,75 @@ type FinishListingAgendaInput struct {
 	OwnerID           int64
 	ActorID           int64
 }
+
+// ImportItemStatus classifies an occurrence of an imported calendar.
+type ImportItemStatus string
+
+const (
+	// ImportItemReady means the occurrence can be created as a block.
+	ImportItemReady ImportItemStatus = "READY"
+	// ImportItemConflict means the occurrence overlaps a confirmed visit, a photo session or an open house.
+	ImportItemConflict ImportItemStatus = "CONFLICT"
+	// ImportItemAlreadyBlocked means the period (or this part of the occurrence) is already covered by an existing block.
+	ImportItemAlreadyBlocked ImportItemStatus = "ALREADY_BLOCKED"
+	// ImportItemDuplicate means the period (or this part of the occurrence) is covered by an earlier occurrence of the same import.
+	ImportItemDuplicate ImportItemStatus = "DUPLICATE"
+	// ImportItemCreated means the block was persisted by the import.
+	ImportItemCreated ImportItemStatus = "CREATED"
+)
+
+// ImportBlocksInput carries an iCalendar document to be converted into blocking entries.
+type ImportBlocksInput struct {
+	ListingIdentityID int64
+	OwnerID           int64
+	ActorID           int64
+	EntryType         schedulemodel.EntryType
+	Calendar          string
+	// SkipConflicts imports the remaining occurrences instead of failing when some conflict.
+	SkipConflicts bool
+}
+
+// ImportConflict describes an existing entry that prevents an occurrence from being imported.
+type ImportConflict struct {
+	EntryID        uint64
+	EntryType      schedulemodel.EntryType
+	StartsAt       time.Time
+	EndsAt         time.Time
+	VisitID        *uint64
+	PhotoBookingID *uint64
+}
+
+// ImportBlockItem is one expanded occurrence of the imported calendar, or one part of it.
+type ImportBlockItem struct {
+	UID      string
+	Summary  string
+	StartsAt time.Time
+	EndsAt   time.Time
+	AllDay   bool
+	// Partial marks a part of an occurrence that was split because it is only partly covered.
+	Partial   bool
+	Status    ImportItemStatus
+	EntryID   uint64
+	Conflicts []ImportConflict
+}
+
+// ImportUnsupportedEvent is a calendar event left out of the import.
+type ImportUnsupportedEvent struct {
+	UID     string
+	Summary string
+	Reason  string
+}
+
+// ImportBlocksResult is the preview or outcome of a calendar import. Times are in the agenda timezone.
+type ImportBlocksResult struct {
+	ListingIdentityID int64
+	EntryType         schedulemodel.EntryType
+	Timezone          string
+	WindowStart       time.Time
+	WindowEnd         time.Time
+	Items             []ImportBlockItem
+	Unsupported       []ImportUnsupportedEvent
+	Ready             int
+	Conflicts         int
+	Created           int
+}
@@ -0,0 +1,200 @@
+package scheduleservices
+
+import (
+	"sort"
+	"time"
+
+	schedulemodel "github.com/projeto-toq/toq_server/internal/core/model/schedule_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// Rule precedence levels, evaluated in ascending order: a more specific recurrence overrides a broader one.
+const (
+	ruleLevelWeekly = iota
+	ruleLevelMonthly
+	ruleLevelDate
+)
+
+var ruleLevels = []int{ruleLevelWeekly, ruleLevelMonthly, ruleLevelDate}
+
+const maxWeekOfMonth = 5
+
+func ruleLevel(rule schedulemodel.AgendaRuleInterface) int {
+	switch rule.Recurrence() {
+	case schedulemodel.RuleRecurrenceMonthlyDay, schedulemodel.RuleRecurrenceMonthlyWeekday:
+		return ruleLevelMonthly
+	case schedulemodel.RuleRecurrenceDate:
+		return ruleLevelDate
+	default:
+		return ruleLevelWeekly
+	}
+}
+
+// normalizeRuleSchedule fills defaults and validates the recurrence-specific fields of a rule request.
+func normalizeRuleSchedule(schedule RuleSchedule) (RuleSchedule, *utils.HTTPError) {
+	if schedule.Recurrence == "" {
+		schedule.Recurrence = schedulemodel.RuleRecurrenceWeekly
+	}
+	if schedule.RuleType == "" {
+		schedule.RuleType = schedulemodel.RuleTypeBlock
+	}
+
+	switch schedule.RuleType {
+	case schedulemodel.RuleTypeBlock, schedulemodel.RuleTypeFree:
+	default:
+		return RuleSchedule{}, utils.ValidationError("ruleType", "ruleType must be BLOCK or FREE")
+	}
+
+	switch schedule.Recurrence {
+	case schedulemodel.RuleRecurrenceWeekly:
+		if schedule.RuleType == schedulemodel.RuleTypeFree {
+			return RuleSchedule{}, utils.ValidationError("ruleType", "FREE rules must use MONTHLY_DAY, MONTHLY_WEEKDAY or DATE recurrence")
+		}
+	case schedulemodel.RuleRecurrenceMonthlyDay:
+		if schedule.DayOfMonth < 1 || schedule.DayOfMonth > 31 {
+			return RuleSchedule{}, utils.ValidationError("dayOfMonth", "dayOfMonth must be between 1 and 31")
+		}
+	case schedulemodel.RuleRecurrenceMonthlyWeekday:
+		if schedule.WeekOfMonth == 0 || schedule.WeekOfMonth < -1 || schedule.WeekOfMonth > maxWeekOfMonth {
+			return RuleSchedule{}, utils.ValidationError("weekOfMonth", "weekOfMonth must be between 1 and 5, or -1 for the last week")
+		}
+	case schedulemodel.RuleRecurrenceDate:
+		if schedule.Date == nil || schedule.Date.IsZero() {
+			return RuleSchedule{}, utils.ValidationError("date", "date is required for DATE rules")
+		}
+		if schedule.ValidFrom != nil || schedule.ValidUntil != nil {
+			return RuleSchedule{}, utils.ValidationError("validFrom", "validFrom and validUntil do not apply to DATE rules")
+		}
+	default:
+		return RuleSchedule{}, utils.ValidationError("recurrence", "recurrence must be WEEKLY, MONTHLY_DAY, MONTHLY_WEEKDAY or DATE")
+	}
+
+	if schedule.ValidFrom != nil && schedule.ValidUntil != nil && civilDay(*schedule.ValidUntil).Before(civilDay(*schedule.ValidFrom)) {
+		return RuleSchedule{}, utils.ValidationError("validUntil", "validUntil must be on or after validFrom")
+	}
+
+	return schedule, nil
+}
+
+// usesWeekday reports whether rules of this recurrence are selected by weekday.
+func usesWeekday(recurrence schedulemodel.RuleRecurrence) bool {
+	return recurrence == schedulemodel.RuleRecurrenceWeekly || recurrence == schedulemodel.RuleRecurrenceMonthlyWeekday
+}
+
+// applyRuleSchedule copies the schedule into the rule, clearing fields that do not apply to its recurrence.
+func applyRuleSchedule(rule schedulemodel.AgendaRuleInterface, weekday time.Weekday, schedule RuleSchedule) {
+	rule.SetRecurrence(schedule.Recurrence)
+	rule.SetRuleType(schedule.RuleType)
+	rule.SetDayOfWeek(weekday)
+	rule.SetDayOfMonth(0)
+	rule.SetWeekOfMonth(0)
+	rule.ClearRuleDate()
+	rule.ClearValidFrom()
+	rule.ClearValidUntil()
+
+	switch schedule.Recurrence {
+	case schedulemodel.RuleRecurrenceMonthlyDay:
+		rule.SetDayOfWeek(time.Sunday)
+		rule.SetDayOfMonth(schedule.DayOfMonth)
+	case schedulemodel.RuleRecurrenceMonthlyWeekday:
+		rule.SetWeekOfMonth(schedule.WeekOfMonth)
+	case schedulemodel.RuleRecurrenceDate:
+		date := civilDay(*schedule.Date)
+		rule.SetDayOfWeek(date.Weekday())
+		rule.SetRuleDate(date)
+	}
+
+	if schedule.ValidFrom != nil {
+		rule.SetValidFrom(civilDay(*schedule.ValidFrom))
+	}
+	if schedule.ValidUntil != nil {
+		rule.SetValidUntil(civilDay(*schedule.ValidUntil))
+	}
+}
+
+// ruleAppliesOn reports whether the rule targets the agenda-local day starting at dayStart.
+func ruleAppliesOn(rule schedulemodel.AgendaRuleInterface, dayStart time.Time) bool {
+	day := civilDay(dayStart)
+	if from, ok := rule.ValidFrom(); ok && day.Before(civilDay(from)) {
+		return false
+	}
+	if until, ok := rule.ValidUntil(); ok && day.After(civilDay(until)) {
+		return false
+	}
+
+	switch rule.Recurrence() {
+	case schedulemodel.RuleRecurrenceMonthlyDay:
+		return day.Day() == int(rule.DayOfMonth())
+	case schedulemodel.RuleRecurrenceMonthlyWeekday:
+		if day.Weekday() != rule.DayOfWeek() {
+			return false
+		}
+		if rule.WeekOfMonth() < 0 {
+			return day.AddDate(0, 0, 7).Month() != day.Month()
+		}
+		return (day.Day()-1)/7+1 == int(rule.WeekOfMonth())
+	case schedulemodel.RuleRecurrenceDate:
+		date, ok := rule.RuleDate()
+		return ok && civilDay(date).Equal(day)
+	default:
+		return day.Weekday() == rule.DayOfWeek()
+	}
+}
+
+// sameRuleSelector reports whether two rules target the same days at the same precedence level, ignoring validity.
+func sameRuleSelector(a, b schedulemodel.AgendaRuleInterface) bool {
+	if a.Recurrence() != b.Recurrence() {
+		return false
+	}
+	switch a.Recurrence() {
+	case schedulemodel.RuleRecurrenceMonthlyDay:
+		return a.DayOfMonth() == b.DayOfMonth()
+	case schedulemodel.RuleRecurrenceMonthlyWeekday:
+		return a.DayOfWeek() == b.DayOfWeek() && a.WeekOfMonth() == b.WeekOfMonth()
+	case schedulemodel.RuleRecurrenceDate:
+		aDate, aOK := a.RuleDate()
+		bDate, bOK := b.RuleDate()
+		return aOK && bOK && civilDay(aDate).Equal(civilDay(bDate))
+	default:
+		return a.DayOfWeek() == b.DayOfWeek()
+	}
+}
+
+// validityOverlaps reports whether the validity periods of two rules share at least one day.
+func validityOverlaps(a, b schedulemodel.AgendaRuleInterface) bool {
+	if aFrom, ok := a.ValidFrom(); ok {
+		if bUntil, ok := b.ValidUntil(); ok && civilDay(bUntil).Before(civilDay(aFrom)) {
+			return false
+		}
+	}
+	if bFrom, ok := b.ValidFrom(); ok {
+		if aUntil, ok := a.ValidUntil(); ok && civilDay(aUntil).Before(civilDay(bFrom)) {
+			return false
+		}
+	}
+	return true
+}
+
+// civilDay keeps only the calendar day of value, as a UTC midnight, so dates compare regardless of location.
+func civilDay(value time.Time) time.Time {
+	return time.Date(value.Year(), value.Month(), value.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// addRange merges addition into base, keeping the result sorted and without overlaps.
+func addRange(base []timeRange, addition timeRange) []timeRange {
+	if !addition.isValid() {
+		return base
+	}
+	merged := append(append(make([]timeRange, 0, len(base)+1), base...), addition)
+	sort.Slice(merged, func(i, j int) bool { return merged[i].start.Before(merged[j].start) })
+
+	result := make([]timeRange, 0, len(merged))
+	for _, r := range merged {
+		if n := len(result); n > 0 && !r.start.After(result[n-1].end) {
+			result[n-1].end = maxTime(result[n-1].end, r.end)
+			continue
+		}
+		result = append(result, r)
+	}
+	return result
+}
@@ -20,8 +20,18 @@ func (s *scheduleService) CreateRules(ctx context.Context, input CreateRuleInput
 	if input.ActorID <= 0 {
 		return RuleMutationResult{}, utils.ValidationError("actorId", "actorId must be greater than zero")
 	}
-	if len(input.Weekdays) == 0 {
-		return RuleMutationResult{}, utils.ValidationError("weekDays", "weekDays must contain at least one value")
+	schedule, scheduleErr := normalizeRuleSchedule(input.Schedule)
+	if scheduleErr != nil {
+		return RuleMutationResult{}, scheduleErr
+	}
+	weekdays := input.Weekdays
+	if usesWeekday(schedule.Recurrence) {
+		if len(weekdays) == 0 {
+			return RuleMutationResult{}, utils.ValidationError("weekDays", "weekDays must contain at least one value")
+		}
+	} else {
+		// Monthly-by-day and date rules are not tied to a weekday: one rule is created regardless of weekDays.
+		weekdays = []time.Weekday{time.Sunday}
 	}
 	if rngErr := validateRuleRangeMinutes(input.Range); rngErr != nil {
 		return RuleMutationResult{}, rngErr
@@ -74,21 +84,17 @@ func (s *scheduleService) CreateRules(ctx context.Context, input CreateRuleInput
 		return RuleMutationResult{}, utils.InternalError("")
 	}
 
-	for _, weekday := range input.Weekdays {
-		if ruleConflict(existingRules, weekday, input.Range, 0) {
-			return RuleMutationResult{}, utils.ConflictError("Rule overlaps with an existing rule for the same weekday")
-		}
-	}
-
-	newRules := make([]schedulemodel.AgendaRuleInterface, 0, len(input.Weekdays))
-	for _, weekday := range input.Weekdays {
+	newRules := make([]schedulemodel.AgendaRuleInterface, 0, len(weekdays))
+	for _, weekday := range weekdays {
 		rule := schedulemodel.NewAgendaRule()
 		rule.SetAgendaID(agenda.ID())
-		rule.SetDayOfWeek(weekday)
+		applyRuleSchedule(rule, weekday, schedule)
 		rule.SetStartMinutes(input.Range.StartMinute)
 		rule.SetEndMinutes(input.Range.EndMinute)
-		rule.SetRuleType(schedulemodel.RuleTypeBlock)
 		rule.SetActive(input.Active)
+		if ruleConflict(existingRules, rule, 0) {
+			return RuleMutationResult{}, utils.ConflictError("Rule overlaps with an existing rule for the same days")
+		}
 		newRules = append(newRules, rule)
 	}
 
@@ -98,6 +104,10 @@ func (s *scheduleService) CreateRules(ctx context.Context, input CreateRuleInput
 		return RuleMutationResult{}, utils.InternalError("")
 	}
 
+	if err := s.markTemplateOverridden(ctx, tx, agenda); err != nil {
+		return RuleMutationResult{}, err
+	}
+
 	if cmErr := s.globalService.CommitTransaction(ctx, tx); cmErr != nil {
 		utils.SetSpanError(ctx, cmErr)
 		logger.Error("schedule.rules.create.tx_commit_error", "err", cmErr, "listing_identity_id", input.ListingIdentityID)
@@ -122,6 +132,10 @@ func (s *scheduleService) UpdateRule(ctx context.Context, input UpdateRuleInput)
 	if input.ActorID <= 0 {
 		return nil, utils.ValidationError("actorId", "actorId must be greater than zero")
 	}
+	schedule, scheduleErr := normalizeRuleSchedule(input.Schedule)
+	if scheduleErr != nil {
+		return nil, scheduleErr
+	}
 	if rngErr := validateRuleRangeMinutes(input.Range); rngErr != nil {
 		return nil, rngErr
 	}
@@ -187,16 +201,15 @@ func (s *scheduleService) UpdateRule(ctx context.Context, input UpdateRuleInput)
 		return nil, utils.InternalError("")
 	}
 
-	if ruleConflict(existingRules, input.Weekday, input.Range, input.RuleID) {
-		return nil, utils.ConflictError("Rule overlaps with an existing rule for the same weekday")
-	}
-
-	rule.SetDayOfWeek(input.Weekday)
+	applyRuleSchedule(rule, input.Weekday, schedule)
 	rule.SetStartMinutes(input.Range.StartMinute)
 	rule.SetEndMinutes(input.Range.EndMinute)
-	rule.SetRuleType(schedulemodel.RuleTypeBlock)
 	rule.SetActive(input.Active)
 
+	if ruleConflict(existingRules, rule, input.RuleID) {
+		return nil, utils.ConflictError("Rule overlaps with an existing rule for the same days")
+	}
+
 	if err := s.scheduleRepo.UpdateRule(ctx, tx, rule); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, utils.NotFoundError("Agenda rule")
@@ -206,6 +219,10 @@ func (s *scheduleService) UpdateRule(ctx context.Context, input UpdateRuleInput)
 		return nil, utils.InternalError("")
 	}
 
+	if err := s.markTemplateOverridden(ctx, tx, agenda); err != nil {
+		return nil, err
+	}
+
 	if cmErr := s.globalService.CommitTransaction(ctx, tx); cmErr != nil {
 		utils.SetSpanError(ctx, cmErr)
 		logger.Error("schedule.rules.update.tx_commit_error", "err", cmErr, "rule_id", input.RuleID)
@@ -294,6 +311,10 @@ func (s *scheduleService) DeleteRule(ctx context.Context, input DeleteRuleInput)
 		return utils.InternalError("")
 	}
 
+	if err := s.markTemplateOverridden(ctx, tx, agenda); err != nil {
+		return err
+	}
+
 	if cmErr := s.globalService.CommitTransaction(ctx, tx); cmErr != nil {
 		utils.SetSpanError(ctx, cmErr)
 		logger.Error("schedule.rules.delete.tx_commit_error", "err", cmErr, "rule_id", input.RuleID)
@@ -355,7 +376,14 @@ func (s *scheduleService) ListRules(ctx context.Context, listingIdentityID, owne
 		return schedulemodel.RuleListResult{}, utils.InternalError("")
 	}
 
-	return schedulemodel.RuleListResult{ListingIdentityID: listingIdentityID, Timezone: agenda.Timezone(), Rules: rules}, nil
+	templateID, overridden := templateLink(agenda)
+	return schedulemodel.RuleListResult{
+		ListingIdentityID:  listingIdentityID,
+		Timezone:           agenda.Timezone(),
+		Rules:              rules,
+		TemplateID:         templateID,
+		TemplateOverridden: overridden,
+	}, nil
 }
 
 func validateRuleRangeMinutes(rng RuleTimeRange) *utils.HTTPError {
@@ -371,7 +399,10 @@ func validateRuleRangeMinutes(rng RuleTimeRange) *utils.HTTPError {
 	return nil
 }
 
-func ruleConflict(existing []schedulemodel.AgendaRuleInterface, weekday time.Weekday, rng RuleTimeRange, ignoreID uint64) bool {
+// ruleConflict reports whether candidate overlaps an active rule targeting the same days at the same precedence
+// level (same recurrence and selector, overlapping validity). Rules at different levels are expected to overlap:
+// that is how exceptions override broader rules.
+func ruleConflict(existing []schedulemodel.AgendaRuleInterface, candidate schedulemodel.AgendaRuleInterface, ignoreID uint64) bool {
 	for _, rule := range existing {
 		if rule == nil {
 			continue
@@ -379,13 +410,13 @@ func ruleConflict(existing []schedulemodel.AgendaRuleInterface, weekday time.Wee
 		if !rule.IsActive() {
 			continue
 		}
-		if rule.DayOfWeek() != weekday {
+		if ignoreID != 0 && rule.ID() == ignoreID {
 			continue
 		}
-		if rule.ID() == ignoreID {
+		if !sameRuleSelector(rule, candidate) || !validityOverlaps(rule, candidate) {
 			continue
 		}
-		if intervalsOverlapMinutes(rule.StartMinutes(), rule.EndMinutes(), rng.StartMinute, rng.EndMinute) {
+		if intervalsOverlapMinutes(rule.StartMinutes(), rule.EndMinutes(), candidate.StartMinutes(), candidate.EndMinutes()) {
 			return true
 		}
 	}
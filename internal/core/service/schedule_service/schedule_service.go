@@ -22,11 +22,18 @@ type ScheduleServiceInterface interface {
 	UpdateRule(ctx context.Context, input UpdateRuleInput) (schedulemodel.AgendaRuleInterface, error)
 	DeleteRule(ctx context.Context, input DeleteRuleInput) error
 	ListRules(ctx context.Context, listingIdentityID, ownerID int64) (schedulemodel.RuleListResult, error)
+	ListTemplates(ctx context.Context, ownerID int64) ([]TemplateListItem, error)
+	CreateTemplate(ctx context.Context, input SaveTemplateInput) (TemplateMutationResult, error)
+	UpdateTemplate(ctx context.Context, input SaveTemplateInput) (TemplateMutationResult, error)
+	DeleteTemplate(ctx context.Context, input DeleteTemplateInput) error
+	ApplyTemplate(ctx context.Context, input ApplyTemplateInput) (TemplateMutationResult, error)
 	ListOwnerSummary(ctx context.Context, filter schedulemodel.OwnerSummaryFilter) (schedulemodel.OwnerSummaryResult, error)
 	ListAgendaEntries(ctx context.Context, filter schedulemodel.AgendaDetailFilter) (schedulemodel.AgendaDetailResult, error)
 	CreateBlockEntry(ctx context.Context, input CreateBlockEntryInput) (schedulemodel.AgendaEntryInterface, error)
 	UpdateBlockEntry(ctx context.Context, input UpdateBlockEntryInput) (schedulemodel.AgendaEntryInterface, error)
 	DeleteBlockEntry(ctx context.Context, input DeleteEntryInput) error
+	PreviewBlockImport(ctx context.Context, input ImportBlocksInput) (ImportBlocksResult, error)
+	ImportBlocks(ctx context.Context, input ImportBlocksInput) (ImportBlocksResult, error)
 	GetAvailability(ctx context.Context, filter schedulemodel.AvailabilityFilter) (AvailabilityResult, error)
 	FinishListingAgenda(ctx context.Context, input FinishListingAgendaInput) error
 	CreateVisitEntry(ctx context.Context, agendaID uint64, visitID uint64, start, end time.Time, pending bool) (schedulemodel.AgendaEntryInterface, error)
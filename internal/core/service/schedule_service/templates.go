@@ -0,0 +1,578 @@
+package scheduleservices
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	schedulemodel "github.com/projeto-toq/toq_server/internal/core/model/schedule_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+const (
+	maxTemplateNameLength = 80
+	maxTemplateRules      = 200
+	maxTemplateListings   = 100
+)
+
+// ListTemplates returns the owner's availability templates with the agendas linked to each one.
+func (s *scheduleService) ListTemplates(ctx context.Context, ownerID int64) ([]TemplateListItem, error) {
+	if ownerID <= 0 {
+		return nil, utils.ValidationError("ownerId", "ownerId must be greater than zero")
+	}
+
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, utils.InternalError("")
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	tx, txErr := s.globalService.StartReadOnlyTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("schedule.templates.list.tx_start_error", "err", txErr, "owner_id", ownerID)
+		return nil, utils.InternalError("")
+	}
+	defer func() {
+		if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+			utils.SetSpanError(ctx, rbErr)
+			logger.Error("schedule.templates.list.tx_rollback_error", "err", rbErr, "owner_id", ownerID)
+		}
+	}()
+
+	templates, err := s.scheduleRepo.ListTemplatesByOwner(ctx, tx, ownerID)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("schedule.templates.list.repo_error", "err", err, "owner_id", ownerID)
+		return nil, utils.InternalError("")
+	}
+
+	items := make([]TemplateListItem, 0, len(templates))
+	for _, template := range templates {
+		agendas, listErr := s.scheduleRepo.ListAgendasByTemplate(ctx, tx, template.ID())
+		if listErr != nil {
+			utils.SetSpanError(ctx, listErr)
+			logger.Error("schedule.templates.list.agendas_error", "err", listErr, "template_id", template.ID())
+			return nil, utils.InternalError("")
+		}
+		items = append(items, TemplateListItem{Template: template, LinkedAgendas: agendas})
+	}
+
+	return items, nil
+}
+
+// CreateTemplate stores a new availability template. Marking it as default unmarks the owner's previous default.
+func (s *scheduleService) CreateTemplate(ctx context.Context, input SaveTemplateInput) (TemplateMutationResult, error) {
+	name, rules, validationErr := validateSaveTemplateInput(input)
+	if validationErr != nil {
+		return TemplateMutationResult{}, validationErr
+	}
+
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return TemplateMutationResult{}, utils.InternalError("")
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	tx, txErr := s.globalService.StartTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("schedule.templates.create.tx_start_error", "err", txErr, "owner_id", input.OwnerID)
+		return TemplateMutationResult{}, utils.InternalError("")
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+				utils.SetSpanError(ctx, rbErr)
+				logger.Error("schedule.templates.create.tx_rollback_error", "err", rbErr, "owner_id", input.OwnerID)
+			}
+		}
+	}()
+
+	if err := s.ensureTemplateNameAvailable(ctx, tx, input.OwnerID, name, 0); err != nil {
+		return TemplateMutationResult{}, err
+	}
+
+	now := time.Now().UTC()
+	template := schedulemodel.NewAvailabilityTemplate()
+	template.SetOwnerID(input.OwnerID)
+	template.SetName(name)
+	template.SetDefault(input.IsDefault)
+	template.SetCreatedAt(now)
+	template.SetUpdatedAt(now)
+
+	if _, err := s.scheduleRepo.InsertTemplate(ctx, tx, template); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("schedule.templates.create.insert_error", "err", err, "owner_id", input.OwnerID)
+		return TemplateMutationResult{}, utils.InternalError("")
+	}
+
+	if err := s.saveTemplateRules(ctx, tx, template, rules); err != nil {
+		return TemplateMutationResult{}, err
+	}
+
+	if cmErr := s.globalService.CommitTransaction(ctx, tx); cmErr != nil {
+		utils.SetSpanError(ctx, cmErr)
+		logger.Error("schedule.templates.create.tx_commit_error", "err", cmErr, "template_id", template.ID())
+		return TemplateMutationResult{}, utils.InternalError("")
+	}
+
+	committed = true
+
+	return TemplateMutationResult{Template: template}, nil
+}
+
+// UpdateTemplate replaces the name, default flag and rules of a template, then copies the new rules to every
+// linked agenda that was not overridden locally.
+func (s *scheduleService) UpdateTemplate(ctx context.Context, input SaveTemplateInput) (TemplateMutationResult, error) {
+	if input.TemplateID == 0 {
+		return TemplateMutationResult{}, utils.ValidationError("templateId", "templateId must be greater than zero")
+	}
+	name, rules, validationErr := validateSaveTemplateInput(input)
+	if validationErr != nil {
+		return TemplateMutationResult{}, validationErr
+	}
+
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return TemplateMutationResult{}, utils.InternalError("")
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	tx, txErr := s.globalService.StartTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("schedule.templates.update.tx_start_error", "err", txErr, "template_id", input.TemplateID)
+		return TemplateMutationResult{}, utils.InternalError("")
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+				utils.SetSpanError(ctx, rbErr)
+				logger.Error("schedule.templates.update.tx_rollback_error", "err", rbErr, "template_id", input.TemplateID)
+			}
+		}
+	}()
+
+	template, err := s.getOwnedTemplate(ctx, tx, input.TemplateID, input.OwnerID)
+	if err != nil {
+		return TemplateMutationResult{}, err
+	}
+
+	if err := s.ensureTemplateNameAvailable(ctx, tx, input.OwnerID, name, template.ID()); err != nil {
+		return TemplateMutationResult{}, err
+	}
+
+	template.SetName(name)
+	template.SetDefault(input.IsDefault)
+	template.SetUpdatedAt(time.Now().UTC())
+
+	if err := s.scheduleRepo.UpdateTemplate(ctx, tx, template); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return TemplateMutationResult{}, utils.NotFoundError("Availability template")
+		}
+		utils.SetSpanError(ctx, err)
+		logger.Error("schedule.templates.update.exec_error", "err", err, "template_id", input.TemplateID)
+		return TemplateMutationResult{}, utils.InternalError("")
+	}
+
+	if err := s.saveTemplateRules(ctx, tx, template, rules); err != nil {
+		return TemplateMutationResult{}, err
+	}
+
+	agendas, err := s.scheduleRepo.ListAgendasByTemplate(ctx, tx, template.ID())
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("schedule.templates.update.list_agendas_error", "err", err, "template_id", input.TemplateID)
+		return TemplateMutationResult{}, utils.InternalError("")
+	}
+
+	result := TemplateMutationResult{Template: template}
+	for _, agenda := range agendas {
+		if agenda.TemplateOverridden() {
+			result.OverriddenListingIdentityIDs = append(result.OverriddenListingIdentityIDs, agenda.ListingIdentityID())
+			continue
+		}
+		if err := s.syncAgendaWithTemplate(ctx, tx, agenda, template); err != nil {
+			return TemplateMutationResult{}, err
+		}
+		result.SyncedListingIdentityIDs = append(result.SyncedListingIdentityIDs, agenda.ListingIdentityID())
+	}
+
+	if cmErr := s.globalService.CommitTransaction(ctx, tx); cmErr != nil {
+		utils.SetSpanError(ctx, cmErr)
+		logger.Error("schedule.templates.update.tx_commit_error", "err", cmErr, "template_id", input.TemplateID)
+		return TemplateMutationResult{}, utils.InternalError("")
+	}
+
+	committed = true
+
+	logger.Info("schedule.templates.update.propagated", "template_id", input.TemplateID,
+		"synced", len(result.SyncedListingIdentityIDs), "overridden", len(result.OverriddenListingIdentityIDs))
+
+	return result, nil
+}
+
+// DeleteTemplate removes a template. Linked agendas keep their current rules and are simply unlinked.
+func (s *scheduleService) DeleteTemplate(ctx context.Context, input DeleteTemplateInput) error {
+	if input.TemplateID == 0 {
+		return utils.ValidationError("templateId", "templateId must be greater than zero")
+	}
+	if input.OwnerID <= 0 {
+		return utils.ValidationError("ownerId", "ownerId must be greater than zero")
+	}
+
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return utils.InternalError("")
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	tx, txErr := s.globalService.StartTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("schedule.templates.delete.tx_start_error", "err", txErr, "template_id", input.TemplateID)
+		return utils.InternalError("")
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+				utils.SetSpanError(ctx, rbErr)
+				logger.Error("schedule.templates.delete.tx_rollback_error", "err", rbErr, "template_id", input.TemplateID)
+			}
+		}
+	}()
+
+	if _, err := s.getOwnedTemplate(ctx, tx, input.TemplateID, input.OwnerID); err != nil {
+		return err
+	}
+
+	if err := s.scheduleRepo.DeleteTemplate(ctx, tx, input.TemplateID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return utils.NotFoundError("Availability template")
+		}
+		utils.SetSpanError(ctx, err)
+		logger.Error("schedule.templates.delete.exec_error", "err", err, "template_id", input.TemplateID)
+		return utils.InternalError("")
+	}
+
+	if cmErr := s.globalService.CommitTransaction(ctx, tx); cmErr != nil {
+		utils.SetSpanError(ctx, cmErr)
+		logger.Error("schedule.templates.delete.tx_commit_error", "err", cmErr, "template_id", input.TemplateID)
+		return utils.InternalError("")
+	}
+
+	committed = true
+	return nil
+}
+
+// ApplyTemplate replaces the rules of each listed agenda with the template rules and links the agendas to it,
+// clearing any previous local override. Either every listing is updated or none is.
+func (s *scheduleService) ApplyTemplate(ctx context.Context, input ApplyTemplateInput) (TemplateMutationResult, error) {
+	if input.TemplateID == 0 {
+		return TemplateMutationResult{}, utils.ValidationError("templateId", "templateId must be greater than zero")
+	}
+	if input.OwnerID <= 0 {
+		return TemplateMutationResult{}, utils.ValidationError("ownerId", "ownerId must be greater than zero")
+	}
+	listingIDs, listErr := uniqueListingIdentityIDs(input.ListingIdentityIDs)
+	if listErr != nil {
+		return TemplateMutationResult{}, listErr
+	}
+
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return TemplateMutationResult{}, utils.InternalError("")
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	tx, txErr := s.globalService.StartTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("schedule.templates.apply.tx_start_error", "err", txErr, "template_id", input.TemplateID)
+		return TemplateMutationResult{}, utils.InternalError("")
+	}
+
+	committed := false
+	defer func() {
+		if !committed {
+			if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+				utils.SetSpanError(ctx, rbErr)
+				logger.Error("schedule.templates.apply.tx_rollback_error", "err", rbErr, "template_id", input.TemplateID)
+			}
+		}
+	}()
+
+	template, err := s.getOwnedTemplate(ctx, tx, input.TemplateID, input.OwnerID)
+	if err != nil {
+		return TemplateMutationResult{}, err
+	}
+
+	result := TemplateMutationResult{Template: template}
+	for _, listingIdentityID := range listingIDs {
+		agenda, getErr := s.scheduleRepo.GetAgendaByListingIdentityID(ctx, tx, listingIdentityID)
+		if getErr != nil {
+			if errors.Is(getErr, sql.ErrNoRows) {
+				return TemplateMutationResult{}, utils.NotFoundError("Agenda")
+			}
+			utils.SetSpanError(ctx, getErr)
+			logger.Error("schedule.templates.apply.get_agenda_error", "err", getErr, "listing_identity_id", listingIdentityID)
+			return TemplateMutationResult{}, utils.InternalError("")
+		}
+
+		if agenda.OwnerID() != input.OwnerID {
+			return TemplateMutationResult{}, utils.AuthorizationError("Owner does not match listing agenda")
+		}
+
+		if err := s.syncAgendaWithTemplate(ctx, tx, agenda, template); err != nil {
+			return TemplateMutationResult{}, err
+		}
+		result.SyncedListingIdentityIDs = append(result.SyncedListingIdentityIDs, listingIdentityID)
+	}
+
+	if cmErr := s.globalService.CommitTransaction(ctx, tx); cmErr != nil {
+		utils.SetSpanError(ctx, cmErr)
+		logger.Error("schedule.templates.apply.tx_commit_error", "err", cmErr, "template_id", input.TemplateID)
+		return TemplateMutationResult{}, utils.InternalError("")
+	}
+
+	committed = true
+
+	return result, nil
+}
+
+func validateSaveTemplateInput(input SaveTemplateInput) (string, []schedulemodel.AgendaRuleInterface, *utils.HTTPError) {
+	if input.OwnerID <= 0 {
+		return "", nil, utils.ValidationError("ownerId", "ownerId must be greater than zero")
+	}
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return "", nil, utils.ValidationError("name", "name is required")
+	}
+	if utf8.RuneCountInString(name) > maxTemplateNameLength {
+		return "", nil, utils.ValidationError("name", "name must not exceed 80 characters")
+	}
+	rules, err := buildTemplateRules(input.Rules)
+	if err != nil {
+		return "", nil, err
+	}
+	return name, rules, nil
+}
+
+// buildTemplateRules validates the template rules the same way CreateRules does and expands weekday-based
+// recurrences into one rule per weekday.
+func buildTemplateRules(inputs []TemplateRuleInput) ([]schedulemodel.AgendaRuleInterface, *utils.HTTPError) {
+	rules := make([]schedulemodel.AgendaRuleInterface, 0, len(inputs))
+	for _, input := range inputs {
+		schedule, scheduleErr := normalizeRuleSchedule(input.Schedule)
+		if scheduleErr != nil {
+			return nil, scheduleErr
+		}
+		weekdays := input.Weekdays
+		if usesWeekday(schedule.Recurrence) {
+			if len(weekdays) == 0 {
+				return nil, utils.ValidationError("weekDays", "weekDays must contain at least one value")
+			}
+		} else {
+			weekdays = []time.Weekday{time.Sunday}
+		}
+		if rngErr := validateRuleRangeMinutes(input.Range); rngErr != nil {
+			return nil, rngErr
+		}
+
+		for _, weekday := range weekdays {
+			rule := schedulemodel.NewAgendaRule()
+			applyRuleSchedule(rule, weekday, schedule)
+			rule.SetStartMinutes(input.Range.StartMinute)
+			rule.SetEndMinutes(input.Range.EndMinute)
+			rule.SetActive(input.Active)
+			if ruleConflict(rules, rule, 0) {
+				return nil, utils.ConflictError("Template rules overlap for the same days")
+			}
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) > maxTemplateRules {
+		return nil, utils.ValidationError("rules", "a template must not expand to more than 200 rules")
+	}
+	return rules, nil
+}
+
+func uniqueListingIdentityIDs(values []int64) ([]int64, *utils.HTTPError) {
+	if len(values) == 0 {
+		return nil, utils.ValidationError("listingIdentityIds", "listingIdentityIds must contain at least one value")
+	}
+	seen := make(map[int64]struct{}, len(values))
+	result := make([]int64, 0, len(values))
+	for _, value := range values {
+		if value <= 0 {
+			return nil, utils.ValidationError("listingIdentityIds", "listingIdentityIds must be greater than zero")
+		}
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		seen[value] = struct{}{}
+		result = append(result, value)
+	}
+	if len(result) > maxTemplateListings {
+		return nil, utils.ValidationError("listingIdentityIds", "listingIdentityIds must not exceed 100 values")
+	}
+	return result, nil
+}
+
+// getOwnedTemplate loads a template inside tx and checks it belongs to ownerID.
+func (s *scheduleService) getOwnedTemplate(ctx context.Context, tx *sql.Tx, templateID uint64, ownerID int64) (schedulemodel.AvailabilityTemplateInterface, error) {
+	template, err := s.scheduleRepo.GetTemplateByID(ctx, tx, templateID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, utils.NotFoundError("Availability template")
+		}
+		utils.SetSpanError(ctx, err)
+		utils.LoggerFromContext(ctx).Error("schedule.templates.get_template_error", "err", err, "template_id", templateID)
+		return nil, utils.InternalError("")
+	}
+	if template.OwnerID() != ownerID {
+		return nil, utils.AuthorizationError("Owner does not match availability template")
+	}
+	return template, nil
+}
+
+// ensureTemplateNameAvailable rejects names already used by another template of the owner, ignoring case.
+func (s *scheduleService) ensureTemplateNameAvailable(ctx context.Context, tx *sql.Tx, ownerID int64, name string, ignoreID uint64) error {
+	templates, err := s.scheduleRepo.ListTemplatesByOwner(ctx, tx, ownerID)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		utils.LoggerFromContext(ctx).Error("schedule.templates.list_templates_error", "err", err, "owner_id", ownerID)
+		return utils.InternalError("")
+	}
+	for _, template := range templates {
+		if template.ID() != ignoreID && strings.EqualFold(template.Name(), name) {
+			return utils.ConflictError("An availability template with this name already exists")
+		}
+	}
+	return nil
+}
+
+// saveTemplateRules stores the template rules and, for a default template, unmarks the owner's other defaults.
+func (s *scheduleService) saveTemplateRules(ctx context.Context, tx *sql.Tx, template schedulemodel.AvailabilityTemplateInterface, rules []schedulemodel.AgendaRuleInterface) error {
+	logger := utils.LoggerFromContext(ctx)
+
+	if err := s.scheduleRepo.ReplaceTemplateRules(ctx, tx, template.ID(), rules); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("schedule.templates.replace_rules_error", "err", err, "template_id", template.ID())
+		return utils.InternalError("")
+	}
+	template.SetRules(rules)
+
+	if template.IsDefault() {
+		if err := s.scheduleRepo.ClearDefaultTemplates(ctx, tx, template.OwnerID(), template.ID()); err != nil {
+			utils.SetSpanError(ctx, err)
+			logger.Error("schedule.templates.clear_default_error", "err", err, "template_id", template.ID())
+			return utils.InternalError("")
+		}
+	}
+	return nil
+}
+
+// syncAgendaWithTemplate replaces the agenda rules with copies of the template rules and marks the agenda as
+// linked and not overridden.
+func (s *scheduleService) syncAgendaWithTemplate(ctx context.Context, tx *sql.Tx, agenda schedulemodel.AgendaInterface, template schedulemodel.AvailabilityTemplateInterface) error {
+	logger := utils.LoggerFromContext(ctx)
+
+	if err := s.scheduleRepo.DeleteRulesByAgenda(ctx, tx, agenda.ID()); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		utils.SetSpanError(ctx, err)
+		logger.Error("schedule.templates.sync.delete_rules_error", "err", err, "listing_identity_id", agenda.ListingIdentityID())
+		return utils.InternalError("")
+	}
+
+	if err := s.scheduleRepo.InsertRules(ctx, tx, cloneTemplateRules(template.Rules(), agenda.ID())); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("schedule.templates.sync.insert_rules_error", "err", err, "listing_identity_id", agenda.ListingIdentityID())
+		return utils.InternalError("")
+	}
+
+	agenda.SetTemplateID(template.ID())
+	agenda.SetTemplateOverridden(false)
+	if err := s.scheduleRepo.UpdateAgendaTemplate(ctx, tx, agenda); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("schedule.templates.sync.link_error", "err", err, "listing_identity_id", agenda.ListingIdentityID())
+		return utils.InternalError("")
+	}
+	return nil
+}
+
+// markTemplateOverridden flags a linked agenda after a local rule change so template edits stop propagating to it.
+func (s *scheduleService) markTemplateOverridden(ctx context.Context, tx *sql.Tx, agenda schedulemodel.AgendaInterface) error {
+	if _, linked := agenda.TemplateID(); !linked || agenda.TemplateOverridden() {
+		return nil
+	}
+
+	agenda.SetTemplateOverridden(true)
+	if err := s.scheduleRepo.UpdateAgendaTemplate(ctx, tx, agenda); err != nil {
+		utils.SetSpanError(ctx, err)
+		utils.LoggerFromContext(ctx).Error("schedule.templates.mark_overridden_error", "err", err, "listing_identity_id", agenda.ListingIdentityID())
+		return utils.InternalError("")
+	}
+	return nil
+}
+
+// cloneTemplateRules copies template rules into new rules owned by agendaID.
+func cloneTemplateRules(source []schedulemodel.AgendaRuleInterface, agendaID uint64) []schedulemodel.AgendaRuleInterface {
+	rules := make([]schedulemodel.AgendaRuleInterface, 0, len(source))
+	for _, original := range source {
+		rule := schedulemodel.NewAgendaRule()
+		rule.SetAgendaID(agendaID)
+		rule.SetDayOfWeek(original.DayOfWeek())
+		rule.SetStartMinutes(original.StartMinutes())
+		rule.SetEndMinutes(original.EndMinutes())
+		rule.SetRuleType(original.RuleType())
+		rule.SetActive(original.IsActive())
+		rule.SetRecurrence(original.Recurrence())
+		rule.SetDayOfMonth(original.DayOfMonth())
+		rule.SetWeekOfMonth(original.WeekOfMonth())
+		if date, ok := original.RuleDate(); ok {
+			rule.SetRuleDate(date)
+		}
+		if from, ok := original.ValidFrom(); ok {
+			rule.SetValidFrom(from)
+		}
+		if until, ok := original.ValidUntil(); ok {
+			rule.SetValidUntil(until)
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// templateLink exposes the agenda template link in the shape used by RuleListResult.
+func templateLink(agenda schedulemodel.AgendaInterface) (*uint64, bool) {
+	templateID, ok := agenda.TemplateID()
+	if !ok {
+		return nil, false
+	}
+	return &templateID, agenda.TemplateOverridden()
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	auditmodel "github.com/projeto-toq/toq_server/internal/core/model/audit_model"
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
 	permissionmodel "github.com/projeto-toq/toq_server/internal/core/model/permission_model"
 	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
 	auditservice "github.com/projeto-toq/toq_server/internal/core/service/audit_service"
@@ -125,10 +126,12 @@ func (us *userService) acceptInvitation(ctx context.Context, tx *sql.Tx, userID
 	// Notificar a imobiliária sobre a aceitação do convite
 	notificationService := us.globalService.GetUnifiedNotificationService()
 	emailRequest := globalservice.NotificationRequest{
-		Type:    globalservice.NotificationTypeEmail,
-		To:      agency.GetEmail(),
-		Subject: "Convite Aceito - TOQ",
-		Body:    fmt.Sprintf("O corretor %s aceitou seu convite para trabalhar com sua imobiliária!", realtor.GetFullName()),
+		Type:     globalservice.NotificationTypeEmail,
+		To:       agency.GetEmail(),
+		Subject:  "Convite Aceito - TOQ",
+		Body:     fmt.Sprintf("O corretor %s aceitou seu convite para trabalhar com sua imobiliária!", realtor.GetFullName()),
+		UserID:   agency.GetID(),
+		Category: globalmodel.NotificationCategoryTransactional,
 	}
 
 	err = notificationService.SendNotification(ctx, emailRequest)
@@ -0,0 +1,123 @@
+package userservices
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	auditmodel "github.com/projeto-toq/toq_server/internal/core/model/audit_model"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	userrepository "github.com/projeto-toq/toq_server/internal/core/port/right/repository/user_repository"
+	auditservice "github.com/projeto-toq/toq_server/internal/core/service/audit_service"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+const anonymizedFullName = "Usuário anonimizado"
+
+// anonymizedFields lists the personal data categories overwritten by the pipeline (certificate content).
+var anonymizedFields = []string{
+	"full_name", "nick_name", "national_id", "email", "phone_number",
+	"address", "creci_number", "born_at_day_month", "password", "photos", "documents",
+	"session_network_data",
+}
+
+// anonymizeUser replaces the user's PII with irreversible tokens and records a deletion certificate
+// in audit, all bound to the caller's transaction.
+//
+// Tokens are derived from a random seed that is never persisted, so the original values cannot be
+// recovered. Rows referencing the user (listings, visits, proposals, audit events) keep their foreign
+// keys, and coarse attributes (city, state, birth year) are preserved for aggregate metrics.
+//
+// The storage folder cannot be restored by a rollback, so the caller purges it only after the
+// commit (see purgeUserFolder); the certificate records the purge as scheduled.
+func (us *userService) anonymizeUser(ctx context.Context, tx *sql.Tx, user usermodel.UserInterface) error {
+	logger := utils.LoggerFromContext(ctx)
+
+	seed := make([]byte, 32)
+	if _, err := rand.Read(seed); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("user.anonymize.seed_error", "user_id", user.GetID(), "error", err)
+		return utils.InternalError("Failed to anonymize user")
+	}
+	token := anonymizationToken(seed, user.GetID())
+
+	bornAt := user.GetBornAt()
+	anon := userrepository.UserAnonymization{
+		UserID:              user.GetID(),
+		OriginalPhoneNumber: user.GetPhoneNumber(),
+		FullName:            anonymizedFullName,
+		NickName:            "anon-" + token[:16],
+		NationalID:          "ANON" + token[:20],
+		Email:               fmt.Sprintf("anon-%s@anonimizado.invalid", token[:16]),
+		PhoneNumber:         "anon-" + token[:16],
+		Password:            "!" + token,
+		BornAt:              time.Date(bornAt.Year(), time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	affected, err := us.repo.AnonymizeUser(ctx, tx, anon)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("user.anonymize.repo_error", "user_id", user.GetID(), "error", err)
+		return utils.InternalError("Failed to anonymize user")
+	}
+
+	certificate := map[string]any{
+		"certificate_id":       uuid.NewString(),
+		"user_id":              user.GetID(),
+		"anonymized_at":        time.Now().UTC().Format(time.RFC3339Nano),
+		"legal_basis":          "LGPD art. 16 e art. 18, VI",
+		"method":               "irreversible_token_replacement",
+		"fields":               anonymizedFields,
+		"affected_rows":        affected,
+		"storage_folder_purge": "after_commit",
+	}
+	payload, err := json.Marshal(certificate)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("user.anonymize.certificate_marshal_error", "user_id", user.GetID(), "error", err)
+		return utils.InternalError("Failed to anonymize user")
+	}
+	digest := sha256.Sum256(payload)
+	certificate["certificate_sha256"] = hex.EncodeToString(digest[:])
+
+	auditRecord := auditservice.BuildRecordFromContext(
+		ctx,
+		user.GetID(),
+		auditmodel.AuditTarget{Type: auditmodel.TargetUser, ID: user.GetID()},
+		auditmodel.OperationAnonymize,
+		certificate,
+	)
+	if err := us.auditService.RecordChange(ctx, tx, auditRecord); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("user.anonymize.audit_error", "user_id", user.GetID(), "error", err)
+		return utils.InternalError("Failed to record deletion certificate")
+	}
+
+	logger.Info("user.anonymize.success", "user_id", user.GetID(), "certificate_id", certificate["certificate_id"])
+	return nil
+}
+
+// purgeUserFolder deletes the user's stored files once the anonymization is committed. A failure is
+// logged for manual cleanup: the account is already anonymized and must not be reported as failed.
+func (us *userService) purgeUserFolder(ctx context.Context, userID int64) {
+	if err := us.DeleteUserFolder(ctx, userID); err != nil {
+		utils.LoggerFromContext(ctx).Error("user.anonymize.purge_folder_error", "user_id", userID, "error", err)
+		return
+	}
+	utils.LoggerFromContext(ctx).Info("user.anonymize.folder_purged", "user_id", userID)
+}
+
+// anonymizationToken derives a hex token from a throwaway random seed and the user id.
+func anonymizationToken(seed []byte, userID int64) string {
+	h := sha256.New()
+	h.Write(seed)
+	fmt.Fprintf(h, ":%d", userID)
+	return hex.EncodeToString(h.Sum(nil))
+}
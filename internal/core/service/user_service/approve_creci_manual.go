@@ -105,6 +105,16 @@ func (us *userService) sendManualApprovalNotification(ctx context.Context, userI
 	ctx = utils.ContextWithLogger(ctx)
 	logger := utils.LoggerFromContext(ctx)
 
+	subject, body := buildManualApprovalNotificationPayload(target)
+	notify := us.globalService.GetUnifiedNotificationService()
+	notify.StoreInbox(ctx, globalservice.NotificationRequest{
+		Type:     globalservice.NotificationTypeFCM,
+		Subject:  subject,
+		Body:     body,
+		UserID:   userID,
+		Category: globalmodel.NotificationCategoryTransactional,
+	})
+
 	tokens, err := us.globalService.ListDeviceTokensByUserIDIfOptedIn(ctx, userID)
 	if err != nil {
 		utils.SetSpanError(ctx, err)
@@ -117,15 +127,15 @@ func (us *userService) sendManualApprovalNotification(ctx context.Context, userI
 		return nil
 	}
 
-	subject, body := buildManualApprovalNotificationPayload(target)
-	notify := us.globalService.GetUnifiedNotificationService()
-
 	for _, token := range tokens {
 		req := globalservice.NotificationRequest{
-			Type:    globalservice.NotificationTypeFCM,
-			Token:   token,
-			Subject: subject,
-			Body:    body,
+			Type:      globalservice.NotificationTypeFCM,
+			Token:     token,
+			Subject:   subject,
+			Body:      body,
+			UserID:    userID,
+			Category:  globalmodel.NotificationCategoryTransactional,
+			SkipInbox: true,
 		}
 
 		if err := notify.SendNotification(ctx, req); err != nil {
@@ -14,6 +14,8 @@ import (
 type AssignRoleOptions struct {
 	IsActive *bool
 	Status   *globalmodel.UserRoleStatus
+	// ValidFrom agenda o início da vigência do role (nil = vigente imediatamente).
+	ValidFrom *time.Time
 }
 
 // AssignRoleToUser atribui um role a um usuário (sem transação - uso direto)
@@ -87,6 +89,9 @@ func (us *userService) AssignRoleToUser(ctx context.Context, userID, roleID int6
 //   - Usuário NÃO pode ter o mesmo role duplicado (409 se já existe)
 //   - Status padrão: StatusPendingBoth (se não especificado em opts)
 //   - IsActive padrão: true (se não especificado em opts)
+//   - expiresAt deve estar no futuro e ser posterior a opts.ValidFrom (400 caso contrário)
+//   - ValidFrom no futuro cria a concessão como RoleGrantStateScheduled; o worker de
+//     agendamento a torna efetiva quando a data for atingida
 //
 // Side Effects:
 //   - Cria registro em user_roles table
@@ -121,7 +126,20 @@ func (us *userService) AssignRoleToUserWithTx(ctx context.Context, tx *sql.Tx, u
 		return nil, utils.BadRequest("invalid role id")
 	}
 
-	logger.Debug("permission.role.assign.request", "user_id", userID, "role_id", roleID, "expires_at", expiresAt)
+	var validFrom *time.Time
+	if opts != nil {
+		validFrom = opts.ValidFrom
+	}
+
+	now := time.Now().UTC()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, utils.ValidationError("validUntil", "must be in the future")
+	}
+	if validFrom != nil && expiresAt != nil && !expiresAt.After(*validFrom) {
+		return nil, utils.ValidationError("validUntil", "must be after validFrom")
+	}
+
+	logger.Debug("permission.role.assign.request", "user_id", userID, "role_id", roleID, "expires_at", expiresAt, "valid_from", validFrom)
 
 	// Verify role exists (infrastructure + domain validation)
 	role, err := us.permissionService.GetRoleByIDWithTx(ctx, tx, roleID)
@@ -169,6 +187,16 @@ func (us *userService) AssignRoleToUserWithTx(ctx context.Context, tx *sql.Tx, u
 		userRole.SetExpiresAt(expiresAt)
 	}
 
+	// Schedule the grant when it starts in the future
+	grantState := usermodel.RoleGrantStateEffective
+	if validFrom != nil {
+		userRole.SetValidFrom(validFrom)
+		if validFrom.After(now) {
+			grantState = usermodel.RoleGrantStateScheduled
+		}
+	}
+	userRole.SetGrantState(grantState)
+
 	// Persist to database
 	userRole, err = us.repo.CreateUserRole(ctx, tx, userRole)
 	if err != nil {
@@ -178,7 +206,7 @@ func (us *userService) AssignRoleToUserWithTx(ctx context.Context, tx *sql.Tx, u
 	}
 
 	// Log success (domain event)
-	logger.Info("permission.role.assigned", "user_id", userID, "role_id", roleID, "role_name", role.GetName(), "is_active", isActive, "status", status.String())
+	logger.Info("permission.role.assigned", "user_id", userID, "role_id", roleID, "role_name", role.GetName(), "is_active", isActive, "status", status.String(), "grant_state", grantState.String())
 
 	// Invalidate user permissions cache (best-effort, post-commit operation)
 	// Failure does not block the operation as the cache will be eventually consistent
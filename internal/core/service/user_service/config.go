@@ -13,6 +13,10 @@ type Config struct {
 	PhotographerAgendaRefreshInterval time.Duration
 	MaxWrongSigninAttempts            int
 	TempBlockDuration                 time.Duration
+	DataExportURLTTL                  time.Duration
+	DataExportStaleAfter              time.Duration
+	DataExportMaxAttempts             int
+	ConsentPolicyVersion              string
 }
 
 func normalizeConfig(cfg Config) Config {
@@ -36,5 +40,18 @@ func normalizeConfig(cfg Config) Config {
 	if cfg.TempBlockDuration <= 0 {
 		cfg.TempBlockDuration = 15 * time.Minute
 	}
+	cfg.ConsentPolicyVersion = strings.TrimSpace(cfg.ConsentPolicyVersion)
+	if cfg.ConsentPolicyVersion == "" {
+		cfg.ConsentPolicyVersion = "1.0"
+	}
+	if cfg.DataExportURLTTL <= 0 {
+		cfg.DataExportURLTTL = 72 * time.Hour
+	}
+	if cfg.DataExportStaleAfter <= 0 {
+		cfg.DataExportStaleAfter = 30 * time.Minute
+	}
+	if cfg.DataExportMaxAttempts <= 0 {
+		cfg.DataExportMaxAttempts = 3
+	}
 	return cfg
 }
@@ -3,6 +3,7 @@ package userservices
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -72,6 +73,15 @@ func (us *userService) CreateSystemUser(ctx context.Context, input CreateSystemU
 		return SystemUserResult{}, utils.ValidationError("zipCode", "Zip code must be provided when address number is informed")
 	}
 
+	if input.ValidUntil != nil {
+		if !input.ValidUntil.After(time.Now().UTC()) {
+			return SystemUserResult{}, utils.ValidationError("validUntil", "Valid until must be in the future")
+		}
+		if input.ValidFrom != nil && !input.ValidUntil.After(*input.ValidFrom) {
+			return SystemUserResult{}, utils.ValidationError("validUntil", "Valid until must be after valid from")
+		}
+	}
+
 	tx, txErr := us.globalService.StartTransaction(ctx)
 	if txErr != nil {
 		utils.SetSpanError(ctx, txErr)
@@ -195,8 +205,8 @@ func (us *userService) CreateSystemUser(ctx context.Context, input CreateSystemU
 
 	status := globalmodel.StatusActive
 	isActive := true
-	assignOpts := &AssignRoleOptions{IsActive: &isActive, Status: &status}
-	userRole, assignErr := us.AssignRoleToUserWithTx(ctx, tx, newUser.GetID(), role.GetID(), nil, assignOpts)
+	assignOpts := &AssignRoleOptions{IsActive: &isActive, Status: &status, ValidFrom: input.ValidFrom}
+	userRole, assignErr := us.AssignRoleToUserWithTx(ctx, tx, newUser.GetID(), role.GetID(), input.ValidUntil, assignOpts)
 	if assignErr != nil {
 		utils.SetSpanError(ctx, assignErr)
 		logger.Error("admin.users.create.assign_role_failed", "user_id", newUser.GetID(), "role_id", role.GetID(), "error", assignErr)
@@ -225,6 +235,8 @@ func (us *userService) CreateSystemUser(ctx context.Context, input CreateSystemU
 			"cpf":                cpfDigits,
 			"zip_code":           newUser.GetZipCode(),
 			"has_custom_address": customZipCode != "",
+			"valid_from":         input.ValidFrom,
+			"valid_until":        input.ValidUntil,
 		},
 	)
 	if auditErr := us.auditService.RecordChange(ctx, tx, auditRecord); auditErr != nil {
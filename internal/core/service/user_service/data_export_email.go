@@ -0,0 +1,78 @@
+package userservices
+
+import (
+	"bytes"
+	"context"
+	"html/template"
+	"sync"
+
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	globalservice "github.com/projeto-toq/toq_server/internal/core/service/global_service"
+)
+
+const dataExportReadyTemplatePath = "internal/core/templates/email_data_export_ready.html"
+
+// dataExportEmailRenderer renders the data export ready template lazily.
+type dataExportEmailRenderer struct {
+	once sync.Once
+	tmpl *template.Template
+	err  error
+}
+
+var dataExportRenderer = &dataExportEmailRenderer{}
+
+func (r *dataExportEmailRenderer) render(data any) (string, error) {
+	r.once.Do(func() {
+		r.tmpl, r.err = template.ParseFiles(dataExportReadyTemplatePath)
+	})
+	if r.err != nil {
+		return "", r.err
+	}
+	var buf bytes.Buffer
+	if err := r.tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// sendDataExportReadyEmail emails the user an expiring link to their personal data archive.
+func (us *userService) sendDataExportReadyEmail(ctx context.Context, job usermodel.DataExportInterface) error {
+	user, err := us.repo.GetUserByID(ctx, nil, job.GetUserID())
+	if err != nil {
+		return err
+	}
+
+	url, err := us.cloudStorageService.GenerateUserObjectDownloadURL(job.GetObjectKey(), us.cfg.DataExportURLTTL)
+	if err != nil {
+		return err
+	}
+
+	expiresAt := ""
+	if job.GetExpiresAt() != nil {
+		expiresAt = job.GetExpiresAt().Format("02/01/2006 15:04 MST")
+	}
+
+	name := user.GetNickName()
+	if name == "" {
+		name = user.GetFullName()
+	}
+
+	body, err := dataExportRenderer.render(map[string]any{
+		"NickName":    name,
+		"DownloadURL": url,
+		"ExpiresAt":   expiresAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	return us.globalService.GetUnifiedNotificationService().SendNotification(ctx, globalservice.NotificationRequest{
+		Type:     globalservice.NotificationTypeEmail,
+		To:       user.GetEmail(),
+		Subject:  "TOQ - Seus dados pessoais estão disponíveis",
+		Body:     body,
+		UserID:   job.GetUserID(),
+		Category: globalmodel.NotificationCategoryTransactional,
+	})
+}
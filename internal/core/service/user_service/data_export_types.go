@@ -0,0 +1,24 @@
+package userservices
+
+import (
+	"time"
+
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+)
+
+// DataExportStatusOutput descreve o último pedido de exportação de dados pessoais (LGPD) do usuário.
+type DataExportStatusOutput struct {
+	ID           int64
+	Status       usermodel.DataExportStatus
+	RequestedAt  time.Time
+	CompletedAt  *time.Time
+	ExpiresAt    *time.Time
+	DownloadURL  string
+	ErrorMessage string
+}
+
+// DataExportProcessResult resume uma execução do processador de exportações.
+type DataExportProcessResult struct {
+	Completed int
+	Failed    int
+}
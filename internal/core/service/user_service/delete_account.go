@@ -14,8 +14,9 @@ import (
 )
 
 // DeleteAccount deletes the current authenticated user's account.
-// It revokes all sessions, removes device tokens, and marks the account as deleted.
-// User data and role history are preserved for audit purposes.
+// It revokes all sessions, removes device tokens, marks the account as deleted and anonymizes
+// the user's personal data (LGPD). The user row and role history are kept with irreversible
+// tokens so listings, visits, proposals and audit events preserve referential integrity.
 // Idempotent: if already deleted, returns success and expired tokens.
 func (us *userService) DeleteAccount(ctx context.Context) (tokens usermodel.Tokens, err error) {
 	ctx, spanEnd, err := utils.GenerateTracer(ctx)
@@ -86,6 +87,9 @@ func (us *userService) DeleteAccount(ctx context.Context) (tokens usermodel.Toke
 		us.globalService.GetEventBus().Publish(events.SessionEvent{Type: events.SessionsRevoked, UserID: userID})
 	}
 
+	// Stored files cannot be restored by a rollback, so they are purged only after the commit
+	us.purgeUserFolder(ctx, userID)
+
 	return
 }
 
@@ -138,7 +142,7 @@ func (us *userService) deleteAccount(ctx context.Context, tx *sql.Tx, user userm
 		logger.Warn("user.delete_account.remove_device_tokens_warning", "error", err2, "user_id", user.GetID())
 	}
 
-	// Mark user as deleted (preserving all data for audit)
+	// Mark user as deleted (personal data is anonymized below)
 	user.SetDeleted(true)
 
 	err = us.repo.UpdateUserByID(ctx, tx, user)
@@ -192,6 +196,11 @@ func (us *userService) deleteAccount(ctx context.Context, tx *sql.Tx, user userm
 		return
 	}
 
+	// Replace PII with irreversible tokens and record the deletion certificate (files are purged after commit)
+	if err = us.anonymizeUser(ctx, tx, user); err != nil {
+		return
+	}
+
 	// Generate expired tokens to ensure client logout on all devices
 	tokens, err = us.CreateTokens(ctx, tx, user, true)
 	if err != nil {
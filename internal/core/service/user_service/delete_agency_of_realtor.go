@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	auditmodel "github.com/projeto-toq/toq_server/internal/core/model/audit_model"
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
 	auditservice "github.com/projeto-toq/toq_server/internal/core/service/audit_service"
 	globalservice "github.com/projeto-toq/toq_server/internal/core/service/global_service"
 	"github.com/projeto-toq/toq_server/internal/core/utils"
@@ -81,10 +82,12 @@ func (us *userService) deleteAgencyOfRealtor(ctx context.Context, tx *sql.Tx, re
 	// Notificar o corretor sobre a saída da imobiliária
 	notificationService := us.globalService.GetUnifiedNotificationService()
 	emailRequest := globalservice.NotificationRequest{
-		Type:    globalservice.NotificationTypeEmail,
-		To:      realtor.GetEmail(),
-		Subject: "Saída de Imobiliária - TOQ",
-		Body:    fmt.Sprintf("Você saiu da imobiliária %s.", agency.GetNickName()),
+		Type:     globalservice.NotificationTypeEmail,
+		To:       realtor.GetEmail(),
+		Subject:  "Saída de Imobiliária - TOQ",
+		Body:     fmt.Sprintf("Você saiu da imobiliária %s.", agency.GetNickName()),
+		UserID:   realtor.GetID(),
+		Category: globalmodel.NotificationCategoryTransactional,
 	}
 
 	err = notificationService.SendNotification(ctx, emailRequest)
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	auditmodel "github.com/projeto-toq/toq_server/internal/core/model/audit_model"
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
 	auditservice "github.com/projeto-toq/toq_server/internal/core/service/audit_service"
 	globalservice "github.com/projeto-toq/toq_server/internal/core/service/global_service"
 	"github.com/projeto-toq/toq_server/internal/core/utils"
@@ -83,10 +84,12 @@ func (us *userService) deleteRealtorOfAgency(ctx context.Context, tx *sql.Tx, ag
 	// Notificar o corretor sobre a remoção da imobiliária
 	notificationService := us.globalService.GetUnifiedNotificationService()
 	emailRequest := globalservice.NotificationRequest{
-		Type:    globalservice.NotificationTypeEmail,
-		To:      realtor.GetEmail(),
-		Subject: "Remoção de Imobiliária - TOQ",
-		Body:    fmt.Sprintf("Você foi removido da imobiliária %s.", agency.GetNickName()),
+		Type:     globalservice.NotificationTypeEmail,
+		To:       realtor.GetEmail(),
+		Subject:  "Remoção de Imobiliária - TOQ",
+		Body:     fmt.Sprintf("Você foi removido da imobiliária %s.", agency.GetNickName()),
+		UserID:   realtor.GetID(),
+		Category: globalmodel.NotificationCategoryTransactional,
 	}
 
 	err = notificationService.SendNotification(ctx, emailRequest)
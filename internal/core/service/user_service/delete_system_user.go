@@ -12,7 +12,8 @@ import (
 )
 
 // DeleteSystemUser performs logical deletion of a system user and deactivates all their roles.
-// User data and role history are preserved for audit purposes.
+// Personal data is anonymized as in DeleteAccount (LGPD); the user row and role history are kept
+// with irreversible tokens for audit, and stored files are purged after the commit.
 func (us *userService) DeleteSystemUser(ctx context.Context, input DeleteSystemUserInput) error {
 	ctx, spanEnd, err := utils.GenerateTracer(ctx)
 	if err != nil {
@@ -93,7 +94,7 @@ func (us *userService) DeleteSystemUser(ctx context.Context, input DeleteSystemU
 		logger.Warn("admin.users.delete.remove_tokens_failed", "user_id", existing.GetID(), "error", removeTokensErr)
 	}
 
-	// Mark user as deleted (preserving all data for audit)
+	// Mark user as deleted (personal data is anonymized below)
 	existing.SetDeleted(true)
 	existing.SetLastActivityAt(time.Now().UTC())
 
@@ -144,12 +145,21 @@ func (us *userService) DeleteSystemUser(ctx context.Context, input DeleteSystemU
 		return opErr
 	}
 
+	// Replace PII with irreversible tokens and record the deletion certificate (files are purged after commit)
+	if anonErr := us.anonymizeUser(ctx, tx, existing); anonErr != nil {
+		opErr = anonErr
+		return opErr
+	}
+
 	if commitErr := us.globalService.CommitTransaction(ctx, tx); commitErr != nil {
 		utils.SetSpanError(ctx, commitErr)
 		logger.Error("admin.users.delete.tx_commit_failed", "user_id", existing.GetID(), "error", commitErr)
 		return utils.InternalError("")
 	}
 
+	// Stored files cannot be restored by a rollback, so they are purged only after the commit
+	us.purgeUserFolder(ctx, existing.GetID())
+
 	logger.Info("admin.users.delete.success", "user_id", existing.GetID())
 	return nil
 }
@@ -0,0 +1,88 @@
+package userservices
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// GetDataExportStatus returns the latest personal data export of the authenticated user.
+//
+// When the export is COMPLETED and not yet expired a fresh signed download URL is generated,
+// valid at most until the export expiration.
+func (us *userService) GetDataExportStatus(ctx context.Context) (output DataExportStatusOutput, err error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return output, utils.InternalError("Failed to generate tracer")
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	userID, err := us.globalService.GetUserIDFromContext(ctx)
+	if err != nil || userID == 0 {
+		return output, utils.AuthenticationError("")
+	}
+
+	tx, txErr := us.globalService.StartReadOnlyTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("user.data_export.status.tx_start_error", "user_id", userID, "error", txErr)
+		return output, utils.InternalError("Failed to start transaction")
+	}
+	defer func() {
+		if rbErr := us.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+			utils.SetSpanError(ctx, rbErr)
+			logger.Error("user.data_export.status.tx_rollback_error", "user_id", userID, "error", rbErr)
+		}
+	}()
+
+	export, getErr := us.repo.GetLatestDataExportByUserID(ctx, tx, userID)
+	if getErr != nil {
+		if errors.Is(getErr, sql.ErrNoRows) {
+			return output, utils.NotFoundError("Data export")
+		}
+		utils.SetSpanError(ctx, getErr)
+		logger.Error("user.data_export.status.get_latest_error", "user_id", userID, "error", getErr)
+		return output, utils.InternalError("")
+	}
+
+	output = DataExportStatusOutput{
+		ID:           export.GetID(),
+		Status:       export.GetStatus(),
+		RequestedAt:  export.GetRequestedAt(),
+		CompletedAt:  export.GetCompletedAt(),
+		ExpiresAt:    export.GetExpiresAt(),
+		ErrorMessage: export.GetErrorMessage(),
+	}
+
+	if export.GetStatus() != usermodel.DataExportStatusCompleted || export.GetObjectKey() == "" {
+		return output, nil
+	}
+
+	ttl := us.cfg.DataExportURLTTL
+	if expiresAt := export.GetExpiresAt(); expiresAt != nil {
+		remaining := time.Until(*expiresAt)
+		if remaining <= 0 {
+			return output, nil
+		}
+		if remaining < ttl {
+			ttl = remaining
+		}
+	}
+
+	url, urlErr := us.cloudStorageService.GenerateUserObjectDownloadURL(export.GetObjectKey(), ttl)
+	if urlErr != nil {
+		utils.SetSpanError(ctx, urlErr)
+		logger.Error("user.data_export.status.signed_url_error", "user_id", userID, "export_id", export.GetID(), "error", urlErr)
+		return output, utils.InternalError("Failed to generate download URL")
+	}
+	output.DownloadURL = url
+
+	return output, nil
+}
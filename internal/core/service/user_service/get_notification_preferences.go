@@ -0,0 +1,68 @@
+package userservices
+
+import (
+	"context"
+
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// GetNotificationPreferences returns the consent matrix (channel × category) of the authenticated user.
+//
+// Categories without a recorded choice report their default; transactional entries are always granted.
+func (us *userService) GetNotificationPreferences(ctx context.Context) (output NotificationPreferencesOutput, err error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return output, utils.InternalError("Failed to generate tracer")
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	userID, err := us.globalService.GetUserIDFromContext(ctx)
+	if err != nil || userID == 0 {
+		return output, utils.AuthenticationError("")
+	}
+
+	consents, listErr := us.repo.ListNotificationConsents(ctx, nil, userID)
+	if listErr != nil {
+		utils.SetSpanError(ctx, listErr)
+		logger.Error("user.notification_preferences.get.list_error", "user_id", userID, "error", listErr)
+		return output, utils.InternalError("")
+	}
+
+	return us.buildNotificationPreferences(consents), nil
+}
+
+// buildNotificationPreferences expands recorded consents into the full channel × category matrix.
+func (us *userService) buildNotificationPreferences(consents []usermodel.NotificationConsent) NotificationPreferencesOutput {
+	recorded := make(map[globalmodel.NotificationChannel]map[globalmodel.NotificationCategory]usermodel.NotificationConsent, len(globalmodel.NotificationChannels))
+	for _, consent := range consents {
+		if recorded[consent.Channel] == nil {
+			recorded[consent.Channel] = make(map[globalmodel.NotificationCategory]usermodel.NotificationConsent)
+		}
+		recorded[consent.Channel][consent.Category] = consent
+	}
+
+	output := NotificationPreferencesOutput{CurrentPolicyVersion: us.cfg.ConsentPolicyVersion}
+	for _, channel := range globalmodel.NotificationChannels {
+		for _, category := range globalmodel.NotificationCategories {
+			pref := NotificationPreference{
+				Channel:   channel,
+				Category:  category,
+				Mandatory: category.IsMandatory(),
+				Granted:   usermodel.ResolveNotificationConsent(consents, channel, category),
+			}
+			if consent, ok := recorded[channel][category]; ok && !pref.Mandatory {
+				updatedAt := consent.UpdatedAt
+				pref.Recorded = true
+				pref.PolicyVersion = consent.PolicyVersion
+				pref.UpdatedAt = &updatedAt
+			}
+			output.Preferences = append(output.Preferences, pref)
+		}
+	}
+	return output
+}
@@ -0,0 +1,33 @@
+package userservices
+
+import (
+	"context"
+
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// GetUnreadNotificationCount returns how many inbox entries the authenticated user has not read (app badge).
+func (us *userService) GetUnreadNotificationCount(ctx context.Context) (int64, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return 0, utils.InternalError("Failed to generate tracer")
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	userID, err := us.globalService.GetUserIDFromContext(ctx)
+	if err != nil || userID == 0 {
+		return 0, utils.AuthenticationError("")
+	}
+
+	count, countErr := us.repo.CountUnreadInboxNotifications(ctx, nil, userID)
+	if countErr != nil {
+		utils.SetSpanError(ctx, countErr)
+		logger.Error("user.notifications.unread_count.repo_error", "user_id", userID, "error", countErr)
+		return 0, utils.InternalError("")
+	}
+
+	return count, nil
+}
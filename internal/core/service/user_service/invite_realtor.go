@@ -107,10 +107,12 @@ func (us *userService) inviteRealtor(ctx context.Context, tx *sql.Tx, phoneNumbe
 		if len(tokens) > 0 {
 			// Send to the first available device token
 			pushRequest := globalservice.NotificationRequest{
-				Type:    globalservice.NotificationTypeFCM,
-				Token:   tokens[0].Token,
-				Subject: "Nova Proposta de Trabalho",
-				Body:    fmt.Sprintf("A imobiliária %s quer trabalhar com você!", agency.GetNickName()),
+				Type:     globalservice.NotificationTypeFCM,
+				Token:    tokens[0].Token,
+				Subject:  "Nova Proposta de Trabalho",
+				Body:     fmt.Sprintf("A imobiliária %s quer trabalhar com você!", agency.GetNickName()),
+				UserID:   realtor.GetID(),
+				Category: globalmodel.NotificationCategoryTransactional,
 			}
 
 			err = notificationService.SendNotification(ctx, pushRequest)
@@ -260,9 +262,10 @@ func (us *userService) sendSMStoNewRealtor(ctx context.Context, phoneNumber stri
 	// Enviar SMS para corretor que não está na plataforma
 	notificationService := us.globalService.GetUnifiedNotificationService()
 	smsRequest := globalservice.NotificationRequest{
-		Type: globalservice.NotificationTypeSMS,
-		To:   phoneNumber,
-		Body: fmt.Sprintf("A imobiliária %s quer trabalhar com você! Baixe o app TOQ e aceite o convite.", agency.GetNickName()),
+		Type:     globalservice.NotificationTypeSMS,
+		Category: globalmodel.NotificationCategoryTransactional,
+		To:       phoneNumber,
+		Body:     fmt.Sprintf("A imobiliária %s quer trabalhar com você! Baixe o app TOQ e aceite o convite.", agency.GetNickName()),
 	}
 
 	err = notificationService.SendNotification(ctx, smsRequest)
@@ -312,10 +315,12 @@ func (us *userService) createAgencyInviteByPhone(ctx context.Context, tx *sql.Tx
 		if len(tokens) > 0 {
 			// Send to the first available device token
 			pushRequest := globalservice.NotificationRequest{
-				Type:    globalservice.NotificationTypeFCM,
-				Token:   tokens[0].Token,
-				Subject: "Nova Proposta de Trabalho",
-				Body:    fmt.Sprintf("A imobiliária %s quer trabalhar com você!", agency.GetNickName()),
+				Type:     globalservice.NotificationTypeFCM,
+				Token:    tokens[0].Token,
+				Subject:  "Nova Proposta de Trabalho",
+				Body:     fmt.Sprintf("A imobiliária %s quer trabalhar com você!", agency.GetNickName()),
+				UserID:   realtor.GetID(),
+				Category: globalmodel.NotificationCategoryTransactional,
 			}
 
 			err = notificationService.SendNotification(ctx, pushRequest)
@@ -0,0 +1,83 @@
+package userservices
+
+import (
+	"context"
+	"time"
+
+	userrepository "github.com/projeto-toq/toq_server/internal/core/port/right/repository/user_repository"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ListExpiringUserRoles retorna concessões de role que expiram nos próximos WithinDays dias (padrão 7, máximo 90).
+func (us *userService) ListExpiringUserRoles(ctx context.Context, input ListExpiringUserRolesInput) (ListExpiringUserRolesOutput, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return ListExpiringUserRolesOutput{}, utils.InternalError("Failed to generate tracer")
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	if input.Page <= 0 {
+		input.Page = 1
+	}
+	if input.Limit <= 0 {
+		input.Limit = 20
+	}
+	if input.WithinDays <= 0 {
+		input.WithinDays = 7
+	}
+	if input.WithinDays > 90 {
+		return ListExpiringUserRolesOutput{}, utils.ValidationError("withinDays", "Must be at most 90")
+	}
+
+	tx, txErr := us.globalService.StartReadOnlyTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("admin.users.roles.expiring.tx_start_failed", "error", txErr)
+		return ListExpiringUserRolesOutput{}, utils.InternalError("")
+	}
+	defer func() {
+		_ = us.globalService.RollbackTransaction(ctx, tx)
+	}()
+
+	result, listErr := us.repo.ListExpiringUserRoles(ctx, tx, userrepository.ExpiringUserRolesFilter{
+		Until: time.Now().UTC().AddDate(0, 0, input.WithinDays),
+		Page:  input.Page,
+		Limit: input.Limit,
+	})
+	if listErr != nil {
+		utils.SetSpanError(ctx, listErr)
+		logger.Error("admin.users.roles.expiring.repo_error", "error", listErr)
+		return ListExpiringUserRolesOutput{}, utils.InternalError("")
+	}
+
+	items := make([]ExpiringUserRoleItem, 0, len(result.Items))
+	for _, row := range result.Items {
+		userRole := row.UserRole
+		item := ExpiringUserRoleItem{
+			UserRoleID:       userRole.GetID(),
+			UserID:           userRole.GetUserID(),
+			FullName:         row.UserFullName,
+			Email:            row.UserEmail,
+			RoleID:           userRole.GetRoleID(),
+			RoleSlug:         row.RoleSlug,
+			RoleName:         row.RoleName,
+			GrantState:       userRole.GetGrantState(),
+			ValidFrom:        userRole.GetValidFrom(),
+			ExpiryNotifiedAt: userRole.GetExpiryNotifiedAt(),
+		}
+		if expiresAt := userRole.GetExpiresAt(); expiresAt != nil {
+			item.ExpiresAt = *expiresAt
+		}
+		items = append(items, item)
+	}
+
+	return ListExpiringUserRolesOutput{
+		Items: items,
+		Total: result.Total,
+		Page:  input.Page,
+		Limit: input.Limit,
+	}, nil
+}
@@ -0,0 +1,62 @@
+package userservices
+
+import (
+	"context"
+
+	userrepository "github.com/projeto-toq/toq_server/internal/core/port/right/repository/user_repository"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ListNotifications returns a page of the authenticated user's in-app inbox, newest first (default 20, max 100 per page).
+func (us *userService) ListNotifications(ctx context.Context, input ListNotificationsInput) (ListNotificationsOutput, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return ListNotificationsOutput{}, utils.InternalError("Failed to generate tracer")
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	userID, err := us.globalService.GetUserIDFromContext(ctx)
+	if err != nil || userID == 0 {
+		return ListNotificationsOutput{}, utils.AuthenticationError("")
+	}
+
+	if input.Page <= 0 {
+		input.Page = 1
+	}
+	if input.Limit <= 0 {
+		input.Limit = 20
+	}
+	if input.Limit > 100 {
+		return ListNotificationsOutput{}, utils.ValidationError("limit", "Must be at most 100")
+	}
+
+	result, listErr := us.repo.ListInboxNotifications(ctx, nil, userrepository.InboxNotificationsFilter{
+		UserID:     userID,
+		OnlyUnread: input.OnlyUnread,
+		Page:       input.Page,
+		Limit:      input.Limit,
+	})
+	if listErr != nil {
+		utils.SetSpanError(ctx, listErr)
+		logger.Error("user.notifications.list.repo_error", "user_id", userID, "error", listErr)
+		return ListNotificationsOutput{}, utils.InternalError("")
+	}
+
+	unread, countErr := us.repo.CountUnreadInboxNotifications(ctx, nil, userID)
+	if countErr != nil {
+		utils.SetSpanError(ctx, countErr)
+		logger.Error("user.notifications.list.count_error", "user_id", userID, "error", countErr)
+		return ListNotificationsOutput{}, utils.InternalError("")
+	}
+
+	return ListNotificationsOutput{
+		Items:       result.Items,
+		Total:       result.Total,
+		UnreadCount: unread,
+		Page:        input.Page,
+		Limit:       input.Limit,
+	}, nil
+}
@@ -0,0 +1,116 @@
+package userservices
+
+import (
+	"context"
+	"time"
+
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// MarkNotificationRead marks one inbox entry of the authenticated user as read.
+//
+// Marking an entry that is already read is a no-op; entries of other users are reported as not found.
+func (us *userService) MarkNotificationRead(ctx context.Context, notificationID int64) (err error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return utils.InternalError("Failed to generate tracer")
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	userID, err := us.globalService.GetUserIDFromContext(ctx)
+	if err != nil || userID == 0 {
+		return utils.AuthenticationError("")
+	}
+	if notificationID <= 0 {
+		return utils.ValidationError("id", "Invalid notification id")
+	}
+
+	tx, txErr := us.globalService.StartTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("user.notifications.mark_read.tx_start_error", "user_id", userID, "error", txErr)
+		return utils.InternalError("")
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := us.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+				utils.SetSpanError(ctx, rbErr)
+				logger.Error("user.notifications.mark_read.tx_rollback_error", "user_id", userID, "error", rbErr)
+			}
+		}
+	}()
+
+	if markErr := us.repo.MarkInboxNotificationRead(ctx, tx, userID, notificationID, time.Now().UTC()); markErr != nil {
+		if errorsIsNoRows(markErr) {
+			err = utils.NotFoundError("Notification")
+			return err
+		}
+		utils.SetSpanError(ctx, markErr)
+		logger.Error("user.notifications.mark_read.repo_error", "user_id", userID, "notification_id", notificationID, "error", markErr)
+		err = utils.InternalError("")
+		return err
+	}
+
+	if cmErr := us.globalService.CommitTransaction(ctx, tx); cmErr != nil {
+		utils.SetSpanError(ctx, cmErr)
+		logger.Error("user.notifications.mark_read.tx_commit_error", "user_id", userID, "error", cmErr)
+		err = utils.InternalError("")
+		return err
+	}
+
+	return nil
+}
+
+// MarkAllNotificationsRead marks every unread inbox entry of the authenticated user as read.
+//
+// Returns the number of entries updated (0 when the inbox had nothing unread).
+func (us *userService) MarkAllNotificationsRead(ctx context.Context) (updated int64, err error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return 0, utils.InternalError("Failed to generate tracer")
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	userID, err := us.globalService.GetUserIDFromContext(ctx)
+	if err != nil || userID == 0 {
+		return 0, utils.AuthenticationError("")
+	}
+
+	tx, txErr := us.globalService.StartTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("user.notifications.mark_all_read.tx_start_error", "user_id", userID, "error", txErr)
+		return 0, utils.InternalError("")
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := us.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+				utils.SetSpanError(ctx, rbErr)
+				logger.Error("user.notifications.mark_all_read.tx_rollback_error", "user_id", userID, "error", rbErr)
+			}
+		}
+	}()
+
+	updated, markErr := us.repo.MarkAllInboxNotificationsRead(ctx, tx, userID, time.Now().UTC())
+	if markErr != nil {
+		utils.SetSpanError(ctx, markErr)
+		logger.Error("user.notifications.mark_all_read.repo_error", "user_id", userID, "error", markErr)
+		err = utils.InternalError("")
+		return 0, err
+	}
+
+	if cmErr := us.globalService.CommitTransaction(ctx, tx); cmErr != nil {
+		utils.SetSpanError(ctx, cmErr)
+		logger.Error("user.notifications.mark_all_read.tx_commit_error", "user_id", userID, "error", cmErr)
+		err = utils.InternalError("")
+		return 0, err
+	}
+
+	return updated, nil
+}
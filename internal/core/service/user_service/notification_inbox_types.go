@@ -0,0 +1,21 @@
+package userservices
+
+import (
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+)
+
+// ListNotificationsInput pagina a caixa de entrada do usuário autenticado.
+type ListNotificationsInput struct {
+	OnlyUnread bool
+	Page       int
+	Limit      int
+}
+
+// ListNotificationsOutput traz a página solicitada e o total de não lidas para o badge.
+type ListNotificationsOutput struct {
+	Items       []usermodel.InboxNotification
+	Total       int64
+	UnreadCount int64
+	Page        int
+	Limit       int
+}
@@ -0,0 +1,39 @@
+package userservices
+
+import (
+	"time"
+
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
+)
+
+// NotificationPreference descreve o consentimento efetivo de um canal/categoria.
+type NotificationPreference struct {
+	Channel  globalmodel.NotificationChannel
+	Category globalmodel.NotificationCategory
+	Granted  bool
+	// Mandatory indica categorias transacionais que não podem ser desativadas.
+	Mandatory bool
+	// Recorded indica se o valor vem de uma escolha registrada (false = padrão da categoria).
+	Recorded      bool
+	PolicyVersion string
+	UpdatedAt     *time.Time
+}
+
+// NotificationPreferencesOutput é a matriz completa de preferências do usuário.
+type NotificationPreferencesOutput struct {
+	CurrentPolicyVersion string
+	Preferences          []NotificationPreference
+}
+
+// NotificationPreferenceChange representa uma alteração solicitada pelo usuário.
+type NotificationPreferenceChange struct {
+	Channel  globalmodel.NotificationChannel
+	Category globalmodel.NotificationCategory
+	Granted  bool
+}
+
+// UpdateNotificationPreferencesInput agrupa as alterações e a versão da política aceita.
+type UpdateNotificationPreferencesInput struct {
+	PolicyVersion string
+	Changes       []NotificationPreferenceChange
+}
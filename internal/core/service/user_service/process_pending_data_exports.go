@@ -0,0 +1,301 @@
+package userservices
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	auditmodel "github.com/projeto-toq/toq_server/internal/core/model/audit_model"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	auditservice "github.com/projeto-toq/toq_server/internal/core/service/audit_service"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+const dataExportContentType = "application/zip"
+
+// ProcessPendingDataExports builds and delivers queued LGPD personal data exports.
+//
+// Each run:
+//  1. Requeues PROCESSING jobs older than DataExportStaleAfter (worker crashed or was stopped);
+//     jobs already claimed DataExportMaxAttempts times are marked FAILED instead
+//  2. Claims up to limit PENDING jobs (FOR UPDATE SKIP LOCKED) and marks them PROCESSING
+//  3. Streams every section in usermodel.DataExportSections into a ZIP archive uploaded under
+//     the user's storage prefix (one JSON file per section plus manifest.json)
+//  4. Marks the job COMPLETED with audit and emails the user an expiring signed link to the archive
+//
+// A job that fails to build or upload is marked FAILED with the error message; the user may
+// request a new export afterwards.
+func (us *userService) ProcessPendingDataExports(ctx context.Context, limit int) (DataExportProcessResult, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return DataExportProcessResult{}, derrors.Infra("trace", err)
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	if limit <= 0 {
+		limit = 10
+	}
+
+	var result DataExportProcessResult
+
+	jobs, claimErr := us.claimPendingDataExports(ctx, limit)
+	if claimErr != nil {
+		utils.SetSpanError(ctx, claimErr)
+		logger.Error("user.data_export.process.claim_error", "err", claimErr)
+		return result, derrors.Infra("claim pending data exports", claimErr)
+	}
+
+	for _, job := range jobs {
+		if processErr := us.processDataExport(ctx, job); processErr != nil {
+			logger.Warn("user.data_export.process.job_failed", "export_id", job.GetID(), "user_id", job.GetUserID(), "err", processErr)
+			us.failDataExport(ctx, job, processErr)
+			result.Failed++
+			continue
+		}
+		result.Completed++
+	}
+
+	if result.Completed > 0 || result.Failed > 0 {
+		logger.Info("user.data_export.process.processed", "completed", result.Completed, "failed", result.Failed)
+	}
+	return result, nil
+}
+
+// claimPendingDataExports reclaims stale jobs and moves a batch of PENDING jobs to PROCESSING in a single transaction.
+func (us *userService) claimPendingDataExports(ctx context.Context, limit int) (jobs []usermodel.DataExportInterface, err error) {
+	logger := utils.LoggerFromContext(ctx)
+
+	tx, err := us.globalService.StartTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = us.globalService.RollbackTransaction(ctx, tx)
+		}
+	}()
+
+	now := time.Now().UTC()
+	requeued, failed, err := us.repo.ReclaimStaleDataExports(ctx, tx, now.Add(-us.cfg.DataExportStaleAfter), us.cfg.DataExportMaxAttempts)
+	if err != nil {
+		return nil, err
+	}
+	if requeued > 0 || failed > 0 {
+		logger.Warn("user.data_export.process.stale_reclaimed", "requeued", requeued, "failed", failed)
+	}
+
+	jobs, err = us.repo.ListPendingDataExports(ctx, tx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, job := range jobs {
+		job.SetStatus(usermodel.DataExportStatusProcessing)
+		job.SetAttempts(job.GetAttempts() + 1)
+		job.SetStartedAt(&now)
+		if err = us.repo.UpdateDataExport(ctx, tx, job); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = us.globalService.CommitTransaction(ctx, tx); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// processDataExport builds, uploads and delivers a single export job.
+func (us *userService) processDataExport(ctx context.Context, job usermodel.DataExportInterface) (err error) {
+	logger := utils.LoggerFromContext(ctx)
+
+	objectName := fmt.Sprintf("exports/data-export-%d.zip", job.GetID())
+	objectKey, size, err := us.uploadDataExportArchive(ctx, job, objectName)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	expiresAt := now.Add(us.cfg.DataExportURLTTL)
+	job.SetStatus(usermodel.DataExportStatusCompleted)
+	job.SetObjectKey(objectKey)
+	job.SetErrorMessage("")
+	job.SetCompletedAt(&now)
+	job.SetExpiresAt(&expiresAt)
+
+	tx, err := us.globalService.StartTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = us.globalService.RollbackTransaction(ctx, tx)
+		}
+	}()
+
+	if err = us.repo.UpdateDataExport(ctx, tx, job); err != nil {
+		return err
+	}
+
+	auditRecord := auditservice.BuildRecordFromContext(
+		ctx,
+		job.GetUserID(),
+		auditmodel.AuditTarget{Type: auditmodel.TargetUserDataExport, ID: job.GetID()},
+		auditmodel.OperationDataExport,
+		map[string]any{
+			"action":     "completed",
+			"object_key": objectKey,
+			"size_bytes": size,
+			"expires_at": expiresAt,
+		},
+	)
+	if err = us.auditService.RecordChange(ctx, tx, auditRecord); err != nil {
+		return err
+	}
+
+	if err = us.globalService.CommitTransaction(ctx, tx); err != nil {
+		return err
+	}
+
+	if emailErr := us.sendDataExportReadyEmail(ctx, job); emailErr != nil {
+		utils.SetSpanError(ctx, emailErr)
+		logger.Error("user.data_export.process.email_error", "export_id", job.GetID(), "user_id", job.GetUserID(), "err", emailErr)
+	}
+
+	return nil
+}
+
+// uploadDataExportArchive streams the ZIP archive to storage while it is built; returns the object key and archive size.
+func (us *userService) uploadDataExportArchive(ctx context.Context, job usermodel.DataExportInterface, objectName string) (string, int64, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pipeReader, pipeWriter := io.Pipe()
+	counter := &dataExportSizeCounter{}
+
+	writeDone := make(chan error, 1)
+	go func() {
+		err := us.writeDataExportArchive(ctx, job, io.MultiWriter(pipeWriter, counter))
+		pipeWriter.CloseWithError(err)
+		writeDone <- err
+	}()
+
+	objectKey, uploadErr := us.cloudStorageService.UploadUserObject(ctx, job.GetUserID(), objectName, pipeReader, dataExportContentType)
+	if uploadErr != nil {
+		// Unblock the writer if the upload gave up before consuming the whole stream.
+		pipeReader.CloseWithError(uploadErr)
+		cancel()
+	}
+	writeErr := <-writeDone
+
+	// A writer failure aborts the pipe, so the upload usually fails with the same error: report the root cause.
+	if writeErr != nil && (uploadErr == nil || errors.Is(uploadErr, writeErr)) {
+		return "", 0, fmt.Errorf("build archive: %w", writeErr)
+	}
+	if uploadErr != nil {
+		return "", 0, fmt.Errorf("upload archive: %w", uploadErr)
+	}
+	return objectKey, counter.n, nil
+}
+
+// dataExportSizeCounter counts the archive bytes streamed to storage.
+type dataExportSizeCounter struct {
+	n int64
+}
+
+func (c *dataExportSizeCounter) Write(p []byte) (int, error) {
+	c.n += int64(len(p))
+	return len(p), nil
+}
+
+// writeDataExportArchive collects all personal data sections into a ZIP archive written to out.
+func (us *userService) writeDataExportArchive(ctx context.Context, job usermodel.DataExportInterface, out io.Writer) error {
+	type manifestSection struct {
+		Name    usermodel.DataExportSection `json:"name"`
+		File    string                      `json:"file"`
+		Records int                         `json:"records"`
+	}
+	manifest := struct {
+		ExportID    int64             `json:"exportId"`
+		UserID      int64             `json:"userId"`
+		RequestedAt time.Time         `json:"requestedAt"`
+		GeneratedAt time.Time         `json:"generatedAt"`
+		Sections    []manifestSection `json:"sections"`
+	}{
+		ExportID:    job.GetID(),
+		UserID:      job.GetUserID(),
+		RequestedAt: job.GetRequestedAt(),
+		GeneratedAt: time.Now().UTC(),
+	}
+
+	zw := zip.NewWriter(out)
+
+	for _, section := range usermodel.DataExportSections {
+		records, err := us.repo.ListUserDataExportRecords(ctx, nil, job.GetUserID(), section)
+		if err != nil {
+			return fmt.Errorf("collect section %s: %w", section, err)
+		}
+
+		fileName := string(section) + ".json"
+		if err := writeDataExportJSON(zw, fileName, records); err != nil {
+			return err
+		}
+		manifest.Sections = append(manifest.Sections, manifestSection{Name: section, File: fileName, Records: len(records)})
+	}
+
+	if err := writeDataExportJSON(zw, "manifest.json", manifest); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("close zip: %w", err)
+	}
+	return nil
+}
+
+func writeDataExportJSON(zw *zip.Writer, name string, payload any) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("create %s: %w", name, err)
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(payload); err != nil {
+		return fmt.Errorf("encode %s: %w", name, err)
+	}
+	return nil
+}
+
+// failDataExport marks the job FAILED; errors are only logged since the worker moves on.
+func (us *userService) failDataExport(ctx context.Context, job usermodel.DataExportInterface, cause error) {
+	logger := utils.LoggerFromContext(ctx)
+
+	message := cause.Error()
+	if len(message) > 500 {
+		message = message[:500]
+	}
+	now := time.Now().UTC()
+	job.SetStatus(usermodel.DataExportStatusFailed)
+	job.SetErrorMessage(message)
+	job.SetCompletedAt(&now)
+
+	tx, err := us.globalService.StartTransaction(ctx)
+	if err != nil {
+		logger.Error("user.data_export.process.fail_tx_start_error", "export_id", job.GetID(), "err", err)
+		return
+	}
+	if err = us.repo.UpdateDataExport(ctx, tx, job); err != nil {
+		_ = us.globalService.RollbackTransaction(ctx, tx)
+		logger.Error("user.data_export.process.fail_update_error", "export_id", job.GetID(), "err", err)
+		return
+	}
+	if err = us.globalService.CommitTransaction(ctx, tx); err != nil {
+		logger.Error("user.data_export.process.fail_tx_commit_error", "export_id", job.GetID(), "err", err)
+	}
+}
@@ -0,0 +1,156 @@
+package userservices
+
+import (
+	"context"
+	"time"
+
+	"github.com/projeto-toq/toq_server/internal/core/derrors"
+	auditmodel "github.com/projeto-toq/toq_server/internal/core/model/audit_model"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	userrepository "github.com/projeto-toq/toq_server/internal/core/port/right/repository/user_repository"
+	auditservice "github.com/projeto-toq/toq_server/internal/core/service/audit_service"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ProcessRoleGrantSchedule advances time-bound role grants and sends pre-expiration notices.
+//
+// Each run:
+//  1. Lists grants whose valid_from/expires_at window changed state and persists the new
+//     grant_state (SCHEDULED → EFFECTIVE, any → EXPIRED), one transaction per grant with audit
+//  2. Invalidates the permission cache of affected users after commit
+//  3. Emails users whose grants expire within noticeWindow and marks expiry_notified_at
+//
+// Permission checks never depend on this worker (queries filter valid_from/expires_at directly);
+// it only keeps caches, audit trail and notifications in sync with the schedule.
+func (us *userService) ProcessRoleGrantSchedule(ctx context.Context, noticeWindow time.Duration, limit int) (RoleGrantScheduleResult, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return RoleGrantScheduleResult{}, derrors.Infra("trace", err)
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	if limit <= 0 {
+		limit = 200
+	}
+
+	var result RoleGrantScheduleResult
+
+	pending, listErr := us.repo.ListUserRolesPendingGrantTransition(ctx, nil, limit)
+	if listErr != nil {
+		utils.SetSpanError(ctx, listErr)
+		logger.Error("user.role_grant_schedule.list_transitions_error", "err", listErr)
+		return result, derrors.Infra("list role grant transitions", listErr)
+	}
+
+	now := time.Now().UTC()
+	for _, userRole := range pending {
+		next := usermodel.RoleGrantStateEffective
+		if expiresAt := userRole.GetExpiresAt(); expiresAt != nil && !expiresAt.After(now) {
+			next = usermodel.RoleGrantStateExpired
+		}
+
+		if applyErr := us.applyRoleGrantState(ctx, userRole, next); applyErr != nil {
+			logger.Warn("user.role_grant_schedule.transition_failed", "user_role_id", userRole.GetID(), "user_id", userRole.GetUserID(), "err", applyErr)
+			continue
+		}
+
+		us.permissionService.InvalidateUserCacheSafe(ctx, userRole.GetUserID(), "role_grant_schedule")
+		if next == usermodel.RoleGrantStateExpired {
+			result.Expired++
+		} else {
+			result.Activated++
+		}
+	}
+
+	if noticeWindow > 0 {
+		result.Notified = us.sendPendingRoleGrantExpiryNotices(ctx, now.Add(noticeWindow), limit)
+	}
+
+	if result.Activated > 0 || result.Expired > 0 || result.Notified > 0 {
+		logger.Info("user.role_grant_schedule.processed", "activated", result.Activated, "expired", result.Expired, "notified", result.Notified)
+	}
+	return result, nil
+}
+
+// applyRoleGrantState persists the grant_state flip and its audit record atomically.
+func (us *userService) applyRoleGrantState(ctx context.Context, userRole usermodel.UserRoleInterface, next usermodel.RoleGrantState) (err error) {
+	tx, err := us.globalService.StartTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = us.globalService.RollbackTransaction(ctx, tx)
+		}
+	}()
+
+	if err = us.repo.UpdateUserRoleGrantState(ctx, tx, userRole.GetID(), next); err != nil {
+		return err
+	}
+
+	auditRecord := auditservice.BuildRecordFromContext(
+		ctx,
+		userRole.GetUserID(),
+		auditmodel.AuditTarget{Type: auditmodel.TargetUserRole, ID: userRole.GetID()},
+		auditmodel.OperationStatusChange,
+		map[string]any{
+			"action":         "role_grant_schedule",
+			"role_id":        userRole.GetRoleID(),
+			"previous_state": userRole.GetGrantState().String(),
+			"new_state":      next.String(),
+			"valid_from":     userRole.GetValidFrom(),
+			"expires_at":     userRole.GetExpiresAt(),
+			"processed_at":   time.Now().UTC(),
+		},
+	)
+	if err = us.auditService.RecordChange(ctx, tx, auditRecord); err != nil {
+		return err
+	}
+
+	return us.globalService.CommitTransaction(ctx, tx)
+}
+
+// sendPendingRoleGrantExpiryNotices notifies users of grants expiring before until; returns how many were sent.
+func (us *userService) sendPendingRoleGrantExpiryNotices(ctx context.Context, until time.Time, limit int) int {
+	logger := utils.LoggerFromContext(ctx)
+
+	expiring, err := us.repo.ListExpiringUserRoles(ctx, nil, userrepository.ExpiringUserRolesFilter{
+		Until:           until,
+		OnlyNotNotified: true,
+		Limit:           limit,
+	})
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("user.role_grant_schedule.list_expiring_error", "err", err)
+		return 0
+	}
+
+	sent := 0
+	for _, grant := range expiring.Items {
+		userRoleID := grant.UserRole.GetID()
+		if notifyErr := us.sendRoleGrantExpiryNotice(ctx, grant); notifyErr != nil {
+			logger.Warn("user.role_grant_schedule.notice_failed", "user_role_id", userRoleID, "err", notifyErr)
+			continue
+		}
+
+		tx, txErr := us.globalService.StartTransaction(ctx)
+		if txErr != nil {
+			logger.Error("user.role_grant_schedule.tx_start_error", "user_role_id", userRoleID, "err", txErr)
+			continue
+		}
+		if markErr := us.repo.MarkUserRoleExpiryNotified(ctx, tx, userRoleID, time.Now().UTC()); markErr != nil {
+			_ = us.globalService.RollbackTransaction(ctx, tx)
+			logger.Error("user.role_grant_schedule.mark_notified_error", "user_role_id", userRoleID, "err", markErr)
+			continue
+		}
+		if cmErr := us.globalService.CommitTransaction(ctx, tx); cmErr != nil {
+			logger.Error("user.role_grant_schedule.tx_commit_error", "user_role_id", userRoleID, "err", cmErr)
+			continue
+		}
+		sent++
+	}
+	return sent
+}
@@ -81,5 +81,7 @@ func (us *userService) pushOptIn(ctx context.Context, tx *sql.Tx, userID int64)
 		return
 	}
 
+	err = us.syncLegacyPushConsents(ctx, tx, userID, true, "push_optin")
+
 	return
 }
@@ -83,5 +83,7 @@ func (us *userService) pushOptOut(ctx context.Context, tx *sql.Tx, userID int64)
 		utils.LoggerFromContext(ctx).Error("user.push_optout.audit_error", "error", err, "user_id", userID)
 		return
 	}
+
+	err = us.syncLegacyPushConsents(ctx, tx, userID, false, "push_optout")
 	return
 }
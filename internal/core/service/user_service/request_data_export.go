@@ -0,0 +1,102 @@
+package userservices
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	auditmodel "github.com/projeto-toq/toq_server/internal/core/model/audit_model"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	auditservice "github.com/projeto-toq/toq_server/internal/core/service/audit_service"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// RequestDataExport enqueues an asynchronous LGPD personal data export for the authenticated user.
+//
+// Only one export may be open (PENDING/PROCESSING) at a time; a second request returns 409.
+// The archive is built by the data export worker and delivered by email as an expiring link.
+func (us *userService) RequestDataExport(ctx context.Context) (output DataExportStatusOutput, err error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return output, utils.InternalError("Failed to generate tracer")
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	userID, err := us.globalService.GetUserIDFromContext(ctx)
+	if err != nil || userID == 0 {
+		return output, utils.AuthenticationError("")
+	}
+
+	tx, txErr := us.globalService.StartTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("user.data_export.request.tx_start_error", "user_id", userID, "error", txErr)
+		return output, utils.InternalError("Failed to start transaction")
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := us.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+				utils.SetSpanError(ctx, rbErr)
+				logger.Error("user.data_export.request.tx_rollback_error", "user_id", userID, "error", rbErr)
+			}
+		}
+	}()
+
+	latest, latestErr := us.repo.GetLatestDataExportByUserID(ctx, tx, userID)
+	if latestErr != nil && !errors.Is(latestErr, sql.ErrNoRows) {
+		utils.SetSpanError(ctx, latestErr)
+		logger.Error("user.data_export.request.get_latest_error", "user_id", userID, "error", latestErr)
+		err = utils.InternalError("")
+		return output, err
+	}
+	if latest != nil && latest.GetStatus().IsOpen() {
+		err = utils.ConflictError("A data export is already in progress")
+		return output, err
+	}
+
+	export := usermodel.NewDataExport()
+	export.SetUserID(userID)
+	export.SetStatus(usermodel.DataExportStatusPending)
+	export.SetRequestedAt(time.Now().UTC())
+
+	if err = us.repo.CreateDataExport(ctx, tx, export); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("user.data_export.request.create_error", "user_id", userID, "error", err)
+		err = utils.InternalError("")
+		return output, err
+	}
+
+	auditRecord := auditservice.BuildRecordFromContext(
+		ctx,
+		userID,
+		auditmodel.AuditTarget{Type: auditmodel.TargetUserDataExport, ID: export.GetID()},
+		auditmodel.OperationDataExport,
+		map[string]any{
+			"action": "requested",
+		},
+	)
+	if err = us.auditService.RecordChange(ctx, tx, auditRecord); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("user.data_export.request.audit_error", "user_id", userID, "error", err)
+		err = utils.InternalError("")
+		return output, err
+	}
+
+	if err = us.globalService.CommitTransaction(ctx, tx); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("user.data_export.request.tx_commit_error", "user_id", userID, "error", err)
+		err = utils.InternalError("Failed to commit transaction")
+		return output, err
+	}
+
+	logger.Info("user.data_export.requested", "user_id", userID, "export_id", export.GetID())
+	return DataExportStatusOutput{
+		ID:          export.GetID(),
+		Status:      export.GetStatus(),
+		RequestedAt: export.GetRequestedAt(),
+	}, nil
+}
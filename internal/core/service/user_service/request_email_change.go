@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
 	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
 	globalservice "github.com/projeto-toq/toq_server/internal/core/service/global_service"
 	"github.com/projeto-toq/toq_server/internal/core/utils"
@@ -70,10 +71,13 @@ func (us *userService) RequestEmailChange(ctx context.Context, newEmail string)
 	// Enviar notificação (assíncrono pelo serviço unificado)
 	notificationService := us.globalService.GetUnifiedNotificationService()
 	emailRequest := globalservice.NotificationRequest{
-		Type:    globalservice.NotificationTypeEmail,
-		To:      validation.GetNewEmail(),
-		Subject: "TOQ - Confirmação de Alteração de Email",
-		Body:    "Seu código de validação para alteração de email é: " + validation.GetEmailCode(),
+		Type:      globalservice.NotificationTypeEmail,
+		UserID:    user.GetID(),
+		Category:  globalmodel.NotificationCategoryTransactional,
+		SkipInbox: true,
+		To:        validation.GetNewEmail(),
+		Subject:   "TOQ - Confirmação de Alteração de Email",
+		Body:      "Seu código de validação para alteração de email é: " + validation.GetEmailCode(),
 	}
 
 	notifyErr := notificationService.SendNotification(ctx, emailRequest)
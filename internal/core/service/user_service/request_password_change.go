@@ -6,6 +6,7 @@ import (
 	"errors"
 	"time"
 
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
 	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
 	globalservice "github.com/projeto-toq/toq_server/internal/core/service/global_service"
 	"github.com/projeto-toq/toq_server/internal/core/utils"
@@ -63,10 +64,13 @@ func (us *userService) RequestPasswordChange(ctx context.Context, nationalID str
 	// Send notification after commit
 	notificationService := us.globalService.GetUnifiedNotificationService()
 	emailRequest := globalservice.NotificationRequest{
-		Type:    globalservice.NotificationTypeEmail,
-		To:      user.GetEmail(),
-		Subject: "TOQ - Password Reset",
-		Body:    "Your password reset code is: " + validation.GetPasswordCode(),
+		Type:      globalservice.NotificationTypeEmail,
+		UserID:    user.GetID(),
+		Category:  globalmodel.NotificationCategoryTransactional,
+		SkipInbox: true,
+		To:        user.GetEmail(),
+		Subject:   "TOQ - Password Reset",
+		Body:      "Your password reset code is: " + validation.GetPasswordCode(),
 	}
 
 	if notifyErr := notificationService.SendNotification(ctx, emailRequest); notifyErr != nil {
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"time"
 
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
 	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
 	globalservice "github.com/projeto-toq/toq_server/internal/core/service/global_service"
 
@@ -76,9 +77,12 @@ func (us *userService) RequestPhoneChange(ctx context.Context, newPhone string)
 	// Usar o sistema unificado de notificação
 	notificationService := us.globalService.GetUnifiedNotificationService()
 	smsRequest := globalservice.NotificationRequest{
-		Type: globalservice.NotificationTypeSMS,
-		To:   validation.GetNewPhone(),
-		Body: "TOQ - Seu código de validação: " + validation.GetPhoneCode(),
+		Type:      globalservice.NotificationTypeSMS,
+		UserID:    user.GetID(),
+		Category:  globalmodel.NotificationCategoryTransactional,
+		SkipInbox: true,
+		To:        validation.GetNewPhone(),
+		Body:      "TOQ - Seu código de validação: " + validation.GetPhoneCode(),
 	}
 
 	notifyErr := notificationService.SendNotification(ctx, smsRequest)
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"time"
 
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
 	globalservice "github.com/projeto-toq/toq_server/internal/core/service/global_service"
 	"github.com/projeto-toq/toq_server/internal/core/utils"
 )
@@ -58,10 +59,13 @@ func (us *userService) ResendEmailChangeCode(ctx context.Context) (err error) {
 	// Após o commit, enviar a notificação por e-mail com o novo código
 	notificationService := us.globalService.GetUnifiedNotificationService()
 	emailRequest := globalservice.NotificationRequest{
-		Type:    globalservice.NotificationTypeEmail,
-		To:      userEmail,
-		Subject: "TOQ - Código de alteração de email",
-		Body:    "Seu código de validação para alteração de email é: " + code,
+		Type:      globalservice.NotificationTypeEmail,
+		UserID:    userID,
+		Category:  globalmodel.NotificationCategoryTransactional,
+		SkipInbox: true,
+		To:        userEmail,
+		Subject:   "TOQ - Código de alteração de email",
+		Body:      "Seu código de validação para alteração de email é: " + code,
 	}
 	if notifyErr := notificationService.SendNotification(ctx, emailRequest); notifyErr != nil {
 		utils.SetSpanError(ctx, notifyErr)
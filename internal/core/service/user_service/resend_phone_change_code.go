@@ -6,6 +6,7 @@ import (
 	"errors"
 	"time"
 
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
 	globalservice "github.com/projeto-toq/toq_server/internal/core/service/global_service"
 	"github.com/projeto-toq/toq_server/internal/core/utils"
 )
@@ -57,9 +58,12 @@ func (us *userService) ResendPhoneChangeCode(ctx context.Context) (err error) {
 	// After commit, send SMS with the new code
 	notificationService := us.globalService.GetUnifiedNotificationService()
 	smsRequest := globalservice.NotificationRequest{
-		Type: globalservice.NotificationTypeSMS,
-		To:   destPhone,
-		Body: "TOQ - Seu código de validação: " + code,
+		Type:      globalservice.NotificationTypeSMS,
+		UserID:    userID,
+		Category:  globalmodel.NotificationCategoryTransactional,
+		SkipInbox: true,
+		To:        destPhone,
+		Body:      "TOQ - Seu código de validação: " + code,
 	}
 	if notifyErr := notificationService.SendNotification(ctx, smsRequest); notifyErr != nil {
 		utils.SetSpanError(ctx, notifyErr)
@@ -0,0 +1,68 @@
+package userservices
+
+import (
+	"bytes"
+	"context"
+	"html/template"
+	"sync"
+
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
+	userrepository "github.com/projeto-toq/toq_server/internal/core/port/right/repository/user_repository"
+	globalservice "github.com/projeto-toq/toq_server/internal/core/service/global_service"
+)
+
+const roleGrantExpiryTemplatePath = "internal/core/templates/email_role_grant_expiring.html"
+
+// roleGrantExpiryEmailRenderer renders the role expiration notice template lazily.
+type roleGrantExpiryEmailRenderer struct {
+	once sync.Once
+	tmpl *template.Template
+	err  error
+}
+
+var roleGrantExpiryRenderer = &roleGrantExpiryEmailRenderer{}
+
+func (r *roleGrantExpiryEmailRenderer) render(data any) (string, error) {
+	r.once.Do(func() {
+		r.tmpl, r.err = template.ParseFiles(roleGrantExpiryTemplatePath)
+	})
+	if r.err != nil {
+		return "", r.err
+	}
+	var buf bytes.Buffer
+	if err := r.tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// sendRoleGrantExpiryNotice emails the user that one of their time-bound roles is about to expire.
+func (us *userService) sendRoleGrantExpiryNotice(ctx context.Context, grant userrepository.ExpiringUserRole) error {
+	expiresAt := grant.UserRole.GetExpiresAt()
+	if expiresAt == nil {
+		return nil
+	}
+
+	name := grant.UserNickName
+	if name == "" {
+		name = grant.UserFullName
+	}
+
+	body, err := roleGrantExpiryRenderer.render(map[string]any{
+		"NickName":  name,
+		"RoleName":  grant.RoleName,
+		"ExpiresAt": expiresAt.Format("02/01/2006 15:04 MST"),
+	})
+	if err != nil {
+		return err
+	}
+
+	return us.globalService.GetUnifiedNotificationService().SendNotification(ctx, globalservice.NotificationRequest{
+		Type:     globalservice.NotificationTypeEmail,
+		To:       grant.UserEmail,
+		Subject:  "TOQ - Seu acesso está prestes a expirar",
+		Body:     body,
+		UserID:   grant.UserRole.GetUserID(),
+		Category: globalmodel.NotificationCategoryTransactional,
+	})
+}
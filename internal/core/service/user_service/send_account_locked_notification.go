@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
 	globalservice "github.com/projeto-toq/toq_server/internal/core/service/global_service"
 	"github.com/projeto-toq/toq_server/internal/core/utils"
 )
@@ -118,10 +119,12 @@ func (us *userService) sendAccountLockedNotification(_ context.Context, userID i
 
 	// Prepare notification request
 	notificationReq := globalservice.NotificationRequest{
-		Type:    globalservice.NotificationTypeEmail,
-		To:      user.GetEmail(),
-		Subject: "TOQ - Alerta de Segurança",
-		Body:    body,
+		Type:     globalservice.NotificationTypeEmail,
+		To:       user.GetEmail(),
+		Subject:  "TOQ - Alerta de Segurança",
+		Body:     body,
+		UserID:   userID,
+		Category: globalmodel.NotificationCategoryTransactional,
 	}
 
 	// Send notification asynchronously (fire-and-forget)
@@ -0,0 +1,104 @@
+package userservices
+
+import (
+	"context"
+	"database/sql"
+
+	auditmodel "github.com/projeto-toq/toq_server/internal/core/model/audit_model"
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	auditservice "github.com/projeto-toq/toq_server/internal/core/service/audit_service"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+const legacyOptStatusConsentSource = "legacy_opt_status"
+
+// syncLegacyPushConsents mirrors a legacy push opt-in/opt-out (users.opt_status) onto the push
+// consents of the preference center, so both keep giving the same answer.
+//
+// Opting out revokes every optional push category; opting in grants the service categories
+// but leaves marketing untouched, since it requires an explicit choice.
+func (us *userService) syncLegacyPushConsents(ctx context.Context, tx *sql.Tx, userID int64, granted bool, trigger string) error {
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	for _, category := range globalmodel.NotificationCategories {
+		if category.IsMandatory() || (granted && category == globalmodel.NotificationCategoryMarketing) {
+			continue
+		}
+
+		consent := usermodel.NotificationConsent{
+			UserID:        userID,
+			Channel:       globalmodel.NotificationChannelPush,
+			Category:      category,
+			Granted:       granted,
+			PolicyVersion: us.cfg.ConsentPolicyVersion,
+			Source:        legacyOptStatusConsentSource,
+		}
+		if err := us.repo.UpsertNotificationConsent(ctx, tx, consent); err != nil {
+			utils.SetSpanError(ctx, err)
+			logger.Error("user.legacy_push_consents.upsert_error", "user_id", userID, "category", category, "error", err)
+			return err
+		}
+
+		auditRecord := auditservice.BuildRecordFromContext(
+			ctx,
+			userID,
+			auditmodel.AuditTarget{Type: auditmodel.TargetUserConsent, ID: userID},
+			auditmodel.OperationConsentChange,
+			map[string]any{
+				"channel":        string(globalmodel.NotificationChannelPush),
+				"category":       string(category),
+				"granted":        granted,
+				"policy_version": us.cfg.ConsentPolicyVersion,
+				"source":         legacyOptStatusConsentSource,
+				"trigger":        trigger,
+			},
+		)
+		if err := us.auditService.RecordChange(ctx, tx, auditRecord); err != nil {
+			utils.SetSpanError(ctx, err)
+			logger.Error("user.legacy_push_consents.audit_error", "user_id", userID, "category", category, "error", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// enableLegacyPushOptIn turns users.opt_status on when a push category is granted in the
+// preference center; otherwise device tokens stay filtered out and the grant has no effect.
+// Revocations leave the flag alone because transactional pushes still depend on it.
+func (us *userService) enableLegacyPushOptIn(ctx context.Context, tx *sql.Tx, userID int64) error {
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	user, err := us.repo.GetUserByID(ctx, tx, userID)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("user.legacy_push_optin.read_user_error", "user_id", userID, "error", err)
+		return err
+	}
+	if user.IsOptStatus() {
+		return nil
+	}
+
+	user.SetOptStatus(true)
+	if err = us.repo.UpdateUserByID(ctx, tx, user); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("user.legacy_push_optin.update_user_error", "user_id", userID, "error", err)
+		return err
+	}
+
+	auditRecord := auditservice.BuildRecordFromContext(
+		ctx,
+		userID,
+		auditmodel.AuditTarget{Type: auditmodel.TargetUser, ID: userID},
+		auditmodel.OperationUpdate,
+		map[string]any{"opt_status": true, "trigger": "notification_preferences"},
+	)
+	if err = us.auditService.RecordChange(ctx, tx, auditRecord); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("user.legacy_push_optin.audit_error", "user_id", userID, "error", err)
+		return err
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"html/template"
 	"sync"
 
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
 	permissionmodel "github.com/projeto-toq/toq_server/internal/core/model/permission_model"
 	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
 	globalservice "github.com/projeto-toq/toq_server/internal/core/service/global_service"
@@ -55,10 +56,12 @@ func (us *userService) sendSystemUserWelcomeEmail(ctx context.Context, user user
 
 	notificationService := us.globalService.GetUnifiedNotificationService()
 	emailRequest := globalservice.NotificationRequest{
-		Type:    globalservice.NotificationTypeEmail,
-		To:      user.GetEmail(),
-		Subject: "TOQ - Welcome",
-		Body:    body,
+		Type:     globalservice.NotificationTypeEmail,
+		To:       user.GetEmail(),
+		Subject:  "TOQ - Welcome",
+		Body:     body,
+		UserID:   user.GetID(),
+		Category: globalmodel.NotificationCategoryTransactional,
 	}
 
 	if notifyErr := notificationService.SendNotification(ctx, emailRequest); notifyErr != nil {
@@ -57,6 +57,9 @@ type CreateSystemUserInput struct {
 	RoleSlug    permissionmodel.RoleSlug
 	ZipCode     string
 	Number      string
+	// ValidFrom/ValidUntil delimitam a vigência do role (nil = imediato/sem expiração).
+	ValidFrom  *time.Time
+	ValidUntil *time.Time
 }
 
 // UpdateSystemUserInput representa os dados editáveis de um usuário de sistema.
@@ -65,6 +68,9 @@ type UpdateSystemUserInput struct {
 	FullName    string
 	Email       string
 	PhoneNumber string
+	// ValidFrom/ValidUntil redefinem a vigência do role (nil = mantém o valor atual).
+	ValidFrom  *time.Time
+	ValidUntil *time.Time
 }
 
 // DeleteSystemUserInput representa o alvo de exclusão lógica de um usuário de sistema.
@@ -79,3 +85,40 @@ type SystemUserResult struct {
 	RoleSlug permissionmodel.RoleSlug
 	Email    string
 }
+
+// ListExpiringUserRolesInput filtra concessões de role com expiração próxima.
+type ListExpiringUserRolesInput struct {
+	WithinDays int
+	Page       int
+	Limit      int
+}
+
+// ExpiringUserRoleItem descreve uma concessão de role prestes a expirar.
+type ExpiringUserRoleItem struct {
+	UserRoleID       int64
+	UserID           int64
+	FullName         string
+	Email            string
+	RoleID           int64
+	RoleSlug         string
+	RoleName         string
+	GrantState       usermodel.RoleGrantState
+	ValidFrom        *time.Time
+	ExpiresAt        time.Time
+	ExpiryNotifiedAt *time.Time
+}
+
+// ListExpiringUserRolesOutput agrega concessões a expirar com metadados de paginação.
+type ListExpiringUserRolesOutput struct {
+	Items []ExpiringUserRoleItem
+	Total int64
+	Page  int
+	Limit int
+}
+
+// RoleGrantScheduleResult resume uma execução do agendador de concessões de role.
+type RoleGrantScheduleResult struct {
+	Activated int
+	Expired   int
+	Notified  int
+}
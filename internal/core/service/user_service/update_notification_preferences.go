@@ -0,0 +1,145 @@
+package userservices
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	auditmodel "github.com/projeto-toq/toq_server/internal/core/model/audit_model"
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
+	auditservice "github.com/projeto-toq/toq_server/internal/core/service/audit_service"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+const notificationConsentSource = "preference_center"
+
+// UpdateNotificationPreferences records the authenticated user's consent changes.
+//
+// Every change is stored against the accepted policy version (which must be the current one)
+// and audited, giving a timestamped consent history. Transactional categories cannot be revoked.
+// Granting a push category also turns the legacy push opt-in (users.opt_status) back on.
+func (us *userService) UpdateNotificationPreferences(ctx context.Context, input UpdateNotificationPreferencesInput) (output NotificationPreferencesOutput, err error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return output, utils.InternalError("Failed to generate tracer")
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	userID, err := us.globalService.GetUserIDFromContext(ctx)
+	if err != nil || userID == 0 {
+		return output, utils.AuthenticationError("")
+	}
+
+	if len(input.Changes) == 0 {
+		return output, utils.ValidationError("preferences", "At least one preference is required")
+	}
+
+	policyVersion := strings.TrimSpace(input.PolicyVersion)
+	if policyVersion == "" {
+		policyVersion = us.cfg.ConsentPolicyVersion
+	}
+	if policyVersion != us.cfg.ConsentPolicyVersion {
+		return output, utils.ValidationError("policyVersion", fmt.Sprintf("Consent must reference the current policy version (%s)", us.cfg.ConsentPolicyVersion))
+	}
+
+	for i, change := range input.Changes {
+		field := fmt.Sprintf("preferences[%d]", i)
+		if !change.Channel.IsValid() {
+			return output, utils.ValidationError(field+".channel", "Invalid notification channel")
+		}
+		if !change.Category.IsValid() {
+			return output, utils.ValidationError(field+".category", "Invalid notification category")
+		}
+		if change.Category.IsMandatory() && !change.Granted {
+			return output, utils.ValidationError(field+".category", "Transactional notifications cannot be disabled")
+		}
+	}
+
+	tx, txErr := us.globalService.StartTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("user.notification_preferences.update.tx_start_error", "user_id", userID, "error", txErr)
+		return output, utils.InternalError("Failed to start transaction")
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := us.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+				utils.SetSpanError(ctx, rbErr)
+				logger.Error("user.notification_preferences.update.tx_rollback_error", "user_id", userID, "error", rbErr)
+			}
+		}
+	}()
+
+	for _, change := range input.Changes {
+		if change.Category.IsMandatory() {
+			continue
+		}
+
+		consent := usermodel.NotificationConsent{
+			UserID:        userID,
+			Channel:       change.Channel,
+			Category:      change.Category,
+			Granted:       change.Granted,
+			PolicyVersion: policyVersion,
+			Source:        notificationConsentSource,
+		}
+		if err = us.repo.UpsertNotificationConsent(ctx, tx, consent); err != nil {
+			utils.SetSpanError(ctx, err)
+			logger.Error("user.notification_preferences.update.upsert_error", "user_id", userID, "channel", change.Channel, "category", change.Category, "error", err)
+			err = utils.InternalError("")
+			return output, err
+		}
+
+		auditRecord := auditservice.BuildRecordFromContext(
+			ctx,
+			userID,
+			auditmodel.AuditTarget{Type: auditmodel.TargetUserConsent, ID: userID},
+			auditmodel.OperationConsentChange,
+			map[string]any{
+				"channel":        string(change.Channel),
+				"category":       string(change.Category),
+				"granted":        change.Granted,
+				"policy_version": policyVersion,
+				"source":         notificationConsentSource,
+			},
+		)
+		if err = us.auditService.RecordChange(ctx, tx, auditRecord); err != nil {
+			utils.SetSpanError(ctx, err)
+			logger.Error("user.notification_preferences.update.audit_error", "user_id", userID, "error", err)
+			err = utils.InternalError("")
+			return output, err
+		}
+	}
+
+	for _, change := range input.Changes {
+		if change.Channel == globalmodel.NotificationChannelPush && change.Granted && !change.Category.IsMandatory() {
+			if err = us.enableLegacyPushOptIn(ctx, tx, userID); err != nil {
+				err = utils.InternalError("")
+				return output, err
+			}
+			break
+		}
+	}
+
+	consents, err := us.repo.ListNotificationConsents(ctx, tx, userID)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("user.notification_preferences.update.list_error", "user_id", userID, "error", err)
+		err = utils.InternalError("")
+		return output, err
+	}
+
+	if err = us.globalService.CommitTransaction(ctx, tx); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("user.notification_preferences.update.tx_commit_error", "user_id", userID, "error", err)
+		err = utils.InternalError("Failed to commit transaction")
+		return output, err
+	}
+
+	logger.Info("user.notification_preferences.updated", "user_id", userID, "changes", len(input.Changes), "policy_version", policyVersion)
+	return us.buildNotificationPreferences(consents), nil
+}
@@ -103,5 +103,7 @@ func (us *userService) updateOptStatus(ctx context.Context, tx *sql.Tx, userID i
 		utils.LoggerFromContext(ctx).Error("user.update_opt_status.audit_error", "error", err, "user_id", userID, "opt", opt)
 		return
 	}
+
+	err = us.syncLegacyPushConsents(ctx, tx, userID, opt, "update_opt_status")
 	return
 }
@@ -2,17 +2,23 @@ package userservices
 
 import (
 	"context"
+	"database/sql"
 	"strings"
+	"time"
 
 	derrors "github.com/projeto-toq/toq_server/internal/core/derrors"
 	auditmodel "github.com/projeto-toq/toq_server/internal/core/model/audit_model"
 	permissionmodel "github.com/projeto-toq/toq_server/internal/core/model/permission_model"
+	usermodel "github.com/projeto-toq/toq_server/internal/core/model/user_model"
 	auditservice "github.com/projeto-toq/toq_server/internal/core/service/audit_service"
 	"github.com/projeto-toq/toq_server/internal/core/utils"
 	validators "github.com/projeto-toq/toq_server/internal/core/utils/validators"
 )
 
 // UpdateSystemUser atualiza dados sensíveis de um usuário de sistema.
+//
+// ValidFrom/ValidUntil, quando informados, concedem, estendem ou encurtam a vigência do role
+// sistêmico; o campo omitido mantém o valor atual.
 func (us *userService) UpdateSystemUser(ctx context.Context, input UpdateSystemUserInput) (SystemUserResult, error) {
 	ctx, spanEnd, err := utils.GenerateTracer(ctx)
 	if err != nil {
@@ -109,6 +115,14 @@ func (us *userService) UpdateSystemUser(ctx context.Context, input UpdateSystemU
 		return SystemUserResult{}, opErr
 	}
 
+	var grantChanges map[string]any
+	if input.ValidFrom != nil || input.ValidUntil != nil {
+		grantChanges, opErr = us.updateSystemUserGrantWindow(ctx, tx, input, activeRole.GetRoleID())
+		if opErr != nil {
+			return SystemUserResult{}, opErr
+		}
+	}
+
 	prevFullName := existing.GetFullName()
 	prevEmail := existing.GetEmail()
 	prevPhone := existing.GetPhoneNumber()
@@ -136,6 +150,10 @@ func (us *userService) UpdateSystemUser(ctx context.Context, input UpdateSystemU
 		changes["phone"] = map[string]string{"from": prevPhone, "to": normalizedPhone}
 	}
 
+	for field, change := range grantChanges {
+		changes[field] = change
+	}
+
 	auditRecord := auditservice.BuildRecordFromContext(
 		ctx,
 		existing.GetID(),
@@ -160,6 +178,10 @@ func (us *userService) UpdateSystemUser(ctx context.Context, input UpdateSystemU
 		return SystemUserResult{}, utils.InternalError("")
 	}
 
+	if len(grantChanges) > 0 {
+		us.permissionService.InvalidateUserCacheSafe(ctx, existing.GetID(), "update_system_user_grant")
+	}
+
 	logger.Info("admin.users.update.success", "user_id", existing.GetID())
 	return SystemUserResult{
 		UserID:   existing.GetID(),
@@ -168,3 +190,57 @@ func (us *userService) UpdateSystemUser(ctx context.Context, input UpdateSystemU
 		Email:    existing.GetEmail(),
 	}, nil
 }
+
+// updateSystemUserGrantWindow aplica a nova vigência ao role sistêmico e devolve as mudanças para auditoria.
+func (us *userService) updateSystemUserGrantWindow(ctx context.Context, tx *sql.Tx, input UpdateSystemUserInput, roleID int64) (map[string]any, error) {
+	logger := utils.LoggerFromContext(ctx)
+
+	userRole, roleErr := us.repo.GetUserRoleByUserIDAndRoleID(ctx, tx, input.UserID, roleID)
+	if roleErr != nil {
+		utils.SetSpanError(ctx, roleErr)
+		logger.Error("admin.users.update.get_user_role_failed", "user_id", input.UserID, "role_id", roleID, "error", roleErr)
+		return nil, utils.InternalError("")
+	}
+	if userRole == nil {
+		return nil, derrors.ErrSystemUserRoleMismatch
+	}
+
+	prevValidFrom := userRole.GetValidFrom()
+	prevValidUntil := userRole.GetExpiresAt()
+
+	validFrom := prevValidFrom
+	if input.ValidFrom != nil {
+		validFrom = input.ValidFrom
+	}
+	validUntil := prevValidUntil
+	if input.ValidUntil != nil {
+		validUntil = input.ValidUntil
+	}
+
+	now := time.Now().UTC()
+	if validUntil != nil {
+		if !validUntil.After(now) {
+			return nil, utils.ValidationError("validUntil", "Valid until must be in the future")
+		}
+		if validFrom != nil && !validUntil.After(*validFrom) {
+			return nil, utils.ValidationError("validUntil", "Valid until must be after valid from")
+		}
+	}
+
+	state := usermodel.RoleGrantStateEffective
+	if validFrom != nil && validFrom.After(now) {
+		state = usermodel.RoleGrantStateScheduled
+	}
+
+	if updateErr := us.repo.UpdateUserRoleGrantWindow(ctx, tx, userRole.GetID(), validFrom, validUntil, state); updateErr != nil {
+		utils.SetSpanError(ctx, updateErr)
+		logger.Error("admin.users.update.grant_window_failed", "user_id", input.UserID, "user_role_id", userRole.GetID(), "error", updateErr)
+		return nil, utils.InternalError("")
+	}
+
+	return map[string]any{
+		"valid_from":  map[string]any{"from": prevValidFrom, "to": validFrom},
+		"valid_until": map[string]any{"from": prevValidUntil, "to": validUntil},
+		"grant_state": map[string]string{"from": userRole.GetGrantState().String(), "to": state.String()},
+	}, nil
+}
@@ -112,6 +112,22 @@ type UserServiceInterface interface {
 	// It must not change email, phone or password; those have dedicated flows.
 	UpdateProfile(ctx context.Context, in UpdateProfileInput) (err error)
 	UpdateOptStatus(ctx context.Context, optIn bool) (err error)
+	// RequestDataExport enqueues an LGPD personal data export for the authenticated user
+	RequestDataExport(ctx context.Context) (DataExportStatusOutput, error)
+	// GetDataExportStatus returns the latest data export with a fresh signed URL when ready
+	GetDataExportStatus(ctx context.Context) (DataExportStatusOutput, error)
+	// GetNotificationPreferences returns the consent matrix per channel and category
+	GetNotificationPreferences(ctx context.Context) (NotificationPreferencesOutput, error)
+	// UpdateNotificationPreferences records consent changes against the current policy version
+	UpdateNotificationPreferences(ctx context.Context, input UpdateNotificationPreferencesInput) (NotificationPreferencesOutput, error)
+	// ListNotifications returns a page of the in-app notification inbox with the unread count
+	ListNotifications(ctx context.Context, input ListNotificationsInput) (ListNotificationsOutput, error)
+	// MarkNotificationRead marks a single inbox entry as read
+	MarkNotificationRead(ctx context.Context, notificationID int64) error
+	// MarkAllNotificationsRead marks every unread inbox entry as read and returns how many were updated
+	MarkAllNotificationsRead(ctx context.Context) (int64, error)
+	// GetUnreadNotificationCount returns the unread inbox count used by app badges
+	GetUnreadNotificationCount(ctx context.Context) (int64, error)
 	GetPhotoUploadURL(ctx context.Context, variant, contentType string) (signedURL string, err error)
 	GetPhotoDownloadURL(ctx context.Context, variant string) (signedURL string, err error)
 	CreateUserFolder(ctx context.Context, userID int64) (err error)
@@ -140,6 +156,8 @@ type UserServiceInterface interface {
 	CreateSystemUser(ctx context.Context, input CreateSystemUserInput) (SystemUserResult, error)
 	UpdateSystemUser(ctx context.Context, input UpdateSystemUserInput) (SystemUserResult, error)
 	DeleteSystemUser(ctx context.Context, input DeleteSystemUserInput) error
+	// ListExpiringUserRoles lists time-bound role grants expiring soon (admin panel)
+	ListExpiringUserRoles(ctx context.Context, input ListExpiringUserRolesInput) (ListExpiringUserRolesOutput, error)
 
 	ValidateCPF(ctx context.Context, nationalID string, bornAt time.Time) error
 	ValidateCNPJ(ctx context.Context, nationalID string) error
@@ -171,6 +189,10 @@ type UserServiceInterface interface {
 
 	// Maintenance
 	PurgeStaleDeviceTokens(ctx context.Context, maxAge time.Duration, limit int) (int64, error)
+	// ProcessRoleGrantSchedule activates/expires time-bound role grants and sends pre-expiration notices
+	ProcessRoleGrantSchedule(ctx context.Context, noticeWindow time.Duration, limit int) (RoleGrantScheduleResult, error)
+	// ProcessPendingDataExports builds, uploads and emails queued LGPD personal data exports
+	ProcessPendingDataExports(ctx context.Context, limit int) (DataExportProcessResult, error)
 }
 
 // CreciDocumentDownloadURLs encapsula as URLs assinadas geradas pelo serviço para os documentos CRECI
@@ -0,0 +1,117 @@
+package visitservice
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// CancelOpenHouse cancels a scheduled open house, frees the agenda window and cancels active registrations.
+func (s *visitService) CancelOpenHouse(ctx context.Context, openHouseID int64, reason string) (listingmodel.OpenHouseInterface, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, utils.ValidationError("reason", "Cancel reason is required")
+	}
+
+	ownerID, uidErr := s.globalService.GetUserIDFromContext(ctx)
+	if uidErr != nil {
+		return nil, uidErr
+	}
+
+	tx, txErr := s.globalService.StartTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("visit.open_house.cancel.tx_start_error", "err", txErr)
+		return nil, utils.InternalError("")
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+				utils.SetSpanError(ctx, rbErr)
+				logger.Error("visit.open_house.cancel.tx_rollback_error", "err", rbErr)
+			}
+		}
+	}()
+
+	openHouse, err := s.loadOpenHouse(ctx, tx, openHouseID, true)
+	if err != nil {
+		return nil, err
+	}
+	if openHouse.OwnerUserID() != ownerID {
+		return nil, utils.AuthorizationError("Only the listing owner can cancel this open house")
+	}
+	if openHouse.Status() != listingmodel.OpenHouseStatusScheduled {
+		return nil, utils.ConflictError("Open house is already cancelled")
+	}
+	if !time.Now().UTC().Before(openHouse.EndsAt()) {
+		return nil, utils.ConflictError("Finished open houses cannot be cancelled")
+	}
+
+	if entryID, ok := openHouse.AgendaEntryID(); ok {
+		if delErr := s.scheduleRepo.DeleteEntry(ctx, tx, entryID); delErr != nil && delErr != sql.ErrNoRows {
+			utils.SetSpanError(ctx, delErr)
+			logger.Error("visit.open_house.cancel.delete_entry_error", "open_house_id", openHouseID, "entry_id", entryID, "err", delErr)
+			return nil, utils.InternalError("")
+		}
+		openHouse.ClearAgendaEntryID()
+	}
+
+	openHouse.SetStatus(listingmodel.OpenHouseStatusCancelled)
+	openHouse.SetCancellationReason(reason)
+	if err = s.visitRepo.UpdateOpenHouse(ctx, tx, openHouse); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, utils.NotFoundError("Open house")
+		}
+		utils.SetSpanError(ctx, err)
+		logger.Error("visit.open_house.cancel.update_error", "open_house_id", openHouseID, "err", err)
+		return nil, utils.InternalError("")
+	}
+
+	registrations, err := s.visitRepo.ListOpenHouseRegistrations(ctx, tx, openHouseID, nil)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("visit.open_house.cancel.list_registrations_error", "open_house_id", openHouseID, "err", err)
+		return nil, utils.InternalError("")
+	}
+
+	cancelled := make([]listingmodel.OpenHouseRegistrationInterface, 0, len(registrations))
+	for _, registration := range registrations {
+		if !registration.Status().IsActive() {
+			continue
+		}
+		registration.SetStatus(listingmodel.OpenHouseRegistrationCancelled)
+		if err = s.visitRepo.UpdateOpenHouseRegistration(ctx, tx, registration); err != nil {
+			utils.SetSpanError(ctx, err)
+			logger.Error("visit.open_house.cancel.update_registration_error", "open_house_id", openHouseID, "registration_id", registration.ID(), "err", err)
+			return nil, utils.InternalError("")
+		}
+		cancelled = append(cancelled, registration)
+	}
+
+	if commitErr := s.globalService.CommitTransaction(ctx, tx); commitErr != nil {
+		utils.SetSpanError(ctx, commitErr)
+		logger.Error("visit.open_house.cancel.tx_commit_error", "err", commitErr)
+		return nil, utils.InternalError("")
+	}
+	committed = true
+
+	for _, registration := range cancelled {
+		s.notifyOpenHouseRegistration(ctx, openHouse, registration, string(listingmodel.OpenHouseStatusCancelled))
+	}
+
+	return openHouse, nil
+}
@@ -0,0 +1,122 @@
+package visitservice
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// CancelOpenHouseRegistration cancels a registration (by its realtor or the owner) and promotes the oldest
+// waitlisted client of the same slot when a seat is released.
+func (s *visitService) CancelOpenHouseRegistration(ctx context.Context, registrationID int64) (listingmodel.OpenHouseRegistrationInterface, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	actorID, uidErr := s.globalService.GetUserIDFromContext(ctx)
+	if uidErr != nil {
+		return nil, uidErr
+	}
+
+	tx, txErr := s.globalService.StartTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("visit.open_house.registration_cancel.tx_start_error", "err", txErr)
+		return nil, utils.InternalError("")
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+				utils.SetSpanError(ctx, rbErr)
+				logger.Error("visit.open_house.registration_cancel.tx_rollback_error", "err", rbErr)
+			}
+		}
+	}()
+
+	registration, err := s.loadOpenHouseRegistration(ctx, tx, registrationID)
+	if err != nil {
+		return nil, err
+	}
+
+	openHouse, err := s.loadOpenHouse(ctx, tx, registration.OpenHouseID(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	byOwner := openHouse.OwnerUserID() == actorID
+	if !byOwner && registration.RealtorUserID() != actorID {
+		return nil, utils.AuthorizationError("Only the registering realtor or the listing owner can cancel this registration")
+	}
+
+	// Re-read the slot under the open house lock so status and promotion order are consistent.
+	registrations, err := s.visitRepo.ListOpenHouseRegistrations(ctx, tx, openHouse.ID(), nil)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("visit.open_house.registration_cancel.list_registrations_error", "open_house_id", openHouse.ID(), "err", err)
+		return nil, utils.InternalError("")
+	}
+	for _, current := range registrations {
+		if current.ID() == registration.ID() {
+			registration = current
+			break
+		}
+	}
+
+	if !registration.Status().IsActive() {
+		return nil, utils.ConflictError("Only registered or waitlisted clients can be cancelled")
+	}
+
+	releasedSeat := registration.Status() == listingmodel.OpenHouseRegistrationRegistered
+	registration.SetStatus(listingmodel.OpenHouseRegistrationCancelled)
+	if err = s.visitRepo.UpdateOpenHouseRegistration(ctx, tx, registration); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, utils.NotFoundError("Open house registration")
+		}
+		utils.SetSpanError(ctx, err)
+		logger.Error("visit.open_house.registration_cancel.update_error", "registration_id", registrationID, "err", err)
+		return nil, utils.InternalError("")
+	}
+
+	var promoted listingmodel.OpenHouseRegistrationInterface
+	if releasedSeat && openHouse.Status() == listingmodel.OpenHouseStatusScheduled && registration.SlotStart().After(time.Now().UTC()) {
+		for _, candidate := range registrations {
+			if candidate.Status() == listingmodel.OpenHouseRegistrationWaitlisted && candidate.SlotStart().Equal(registration.SlotStart()) {
+				promoted = candidate
+				break
+			}
+		}
+	}
+	if promoted != nil {
+		promoted.SetStatus(listingmodel.OpenHouseRegistrationRegistered)
+		if err = s.visitRepo.UpdateOpenHouseRegistration(ctx, tx, promoted); err != nil {
+			utils.SetSpanError(ctx, err)
+			logger.Error("visit.open_house.registration_cancel.promote_error", "registration_id", promoted.ID(), "err", err)
+			return nil, utils.InternalError("")
+		}
+	}
+
+	if commitErr := s.globalService.CommitTransaction(ctx, tx); commitErr != nil {
+		utils.SetSpanError(ctx, commitErr)
+		logger.Error("visit.open_house.registration_cancel.tx_commit_error", "err", commitErr)
+		return nil, utils.InternalError("")
+	}
+	committed = true
+
+	if byOwner && registration.RealtorUserID() != actorID {
+		s.notifyOpenHouseRegistration(ctx, openHouse, registration, string(registration.Status()))
+	}
+	if promoted != nil {
+		s.notifyOpenHouseRegistration(ctx, openHouse, promoted, string(promoted.Status()))
+	}
+
+	return registration, nil
+}
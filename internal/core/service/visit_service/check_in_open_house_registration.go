@@ -0,0 +1,96 @@
+package visitservice
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// CheckInOpenHouseRegistration records whether a registered client attended the open house.
+// Owners may correct a previous check-in/no-show mark while the open house is not cancelled.
+func (s *visitService) CheckInOpenHouseRegistration(ctx context.Context, registrationID int64, attended bool) (listingmodel.OpenHouseRegistrationInterface, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	ownerID, uidErr := s.globalService.GetUserIDFromContext(ctx)
+	if uidErr != nil {
+		return nil, uidErr
+	}
+
+	tx, txErr := s.globalService.StartTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("visit.open_house.check_in.tx_start_error", "err", txErr)
+		return nil, utils.InternalError("")
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+				utils.SetSpanError(ctx, rbErr)
+				logger.Error("visit.open_house.check_in.tx_rollback_error", "err", rbErr)
+			}
+		}
+	}()
+
+	registration, err := s.loadOpenHouseRegistration(ctx, tx, registrationID)
+	if err != nil {
+		return nil, err
+	}
+
+	openHouse, err := s.loadOpenHouse(ctx, tx, registration.OpenHouseID(), false)
+	if err != nil {
+		return nil, err
+	}
+	if openHouse.OwnerUserID() != ownerID {
+		return nil, utils.AuthorizationError("Only the listing owner can check clients in")
+	}
+	if openHouse.Status() != listingmodel.OpenHouseStatusScheduled {
+		return nil, utils.ConflictError("Open house is cancelled")
+	}
+
+	now := time.Now().UTC()
+	if now.Before(openHouse.StartsAt().Add(-openHouseCheckInLead)) {
+		return nil, utils.ConflictError("Check-in is not open yet for this open house")
+	}
+	if !registration.Status().HoldsSeat() {
+		return nil, utils.ConflictError("Only registered clients can be checked in")
+	}
+
+	if attended {
+		registration.SetStatus(listingmodel.OpenHouseRegistrationCheckedIn)
+		if _, ok := registration.CheckedInAt(); !ok {
+			registration.SetCheckedInAt(now)
+		}
+	} else {
+		registration.SetStatus(listingmodel.OpenHouseRegistrationNoShow)
+		registration.ClearCheckedInAt()
+	}
+
+	if err = s.visitRepo.UpdateOpenHouseRegistration(ctx, tx, registration); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, utils.NotFoundError("Open house registration")
+		}
+		utils.SetSpanError(ctx, err)
+		logger.Error("visit.open_house.check_in.update_error", "registration_id", registrationID, "err", err)
+		return nil, utils.InternalError("")
+	}
+
+	if commitErr := s.globalService.CommitTransaction(ctx, tx); commitErr != nil {
+		utils.SetSpanError(ctx, commitErr)
+		logger.Error("visit.open_house.check_in.tx_commit_error", "err", commitErr)
+		return nil, utils.InternalError("")
+	}
+	committed = true
+
+	return registration, nil
+}
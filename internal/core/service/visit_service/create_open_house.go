@@ -0,0 +1,178 @@
+package visitservice
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	schedulemodel "github.com/projeto-toq/toq_server/internal/core/model/schedule_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// CreateOpenHouse opens a group visit window on the listing agenda and blocks it for private visits.
+func (s *visitService) CreateOpenHouse(ctx context.Context, input CreateOpenHouseInput) (listingmodel.OpenHouseInterface, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	notes := strings.TrimSpace(input.Notes)
+	if err := s.validateOpenHouseInput(input, notes); err != nil {
+		return nil, err
+	}
+
+	ownerID, uidErr := s.globalService.GetUserIDFromContext(ctx)
+	if uidErr != nil {
+		return nil, uidErr
+	}
+
+	tx, txErr := s.globalService.StartTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("visit.open_house.create.tx_start_error", "err", txErr)
+		return nil, utils.InternalError("")
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+				utils.SetSpanError(ctx, rbErr)
+				logger.Error("visit.open_house.create.tx_rollback_error", "err", rbErr)
+			}
+		}
+	}()
+
+	listingIdentity, err := s.listingRepo.GetListingIdentityByID(ctx, tx, input.ListingIdentityID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, utils.NotFoundError("Listing")
+		}
+		utils.SetSpanError(ctx, err)
+		logger.Error("visit.open_house.create.get_listing_identity_error", "listing_identity_id", input.ListingIdentityID, "err", err)
+		return nil, utils.InternalError("")
+	}
+	if listingIdentity.UserID != ownerID {
+		return nil, utils.AuthorizationError("Only the listing owner can create open houses")
+	}
+
+	agenda, err := s.scheduleRepo.GetAgendaByListingIdentityID(ctx, tx, input.ListingIdentityID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, utils.NotFoundError("Agenda")
+		}
+		utils.SetSpanError(ctx, err)
+		logger.Error("visit.open_house.create.get_agenda_error", "listing_identity_id", input.ListingIdentityID, "err", err)
+		return nil, utils.InternalError("")
+	}
+
+	// The window must be free of visits, photo sessions, blocks and other open houses.
+	entries, err := s.scheduleRepo.ListEntriesBetween(ctx, tx, agenda.ID(), input.StartsAt, input.EndsAt)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("visit.open_house.create.list_entries_error", "agenda_id", agenda.ID(), "err", err)
+		return nil, utils.InternalError("")
+	}
+	for _, e := range entries {
+		if !e.Blocking() {
+			continue
+		}
+		if e.StartsAt().Before(input.EndsAt) && e.EndsAt().After(input.StartsAt) {
+			return nil, utils.ConflictError("Open house overlaps existing agenda entries")
+		}
+	}
+
+	entry := schedulemodel.NewAgendaEntry()
+	entry.SetAgendaID(agenda.ID())
+	entry.SetEntryType(schedulemodel.EntryTypeOpenHouse)
+	entry.SetBlocking(true)
+	entry.SetStartsAt(input.StartsAt)
+	entry.SetEndsAt(input.EndsAt)
+	entry.SetReason(openHouseEntryReason)
+	entryID, err := s.scheduleRepo.InsertEntry(ctx, tx, entry)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("visit.open_house.create.insert_entry_error", "agenda_id", agenda.ID(), "err", err)
+		return nil, utils.InternalError("")
+	}
+
+	now := time.Now().UTC()
+
+	openHouse := listingmodel.NewOpenHouse()
+	openHouse.SetListingIdentityID(input.ListingIdentityID)
+	openHouse.SetOwnerUserID(ownerID)
+	openHouse.SetAgendaEntryID(entryID)
+	openHouse.SetStartsAt(input.StartsAt)
+	openHouse.SetEndsAt(input.EndsAt)
+	openHouse.SetCapacity(input.Capacity)
+	openHouse.SetSlotMinutes(input.SlotMinutes)
+	openHouse.SetStatus(listingmodel.OpenHouseStatusScheduled)
+	openHouse.SetCreatedAt(now)
+	openHouse.SetUpdatedAt(now)
+	if notes != "" {
+		openHouse.SetNotes(notes)
+	}
+
+	if _, err = s.visitRepo.InsertOpenHouse(ctx, tx, openHouse); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("visit.open_house.create.insert_error", "listing_identity_id", input.ListingIdentityID, "err", err)
+		return nil, utils.InternalError("")
+	}
+
+	if commitErr := s.globalService.CommitTransaction(ctx, tx); commitErr != nil {
+		utils.SetSpanError(ctx, commitErr)
+		logger.Error("visit.open_house.create.tx_commit_error", "err", commitErr)
+		return nil, utils.InternalError("")
+	}
+	committed = true
+
+	return openHouse, nil
+}
+
+// validateOpenHouseInput enforces window bounds, lead time/horizon, capacity and slot sizing.
+func (s *visitService) validateOpenHouseInput(input CreateOpenHouseInput, notes string) error {
+	if input.ListingIdentityID <= 0 {
+		return utils.ValidationError("listingIdentityId", "must be greater than zero")
+	}
+	if !input.StartsAt.Before(input.EndsAt) {
+		return utils.ValidationError("startsAt", "start must be before end")
+	}
+
+	duration := input.EndsAt.Sub(input.StartsAt)
+	if duration < openHouseMinDuration || duration > openHouseMaxDuration {
+		return utils.ValidationError("endsAt", fmt.Sprintf("open house must last between %d minutes and %d hours", int(openHouseMinDuration.Minutes()), int(openHouseMaxDuration.Hours())))
+	}
+
+	now := time.Now().UTC()
+	if !input.StartsAt.After(now.Add(time.Duration(s.config.MinHoursAhead) * time.Hour)) {
+		return utils.ValidationError("startsAt", fmt.Sprintf("must be at least %d hours in advance", s.config.MinHoursAhead))
+	}
+	if input.EndsAt.After(now.Add(time.Duration(s.config.MaxDaysAhead) * 24 * time.Hour)) {
+		return utils.ValidationError("startsAt", fmt.Sprintf("must be within %d days from now", s.config.MaxDaysAhead))
+	}
+
+	if input.Capacity == 0 || input.Capacity > openHouseMaxCapacity {
+		return utils.ValidationError("capacity", fmt.Sprintf("must be between 1 and %d", openHouseMaxCapacity))
+	}
+
+	if input.SlotMinutes != 0 {
+		if input.SlotMinutes < openHouseMinSlotMinutes || input.SlotMinutes > openHouseMaxSlotMinutes {
+			return utils.ValidationError("slotMinutes", fmt.Sprintf("must be zero or between %d and %d", openHouseMinSlotMinutes, openHouseMaxSlotMinutes))
+		}
+		if duration%(time.Duration(input.SlotMinutes)*time.Minute) != 0 {
+			return utils.ValidationError("slotMinutes", "window duration must be a multiple of the slot interval")
+		}
+	}
+
+	if len([]rune(notes)) > openHouseNotesMaxLength {
+		return utils.ValidationError("notes", fmt.Sprintf("must have at most %d characters", openHouseNotesMaxLength))
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package visitservice
+
+import (
+	"context"
+
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// GetOpenHouse returns the open house with slot occupation. Owners see every registration while
+// realtors only see the clients they registered.
+func (s *visitService) GetOpenHouse(ctx context.Context, openHouseID int64) (OpenHouseDetailOutput, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return OpenHouseDetailOutput{}, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	actorID, uidErr := s.globalService.GetUserIDFromContext(ctx)
+	if uidErr != nil {
+		return OpenHouseDetailOutput{}, uidErr
+	}
+
+	tx, txErr := s.globalService.StartReadOnlyTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("visit.open_house.get.tx_start_error", "err", txErr)
+		return OpenHouseDetailOutput{}, utils.InternalError("")
+	}
+	defer func() {
+		if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+			utils.SetSpanError(ctx, rbErr)
+			logger.Error("visit.open_house.get.tx_rollback_error", "err", rbErr)
+		}
+	}()
+
+	openHouse, err := s.loadOpenHouse(ctx, tx, openHouseID, false)
+	if err != nil {
+		return OpenHouseDetailOutput{}, err
+	}
+
+	registrations, err := s.visitRepo.ListOpenHouseRegistrations(ctx, tx, openHouseID, nil)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("visit.open_house.get.list_registrations_error", "open_house_id", openHouseID, "err", err)
+		return OpenHouseDetailOutput{}, utils.InternalError("")
+	}
+
+	decorated := decorateOpenHouseRegistrations(registrations)
+	if openHouse.OwnerUserID() != actorID {
+		visible := make([]OpenHouseRegistrationOutput, 0)
+		for _, item := range decorated {
+			if item.Registration.RealtorUserID() == actorID {
+				visible = append(visible, item)
+			}
+		}
+		decorated = visible
+	}
+
+	return OpenHouseDetailOutput{
+		OpenHouse:     openHouse,
+		Slots:         summarizeOpenHouseSlots(openHouse, registrations),
+		Registrations: decorated,
+	}, nil
+}
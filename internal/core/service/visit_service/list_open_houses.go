@@ -0,0 +1,54 @@
+package visitservice
+
+import (
+	"context"
+
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// ListOpenHouses lists open houses for an owner or for a listing, with registration counters.
+func (s *visitService) ListOpenHouses(ctx context.Context, filter listingmodel.OpenHouseListFilter) (OpenHouseListOutput, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return OpenHouseListOutput{}, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	if filter.OwnerUserID == nil && filter.ListingIdentityID == nil {
+		return OpenHouseListOutput{}, utils.ValidationError("listingIdentityId", "Listing identity is required")
+	}
+	if filter.From != nil && filter.To != nil && filter.To.Before(*filter.From) {
+		return OpenHouseListOutput{}, utils.ValidationError("to", "must be after from")
+	}
+
+	tx, txErr := s.globalService.StartReadOnlyTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("visit.open_house.list.tx_start_error", "err", txErr)
+		return OpenHouseListOutput{}, utils.InternalError("")
+	}
+	defer func() {
+		if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+			utils.SetSpanError(ctx, rbErr)
+			logger.Error("visit.open_house.list.tx_rollback_error", "err", rbErr)
+		}
+	}()
+
+	result, err := s.visitRepo.ListOpenHouses(ctx, tx, filter)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("visit.open_house.list.repo_error", "err", err)
+		return OpenHouseListOutput{}, utils.InternalError("")
+	}
+
+	return OpenHouseListOutput{
+		Items: result.OpenHouses,
+		Total: result.Total,
+		Page:  filter.Page,
+		Limit: filter.Limit,
+	}, nil
+}
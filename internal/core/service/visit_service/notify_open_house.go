@@ -0,0 +1,105 @@
+package visitservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	"github.com/projeto-toq/toq_server/internal/core/templates"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// NotifyOpenHouse broadcasts an owner message to every realtor with registered (and optionally waitlisted) clients.
+func (s *visitService) NotifyOpenHouse(ctx context.Context, input NotifyOpenHouseInput) (NotifyOpenHouseOutput, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return NotifyOpenHouseOutput{}, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	message := strings.TrimSpace(input.Message)
+	if message == "" || len([]rune(message)) > openHouseMessageMaxLength {
+		return NotifyOpenHouseOutput{}, utils.ValidationError("message", fmt.Sprintf("must have between 1 and %d characters", openHouseMessageMaxLength))
+	}
+
+	ownerID, uidErr := s.globalService.GetUserIDFromContext(ctx)
+	if uidErr != nil {
+		return NotifyOpenHouseOutput{}, uidErr
+	}
+
+	tx, txErr := s.globalService.StartReadOnlyTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("visit.open_house.notify.tx_start_error", "err", txErr)
+		return NotifyOpenHouseOutput{}, utils.InternalError("")
+	}
+	defer func() {
+		if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+			utils.SetSpanError(ctx, rbErr)
+			logger.Error("visit.open_house.notify.tx_rollback_error", "err", rbErr)
+		}
+	}()
+
+	openHouse, err := s.loadOpenHouse(ctx, tx, input.OpenHouseID, false)
+	if err != nil {
+		return NotifyOpenHouseOutput{}, err
+	}
+	if openHouse.OwnerUserID() != ownerID {
+		return NotifyOpenHouseOutput{}, utils.AuthorizationError("Only the listing owner can notify open house participants")
+	}
+	if openHouse.Status() != listingmodel.OpenHouseStatusScheduled {
+		return NotifyOpenHouseOutput{}, utils.ConflictError("Open house is cancelled")
+	}
+
+	registrations, err := s.visitRepo.ListOpenHouseRegistrations(ctx, tx, openHouse.ID(), nil)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("visit.open_house.notify.list_registrations_error", "open_house_id", openHouse.ID(), "err", err)
+		return NotifyOpenHouseOutput{}, utils.InternalError("")
+	}
+
+	recipients := make([]int64, 0)
+	seen := make(map[int64]struct{})
+	for _, registration := range registrations {
+		switch registration.Status() {
+		case listingmodel.OpenHouseRegistrationRegistered, listingmodel.OpenHouseRegistrationCheckedIn:
+		case listingmodel.OpenHouseRegistrationWaitlisted:
+			if !input.IncludeWaitlist {
+				continue
+			}
+		default:
+			continue
+		}
+		if _, ok := seen[registration.RealtorUserID()]; ok {
+			continue
+		}
+		seen[registration.RealtorUserID()] = struct{}{}
+		recipients = append(recipients, registration.RealtorUserID())
+	}
+
+	if len(recipients) == 0 {
+		return NotifyOpenHouseOutput{}, nil
+	}
+
+	payload, err := templates.RenderOpenHouseMessage(templates.OpenHouseTemplateData{
+		OpenHouseID:       openHouse.ID(),
+		ListingIdentityID: openHouse.ListingIdentityID(),
+		StartsAt:          openHouse.StartsAt(),
+		Message:           message,
+	})
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("visit.open_house.notify.render_error", "open_house_id", openHouse.ID(), "err", err)
+		return NotifyOpenHouseOutput{}, utils.InternalError("")
+	}
+
+	for _, realtorID := range recipients {
+		s.dispatchVisitNotification(ctx, realtorID, payload)
+	}
+
+	return NotifyOpenHouseOutput{Recipients: len(recipients)}, nil
+}
@@ -3,6 +3,7 @@ package visitservice
 import (
 	"context"
 
+	globalmodel "github.com/projeto-toq/toq_server/internal/core/model/global_model"
 	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
 	globalservice "github.com/projeto-toq/toq_server/internal/core/service/global_service"
 	"github.com/projeto-toq/toq_server/internal/core/templates"
@@ -64,25 +65,34 @@ func (s *visitService) dispatchVisitNotification(ctx context.Context, userID int
 		return
 	}
 
+	notifier.StoreInbox(ctx, globalservice.NotificationRequest{
+		Type:     globalservice.NotificationTypeFCM,
+		Subject:  payload.Title,
+		Body:     payload.Body,
+		Data:     cloneVisitData(payload.Data),
+		UserID:   userID,
+		Category: globalmodel.NotificationCategoryVisitUpdates,
+	})
+
 	tokens, err := s.globalService.ListDeviceTokensByUserIDIfOptedIn(ctx, userID)
 	if err != nil {
 		utils.LoggerFromContext(ctx).Warn("visit.notify.list_tokens_error", "user_id", userID, "err", err)
 		return
 	}
-	if len(tokens) == 0 {
-		return
-	}
 
 	for _, token := range tokens {
 		if token == "" {
 			continue
 		}
 		req := globalservice.NotificationRequest{
-			Type:    globalservice.NotificationTypeFCM,
-			Subject: payload.Title,
-			Body:    payload.Body,
-			Token:   token,
-			Data:    cloneVisitData(payload.Data),
+			Type:      globalservice.NotificationTypeFCM,
+			Subject:   payload.Title,
+			Body:      payload.Body,
+			Token:     token,
+			Data:      cloneVisitData(payload.Data),
+			UserID:    userID,
+			Category:  globalmodel.NotificationCategoryVisitUpdates,
+			SkipInbox: true,
 		}
 		if err := notifier.SendNotification(ctx, req); err != nil {
 			utils.LoggerFromContext(ctx).Warn("visit.notify.enqueue_error", "user_id", userID, "token", token, "err", err)
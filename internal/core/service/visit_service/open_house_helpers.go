@@ -0,0 +1,133 @@
+package visitservice
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	"github.com/projeto-toq/toq_server/internal/core/templates"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// loadOpenHouse resolves an open house by ID (optionally locking it) with a not-found mapping.
+func (s *visitService) loadOpenHouse(ctx context.Context, tx *sql.Tx, openHouseID int64, forUpdate bool) (listingmodel.OpenHouseInterface, error) {
+	var (
+		openHouse listingmodel.OpenHouseInterface
+		err       error
+	)
+	if forUpdate {
+		openHouse, err = s.visitRepo.GetOpenHouseByIDForUpdate(ctx, tx, openHouseID)
+	} else {
+		openHouse, err = s.visitRepo.GetOpenHouseByID(ctx, tx, openHouseID)
+	}
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, utils.NotFoundError("Open house")
+		}
+		return nil, err
+	}
+	return openHouse, nil
+}
+
+// loadOpenHouseRegistration resolves a registration by ID with a not-found mapping.
+func (s *visitService) loadOpenHouseRegistration(ctx context.Context, tx *sql.Tx, registrationID int64) (listingmodel.OpenHouseRegistrationInterface, error) {
+	registration, err := s.visitRepo.GetOpenHouseRegistrationByID(ctx, tx, registrationID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, utils.NotFoundError("Open house registration")
+		}
+		return nil, err
+	}
+	return registration, nil
+}
+
+// summarizeOpenHouseSlots computes seat usage per slot from the full registration list.
+func summarizeOpenHouseSlots(openHouse listingmodel.OpenHouseInterface, registrations []listingmodel.OpenHouseRegistrationInterface) []OpenHouseSlotOutput {
+	starts := openHouse.SlotStarts()
+	slots := make([]OpenHouseSlotOutput, 0, len(starts))
+	for _, start := range starts {
+		slot := OpenHouseSlotOutput{SlotStart: start, SlotEnd: openHouse.SlotEnd(start)}
+		for _, registration := range registrations {
+			if !registration.SlotStart().Equal(start) {
+				continue
+			}
+			if registration.Status() == listingmodel.OpenHouseRegistrationCheckedIn {
+				slot.CheckedIn++
+			}
+			switch {
+			case registration.Status().HoldsSeat():
+				slot.Registered++
+			case registration.Status() == listingmodel.OpenHouseRegistrationWaitlisted:
+				slot.Waitlisted++
+			}
+		}
+		slot.Available = max(int(openHouse.Capacity())-slot.Registered, 0)
+		slots = append(slots, slot)
+	}
+	return slots
+}
+
+// countSlotSeats returns seats taken and waitlisted registrations for the slot starting at slotStart.
+func countSlotSeats(registrations []listingmodel.OpenHouseRegistrationInterface, slotStart time.Time) (taken int, waitlisted int) {
+	for _, registration := range registrations {
+		if !registration.SlotStart().Equal(slotStart) {
+			continue
+		}
+		if registration.Status().HoldsSeat() {
+			taken++
+		} else if registration.Status() == listingmodel.OpenHouseRegistrationWaitlisted {
+			waitlisted++
+		}
+	}
+	return taken, waitlisted
+}
+
+// decorateOpenHouseRegistrations attaches waitlist positions using the repository order (slot, created_at, id).
+func decorateOpenHouseRegistrations(registrations []listingmodel.OpenHouseRegistrationInterface) []OpenHouseRegistrationOutput {
+	positions := make(map[time.Time]int)
+	out := make([]OpenHouseRegistrationOutput, 0, len(registrations))
+	for _, registration := range registrations {
+		item := OpenHouseRegistrationOutput{Registration: registration}
+		if registration.Status() == listingmodel.OpenHouseRegistrationWaitlisted {
+			key := registration.SlotStart().UTC()
+			positions[key]++
+			item.WaitlistPosition = positions[key]
+		}
+		out = append(out, item)
+	}
+	return out
+}
+
+// findOpenHouseSlot returns the slot start matching the requested time, or the window start for single-slot events.
+func findOpenHouseSlot(openHouse listingmodel.OpenHouseInterface, requested *time.Time) (time.Time, error) {
+	if openHouse.SlotMinutes() == 0 {
+		return openHouse.StartsAt(), nil
+	}
+	if requested == nil {
+		return time.Time{}, utils.ValidationError("slotStart", "Slot start is required for open houses split into slots")
+	}
+	for _, start := range openHouse.SlotStarts() {
+		if start.Equal(*requested) {
+			return start, nil
+		}
+	}
+	return time.Time{}, utils.ValidationError("slotStart", "Slot start must match one of the open house slots")
+}
+
+func (s *visitService) notifyOpenHouseRegistration(ctx context.Context, openHouse listingmodel.OpenHouseInterface, registration listingmodel.OpenHouseRegistrationInterface, status string) {
+	payload, err := templates.RenderOpenHouseRealtorStatus(templates.OpenHouseTemplateData{
+		OpenHouseID:       openHouse.ID(),
+		RegistrationID:    registration.ID(),
+		ListingIdentityID: openHouse.ListingIdentityID(),
+		StartsAt:          openHouse.StartsAt(),
+		SlotStart:         registration.SlotStart(),
+		ClientName:        registration.ClientName(),
+		Status:            status,
+	})
+	if err != nil {
+		utils.LoggerFromContext(ctx).Warn("visit.open_house.notify.render_realtor_status_error", "open_house_id", openHouse.ID(), "registration_id", registration.ID(), "err", err)
+		return
+	}
+	s.dispatchVisitNotification(ctx, registration.RealtorUserID(), payload)
+}
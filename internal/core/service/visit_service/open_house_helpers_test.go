@@ -0,0 +1,202 @@
+package visitservice
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+)
+
+var openHouseStart = time.Date(2026, time.March, 7, 13, 0, 0, 0, time.UTC)
+
+func testOpenHouse(capacity, slotMinutes uint16, hours int) listingmodel.OpenHouseInterface {
+	openHouse := listingmodel.NewOpenHouse()
+	openHouse.SetStartsAt(openHouseStart)
+	openHouse.SetEndsAt(openHouseStart.Add(time.Duration(hours) * time.Hour))
+	openHouse.SetCapacity(capacity)
+	openHouse.SetSlotMinutes(slotMinutes)
+	return openHouse
+}
+
+// testRegistrations builds registrations in repository order from "<slot offset in minutes>:<status>" specs.
+func testRegistrations(t *testing.T, specs ...string) []listingmodel.OpenHouseRegistrationInterface {
+	t.Helper()
+
+	registrations := make([]listingmodel.OpenHouseRegistrationInterface, 0, len(specs))
+	for i, spec := range specs {
+		var offset int
+		var status string
+		if _, err := fmt.Sscanf(strings.Replace(spec, ":", " ", 1), "%d %s", &offset, &status); err != nil {
+			t.Fatalf("invalid registration spec %q: %v", spec, err)
+		}
+		registration := listingmodel.NewOpenHouseRegistration()
+		registration.SetID(int64(i + 1))
+		registration.SetSlotStart(openHouseStart.Add(time.Duration(offset) * time.Minute))
+		registration.SetStatus(listingmodel.OpenHouseRegistrationStatus(status))
+		registrations = append(registrations, registration)
+	}
+	return registrations
+}
+
+func TestSummarizeOpenHouseSlots(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		openHouse     listingmodel.OpenHouseInterface
+		registrations []listingmodel.OpenHouseRegistrationInterface
+		expected      string
+	}{
+		{
+			name:      "single slot without registrations",
+			openHouse: testOpenHouse(5, 0, 3),
+			expected:  "13:00-16:00 registered=0 waitlisted=0 checkedIn=0 available=5",
+		},
+		{
+			name:      "checked in and no-show hold seats, cancelled does not",
+			openHouse: testOpenHouse(4, 0, 3),
+			registrations: testRegistrations(t,
+				"0:REGISTERED", "0:CHECKED_IN", "0:NO_SHOW", "0:CANCELLED", "0:WAITLISTED",
+			),
+			expected: "13:00-16:00 registered=3 waitlisted=1 checkedIn=1 available=1",
+		},
+		{
+			name:      "counts are kept per slot",
+			openHouse: testOpenHouse(2, 60, 3),
+			registrations: testRegistrations(t,
+				"0:REGISTERED", "0:REGISTERED", "0:WAITLISTED", "0:WAITLISTED",
+				"60:CHECKED_IN", "60:CANCELLED",
+			),
+			expected: "13:00-14:00 registered=2 waitlisted=2 checkedIn=0 available=0 | " +
+				"14:00-15:00 registered=1 waitlisted=0 checkedIn=1 available=1 | " +
+				"15:00-16:00 registered=0 waitlisted=0 checkedIn=0 available=2",
+		},
+		{
+			name:      "available never goes negative after a capacity reduction",
+			openHouse: testOpenHouse(1, 0, 2),
+			registrations: testRegistrations(t,
+				"0:REGISTERED", "0:REGISTERED", "0:REGISTERED",
+			),
+			expected: "13:00-15:00 registered=3 waitlisted=0 checkedIn=0 available=0",
+		},
+		{
+			name:      "trailing partial slot is dropped",
+			openHouse: testOpenHouse(3, 90, 2),
+			expected:  "13:00-14:30 registered=0 waitlisted=0 checkedIn=0 available=3",
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			slots := summarizeOpenHouseSlots(tt.openHouse, tt.registrations)
+			parts := make([]string, 0, len(slots))
+			for _, slot := range slots {
+				parts = append(parts, fmt.Sprintf("%s-%s registered=%d waitlisted=%d checkedIn=%d available=%d",
+					slot.SlotStart.Format("15:04"), slot.SlotEnd.Format("15:04"), slot.Registered, slot.Waitlisted, slot.CheckedIn, slot.Available))
+			}
+			if got := strings.Join(parts, " | "); got != tt.expected {
+				t.Fatalf("summarizeOpenHouseSlots() = %q, expected %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCountSlotSeats(t *testing.T) {
+	t.Parallel()
+
+	registrations := testRegistrations(t,
+		"0:REGISTERED", "0:NO_SHOW", "0:WAITLISTED", "0:CANCELLED",
+		"30:CHECKED_IN", "30:WAITLISTED", "30:WAITLISTED",
+	)
+
+	cases := []struct {
+		offset             int
+		expectedTaken      int
+		expectedWaitlisted int
+	}{
+		{offset: 0, expectedTaken: 2, expectedWaitlisted: 1},
+		{offset: 30, expectedTaken: 1, expectedWaitlisted: 2},
+		{offset: 60, expectedTaken: 0, expectedWaitlisted: 0},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(fmt.Sprintf("slot +%dm", tt.offset), func(t *testing.T) {
+			t.Parallel()
+
+			taken, waitlisted := countSlotSeats(registrations, openHouseStart.Add(time.Duration(tt.offset)*time.Minute))
+			if taken != tt.expectedTaken || waitlisted != tt.expectedWaitlisted {
+				t.Fatalf("countSlotSeats(+%dm) = (%d, %d), expected (%d, %d)", tt.offset, taken, waitlisted, tt.expectedTaken, tt.expectedWaitlisted)
+			}
+		})
+	}
+}
+
+func TestDecorateOpenHouseRegistrations(t *testing.T) {
+	t.Parallel()
+
+	registrations := testRegistrations(t,
+		"0:REGISTERED", "0:WAITLISTED", "0:CANCELLED", "0:WAITLISTED",
+		"30:WAITLISTED", "30:REGISTERED",
+	)
+
+	out := decorateOpenHouseRegistrations(registrations)
+	positions := make([]string, 0, len(out))
+	for _, item := range out {
+		positions = append(positions, fmt.Sprintf("%d:%d", item.Registration.ID(), item.WaitlistPosition))
+	}
+
+	if got, expected := strings.Join(positions, " "), "1:0 2:1 3:0 4:2 5:1 6:0"; got != expected {
+		t.Fatalf("decorateOpenHouseRegistrations() positions = %q, expected %q", got, expected)
+	}
+}
+
+func TestFindOpenHouseSlot(t *testing.T) {
+	t.Parallel()
+
+	at := func(offset int) *time.Time {
+		value := openHouseStart.Add(time.Duration(offset) * time.Minute)
+		return &value
+	}
+
+	cases := []struct {
+		name      string
+		openHouse listingmodel.OpenHouseInterface
+		requested *time.Time
+		expected  time.Time
+		expectErr bool
+	}{
+		{name: "single slot ignores the request", openHouse: testOpenHouse(5, 0, 2), requested: at(45), expected: openHouseStart},
+		{name: "single slot without request", openHouse: testOpenHouse(5, 0, 2), expected: openHouseStart},
+		{name: "matching slot", openHouse: testOpenHouse(5, 30, 2), requested: at(90), expected: *at(90)},
+		{name: "slot split requires a start", openHouse: testOpenHouse(5, 30, 2), expectErr: true},
+		{name: "start between slots", openHouse: testOpenHouse(5, 30, 2), requested: at(45), expectErr: true},
+		{name: "start after the last slot", openHouse: testOpenHouse(5, 30, 2), requested: at(120), expectErr: true},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := findOpenHouseSlot(tt.openHouse, tt.requested)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatalf("findOpenHouseSlot() = %s, expected an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("findOpenHouseSlot() unexpected error: %v", err)
+			}
+			if !got.Equal(tt.expected) {
+				t.Fatalf("findOpenHouseSlot() = %s, expected %s", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package visitservice
+
+import (
+	"time"
+
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+)
+
+const (
+	openHouseEntryReason      = "OPEN_HOUSE"
+	openHouseMinDuration      = 30 * time.Minute
+	openHouseMaxDuration      = 12 * time.Hour
+	openHouseMaxCapacity      = 200
+	openHouseMinSlotMinutes   = 15
+	openHouseMaxSlotMinutes   = 240
+	openHouseNotesMaxLength   = 500
+	openHouseMessageMaxLength = 500
+	openHouseClientNameMax    = 120
+	openHouseClientPhoneMax   = 25
+	// openHouseCheckInLead allows owners to start checking clients in slightly before the window opens.
+	openHouseCheckInLead = time.Hour
+)
+
+// CreateOpenHouseInput holds the data to open a group visit window on a listing agenda.
+type CreateOpenHouseInput struct {
+	ListingIdentityID int64
+	StartsAt          time.Time
+	EndsAt            time.Time
+	Capacity          uint16
+	SlotMinutes       uint16
+	Notes             string
+}
+
+// RegisterOpenHouseClientInput holds the data a realtor provides to register a client.
+// SlotStart is required when the open house is split into slots and ignored otherwise.
+type RegisterOpenHouseClientInput struct {
+	OpenHouseID int64
+	ClientName  string
+	ClientPhone string
+	SlotStart   *time.Time
+}
+
+// NotifyOpenHouseInput holds an owner message broadcast to the realtors registered in an open house.
+type NotifyOpenHouseInput struct {
+	OpenHouseID     int64
+	Message         string
+	IncludeWaitlist bool
+}
+
+// OpenHouseSlotOutput summarizes the occupation of a single slot.
+type OpenHouseSlotOutput struct {
+	SlotStart  time.Time
+	SlotEnd    time.Time
+	Registered int
+	Waitlisted int
+	CheckedIn  int
+	Available  int
+}
+
+// OpenHouseRegistrationOutput decorates a registration with its waitlist position (zero when not waitlisted).
+type OpenHouseRegistrationOutput struct {
+	Registration     listingmodel.OpenHouseRegistrationInterface
+	WaitlistPosition int
+}
+
+// OpenHouseDetailOutput aggregates the open house with slot occupation and visible registrations.
+// Owners see every registration; realtors only see the clients they registered.
+type OpenHouseDetailOutput struct {
+	OpenHouse     listingmodel.OpenHouseInterface
+	Slots         []OpenHouseSlotOutput
+	Registrations []OpenHouseRegistrationOutput
+}
+
+// OpenHouseListOutput carries paginated open houses with registration counters.
+type OpenHouseListOutput struct {
+	Items []listingmodel.OpenHouseWithCounts
+	Total int64
+	Page  int
+	Limit int
+}
+
+// NotifyOpenHouseOutput reports how many realtors were targeted by a broadcast.
+type NotifyOpenHouseOutput struct {
+	Recipients int
+}
@@ -0,0 +1,122 @@
+package visitservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	listingmodel "github.com/projeto-toq/toq_server/internal/core/model/listing_model"
+	"github.com/projeto-toq/toq_server/internal/core/utils"
+)
+
+// RegisterOpenHouseClient registers a realtor's client into an open house slot, waitlisting it when the slot is full.
+func (s *visitService) RegisterOpenHouseClient(ctx context.Context, input RegisterOpenHouseClientInput) (OpenHouseRegistrationOutput, error) {
+	ctx, spanEnd, err := utils.GenerateTracer(ctx)
+	if err != nil {
+		return OpenHouseRegistrationOutput{}, err
+	}
+	defer spanEnd()
+
+	ctx = utils.ContextWithLogger(ctx)
+	logger := utils.LoggerFromContext(ctx)
+
+	clientName := strings.TrimSpace(input.ClientName)
+	clientPhone := strings.TrimSpace(input.ClientPhone)
+	if clientName == "" || len([]rune(clientName)) > openHouseClientNameMax {
+		return OpenHouseRegistrationOutput{}, utils.ValidationError("clientName", fmt.Sprintf("must have between 1 and %d characters", openHouseClientNameMax))
+	}
+	if len(clientPhone) > openHouseClientPhoneMax {
+		return OpenHouseRegistrationOutput{}, utils.ValidationError("clientPhone", fmt.Sprintf("must have at most %d characters", openHouseClientPhoneMax))
+	}
+
+	realtorID, uidErr := s.globalService.GetUserIDFromContext(ctx)
+	if uidErr != nil {
+		return OpenHouseRegistrationOutput{}, uidErr
+	}
+
+	tx, txErr := s.globalService.StartTransaction(ctx)
+	if txErr != nil {
+		utils.SetSpanError(ctx, txErr)
+		logger.Error("visit.open_house.register.tx_start_error", "err", txErr)
+		return OpenHouseRegistrationOutput{}, utils.InternalError("")
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			if rbErr := s.globalService.RollbackTransaction(ctx, tx); rbErr != nil {
+				utils.SetSpanError(ctx, rbErr)
+				logger.Error("visit.open_house.register.tx_rollback_error", "err", rbErr)
+			}
+		}
+	}()
+
+	// Lock the open house so concurrent registrations see a consistent seat count.
+	openHouse, err := s.loadOpenHouse(ctx, tx, input.OpenHouseID, true)
+	if err != nil {
+		return OpenHouseRegistrationOutput{}, err
+	}
+	if openHouse.Status() != listingmodel.OpenHouseStatusScheduled {
+		return OpenHouseRegistrationOutput{}, utils.ConflictError("Open house is cancelled")
+	}
+	if openHouse.OwnerUserID() == realtorID {
+		return OpenHouseRegistrationOutput{}, utils.AuthorizationError("Owners cannot register clients into their own open house")
+	}
+
+	slotStart, err := findOpenHouseSlot(openHouse, input.SlotStart)
+	if err != nil {
+		return OpenHouseRegistrationOutput{}, err
+	}
+	now := time.Now().UTC()
+	if !slotStart.After(now) {
+		return OpenHouseRegistrationOutput{}, utils.ConflictError("Open house slot has already started")
+	}
+
+	registrations, err := s.visitRepo.ListOpenHouseRegistrations(ctx, tx, openHouse.ID(), nil)
+	if err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("visit.open_house.register.list_registrations_error", "open_house_id", openHouse.ID(), "err", err)
+		return OpenHouseRegistrationOutput{}, utils.InternalError("")
+	}
+	for _, existing := range registrations {
+		if existing.RealtorUserID() == realtorID && existing.Status().IsActive() && strings.EqualFold(existing.ClientName(), clientName) {
+			return OpenHouseRegistrationOutput{}, utils.ConflictError("Client is already registered in this open house")
+		}
+	}
+
+	taken, waitlisted := countSlotSeats(registrations, slotStart)
+
+	registration := listingmodel.NewOpenHouseRegistration()
+	registration.SetOpenHouseID(openHouse.ID())
+	registration.SetRealtorUserID(realtorID)
+	registration.SetClientName(clientName)
+	if clientPhone != "" {
+		registration.SetClientPhone(clientPhone)
+	}
+	registration.SetSlotStart(slotStart)
+	registration.SetCreatedAt(now)
+	registration.SetUpdatedAt(now)
+
+	output := OpenHouseRegistrationOutput{Registration: registration}
+	if taken < int(openHouse.Capacity()) {
+		registration.SetStatus(listingmodel.OpenHouseRegistrationRegistered)
+	} else {
+		registration.SetStatus(listingmodel.OpenHouseRegistrationWaitlisted)
+		output.WaitlistPosition = waitlisted + 1
+	}
+
+	if _, err = s.visitRepo.InsertOpenHouseRegistration(ctx, tx, registration); err != nil {
+		utils.SetSpanError(ctx, err)
+		logger.Error("visit.open_house.register.insert_error", "open_house_id", openHouse.ID(), "err", err)
+		return OpenHouseRegistrationOutput{}, utils.InternalError("")
+	}
+
+	if commitErr := s.globalService.CommitTransaction(ctx, tx); commitErr != nil {
+		utils.SetSpanError(ctx, commitErr)
+		logger.Error("visit.open_house.register.tx_commit_error", "err", commitErr)
+		return OpenHouseRegistrationOutput{}, utils.InternalError("")
+	}
+	committed = true
+
+	return output, nil
+}
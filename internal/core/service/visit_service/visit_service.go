@@ -49,6 +49,15 @@ type Service interface {
 	MarkNoShow(ctx context.Context, visitID int64, ownerNotes string) (listingmodel.VisitInterface, error)
 	GetVisit(ctx context.Context, visitID int64) (VisitDetailOutput, error)
 	ListVisits(ctx context.Context, filter listingmodel.VisitListFilter) (VisitListOutput, error)
+
+	CreateOpenHouse(ctx context.Context, input CreateOpenHouseInput) (listingmodel.OpenHouseInterface, error)
+	CancelOpenHouse(ctx context.Context, openHouseID int64, reason string) (listingmodel.OpenHouseInterface, error)
+	ListOpenHouses(ctx context.Context, filter listingmodel.OpenHouseListFilter) (OpenHouseListOutput, error)
+	GetOpenHouse(ctx context.Context, openHouseID int64) (OpenHouseDetailOutput, error)
+	RegisterOpenHouseClient(ctx context.Context, input RegisterOpenHouseClientInput) (OpenHouseRegistrationOutput, error)
+	CancelOpenHouseRegistration(ctx context.Context, registrationID int64) (listingmodel.OpenHouseRegistrationInterface, error)
+	CheckInOpenHouseRegistration(ctx context.Context, registrationID int64, attended bool) (listingmodel.OpenHouseRegistrationInterface, error)
+	NotifyOpenHouse(ctx context.Context, input NotifyOpenHouseInput) (NotifyOpenHouseOutput, error)
 }
 
 // NewService wires the visit service dependencies.
@@ -0,0 +1,97 @@
+package templates
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//go:embed push_open_house_realtor_status.json
+var openHouseRealtorStatusTemplateBytes []byte
+
+//go:embed push_open_house_message.json
+var openHouseMessageTemplateBytes []byte
+
+var (
+	openHouseRealtorStatusOnce sync.Once
+	openHouseMessageOnce       sync.Once
+
+	openHouseRealtorStatusTpl visitTemplate
+	openHouseMessageTpl       visitTemplate
+
+	openHouseRealtorStatusErr error
+	openHouseMessageErr       error
+)
+
+// OpenHouseTemplateData contains dynamic values injected in open-house notifications.
+type OpenHouseTemplateData struct {
+	OpenHouseID       int64
+	RegistrationID    int64
+	ListingIdentityID int64
+	StartsAt          time.Time
+	SlotStart         time.Time
+	ClientName        string
+	Status            string
+	Message           string
+}
+
+// RenderOpenHouseRealtorStatus renders registration/open-house status updates for realtors.
+func RenderOpenHouseRealtorStatus(data OpenHouseTemplateData) (VisitPayload, error) {
+	tpl, err := loadOpenHouseTemplate(&openHouseRealtorStatusOnce, openHouseRealtorStatusTemplateBytes, &openHouseRealtorStatusTpl, &openHouseRealtorStatusErr, "open house realtor status")
+	if err != nil {
+		return VisitPayload{}, err
+	}
+	return renderOpenHouseTemplate(tpl, data)
+}
+
+// RenderOpenHouseMessage renders a free-text owner message broadcast to registered realtors.
+func RenderOpenHouseMessage(data OpenHouseTemplateData) (VisitPayload, error) {
+	tpl, err := loadOpenHouseTemplate(&openHouseMessageOnce, openHouseMessageTemplateBytes, &openHouseMessageTpl, &openHouseMessageErr, "open house message")
+	if err != nil {
+		return VisitPayload{}, err
+	}
+	return renderOpenHouseTemplate(tpl, data)
+}
+
+func renderOpenHouseTemplate(tpl visitTemplate, data OpenHouseTemplateData) (VisitPayload, error) {
+	placeholders := map[string]string{
+		"{{open_house_id}}":       strconv.FormatInt(data.OpenHouseID, 10),
+		"{{registration_id}}":     strconv.FormatInt(data.RegistrationID, 10),
+		"{{listing_identity_id}}": strconv.FormatInt(data.ListingIdentityID, 10),
+		"{{starts_at}}":           data.StartsAt.UTC().Format(time.RFC3339),
+		"{{slot_start}}":          data.SlotStart.UTC().Format(time.RFC3339),
+		"{{client_name}}":         strings.TrimSpace(data.ClientName),
+		"{{status}}":              strings.ToUpper(strings.TrimSpace(data.Status)),
+		"{{message}}":             strings.TrimSpace(data.Message),
+	}
+
+	rendered := VisitPayload{
+		Title: applyPlaceholders(tpl.Title, placeholders),
+		Body:  applyPlaceholders(tpl.Body, placeholders),
+		Data:  make(map[string]string, len(tpl.Data)+2),
+	}
+
+	for key, value := range tpl.Data {
+		rendered.Data[key] = applyPlaceholders(value, placeholders)
+	}
+
+	ensureData(rendered.Data, "open_house_id", placeholders["{{open_house_id}}"])
+	ensureData(rendered.Data, "listing_identity_id", placeholders["{{listing_identity_id}}"])
+
+	return rendered, nil
+}
+
+func loadOpenHouseTemplate(once *sync.Once, raw []byte, target *visitTemplate, errOut *error, name string) (visitTemplate, error) {
+	once.Do(func() {
+		if len(raw) == 0 {
+			*errOut = fmt.Errorf("%s template not found", name)
+			return
+		}
+		*errOut = json.Unmarshal(raw, target)
+	})
+	return *target, *errOut
+}
@@ -0,0 +1,276 @@
+// Package ics renders iCalendar (RFC 5545) documents for calendar subscriptions and parses imported ones.
+package ics
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Status is the VEVENT STATUS property.
+type Status string
+
+const (
+	StatusConfirmed Status = "CONFIRMED"
+	StatusTentative Status = "TENTATIVE"
+	StatusCancelled Status = "CANCELLED"
+)
+
+const (
+	maxLineOctets  = 75
+	utcLayout      = "20060102T150405Z"
+	localLayout    = "20060102T150405"
+	timezoneMargin = 24 * time.Hour
+)
+
+// Event is a single VEVENT. Start and End are written as local times of TZ with a TZID parameter,
+// or in UTC when TZ is nil or UTC.
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+	Start       time.Time
+	End         time.Time
+	TZ          *time.Location
+	Status      Status
+	// Transparent marks informational events that do not make the subscriber busy.
+	Transparent bool
+	Categories  []string
+}
+
+// Calendar is a VCALENDAR document. A VTIMEZONE is emitted for every time zone referenced by the events,
+// covering the offsets in force between the earliest start and the latest end.
+type Calendar struct {
+	ProductID string
+	Name      string
+	// RefreshInterval hints subscribers how often to poll; omitted when zero.
+	RefreshInterval time.Duration
+	Events          []Event
+}
+
+// Marshal renders the calendar with CRLF line endings and folded long lines. stamp becomes the DTSTAMP of
+// every event, i.e. the moment the feed was generated.
+func (c Calendar) Marshal(stamp time.Time) []byte {
+	w := &lineWriter{}
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:" + c.ProductID)
+	w.line("CALSCALE:GREGORIAN")
+	w.line("METHOD:PUBLISH")
+	if c.Name != "" {
+		w.line("X-WR-CALNAME:" + escapeText(c.Name))
+	}
+	if c.RefreshInterval > 0 {
+		duration := formatDuration(c.RefreshInterval)
+		w.line("REFRESH-INTERVAL;VALUE=DURATION:" + duration)
+		w.line("X-PUBLISHED-TTL:" + duration)
+	}
+
+	for _, tz := range c.timezones() {
+		writeTimezone(w, tz.loc, tz.from, tz.to)
+	}
+
+	dtstamp := stamp.UTC().Format(utcLayout)
+	for _, event := range c.Events {
+		w.line("BEGIN:VEVENT")
+		w.line("UID:" + event.UID)
+		w.line("DTSTAMP:" + dtstamp)
+		w.line(formatDateTime("DTSTART", event.Start, event.TZ))
+		w.line(formatDateTime("DTEND", event.End, event.TZ))
+		w.line("SUMMARY:" + escapeText(event.Summary))
+		if event.Description != "" {
+			w.line("DESCRIPTION:" + escapeText(event.Description))
+		}
+		if event.Location != "" {
+			w.line("LOCATION:" + escapeText(event.Location))
+		}
+		if event.Status != "" {
+			w.line("STATUS:" + string(event.Status))
+		}
+		if event.Transparent {
+			w.line("TRANSP:TRANSPARENT")
+		} else {
+			w.line("TRANSP:OPAQUE")
+		}
+		if len(event.Categories) > 0 {
+			escaped := make([]string, 0, len(event.Categories))
+			for _, category := range event.Categories {
+				escaped = append(escaped, escapeText(category))
+			}
+			w.line("CATEGORIES:" + strings.Join(escaped, ","))
+		}
+		w.line("END:VEVENT")
+	}
+
+	w.line("END:VCALENDAR")
+	return []byte(w.String())
+}
+
+type timezoneRange struct {
+	loc      *time.Location
+	from, to time.Time
+}
+
+func (c Calendar) timezones() []timezoneRange {
+	byName := make(map[string]*timezoneRange)
+	for _, event := range c.Events {
+		if isUTC(event.TZ) {
+			continue
+		}
+		name := event.TZ.String()
+		tz, ok := byName[name]
+		if !ok {
+			byName[name] = &timezoneRange{loc: event.TZ, from: event.Start, to: event.End}
+			continue
+		}
+		if event.Start.Before(tz.from) {
+			tz.from = event.Start
+		}
+		if event.End.After(tz.to) {
+			tz.to = event.End
+		}
+	}
+
+	result := make([]timezoneRange, 0, len(byName))
+	for _, tz := range byName {
+		result = append(result, *tz)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].loc.String() < result[j].loc.String() })
+	return result
+}
+
+// writeTimezone describes loc with one STANDARD/DAYLIGHT block per offset in force during [from, to].
+// Transitions are found from the Go zone database, so no RRULEs are needed.
+func writeTimezone(w *lineWriter, loc *time.Location, from, to time.Time) {
+	from = from.Add(-timezoneMargin).UTC()
+	to = to.Add(timezoneMargin).UTC()
+
+	w.line("BEGIN:VTIMEZONE")
+	w.line("TZID:" + loc.String())
+
+	name, offset := from.In(loc).Zone()
+	writeObservance(w, from.In(loc).IsDST(), from, offset, offset, name)
+
+	for cursor := from; cursor.Before(to); cursor = cursor.Add(time.Hour) {
+		next := cursor.Add(time.Hour)
+		nextName, nextOffset := next.In(loc).Zone()
+		if nextOffset == offset {
+			continue
+		}
+		onset := findTransition(loc, cursor, next, offset)
+		writeObservance(w, onset.In(loc).IsDST(), onset, offset, nextOffset, nextName)
+		offset = nextOffset
+	}
+
+	w.line("END:VTIMEZONE")
+}
+
+func writeObservance(w *lineWriter, daylight bool, onset time.Time, offsetFrom, offsetTo int, name string) {
+	kind := "STANDARD"
+	if daylight {
+		kind = "DAYLIGHT"
+	}
+	// DTSTART of an observance is the local time before the onset, i.e. expressed with TZOFFSETFROM.
+	local := onset.Add(time.Duration(offsetFrom) * time.Second).UTC()
+
+	w.line("BEGIN:" + kind)
+	w.line("DTSTART:" + local.Format(localLayout))
+	w.line("TZOFFSETFROM:" + formatOffset(offsetFrom))
+	w.line("TZOFFSETTO:" + formatOffset(offsetTo))
+	if name != "" {
+		w.line("TZNAME:" + escapeText(name))
+	}
+	w.line("END:" + kind)
+}
+
+// findTransition returns the first minute in (lo, hi] whose offset differs from offset.
+func findTransition(loc *time.Location, lo, hi time.Time, offset int) time.Time {
+	for hi.Sub(lo) > time.Minute {
+		mid := lo.Add(hi.Sub(lo) / 2).Truncate(time.Minute)
+		if !mid.After(lo) {
+			break
+		}
+		if _, midOffset := mid.In(loc).Zone(); midOffset == offset {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hi
+}
+
+func formatDateTime(property string, value time.Time, tz *time.Location) string {
+	if isUTC(tz) {
+		return property + ":" + value.UTC().Format(utcLayout)
+	}
+	return property + ";TZID=" + tz.String() + ":" + value.In(tz).Format(localLayout)
+}
+
+func formatOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+	hours := seconds / 3600
+	minutes := (seconds % 3600) / 60
+	return fmt.Sprintf("%s%02d%02d", sign, hours, minutes)
+}
+
+func formatDuration(d time.Duration) string {
+	minutes := int(d.Round(time.Minute) / time.Minute)
+	if minutes <= 0 {
+		minutes = 1
+	}
+	if minutes%60 == 0 {
+		return "PT" + strconv.Itoa(minutes/60) + "H"
+	}
+	return "PT" + strconv.Itoa(minutes) + "M"
+}
+
+func isUTC(loc *time.Location) bool {
+	return loc == nil || loc == time.UTC || loc.String() == "UTC"
+}
+
+// escapeText escapes a TEXT value (RFC 5545 section 3.3.11).
+func escapeText(value string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	)
+	return replacer.Replace(value)
+}
+
+// lineWriter accumulates content lines, folding them at 75 octets without splitting UTF-8 sequences.
+type lineWriter struct {
+	sb strings.Builder
+}
+
+func (w *lineWriter) line(value string) {
+	limit := maxLineOctets
+	for len(value) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(value[cut]) {
+			cut--
+		}
+		w.sb.WriteString(value[:cut])
+		w.sb.WriteString("\r\n ")
+		value = value[cut:]
+		// Continuation lines start with a space, which counts towards the limit.
+		limit = maxLineOctets - 1
+	}
+	w.sb.WriteString(value)
+	w.sb.WriteString("\r\n")
+}
+
+func (w *lineWriter) String() string {
+	return w.sb.String()
+}
@@ -0,0 +1,181 @@
+package ics
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestMarshalFoldsLongLines(t *testing.T) {
+	t.Parallel()
+
+	summary := strings.Repeat("Visita ao imóvel; chave na portaria, ", 6) + "ligar antes\nnão tocar a campainha"
+	start := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	calendar := Calendar{
+		ProductID: "-//TOQ//Agenda//PT",
+		Events:    []Event{{UID: "visit-1@toq", Summary: summary, Start: start, End: start.Add(time.Hour)}},
+	}
+
+	document := calendar.Marshal(start)
+	if !bytes.HasSuffix(document, []byte("END:VCALENDAR\r\n")) {
+		t.Fatalf("Marshal() does not end with a CRLF terminated END:VCALENDAR")
+	}
+
+	lines := strings.Split(strings.TrimSuffix(string(document), "\r\n"), "\r\n")
+	folded := 0
+	for i, line := range lines {
+		if strings.Contains(line, "\n") {
+			t.Fatalf("line %d contains a bare LF: %q", i, line)
+		}
+		if len(line) > maxLineOctets {
+			t.Fatalf("line %d has %d octets, expected at most %d: %q", i, len(line), maxLineOctets, line)
+		}
+		if !utf8.ValidString(line) {
+			t.Fatalf("line %d splits a UTF-8 sequence: %q", i, line)
+		}
+		if strings.HasPrefix(line, " ") {
+			folded++
+		}
+	}
+	if folded == 0 {
+		t.Fatalf("Marshal() did not fold the %d octets summary", len(summary))
+	}
+
+	events, err := Parse(bytes.NewReader(document), time.UTC)
+	if err != nil {
+		t.Fatalf("Parse of the marshalled calendar unexpected error: %v", err)
+	}
+	if len(events) != 1 || events[0].Summary != summary {
+		t.Fatalf("round trip summary = %+v, expected %q", events, summary)
+	}
+	if !events[0].Start.Equal(start) || !events[0].End.Equal(start.Add(time.Hour)) {
+		t.Fatalf("round trip times = %s/%s, expected %s/%s", events[0].Start, events[0].End, start, start.Add(time.Hour))
+	}
+}
+
+func TestMarshalTimezones(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	cases := []struct {
+		name     string
+		events   []Event
+		expected []string
+	}{
+		{
+			name: "utc events need no timezone",
+			events: []Event{
+				{UID: "utc", Start: time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC), End: time.Date(2026, time.March, 2, 13, 0, 0, 0, time.UTC)},
+			},
+			expected: []string{
+				"DTSTART:20260302T120000Z",
+				"DTEND:20260302T130000Z",
+			},
+		},
+		{
+			name: "fixed offset zone has a single observance",
+			events: []Event{
+				{UID: "sp", TZ: saoPaulo, Start: time.Date(2026, time.March, 2, 9, 0, 0, 0, saoPaulo), End: time.Date(2026, time.March, 2, 10, 0, 0, 0, saoPaulo)},
+			},
+			expected: []string{
+				"BEGIN:VTIMEZONE",
+				"TZID:America/Sao_Paulo",
+				"BEGIN:STANDARD",
+				"DTSTART:20260301T090000",
+				"TZOFFSETFROM:-0300",
+				"TZOFFSETTO:-0300",
+				"TZNAME:-03",
+				"END:STANDARD",
+				"END:VTIMEZONE",
+				"DTSTART;TZID=America/Sao_Paulo:20260302T090000",
+				"DTEND;TZID=America/Sao_Paulo:20260302T100000",
+			},
+		},
+		{
+			name: "range across a daylight saving change",
+			events: []Event{
+				{UID: "before", TZ: newYork, Start: time.Date(2026, time.March, 2, 9, 0, 0, 0, newYork), End: time.Date(2026, time.March, 2, 10, 0, 0, 0, newYork)},
+				{UID: "after", TZ: newYork, Start: time.Date(2026, time.March, 16, 9, 0, 0, 0, newYork), End: time.Date(2026, time.March, 16, 10, 0, 0, 0, newYork)},
+			},
+			expected: []string{
+				"BEGIN:VTIMEZONE",
+				"TZID:America/New_York",
+				"BEGIN:STANDARD",
+				"DTSTART:20260301T090000",
+				"TZOFFSETFROM:-0500",
+				"TZOFFSETTO:-0500",
+				"TZNAME:EST",
+				"END:STANDARD",
+				"BEGIN:DAYLIGHT",
+				"DTSTART:20260308T020000",
+				"TZOFFSETFROM:-0500",
+				"TZOFFSETTO:-0400",
+				"TZNAME:EDT",
+				"END:DAYLIGHT",
+				"END:VTIMEZONE",
+				"DTSTART;TZID=America/New_York:20260302T090000",
+				"DTSTART;TZID=America/New_York:20260316T090000",
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			document := string(Calendar{ProductID: "-//TOQ//Agenda//PT", Events: tt.events}.Marshal(time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)))
+
+			// Expected lines must appear in this order.
+			rest := document
+			for _, line := range tt.expected {
+				index := strings.Index(rest, line+"\r\n")
+				if index < 0 {
+					t.Fatalf("Marshal() = %q, expected line %q after the previous expected lines", document, line)
+				}
+				rest = rest[index+len(line)+2:]
+			}
+			if block := strings.Count(document, "BEGIN:VTIMEZONE"); block > 1 {
+				t.Fatalf("Marshal() emitted %d VTIMEZONE blocks, expected at most one", block)
+			}
+			if len(tt.events) > 0 && isUTC(tt.events[0].TZ) && strings.Contains(document, "VTIMEZONE") {
+				t.Fatalf("Marshal() emitted a VTIMEZONE for UTC events: %q", document)
+			}
+		})
+	}
+}
+
+func TestMarshalRefreshInterval(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		interval time.Duration
+		expected string
+	}{
+		{interval: 2 * time.Hour, expected: "REFRESH-INTERVAL;VALUE=DURATION:PT2H"},
+		{interval: 90 * time.Minute, expected: "REFRESH-INTERVAL;VALUE=DURATION:PT90M"},
+		{interval: 10 * time.Second, expected: "REFRESH-INTERVAL;VALUE=DURATION:PT1M"},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.expected, func(t *testing.T) {
+			t.Parallel()
+
+			document := string(Calendar{ProductID: "-//TOQ//Agenda//PT", RefreshInterval: tt.interval}.Marshal(time.Now()))
+			if !strings.Contains(document, tt.expected+"\r\n") {
+				t.Fatalf("Marshal(RefreshInterval %s) = %q, expected it to contain %q", tt.interval, document, tt.expected)
+			}
+		})
+	}
+}
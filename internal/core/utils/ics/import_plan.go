@@ -0,0 +1,130 @@
+package ics
+
+import (
+	"sort"
+	"time"
+)
+
+// Span is a half-open time interval [Start, End).
+type Span struct {
+	Start time.Time
+	End   time.Time
+}
+
+func (s Span) overlaps(other Span) bool {
+	return other.Start.Before(s.End) && other.End.After(s.Start)
+}
+
+func (s Span) covers(other Span) bool {
+	return !s.Start.After(other.Start) && !s.End.Before(other.End)
+}
+
+// Coverage tells whether a part of an imported occurrence is already present in the target agenda.
+type Coverage string
+
+const (
+	// CoverageNone means the part is free and can be imported.
+	CoverageNone Coverage = "NONE"
+	// CoverageBlocked means the part is already covered by an existing block.
+	CoverageBlocked Coverage = "BLOCKED"
+	// CoverageDuplicate means the part is covered by an earlier occurrence of the same import.
+	CoverageDuplicate Coverage = "DUPLICATE"
+)
+
+// Segment is a contiguous part of an occurrence sharing the same coverage.
+type Segment struct {
+	Span
+	Coverage Coverage
+}
+
+// Classification is the outcome of matching one occurrence against the agenda.
+type Classification struct {
+	// Conflicts holds the indexes of the busy spans overlapping the occurrence. Conflicting occurrences
+	// cannot be imported and have no segments.
+	Conflicts []int
+	// Segments splits the occurrence by coverage, in chronological order. A single segment means the
+	// whole occurrence shares one coverage.
+	Segments []Segment
+}
+
+// ImportPlanner classifies the occurrences of an imported calendar against the busy periods of an
+// agenda (entries the import must not overlap) and its existing blocks. Occurrences must be classified
+// in order: the free parts of each one are remembered to detect duplicates in the following ones.
+type ImportPlanner struct {
+	busy     []Span
+	blocked  []Span
+	accepted []Span
+}
+
+// NewImportPlanner returns a planner for an agenda with the given busy periods and existing blocks.
+func NewImportPlanner(busy, blocked []Span) *ImportPlanner {
+	return &ImportPlanner{busy: busy, blocked: blocked}
+}
+
+// Classify reports the busy periods overlapping the occurrence or, when there are none, splits it into
+// the parts already blocked, the parts repeated from earlier occurrences and the parts left to import.
+func (p *ImportPlanner) Classify(occurrence Span) Classification {
+	var result Classification
+	for i, busy := range p.busy {
+		if occurrence.overlaps(busy) {
+			result.Conflicts = append(result.Conflicts, i)
+		}
+	}
+	if len(result.Conflicts) > 0 {
+		return result
+	}
+
+	bounds := []time.Time{occurrence.Start, occurrence.End}
+	for _, spans := range [][]Span{p.blocked, p.accepted} {
+		for _, span := range spans {
+			if !occurrence.overlaps(span) {
+				continue
+			}
+			if span.Start.After(occurrence.Start) {
+				bounds = append(bounds, span.Start)
+			}
+			if span.End.Before(occurrence.End) {
+				bounds = append(bounds, span.End)
+			}
+		}
+	}
+	sort.Slice(bounds, func(i, j int) bool { return bounds[i].Before(bounds[j]) })
+
+	for i := 1; i < len(bounds); i++ {
+		part := Span{Start: bounds[i-1], End: bounds[i]}
+		if !part.Start.Before(part.End) {
+			continue
+		}
+
+		coverage := CoverageNone
+		if coveredBy(p.blocked, part) {
+			coverage = CoverageBlocked
+		} else if coveredBy(p.accepted, part) {
+			coverage = CoverageDuplicate
+		}
+
+		if last := len(result.Segments) - 1; last >= 0 && result.Segments[last].Coverage == coverage {
+			result.Segments[last].End = part.End
+			continue
+		}
+		result.Segments = append(result.Segments, Segment{Span: part, Coverage: coverage})
+	}
+
+	for _, segment := range result.Segments {
+		if segment.Coverage == CoverageNone {
+			p.accepted = append(p.accepted, segment.Span)
+		}
+	}
+	return result
+}
+
+// coveredBy reports whether part lies inside one of spans. Parts come from cutting at every span
+// boundary, so a part is never covered by the union of spans without being covered by one of them.
+func coveredBy(spans []Span, part Span) bool {
+	for _, span := range spans {
+		if span.covers(part) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package ics
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func planHour(hour int) time.Time {
+	return time.Date(2026, time.March, 2, hour, 0, 0, 0, time.UTC)
+}
+
+func planSpan(from, to int) Span {
+	return Span{Start: planHour(from), End: planHour(to)}
+}
+
+func formatSegments(segments []Segment) string {
+	parts := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		parts = append(parts, fmt.Sprintf("%02d-%02d:%s", segment.Start.Hour(), segment.End.Hour(), segment.Coverage))
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestImportPlannerClassify(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		busy        []Span
+		blocked     []Span
+		occurrences []Span
+		expected    []string
+	}{
+		{
+			name:        "free occurrence",
+			occurrences: []Span{planSpan(9, 12)},
+			expected:    []string{"09-12:NONE"},
+		},
+		{
+			name:        "fully blocked",
+			blocked:     []Span{planSpan(8, 13)},
+			occurrences: []Span{planSpan(9, 12)},
+			expected:    []string{"09-12:BLOCKED"},
+		},
+		{
+			name:        "partly blocked keeps the remainder",
+			blocked:     []Span{planSpan(10, 11)},
+			occurrences: []Span{planSpan(9, 12)},
+			expected:    []string{"09-10:NONE 10-11:BLOCKED 11-12:NONE"},
+		},
+		{
+			name:        "adjacent blocks merge",
+			blocked:     []Span{planSpan(9, 10), planSpan(10, 12)},
+			occurrences: []Span{planSpan(9, 12)},
+			expected:    []string{"09-12:BLOCKED"},
+		},
+		{
+			name:        "touching block is not an overlap",
+			blocked:     []Span{planSpan(12, 14)},
+			occurrences: []Span{planSpan(9, 12)},
+			expected:    []string{"09-12:NONE"},
+		},
+		{
+			name:        "partial duplicate keeps the new part",
+			occurrences: []Span{planSpan(9, 11), planSpan(10, 12)},
+			expected:    []string{"09-11:NONE", "10-11:DUPLICATE 11-12:NONE"},
+		},
+		{
+			name:        "full duplicate",
+			occurrences: []Span{planSpan(9, 12), planSpan(10, 11)},
+			expected:    []string{"09-12:NONE", "10-11:DUPLICATE"},
+		},
+		{
+			name:        "blocked takes precedence over duplicate",
+			blocked:     []Span{planSpan(10, 11)},
+			occurrences: []Span{planSpan(9, 11), planSpan(9, 12)},
+			expected:    []string{"09-10:NONE 10-11:BLOCKED", "09-10:DUPLICATE 10-11:BLOCKED 11-12:NONE"},
+		},
+		{
+			name:        "conflicts have no segments and are not remembered",
+			busy:        []Span{planSpan(10, 11)},
+			occurrences: []Span{planSpan(9, 12), planSpan(11, 12)},
+			expected:    []string{"conflicts:[0]", "11-12:NONE"},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			planner := NewImportPlanner(tt.busy, tt.blocked)
+			for i, occurrence := range tt.occurrences {
+				classification := planner.Classify(occurrence)
+				got := formatSegments(classification.Segments)
+				if len(classification.Conflicts) > 0 {
+					got = fmt.Sprintf("conflicts:%v", classification.Conflicts)
+				}
+				if got != tt.expected[i] {
+					t.Fatalf("Classify(occurrence %d) = %q, expected %q", i, got, tt.expected[i])
+				}
+			}
+		})
+	}
+}
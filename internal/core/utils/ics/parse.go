@@ -0,0 +1,407 @@
+package ics
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidCalendar is returned when the input is not a parseable VCALENDAR.
+	ErrInvalidCalendar = errors.New("ics: invalid calendar")
+	// ErrUnsupportedRecurrence marks RRULEs using parts this package cannot expand.
+	ErrUnsupportedRecurrence = errors.New("ics: unsupported recurrence rule")
+)
+
+const (
+	dateLayout     = "20060102"
+	maxLineLength  = 1 << 20
+	componentEvent = "VEVENT"
+)
+
+// ParsedEvent is a VEVENT read from an imported calendar. Times carry the location of their TZID
+// (or the default location for floating and all-day values).
+type ParsedEvent struct {
+	UID         string
+	Summary     string
+	Start       time.Time
+	End         time.Time
+	AllDay      bool
+	Status      Status
+	Transparent bool
+	// Recurrence is nil for single events; RecurrenceErr is set when an RRULE exists but cannot be expanded.
+	Recurrence    *Recurrence
+	RecurrenceErr error
+	ExDates       []DateValue
+	// RecurrenceID identifies the instance of a recurring event this VEVENT overrides.
+	RecurrenceID *DateValue
+}
+
+// DateValue is a DATE or DATE-TIME property value.
+type DateValue struct {
+	Time   time.Time
+	AllDay bool
+}
+
+// matches reports whether the value designates the instance starting at t.
+func (d DateValue) matches(t time.Time) bool {
+	if d.AllDay {
+		y1, m1, d1 := d.Time.Date()
+		y2, m2, d2 := t.In(d.Time.Location()).Date()
+		return y1 == y2 && m1 == m2 && d1 == d2
+	}
+	return d.Time.Equal(t)
+}
+
+type contentLine struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Parse reads the VEVENTs of an iCalendar document. defaultLoc is used for floating times, all-day
+// dates and TZIDs that are not IANA identifiers (VTIMEZONE definitions are not interpreted).
+func Parse(r io.Reader, defaultLoc *time.Location) ([]ParsedEvent, error) {
+	if defaultLoc == nil {
+		defaultLoc = time.UTC
+	}
+
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		events     []ParsedEvent
+		stack      []string
+		current    *ParsedEvent
+		rawRule    string
+		hasStart   bool
+		hasEnd     bool
+		duration   string
+		sawCalBody bool
+	)
+
+	for number, raw := range lines {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		line, lineErr := parseContentLine(raw)
+		if lineErr != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidCalendar, number+1, lineErr)
+		}
+
+		switch line.name {
+		case "BEGIN":
+			component := strings.ToUpper(line.value)
+			if component == "VCALENDAR" {
+				sawCalBody = true
+			}
+			stack = append(stack, component)
+			if component == componentEvent && len(stack) >= 2 {
+				current = &ParsedEvent{}
+				rawRule, duration, hasStart, hasEnd = "", "", false, false
+			}
+			continue
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1] != strings.ToUpper(line.value) {
+				return nil, fmt.Errorf("%w: line %d: unbalanced END:%s", ErrInvalidCalendar, number+1, line.value)
+			}
+			stack = stack[:len(stack)-1]
+			if strings.ToUpper(line.value) == componentEvent && current != nil {
+				if !hasStart {
+					return nil, fmt.Errorf("%w: event %q without DTSTART", ErrInvalidCalendar, current.UID)
+				}
+				if finishErr := finishEvent(current, hasEnd, duration, rawRule); finishErr != nil {
+					return nil, fmt.Errorf("%w: event %q: %v", ErrInvalidCalendar, current.UID, finishErr)
+				}
+				events = append(events, *current)
+				current = nil
+			}
+			continue
+		}
+
+		if current == nil || len(stack) == 0 || stack[len(stack)-1] != componentEvent {
+			continue
+		}
+
+		switch line.name {
+		case "UID":
+			current.UID = strings.TrimSpace(line.value)
+		case "SUMMARY":
+			current.Summary = unescapeText(line.value)
+		case "STATUS":
+			current.Status = Status(strings.ToUpper(strings.TrimSpace(line.value)))
+		case "TRANSP":
+			current.Transparent = strings.EqualFold(strings.TrimSpace(line.value), "TRANSPARENT")
+		case "DTSTART":
+			value, valueErr := parseDateValue(line, defaultLoc)
+			if valueErr != nil {
+				return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidCalendar, number+1, valueErr)
+			}
+			current.Start, current.AllDay, hasStart = value.Time, value.AllDay, true
+		case "DTEND":
+			value, valueErr := parseDateValue(line, defaultLoc)
+			if valueErr != nil {
+				return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidCalendar, number+1, valueErr)
+			}
+			current.End, hasEnd = value.Time, true
+		case "DURATION":
+			duration = strings.TrimSpace(line.value)
+		case "RRULE":
+			rawRule = strings.TrimSpace(line.value)
+		case "EXDATE":
+			for _, part := range strings.Split(line.value, ",") {
+				value, valueErr := parseDateValue(contentLine{name: line.name, params: line.params, value: part}, defaultLoc)
+				if valueErr != nil {
+					return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidCalendar, number+1, valueErr)
+				}
+				current.ExDates = append(current.ExDates, value)
+			}
+		case "RECURRENCE-ID":
+			value, valueErr := parseDateValue(line, defaultLoc)
+			if valueErr != nil {
+				return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidCalendar, number+1, valueErr)
+			}
+			current.RecurrenceID = &value
+		}
+	}
+
+	if !sawCalBody || len(stack) != 0 {
+		return nil, fmt.Errorf("%w: missing or unterminated VCALENDAR", ErrInvalidCalendar)
+	}
+
+	return events, nil
+}
+
+func finishEvent(event *ParsedEvent, hasEnd bool, duration, rawRule string) error {
+	switch {
+	case hasEnd:
+		if event.AllDay {
+			// DTEND of all-day events is a DATE as well; keep it at midnight of the start location.
+			y, m, d := event.End.Date()
+			event.End = time.Date(y, m, d, 0, 0, 0, 0, event.Start.Location())
+		}
+	case duration != "":
+		days, clock, err := parseDuration(duration)
+		if err != nil {
+			return err
+		}
+		event.End = event.Start.AddDate(0, 0, days).Add(clock)
+	case event.AllDay:
+		event.End = event.Start.AddDate(0, 0, 1)
+	default:
+		event.End = event.Start
+	}
+
+	if event.End.Before(event.Start) {
+		return errors.New("DTEND before DTSTART")
+	}
+
+	if rawRule != "" {
+		rule, err := parseRecurrence(rawRule, event.Start)
+		if err != nil {
+			if !errors.Is(err, ErrUnsupportedRecurrence) {
+				return err
+			}
+			event.RecurrenceErr = err
+		}
+		event.Recurrence = rule
+	}
+
+	return nil
+}
+
+// unfold joins folded lines (RFC 5545 section 3.1) and strips line terminators.
+func unfold(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineLength)
+
+	var lines []string
+	for scanner.Scan() {
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if len(lines) == 0 {
+			text = strings.TrimPrefix(text, "\ufeff")
+		}
+		if (strings.HasPrefix(text, " ") || strings.HasPrefix(text, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += text[1:]
+			continue
+		}
+		lines = append(lines, text)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCalendar, err)
+	}
+	return lines, nil
+}
+
+func parseContentLine(raw string) (contentLine, error) {
+	inQuotes := false
+	colon := -1
+	for i, r := range raw {
+		if r == '"' {
+			inQuotes = !inQuotes
+		}
+		if r == ':' && !inQuotes {
+			colon = i
+			break
+		}
+	}
+	if colon <= 0 {
+		return contentLine{}, errors.New("missing ':' separator")
+	}
+
+	head := raw[:colon]
+	line := contentLine{value: raw[colon+1:], params: make(map[string]string)}
+
+	segments := splitOutsideQuotes(head, ';')
+	line.name = strings.ToUpper(strings.TrimSpace(segments[0]))
+	for _, segment := range segments[1:] {
+		key, value, ok := strings.Cut(segment, "=")
+		if !ok {
+			continue
+		}
+		line.params[strings.ToUpper(strings.TrimSpace(key))] = strings.Trim(strings.TrimSpace(value), `"`)
+	}
+
+	return line, nil
+}
+
+func splitOutsideQuotes(value string, sep rune) []string {
+	var (
+		parts    []string
+		inQuotes bool
+		start    int
+	)
+	for i, r := range value {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+		case r == sep && !inQuotes:
+			parts = append(parts, value[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, value[start:])
+}
+
+func parseDateValue(line contentLine, defaultLoc *time.Location) (DateValue, error) {
+	value := strings.TrimSpace(line.value)
+	if strings.EqualFold(line.params["VALUE"], "DATE") || len(value) == len(dateLayout) {
+		t, err := time.ParseInLocation(dateLayout, value, defaultLoc)
+		if err != nil {
+			return DateValue{}, fmt.Errorf("invalid %s date %q", line.name, value)
+		}
+		return DateValue{Time: t, AllDay: true}, nil
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(utcLayout, value)
+		if err != nil {
+			return DateValue{}, fmt.Errorf("invalid %s date-time %q", line.name, value)
+		}
+		return DateValue{Time: t}, nil
+	}
+
+	loc := defaultLoc
+	if tzid := strings.TrimPrefix(line.params["TZID"], "/"); tzid != "" {
+		if named, err := time.LoadLocation(tzid); err == nil {
+			loc = named
+		}
+	}
+	t, err := time.ParseInLocation(localLayout, value, loc)
+	if err != nil {
+		return DateValue{}, fmt.Errorf("invalid %s date-time %q", line.name, value)
+	}
+	return DateValue{Time: t}, nil
+}
+
+// parseDuration splits an RFC 5545 duration into calendar days and clock time, so that
+// day-based durations keep the wall-clock time across DST changes.
+func parseDuration(value string) (int, time.Duration, error) {
+	raw := strings.ToUpper(strings.TrimSpace(value))
+	sign := 1
+	switch {
+	case strings.HasPrefix(raw, "-"):
+		sign = -1
+		raw = raw[1:]
+	case strings.HasPrefix(raw, "+"):
+		raw = raw[1:]
+	}
+	if !strings.HasPrefix(raw, "P") || len(raw) < 3 {
+		return 0, 0, fmt.Errorf("invalid duration %q", value)
+	}
+	raw = raw[1:]
+
+	var (
+		days   int
+		clock  time.Duration
+		inTime bool
+		digits strings.Builder
+	)
+	for _, r := range raw {
+		if r >= '0' && r <= '9' {
+			digits.WriteRune(r)
+			continue
+		}
+		if r == 'T' {
+			inTime = true
+			continue
+		}
+		if digits.Len() == 0 {
+			return 0, 0, fmt.Errorf("invalid duration %q", value)
+		}
+		n, _ := strconv.Atoi(digits.String())
+		digits.Reset()
+		switch {
+		case r == 'W' && !inTime:
+			days += 7 * n
+		case r == 'D' && !inTime:
+			days += n
+		case r == 'H' && inTime:
+			clock += time.Duration(n) * time.Hour
+		case r == 'M' && inTime:
+			clock += time.Duration(n) * time.Minute
+		case r == 'S' && inTime:
+			clock += time.Duration(n) * time.Second
+		default:
+			return 0, 0, fmt.Errorf("invalid duration %q", value)
+		}
+	}
+	if digits.Len() != 0 {
+		return 0, 0, fmt.Errorf("invalid duration %q", value)
+	}
+
+	return sign * days, time.Duration(sign) * clock, nil
+}
+
+// unescapeText reverses escapeText.
+func unescapeText(value string) string {
+	if !strings.Contains(value, `\`) {
+		return value
+	}
+	var sb strings.Builder
+	escaped := false
+	for _, r := range value {
+		if !escaped {
+			if r == '\\' {
+				escaped = true
+				continue
+			}
+			sb.WriteRune(r)
+			continue
+		}
+		escaped = false
+		switch r {
+		case 'n', 'N':
+			sb.WriteRune('\n')
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
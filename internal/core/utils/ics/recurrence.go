@@ -0,0 +1,505 @@
+package ics
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the RRULE FREQ part; only day-based frequencies are supported.
+type Frequency string
+
+const (
+	FrequencyDaily   Frequency = "DAILY"
+	FrequencyWeekly  Frequency = "WEEKLY"
+	FrequencyMonthly Frequency = "MONTHLY"
+	FrequencyYearly  Frequency = "YEARLY"
+)
+
+// maxPeriods bounds the expansion of rules whose DTSTART lies far in the past.
+const maxPeriods = 100000
+
+// ErrTooManyOccurrences is returned by Expand when the window holds more instances than allowed.
+var ErrTooManyOccurrences = errors.New("ics: too many occurrences")
+
+// WeekdayNum is a BYDAY element; Ordinal is 0 for "every", positive from the start or negative from
+// the end of the month.
+type WeekdayNum struct {
+	Ordinal int
+	Day     time.Weekday
+}
+
+// Recurrence is a parsed RRULE.
+type Recurrence struct {
+	Freq       Frequency
+	Interval   int
+	Count      int
+	Until      *time.Time
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	ByMonth    []time.Month
+	WeekStart  time.Weekday
+}
+
+// Occurrence is one concrete busy interval produced by Expand.
+type Occurrence struct {
+	UID     string
+	Summary string
+	Start   time.Time
+	End     time.Time
+	AllDay  bool
+}
+
+// Expansion is the result of expanding a parsed calendar over a window.
+type Expansion struct {
+	Occurrences []Occurrence
+	// Unsupported lists recurring events whose RRULE could not be expanded; they are not in Occurrences.
+	Unsupported []ParsedEvent
+}
+
+var weekdayCodes = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// Expand returns the busy occurrences overlapping [from, to) ordered by start. Cancelled and
+// transparent (free) events are ignored, EXDATEs are removed and RECURRENCE-ID overrides replace the
+// instance they designate. ErrTooManyOccurrences is returned when more than limit instances match.
+func Expand(events []ParsedEvent, from, to time.Time, limit int) (Expansion, error) {
+	overrides := make(map[string][]DateValue)
+	for _, event := range events {
+		if event.RecurrenceID != nil && event.UID != "" {
+			overrides[event.UID] = append(overrides[event.UID], *event.RecurrenceID)
+		}
+	}
+
+	var result Expansion
+	emit := func(event ParsedEvent, start, end time.Time) error {
+		if !start.Before(to) || !end.After(from) || !end.After(start) {
+			return nil
+		}
+		if limit > 0 && len(result.Occurrences) >= limit {
+			return ErrTooManyOccurrences
+		}
+		result.Occurrences = append(result.Occurrences, Occurrence{
+			UID:     event.UID,
+			Summary: event.Summary,
+			Start:   start,
+			End:     end,
+			AllDay:  event.AllDay,
+		})
+		return nil
+	}
+
+	for _, event := range events {
+		if event.Status == StatusCancelled || event.Transparent {
+			continue
+		}
+		if event.Recurrence == nil || event.RecurrenceID != nil {
+			if event.RecurrenceErr != nil && event.RecurrenceID == nil {
+				result.Unsupported = append(result.Unsupported, event)
+				continue
+			}
+			if err := emit(event, event.Start, event.End); err != nil {
+				return Expansion{}, err
+			}
+			continue
+		}
+
+		excluded := append(append([]DateValue{}, event.ExDates...), overrides[event.UID]...)
+		if err := expandEvent(event, excluded, to, emit); err != nil {
+			return Expansion{}, err
+		}
+	}
+
+	sort.SliceStable(result.Occurrences, func(i, j int) bool {
+		a, b := result.Occurrences[i], result.Occurrences[j]
+		if !a.Start.Equal(b.Start) {
+			return a.Start.Before(b.Start)
+		}
+		return a.UID < b.UID
+	})
+
+	return result, nil
+}
+
+func expandEvent(event ParsedEvent, excluded []DateValue, to time.Time, emit func(ParsedEvent, time.Time, time.Time) error) error {
+	rule := event.Recurrence
+	start := event.Start
+	days := 0
+	length := event.End.Sub(event.Start)
+	if event.AllDay {
+		days = int(event.End.Sub(event.Start).Round(24*time.Hour) / (24 * time.Hour))
+	}
+
+	count := 0
+	for period := 0; period < maxPeriods; period++ {
+		candidates, periodStart := candidatesForPeriod(rule, start, period)
+		if !periodStart.Before(to) {
+			return nil
+		}
+
+		for _, candidate := range candidates {
+			if candidate.Before(start) {
+				continue
+			}
+			if rule.Until != nil && candidate.After(*rule.Until) {
+				return nil
+			}
+			if rule.Count > 0 && count >= rule.Count {
+				return nil
+			}
+			count++
+			if !candidate.Before(to) {
+				return nil
+			}
+			if isExcluded(excluded, candidate) {
+				continue
+			}
+
+			end := candidate.Add(length)
+			if event.AllDay {
+				end = candidate.AddDate(0, 0, days)
+			}
+			if err := emit(event, candidate, end); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func isExcluded(excluded []DateValue, candidate time.Time) bool {
+	for _, value := range excluded {
+		if value.matches(candidate) {
+			return true
+		}
+	}
+	return false
+}
+
+// candidatesForPeriod returns the sorted instance starts of the period-th interval of the rule and
+// the first day of that interval.
+func candidatesForPeriod(rule *Recurrence, start time.Time, period int) ([]time.Time, time.Time) {
+	loc := start.Location()
+	y, m, d := start.Date()
+	hh, mm, ss := start.Clock()
+	at := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, hh, mm, ss, 0, loc)
+	}
+
+	var dates []time.Time
+	var periodStart time.Time
+
+	switch rule.Freq {
+	case FrequencyDaily:
+		day := at(y, m, d+period*rule.Interval)
+		periodStart = day
+		if monthAllowed(rule, day.Month()) && weekdayAllowed(rule, day.Weekday()) && monthDayAllowed(rule, day) {
+			dates = append(dates, day)
+		}
+	case FrequencyWeekly:
+		offset := (int(start.Weekday()) - int(rule.WeekStart) + 7) % 7
+		weekStart := at(y, m, d-offset+period*rule.Interval*7)
+		periodStart = weekStart
+		weekdays := []time.Weekday{start.Weekday()}
+		if len(rule.ByDay) > 0 {
+			weekdays = weekdays[:0]
+			for _, byDay := range rule.ByDay {
+				weekdays = append(weekdays, byDay.Day)
+			}
+		}
+		for _, weekday := range weekdays {
+			wy, wm, wd := weekStart.Date()
+			day := at(wy, wm, wd+(int(weekday)-int(rule.WeekStart)+7)%7)
+			if monthAllowed(rule, day.Month()) {
+				dates = append(dates, day)
+			}
+		}
+	case FrequencyMonthly:
+		first := time.Date(y, m+time.Month(period*rule.Interval), 1, hh, mm, ss, 0, loc)
+		periodStart = first
+		if monthAllowed(rule, first.Month()) {
+			for _, day := range monthDays(rule, first.Year(), first.Month(), d) {
+				dates = append(dates, at(first.Year(), first.Month(), day))
+			}
+		}
+	case FrequencyYearly:
+		year := y + period*rule.Interval
+		periodStart = time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+		months := rule.ByMonth
+		if len(months) == 0 {
+			months = []time.Month{m}
+		}
+		for _, month := range months {
+			for _, day := range monthDays(rule, year, month, d) {
+				dates = append(dates, at(year, month, day))
+			}
+		}
+	}
+
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+	return dedupeTimes(dates), periodStart
+}
+
+// monthDays resolves BYMONTHDAY/BYDAY within one month, defaulting to the DTSTART day.
+func monthDays(rule *Recurrence, year int, month time.Month, defaultDay int) []int {
+	last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	if len(rule.ByMonthDay) == 0 && len(rule.ByDay) == 0 {
+		if defaultDay > last {
+			return nil
+		}
+		return []int{defaultDay}
+	}
+
+	var byMonthDay map[int]bool
+	if len(rule.ByMonthDay) > 0 {
+		byMonthDay = make(map[int]bool)
+		for _, value := range rule.ByMonthDay {
+			day := value
+			if value < 0 {
+				day = last + value + 1
+			}
+			if day >= 1 && day <= last {
+				byMonthDay[day] = true
+			}
+		}
+	}
+
+	var days []int
+	if len(rule.ByDay) > 0 {
+		for _, byDay := range rule.ByDay {
+			var matches []int
+			for day := 1; day <= last; day++ {
+				if time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday() == byDay.Day {
+					matches = append(matches, day)
+				}
+			}
+			switch {
+			case byDay.Ordinal > 0 && byDay.Ordinal <= len(matches):
+				matches = matches[byDay.Ordinal-1 : byDay.Ordinal]
+			case byDay.Ordinal < 0 && -byDay.Ordinal <= len(matches):
+				matches = matches[len(matches)+byDay.Ordinal : len(matches)+byDay.Ordinal+1]
+			case byDay.Ordinal != 0:
+				matches = nil
+			}
+			for _, day := range matches {
+				if byMonthDay == nil || byMonthDay[day] {
+					days = append(days, day)
+				}
+			}
+		}
+	} else {
+		for day := range byMonthDay {
+			days = append(days, day)
+		}
+	}
+
+	sort.Ints(days)
+	return days
+}
+
+func monthAllowed(rule *Recurrence, month time.Month) bool {
+	if len(rule.ByMonth) == 0 {
+		return true
+	}
+	for _, allowed := range rule.ByMonth {
+		if allowed == month {
+			return true
+		}
+	}
+	return false
+}
+
+func weekdayAllowed(rule *Recurrence, weekday time.Weekday) bool {
+	if len(rule.ByDay) == 0 {
+		return true
+	}
+	for _, byDay := range rule.ByDay {
+		if byDay.Day == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+func monthDayAllowed(rule *Recurrence, day time.Time) bool {
+	if len(rule.ByMonthDay) == 0 {
+		return true
+	}
+	last := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	for _, value := range rule.ByMonthDay {
+		if value == day.Day() || (value < 0 && last+value+1 == day.Day()) {
+			return true
+		}
+	}
+	return false
+}
+
+func dedupeTimes(values []time.Time) []time.Time {
+	if len(values) < 2 {
+		return values
+	}
+	result := values[:1]
+	for _, value := range values[1:] {
+		if !value.Equal(result[len(result)-1]) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// parseRecurrence parses an RRULE value. UNTIL given as a DATE includes that whole day in the
+// location of DTSTART.
+func parseRecurrence(value string, start time.Time) (*Recurrence, error) {
+	rule := &Recurrence{Interval: 1, WeekStart: time.Monday}
+
+	for _, part := range strings.Split(value, ";") {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		key, raw, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid RRULE part %q", part)
+		}
+		key = strings.ToUpper(strings.TrimSpace(key))
+		raw = strings.ToUpper(strings.TrimSpace(raw))
+
+		switch key {
+		case "FREQ":
+			switch Frequency(raw) {
+			case FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly:
+				rule.Freq = Frequency(raw)
+			default:
+				return nil, fmt.Errorf("%w: FREQ=%s", ErrUnsupportedRecurrence, raw)
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(raw)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid INTERVAL %q", raw)
+			}
+			rule.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(raw)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid COUNT %q", raw)
+			}
+			rule.Count = n
+		case "UNTIL":
+			until, err := parseUntil(raw, start.Location())
+			if err != nil {
+				return nil, err
+			}
+			rule.Until = &until
+		case "BYDAY":
+			for _, item := range strings.Split(raw, ",") {
+				byDay, err := parseWeekdayNum(item)
+				if err != nil {
+					return nil, err
+				}
+				rule.ByDay = append(rule.ByDay, byDay)
+			}
+		case "BYMONTHDAY":
+			for _, item := range strings.Split(raw, ",") {
+				n, err := strconv.Atoi(item)
+				if err != nil || n == 0 || n < -31 || n > 31 {
+					return nil, fmt.Errorf("invalid BYMONTHDAY %q", item)
+				}
+				rule.ByMonthDay = append(rule.ByMonthDay, n)
+			}
+		case "BYMONTH":
+			for _, item := range strings.Split(raw, ",") {
+				n, err := strconv.Atoi(item)
+				if err != nil || n < 1 || n > 12 {
+					return nil, fmt.Errorf("invalid BYMONTH %q", item)
+				}
+				rule.ByMonth = append(rule.ByMonth, time.Month(n))
+			}
+		case "WKST":
+			weekday, ok := weekdayCodes[raw]
+			if !ok {
+				return nil, fmt.Errorf("invalid WKST %q", raw)
+			}
+			rule.WeekStart = weekday
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedRecurrence, key)
+		}
+	}
+
+	if rule.Freq == "" {
+		return nil, errors.New("RRULE without FREQ")
+	}
+	if rule.Count > 0 && rule.Until != nil {
+		return nil, errors.New("RRULE with both COUNT and UNTIL")
+	}
+	for _, byDay := range rule.ByDay {
+		if byDay.Ordinal == 0 {
+			continue
+		}
+		if rule.Freq != FrequencyMonthly && !(rule.Freq == FrequencyYearly && len(rule.ByMonth) > 0) {
+			return nil, fmt.Errorf("%w: ordinal BYDAY with FREQ=%s", ErrUnsupportedRecurrence, rule.Freq)
+		}
+	}
+	if rule.Freq == FrequencyYearly && len(rule.ByDay) > 0 && len(rule.ByMonth) == 0 {
+		return nil, fmt.Errorf("%w: yearly BYDAY without BYMONTH", ErrUnsupportedRecurrence)
+	}
+	if rule.Freq == FrequencyWeekly && len(rule.ByMonthDay) > 0 {
+		return nil, fmt.Errorf("invalid BYMONTHDAY with FREQ=WEEKLY")
+	}
+
+	return rule, nil
+}
+
+func parseUntil(raw string, loc *time.Location) (time.Time, error) {
+	switch {
+	case len(raw) == len(dateLayout):
+		day, err := time.ParseInLocation(dateLayout, raw, loc)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid UNTIL %q", raw)
+		}
+		return day.AddDate(0, 0, 1).Add(-time.Second), nil
+	case strings.HasSuffix(raw, "Z"):
+		until, err := time.Parse(utcLayout, raw)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid UNTIL %q", raw)
+		}
+		return until, nil
+	default:
+		until, err := time.ParseInLocation(localLayout, raw, loc)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid UNTIL %q", raw)
+		}
+		return until, nil
+	}
+}
+
+func parseWeekdayNum(raw string) (WeekdayNum, error) {
+	raw = strings.TrimSpace(raw)
+	if len(raw) < 2 {
+		return WeekdayNum{}, fmt.Errorf("invalid BYDAY %q", raw)
+	}
+	code := raw[len(raw)-2:]
+	weekday, ok := weekdayCodes[code]
+	if !ok {
+		return WeekdayNum{}, fmt.Errorf("invalid BYDAY %q", raw)
+	}
+	result := WeekdayNum{Day: weekday}
+	if prefix := raw[:len(raw)-2]; prefix != "" {
+		n, err := strconv.Atoi(prefix)
+		if err != nil || n == 0 || n < -5 || n > 5 {
+			return WeekdayNum{}, fmt.Errorf("invalid BYDAY %q", raw)
+		}
+		result.Ordinal = n
+	}
+	return result, nil
+}
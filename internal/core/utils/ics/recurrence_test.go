@@ -0,0 +1,210 @@
+package ics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// calendarDocument wraps VEVENT lines in a VCALENDAR with CRLF line endings.
+func calendarDocument(lines ...string) string {
+	all := append([]string{"BEGIN:VCALENDAR", "VERSION:2.0"}, lines...)
+	all = append(all, "END:VCALENDAR")
+	return strings.Join(all, "\r\n") + "\r\n"
+}
+
+func formatOccurrences(occurrences []Occurrence, loc *time.Location) string {
+	parts := make([]string, 0, len(occurrences))
+	for _, occurrence := range occurrences {
+		parts = append(parts, occurrence.Start.In(loc).Format("2006-01-02T15:04")+"/"+occurrence.End.In(loc).Format("15:04"))
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestExpand(t *testing.T) {
+	t.Parallel()
+
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+	from := time.Date(2026, time.January, 1, 0, 0, 0, 0, loc)
+	to := time.Date(2026, time.July, 1, 0, 0, 0, 0, loc)
+
+	cases := []struct {
+		name     string
+		events   []string
+		expected string
+	}{
+		{
+			name: "single event",
+			events: []string{
+				"BEGIN:VEVENT", "UID:single", "DTSTART:20260302T120000Z", "DTEND:20260302T130000Z", "END:VEVENT",
+			},
+			expected: "2026-03-02T09:00/10:00",
+		},
+		{
+			name: "monthly second tuesday",
+			events: []string{
+				"BEGIN:VEVENT", "UID:2tu", "DTSTART;TZID=America/Sao_Paulo:20260310T090000", "DURATION:PT1H",
+				"RRULE:FREQ=MONTHLY;BYDAY=2TU;COUNT=3", "END:VEVENT",
+			},
+			expected: "2026-03-10T09:00/10:00 2026-04-14T09:00/10:00 2026-05-12T09:00/10:00",
+		},
+		{
+			name: "monthly last friday",
+			events: []string{
+				"BEGIN:VEVENT", "UID:-1fr", "DTSTART;TZID=America/Sao_Paulo:20260130T140000", "DTEND;TZID=America/Sao_Paulo:20260130T150000",
+				"RRULE:FREQ=MONTHLY;BYDAY=-1FR;COUNT=3", "END:VEVENT",
+			},
+			expected: "2026-01-30T14:00/15:00 2026-02-27T14:00/15:00 2026-03-27T14:00/15:00",
+		},
+		{
+			name: "yearly ordinal weekday in month",
+			events: []string{
+				"BEGIN:VEVENT", "UID:yearly", "DTSTART;TZID=America/Sao_Paulo:20250511T100000", "DURATION:PT2H",
+				"RRULE:FREQ=YEARLY;BYMONTH=5;BYDAY=2SU", "END:VEVENT",
+			},
+			expected: "2026-05-10T10:00/12:00",
+		},
+		{
+			name: "weekly until date includes the last day",
+			events: []string{
+				"BEGIN:VEVENT", "UID:weekly", "DTSTART;TZID=America/Sao_Paulo:20260302T180000", "DURATION:PT30M",
+				"RRULE:FREQ=WEEKLY;BYDAY=MO,WE;UNTIL=20260311", "END:VEVENT",
+			},
+			expected: "2026-03-02T18:00/18:30 2026-03-04T18:00/18:30 2026-03-09T18:00/18:30 2026-03-11T18:00/18:30",
+		},
+		{
+			name: "until date-time in utc",
+			events: []string{
+				"BEGIN:VEVENT", "UID:until-utc", "DTSTART;TZID=America/Sao_Paulo:20260302T080000", "DURATION:PT1H",
+				"RRULE:FREQ=DAILY;UNTIL=20260304T110000Z", "END:VEVENT",
+			},
+			expected: "2026-03-02T08:00/09:00 2026-03-03T08:00/09:00 2026-03-04T08:00/09:00",
+		},
+		{
+			name: "exdate is removed and still counts towards count",
+			events: []string{
+				"BEGIN:VEVENT", "UID:exdate", "DTSTART;TZID=America/Sao_Paulo:20260302T080000", "DURATION:PT1H",
+				"RRULE:FREQ=DAILY;COUNT=4", "EXDATE;TZID=America/Sao_Paulo:20260303T080000,20260304T080000", "END:VEVENT",
+			},
+			expected: "2026-03-02T08:00/09:00 2026-03-05T08:00/09:00",
+		},
+		{
+			name: "all-day exdate matches the whole day",
+			events: []string{
+				"BEGIN:VEVENT", "UID:allday", "DTSTART;VALUE=DATE:20260302", "DTEND;VALUE=DATE:20260303",
+				"RRULE:FREQ=DAILY;COUNT=3", "EXDATE;VALUE=DATE:20260303", "END:VEVENT",
+			},
+			expected: "2026-03-02T00:00/00:00 2026-03-04T00:00/00:00",
+		},
+		{
+			name: "recurrence-id override replaces its instance",
+			events: []string{
+				"BEGIN:VEVENT", "UID:series", "DTSTART;TZID=America/Sao_Paulo:20260302T090000", "DURATION:PT1H",
+				"RRULE:FREQ=WEEKLY;COUNT=3", "END:VEVENT",
+				"BEGIN:VEVENT", "UID:series", "RECURRENCE-ID;TZID=America/Sao_Paulo:20260309T090000",
+				"DTSTART;TZID=America/Sao_Paulo:20260310T150000", "DURATION:PT2H", "END:VEVENT",
+			},
+			expected: "2026-03-02T09:00/10:00 2026-03-10T15:00/17:00 2026-03-16T09:00/10:00",
+		},
+		{
+			name: "cancelled override removes its instance",
+			events: []string{
+				"BEGIN:VEVENT", "UID:series", "DTSTART;TZID=America/Sao_Paulo:20260302T090000", "DURATION:PT1H",
+				"RRULE:FREQ=WEEKLY;COUNT=2", "END:VEVENT",
+				"BEGIN:VEVENT", "UID:series", "RECURRENCE-ID;TZID=America/Sao_Paulo:20260302T090000",
+				"DTSTART;TZID=America/Sao_Paulo:20260302T090000", "DURATION:PT1H", "STATUS:CANCELLED", "END:VEVENT",
+			},
+			expected: "2026-03-09T09:00/10:00",
+		},
+		{
+			name: "free and cancelled events are ignored",
+			events: []string{
+				"BEGIN:VEVENT", "UID:free", "DTSTART:20260302T120000Z", "DURATION:PT1H", "TRANSP:TRANSPARENT", "END:VEVENT",
+				"BEGIN:VEVENT", "UID:cancelled", "DTSTART:20260303T120000Z", "DURATION:PT1H", "STATUS:CANCELLED", "END:VEVENT",
+			},
+			expected: "",
+		},
+		{
+			name: "occurrences outside the window are dropped",
+			events: []string{
+				"BEGIN:VEVENT", "UID:edge", "DTSTART;TZID=America/Sao_Paulo:20251230T090000", "DURATION:PT1H",
+				"RRULE:FREQ=MONTHLY;INTERVAL=3", "END:VEVENT",
+			},
+			expected: "2026-03-30T09:00/10:00 2026-06-30T09:00/10:00",
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			events, err := Parse(strings.NewReader(calendarDocument(tt.events...)), loc)
+			if err != nil {
+				t.Fatalf("Parse unexpected error: %v", err)
+			}
+			expansion, err := Expand(events, from, to, 100)
+			if err != nil {
+				t.Fatalf("Expand unexpected error: %v", err)
+			}
+			if got := formatOccurrences(expansion.Occurrences, loc); got != tt.expected {
+				t.Fatalf("Expand() = %q, expected %q", got, tt.expected)
+			}
+			if len(expansion.Unsupported) != 0 {
+				t.Fatalf("Expand() reported %d unsupported events, expected none", len(expansion.Unsupported))
+			}
+		})
+	}
+}
+
+func TestExpandReportsUnsupportedRules(t *testing.T) {
+	t.Parallel()
+
+	document := calendarDocument(
+		"BEGIN:VEVENT", "UID:hourly", "DTSTART:20260302T120000Z", "DURATION:PT1H", "RRULE:FREQ=HOURLY", "END:VEVENT",
+		"BEGIN:VEVENT", "UID:ordinal-weekly", "DTSTART:20260302T120000Z", "DURATION:PT1H", "RRULE:FREQ=WEEKLY;BYDAY=1MO", "END:VEVENT",
+		"BEGIN:VEVENT", "UID:single", "DTSTART:20260302T120000Z", "DURATION:PT1H", "END:VEVENT",
+	)
+	events, err := Parse(strings.NewReader(document), time.UTC)
+	if err != nil {
+		t.Fatalf("Parse unexpected error: %v", err)
+	}
+
+	expansion, err := Expand(events, time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC), 0)
+	if err != nil {
+		t.Fatalf("Expand unexpected error: %v", err)
+	}
+	if len(expansion.Occurrences) != 1 || expansion.Occurrences[0].UID != "single" {
+		t.Fatalf("Expand() occurrences = %+v, expected only the single event", expansion.Occurrences)
+	}
+	if len(expansion.Unsupported) != 2 {
+		t.Fatalf("Expand() reported %d unsupported events, expected 2", len(expansion.Unsupported))
+	}
+	for _, event := range expansion.Unsupported {
+		if !errors.Is(event.RecurrenceErr, ErrUnsupportedRecurrence) {
+			t.Fatalf("event %q RecurrenceErr = %v, expected ErrUnsupportedRecurrence", event.UID, event.RecurrenceErr)
+		}
+	}
+}
+
+func TestExpandLimit(t *testing.T) {
+	t.Parallel()
+
+	document := calendarDocument("BEGIN:VEVENT", "UID:daily", "DTSTART:20260301T120000Z", "DURATION:PT1H", "RRULE:FREQ=DAILY", "END:VEVENT")
+	events, err := Parse(strings.NewReader(document), time.UTC)
+	if err != nil {
+		t.Fatalf("Parse unexpected error: %v", err)
+	}
+
+	from := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := Expand(events, from, from.AddDate(0, 0, 10), 10); err != nil {
+		t.Fatalf("Expand with exactly 10 occurrences unexpected error: %v", err)
+	}
+	if _, err := Expand(events, from, from.AddDate(0, 0, 11), 10); !errors.Is(err, ErrTooManyOccurrences) {
+		t.Fatalf("Expand with 11 occurrences error = %v, expected ErrTooManyOccurrences", err)
+	}
+}
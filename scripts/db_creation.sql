@@ -342,6 +342,9 @@ CREATE TABLE IF NOT EXISTS `toq_db`.`user_roles` (
   `is_active` TINYINT UNSIGNED NOT NULL DEFAULT 1,
   `status` TINYINT NOT NULL DEFAULT 0,
   `expires_at` TIMESTAMP(6) NULL DEFAULT NULL,
+  `valid_from` TIMESTAMP(6) NULL DEFAULT NULL,
+  `grant_state` TINYINT UNSIGNED NOT NULL DEFAULT 0,
+  `expiry_notified_at` TIMESTAMP(6) NULL DEFAULT NULL,
   PRIMARY KEY (`id`),
   INDEX `fk_user_idx` (`user_id` ASC) VISIBLE,
   INDEX `uk_user_roles` (`user_id` ASC, `role_id` ASC) INVISIBLE,
@@ -349,6 +352,7 @@ CREATE TABLE IF NOT EXISTS `toq_db`.`user_roles` (
   INDEX `idx_user_roles_role` (`role_id` ASC) INVISIBLE,
   INDEX `idx_user_roles_active` (`is_active` ASC) INVISIBLE,
   INDEX `idx_user_roles_expires` (`expires_at` ASC) VISIBLE,
+  INDEX `idx_user_roles_grant_state_valid_from` (`grant_state` ASC, `valid_from` ASC) VISIBLE,
   CONSTRAINT `fk_user_roles_user`
     FOREIGN KEY (`user_id`)
     REFERENCES `toq_db`.`users` (`id`)
@@ -362,6 +366,106 @@ ENGINE = InnoDB
 DEFAULT CHARACTER SET = utf8mb3;
 
 
+-- -----------------------------------------------------
+-- Table `toq_db`.`user_data_exports`
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `toq_db`.`user_data_exports` ;
+
+CREATE TABLE IF NOT EXISTS `toq_db`.`user_data_exports` (
+  `id` INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `user_id` INT UNSIGNED NOT NULL,
+  `status` ENUM('PENDING', 'PROCESSING', 'COMPLETED', 'FAILED') NOT NULL DEFAULT 'PENDING',
+  `object_key` VARCHAR(255) NULL DEFAULT NULL,
+  `error_message` VARCHAR(500) NULL DEFAULT NULL,
+  `attempts` TINYINT UNSIGNED NOT NULL DEFAULT 0,
+  `requested_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  `started_at` DATETIME(6) NULL DEFAULT NULL,
+  `completed_at` DATETIME(6) NULL DEFAULT NULL,
+  `expires_at` DATETIME(6) NULL DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `idx_user_data_exports_user` (`user_id` ASC, `requested_at` ASC) VISIBLE,
+  INDEX `idx_user_data_exports_status` (`status` ASC, `requested_at` ASC) VISIBLE,
+  INDEX `idx_user_data_exports_started` (`status` ASC, `started_at` ASC) VISIBLE,
+  CONSTRAINT `fk_user_data_exports_user`
+    FOREIGN KEY (`user_id`)
+    REFERENCES `toq_db`.`users` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
+-- -----------------------------------------------------
+-- Table `toq_db`.`user_notification_consents`
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `toq_db`.`user_notification_consents` ;
+
+CREATE TABLE IF NOT EXISTS `toq_db`.`user_notification_consents` (
+  `id` INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `user_id` INT UNSIGNED NOT NULL,
+  `channel` ENUM('email', 'sms', 'push') NOT NULL,
+  `category` VARCHAR(32) NOT NULL,
+  `granted` TINYINT UNSIGNED NOT NULL,
+  `policy_version` VARCHAR(20) NOT NULL,
+  `source` VARCHAR(32) NOT NULL,
+  `created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  `updated_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_user_notification_consents` (`user_id` ASC, `channel` ASC, `category` ASC) VISIBLE,
+  CONSTRAINT `fk_user_notification_consents_user`
+    FOREIGN KEY (`user_id`)
+    REFERENCES `toq_db`.`users` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
+-- -----------------------------------------------------
+-- Table `toq_db`.`user_calendar_feeds`
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `toq_db`.`user_calendar_feeds` ;
+
+CREATE TABLE IF NOT EXISTS `toq_db`.`user_calendar_feeds` (
+  `user_id` INT UNSIGNED NOT NULL,
+  `token_hash` CHAR(64) NOT NULL,
+  `created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  `last_accessed_at` DATETIME(6) NULL DEFAULT NULL,
+  PRIMARY KEY (`user_id`),
+  UNIQUE INDEX `uk_user_calendar_feeds_token` (`token_hash` ASC) VISIBLE,
+  CONSTRAINT `fk_user_calendar_feeds_user`
+    FOREIGN KEY (`user_id`)
+    REFERENCES `toq_db`.`users` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
+-- -----------------------------------------------------
+-- Table `toq_db`.`user_notifications`
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `toq_db`.`user_notifications` ;
+
+CREATE TABLE IF NOT EXISTS `toq_db`.`user_notifications` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `user_id` INT UNSIGNED NOT NULL,
+  `channel` ENUM('email', 'sms', 'push') NOT NULL,
+  `category` VARCHAR(32) NOT NULL,
+  `title` VARCHAR(255) NOT NULL,
+  `body` VARCHAR(1000) NOT NULL,
+  `deep_link` VARCHAR(500) NULL DEFAULT NULL,
+  `data` JSON NULL,
+  `read_at` DATETIME(6) NULL DEFAULT NULL,
+  `created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  PRIMARY KEY (`id`),
+  INDEX `idx_user_notifications_user_created` (`user_id` ASC, `created_at` DESC) VISIBLE,
+  INDEX `idx_user_notifications_user_unread` (`user_id` ASC, `read_at` ASC) VISIBLE,
+  CONSTRAINT `fk_user_notifications_user`
+    FOREIGN KEY (`user_id`)
+    REFERENCES `toq_db`.`users` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
 -- -----------------------------------------------------
 -- Table `toq_db`.`base_features`
 -- -----------------------------------------------------
@@ -599,6 +703,57 @@ CREATE TABLE IF NOT EXISTS `toq_db`.`listing_catalog_values` (
 ENGINE = InnoDB;
 
 
+-- -----------------------------------------------------
+-- Table `toq_db`.`availability_templates`
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `toq_db`.`availability_templates` ;
+
+CREATE TABLE IF NOT EXISTS `toq_db`.`availability_templates` (
+  `id` INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `owner_id` INT UNSIGNED NOT NULL,
+  `name` VARCHAR(80) NOT NULL,
+  `is_default` TINYINT NOT NULL DEFAULT 0,
+  `created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  `updated_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_availability_templates_owner_name` (`owner_id` ASC, `name` ASC) VISIBLE,
+  CONSTRAINT `fk_availability_templates_owner`
+    FOREIGN KEY (`owner_id`)
+    REFERENCES `toq_db`.`users` (`id`)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
+-- -----------------------------------------------------
+-- Table `toq_db`.`availability_template_rules`
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `toq_db`.`availability_template_rules` ;
+
+CREATE TABLE IF NOT EXISTS `toq_db`.`availability_template_rules` (
+  `id` INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `template_id` INT UNSIGNED NOT NULL,
+  `day_of_week` TINYINT NOT NULL,
+  `start_minute` INT UNSIGNED NOT NULL,
+  `end_minute` INT UNSIGNED NOT NULL,
+  `rule_type` ENUM('BLOCK', 'FREE') NOT NULL,
+  `is_active` TINYINT NOT NULL DEFAULT 1,
+  `recurrence` ENUM('WEEKLY', 'MONTHLY_DAY', 'MONTHLY_WEEKDAY', 'DATE') NOT NULL DEFAULT 'WEEKLY',
+  `day_of_month` TINYINT UNSIGNED NULL,
+  `week_of_month` TINYINT NULL,
+  `rule_date` DATE NULL,
+  `valid_from` DATE NULL,
+  `valid_until` DATE NULL,
+  PRIMARY KEY (`id`),
+  INDEX `fk_template_rules_template_idx` (`template_id` ASC) VISIBLE,
+  CONSTRAINT `fk_template_rules_template`
+    FOREIGN KEY (`template_id`)
+    REFERENCES `toq_db`.`availability_templates` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
 -- -----------------------------------------------------
 -- Table `toq_db`.`listing_agendas`
 -- -----------------------------------------------------
@@ -609,12 +764,20 @@ CREATE TABLE IF NOT EXISTS `toq_db`.`listing_agendas` (
   `listing_identity_id` INT UNSIGNED NOT NULL,
   `owner_id` INT UNSIGNED NOT NULL,
   `timezone` VARCHAR(50) NOT NULL DEFAULT 'America/Sao_Paulo',
+  `template_id` INT UNSIGNED NULL,
+  `template_overridden` TINYINT NOT NULL DEFAULT 0,
   PRIMARY KEY (`id`),
   INDEX `fk_agenda_listing_idx` (`listing_identity_id` ASC) VISIBLE,
+  INDEX `fk_agenda_template_idx` (`template_id` ASC) VISIBLE,
   CONSTRAINT `fk_agenda_listing`
     FOREIGN KEY (`listing_identity_id`)
     REFERENCES `toq_db`.`listing_identities` (`id`)
     ON DELETE NO ACTION
+    ON UPDATE NO ACTION,
+  CONSTRAINT `fk_agenda_template`
+    FOREIGN KEY (`template_id`)
+    REFERENCES `toq_db`.`availability_templates` (`id`)
+    ON DELETE SET NULL
     ON UPDATE NO ACTION)
 ENGINE = InnoDB;
 
@@ -632,9 +795,15 @@ CREATE TABLE IF NOT EXISTS `toq_db`.`listing_agenda_rules` (
   `end_minute` INT UNSIGNED NOT NULL,
   `rule_type` ENUM('BLOCK', 'FREE') NOT NULL,
   `is_active` TINYINT NOT NULL DEFAULT 1,
+  `recurrence` ENUM('WEEKLY', 'MONTHLY_DAY', 'MONTHLY_WEEKDAY', 'DATE') NOT NULL DEFAULT 'WEEKLY',
+  `day_of_month` TINYINT UNSIGNED NULL,
+  `week_of_month` TINYINT NULL,
+  `rule_date` DATE NULL,
+  `valid_from` DATE NULL,
+  `valid_until` DATE NULL,
   PRIMARY KEY (`id`),
   INDEX `fk_rules_agenda_idx` (`agenda_id` ASC) VISIBLE,
-  UNIQUE INDEX `uk_rules_agenda` (`agenda_id` ASC, `day_of_week` ASC, `start_minute` ASC, `end_minute` ASC) VISIBLE,
+  INDEX `idx_rules_agenda_day` (`agenda_id` ASC, `day_of_week` ASC, `start_minute` ASC) VISIBLE,
   CONSTRAINT `fk_rules_agenda`
     FOREIGN KEY (`agenda_id`)
     REFERENCES `toq_db`.`listing_agendas` (`id`)
@@ -651,7 +820,7 @@ DROP TABLE IF EXISTS `toq_db`.`listing_agenda_entries` ;
 CREATE TABLE IF NOT EXISTS `toq_db`.`listing_agenda_entries` (
   `id` INT UNSIGNED NOT NULL AUTO_INCREMENT,
   `agenda_id` INT UNSIGNED NOT NULL,
-  `entry_type` ENUM('BLOCK', 'TEMP_BLOCK', 'VISIT_PENDING', 'VISIT_CONFIRMED', 'PHOTO_SESSION', 'HOLIDAY_INFO') NOT NULL,
+  `entry_type` ENUM('BLOCK', 'TEMP_BLOCK', 'VISIT_PENDING', 'VISIT_CONFIRMED', 'PHOTO_SESSION', 'HOLIDAY_INFO', 'OPEN_HOUSE') NOT NULL,
   `starts_at` DATETIME NOT NULL,
   `ends_at` DATETIME NOT NULL,
   `blocking` TINYINT NOT NULL,
@@ -705,6 +874,73 @@ CREATE TABLE IF NOT EXISTS `toq_db`.`listing_visits` (
 ENGINE = InnoDB;
 
 
+-- -----------------------------------------------------
+-- Table `toq_db`.`listing_open_houses`
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `toq_db`.`listing_open_houses` ;
+
+CREATE TABLE IF NOT EXISTS `toq_db`.`listing_open_houses` (
+  `id` INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `listing_identity_id` INT UNSIGNED NOT NULL,
+  `owner_id` INT UNSIGNED NOT NULL,
+  `agenda_entry_id` INT UNSIGNED NULL,
+  `starts_at` DATETIME NOT NULL,
+  `ends_at` DATETIME NOT NULL,
+  `capacity` SMALLINT UNSIGNED NOT NULL,
+  `slot_minutes` SMALLINT UNSIGNED NOT NULL DEFAULT 0,
+  `status` ENUM('SCHEDULED', 'CANCELLED') NOT NULL DEFAULT 'SCHEDULED',
+  `notes` VARCHAR(500) NULL,
+  `cancellation_reason` VARCHAR(255) NULL,
+  `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  INDEX `idx_open_houses_listing_starts` (`listing_identity_id` ASC, `starts_at` ASC) VISIBLE,
+  INDEX `idx_open_houses_owner_starts` (`owner_id` ASC, `starts_at` ASC) VISIBLE,
+  CONSTRAINT `fk_open_houses_listing_identity`
+    FOREIGN KEY (`listing_identity_id`)
+    REFERENCES `toq_db`.`listing_identities` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION,
+  CONSTRAINT `fk_open_houses_owner`
+    FOREIGN KEY (`owner_id`)
+    REFERENCES `toq_db`.`users` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
+-- -----------------------------------------------------
+-- Table `toq_db`.`listing_open_house_registrations`
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `toq_db`.`listing_open_house_registrations` ;
+
+CREATE TABLE IF NOT EXISTS `toq_db`.`listing_open_house_registrations` (
+  `id` INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `open_house_id` INT UNSIGNED NOT NULL,
+  `realtor_id` INT UNSIGNED NOT NULL,
+  `client_name` VARCHAR(120) NOT NULL,
+  `client_phone` VARCHAR(25) NULL,
+  `slot_start` DATETIME NOT NULL,
+  `status` ENUM('REGISTERED', 'WAITLISTED', 'CANCELLED', 'CHECKED_IN', 'NO_SHOW') NOT NULL,
+  `checked_in_at` DATETIME NULL,
+  `created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  INDEX `idx_open_house_registrations_slot` (`open_house_id` ASC, `slot_start` ASC, `status` ASC) VISIBLE,
+  INDEX `idx_open_house_registrations_realtor` (`realtor_id` ASC) VISIBLE,
+  CONSTRAINT `fk_open_house_registrations_open_house`
+    FOREIGN KEY (`open_house_id`)
+    REFERENCES `toq_db`.`listing_open_houses` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION,
+  CONSTRAINT `fk_open_house_registrations_realtor`
+    FOREIGN KEY (`realtor_id`)
+    REFERENCES `toq_db`.`users` (`id`)
+    ON DELETE CASCADE
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
 -- -----------------------------------------------------
 -- Table `toq_db`.`holiday_calendars`
 -- -----------------------------------------------------
@@ -789,6 +1025,9 @@ CREATE TABLE IF NOT EXISTS `toq_db`.`photographer_photo_session_bookings` (
   `reason` VARCHAR(255) NULL,
   `reservation_token` VARCHAR(36) NULL,
   `reserved_until` DATETIME(6) NOT NULL DEFAULT (DATE_ADD(CURRENT_TIMESTAMP(6), INTERVAL 3 DAY)),
+  `assignment_mode` ENUM('OWNER_CHOICE', 'AUTO') NOT NULL DEFAULT 'OWNER_CHOICE',
+  `assignment_details` JSON NULL,
+  `session_type` ENUM('STANDARD', 'PANORAMA') NOT NULL DEFAULT 'STANDARD',
   PRIMARY KEY (`id`),
   UNIQUE INDEX `uk_booking_entry` (`agenda_entry_id` ASC) VISIBLE,
   INDEX `ix_photographer_user_id_idx` (`photographer_user_id` ASC) VISIBLE,
@@ -812,6 +1051,105 @@ CREATE TABLE IF NOT EXISTS `toq_db`.`photographer_photo_session_bookings` (
 ENGINE = InnoDB;
 
 
+-- -----------------------------------------------------
+-- Table `toq_db`.`photo_session_reschedules`
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `toq_db`.`photo_session_reschedules` ;
+
+CREATE TABLE IF NOT EXISTS `toq_db`.`photo_session_reschedules` (
+  `id` INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `booking_id` INT UNSIGNED NOT NULL,
+  `listing_identity_id` INT UNSIGNED NOT NULL,
+  `requested_by` INT UNSIGNED NOT NULL,
+  `from_photographer_user_id` INT UNSIGNED NOT NULL,
+  `to_photographer_user_id` INT UNSIGNED NOT NULL,
+  `from_starts_at` DATETIME(6) NOT NULL,
+  `to_starts_at` DATETIME(6) NOT NULL,
+  `notice_minutes` INT NOT NULL,
+  `reason` VARCHAR(255) NULL,
+  `created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  PRIMARY KEY (`id`),
+  INDEX `idx_reschedules_booking` (`booking_id` ASC) VISIBLE,
+  INDEX `idx_reschedules_listing_created` (`listing_identity_id` ASC, `created_at` ASC) VISIBLE,
+  INDEX `idx_reschedules_photographer_created` (`from_photographer_user_id` ASC, `created_at` ASC) VISIBLE)
+ENGINE = InnoDB;
+
+
+-- -----------------------------------------------------
+-- Table `toq_db`.`photographer_payout_rules`
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `toq_db`.`photographer_payout_rules` ;
+
+CREATE TABLE IF NOT EXISTS `toq_db`.`photographer_payout_rules` (
+  `id` INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `property_type` SMALLINT UNSIGNED NULL,
+  `session_type` ENUM('STANDARD', 'PANORAMA') NULL,
+  `min_size_m2` DECIMAL(10,2) NULL,
+  `max_size_m2` DECIMAL(10,2) NULL,
+  `base_amount_cents` INT UNSIGNED NOT NULL,
+  `weekend_surcharge_pct` DECIMAL(5,2) NOT NULL DEFAULT 0,
+  `holiday_surcharge_pct` DECIMAL(5,2) NOT NULL DEFAULT 0,
+  `is_active` TINYINT UNSIGNED NOT NULL DEFAULT 1,
+  PRIMARY KEY (`id`))
+ENGINE = InnoDB;
+
+
+-- -----------------------------------------------------
+-- Table `toq_db`.`photographer_payout_ledger`
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `toq_db`.`photographer_payout_ledger` ;
+
+CREATE TABLE IF NOT EXISTS `toq_db`.`photographer_payout_ledger` (
+  `id` INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `booking_id` INT UNSIGNED NOT NULL,
+  `photographer_user_id` INT UNSIGNED NOT NULL,
+  `listing_identity_id` INT UNSIGNED NOT NULL,
+  `rule_id` INT UNSIGNED NULL,
+  `session_type` ENUM('STANDARD', 'PANORAMA') NOT NULL,
+  `property_type` SMALLINT UNSIGNED NOT NULL,
+  `size_m2` DECIMAL(10,2) NOT NULL DEFAULT 0,
+  `session_starts_at` DATETIME(6) NOT NULL,
+  `period` CHAR(7) NOT NULL,
+  `base_amount_cents` INT UNSIGNED NOT NULL,
+  `surcharge_pct` DECIMAL(5,2) NOT NULL DEFAULT 0,
+  `surcharge_reason` VARCHAR(255) NULL,
+  `amount_cents` INT UNSIGNED NOT NULL,
+  `status` ENUM('PENDING', 'PAID') NOT NULL DEFAULT 'PENDING',
+  `payout_reference` VARCHAR(40) NULL,
+  `paid_at` DATETIME(6) NULL,
+  `created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `uk_payout_ledger_booking` (`booking_id` ASC) VISIBLE,
+  INDEX `idx_payout_ledger_photographer_period` (`photographer_user_id` ASC, `period` ASC) VISIBLE,
+  INDEX `idx_payout_ledger_period_status` (`period` ASC, `status` ASC) VISIBLE)
+ENGINE = InnoDB;
+
+
+-- -----------------------------------------------------
+-- Table `toq_db`.`photographer_session_kpis`
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `toq_db`.`photographer_session_kpis` ;
+
+CREATE TABLE IF NOT EXISTS `toq_db`.`photographer_session_kpis` (
+  `booking_id` INT UNSIGNED NOT NULL,
+  `photographer_user_id` INT UNSIGNED NOT NULL,
+  `listing_identity_id` INT UNSIGNED NOT NULL,
+  `session_starts_at` DATETIME(6) NOT NULL,
+  `arrived_at` DATETIME(6) NULL,
+  `arrival_on_time` TINYINT UNSIGNED NULL,
+  `completed_at` DATETIME(6) NULL,
+  `media_uploaded_at` DATETIME(6) NULL,
+  `owner_rejections` INT UNSIGNED NOT NULL DEFAULT 0,
+  `owner_approved_at` DATETIME(6) NULL,
+  `rating` TINYINT UNSIGNED NULL,
+  `rating_comment` VARCHAR(500) NULL,
+  `rated_at` DATETIME(6) NULL,
+  PRIMARY KEY (`booking_id`),
+  INDEX `idx_session_kpis_photographer_start` (`photographer_user_id` ASC, `session_starts_at` ASC) VISIBLE,
+  INDEX `idx_session_kpis_listing_start` (`listing_identity_id` ASC, `session_starts_at` ASC) VISIBLE)
+ENGINE = InnoDB;
+
+
 -- -----------------------------------------------------
 -- Table `toq_db`.`photographer_service_areas`
 -- -----------------------------------------------------
@@ -1036,6 +1374,52 @@ CREATE TABLE IF NOT EXISTS `toq_db`.`media_processing_jobs` (
 ENGINE = InnoDB;
 
 
+-- -----------------------------------------------------
+-- Table `toq_db`.`media_tours`
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `toq_db`.`media_tours` ;
+
+CREATE TABLE IF NOT EXISTS `toq_db`.`media_tours` (
+  `listing_identity_id` INT UNSIGNED NOT NULL,
+  `start_asset_id` INT UNSIGNED NOT NULL,
+  `nodes` JSON NOT NULL,
+  `updated_by` INT UNSIGNED NOT NULL,
+  `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`listing_identity_id`),
+  CONSTRAINT `fk_media_tours_identity`
+    FOREIGN KEY (`listing_identity_id`)
+    REFERENCES `toq_db`.`listing_identities` (`id`)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
+-- -----------------------------------------------------
+-- Table `toq_db`.`listing_parcels`
+-- -----------------------------------------------------
+DROP TABLE IF EXISTS `toq_db`.`listing_parcels` ;
+
+CREATE TABLE IF NOT EXISTS `toq_db`.`listing_parcels` (
+  `listing_identity_id` INT UNSIGNED NOT NULL,
+  `asset_id` INT UNSIGNED NOT NULL,
+  `polygon` POLYGON NOT NULL SRID 4326,
+  `coordinates` JSON NOT NULL,
+  `area_m2` DECIMAL(12,2) NOT NULL,
+  `perimeter_m` DECIMAL(12,2) NOT NULL,
+  `centroid_lat` DECIMAL(10,7) NOT NULL,
+  `centroid_lng` DECIMAL(10,7) NOT NULL,
+  `divergences` JSON NOT NULL,
+  `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`listing_identity_id`),
+  SPATIAL INDEX `idx_listing_parcels_polygon` (`polygon`),
+  CONSTRAINT `fk_listing_parcels_identity`
+    FOREIGN KEY (`listing_identity_id`)
+    REFERENCES `toq_db`.`listing_identities` (`id`)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION)
+ENGINE = InnoDB;
+
+
 -- -----------------------------------------------------
 -- Table `toq_db`.`proposal_documents`
 -- -----------------------------------------------------
@@ -1175,6 +1559,7 @@ START TRANSACTION;
 -- TRUNCATE TABLE listing_catalog_values;
 -- TRUNCATE TABLE holiday_calendars;
 -- TRUNCATE TABLE holiday_calendar_dates;
+-- TRUNCATE TABLE photographer_payout_rules;
 
 LOAD DATA INFILE '/var/lib/mysql-files/base_features.csv'
 INTO TABLE base_features
@@ -1287,6 +1672,18 @@ ENCLOSED BY '"'
 LINES TERMINATED BY '\n'
 IGNORE 1 ROWS;
 
+LOAD DATA INFILE '/var/lib/mysql-files/base_photographer_payout_rules.csv'
+INTO TABLE photographer_payout_rules
+FIELDS TERMINATED BY ';'
+ENCLOSED BY '"'
+LINES TERMINATED BY '\n'
+IGNORE 1 ROWS
+(id, @property_type, @session_type, @min_size_m2, @max_size_m2, base_amount_cents, weekend_surcharge_pct, holiday_surcharge_pct, is_active)
+SET property_type = NULLIF(@property_type, 'NULL'),
+    session_type = NULLIF(@session_type, 'NULL'),
+    min_size_m2 = NULLIF(@min_size_m2, 'NULL'),
+    max_size_m2 = NULLIF(@max_size_m2, 'NULL');
+
 -- Reabilitar verificação de foreign keys
 SET FOREIGN_KEY_CHECKS = 1;
 COMMIT;
@@ -72,15 +72,15 @@ for zip_file in "${zip_files[@]}"; do
 			--memory-size 1024 \
 			--zip-file "fileb://$zip_file" \
 			${FFMPEG_LAYER_ARN:+--layers "$FFMPEG_LAYER_ARN"} \
-			$( [ "$lambda" = "video_thumbnails" ] && echo --environment "Variables={FFMPEG_PATH=/opt/bin/ffmpeg}" ) >/dev/null
+			$( case "$lambda" in video_thumbnails|video_hls) echo --environment "Variables={FFMPEG_PATH=/opt/bin/ffmpeg}" ;; esac ) >/dev/null
 	else
 		aws lambda update-function-code \
 			--function-name "$function_name" \
 			--zip-file "fileb://$zip_file" > /dev/null
 		wait_lambda_updated "$function_name"
 
-			# Update config for video thumbnails to ensure ffmpeg path is set and layer attached if provided
-			if [ "$lambda" = "video_thumbnails" ]; then
+			# Update config for ffmpeg-based lambdas to ensure ffmpeg path is set and layer attached if provided
+			if [ "$lambda" = "video_thumbnails" ] || [ "$lambda" = "video_hls" ]; then
 				if [ -n "$FFMPEG_LAYER_ARN" ]; then
 					aws lambda update-function-configuration \
 						--function-name "$function_name" \
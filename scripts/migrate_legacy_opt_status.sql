@@ -0,0 +1,22 @@
+-- Migração única: converte o opt-out legado de push (users.opt_status = 0) em consentimentos
+-- revogados na central de preferências (user_notification_consents).
+-- Categorias transacionais não são gravadas (sempre entregues). Marketing já exige opt-in explícito,
+-- mas o registro revogado documenta a origem da escolha.
+-- Consentimentos já registrados pelo usuário são preservados (INSERT IGNORE sobre a chave única).
+
+START TRANSACTION;
+
+INSERT IGNORE INTO `toq_db`.`user_notification_consents`
+  (`user_id`, `channel`, `category`, `granted`, `policy_version`, `source`)
+SELECT u.id, 'push', c.category, 0, 'legacy', 'legacy_opt_status'
+  FROM `toq_db`.`users` u
+  CROSS JOIN (
+    SELECT 'visit_updates' AS category
+    UNION ALL SELECT 'proposal_updates'
+    UNION ALL SELECT 'saved_search_alerts'
+    UNION ALL SELECT 'marketing'
+  ) c
+ WHERE u.opt_status = 0
+   AND u.deleted = 0;
+
+COMMIT;